@@ -35,6 +35,10 @@ const (
 	// MachineArchitectureLabel is a label that indicates the machine architecture.
 	MachineArchitectureLabel = "common.ironcore.dev/architecture"
 
+	// RuntimeCapabilityLabelPrefix is the prefix of the labels a poollet sets on its pool for every
+	// capability of its runtime, e.g. capability.ironcore.dev/snapshots=true.
+	RuntimeCapabilityLabelPrefix = "capability.ironcore.dev/"
+
 	// DefaultEphemeralManager is the default ironcoreephemeral manager.
 	DefaultEphemeralManager = "ephemeral-manager"
 )
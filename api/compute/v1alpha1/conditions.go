@@ -44,3 +44,55 @@ func SetMachinePoolCondition(conditions []MachinePoolCondition, cond MachinePool
 	conditions[idx] = cond
 	return conditions
 }
+
+// RemoveMachinePoolCondition removes the condition of the given type from the conditions slice.
+func RemoveMachinePoolCondition(conditions []MachinePoolCondition, typ MachinePoolConditionType) []MachinePoolCondition {
+	return slices.DeleteFunc(conditions, func(cond MachinePoolCondition) bool {
+		return cond.Type == typ
+	})
+}
+
+// FindMachineCondition returns a pointer to the condition of the given type,
+// or nil if no condition of that type is present.
+func FindMachineCondition(conditions []MachineCondition, typ MachineConditionType) *MachineCondition {
+	idx := slices.IndexFunc(conditions, func(cond MachineCondition) bool {
+		return cond.Type == typ
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &conditions[idx]
+}
+
+// FindMachineHealthCheckCondition returns a pointer to the condition of the given type,
+// or nil if no condition of that type is present.
+func FindMachineHealthCheckCondition(conditions []MachineHealthCheckCondition, typ MachineHealthCheckConditionType) *MachineHealthCheckCondition {
+	idx := slices.IndexFunc(conditions, func(cond MachineHealthCheckCondition) bool {
+		return cond.Type == typ
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &conditions[idx]
+}
+
+// SetMachineHealthCheckCondition inserts or updates a condition of the given type in the
+// conditions slice. LastTransitionTime is set to now only when the condition is newly
+// inserted or its Status differs from the previous value.
+func SetMachineHealthCheckCondition(conditions []MachineHealthCheckCondition, cond MachineHealthCheckCondition) []MachineHealthCheckCondition {
+	idx := slices.IndexFunc(conditions, func(c MachineHealthCheckCondition) bool {
+		return c.Type == cond.Type
+	})
+
+	if idx < 0 || conditions[idx].Status != cond.Status {
+		cond.LastTransitionTime = metav1.Now()
+	} else {
+		cond.LastTransitionTime = conditions[idx].LastTransitionTime
+	}
+
+	if idx < 0 {
+		return append(conditions, cond)
+	}
+	conditions[idx] = cond
+	return conditions
+}
@@ -43,6 +43,28 @@ var _ = Describe("Conditions", func() {
 		),
 	)
 
+	DescribeTable("FindMachineCondition",
+		func(conds []computev1alpha1.MachineCondition, condType computev1alpha1.MachineConditionType, match types.GomegaMatcher) {
+			Expect(computev1alpha1.FindMachineCondition(conds, condType)).To(match)
+		},
+		Entry("returns the matching condition",
+			[]computev1alpha1.MachineCondition{
+				{Type: "Other", Status: corev1.ConditionTrue},
+				{Type: computev1alpha1.MachineResizing, Status: corev1.ConditionTrue, Reason: "X"},
+			},
+			computev1alpha1.MachineResizing,
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(computev1alpha1.MachineResizing),
+				"Reason": Equal("X"),
+			})),
+		),
+		Entry("returns nil when no condition of the given type is present",
+			[]computev1alpha1.MachineCondition{{Type: "Other"}},
+			computev1alpha1.MachineResizing,
+			BeNil(),
+		),
+	)
+
 	Describe("SetMachinePoolCondition", func() {
 		It("should append the condition when it is absent", func() {
 			out := computev1alpha1.SetMachinePoolCondition(nil, computev1alpha1.MachinePoolCondition{
@@ -97,4 +119,23 @@ var _ = Describe("Conditions", func() {
 			Expect(out[0].LastTransitionTime.Equal(&earlier)).To(BeFalse())
 		})
 	})
+
+	DescribeTable("RemoveMachinePoolCondition",
+		func(conds []computev1alpha1.MachinePoolCondition, condType computev1alpha1.MachinePoolConditionType, match types.GomegaMatcher) {
+			Expect(computev1alpha1.RemoveMachinePoolCondition(conds, condType)).To(match)
+		},
+		Entry("removes the matching condition",
+			[]computev1alpha1.MachinePoolCondition{
+				{Type: computev1alpha1.MachinePoolReady, Status: corev1.ConditionTrue},
+				{Type: computev1alpha1.MachinePoolDrained, Status: corev1.ConditionFalse},
+			},
+			computev1alpha1.MachinePoolDrained,
+			ConsistOf(HaveField("Type", computev1alpha1.MachinePoolReady)),
+		),
+		Entry("keeps the conditions when no condition of the given type is present",
+			[]computev1alpha1.MachinePoolCondition{{Type: computev1alpha1.MachinePoolReady}},
+			computev1alpha1.MachinePoolDrained,
+			HaveLen(1),
+		),
+	)
 })
@@ -47,6 +47,28 @@ type MachineSpec struct {
 	// GuestConfig contains guest OS level configuration for the machine.
 	// +optional
 	GuestConfig *MachineGuestConfig `json:"guestConfig,omitempty"`
+	// Restart requests a restart of the machine.
+	// Every time Restart.RequestedAt changes, the machine is restarted.
+	// +optional
+	Restart *MachineRestart `json:"restart,omitempty"`
+	// IOFreeze requests to freeze the I/O of the machine's volumes, e.g. to take consistent snapshots.
+	// The I/O is thawed once IOFreeze is unset or its timeout expired.
+	// +optional
+	IOFreeze *MachineIOFreeze `json:"ioFreeze,omitempty"`
+	// TopologySpreadConstraints describes how machines matching a label selector should be spread
+	// across topology domains, e.g. zones. All constraints have to be satisfied to schedule the machine.
+	// +optional
+	TopologySpreadConstraints []TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// Affinity are the affinity scheduling rules of the machine.
+	// +optional
+	Affinity *Affinity `json:"affinity,omitempty"`
+	// PriorityClassName is the name of the MachinePriorityClass of the machine.
+	// If empty, the MachinePriorityClass marked as global default is used, if any.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// Priority is the priority of the machine. It is resolved from the PriorityClassName on creation.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
 }
 
 // Power is the desired power state of a Machine.
@@ -57,8 +79,129 @@ const (
 	PowerOn Power = "On"
 	// PowerOff indicates that a Machine should be powered off.
 	PowerOff Power = "Off"
+	// PowerSuspended indicates that a Machine should be suspended, i.e. paused while retaining its memory.
+	PowerSuspended Power = "Suspended"
+)
+
+// MachineRestart is a request to restart a Machine.
+type MachineRestart struct {
+	// RequestedAt is the time the restart was requested at.
+	RequestedAt metav1.Time `json:"requestedAt"`
+	// Mode is the mode to restart the machine with.
+	// Defaults to RestartModeSoft.
+	// +optional
+	Mode RestartMode `json:"mode,omitempty"`
+}
+
+// RestartMode is the mode a Machine is restarted with.
+type RestartMode string
+
+const (
+	// RestartModeSoft gracefully restarts a Machine via its guest OS.
+	RestartModeSoft RestartMode = "Soft"
+	// RestartModeHard immediately resets a Machine without involving its guest OS.
+	RestartModeHard RestartMode = "Hard"
+)
+
+// MachineIOFreeze is a request to freeze the I/O of a Machine.
+type MachineIOFreeze struct {
+	// RequestedAt is the time the freeze was requested at.
+	RequestedAt metav1.Time `json:"requestedAt"`
+	// Timeout is the duration after RequestedAt after which the I/O is thawed again.
+	Timeout metav1.Duration `json:"timeout"`
+}
+
+// TopologySpreadConstraint specifies how to spread matching machines among the given topology.
+type TopologySpreadConstraint struct {
+	// MaxSkew is the maximum permitted difference between the number of matching machines
+	// in any two topology domains. It must be greater than zero.
+	MaxSkew int32 `json:"maxSkew"`
+	// TopologyKey is the key of machine pool labels. Machine pools with the same value of this label
+	// are considered to be in the same topology domain, e.g. topology.ironcore.dev/zone.
+	TopologyKey string `json:"topologyKey"`
+	// WhenUnsatisfiable indicates how to deal with a machine if it doesn't satisfy the spread constraint.
+	// Defaults to DoNotSchedule.
+	// +optional
+	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+	// LabelSelector is used to find the machines in the namespace of the machine that are counted
+	// to determine the number of machines in their topology domain.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// UnsatisfiableConstraintAction is the action to take if a TopologySpreadConstraint is not satisfied.
+type UnsatisfiableConstraintAction string
+
+const (
+	// DoNotSchedule instructs the scheduler not to schedule the machine when the constraint is not satisfied.
+	DoNotSchedule UnsatisfiableConstraintAction = "DoNotSchedule"
+	// ScheduleAnyway instructs the scheduler to schedule the machine even if the constraint is not satisfied,
+	// preferring topology domains that reduce the skew.
+	ScheduleAnyway UnsatisfiableConstraintAction = "ScheduleAnyway"
 )
 
+// MachinePoolTopologyKey is a topology key that puts every MachinePool into its own topology domain,
+// identified by the name of the MachinePool.
+const MachinePoolTopologyKey = "compute.ironcore.dev/machinepool"
+
+// Affinity is a group of affinity scheduling rules of a Machine.
+type Affinity struct {
+	// MachineAffinity describes rules to co-locate the machine with other machines.
+	// +optional
+	MachineAffinity *MachineAffinity `json:"machineAffinity,omitempty"`
+	// MachineAntiAffinity describes rules to keep the machine apart from other machines.
+	// +optional
+	MachineAntiAffinity *MachineAntiAffinity `json:"machineAntiAffinity,omitempty"`
+}
+
+// MachineAffinity is a group of machine affinity scheduling rules.
+type MachineAffinity struct {
+	// RequiredDuringSchedulingIgnoredDuringExecution are the terms that have to be satisfied
+	// to schedule the machine onto a machine pool. All terms have to be satisfied.
+	// +optional
+	RequiredDuringSchedulingIgnoredDuringExecution []MachineAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// PreferredDuringSchedulingIgnoredDuringExecution are the terms the scheduler prefers to satisfy.
+	// Machine pools satisfying terms with a higher sum of weights are preferred.
+	// +optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedMachineAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// MachineAntiAffinity is a group of machine anti-affinity scheduling rules.
+type MachineAntiAffinity struct {
+	// RequiredDuringSchedulingIgnoredDuringExecution are the terms that have to be satisfied
+	// to schedule the machine onto a machine pool. All terms have to be satisfied.
+	// +optional
+	RequiredDuringSchedulingIgnoredDuringExecution []MachineAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// PreferredDuringSchedulingIgnoredDuringExecution are the terms the scheduler prefers to satisfy.
+	// Machine pools satisfying terms with a higher sum of weights are preferred.
+	// +optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedMachineAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// MachineAffinityTerm selects a set of machines the machine should be co-located with (affinity)
+// or not co-located with (anti-affinity). Co-located is defined as running on a machine pool whose
+// value of the label with key TopologyKey matches that of any machine pool a selected machine runs on.
+type MachineAffinityTerm struct {
+	// LabelSelector selects the machines the term applies to.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Namespaces are the namespaces the LabelSelector applies to.
+	// If empty, the namespace of the machine is used.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// TopologyKey is the key of machine pool labels defining the topology domains.
+	// MachinePoolTopologyKey puts every machine pool into its own topology domain.
+	TopologyKey string `json:"topologyKey"`
+}
+
+// WeightedMachineAffinityTerm is a MachineAffinityTerm with a weight.
+type WeightedMachineAffinityTerm struct {
+	// Weight of the term, in the range 1-100.
+	Weight int32 `json:"weight"`
+	// MachineAffinityTerm is the affinity term.
+	MachineAffinityTerm MachineAffinityTerm `json:"machineAffinityTerm"`
+}
+
 // EFIVar is a variable to pass to EFI while booting up.
 type EFIVar struct {
 	// Name is the name of the EFIVar.
@@ -186,6 +329,13 @@ const (
 // MachineConditionType is a type a MachineCondition can have.
 type MachineConditionType string
 
+const (
+	// MachineResizing reports whether the machine is being resized to its MachineClassRef.
+	MachineResizing MachineConditionType = "Resizing"
+	// MachineIOFrozen reports whether the I/O of the machine is frozen as requested by its IOFreeze.
+	MachineIOFrozen MachineConditionType = "IOFrozen"
+)
+
 // MachineCondition is one of the conditions of a machine.
 type MachineCondition struct {
 	// Type is the type of the condition.
@@ -210,6 +360,8 @@ type MachineStatus struct {
 	Conditions []MachineCondition `json:"conditions,omitempty"`
 	// State is the infrastructure state of the machine.
 	State MachineState `json:"state,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned.
+	LastStateTransitionTime *metav1.Time `json:"lastStateTransitionTime,omitempty"`
 	// NetworkInterfaces is the list of network interface states for the machine.
 	NetworkInterfaces []NetworkInterfaceStatus `json:"networkInterfaces,omitempty"`
 	// Volumes is the list of volume states for the machine.
@@ -229,6 +381,8 @@ const (
 	MachineStateRunning MachineState = "Running"
 	// MachineStateShutdown means the machine is shut down.
 	MachineStateShutdown MachineState = "Shutdown"
+	// MachineStateSuspended means the machine is suspended.
+	MachineStateSuspended MachineState = "Suspended"
 	// MachineStateTerminated means the machine has been permanently stopped and cannot be started.
 	MachineStateTerminated MachineState = "Terminated"
 	// MachineStateTerminating means the machine that is terminating.
@@ -265,9 +419,112 @@ type MachineExecOptions struct {
 	InsecureSkipTLSVerifyBackend bool `json:"insecureSkipTLSVerifyBackend,omitempty"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:conversion-gen:explicit-from=net/url.Values
+
+// MachineConsoleLogOptions is the query options to a Machine's console log call
+type MachineConsoleLogOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Follow the console log stream of the machine.
+	// +optional
+	Follow bool `json:"follow,omitempty"`
+	// TailLines is the number of lines from the end of the console log to show.
+	// If not specified, the console log is shown from its beginning.
+	// +optional
+	TailLines *int64 `json:"tailLines,omitempty"`
+	// SinceTime is an RFC3339 timestamp from which to show the console log.
+	// If not specified, the console log is shown from its beginning.
+	// +optional
+	SinceTime *metav1.Time `json:"sinceTime,omitempty"`
+	// InsecureSkipTLSVerifyBackend skips verifying the serving certificate of the machine pool.
+	// +optional
+	InsecureSkipTLSVerifyBackend bool `json:"insecureSkipTLSVerifyBackend,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineStats is the resource usage of a Machine as reported by its machine pool.
+type MachineStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Timestamp is the time the stats were collected at.
+	Timestamp metav1.Time `json:"timestamp"`
+	// CPU is the CPU usage of the machine.
+	CPU *MachineCPUStats `json:"cpu,omitempty"`
+	// Memory is the memory usage of the machine.
+	Memory *MachineMemoryStats `json:"memory,omitempty"`
+	// Volumes are the counters of the volumes attached to the machine.
+	Volumes []MachineVolumeStats `json:"volumes,omitempty"`
+	// NetworkInterfaces are the counters of the network interfaces attached to the machine.
+	NetworkInterfaces []MachineNetworkInterfaceStats `json:"networkInterfaces,omitempty"`
+}
+
+// MachineCPUStats is the CPU usage of a machine.
+type MachineCPUStats struct {
+	// UsageCoreNanoSeconds is the cumulative CPU time consumed by the machine in core-nanoseconds.
+	UsageCoreNanoSeconds int64 `json:"usageCoreNanoSeconds"`
+}
+
+// MachineMemoryStats is the memory usage of a machine.
+type MachineMemoryStats struct {
+	// UsageBytes is the memory in use by the machine in bytes.
+	UsageBytes int64 `json:"usageBytes"`
+	// AvailableBytes is the memory available to the machine in bytes.
+	AvailableBytes int64 `json:"availableBytes"`
+}
+
+// MachineVolumeStats are the counters of a volume attached to a machine.
+type MachineVolumeStats struct {
+	// Name is the name of the volume in the machine spec.
+	Name string `json:"name"`
+	// ReadBytes is the cumulative number of bytes read from the volume.
+	ReadBytes int64 `json:"readBytes"`
+	// WriteBytes is the cumulative number of bytes written to the volume.
+	WriteBytes int64 `json:"writeBytes"`
+	// ReadOps is the cumulative number of read operations on the volume.
+	ReadOps int64 `json:"readOps"`
+	// WriteOps is the cumulative number of write operations on the volume.
+	WriteOps int64 `json:"writeOps"`
+}
+
+// MachineNetworkInterfaceStats are the counters of a network interface attached to a machine.
+type MachineNetworkInterfaceStats struct {
+	// Name is the name of the network interface in the machine spec.
+	Name string `json:"name"`
+	// RxBytes is the cumulative number of bytes received.
+	RxBytes int64 `json:"rxBytes"`
+	// TxBytes is the cumulative number of bytes transmitted.
+	TxBytes int64 `json:"txBytes"`
+	// RxPackets is the cumulative number of packets received.
+	RxPackets int64 `json:"rxPackets"`
+	// TxPackets is the cumulative number of packets transmitted.
+	TxPackets int64 `json:"txPackets"`
+}
+
+// DefaultSSHPublicKeyKey is the default key for MachineGuestConfig.SSHPublicKeyRefs.
+const DefaultSSHPublicKeyKey = "ssh-publickey"
+
+// DefaultUserDataKey is the default key for MachineGuestConfig.UserDataRef.
+const DefaultUserDataKey = "user-data"
+
 // MachineGuestConfig contains guest OS level configuration for the machine.
 type MachineGuestConfig struct {
 	// Hostname is the desired hostname of the machine.
 	// +optional
 	Hostname string `json:"hostname,omitempty"`
+	// SSHPublicKeyRefs are references to secrets containing SSH public keys authorized to log into the machine.
+	// If key is empty, DefaultSSHPublicKeyKey will be used as fallback.
+	// +optional
+	SSHPublicKeyRefs []commonv1alpha1.SecretKeySelector `json:"sshPublicKeyRefs,omitempty"`
+	// Timezone is the IANA time zone of the machine, e.g. Europe/Berlin.
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+	// NTPServers are the NTP servers the machine synchronizes its clock with.
+	// +optional
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// UserDataRef is a reference to a secret containing cloud-init user data for the machine.
+	// Mutually exclusive with MachineSpec.IgnitionRef.
+	// If key is empty, DefaultUserDataKey will be used as fallback.
+	// +optional
+	UserDataRef *commonv1alpha1.SecretKeySelector `json:"userDataRef,omitempty"`
 }
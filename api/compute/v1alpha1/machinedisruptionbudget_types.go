@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// MachineDisruptionBudgetSpec defines the desired state of MachineDisruptionBudget
+type MachineDisruptionBudgetSpec struct {
+	// Selector selects the machines the budget applies to.
+	Selector *metav1.LabelSelector `json:"selector"`
+	// MinAvailable is the number or percentage of selected machines that have to remain available
+	// after an eviction. Mutually exclusive with MaxUnavailable.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of selected machines that may be unavailable
+	// after an eviction. Mutually exclusive with MinAvailable.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// MachineDisruptionBudgetStatus defines the observed state of MachineDisruptionBudget
+type MachineDisruptionBudgetStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MachineDisruptionBudget.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// DisruptedMachines contains the machines whose eviction was accepted but that have not yet been
+	// observed as deleted, mapped to the time of their eviction.
+	DisruptedMachines map[string]metav1.Time `json:"disruptedMachines,omitempty"`
+	// DisruptionsAllowed is the number of machine disruptions that are currently allowed.
+	DisruptionsAllowed int32 `json:"disruptionsAllowed"`
+	// CurrentHealthy is the number of currently healthy, i.e. running, selected machines.
+	CurrentHealthy int32 `json:"currentHealthy"`
+	// DesiredHealthy is the minimum desired number of healthy selected machines.
+	DesiredHealthy int32 `json:"desiredHealthy"`
+	// ExpectedMachines is the total number of selected machines.
+	ExpectedMachines int32 `json:"expectedMachines"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineDisruptionBudget limits the number of machines of a replicated application that are
+// down simultaneously due to voluntary disruptions, i.e. evictions.
+type MachineDisruptionBudget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MachineDisruptionBudgetSpec   `json:"spec,omitempty"`
+	Status MachineDisruptionBudgetStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineDisruptionBudgetList contains a list of MachineDisruptionBudget
+type MachineDisruptionBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachineDisruptionBudget `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineEviction evicts a machine while honoring the MachineDisruptionBudgets selecting it.
+// It is created via the eviction subresource of a machine.
+type MachineEviction struct {
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta describes the machine that is being evicted.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// DeleteOptions may be provided for the deletion of the machine.
+	DeleteOptions *metav1.DeleteOptions `json:"deleteOptions,omitempty"`
+}
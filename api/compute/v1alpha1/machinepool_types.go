@@ -18,6 +18,20 @@ type MachinePoolSpec struct {
 	// Taints of the MachinePool. Only Machines who tolerate all the taints
 	// will land in the MachinePool.
 	Taints []commonv1alpha1.Taint `json:"taints,omitempty"`
+	// Unschedulable marks the MachinePool as unschedulable. No new Machines are scheduled onto an
+	// unschedulable MachinePool, while the Machines already running on it are not affected.
+	Unschedulable bool `json:"unschedulable,omitempty"`
+	// Drain requests all Machines to be evicted from the MachinePool. A draining MachinePool is unschedulable.
+	Drain *MachinePoolDrain `json:"drain,omitempty"`
+}
+
+// MachinePoolDrain is a request to evict all Machines from a MachinePool.
+type MachinePoolDrain struct {
+	// RequestedAt is the time the drain was requested. Changing it restarts the drain.
+	RequestedAt metav1.Time `json:"requestedAt"`
+	// EvictionTimeout is the time to wait for an evicted Machine to be gone before
+	// evicting the next Machine. Defaults to 5 minutes.
+	EvictionTimeout *metav1.Duration `json:"evictionTimeout,omitempty"`
 }
 
 // MachinePoolStatus defines the observed state of MachinePool
@@ -31,6 +45,33 @@ type MachinePoolStatus struct {
 	Capacity corev1alpha1.ResourceList `json:"capacity,omitempty"`
 	// Allocatable represents the resources of a machine pool that are available for scheduling.
 	Allocatable corev1alpha1.ResourceList `json:"allocatable,omitempty"`
+	// Drain reports the progress of the requested drain of the MachinePool.
+	Drain *MachinePoolDrainStatus `json:"drain,omitempty"`
+}
+
+// MachinePoolDrainState is the state of a MachinePool drain.
+// +enum
+type MachinePoolDrainState string
+
+const (
+	// MachinePoolDrainStateDraining means Machines are being evicted from the MachinePool.
+	MachinePoolDrainStateDraining MachinePoolDrainState = "Draining"
+	// MachinePoolDrainStateDrained means all Machines have been evicted from the MachinePool.
+	MachinePoolDrainStateDrained MachinePoolDrainState = "Drained"
+)
+
+// MachinePoolDrainStatus reports the progress of a MachinePool drain.
+type MachinePoolDrainStatus struct {
+	// State is the state of the drain.
+	State MachinePoolDrainState `json:"state,omitempty"`
+	// ObservedRequestedAt is the requestedAt time of the drain this status refers to.
+	ObservedRequestedAt metav1.Time `json:"observedRequestedAt,omitempty"`
+	// StartTime is the time the drain was started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time all Machines had been evicted.
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// RemainingMachines is the number of Machines still bound to the MachinePool.
+	RemainingMachines int32 `json:"remainingMachines"`
 }
 
 // MachinePoolDaemonEndpoints lists ports opened by daemons running on the MachinePool.
@@ -88,6 +129,12 @@ type MachinePoolConditionType string
 const (
 	// MachinePoolReady means the machine pool is healthy and ready to accept machines.
 	MachinePoolReady MachinePoolConditionType = "Ready"
+	// MachinePoolDrained reports whether a requested drain of the machine pool has completed.
+	// A False status with reason EvictionBlocked or EvictionTimeout indicates a stuck drain.
+	MachinePoolDrained MachinePoolConditionType = "Drained"
+	// MachinePoolRuntimeCapabilities reports whether the capabilities of the machine runtime are known.
+	// The message lists the capabilities.
+	MachinePoolRuntimeCapabilities MachinePoolConditionType = "RuntimeCapabilities"
 )
 
 // MachinePoolCondition is one of the conditions of a MachinePool.
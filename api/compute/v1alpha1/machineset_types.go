@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineTemplateSpec is the specification of a Machine template.
+type MachineTemplateSpec struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MachineSpec `json:"spec,omitempty"`
+}
+
+// MachineSetSpec defines the desired state of MachineSet
+type MachineSetSpec struct {
+	// Replicas is the number of desired machines. Defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Selector is a label query over machines that should match the replica count.
+	// It must match the labels of the template.
+	Selector *metav1.LabelSelector `json:"selector"`
+	// Template is the template of the machines created by the MachineSet.
+	Template MachineTemplateSpec `json:"template"`
+}
+
+// MachineSetStatus defines the observed state of MachineSet
+type MachineSetStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MachineSet.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Replicas is the number of machines currently owned by the MachineSet.
+	Replicas int32 `json:"replicas"`
+	// ReadyReplicas is the number of running machines owned by the MachineSet.
+	ReadyReplicas int32 `json:"readyReplicas"`
+}
+
+// +genclient
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineSet ensures that a specified number of machines created from a template are running.
+type MachineSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MachineSetSpec   `json:"spec,omitempty"`
+	Status MachineSetStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineSetList contains a list of MachineSet
+type MachineSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachineSet `json:"items"`
+}
@@ -29,12 +29,25 @@ func Resource(resource string) schema.GroupResource {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Machine{},
+		&MachineConsoleLogOptions{},
+		&MachineStats{},
 		&MachineExecOptions{},
 		&MachineList{},
 		&MachineClass{},
 		&MachineClassList{},
 		&MachinePool{},
 		&MachinePoolList{},
+		&MachinePriorityClass{},
+		&MachinePriorityClassList{},
+		&MachineDisruptionBudget{},
+		&MachineDisruptionBudgetList{},
+		&MachineHealthCheck{},
+		&MachineHealthCheckList{},
+		&MachineSet{},
+		&MachineSetList{},
+		&MachineDeployment{},
+		&MachineDeploymentList{},
+		&MachineEviction{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -5,6 +5,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"time"
 )
 
 // MachineEphemeralNetworkInterfaceName returns the name of a NetworkInterface for an
@@ -72,5 +73,19 @@ func MachineSecretNames(machine *Machine) []string {
 		names = append(names, ignitionRef.Name)
 	}
 
+	if guestConfig := machine.Spec.GuestConfig; guestConfig != nil {
+		for _, sshPublicKeyRef := range guestConfig.SSHPublicKeyRefs {
+			names = append(names, sshPublicKeyRef.Name)
+		}
+		if userDataRef := guestConfig.UserDataRef; userDataRef != nil {
+			names = append(names, userDataRef.Name)
+		}
+	}
+
 	return names
 }
+
+// MachineIOFreezeDeadline returns the time the requested I/O freeze of a machine expires at.
+func MachineIOFreezeDeadline(ioFreeze *MachineIOFreeze) time.Time {
+	return ioFreeze.RequestedAt.Add(ioFreeze.Timeout.Duration)
+}
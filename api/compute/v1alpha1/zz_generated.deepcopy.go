@@ -13,10 +13,38 @@ import (
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Affinity) DeepCopyInto(out *Affinity) {
+	*out = *in
+	if in.MachineAffinity != nil {
+		in, out := &in.MachineAffinity, &out.MachineAffinity
+		*out = new(MachineAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineAntiAffinity != nil {
+		in, out := &in.MachineAntiAffinity, &out.MachineAntiAffinity
+		*out = new(MachineAntiAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Affinity.
+func (in *Affinity) DeepCopy() *Affinity {
+	if in == nil {
+		return nil
+	}
+	out := new(Affinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DaemonEndpoint) DeepCopyInto(out *DaemonEndpoint) {
 	*out = *in
@@ -161,6 +189,108 @@ func (in *Machine) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAffinity) DeepCopyInto(out *MachineAffinity) {
+	*out = *in
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]MachineAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedMachineAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAffinity.
+func (in *MachineAffinity) DeepCopy() *MachineAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAffinityTerm) DeepCopyInto(out *MachineAffinityTerm) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAffinityTerm.
+func (in *MachineAffinityTerm) DeepCopy() *MachineAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAntiAffinity) DeepCopyInto(out *MachineAntiAffinity) {
+	*out = *in
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]MachineAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedMachineAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAntiAffinity.
+func (in *MachineAntiAffinity) DeepCopy() *MachineAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCPUStats) DeepCopyInto(out *MachineCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCPUStats.
+func (in *MachineCPUStats) DeepCopy() *MachineCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineClass) DeepCopyInto(out *MachineClass) {
 	*out = *in
@@ -245,24 +375,33 @@ func (in *MachineCondition) DeepCopy() *MachineCondition {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineExecOptions) DeepCopyInto(out *MachineExecOptions) {
+func (in *MachineConsoleLogOptions) DeepCopyInto(out *MachineConsoleLogOptions) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.TailLines != nil {
+		in, out := &in.TailLines, &out.TailLines
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SinceTime != nil {
+		in, out := &in.SinceTime, &out.SinceTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineExecOptions.
-func (in *MachineExecOptions) DeepCopy() *MachineExecOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineConsoleLogOptions.
+func (in *MachineConsoleLogOptions) DeepCopy() *MachineConsoleLogOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineExecOptions)
+	out := new(MachineConsoleLogOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachineExecOptions) DeepCopyObject() runtime.Object {
+func (in *MachineConsoleLogOptions) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -270,29 +409,41 @@ func (in *MachineExecOptions) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineGuestConfig) DeepCopyInto(out *MachineGuestConfig) {
+func (in *MachineDeployment) DeepCopyInto(out *MachineDeployment) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGuestConfig.
-func (in *MachineGuestConfig) DeepCopy() *MachineGuestConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeployment.
+func (in *MachineDeployment) DeepCopy() *MachineDeployment {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineGuestConfig)
+	out := new(MachineDeployment)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineDeployment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineList) DeepCopyInto(out *MachineList) {
+func (in *MachineDeploymentList) DeepCopyInto(out *MachineDeploymentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Machine, len(*in))
+		*out = make([]MachineDeployment, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -300,18 +451,18 @@ func (in *MachineList) DeepCopyInto(out *MachineList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineList.
-func (in *MachineList) DeepCopy() *MachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentList.
+func (in *MachineDeploymentList) DeepCopy() *MachineDeploymentList {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineList)
+	out := new(MachineDeploymentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachineList) DeepCopyObject() runtime.Object {
+func (in *MachineDeploymentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -319,92 +470,111 @@ func (in *MachineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePool) DeepCopyInto(out *MachinePool) {
+func (in *MachineDeploymentSpec) DeepCopyInto(out *MachineDeploymentSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePool.
-func (in *MachinePool) DeepCopy() *MachinePool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentSpec.
+func (in *MachineDeploymentSpec) DeepCopy() *MachineDeploymentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePool)
+	out := new(MachineDeploymentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachinePool) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolAddress) DeepCopyInto(out *MachinePoolAddress) {
+func (in *MachineDeploymentStatus) DeepCopyInto(out *MachineDeploymentStatus) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolAddress.
-func (in *MachinePoolAddress) DeepCopy() *MachinePoolAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentStatus.
+func (in *MachineDeploymentStatus) DeepCopy() *MachineDeploymentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolAddress)
+	out := new(MachineDeploymentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolCondition) DeepCopyInto(out *MachinePoolCondition) {
+func (in *MachineDeploymentStrategy) DeepCopyInto(out *MachineDeploymentStrategy) {
 	*out = *in
-	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateMachineDeployment)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolCondition.
-func (in *MachinePoolCondition) DeepCopy() *MachinePoolCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentStrategy.
+func (in *MachineDeploymentStrategy) DeepCopy() *MachineDeploymentStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolCondition)
+	out := new(MachineDeploymentStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolDaemonEndpoints) DeepCopyInto(out *MachinePoolDaemonEndpoints) {
+func (in *MachineDisruptionBudget) DeepCopyInto(out *MachineDisruptionBudget) {
 	*out = *in
-	out.MachinepoolletEndpoint = in.MachinepoolletEndpoint
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolDaemonEndpoints.
-func (in *MachinePoolDaemonEndpoints) DeepCopy() *MachinePoolDaemonEndpoints {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDisruptionBudget.
+func (in *MachineDisruptionBudget) DeepCopy() *MachineDisruptionBudget {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolDaemonEndpoints)
+	out := new(MachineDisruptionBudget)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineDisruptionBudget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolList) DeepCopyInto(out *MachinePoolList) {
+func (in *MachineDisruptionBudgetList) DeepCopyInto(out *MachineDisruptionBudgetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MachinePool, len(*in))
+		*out = make([]MachineDisruptionBudget, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -412,18 +582,18 @@ func (in *MachinePoolList) DeepCopyInto(out *MachinePoolList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolList.
-func (in *MachinePoolList) DeepCopy() *MachinePoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDisruptionBudgetList.
+func (in *MachineDisruptionBudgetList) DeepCopy() *MachineDisruptionBudgetList {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolList)
+	out := new(MachineDisruptionBudgetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachinePoolList) DeepCopyObject() runtime.Object {
+func (in *MachineDisruptionBudgetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -431,107 +601,824 @@ func (in *MachinePoolList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
+func (in *MachineDisruptionBudgetSpec) DeepCopyInto(out *MachineDisruptionBudgetSpec) {
 	*out = *in
-	if in.Taints != nil {
-		in, out := &in.Taints, &out.Taints
-		*out = make([]commonv1alpha1.Taint, len(*in))
-		copy(*out, *in)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolSpec.
-func (in *MachinePoolSpec) DeepCopy() *MachinePoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDisruptionBudgetSpec.
+func (in *MachineDisruptionBudgetSpec) DeepCopy() *MachineDisruptionBudgetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolSpec)
+	out := new(MachineDisruptionBudgetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolStatus) DeepCopyInto(out *MachinePoolStatus) {
+func (in *MachineDisruptionBudgetStatus) DeepCopyInto(out *MachineDisruptionBudgetStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]MachinePoolCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.AvailableMachineClasses != nil {
-		in, out := &in.AvailableMachineClasses, &out.AvailableMachineClasses
-		*out = make([]v1.LocalObjectReference, len(*in))
-		copy(*out, *in)
-	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]MachinePoolAddress, len(*in))
-		copy(*out, *in)
-	}
-	out.DaemonEndpoints = in.DaemonEndpoints
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		*out = make(corev1alpha1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
-	if in.Allocatable != nil {
-		in, out := &in.Allocatable, &out.Allocatable
-		*out = make(corev1alpha1.ResourceList, len(*in))
+	if in.DisruptedMachines != nil {
+		in, out := &in.DisruptedMachines, &out.DisruptedMachines
+		*out = make(map[string]v1.Time, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolStatus.
-func (in *MachinePoolStatus) DeepCopy() *MachinePoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDisruptionBudgetStatus.
+func (in *MachineDisruptionBudgetStatus) DeepCopy() *MachineDisruptionBudgetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolStatus)
+	out := new(MachineDisruptionBudgetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
+func (in *MachineEviction) DeepCopyInto(out *MachineEviction) {
 	*out = *in
-	out.MachineClassRef = in.MachineClassRef
-	if in.MachinePoolSelector != nil {
-		in, out := &in.MachinePoolSelector, &out.MachinePoolSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.MachinePoolRef != nil {
-		in, out := &in.MachinePoolRef, &out.MachinePoolRef
-		*out = new(v1.LocalObjectReference)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.DeleteOptions != nil {
+		in, out := &in.DeleteOptions, &out.DeleteOptions
+		*out = new(v1.DeleteOptions)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]NetworkInterface, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineEviction.
+func (in *MachineEviction) DeepCopy() *MachineEviction {
+	if in == nil {
+		return nil
 	}
-	if in.Volumes != nil {
-		in, out := &in.Volumes, &out.Volumes
-		*out = make([]Volume, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(MachineEviction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineEviction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.IgnitionRef != nil {
-		in, out := &in.IgnitionRef, &out.IgnitionRef
-		*out = new(commonv1alpha1.SecretKeySelector)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineExecOptions) DeepCopyInto(out *MachineExecOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineExecOptions.
+func (in *MachineExecOptions) DeepCopy() *MachineExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineExecOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineGuestConfig) DeepCopyInto(out *MachineGuestConfig) {
+	*out = *in
+	if in.SSHPublicKeyRefs != nil {
+		in, out := &in.SSHPublicKeyRefs, &out.SSHPublicKeyRefs
+		*out = make([]commonv1alpha1.SecretKeySelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserDataRef != nil {
+		in, out := &in.UserDataRef, &out.UserDataRef
+		*out = new(commonv1alpha1.SecretKeySelector)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGuestConfig.
+func (in *MachineGuestConfig) DeepCopy() *MachineGuestConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineGuestConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheck) DeepCopyInto(out *MachineHealthCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheck.
+func (in *MachineHealthCheck) DeepCopy() *MachineHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineHealthCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckCondition) DeepCopyInto(out *MachineHealthCheckCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckCondition.
+func (in *MachineHealthCheckCondition) DeepCopy() *MachineHealthCheckCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckList) DeepCopyInto(out *MachineHealthCheckList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachineHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckList.
+func (in *MachineHealthCheckList) DeepCopy() *MachineHealthCheckList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineHealthCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckSpec) DeepCopyInto(out *MachineHealthCheckSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnhealthyStates != nil {
+		in, out := &in.UnhealthyStates, &out.UnhealthyStates
+		*out = make([]UnhealthyMachineState, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyConditions != nil {
+		in, out := &in.UnhealthyConditions, &out.UnhealthyConditions
+		*out = make([]UnhealthyMachineCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxUnhealthy != nil {
+		in, out := &in.MaxUnhealthy, &out.MaxUnhealthy
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckSpec.
+func (in *MachineHealthCheckSpec) DeepCopy() *MachineHealthCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckStatus) DeepCopyInto(out *MachineHealthCheckStatus) {
+	*out = *in
+	if in.UnhealthyMachines != nil {
+		in, out := &in.UnhealthyMachines, &out.UnhealthyMachines
+		*out = make([]UnhealthyMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]MachineHealthCheckCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckStatus.
+func (in *MachineHealthCheckStatus) DeepCopy() *MachineHealthCheckStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineIOFreeze) DeepCopyInto(out *MachineIOFreeze) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineIOFreeze.
+func (in *MachineIOFreeze) DeepCopy() *MachineIOFreeze {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineIOFreeze)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineList) DeepCopyInto(out *MachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Machine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineList.
+func (in *MachineList) DeepCopy() *MachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineMemoryStats) DeepCopyInto(out *MachineMemoryStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineMemoryStats.
+func (in *MachineMemoryStats) DeepCopy() *MachineMemoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineMemoryStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNetworkInterfaceStats) DeepCopyInto(out *MachineNetworkInterfaceStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNetworkInterfaceStats.
+func (in *MachineNetworkInterfaceStats) DeepCopy() *MachineNetworkInterfaceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNetworkInterfaceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePool) DeepCopyInto(out *MachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePool.
+func (in *MachinePool) DeepCopy() *MachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolAddress) DeepCopyInto(out *MachinePoolAddress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolAddress.
+func (in *MachinePoolAddress) DeepCopy() *MachinePoolAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolCondition) DeepCopyInto(out *MachinePoolCondition) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolCondition.
+func (in *MachinePoolCondition) DeepCopy() *MachinePoolCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolDaemonEndpoints) DeepCopyInto(out *MachinePoolDaemonEndpoints) {
+	*out = *in
+	out.MachinepoolletEndpoint = in.MachinepoolletEndpoint
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolDaemonEndpoints.
+func (in *MachinePoolDaemonEndpoints) DeepCopy() *MachinePoolDaemonEndpoints {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolDaemonEndpoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolDrain) DeepCopyInto(out *MachinePoolDrain) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	if in.EvictionTimeout != nil {
+		in, out := &in.EvictionTimeout, &out.EvictionTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolDrain.
+func (in *MachinePoolDrain) DeepCopy() *MachinePoolDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolDrainStatus) DeepCopyInto(out *MachinePoolDrainStatus) {
+	*out = *in
+	in.ObservedRequestedAt.DeepCopyInto(&out.ObservedRequestedAt)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolDrainStatus.
+func (in *MachinePoolDrainStatus) DeepCopy() *MachinePoolDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolList) DeepCopyInto(out *MachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolList.
+func (in *MachinePoolList) DeepCopy() *MachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
+	*out = *in
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]commonv1alpha1.Taint, len(*in))
+		copy(*out, *in)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(MachinePoolDrain)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolSpec.
+func (in *MachinePoolSpec) DeepCopy() *MachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolStatus) DeepCopyInto(out *MachinePoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]MachinePoolCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AvailableMachineClasses != nil {
+		in, out := &in.AvailableMachineClasses, &out.AvailableMachineClasses
+		*out = make([]corev1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]MachinePoolAddress, len(*in))
+		copy(*out, *in)
+	}
+	out.DaemonEndpoints = in.DaemonEndpoints
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1alpha1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(corev1alpha1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(MachinePoolDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolStatus.
+func (in *MachinePoolStatus) DeepCopy() *MachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePriorityClass) DeepCopyInto(out *MachinePriorityClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePriorityClass.
+func (in *MachinePriorityClass) DeepCopy() *MachinePriorityClass {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePriorityClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachinePriorityClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePriorityClassList) DeepCopyInto(out *MachinePriorityClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachinePriorityClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePriorityClassList.
+func (in *MachinePriorityClassList) DeepCopy() *MachinePriorityClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePriorityClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachinePriorityClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRestart) DeepCopyInto(out *MachineRestart) {
+	*out = *in
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRestart.
+func (in *MachineRestart) DeepCopy() *MachineRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSet) DeepCopyInto(out *MachineSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSet.
+func (in *MachineSet) DeepCopy() *MachineSet {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetList) DeepCopyInto(out *MachineSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachineSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetList.
+func (in *MachineSetList) DeepCopy() *MachineSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetSpec) DeepCopyInto(out *MachineSetSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetSpec.
+func (in *MachineSetSpec) DeepCopy() *MachineSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetStatus) DeepCopyInto(out *MachineSetStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetStatus.
+func (in *MachineSetStatus) DeepCopy() *MachineSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
+	*out = *in
+	out.MachineClassRef = in.MachineClassRef
+	if in.MachinePoolSelector != nil {
+		in, out := &in.MachinePoolSelector, &out.MachinePoolSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MachinePoolRef != nil {
+		in, out := &in.MachinePoolRef, &out.MachinePoolRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnitionRef != nil {
+		in, out := &in.IgnitionRef, &out.IgnitionRef
+		*out = new(commonv1alpha1.SecretKeySelector)
 		**out = **in
 	}
 	if in.EFIVars != nil {
@@ -547,6 +1434,33 @@ func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
 	if in.GuestConfig != nil {
 		in, out := &in.GuestConfig, &out.GuestConfig
 		*out = new(MachineGuestConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Restart != nil {
+		in, out := &in.Restart, &out.Restart
+		*out = new(MachineRestart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOFreeze != nil {
+		in, out := &in.IOFreeze, &out.IOFreeze
+		*out = new(MachineIOFreeze)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
 		**out = **in
 	}
 	return
@@ -562,6 +1476,53 @@ func (in *MachineSpec) DeepCopy() *MachineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineStats) DeepCopyInto(out *MachineStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(MachineCPUStats)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MachineMemoryStats)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]MachineVolumeStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]MachineNetworkInterfaceStats, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineStats.
+func (in *MachineStats) DeepCopy() *MachineStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 	*out = *in
@@ -572,6 +1533,10 @@ func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastStateTransitionTime != nil {
+		in, out := &in.LastStateTransitionTime, &out.LastStateTransitionTime
+		*out = (*in).DeepCopy()
+	}
 	if in.NetworkInterfaces != nil {
 		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
 		*out = make([]NetworkInterfaceStatus, len(*in))
@@ -599,6 +1564,40 @@ func (in *MachineStatus) DeepCopy() *MachineStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTemplateSpec) DeepCopyInto(out *MachineTemplateSpec) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTemplateSpec.
+func (in *MachineTemplateSpec) DeepCopy() *MachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineVolumeStats) DeepCopyInto(out *MachineVolumeStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineVolumeStats.
+func (in *MachineVolumeStats) DeepCopy() *MachineVolumeStats {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineVolumeStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 	*out = *in
@@ -621,7 +1620,7 @@ func (in *NetworkInterfaceSource) DeepCopyInto(out *NetworkInterfaceSource) {
 	*out = *in
 	if in.NetworkInterfaceRef != nil {
 		in, out := &in.NetworkInterfaceRef, &out.NetworkInterfaceRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.Ephemeral != nil {
@@ -663,6 +1662,107 @@ func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdateMachineDeployment) DeepCopyInto(out *RollingUpdateMachineDeployment) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateMachineDeployment.
+func (in *RollingUpdateMachineDeployment) DeepCopy() *RollingUpdateMachineDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdateMachineDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySpreadConstraint) DeepCopyInto(out *TopologySpreadConstraint) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpreadConstraint.
+func (in *TopologySpreadConstraint) DeepCopy() *TopologySpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyMachine) DeepCopyInto(out *UnhealthyMachine) {
+	*out = *in
+	if in.LastRemediationTime != nil {
+		in, out := &in.LastRemediationTime, &out.LastRemediationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyMachine.
+func (in *UnhealthyMachine) DeepCopy() *UnhealthyMachine {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyMachine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyMachineCondition) DeepCopyInto(out *UnhealthyMachineCondition) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyMachineCondition.
+func (in *UnhealthyMachineCondition) DeepCopy() *UnhealthyMachineCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyMachineCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyMachineState) DeepCopyInto(out *UnhealthyMachineState) {
+	*out = *in
+	out.Timeout = in.Timeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyMachineState.
+func (in *UnhealthyMachineState) DeepCopy() *UnhealthyMachineState {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyMachineState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
@@ -690,7 +1790,7 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 	*out = *in
 	if in.VolumeRef != nil {
 		in, out := &in.VolumeRef, &out.VolumeRef
-		*out = new(v1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.EmptyDisk != nil {
@@ -741,3 +1841,20 @@ func (in *VolumeStatus) DeepCopy() *VolumeStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedMachineAffinityTerm) DeepCopyInto(out *WeightedMachineAffinityTerm) {
+	*out = *in
+	in.MachineAffinityTerm.DeepCopyInto(&out.MachineAffinityTerm)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedMachineAffinityTerm.
+func (in *WeightedMachineAffinityTerm) DeepCopy() *WeightedMachineAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedMachineAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -8,6 +8,11 @@
 
 package v1alpha1
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in Affinity) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.Affinity"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in DaemonEndpoint) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.DaemonEndpoint"
@@ -43,6 +48,26 @@ func (in Machine) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.Machine"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineAffinity) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineAffinity"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineAffinityTerm) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineAffinityTerm"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineAntiAffinity) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineAntiAffinity"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineCPUStats) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineCPUStats"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachineClass) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineClass"
@@ -58,6 +83,61 @@ func (in MachineCondition) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineCondition"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineConsoleLogOptions) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineConsoleLogOptions"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDeployment) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDeployment"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDeploymentList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDeploymentList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDeploymentSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDeploymentSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDeploymentStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDeploymentStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDeploymentStrategy) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDeploymentStrategy"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDisruptionBudget) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDisruptionBudget"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDisruptionBudgetList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDisruptionBudgetList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDisruptionBudgetSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDisruptionBudgetSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineDisruptionBudgetStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDisruptionBudgetStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineEviction) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineEviction"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachineExecOptions) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineExecOptions"
@@ -68,11 +148,51 @@ func (in MachineGuestConfig) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineGuestConfig"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineHealthCheck) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineHealthCheck"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineHealthCheckCondition) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineHealthCheckCondition"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineHealthCheckList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineHealthCheckList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineHealthCheckSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineHealthCheckSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineHealthCheckStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineHealthCheckStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineIOFreeze) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineIOFreeze"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachineList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineMemoryStats) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineMemoryStats"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineNetworkInterfaceStats) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineNetworkInterfaceStats"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachinePool) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePool"
@@ -93,6 +213,16 @@ func (in MachinePoolDaemonEndpoints) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePoolDaemonEndpoints"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachinePoolDrain) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePoolDrain"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachinePoolDrainStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePoolDrainStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachinePoolList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePoolList"
@@ -108,16 +238,66 @@ func (in MachinePoolStatus) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePoolStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachinePriorityClass) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePriorityClass"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachinePriorityClassList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachinePriorityClassList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineRestart) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineRestart"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineSet) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineSet"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineSetList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineSetList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineSetSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineSetSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineSetStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineSetStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachineSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineSpec"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineStats) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineStats"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in MachineStatus) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineTemplateSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineTemplateSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in MachineVolumeStats) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineVolumeStats"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in NetworkInterface) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.NetworkInterface"
@@ -133,6 +313,31 @@ func (in NetworkInterfaceStatus) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.NetworkInterfaceStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in RollingUpdateMachineDeployment) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.RollingUpdateMachineDeployment"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in TopologySpreadConstraint) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.TopologySpreadConstraint"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in UnhealthyMachine) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.UnhealthyMachine"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in UnhealthyMachineCondition) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.UnhealthyMachineCondition"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in UnhealthyMachineState) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.UnhealthyMachineState"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in Volume) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.Volume"
@@ -147,3 +352,8 @@ func (in VolumeSource) OpenAPIModelName() string {
 func (in VolumeStatus) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.VolumeStatus"
 }
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in WeightedMachineAffinityTerm) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.compute.v1alpha1.WeightedMachineAffinityTerm"
+}
@@ -9,6 +9,8 @@ const (
 	VolumeVolumePoolRefNameField     = "spec.volumePoolRef.name"
 	VolumeVolumeClassRefNameField    = "spec.volumeClassRef.name"
 	VolumeVolumeSnapshotRefNameField = "spec.volumeSnapshotRef.name"
+	VolumeVolumeRefNameField         = "spec.dataSource.volumeRef.name"
+	VolumeVolumeBackupRefNameField   = "spec.dataSource.volumeBackupRef.name"
 
 	BucketBucketPoolRefNameField  = "spec.bucketPoolRef.name"
 	BucketBucketClassRefNameField = "spec.bucketClassRef.name"
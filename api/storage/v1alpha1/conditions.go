@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FindVolumePoolCondition returns a pointer to the condition of the given type,
+// or nil if no condition of that type is present.
+func FindVolumePoolCondition(conditions []VolumePoolCondition, typ VolumePoolConditionType) *VolumePoolCondition {
+	idx := slices.IndexFunc(conditions, func(cond VolumePoolCondition) bool {
+		return cond.Type == typ
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &conditions[idx]
+}
+
+// SetVolumePoolCondition inserts or updates a condition of the given type in the
+// conditions slice. LastTransitionTime is set to now only when the condition is newly
+// inserted or its Status differs from the previous value.
+func SetVolumePoolCondition(conditions []VolumePoolCondition, cond VolumePoolCondition) []VolumePoolCondition {
+	idx := slices.IndexFunc(conditions, func(c VolumePoolCondition) bool {
+		return c.Type == cond.Type
+	})
+
+	if idx < 0 || conditions[idx].Status != cond.Status {
+		cond.LastTransitionTime = metav1.Now()
+	} else {
+		cond.LastTransitionTime = conditions[idx].LastTransitionTime
+	}
+
+	if idx < 0 {
+		return append(conditions, cond)
+	}
+	conditions[idx] = cond
+	return conditions
+}
+
+// FindBucketPoolCondition returns a pointer to the condition of the given type,
+// or nil if no condition of that type is present.
+func FindBucketPoolCondition(conditions []BucketPoolCondition, typ BucketPoolConditionType) *BucketPoolCondition {
+	idx := slices.IndexFunc(conditions, func(cond BucketPoolCondition) bool {
+		return cond.Type == typ
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &conditions[idx]
+}
+
+// SetBucketPoolCondition inserts or updates a condition of the given type in the
+// conditions slice. LastTransitionTime is set to now only when the condition is newly
+// inserted or its Status differs from the previous value.
+func SetBucketPoolCondition(conditions []BucketPoolCondition, cond BucketPoolCondition) []BucketPoolCondition {
+	idx := slices.IndexFunc(conditions, func(c BucketPoolCondition) bool {
+		return c.Type == cond.Type
+	})
+
+	if idx < 0 || conditions[idx].Status != cond.Status {
+		cond.LastTransitionTime = metav1.Now()
+	} else {
+		cond.LastTransitionTime = conditions[idx].LastTransitionTime
+	}
+
+	if idx < 0 {
+		return append(conditions, cond)
+	}
+	conditions[idx] = cond
+	return conditions
+}
+
+// FindVolumeCondition returns a pointer to the condition of the given type,
+// or nil if no condition of that type is present.
+func FindVolumeCondition(conditions []VolumeCondition, typ VolumeConditionType) *VolumeCondition {
+	idx := slices.IndexFunc(conditions, func(cond VolumeCondition) bool {
+		return cond.Type == typ
+	})
+	if idx < 0 {
+		return nil
+	}
+	return &conditions[idx]
+}
+
+// SetVolumeCondition inserts or updates a condition of the given type in the
+// conditions slice. LastTransitionTime is set to now only when the condition is newly
+// inserted or its Status differs from the previous value.
+func SetVolumeCondition(conditions []VolumeCondition, cond VolumeCondition) []VolumeCondition {
+	idx := slices.IndexFunc(conditions, func(c VolumeCondition) bool {
+		return c.Type == cond.Type
+	})
+
+	if idx < 0 || conditions[idx].Status != cond.Status {
+		cond.LastTransitionTime = metav1.Now()
+	} else {
+		cond.LastTransitionTime = conditions[idx].LastTransitionTime
+	}
+
+	if idx < 0 {
+		return append(conditions, cond)
+	}
+	conditions[idx] = cond
+	return conditions
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"time"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Conditions", func() {
+	DescribeTable("FindVolumePoolCondition",
+		func(conds []storagev1alpha1.VolumePoolCondition, condType storagev1alpha1.VolumePoolConditionType, match types.GomegaMatcher) {
+			Expect(storagev1alpha1.FindVolumePoolCondition(conds, condType)).To(match)
+		},
+		Entry("returns the matching condition",
+			[]storagev1alpha1.VolumePoolCondition{
+				{Type: "Other", Status: corev1.ConditionTrue},
+				{Type: storagev1alpha1.VolumePoolRuntimeCapabilities, Status: corev1.ConditionFalse, Reason: "X"},
+			},
+			storagev1alpha1.VolumePoolRuntimeCapabilities,
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(storagev1alpha1.VolumePoolRuntimeCapabilities),
+				"Reason": Equal("X"),
+			})),
+		),
+		Entry("returns nil when no condition of the given type is present",
+			[]storagev1alpha1.VolumePoolCondition{{Type: "Other"}},
+			storagev1alpha1.VolumePoolRuntimeCapabilities,
+			BeNil(),
+		),
+	)
+
+	Describe("SetVolumePoolCondition", func() {
+		It("should append the condition when it is absent", func() {
+			out := storagev1alpha1.SetVolumePoolCondition(nil, storagev1alpha1.VolumePoolCondition{
+				Type:   storagev1alpha1.VolumePoolRuntimeCapabilities,
+				Status: corev1.ConditionTrue,
+			})
+
+			Expect(out).To(HaveLen(1))
+			Expect(out[0].Type).To(Equal(storagev1alpha1.VolumePoolRuntimeCapabilities))
+			Expect(out[0].LastTransitionTime.IsZero()).To(BeFalse())
+		})
+
+		It("should only advance LastTransitionTime when the status changes", func() {
+			earlier := metav1.NewTime(time.Now().Add(-time.Hour))
+			in := []storagev1alpha1.VolumePoolCondition{{
+				Type:               storagev1alpha1.VolumePoolRuntimeCapabilities,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: earlier,
+			}}
+
+			out := storagev1alpha1.SetVolumePoolCondition(in, storagev1alpha1.VolumePoolCondition{
+				Type:    storagev1alpha1.VolumePoolRuntimeCapabilities,
+				Status:  corev1.ConditionTrue,
+				Message: "expand",
+			})
+			Expect(out).To(HaveLen(1))
+			Expect(out[0].Message).To(Equal("expand"))
+			Expect(out[0].LastTransitionTime.Equal(&earlier)).To(BeTrue())
+
+			out = storagev1alpha1.SetVolumePoolCondition(out, storagev1alpha1.VolumePoolCondition{
+				Type:   storagev1alpha1.VolumePoolRuntimeCapabilities,
+				Status: corev1.ConditionFalse,
+			})
+			Expect(out[0].LastTransitionTime.Equal(&earlier)).To(BeFalse())
+		})
+	})
+
+	Describe("SetBucketPoolCondition", func() {
+		It("should append the condition when it is absent", func() {
+			out := storagev1alpha1.SetBucketPoolCondition(nil, storagev1alpha1.BucketPoolCondition{
+				Type:   storagev1alpha1.BucketPoolRuntimeCapabilities,
+				Status: corev1.ConditionTrue,
+			})
+
+			Expect(out).To(HaveLen(1))
+			Expect(storagev1alpha1.FindBucketPoolCondition(out, storagev1alpha1.BucketPoolRuntimeCapabilities)).
+				To(HaveField("LastTransitionTime", Not(BeZero())))
+		})
+
+		It("should only advance LastTransitionTime when the status changes", func() {
+			earlier := metav1.NewTime(time.Now().Add(-time.Hour))
+			in := []storagev1alpha1.BucketPoolCondition{{
+				Type:               storagev1alpha1.BucketPoolRuntimeCapabilities,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: earlier,
+			}}
+
+			out := storagev1alpha1.SetBucketPoolCondition(in, storagev1alpha1.BucketPoolCondition{
+				Type:   storagev1alpha1.BucketPoolRuntimeCapabilities,
+				Status: corev1.ConditionTrue,
+			})
+			Expect(out[0].LastTransitionTime.Equal(&earlier)).To(BeTrue())
+
+			out = storagev1alpha1.SetBucketPoolCondition(out, storagev1alpha1.BucketPoolCondition{
+				Type:   storagev1alpha1.BucketPoolRuntimeCapabilities,
+				Status: corev1.ConditionFalse,
+			})
+			Expect(out[0].LastTransitionTime.Equal(&earlier)).To(BeFalse())
+		})
+	})
+
+	Describe("SetVolumeCondition", func() {
+		It("should append the condition when it is absent", func() {
+			out := storagev1alpha1.SetVolumeCondition(nil, storagev1alpha1.VolumeCondition{
+				Type:   storagev1alpha1.VolumeReverting,
+				Status: corev1.ConditionTrue,
+				Reason: storagev1alpha1.VolumeRevertingReasonWaitingForDetach,
+			})
+
+			Expect(out).To(HaveLen(1))
+			Expect(storagev1alpha1.FindVolumeCondition(out, storagev1alpha1.VolumeReverting)).
+				To(HaveField("Reason", storagev1alpha1.VolumeRevertingReasonWaitingForDetach))
+		})
+
+		It("should only advance LastTransitionTime when the status changes", func() {
+			earlier := metav1.NewTime(time.Now().Add(-time.Hour))
+			in := []storagev1alpha1.VolumeCondition{{
+				Type:               storagev1alpha1.VolumeReverting,
+				Status:             corev1.ConditionTrue,
+				Reason:             storagev1alpha1.VolumeRevertingReasonWaitingForDetach,
+				LastTransitionTime: earlier,
+			}}
+
+			out := storagev1alpha1.SetVolumeCondition(in, storagev1alpha1.VolumeCondition{
+				Type:   storagev1alpha1.VolumeReverting,
+				Status: corev1.ConditionTrue,
+				Reason: storagev1alpha1.VolumeRevertingReasonReverting,
+			})
+			Expect(out[0].Reason).To(Equal(storagev1alpha1.VolumeRevertingReasonReverting))
+			Expect(out[0].LastTransitionTime.Equal(&earlier)).To(BeTrue())
+
+			out = storagev1alpha1.SetVolumeCondition(out, storagev1alpha1.VolumeCondition{
+				Type:   storagev1alpha1.VolumeReverting,
+				Status: corev1.ConditionFalse,
+				Reason: storagev1alpha1.VolumeRevertingReasonReverted,
+			})
+			Expect(out[0].LastTransitionTime.Equal(&earlier)).To(BeFalse())
+		})
+	})
+})
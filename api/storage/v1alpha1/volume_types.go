@@ -40,12 +40,30 @@ type VolumeSpec struct {
 	Encryption *VolumeEncryption `json:"encryption,omitempty"`
 	// DataSource contains the content to prepopulate the Volume with.
 	DataSource VolumeDataSource `json:"dataSource,omitempty"`
+	// Revert requests to revert the volume to a VolumeSnapshot of itself.
+	// Every time Revert.RequestedAt changes, the volume is reverted as soon as no machine claims it.
+	Revert *VolumeRevert `json:"revert,omitempty"`
+}
+
+// VolumeRevert is a request to revert a Volume to a VolumeSnapshot.
+type VolumeRevert struct {
+	// VolumeSnapshotRef references the VolumeSnapshot to revert the volume to.
+	// The VolumeSnapshot has to be a snapshot of the volume.
+	VolumeSnapshotRef corev1.LocalObjectReference `json:"volumeSnapshotRef"`
+	// RequestedAt is the time the revert was requested at.
+	RequestedAt metav1.Time `json:"requestedAt"`
 }
 
 // VolumeDataSource specifies the source to use for a Volume.
 type VolumeDataSource struct {
 	// VolumeSnapshotRef instructs to use the specified VolumeSnapshot as the data source.
 	VolumeSnapshotRef *corev1.LocalObjectReference `json:"volumeSnapshotRef,omitempty"`
+	// VolumeRef instructs to clone the specified Volume of the same namespace.
+	// The storage of the clone must be at least the storage of the source Volume.
+	VolumeRef *corev1.LocalObjectReference `json:"volumeRef,omitempty"`
+	// VolumeBackupRef instructs to restore the specified VolumeBackup of the same namespace.
+	// Unlike VolumeSnapshots, VolumeBackups can be restored on any VolumePool.
+	VolumeBackupRef *corev1.LocalObjectReference `json:"volumeBackupRef,omitempty"`
 	// OSImage defines an optional os image to bootstrap the volume.
 	OSImage *OSDataSource `json:"osImage,omitempty"`
 }
@@ -87,11 +105,32 @@ type VolumeStatus struct {
 
 	// Resources is a effective volume's resources.
 	Resources corev1alpha1.ResourceList `json:"resources,omitempty"`
+
+	// LastAppliedRevert is the last revert that was applied to the volume.
+	LastAppliedRevert *VolumeRevert `json:"lastAppliedRevert,omitempty"`
 }
 
 // VolumeConditionType is a type a VolumeCondition can have.
 type VolumeConditionType string
 
+const (
+	// VolumeReverting reports whether a revert of the volume is in progress.
+	VolumeReverting VolumeConditionType = "Reverting"
+)
+
+const (
+	// VolumeRevertingReasonWaitingForDetach indicates that the volume is still claimed by a machine.
+	VolumeRevertingReasonWaitingForDetach = "WaitingForDetach"
+	// VolumeRevertingReasonWaitingForSnapshot indicates that the VolumeSnapshot is not ready yet.
+	VolumeRevertingReasonWaitingForSnapshot = "WaitingForSnapshot"
+	// VolumeRevertingReasonReverting indicates that the volume is being reverted.
+	VolumeRevertingReasonReverting = "Reverting"
+	// VolumeRevertingReasonReverted indicates that the volume was reverted.
+	VolumeRevertingReasonReverted = "Reverted"
+	// VolumeRevertingReasonFailed indicates that the volume cannot be reverted to the VolumeSnapshot.
+	VolumeRevertingReasonFailed = "RevertFailed"
+)
+
 // VolumeCondition is one of the conditions of a volume.
 type VolumeCondition struct {
 	// Type is the type of the condition.
@@ -43,6 +43,12 @@ const (
 // VolumePoolConditionType is a type a VolumePoolCondition can have.
 type VolumePoolConditionType string
 
+const (
+	// VolumePoolRuntimeCapabilities reports whether the capabilities of the volume runtime are known.
+	// The message lists the capabilities.
+	VolumePoolRuntimeCapabilities VolumePoolConditionType = "RuntimeCapabilities"
+)
+
 // VolumePoolCondition is one of the conditions of a volume.
 type VolumePoolCondition struct {
 	// Type is the type of the condition.
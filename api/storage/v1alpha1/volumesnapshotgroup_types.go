@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeSnapshotGroupNameLabel is the label added to VolumeSnapshots created by a VolumeSnapshotGroup.
+// Its value is the name of the VolumeSnapshotGroup.
+const VolumeSnapshotGroupNameLabel = "storage.ironcore.dev/volume-snapshot-group"
+
+// VolumeSnapshotGroupSpec defines the desired state of VolumeSnapshotGroup
+type VolumeSnapshotGroupSpec struct {
+	// MachineRef references the Machine whose Volumes to snapshot.
+	// Exactly one of MachineRef and VolumeSelector has to be set.
+	MachineRef *corev1.LocalObjectReference `json:"machineRef,omitempty"`
+	// VolumeSelector selects the Volumes to snapshot.
+	// Exactly one of MachineRef and VolumeSelector has to be set.
+	VolumeSelector *metav1.LabelSelector `json:"volumeSelector,omitempty"`
+	// FreezeIO instructs to freeze the I/O of the Machine while its Volumes are snapshotted.
+	// Requires MachineRef to be set.
+	FreezeIO bool `json:"freezeIO,omitempty"`
+	// FreezeTimeout is the maximum duration the I/O of the Machine is frozen.
+	// Defaults to 30 seconds if FreezeIO is set.
+	FreezeTimeout *metav1.Duration `json:"freezeTimeout,omitempty"`
+}
+
+// VolumeSnapshotGroupSnapshot is a VolumeSnapshot taken as part of a VolumeSnapshotGroup.
+type VolumeSnapshotGroupSnapshot struct {
+	// VolumeRef references the snapshotted Volume.
+	VolumeRef corev1.LocalObjectReference `json:"volumeRef"`
+	// VolumeSnapshotRef references the VolumeSnapshot of the Volume.
+	VolumeSnapshotRef corev1.LocalObjectReference `json:"volumeSnapshotRef"`
+	// VolumeClassRef is the VolumeClass the Volume had when it was snapshotted.
+	VolumeClassRef *corev1.LocalObjectReference `json:"volumeClassRef,omitempty"`
+	// Resources are the resources the Volume had when it was snapshotted.
+	Resources corev1alpha1.ResourceList `json:"resources,omitempty"`
+}
+
+// VolumeSnapshotGroupStatus defines the observed state of VolumeSnapshotGroup
+type VolumeSnapshotGroupStatus struct {
+	// State is the state of the VolumeSnapshotGroup.
+	State VolumeSnapshotGroupState `json:"state,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned between values.
+	LastStateTransitionTime *metav1.Time `json:"lastStateTransitionTime,omitempty"`
+	// Message is a human-readable explanation of the State.
+	Message string `json:"message,omitempty"`
+	// IOFreezeRequestedAt is the time the I/O freeze of the Machine was requested at.
+	IOFreezeRequestedAt *metav1.Time `json:"ioFreezeRequestedAt,omitempty"`
+	// Snapshots are the VolumeSnapshots of the VolumeSnapshotGroup.
+	Snapshots []VolumeSnapshotGroupSnapshot `json:"snapshots,omitempty"`
+}
+
+// VolumeSnapshotGroupState is the state of a VolumeSnapshotGroup.
+type VolumeSnapshotGroupState string
+
+const (
+	// VolumeSnapshotGroupStatePending reports that the VolumeSnapshots of a VolumeSnapshotGroup are being taken.
+	VolumeSnapshotGroupStatePending VolumeSnapshotGroupState = "Pending"
+	// VolumeSnapshotGroupStateReady reports that all VolumeSnapshots of a VolumeSnapshotGroup are ready.
+	VolumeSnapshotGroupStateReady VolumeSnapshotGroupState = "Ready"
+	// VolumeSnapshotGroupStateFailed reports that a VolumeSnapshotGroup could not be taken consistently.
+	VolumeSnapshotGroupStateFailed VolumeSnapshotGroupState = "Failed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshotGroup snapshots a set of Volumes as one consistent operation.
+type VolumeSnapshotGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSnapshotGroupSpec   `json:"spec,omitempty"`
+	Status VolumeSnapshotGroupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshotGroupList contains a list of VolumeSnapshotGroup
+type VolumeSnapshotGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshotGroup `json:"items"`
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// VolumeSnapshotScheduleNameLabel is the label added to VolumeSnapshots created by a VolumeSnapshotSchedule.
+	// Its value is the name of the VolumeSnapshotSchedule.
+	VolumeSnapshotScheduleNameLabel = "storage.ironcore.dev/volume-snapshot-schedule"
+	// VolumeSnapshotScheduledTimeAnnotation is the annotation added to VolumeSnapshots created by a
+	// VolumeSnapshotSchedule. Its value is the scheduled time the snapshot was created for in RFC 3339 format.
+	VolumeSnapshotScheduledTimeAnnotation = "storage.ironcore.dev/scheduled-time"
+)
+
+// VolumeSnapshotRetention specifies which VolumeSnapshots of a VolumeSnapshotSchedule to keep.
+// Snapshots matching any of the set limits are deleted.
+type VolumeSnapshotRetention struct {
+	// KeepLast is the number of most recent ready VolumeSnapshots to keep per Volume.
+	// VolumeSnapshots that are not ready are kept until KeepLast more recent ready ones exist.
+	KeepLast *int32 `json:"keepLast,omitempty"`
+	// MaxAge is the duration after which VolumeSnapshots are deleted.
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// VolumeSnapshotScheduleSpec defines the desired state of VolumeSnapshotSchedule
+type VolumeSnapshotScheduleSpec struct {
+	// Schedule is the schedule in cron format, e.g. '0 3 * * *'. The schedule is interpreted in UTC.
+	Schedule string `json:"schedule"`
+	// VolumeSelector selects the Volumes to snapshot.
+	VolumeSelector *metav1.LabelSelector `json:"volumeSelector"`
+	// Retention specifies which VolumeSnapshots to keep.
+	Retention VolumeSnapshotRetention `json:"retention"`
+	// Suspend suspends the creation of VolumeSnapshots. Existing VolumeSnapshots are still pruned.
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// VolumeSnapshotScheduleStatus defines the observed state of VolumeSnapshotSchedule
+type VolumeSnapshotScheduleStatus struct {
+	// ObservedGeneration is the most recent generation observed for this VolumeSnapshotSchedule.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastScheduleTime is the last time VolumeSnapshots were scheduled.
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is the last scheduled time for which all VolumeSnapshots became ready.
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// LastFailureTime is the last scheduled time a VolumeSnapshot failed or could not be created.
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+	// LastFailureMessage is a human-readable explanation of the last failure.
+	LastFailureMessage string `json:"lastFailureMessage,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshotSchedule periodically creates VolumeSnapshots of the selected Volumes and prunes
+// them according to its retention.
+type VolumeSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeSnapshotScheduleSpec   `json:"spec,omitempty"`
+	Status VolumeSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshotScheduleList contains a list of VolumeSnapshotSchedule
+type VolumeSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshotSchedule `json:"items"`
+}
@@ -43,6 +43,11 @@ func (in BucketPool) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.BucketPool"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in BucketPoolCondition) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.BucketPoolCondition"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in BucketPoolList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.BucketPoolList"
@@ -88,6 +93,26 @@ func (in VolumeAccess) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeAccess"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeBackup) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeBackup"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeBackupList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeBackupList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeBackupSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeBackupSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeBackupStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeBackupStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in VolumeClass) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeClass"
@@ -143,16 +168,96 @@ func (in VolumePoolStatus) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumePoolStatus"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeRevert) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeRevert"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in VolumeSnapshot) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshot"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroup) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroup"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupRestore) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupRestore"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupRestoreList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupRestoreList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupRestoreSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupRestoreSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupRestoreStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupRestoreStatus"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupRestoreVolume) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupRestoreVolume"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupSnapshot) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupSnapshot"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotGroupStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotGroupStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in VolumeSnapshotList) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotList"
 }
 
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotRetention) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotRetention"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotSchedule) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotSchedule"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotScheduleList) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotScheduleList"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotScheduleSpec) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotScheduleSpec"
+}
+
+// OpenAPIModelName returns the OpenAPI model name for this type.
+func (in VolumeSnapshotScheduleStatus) OpenAPIModelName() string {
+	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotScheduleStatus"
+}
+
 // OpenAPIModelName returns the OpenAPI model name for this type.
 func (in VolumeSnapshotSpec) OpenAPIModelName() string {
 	return "com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotSpec"
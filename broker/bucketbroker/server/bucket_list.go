@@ -6,12 +6,14 @@ package server
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	bucketbrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/bucketbroker/api/v1alpha1"
 	"github.com/ironcore-dev/ironcore/broker/bucketbroker/apiutils"
 	"github.com/ironcore-dev/ironcore/broker/common"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
@@ -20,50 +22,56 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *Server) listManagedAndCreated(ctx context.Context, list client.ObjectList) error {
-	return s.client.List(ctx, list,
+func (s *Server) listManagedAndCreated(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return s.client.List(ctx, list, append([]client.ListOption{
 		client.InNamespace(s.namespace),
 		client.MatchingLabels{
 			bucketbrokerv1alpha1.ManagerLabel: bucketbrokerv1alpha1.BucketBrokerManager,
 			bucketbrokerv1alpha1.CreatedLabel: "true",
 		},
-	)
+	}, opts...)...)
 }
 
-func (s *Server) listAggregateIronCoreBuckets(ctx context.Context) ([]AggregateIronCoreBucket, error) {
+func (s *Server) listAggregateIronCoreBuckets(ctx context.Context, limit int64, continueToken string) ([]AggregateIronCoreBucket, string, error) {
 	ironcoreBucketList := &storagev1alpha1.BucketList{}
-	if err := s.listManagedAndCreated(ctx, ironcoreBucketList); err != nil {
-		return nil, fmt.Errorf("error listing ironcore buckets: %w", err)
-	}
-
-	secretList := &corev1.SecretList{}
-	if err := s.client.List(ctx, secretList,
-		client.InNamespace(s.namespace),
-	); err != nil {
-		return nil, fmt.Errorf("error listing secrets: %w", err)
-	}
+	if err := s.listManagedAndCreated(ctx, ironcoreBucketList, common.ListPageOptions(limit, continueToken)...); err != nil {
+		return nil, "", fmt.Errorf("error listing ironcore buckets: %w", common.ConvertListPageError(err, continueToken))
+	}
+
+	// The secrets of a single page are fetched one by one, listing them up front only
+	// pays off when aggregating all buckets.
+	getSecret := s.clientGetSecretFunc(ctx)
+	if limit == 0 {
+		secretList := &corev1.SecretList{}
+		if err := s.client.List(ctx, secretList,
+			client.InNamespace(s.namespace),
+		); err != nil {
+			return nil, "", fmt.Errorf("error listing secrets: %w", err)
+		}
 
-	secretByNameGetter, err := common.NewObjectGetter[string, *corev1.Secret](
-		corev1.Resource("secrets"),
-		common.ByObjectName[*corev1.Secret](),
-		common.ObjectSlice[string](secretList.Items),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error constructing secret getter: %w", err)
+		secretByNameGetter, err := common.NewObjectGetter[string, *corev1.Secret](
+			corev1.Resource("secrets"),
+			common.ByObjectName[*corev1.Secret](),
+			common.ObjectSlice[string](secretList.Items),
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("error constructing secret getter: %w", err)
+		}
+		getSecret = secretByNameGetter.Get
 	}
 
 	var res []AggregateIronCoreBucket
 	for i := range ironcoreBucketList.Items {
 		ironcoreBucket := &ironcoreBucketList.Items[i]
-		aggregateIronCoreBucket, err := s.aggregateIronCoreBucket(ironcoreBucket, secretByNameGetter.Get)
+		aggregateIronCoreBucket, err := s.aggregateIronCoreBucket(ironcoreBucket, getSecret)
 		if err != nil {
-			return nil, fmt.Errorf("error aggregating ironcore bucket %s: %w", ironcoreBucket.Name, err)
+			return nil, "", fmt.Errorf("error aggregating ironcore bucket %s: %w", ironcoreBucket.Name, err)
 		}
 
 		res = append(res, *aggregateIronCoreBucket)
 	}
 
-	return res, nil
+	return res, ironcoreBucketList.Continue, nil
 }
 
 func (s *Server) clientGetSecretFunc(ctx context.Context) func(string) (*corev1.Secret, error) {
@@ -140,22 +148,22 @@ func (s *Server) getAggregateIronCoreBucket(ctx context.Context, id string) (*Ag
 	return s.aggregateIronCoreBucket(ironcoreBucket, s.clientGetSecretFunc(ctx))
 }
 
-func (s *Server) listBuckets(ctx context.Context) ([]*iri.Bucket, error) {
-	ironcoreBuckets, err := s.listAggregateIronCoreBuckets(ctx)
+func (s *Server) listBuckets(ctx context.Context, limit int64, continueToken string) ([]*iri.Bucket, string, error) {
+	ironcoreBuckets, nextContinueToken, err := s.listAggregateIronCoreBuckets(ctx, limit, continueToken)
 	if err != nil {
-		return nil, fmt.Errorf("error listing buckets: %w", err)
+		return nil, "", fmt.Errorf("error listing buckets: %w", err)
 	}
 
 	var res []*iri.Bucket
 	for _, ironcoreBucket := range ironcoreBuckets {
 		bucket, err := s.convertAggregateIronCoreBucket(&ironcoreBucket)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		res = append(res, bucket)
 	}
-	return res, nil
+	return res, nextContinueToken, nil
 }
 
 func (s *Server) filterBuckets(buckets []*iri.Bucket, filter *iri.BucketFilter) []*iri.Bucket {
@@ -177,6 +185,25 @@ func (s *Server) filterBuckets(buckets []*iri.Bucket, filter *iri.BucketFilter)
 	return res
 }
 
+func (s *Server) filterBucketsByStateAndClass(buckets []*iri.Bucket, filter *iri.BucketFilter) []*iri.Bucket {
+	if filter == nil || (len(filter.States) == 0 && len(filter.Classes) == 0) {
+		return buckets
+	}
+
+	var res []*iri.Bucket
+	for _, bucket := range buckets {
+		if len(filter.States) > 0 && !slices.Contains(filter.States, bucket.GetStatus().GetState()) {
+			continue
+		}
+		if len(filter.Classes) > 0 && !slices.Contains(filter.Classes, bucket.GetSpec().GetClass()) {
+			continue
+		}
+
+		res = append(res, bucket)
+	}
+	return res
+}
+
 func (s *Server) getBucket(ctx context.Context, id string) (*iri.Bucket, error) {
 	ironcoreBucket, err := s.getAggregateIronCoreBucket(ctx, id)
 	if err != nil {
@@ -186,7 +213,18 @@ func (s *Server) getBucket(ctx context.Context, id string) (*iri.Bucket, error)
 	return s.convertAggregateIronCoreBucket(ironcoreBucket)
 }
 
+// ListBuckets lists a page of buckets. The limit and continue token are passed on to the API server,
+// so only the buckets of the requested page are aggregated. Since the label selector, state and class
+// filters are applied afterward, a page may hold fewer buckets than the limit.
 func (s *Server) ListBuckets(ctx context.Context, req *iri.ListBucketsRequest) (*iri.ListBucketsResponse, error) {
+	if err := paging.ValidateLimit(req.Limit); err != nil {
+		return nil, err
+	}
+
+	var (
+		buckets       []*iri.Bucket
+		continueToken string
+	)
 	if filter := req.Filter; filter != nil && filter.Id != "" {
 		bucket, err := s.getBucket(ctx, filter.Id)
 		if err != nil {
@@ -198,19 +236,19 @@ func (s *Server) ListBuckets(ctx context.Context, req *iri.ListBucketsRequest) (
 			}, nil
 		}
 
-		return &iri.ListBucketsResponse{
-			Buckets: []*iri.Bucket{bucket},
-		}, nil
-	}
+		buckets = []*iri.Bucket{bucket}
+	} else {
+		var err error
+		buckets, continueToken, err = s.listBuckets(ctx, req.Limit, req.ContinueToken)
+		if err != nil {
+			return nil, err
+		}
 
-	buckets, err := s.listBuckets(ctx)
-	if err != nil {
-		return nil, err
+		buckets = s.filterBuckets(buckets, req.Filter)
 	}
 
-	buckets = s.filterBuckets(buckets, req.Filter)
-
 	return &iri.ListBucketsResponse{
-		Buckets: buckets,
+		Buckets:       s.filterBucketsByStateAndClass(buckets, req.Filter),
+		ContinueToken: continueToken,
 	}, nil
 }
@@ -9,6 +9,8 @@ import (
 	bucketpoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/bucketpoollet/api/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ = Describe("ListBuckets", func() {
@@ -41,4 +43,62 @@ var _ = Describe("ListBuckets", func() {
 		By("listing the buckets")
 		Expect(srv.ListBuckets(ctx, &iri.ListBucketsRequest{})).To(HaveField("Buckets", ConsistOf(buckets...)))
 	})
+
+	It("should page and filter buckets", func(ctx SpecContext) {
+		By("creating multiple buckets")
+		const noOfBuckets = 3
+
+		buckets := make([]any, noOfBuckets)
+		for i := 0; i < noOfBuckets; i++ {
+			res, err := srv.CreateBucket(ctx, &iri.CreateBucketRequest{
+				Bucket: &iri.Bucket{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels: map[string]string{
+							bucketpoolletv1alpha1.BucketUIDLabel: "foobar",
+						},
+					},
+					Spec: &iri.BucketSpec{
+						Class: bucketClass.Name,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			buckets[i] = res.Bucket
+		}
+
+		By("listing the buckets page by page")
+		var (
+			listed        []*iri.Bucket
+			continueToken string
+		)
+		for {
+			res, err := srv.ListBuckets(ctx, &iri.ListBucketsRequest{Limit: 2, ContinueToken: continueToken})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(res.Buckets)).To(BeNumerically("<=", 2))
+			listed = append(listed, res.Buckets...)
+
+			if res.ContinueToken == "" {
+				break
+			}
+			continueToken = res.ContinueToken
+		}
+		Expect(listed).To(ConsistOf(buckets...))
+
+		By("rejecting an invalid continue token")
+		_, err := srv.ListBuckets(ctx, &iri.ListBucketsRequest{Limit: 2, ContinueToken: "invalid"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		By("filtering the buckets by class")
+		Expect(srv.ListBuckets(ctx, &iri.ListBucketsRequest{
+			Filter: &iri.BucketFilter{Classes: []string{bucketClass.Name}},
+		})).To(HaveField("Buckets", ConsistOf(buckets...)))
+		Expect(srv.ListBuckets(ctx, &iri.ListBucketsRequest{
+			Filter: &iri.BucketFilter{Classes: []string{"unknown"}},
+		})).To(HaveField("Buckets", BeEmpty()))
+
+		By("filtering the buckets by state")
+		Expect(srv.ListBuckets(ctx, &iri.ListBucketsRequest{
+			Filter: &iri.BucketFilter{States: []iri.BucketState{iri.BucketState_BUCKET_ERROR}},
+		})).To(HaveField("Buckets", BeEmpty()))
+	})
 })
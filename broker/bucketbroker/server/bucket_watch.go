@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"time"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	bucketbrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/bucketbroker/api/v1alpha1"
+	"github.com/ironcore-dev/ironcore/broker/bucketbroker/apiutils"
+	"github.com/ironcore-dev/ironcore/broker/common/watch"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// bucketWatchResyncPeriod is the period in which all watched buckets are aggregated and sent again,
+// picking up changes of the secrets the buckets reference.
+const bucketWatchResyncPeriod = 1 * time.Minute
+
+// WatchBuckets implements iri.BucketRuntimeServer on top of an informer.
+// Resuming from a resource version is not supported, the current buckets are always sent first.
+func (s *Server) WatchBuckets(req *iri.WatchBucketsRequest, stream iri.BucketRuntime_WatchBucketsServer) error {
+	ctx := stream.Context()
+	log := s.loggerFrom(ctx)
+
+	informer := watch.NewInformer(
+		s.client,
+		&storagev1alpha1.BucketList{},
+		&storagev1alpha1.Bucket{},
+		bucketWatchResyncPeriod,
+		client.InNamespace(s.namespace),
+		client.MatchingLabels{
+			bucketbrokerv1alpha1.ManagerLabel: bucketbrokerv1alpha1.BucketBrokerManager,
+			bucketbrokerv1alpha1.CreatedLabel: "true",
+		},
+	)
+
+	log.V(1).Info("Watching ironcore buckets")
+	return watch.Run(ctx, informer, func(evt watch.Event[*storagev1alpha1.Bucket]) error {
+		ironcoreBucket := evt.Object
+		if filter := req.Filter; filter != nil && filter.Id != "" && filter.Id != ironcoreBucket.Name {
+			return nil
+		}
+
+		var bucket *iri.Bucket
+		if evt.Type == irimeta.WatchEventType_WATCH_EVENT_DELETED {
+			metadata, err := apiutils.GetObjectMetadata(&ironcoreBucket.ObjectMeta)
+			if err != nil {
+				log.V(1).Info("Error getting ironcore bucket metadata, skipping", "BucketID", ironcoreBucket.Name, "Error", err)
+				return nil
+			}
+			bucket = &iri.Bucket{Metadata: metadata}
+		} else {
+			aggregateIronCoreBucket, err := s.aggregateIronCoreBucket(ironcoreBucket, s.clientGetSecretFunc(ctx))
+			if err != nil {
+				log.V(1).Info("Error aggregating ironcore bucket, skipping", "BucketID", ironcoreBucket.Name, "Error", err)
+				return nil
+			}
+			bucket, err = s.convertAggregateIronCoreBucket(aggregateIronCoreBucket)
+			if err != nil {
+				log.V(1).Info("Error converting ironcore bucket, skipping", "BucketID", ironcoreBucket.Name, "Error", err)
+				return nil
+			}
+		}
+		if len(s.filterBuckets([]*iri.Bucket{bucket}, req.Filter)) == 0 {
+			return nil
+		}
+
+		return stream.Send(&iri.WatchBucketsResponse{
+			Type:            evt.Type,
+			Bucket:          bucket,
+			ResourceVersion: ironcoreBucket.ResourceVersion,
+		})
+	})
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	bucketpoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/bucketpoollet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+type watchBucketsServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *iri.WatchBucketsResponse
+}
+
+func (s *watchBucketsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchBucketsServer) Send(res *iri.WatchBucketsResponse) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.responses <- res:
+		return nil
+	}
+}
+
+var _ = Describe("WatchBuckets", func() {
+	_, _, srv := SetupTest()
+	bucketClass := SetupBucketClass("250Mi", "1500")
+
+	It("should send the changes of the buckets", func(ctx SpecContext) {
+		By("creating a bucket")
+		res, err := srv.CreateBucket(ctx, &iri.CreateBucketRequest{
+			Bucket: &iri.Bucket{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						bucketpoolletv1alpha1.BucketUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.BucketSpec{
+					Class: bucketClass.Name,
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		bucketID := res.Bucket.Metadata.Id
+
+		By("watching the buckets")
+		watchCtx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+		stream := &watchBucketsServer{ctx: watchCtx, responses: make(chan *iri.WatchBucketsResponse, 16)}
+		go func() {
+			defer GinkgoRecover()
+			_ = srv.WatchBuckets(&iri.WatchBucketsRequest{}, stream)
+		}()
+
+		By("waiting for the bucket to be reported as added")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_ADDED),
+			HaveField("Bucket.Metadata.Id", bucketID),
+			HaveField("ResourceVersion", Not(BeEmpty())),
+		)))
+
+		By("deleting the bucket")
+		Expect(srv.DeleteBucket(ctx, &iri.DeleteBucketRequest{
+			BucketId: bucketID,
+		})).Error().NotTo(HaveOccurred())
+
+		By("waiting for the bucket to be reported as deleted")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_DELETED),
+			HaveField("Bucket.Metadata.Id", bucketID),
+		)))
+	})
+})
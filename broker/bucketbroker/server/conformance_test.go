@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"github.com/ironcore-dev/ironcore/iri/apis/bucket"
+	"github.com/ironcore-dev/ironcore/iri/testing/conformance"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Conformance", func() {
+	_, bucketPool, srv := SetupTest()
+	bucketClass := SetupBucketClass("250Mi", "1500")
+
+	var runtime bucket.RuntimeService
+	BeforeEach(func(ctx SpecContext) {
+		By("making the bucket class available in the bucket pool")
+		bucketPool.Status.AvailableBucketClasses = []corev1.LocalObjectReference{{Name: bucketClass.Name}}
+		Expect(k8sClient.Status().Update(ctx, bucketPool)).To(Succeed())
+
+		runtime = conformance.ServeBucketRuntime(srv)
+	})
+
+	conformance.DescribeBucketRuntime(func() conformance.BucketRuntimeConfig {
+		return conformance.BucketRuntimeConfig{
+			Runtime:     runtime,
+			BucketClass: bucketClass.Name,
+		}
+	})
+})
@@ -17,6 +17,7 @@ import (
 	"github.com/ironcore-dev/ironcore/broker/bucketbroker/apiutils"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
 	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 )
 
 const (
@@ -25,40 +26,51 @@ const (
 	InvolvedObjectAPIVersionSelector = "involvedObject.apiVersion"
 )
 
-func (s *Server) listEvents(ctx context.Context) ([]*irievent.Event, error) {
-	log := ctrl.LoggerFrom(ctx)
-	bucketEventList := &corev1.EventList{}
-	selectorField := fields.Set{
+func (s *Server) eventFieldSelector() fields.Selector {
+	return fields.Set{
 		InvolvedObjectKindSelector:       InvolvedObjectKind,
 		InvolvedObjectAPIVersionSelector: storagev1alpha1.SchemeGroupVersion.String(),
+	}.AsSelector()
+}
+
+func (s *Server) convertIronCoreEvent(ctx context.Context, bucketEvent *corev1.Event) (*irievent.Event, error) {
+	ironcoreBucket, err := s.getIronCoreBucket(ctx, bucketEvent.InvolvedObject.Name)
+	if err != nil {
+		return nil, err
+	}
+	bucketObjectMetadata, err := apiutils.GetObjectMetadata(&ironcoreBucket.ObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ironcore bucket object metadata: %w", err)
 	}
+	return &irievent.Event{
+		Spec: &irievent.EventSpec{
+			InvolvedObjectMeta: bucketObjectMetadata,
+			Reason:             bucketEvent.Reason,
+			Message:            bucketEvent.Message,
+			Type:               bucketEvent.Type,
+			Action:             bucketEvent.Action,
+			EventTime:          bucketEvent.LastTimestamp.Unix(),
+		},
+	}, nil
+}
+
+func (s *Server) listEvents(ctx context.Context) ([]*irievent.Event, error) {
+	log := ctrl.LoggerFrom(ctx)
+	bucketEventList := &corev1.EventList{}
 	if err := s.client.List(ctx, bucketEventList,
-		client.InNamespace(s.namespace), client.MatchingFieldsSelector{Selector: selectorField.AsSelector()},
+		client.InNamespace(s.namespace), client.MatchingFieldsSelector{Selector: s.eventFieldSelector()},
 	); err != nil {
 		return nil, err
 	}
 
 	var iriEvents []*irievent.Event
-	for _, bucketEvent := range bucketEventList.Items {
-		ironcoreBucket, err := s.getIronCoreBucket(ctx, bucketEvent.InvolvedObject.Name)
+	for i := range bucketEventList.Items {
+		bucketEvent := &bucketEventList.Items[i]
+		iriEvent, err := s.convertIronCoreEvent(ctx, bucketEvent)
 		if err != nil {
 			log.V(1).Info("Unable to get ironcore bucket", "BucketName", bucketEvent.InvolvedObject.Name)
 			continue
 		}
-		bucketObjectMetadata, err := apiutils.GetObjectMetadata(&ironcoreBucket.ObjectMeta)
-		if err != nil {
-			continue
-		}
-		iriEvent := &irievent.Event{
-			Spec: &irievent.EventSpec{
-				InvolvedObjectMeta: bucketObjectMetadata,
-				Reason:             bucketEvent.Reason,
-				Message:            bucketEvent.Message,
-				Type:               bucketEvent.Type,
-				Action:             bucketEvent.Action,
-				EventTime:          bucketEvent.LastTimestamp.Unix(),
-			},
-		}
 		iriEvents = append(iriEvents, iriEvent)
 	}
 	return iriEvents, nil
@@ -97,7 +109,13 @@ func (s *Server) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*i
 
 	iriEvents = s.filterEvents(iriEvents, req.Filter)
 
+	iriEvents, continueToken, err := paging.PageByOffset(iriEvents, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
+
 	return &iri.ListEventsResponse{
-		Events: iriEvents,
+		Events:        iriEvents,
+		ContinueToken: continueToken,
 	}, nil
 }
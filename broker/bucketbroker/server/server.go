@@ -42,7 +42,7 @@ func init() {
 }
 
 type Server struct {
-	client client.Client
+	client client.WithWatch
 	iri.UnimplementedBucketRuntimeServer
 
 	brokerDownwardAPILabels map[string]string
@@ -117,7 +117,7 @@ var _ iri.BucketRuntimeServer = (*Server)(nil)
 func New(cfg *rest.Config, opts Options) (*Server, error) {
 	setOptionsDefaults(&opts)
 
-	c, err := client.New(cfg, client.Options{
+	c, err := client.NewWithWatch(cfg, client.Options{
 		Scheme: scheme,
 	})
 	if err != nil {
@@ -11,6 +11,12 @@ import (
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
 )
 
+// runtimeCapabilities are the capabilities of the bucketbroker. As it forwards all calls to ironcore,
+// it supports all of them.
+var runtimeCapabilities = []iri.RuntimeCapability{
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_WATCH,
+}
+
 func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
 	var runtimeVersion string
 	switch {
@@ -30,5 +36,6 @@ func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.Ver
 	return &iri.VersionResponse{
 		RuntimeName:    version.RuntimeName,
 		RuntimeVersion: runtimeVersion,
+		Capabilities:   runtimeCapabilities,
 	}, nil
 }
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -111,3 +113,18 @@ func CleanupSocketIfExists(address string) error {
 	}
 	return nil
 }
+
+// ListPageOptions returns the options to list a single page of at most limit objects following the
+// object the continue token of the API server points to. A limit of zero lists all objects.
+func ListPageOptions(limit int64, continueToken string) []client.ListOption {
+	return []client.ListOption{client.Limit(limit), client.Continue(continueToken)}
+}
+
+// ConvertListPageError converts errors of the API server about an invalid or expired continue token
+// to InvalidArgument errors. Other errors are returned as they are.
+func ConvertListPageError(err error, continueToken string) error {
+	if continueToken != "" && (apierrors.IsResourceExpired(err) || apierrors.IsBadRequest(err)) {
+		return status.Errorf(codes.InvalidArgument, "invalid continue token %q: %v", continueToken, err)
+	}
+	return err
+}
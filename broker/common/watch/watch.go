@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package watch implements IRI watches on top of informers.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apiwatch "k8s.io/apimachinery/pkg/watch"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewInformer creates an informer listing and watching the objects of the given list type via the given client.
+func NewInformer(
+	c client.WithWatch,
+	list client.ObjectList,
+	obj client.Object,
+	resyncPeriod time.Duration,
+	opts ...client.ListOption,
+) toolscache.SharedIndexInformer {
+	listOptions := func(options metav1.ListOptions) *client.ListOptions {
+		o := &client.ListOptions{Raw: &options}
+		o.ApplyOptions(opts)
+		return o
+	}
+
+	return toolscache.NewSharedIndexInformer(&toolscache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			res := list.DeepCopyObject().(client.ObjectList)
+			if err := c.List(ctx, res, listOptions(options)); err != nil {
+				return nil, err
+			}
+			return res, nil
+		},
+		WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (apiwatch.Interface, error) {
+			return c.Watch(ctx, list.DeepCopyObject().(client.ObjectList), listOptions(options))
+		},
+	}, obj, resyncPeriod, toolscache.Indexers{})
+}
+
+// Event is a change of an object reported by an informer.
+type Event[O client.Object] struct {
+	Type irimeta.WatchEventType
+	// Object is the current state of the object. For deletions, it is the last known state.
+	Object O
+	// OldObject is the previous state of the object. Only set for modifications.
+	OldObject O
+	// IsInInitialList reports whether the object was part of the initial list of the informer.
+	IsInInitialList bool
+}
+
+// Run runs the informer and calls handle sequentially for every change until the context is done or
+// handle returns an error. Changes of the initial list are reported as added.
+// If the informer resyncs, all objects are reported as modified.
+func Run[O client.Object](ctx context.Context, informer toolscache.SharedIndexInformer, handle func(Event[O]) error) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	handleOrCancel := func(evt Event[O]) {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := handle(evt); err != nil {
+			cancel(err)
+		}
+	}
+
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj any, isInInitialList bool) {
+			handleOrCancel(Event[O]{
+				Type:            irimeta.WatchEventType_WATCH_EVENT_ADDED,
+				Object:          obj.(O),
+				IsInInitialList: isInInitialList,
+			})
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			handleOrCancel(Event[O]{
+				Type:      irimeta.WatchEventType_WATCH_EVENT_MODIFIED,
+				Object:    newObj.(O),
+				OldObject: oldObj.(O),
+			})
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			o, ok := obj.(O)
+			if !ok {
+				return
+			}
+			handleOrCancel(Event[O]{
+				Type:   irimeta.WatchEventType_WATCH_EVENT_DELETED,
+				Object: o,
+			})
+		},
+	}); err != nil {
+		return fmt.Errorf("error adding event handler: %w", err)
+	}
+
+	informer.RunWithContext(ctx)
+	return context.Cause(ctx)
+}
+
+// IsNewEventOccurrence reports whether the change of a Kubernetes event is a new occurrence of it,
+// i.e. the event was created after the initial list or it was repeated.
+func IsNewEventOccurrence(evt Event[*corev1.Event]) bool {
+	switch evt.Type {
+	case irimeta.WatchEventType_WATCH_EVENT_ADDED:
+		return !evt.IsInInitialList
+	case irimeta.WatchEventType_WATCH_EVENT_MODIFIED:
+		return evt.Object.Count != evt.OldObject.Count
+	default:
+		return false
+	}
+}
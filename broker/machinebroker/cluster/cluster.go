@@ -31,7 +31,7 @@ func init() {
 type Cluster interface {
 	Namespace() string
 	Config() *rest.Config
-	Client() client.Client
+	Client() client.WithWatch
 	Scheme() *runtime.Scheme
 	IDGen() idgen.IDGen
 	MachinePoolName() string
@@ -41,7 +41,7 @@ type Cluster interface {
 type cluster struct {
 	namespace           string
 	config              *rest.Config
-	client              client.Client
+	client              client.WithWatch
 	scheme              *runtime.Scheme
 	idGen               idgen.IDGen
 	machinePoolName     string
@@ -63,7 +63,7 @@ func setOptionsDefaults(o *Options) {
 func New(cfg *rest.Config, namespace string, opts Options) (Cluster, error) {
 	setOptionsDefaults(&opts)
 
-	c, err := client.New(cfg, client.Options{
+	c, err := client.NewWithWatch(cfg, client.Options{
 		Scheme: scheme,
 	})
 	if err != nil {
@@ -89,7 +89,7 @@ func (c *cluster) Config() *rest.Config {
 	return c.config
 }
 
-func (c *cluster) Client() client.Client {
+func (c *cluster) Client() client.WithWatch {
 	return c.client
 }
 
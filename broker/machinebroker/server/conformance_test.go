@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"github.com/ironcore-dev/ironcore/iri/apis/machine"
+	"github.com/ironcore-dev/ironcore/iri/testing/conformance"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("Conformance", func() {
+	_, srv := SetupTest()
+	machineClass := SetupMachineClass()
+
+	var runtime machine.RuntimeService
+	BeforeEach(func() {
+		runtime = conformance.ServeMachineRuntime(srv)
+	})
+
+	conformance.DescribeMachineRuntime(func() conformance.MachineRuntimeConfig {
+		return conformance.MachineRuntimeConfig{
+			Runtime:      runtime,
+			MachineClass: machineClass.Name,
+		}
+	})
+})
@@ -19,6 +19,7 @@ import (
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/apiutils"
 	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 )
 
 const (
@@ -28,28 +29,53 @@ const (
 	LogKeyMachineName                = "MachineName"
 )
 
-func (s *Server) listEvents(ctx context.Context) ([]*irievent.Event, error) {
-	log := ctrl.LoggerFrom(ctx)
-	machineEventList := &corev1.EventList{}
-	selectorField := fields.Set{
+func (s *Server) eventFieldSelector() fields.Selector {
+	return fields.Set{
 		InvolvedObjectKindSelector:       InvolvedObjectKind,
 		InvolvedObjectAPIVersionSelector: computev1alpha1.SchemeGroupVersion.String(),
+	}.AsSelector()
+}
+
+func (s *Server) convertIronCoreEvent(ctx context.Context, machineEvent *corev1.Event) (*irievent.Event, error) {
+	ironcoreMachine, err := s.getIronCoreMachine(ctx, machineEvent.InvolvedObject.Name)
+	if err != nil {
+		return nil, err
+	}
+	machineObjectMetadata, err := apiutils.GetObjectMetadata(&ironcoreMachine.ObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ironcore machine object metadata: %w", err)
 	}
+	return &irievent.Event{
+		Spec: &irievent.EventSpec{
+			InvolvedObjectMeta: machineObjectMetadata,
+			Reason:             machineEvent.Reason,
+			Message:            machineEvent.Message,
+			Type:               machineEvent.Type,
+			Action:             machineEvent.Action,
+			EventTime:          machineEvent.LastTimestamp.Unix(),
+		},
+	}, nil
+}
+
+func (s *Server) listEvents(ctx context.Context) ([]*irievent.Event, error) {
+	log := ctrl.LoggerFrom(ctx)
+	machineEventList := &corev1.EventList{}
 	if err := s.cluster.Client().List(ctx, machineEventList,
-		client.InNamespace(s.cluster.Namespace()), client.MatchingFieldsSelector{Selector: selectorField.AsSelector()},
+		client.InNamespace(s.cluster.Namespace()), client.MatchingFieldsSelector{Selector: s.eventFieldSelector()},
 	); err != nil {
 		return nil, err
 	}
 
 	unmanagedMachines := sets.Set[string]{}
 	var iriEvents []*irievent.Event
-	for _, machineEvent := range machineEventList.Items {
+	for i := range machineEventList.Items {
+		machineEvent := &machineEventList.Items[i]
 		if unmanagedMachines.Has(machineEvent.InvolvedObject.Name) {
 			log.V(2).Info("skipping unmanaged machine", LogKeyMachineName, machineEvent.InvolvedObject.Name)
 			continue
 		}
 
-		ironcoreMachine, err := s.getIronCoreMachine(ctx, machineEvent.InvolvedObject.Name)
+		iriEvent, err := s.convertIronCoreEvent(ctx, machineEvent)
 		if err != nil {
 			if errors.Is(err, ErrMachineIsntManaged) || errors.Is(err, ErrMachineNotFound) {
 				unmanagedMachines.Insert(machineEvent.InvolvedObject.Name)
@@ -58,20 +84,6 @@ func (s *Server) listEvents(ctx context.Context) ([]*irievent.Event, error) {
 			log.V(1).Info("Unable to get ironcore machine", LogKeyMachineName, machineEvent.InvolvedObject.Name, "error", err.Error())
 			continue
 		}
-		machineObjectMetadata, err := apiutils.GetObjectMetadata(&ironcoreMachine.ObjectMeta)
-		if err != nil {
-			continue
-		}
-		iriEvent := &irievent.Event{
-			Spec: &irievent.EventSpec{
-				InvolvedObjectMeta: machineObjectMetadata,
-				Reason:             machineEvent.Reason,
-				Message:            machineEvent.Message,
-				Type:               machineEvent.Type,
-				Action:             machineEvent.Action,
-				EventTime:          machineEvent.LastTimestamp.Unix(),
-			},
-		}
 		iriEvents = append(iriEvents, iriEvent)
 	}
 	return iriEvents, nil
@@ -110,7 +122,13 @@ func (s *Server) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*i
 
 	iriEvents = s.filterEvents(iriEvents, req.Filter)
 
+	iriEvents, continueToken, err := paging.PageByOffset(iriEvents, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
+
 	return &iri.ListEventsResponse{
-		Events: iriEvents,
+		Events:        iriEvents,
+		ContinueToken: continueToken,
 	}, nil
 }
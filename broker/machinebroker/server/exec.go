@@ -10,7 +10,6 @@ import (
 
 	"github.com/go-logr/logr"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
-	ironcore "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned"
 	ironcoreclientgoscheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
 	remotecommandserver "github.com/ironcore-dev/ironcore/poollet/machinepoollet/iri/streaming/remotecommand"
@@ -44,15 +43,7 @@ func (s *Server) ServeExec(w http.ResponseWriter, req *http.Request, token strin
 		return
 	}
 
-	ironcoreClientset, err := ironcore.NewForConfig(s.cluster.Config())
-	if err != nil {
-		log.Error(err, "Error getting ironcore api clientset for config")
-		code := http.StatusInternalServerError
-		http.Error(w, http.StatusText(code), code)
-		return
-	}
-
-	reqURL := ironcoreClientset.ComputeV1alpha1().RESTClient().
+	reqURL := s.ironcoreClientset.ComputeV1alpha1().RESTClient().
 		Post().
 		Namespace(s.cluster.Namespace()).
 		Resource("machines").
@@ -36,7 +36,8 @@ type AggregateIronCoreNetworkInterface struct {
 var ironcoreMachineStateToMachineState = map[computev1alpha1.MachineState]iri.MachineState{
 	computev1alpha1.MachineStatePending:     iri.MachineState_MACHINE_PENDING,
 	computev1alpha1.MachineStateRunning:     iri.MachineState_MACHINE_RUNNING,
-	computev1alpha1.MachineStateShutdown:    iri.MachineState_MACHINE_SUSPENDED,
+	computev1alpha1.MachineStateShutdown:    iri.MachineState_MACHINE_STOPPED,
+	computev1alpha1.MachineStateSuspended:   iri.MachineState_MACHINE_SUSPENDED,
 	computev1alpha1.MachineStateTerminated:  iri.MachineState_MACHINE_TERMINATED,
 	computev1alpha1.MachineStateTerminating: iri.MachineState_MACHINE_TERMINATING,
 }
@@ -54,6 +55,8 @@ func (s *Server) convertIronCorePowerState(power computev1alpha1.Power) (iri.Pow
 		return iri.Power_POWER_ON, nil
 	case computev1alpha1.PowerOff:
 		return iri.Power_POWER_OFF, nil
+	case computev1alpha1.PowerSuspended:
+		return iri.Power_POWER_SUSPENDED, nil
 	default:
 		return 0, fmt.Errorf("unknown power state: %q", power)
 	}
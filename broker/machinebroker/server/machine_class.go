@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (s *Server) UpdateMachineClass(ctx context.Context, req *iri.UpdateMachineClassRequest) (*iri.UpdateMachineClassResponse, error) {
+	machineID := req.MachineId
+	log := s.loggerFrom(ctx, "MachineID", machineID, "MachineClass", req.Class)
+
+	if req.Class == "" {
+		return nil, status.Error(codes.InvalidArgument, "must specify machine class")
+	}
+
+	log.V(1).Info("Getting ironcore machine class")
+	machineClass := &computev1alpha1.MachineClass{}
+	if err := s.cluster.Client().Get(ctx, client.ObjectKey{Name: req.Class}, machineClass); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting ironcore machine class %s: %w", req.Class, err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "machine class %s not found", req.Class)
+	}
+
+	log.V(1).Info("Getting ironcore machine")
+	aggIronCoreMachine, err := s.getAggregateIronCoreMachine(ctx, machineID)
+	if err != nil {
+		return nil, convertInternalErrorToGRPC(err)
+	}
+
+	base := aggIronCoreMachine.Machine.DeepCopy()
+	aggIronCoreMachine.Machine.Spec.MachineClassRef.Name = req.Class
+	log.V(1).Info("Patching ironcore machine class")
+	if err := s.cluster.Client().Patch(ctx, aggIronCoreMachine.Machine, client.MergeFrom(base)); err != nil {
+		return nil, fmt.Errorf("error patching ironcore machine class: %w", err)
+	}
+
+	return &iri.UpdateMachineClassResponse{}, nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	ironcoreclientgoscheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const consoleLogChunkSize = 32 * 1024
+
+func (s *Server) prepareIronCoreMachineConsoleLogOptions(req *iri.GetConsoleLogRequest) *computev1alpha1.MachineConsoleLogOptions {
+	opts := &computev1alpha1.MachineConsoleLogOptions{
+		Follow:    req.Follow,
+		TailLines: req.TailLines,
+	}
+	if req.SinceTime != 0 {
+		sinceTime := metav1.NewTime(time.Unix(0, req.SinceTime))
+		opts.SinceTime = &sinceTime
+	}
+	return opts
+}
+
+func (s *Server) GetConsoleLog(req *iri.GetConsoleLogRequest, stream iri.MachineRuntime_GetConsoleLogServer) error {
+	ctx := stream.Context()
+	machineID := req.MachineId
+	log := s.loggerFrom(ctx, "MachineID", machineID)
+
+	if req.TailLines != nil && *req.TailLines < 0 {
+		return status.Errorf(codes.InvalidArgument, "tail_lines must not be negative")
+	}
+
+	log.V(1).Info("Getting ironcore machine")
+	if _, err := s.getIronCoreMachine(ctx, machineID); err != nil {
+		return convertInternalErrorToGRPC(err)
+	}
+
+	log.V(1).Info("Streaming ironcore machine console log")
+	rc, err := s.ironcoreClientset.ComputeV1alpha1().RESTClient().
+		Get().
+		Namespace(s.cluster.Namespace()).
+		Resource("machines").
+		Name(machineID).
+		SubResource("consolelog").
+		VersionedParams(s.prepareIronCoreMachineConsoleLogOptions(req), ironcoreclientgoscheme.ParameterCodec).
+		Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("error streaming ironcore machine console log: %w", err)
+	}
+	defer func() { _ = rc.Close() }()
+
+	buf := make([]byte, consoleLogChunkSize)
+	for {
+		n, err := rc.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&iri.GetConsoleLogResponse{Data: append([]byte(nil), buf[:n]...)}); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error reading ironcore machine console log: %w", err)
+		}
+	}
+}
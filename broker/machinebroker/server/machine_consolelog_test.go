@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/ptr"
+)
+
+type consoleLogServer struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *consoleLogServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *consoleLogServer) Send(*iri.GetConsoleLogResponse) error {
+	return nil
+}
+
+var _ = Describe("GetConsoleLog", func() {
+	_, srv := SetupTest()
+
+	It("should return not found for a non-existing machine", func(ctx SpecContext) {
+		err := srv.GetConsoleLog(&iri.GetConsoleLogRequest{
+			MachineId: "does-not-exist",
+		}, &consoleLogServer{ctx: ctx})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should reject a negative tail lines", func(ctx SpecContext) {
+		err := srv.GetConsoleLog(&iri.GetConsoleLogRequest{
+			MachineId: "does-not-exist",
+			TailLines: ptr.To[int64](-1),
+		}, &consoleLogServer{ctx: ctx})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
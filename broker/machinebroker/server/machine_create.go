@@ -51,6 +51,8 @@ func (s *Server) prepareIronCoreMachinePower(power iri.Power) (computev1alpha1.P
 		return computev1alpha1.PowerOn, nil
 	case iri.Power_POWER_OFF:
 		return computev1alpha1.PowerOff, nil
+	case iri.Power_POWER_SUSPENDED:
+		return computev1alpha1.PowerSuspended, nil
 	default:
 		return "", fmt.Errorf("unknown power state %v", power)
 	}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// machineIOFreezePollInterval is the interval in which the ironcore machine is checked for the io freeze
+	// to be applied.
+	machineIOFreezePollInterval = 1 * time.Second
+	// machineIOFreezeTimeout is the maximum time FreezeMachine waits for the io freeze to be applied before
+	// the caller has to retry.
+	machineIOFreezeTimeout = 30 * time.Second
+)
+
+// FreezeMachine requests an io freeze of the ironcore machine and waits until its machine pool froze the io.
+// Calling it again while the requested freeze has not been observed yet does not request another freeze
+// but keeps waiting for the pending one.
+func (s *Server) FreezeMachine(ctx context.Context, req *iri.FreezeMachineRequest) (*iri.FreezeMachineResponse, error) {
+	machineID := req.MachineId
+	log := s.loggerFrom(ctx, "MachineID", machineID)
+
+	if req.TimeoutSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "timeout has to be greater than zero")
+	}
+
+	log.V(1).Info("Getting ironcore machine")
+	aggIronCoreMachine, err := s.getAggregateIronCoreMachine(ctx, machineID)
+	if err != nil {
+		return nil, convertInternalErrorToGRPC(err)
+	}
+
+	ironcoreMachine := aggIronCoreMachine.Machine
+	if ironCoreMachineIOFreezePending(ironcoreMachine, time.Now()) {
+		log.V(1).Info("IO freeze already requested, waiting for it")
+	} else {
+		base := ironcoreMachine.DeepCopy()
+		ironcoreMachine.Spec.IOFreeze = &computev1alpha1.MachineIOFreeze{
+			RequestedAt: metav1.Now(),
+			Timeout:     metav1.Duration{Duration: time.Duration(req.TimeoutSeconds) * time.Second},
+		}
+		log.V(1).Info("Patching ironcore machine io freeze")
+		if err := s.cluster.Client().Patch(ctx, ironcoreMachine, client.MergeFrom(base)); err != nil {
+			return nil, fmt.Errorf("error patching ironcore machine io freeze: %w", err)
+		}
+	}
+
+	log.V(1).Info("Waiting for ironcore machine io freeze")
+	timeout := min(machineIOFreezeTimeout, time.Duration(req.TimeoutSeconds)*time.Second)
+	if err := s.waitForIronCoreMachineIOFreeze(ctx, ironcoreMachine, timeout); err != nil {
+		return nil, err
+	}
+
+	return &iri.FreezeMachineResponse{}, nil
+}
+
+// ironCoreMachineIOFreezePending reports whether an unexpired io freeze of the ironcore machine has been
+// requested that the machine pool has not observed yet.
+func ironCoreMachineIOFreezePending(machine *computev1alpha1.Machine, now time.Time) bool {
+	ioFreeze := machine.Spec.IOFreeze
+	return ioFreeze != nil &&
+		now.Before(computev1alpha1.MachineIOFreezeDeadline(ioFreeze)) &&
+		machine.Status.ObservedGeneration < machine.Generation
+}
+
+// waitForIronCoreMachineIOFreeze waits until the machine pool of the ironcore machine observed the requested
+// io freeze and reports the result in the IOFrozen condition. A failed freeze is reported as FailedPrecondition,
+// a freeze that did not apply within the timeout as DeadlineExceeded so the caller retries.
+func (s *Server) waitForIronCoreMachineIOFreeze(ctx context.Context, machine *computev1alpha1.Machine, timeout time.Duration) error {
+	generation := machine.Generation
+	if err := wait.PollUntilContextTimeout(ctx, machineIOFreezePollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := s.cluster.Client().Get(ctx, client.ObjectKeyFromObject(machine), machine); err != nil {
+			return false, fmt.Errorf("error getting ironcore machine: %w", err)
+		}
+		return machine.Status.ObservedGeneration >= generation, nil
+	}); err != nil {
+		if wait.Interrupted(err) {
+			return status.Errorf(codes.DeadlineExceeded, "io of machine %s has not been frozen yet", machine.Name)
+		}
+		return err
+	}
+
+	cond := computev1alpha1.FindMachineCondition(machine.Status.Conditions, computev1alpha1.MachineIOFrozen)
+	if cond == nil || cond.Status != corev1.ConditionTrue {
+		message := "io freeze failed"
+		if cond != nil && cond.Message != "" {
+			message = cond.Message
+		}
+		return status.Errorf(codes.FailedPrecondition, "error freezing io of machine %s: %s", machine.Name, message)
+	}
+	return nil
+}
+
+func (s *Server) ThawMachine(ctx context.Context, req *iri.ThawMachineRequest) (*iri.ThawMachineResponse, error) {
+	machineID := req.MachineId
+	log := s.loggerFrom(ctx, "MachineID", machineID)
+
+	log.V(1).Info("Getting ironcore machine")
+	aggIronCoreMachine, err := s.getAggregateIronCoreMachine(ctx, machineID)
+	if err != nil {
+		return nil, convertInternalErrorToGRPC(err)
+	}
+
+	if aggIronCoreMachine.Machine.Spec.IOFreeze == nil {
+		return &iri.ThawMachineResponse{}, nil
+	}
+
+	base := aggIronCoreMachine.Machine.DeepCopy()
+	aggIronCoreMachine.Machine.Spec.IOFreeze = nil
+	log.V(1).Info("Patching ironcore machine io freeze")
+	if err := s.cluster.Client().Patch(ctx, aggIronCoreMachine.Machine, client.MergeFrom(base)); err != nil {
+		return nil, fmt.Errorf("error patching ironcore machine io freeze: %w", err)
+	}
+
+	return &iri.ThawMachineResponse{}, nil
+}
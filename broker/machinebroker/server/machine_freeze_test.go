@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("FreezeMachine", func() {
+	ns, srv := SetupTest()
+	machineClass := SetupMachineClass()
+
+	It("should request and release an io freeze of the ironcore machine", func(ctx SpecContext) {
+		By("creating a machine")
+		res, err := srv.CreateMachine(ctx, &iri.CreateMachineRequest{
+			Machine: &iri.Machine{
+				Spec: &iri.MachineSpec{
+					Power: iri.Power_POWER_ON,
+					Class: machineClass.Name,
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		machineID := res.Machine.Metadata.Id
+
+		By("freezing the machine")
+		freezeErr := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			_, err := srv.FreezeMachine(ctx, &iri.FreezeMachineRequest{
+				MachineId:      machineID,
+				TimeoutSeconds: 30,
+			})
+			freezeErr <- err
+		}()
+
+		By("waiting for the io freeze to be requested on the ironcore machine")
+		ironcoreMachine := &computev1alpha1.Machine{}
+		ironcoreMachineKey := client.ObjectKey{Namespace: ns.Name, Name: machineID}
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Get(ctx, ironcoreMachineKey, ironcoreMachine)).To(Succeed())
+			g.Expect(ironcoreMachine.Spec.IOFreeze).NotTo(BeNil())
+		}).Should(Succeed())
+		Expect(ironcoreMachine.Spec.IOFreeze.Timeout.Duration).To(Equal(30 * time.Second))
+		Expect(ironcoreMachine.Spec.IOFreeze.RequestedAt.IsZero()).To(BeFalse())
+
+		By("asserting the freeze does not return before the io is frozen")
+		Consistently(freezeErr).ShouldNot(Receive())
+
+		By("reporting the io of the ironcore machine as frozen")
+		base := ironcoreMachine.DeepCopy()
+		ironcoreMachine.Status.ObservedGeneration = ironcoreMachine.Generation
+		ironcoreMachine.Status.Conditions = []computev1alpha1.MachineCondition{{
+			Type:   computev1alpha1.MachineIOFrozen,
+			Status: corev1.ConditionTrue,
+			Reason: "Frozen",
+		}}
+		Expect(k8sClient.Status().Patch(ctx, ironcoreMachine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the freeze to return")
+		Eventually(freezeErr).Should(Receive(BeNil()))
+
+		By("thawing the machine")
+		Expect(srv.ThawMachine(ctx, &iri.ThawMachineRequest{
+			MachineId: machineID,
+		})).Error().NotTo(HaveOccurred())
+
+		By("inspecting the ironcore machine")
+		Expect(k8sClient.Get(ctx, ironcoreMachineKey, ironcoreMachine)).To(Succeed())
+		Expect(ironcoreMachine.Spec.IOFreeze).To(BeNil())
+	})
+
+	It("should reject a freeze without timeout", func(ctx SpecContext) {
+		_, err := srv.FreezeMachine(ctx, &iri.FreezeMachineRequest{
+			MachineId: "does-not-exist",
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+
+	It("should return not found for a non-existing machine", func(ctx SpecContext) {
+		_, err := srv.ThawMachine(ctx, &iri.ThawMachineRequest{
+			MachineId: "does-not-exist",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+})
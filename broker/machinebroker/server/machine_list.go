@@ -7,39 +7,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/broker/common"
 	machinebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/machinebroker/api/v1alpha1"
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/apiutils"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 	clientutils "github.com/ironcore-dev/ironcore/utils/client"
 
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *Server) listAggregateIronCoreMachines(ctx context.Context, filter *iri.MachineFilter) ([]AggregateIronCoreMachine, error) {
-	ironcoreMachineList, err := s.listIroncoreMachines(ctx, filter)
+func (s *Server) listAggregateIronCoreMachines(
+	ctx context.Context,
+	filter *iri.MachineFilter,
+	limit int64,
+	continueToken string,
+) ([]AggregateIronCoreMachine, string, error) {
+	ironcoreMachineList, err := s.listIroncoreMachines(ctx, filter, common.ListPageOptions(limit, continueToken)...)
 	if err != nil {
-		return nil, fmt.Errorf("error listing ironcore machines: %w", err)
+		return nil, "", fmt.Errorf("error listing ironcore machines: %w", common.ConvertListPageError(err, continueToken))
 	}
 
-	listOpts := []client.ListOption{
-		client.InNamespace(s.cluster.Namespace()),
-		client.MatchingLabels{
-			machinebrokerv1alpha1.ManagerLabel: machinebrokerv1alpha1.MachineBrokerManager,
-		},
-	}
+	// The objects referenced by a single page are fetched one by one, listing them up front only
+	// pays off when aggregating all machines.
+	var rd client.Reader = s.cluster.Client()
+	if limit == 0 {
+		listOpts := []client.ListOption{
+			client.InNamespace(s.cluster.Namespace()),
+			client.MatchingLabels{
+				machinebrokerv1alpha1.ManagerLabel: machinebrokerv1alpha1.MachineBrokerManager,
+			},
+		}
 
-	rd, err := clientutils.NewCachingReaderBuilder(s.cluster.Client()).
-		List(&corev1.SecretList{}, listOpts...).
-		List(&networkingv1alpha1.NetworkList{}, listOpts...).
-		Build(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("error building caching reader: %w", err)
+		rd, err = clientutils.NewCachingReaderBuilder(s.cluster.Client()).
+			List(&corev1.SecretList{}, listOpts...).
+			List(&networkingv1alpha1.NetworkList{}, listOpts...).
+			Build(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("error building caching reader: %w", err)
+		}
 	}
 
 	var res []AggregateIronCoreMachine
@@ -47,35 +62,64 @@ func (s *Server) listAggregateIronCoreMachines(ctx context.Context, filter *iri.
 		ironcoreMachine := &ironcoreMachineList.Items[i]
 		aggregateIronCoreMachine, err := s.aggregateIronCoreMachine(ctx, rd, ironcoreMachine)
 		if err != nil {
-			return nil, fmt.Errorf("error aggregating ironcore machine %s: %w", ironcoreMachine.Name, err)
+			return nil, "", fmt.Errorf("error aggregating ironcore machine %s: %w", ironcoreMachine.Name, err)
 		}
 
 		res = append(res, *aggregateIronCoreMachine)
 	}
-	return res, nil
+	return res, ironcoreMachineList.Continue, nil
 }
 
-func (s *Server) listIroncoreMachines(ctx context.Context, filter *iri.MachineFilter) (*computev1alpha1.MachineList, error) {
+func (s *Server) listIroncoreMachines(ctx context.Context, filter *iri.MachineFilter, opts ...client.ListOption) (*computev1alpha1.MachineList, error) {
 	ironcoreMachineList := &computev1alpha1.MachineList{}
+	if err := s.cluster.Client().List(ctx, ironcoreMachineList, append([]client.ListOption{
+		client.InNamespace(s.cluster.Namespace()),
+		s.ironcoreMachineMatchingLabels(filter),
+	}, opts...)...); err != nil {
+		return nil, err
+	}
+
+	if filter == nil || len(filter.LabelSelector) == 0 {
+		return ironcoreMachineList, nil
+	}
+
+	var items []computev1alpha1.Machine
+	for _, ironcoreMachine := range ironcoreMachineList.Items {
+		ok, err := s.ironcoreMachineMatchesLabelSelector(&ironcoreMachine, filter.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("error matching ironcore machine %s labels: %w", ironcoreMachine.Name, err)
+		}
+		if ok {
+			items = append(items, ironcoreMachine)
+		}
+	}
+	ironcoreMachineList.Items = items
+	return ironcoreMachineList, nil
+}
+
+// ironcoreMachineMatchingLabels returns the labels to list the ironcore machines matching the filter with.
+// Of the label selector, only the machine uid label is mirrored onto the ironcore machines, the
+// remaining labels have to be matched via ironcoreMachineMatchesLabelSelector.
+func (s *Server) ironcoreMachineMatchingLabels(filter *iri.MachineFilter) client.MatchingLabels {
 	matchingLabels := client.MatchingLabels{
 		machinebrokerv1alpha1.ManagerLabel: machinebrokerv1alpha1.MachineBrokerManager,
 		machinebrokerv1alpha1.CreatedLabel: "true",
 	}
 
-	if filter != nil && filter.LabelSelector != nil {
-		for k := range filter.LabelSelector {
-			matchingLabels[k] = filter.LabelSelector[k]
+	if filter != nil {
+		if machineUID, ok := filter.LabelSelector[machinepoolletv1alpha1.MachineUIDLabel]; ok {
+			matchingLabels[machinepoolletv1alpha1.MachineUIDLabel] = machineUID
 		}
 	}
+	return matchingLabels
+}
 
-	if err := s.cluster.Client().List(ctx, ironcoreMachineList,
-		client.InNamespace(s.cluster.Namespace()),
-		matchingLabels,
-	); err != nil {
-		return nil, err
+func (s *Server) ironcoreMachineMatchesLabelSelector(ironcoreMachine *computev1alpha1.Machine, labelSelector map[string]string) (bool, error) {
+	machineLabels, err := apiutils.GetLabelsAnnotation(ironcoreMachine)
+	if err != nil {
+		return false, err
 	}
-
-	return ironcoreMachineList, nil
+	return labels.SelectorFromSet(labelSelector).Matches(labels.Set(machineLabels)), nil
 }
 
 func (s *Server) aggregateIronCoreMachine(
@@ -165,23 +209,42 @@ func (s *Server) getAggregateIronCoreMachine(ctx context.Context, id string) (*A
 	return s.aggregateIronCoreMachine(ctx, s.cluster.Client(), ironcoreMachine)
 }
 
-func (s *Server) listMachines(ctx context.Context, filter *iri.MachineFilter) ([]*iri.Machine, error) {
-	ironcoreMachines, err := s.listAggregateIronCoreMachines(ctx, filter)
+func (s *Server) listMachines(ctx context.Context, filter *iri.MachineFilter, limit int64, continueToken string) ([]*iri.Machine, string, error) {
+	ironcoreMachines, nextContinueToken, err := s.listAggregateIronCoreMachines(ctx, filter, limit, continueToken)
 	if err != nil {
-		return nil, fmt.Errorf("error listing machines: %w", err)
+		return nil, "", fmt.Errorf("error listing machines: %w", err)
 	}
 
 	var res []*iri.Machine
 	for _, ironcoreMachine := range ironcoreMachines {
 		machine, err := s.convertAggregateIronCoreMachine(&ironcoreMachine)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		res = append(res, machine)
 	}
 
-	return res, nil
+	return res, nextContinueToken, nil
+}
+
+func (s *Server) filterMachinesByStateAndClass(machines []*iri.Machine, filter *iri.MachineFilter) []*iri.Machine {
+	if filter == nil || (len(filter.States) == 0 && len(filter.Classes) == 0) {
+		return machines
+	}
+
+	var res []*iri.Machine
+	for _, machine := range machines {
+		if len(filter.States) > 0 && !slices.Contains(filter.States, machine.GetStatus().GetState()) {
+			continue
+		}
+		if len(filter.Classes) > 0 && !slices.Contains(filter.Classes, machine.GetSpec().GetClass()) {
+			continue
+		}
+
+		res = append(res, machine)
+	}
+	return res
 }
 
 func (s *Server) getMachine(ctx context.Context, id string) (*iri.Machine, error) {
@@ -193,7 +256,18 @@ func (s *Server) getMachine(ctx context.Context, id string) (*iri.Machine, error
 	return s.convertAggregateIronCoreMachine(aggregateIronCoreMachine)
 }
 
+// ListMachines lists a page of machines. The limit and continue token are passed on to the API server,
+// so only the machines of the requested page are aggregated. Since the label selector, state and class
+// filters are applied afterward, a page may hold fewer machines than the limit.
 func (s *Server) ListMachines(ctx context.Context, req *iri.ListMachinesRequest) (*iri.ListMachinesResponse, error) {
+	if err := paging.ValidateLimit(req.Limit); err != nil {
+		return nil, err
+	}
+
+	var (
+		machines      []*iri.Machine
+		continueToken string
+	)
 	if filter := req.Filter; filter != nil && filter.Id != "" {
 		machine, err := s.getMachine(ctx, filter.Id)
 		if err != nil {
@@ -205,17 +279,17 @@ func (s *Server) ListMachines(ctx context.Context, req *iri.ListMachinesRequest)
 			}, nil
 		}
 
-		return &iri.ListMachinesResponse{
-			Machines: []*iri.Machine{machine},
-		}, nil
-	}
-
-	machines, err := s.listMachines(ctx, req.Filter)
-	if err != nil {
-		return nil, convertInternalErrorToGRPC(err)
+		machines = []*iri.Machine{machine}
+	} else {
+		var err error
+		machines, continueToken, err = s.listMachines(ctx, req.Filter, req.Limit, req.ContinueToken)
+		if err != nil {
+			return nil, convertInternalErrorToGRPC(err)
+		}
 	}
 
 	return &iri.ListMachinesResponse{
-		Machines: machines,
+		Machines:      s.filterMachinesByStateAndClass(machines, req.Filter),
+		ContinueToken: continueToken,
 	}, nil
 }
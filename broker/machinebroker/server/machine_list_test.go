@@ -9,6 +9,8 @@ import (
 	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ = Describe("ListMachines", func() {
@@ -69,4 +71,63 @@ var _ = Describe("ListMachines", func() {
 		By("listing the machines")
 		Expect(srv.ListMachines(ctx, &iri.ListMachinesRequest{})).To(HaveField("Machines", ConsistOf(machines...)))
 	})
+
+	It("should page and filter machines", func(ctx SpecContext) {
+		By("creating multiple machines")
+		const noOfMachines = 3
+
+		machines := make([]any, noOfMachines)
+		for i := 0; i < noOfMachines; i++ {
+			res, err := srv.CreateMachine(ctx, &iri.CreateMachineRequest{
+				Machine: &iri.Machine{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels: map[string]string{
+							machinepoolletv1alpha1.MachineUIDLabel: "foobar",
+						},
+					},
+					Spec: &iri.MachineSpec{
+						Power: iri.Power_POWER_ON,
+						Class: machineClass.Name,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			machines[i] = res.Machine
+		}
+
+		By("listing the machines page by page")
+		var (
+			listed        []*iri.Machine
+			continueToken string
+		)
+		for {
+			res, err := srv.ListMachines(ctx, &iri.ListMachinesRequest{Limit: 2, ContinueToken: continueToken})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(res.Machines)).To(BeNumerically("<=", 2))
+			listed = append(listed, res.Machines...)
+
+			if res.ContinueToken == "" {
+				break
+			}
+			continueToken = res.ContinueToken
+		}
+		Expect(listed).To(ConsistOf(machines...))
+
+		By("rejecting an invalid continue token")
+		_, err := srv.ListMachines(ctx, &iri.ListMachinesRequest{Limit: 2, ContinueToken: "invalid"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		By("filtering the machines by class")
+		Expect(srv.ListMachines(ctx, &iri.ListMachinesRequest{
+			Filter: &iri.MachineFilter{Classes: []string{machineClass.Name}},
+		})).To(HaveField("Machines", ConsistOf(machines...)))
+		Expect(srv.ListMachines(ctx, &iri.ListMachinesRequest{
+			Filter: &iri.MachineFilter{Classes: []string{"unknown"}},
+		})).To(HaveField("Machines", BeEmpty()))
+
+		By("filtering the machines by state")
+		Expect(srv.ListMachines(ctx, &iri.ListMachinesRequest{
+			Filter: &iri.MachineFilter{States: []iri.MachineState{iri.MachineState_MACHINE_TERMINATED}},
+		})).To(HaveField("Machines", BeEmpty()))
+	})
 })
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (s *Server) prepareIronCoreMachineRestartMode(mode iri.RebootMode) (computev1alpha1.RestartMode, error) {
+	switch mode {
+	case iri.RebootMode_REBOOT_MODE_SOFT:
+		return computev1alpha1.RestartModeSoft, nil
+	case iri.RebootMode_REBOOT_MODE_HARD:
+		return computev1alpha1.RestartModeHard, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unknown reboot mode %v", mode)
+	}
+}
+
+func (s *Server) RebootMachine(ctx context.Context, req *iri.RebootMachineRequest) (*iri.RebootMachineResponse, error) {
+	machineID := req.MachineId
+	log := s.loggerFrom(ctx, "MachineID", machineID)
+
+	mode, err := s.prepareIronCoreMachineRestartMode(req.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(1).Info("Getting ironcore machine")
+	aggIronCoreMachine, err := s.getAggregateIronCoreMachine(ctx, machineID)
+	if err != nil {
+		return nil, convertInternalErrorToGRPC(err)
+	}
+
+	base := aggIronCoreMachine.Machine.DeepCopy()
+	aggIronCoreMachine.Machine.Spec.Restart = &computev1alpha1.MachineRestart{
+		RequestedAt: metav1.Now(),
+		Mode:        mode,
+	}
+	log.V(1).Info("Patching ironcore machine restart")
+	if err := s.cluster.Client().Patch(ctx, aggIronCoreMachine.Machine, client.MergeFrom(base)); err != nil {
+		return nil, fmt.Errorf("error patching ironcore machine restart: %w", err)
+	}
+
+	return &iri.RebootMachineResponse{}, nil
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("RebootMachine", func() {
+	ns, srv := SetupTest()
+	machineClass := SetupMachineClass()
+
+	It("should request a restart of the ironcore machine", func(ctx SpecContext) {
+		By("creating a machine")
+		res, err := srv.CreateMachine(ctx, &iri.CreateMachineRequest{
+			Machine: &iri.Machine{
+				Spec: &iri.MachineSpec{
+					Power: iri.Power_POWER_ON,
+					Class: machineClass.Name,
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		machineID := res.Machine.Metadata.Id
+
+		By("hard rebooting the machine")
+		Expect(srv.RebootMachine(ctx, &iri.RebootMachineRequest{
+			MachineId: machineID,
+			Mode:      iri.RebootMode_REBOOT_MODE_HARD,
+		})).Error().NotTo(HaveOccurred())
+
+		By("inspecting the ironcore machine")
+		ironcoreMachine := &computev1alpha1.Machine{}
+		ironcoreMachineKey := client.ObjectKey{Namespace: ns.Name, Name: machineID}
+		Expect(k8sClient.Get(ctx, ironcoreMachineKey, ironcoreMachine)).To(Succeed())
+		Expect(ironcoreMachine.Spec.Restart).NotTo(BeNil())
+		Expect(ironcoreMachine.Spec.Restart.Mode).To(Equal(computev1alpha1.RestartModeHard))
+		Expect(ironcoreMachine.Spec.Restart.RequestedAt.IsZero()).To(BeFalse())
+	})
+
+	It("should return not found for a non-existing machine", func(ctx SpecContext) {
+		_, err := srv.RebootMachine(ctx, &iri.RebootMachineRequest{
+			MachineId: "does-not-exist",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+})
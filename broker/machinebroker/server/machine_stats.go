@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func (s *Server) convertIronCoreMachineStats(machineID string, stats *computev1alpha1.MachineStats) *iri.MachineStats {
+	res := &iri.MachineStats{
+		MachineId: machineID,
+		Timestamp: stats.Timestamp.UnixNano(),
+	}
+	if cpu := stats.CPU; cpu != nil {
+		res.Cpu = &iri.CpuStats{
+			UsageCoreNanoSeconds: uint64(cpu.UsageCoreNanoSeconds),
+		}
+	}
+	if memory := stats.Memory; memory != nil {
+		res.Memory = &iri.MemoryStats{
+			UsageBytes:     uint64(memory.UsageBytes),
+			AvailableBytes: uint64(memory.AvailableBytes),
+		}
+	}
+	for _, volume := range stats.Volumes {
+		res.Volumes = append(res.Volumes, &iri.VolumeStats{
+			Name:       volume.Name,
+			ReadBytes:  uint64(volume.ReadBytes),
+			WriteBytes: uint64(volume.WriteBytes),
+			ReadOps:    uint64(volume.ReadOps),
+			WriteOps:   uint64(volume.WriteOps),
+		})
+	}
+	for _, nic := range stats.NetworkInterfaces {
+		res.NetworkInterfaces = append(res.NetworkInterfaces, &iri.NetworkInterfaceStats{
+			Name:      nic.Name,
+			RxBytes:   uint64(nic.RxBytes),
+			TxBytes:   uint64(nic.TxBytes),
+			RxPackets: uint64(nic.RxPackets),
+			TxPackets: uint64(nic.TxPackets),
+		})
+	}
+	return res
+}
+
+const (
+	// machineStatsConcurrency is the maximum number of machine stats requested from the ironcore api at once.
+	machineStatsConcurrency = 16
+	// machineStatsCacheTTL is the time the stats of a machine are served from the cache, so that
+	// subsequent scrapes within that time do not request them again.
+	machineStatsCacheTTL = 5 * time.Second
+)
+
+type machineStatsCacheEntry struct {
+	stats     *iri.MachineStats
+	fetchedAt time.Time
+}
+
+// machineStatsCache caches the stats of machines between scrapes.
+type machineStatsCache struct {
+	mu      sync.Mutex
+	entries map[string]machineStatsCacheEntry
+}
+
+func newMachineStatsCache() *machineStatsCache {
+	return &machineStatsCache{entries: make(map[string]machineStatsCacheEntry)}
+}
+
+func (c *machineStatsCache) get(machineID string, now time.Time) (*iri.MachineStats, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[machineID]
+	if !ok || now.Sub(entry.fetchedAt) >= machineStatsCacheTTL {
+		return nil, false
+	}
+	return entry.stats, true
+}
+
+func (c *machineStatsCache) set(machineID string, stats *iri.MachineStats, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[machineID] = machineStatsCacheEntry{stats: stats, fetchedAt: now}
+}
+
+// prune removes the expired entries of the cache.
+func (c *machineStatsCache) prune(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for machineID, entry := range c.entries {
+		if now.Sub(entry.fetchedAt) >= machineStatsCacheTTL {
+			delete(c.entries, machineID)
+		}
+	}
+}
+
+func (s *Server) getIronCoreMachineStats(ctx context.Context, machineID string) (*iri.MachineStats, error) {
+	stats := &computev1alpha1.MachineStats{}
+	if err := s.ironcoreClientset.ComputeV1alpha1().RESTClient().
+		Get().
+		Namespace(s.cluster.Namespace()).
+		Resource("machines").
+		Name(machineID).
+		SubResource("stats").
+		Do(ctx).
+		Into(stats); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting ironcore machine %s stats: %w", machineID, ErrMachineNotFound)
+		}
+		return nil, fmt.Errorf("error getting ironcore machine %s stats: %w", machineID, err)
+	}
+	return s.convertIronCoreMachineStats(machineID, stats), nil
+}
+
+func (s *Server) GetMachineStats(ctx context.Context, req *iri.GetMachineStatsRequest) (*iri.GetMachineStatsResponse, error) {
+	machineID := req.MachineId
+	log := s.loggerFrom(ctx, "MachineID", machineID)
+
+	log.V(1).Info("Getting ironcore machine")
+	if _, err := s.getIronCoreMachine(ctx, machineID); err != nil {
+		return nil, convertInternalErrorToGRPC(err)
+	}
+
+	log.V(1).Info("Getting ironcore machine stats")
+	stats, err := s.getIronCoreMachineStats(ctx, machineID)
+	if err != nil {
+		return nil, convertInternalErrorToGRPC(err)
+	}
+
+	return &iri.GetMachineStatsResponse{Stats: stats}, nil
+}
+
+// ListMachineStats requests the stats of the running machines concurrently. Stats requested less than
+// machineStatsCacheTTL ago are served from the cache.
+func (s *Server) ListMachineStats(ctx context.Context, req *iri.ListMachineStatsRequest) (*iri.ListMachineStatsResponse, error) {
+	log := s.loggerFrom(ctx)
+
+	ironcoreMachineList, err := s.listIroncoreMachines(ctx, req.Filter)
+	if err != nil {
+		return nil, convertInternalErrorToGRPC(fmt.Errorf("error listing ironcore machines: %w", err))
+	}
+
+	now := time.Now()
+	s.machineStatsCache.prune(now)
+
+	var (
+		mu   sync.Mutex
+		res  = []*iri.MachineStats{}
+		errs []error
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(machineStatsConcurrency)
+	for _, ironcoreMachine := range ironcoreMachineList.Items {
+		machineID := ironcoreMachine.Name
+		if filter := req.Filter; filter != nil && filter.Id != "" && filter.Id != machineID {
+			continue
+		}
+		// Only running machines have stats.
+		if ironcoreMachine.Status.State != computev1alpha1.MachineStateRunning {
+			continue
+		}
+
+		if stats, ok := s.machineStatsCache.get(machineID, now); ok {
+			mu.Lock()
+			res = append(res, stats)
+			mu.Unlock()
+			continue
+		}
+
+		g.Go(func() error {
+			stats, err := s.getIronCoreMachineStats(ctx, machineID)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return nil
+			}
+			s.machineStatsCache.set(machineID, stats, now)
+			res = append(res, stats)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	// Stats are best-effort: Report the stats that could be retrieved, but surface the failures.
+	if len(errs) > 0 {
+		log.Error(errors.Join(errs...), "Error getting machine stats", "Failed", len(errs), "Succeeded", len(res))
+	}
+	return &iri.ListMachineStatsResponse{Stats: res}, nil
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ = Describe("GetMachineStats", func() {
+	_, srv := SetupTest()
+	machineClass := SetupMachineClass()
+
+	It("should return not found for a non-existing machine", func(ctx SpecContext) {
+		_, err := srv.GetMachineStats(ctx, &iri.GetMachineStatsRequest{
+			MachineId: "does-not-exist",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should not request the stats of machines that are not running", func(ctx SpecContext) {
+		By("creating a machine")
+		Expect(srv.CreateMachine(ctx, &iri.CreateMachineRequest{
+			Machine: &iri.Machine{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						machinepoolletv1alpha1.MachineUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.MachineSpec{
+					Power: iri.Power_POWER_ON,
+					Class: machineClass.Name,
+				},
+			},
+		})).Error().NotTo(HaveOccurred())
+
+		By("listing the machine stats")
+		Expect(srv.ListMachineStats(ctx, &iri.ListMachineStatsRequest{})).To(HaveField("Stats", BeEmpty()))
+	})
+})
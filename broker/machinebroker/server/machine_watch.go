@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/broker/common/watch"
+	"github.com/ironcore-dev/ironcore/broker/machinebroker/apiutils"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"golang.org/x/sync/errgroup"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// machineWatchResyncPeriod is the period in which all watched machines are aggregated and sent again,
+// picking up changes of referenced objects that are not watched, e.g. networks of network interfaces.
+// Changes of the network interfaces and volumes of the machines are sent right away.
+const machineWatchResyncPeriod = 1 * time.Minute
+
+// WatchMachines implements iri.MachineRuntimeServer on top of informers.
+// Besides the machines, the network interfaces and volumes are watched to send the machines referencing
+// them again once they change.
+// Resuming from a resource version is not supported, the current machines are always sent first.
+func (s *Server) WatchMachines(req *iri.WatchMachinesRequest, stream iri.MachineRuntime_WatchMachinesServer) error {
+	ctx := stream.Context()
+	log := s.loggerFrom(ctx)
+
+	machineInformer := watch.NewInformer(
+		s.cluster.Client(),
+		&computev1alpha1.MachineList{},
+		&computev1alpha1.Machine{},
+		machineWatchResyncPeriod,
+		client.InNamespace(s.cluster.Namespace()),
+		s.ironcoreMachineMatchingLabels(req.Filter),
+	)
+	nicInformer := watch.NewInformer(
+		s.cluster.Client(),
+		&networkingv1alpha1.NetworkInterfaceList{},
+		&networkingv1alpha1.NetworkInterface{},
+		0,
+		client.InNamespace(s.cluster.Namespace()),
+	)
+	volumeInformer := watch.NewInformer(
+		s.cluster.Client(),
+		&storagev1alpha1.VolumeList{},
+		&storagev1alpha1.Volume{},
+		0,
+		client.InNamespace(s.cluster.Namespace()),
+	)
+
+	// The informers call their handlers concurrently, sending is serialized.
+	var mu sync.Mutex
+	send := func(typ irimeta.WatchEventType, ironcoreMachine *computev1alpha1.Machine) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return s.sendWatchMachineEvent(ctx, req, stream, typ, ironcoreMachine)
+	}
+	sendReferencing := func(references func(*computev1alpha1.Machine) bool) error {
+		if !machineInformer.HasSynced() {
+			return nil
+		}
+		for _, obj := range machineInformer.GetStore().List() {
+			ironcoreMachine := obj.(*computev1alpha1.Machine)
+			if !references(ironcoreMachine) {
+				continue
+			}
+			if err := send(irimeta.WatchEventType_WATCH_EVENT_MODIFIED, ironcoreMachine); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	log.V(1).Info("Watching ironcore machines")
+	g, ctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		return watch.Run(ctx, machineInformer, func(evt watch.Event[*computev1alpha1.Machine]) error {
+			return send(evt.Type, evt.Object)
+		})
+	})
+	g.Go(func() error {
+		return watch.Run(ctx, nicInformer, func(evt watch.Event[*networkingv1alpha1.NetworkInterface]) error {
+			if evt.IsInInitialList {
+				return nil
+			}
+			return sendReferencing(func(ironcoreMachine *computev1alpha1.Machine) bool {
+				return ironcoreMachineReferencesNetworkInterface(ironcoreMachine, evt.Object.Name)
+			})
+		})
+	})
+	g.Go(func() error {
+		return watch.Run(ctx, volumeInformer, func(evt watch.Event[*storagev1alpha1.Volume]) error {
+			if evt.IsInInitialList {
+				return nil
+			}
+			return sendReferencing(func(ironcoreMachine *computev1alpha1.Machine) bool {
+				return ironcoreMachineReferencesVolume(ironcoreMachine, evt.Object.Name)
+			})
+		})
+	})
+	return g.Wait()
+}
+
+func (s *Server) sendWatchMachineEvent(
+	ctx context.Context,
+	req *iri.WatchMachinesRequest,
+	stream iri.MachineRuntime_WatchMachinesServer,
+	typ irimeta.WatchEventType,
+	ironcoreMachine *computev1alpha1.Machine,
+) error {
+	log := s.loggerFrom(ctx)
+	if filter := req.Filter; filter != nil {
+		if filter.Id != "" && filter.Id != ironcoreMachine.Name {
+			return nil
+		}
+		if len(filter.LabelSelector) > 0 {
+			ok, err := s.ironcoreMachineMatchesLabelSelector(ironcoreMachine, filter.LabelSelector)
+			if err != nil {
+				log.V(1).Info("Error matching ironcore machine labels, skipping", "MachineID", ironcoreMachine.Name, "Error", err)
+				return nil
+			}
+			if !ok {
+				return nil
+			}
+		}
+	}
+
+	var machine *iri.Machine
+	if typ == irimeta.WatchEventType_WATCH_EVENT_DELETED {
+		metadata, err := apiutils.GetObjectMetadata(&ironcoreMachine.ObjectMeta)
+		if err != nil {
+			log.V(1).Info("Error getting ironcore machine metadata, skipping", "MachineID", ironcoreMachine.Name, "Error", err)
+			return nil
+		}
+		machine = &iri.Machine{Metadata: metadata}
+	} else {
+		aggregateIronCoreMachine, err := s.aggregateIronCoreMachine(ctx, s.cluster.Client(), ironcoreMachine)
+		if err != nil {
+			log.V(1).Info("Error aggregating ironcore machine, skipping", "MachineID", ironcoreMachine.Name, "Error", err)
+			return nil
+		}
+		machine, err = s.convertAggregateIronCoreMachine(aggregateIronCoreMachine)
+		if err != nil {
+			log.V(1).Info("Error converting ironcore machine, skipping", "MachineID", ironcoreMachine.Name, "Error", err)
+			return nil
+		}
+	}
+
+	return stream.Send(&iri.WatchMachinesResponse{
+		Type:            typ,
+		Machine:         machine,
+		ResourceVersion: ironcoreMachine.ResourceVersion,
+	})
+}
+
+func ironcoreMachineReferencesNetworkInterface(ironcoreMachine *computev1alpha1.Machine, name string) bool {
+	for _, machineNic := range ironcoreMachine.Spec.NetworkInterfaces {
+		if nicRef := machineNic.NetworkInterfaceRef; nicRef != nil && nicRef.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func ironcoreMachineReferencesVolume(ironcoreMachine *computev1alpha1.Machine, name string) bool {
+	for _, machineVolume := range ironcoreMachine.Spec.Volumes {
+		if volumeRef := machineVolume.VolumeRef; volumeRef != nil && volumeRef.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type watchMachinesServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *iri.WatchMachinesResponse
+}
+
+func (s *watchMachinesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchMachinesServer) Send(res *iri.WatchMachinesResponse) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.responses <- res:
+		return nil
+	}
+}
+
+var _ = Describe("WatchMachines", func() {
+	ns, srv := SetupTest()
+	machineClass := SetupMachineClass()
+
+	It("should send the changes of the machines", func(ctx SpecContext) {
+		By("creating a machine")
+		res, err := srv.CreateMachine(ctx, &iri.CreateMachineRequest{
+			Machine: &iri.Machine{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						machinepoolletv1alpha1.MachineUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.MachineSpec{
+					Power: iri.Power_POWER_ON,
+					Class: machineClass.Name,
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		machineID := res.Machine.Metadata.Id
+
+		By("watching the machines")
+		watchCtx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+		stream := &watchMachinesServer{ctx: watchCtx, responses: make(chan *iri.WatchMachinesResponse, 16)}
+		go func() {
+			defer GinkgoRecover()
+			_ = srv.WatchMachines(&iri.WatchMachinesRequest{}, stream)
+		}()
+
+		By("waiting for the machine to be reported as added")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_ADDED),
+			HaveField("Machine.Metadata.Id", machineID),
+			HaveField("ResourceVersion", Not(BeEmpty())),
+		)))
+
+		By("deleting the machine")
+		Expect(srv.DeleteMachine(ctx, &iri.DeleteMachineRequest{
+			MachineId: machineID,
+		})).Error().NotTo(HaveOccurred())
+
+		By("waiting for the machine to be reported as deleted")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_DELETED),
+			HaveField("Machine.Metadata.Id", machineID),
+		)))
+	})
+
+	It("should send the machines again once their network interfaces change", func(ctx SpecContext) {
+		By("creating a machine with a network interface")
+		res, err := srv.CreateMachine(ctx, &iri.CreateMachineRequest{
+			Machine: &iri.Machine{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						machinepoolletv1alpha1.MachineUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.MachineSpec{
+					Power: iri.Power_POWER_ON,
+					Class: machineClass.Name,
+					NetworkInterfaces: []*iri.NetworkInterface{
+						{
+							Name:      "primary-nic",
+							NetworkId: "network-id",
+							Ips:       []string{"10.0.0.1"},
+						},
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		machineID := res.Machine.Metadata.Id
+
+		By("watching the machines")
+		watchCtx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+		stream := &watchMachinesServer{ctx: watchCtx, responses: make(chan *iri.WatchMachinesResponse, 16)}
+		go func() {
+			defer GinkgoRecover()
+			_ = srv.WatchMachines(&iri.WatchMachinesRequest{}, stream)
+		}()
+
+		By("waiting for the machine to be reported as added")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_ADDED),
+			HaveField("Machine.Metadata.Id", machineID),
+		)))
+
+		By("changing the ironcore network interface of the machine")
+		ironcoreMachine := &computev1alpha1.Machine{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: ns.Name, Name: machineID}, ironcoreMachine)).To(Succeed())
+		Expect(ironcoreMachine.Spec.NetworkInterfaces).To(HaveLen(1))
+		Expect(ironcoreMachine.Spec.NetworkInterfaces[0].NetworkInterfaceRef).NotTo(BeNil())
+
+		ironcoreNic := &networkingv1alpha1.NetworkInterface{}
+		ironcoreNicKey := client.ObjectKey{Namespace: ns.Name, Name: ironcoreMachine.Spec.NetworkInterfaces[0].NetworkInterfaceRef.Name}
+		Expect(k8sClient.Get(ctx, ironcoreNicKey, ironcoreNic)).To(Succeed())
+		base := ironcoreNic.DeepCopy()
+		ironcoreNic.Status.State = networkingv1alpha1.NetworkInterfaceStateAvailable
+		Expect(k8sClient.Status().Patch(ctx, ironcoreNic, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the machine to be reported as modified")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_MODIFIED),
+			HaveField("Machine.Metadata.Id", machineID),
+		)))
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"github.com/ironcore-dev/ironcore/broker/common/request"
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/cluster"
 	"github.com/ironcore-dev/ironcore/broker/machinebroker/networks"
+	ironcore "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
 	"k8s.io/client-go/rest"
 )
@@ -22,6 +23,8 @@ var _ iri.MachineRuntimeServer = (*Server)(nil)
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/exec,verbs=get;create
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/consolelog,verbs=get
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/stats,verbs=get
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch;create;update;patch;delete
@@ -49,9 +52,13 @@ type Server struct {
 
 	cluster cluster.Cluster
 
+	// ironcoreClientset is used to access the subresources of ironcore machines, e.g. their stats.
+	ironcoreClientset ironcore.Interface
+
 	networks *networks.Manager
 
-	execRequestCache request.Cache[*iri.ExecRequest]
+	execRequestCache  request.Cache[*iri.ExecRequest]
+	machineStatsCache *machineStatsCache
 }
 
 type Options struct {
@@ -80,12 +87,19 @@ func New(cfg *rest.Config, namespace string, opts Options) (*Server, error) {
 		return nil, err
 	}
 
+	ironcoreClientset, err := ironcore.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ironcore api clientset for config: %w", err)
+	}
+
 	return &Server{
 		baseURL:                 baseURL,
 		brokerDownwardAPILabels: opts.BrokerDownwardAPILabels,
 		cluster:                 c,
+		ironcoreClientset:       ironcoreClientset,
 		networks:                networks.NewManager(c),
 		execRequestCache:        request.NewCache[*iri.ExecRequest](),
+		machineStatsCache:       newMachineStatsCache(),
 	}, nil
 }
 
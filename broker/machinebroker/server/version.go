@@ -11,6 +11,18 @@ import (
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
 )
 
+// runtimeCapabilities are the capabilities of the machinebroker. As it forwards all calls to ironcore,
+// it supports all of them.
+var runtimeCapabilities = []iri.RuntimeCapability{
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_WATCH,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_EXEC,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_NETWORK_INTERFACE_HOTPLUG,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_VOLUME_HOTPLUG,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_MACHINE_CLASS_RESIZE,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_STATS,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_FREEZE,
+}
+
 func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
 	var runtimeVersion string
 	switch {
@@ -30,5 +42,6 @@ func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.Ver
 	return &iri.VersionResponse{
 		RuntimeName:    version.RuntimeName,
 		RuntimeVersion: runtimeVersion,
+		Capabilities:   runtimeCapabilities,
 	}, nil
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/ironcore-dev/ironcore/broker/volumebroker/apiutils"
 	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 )
 
 const (
@@ -25,40 +26,51 @@ const (
 	InvolvedObjectAPIVersionSelector = "involvedObject.apiVersion"
 )
 
-func (s *Server) listEvents(ctx context.Context) ([]*irievent.Event, error) {
-	log := ctrl.LoggerFrom(ctx)
-	volumeEventList := &corev1.EventList{}
-	selectorField := fields.Set{
+func (s *Server) eventFieldSelector() fields.Selector {
+	return fields.Set{
 		InvolvedObjectKindSelector:       InvolvedObjectKind,
 		InvolvedObjectAPIVersionSelector: storagev1alpha1.SchemeGroupVersion.String(),
+	}.AsSelector()
+}
+
+func (s *Server) convertIronCoreEvent(ctx context.Context, volumeEvent *corev1.Event) (*irievent.Event, error) {
+	ironcoreVolume := &storagev1alpha1.Volume{}
+	if err := s.getManagedAndCreated(ctx, volumeEvent.InvolvedObject.Name, ironcoreVolume); err != nil {
+		return nil, err
 	}
+	volumeObjectMetadata, err := apiutils.GetObjectMetadata(&ironcoreVolume.ObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ironcore volume object metadata: %w", err)
+	}
+	return &irievent.Event{
+		Spec: &irievent.EventSpec{
+			InvolvedObjectMeta: volumeObjectMetadata,
+			Reason:             volumeEvent.Reason,
+			Message:            volumeEvent.Message,
+			Type:               volumeEvent.Type,
+			Action:             volumeEvent.Action,
+			EventTime:          volumeEvent.LastTimestamp.Unix(),
+		},
+	}, nil
+}
+
+func (s *Server) listEvents(ctx context.Context) ([]*irievent.Event, error) {
+	log := ctrl.LoggerFrom(ctx)
+	volumeEventList := &corev1.EventList{}
 	if err := s.client.List(ctx, volumeEventList,
-		client.InNamespace(s.namespace), client.MatchingFieldsSelector{Selector: selectorField.AsSelector()},
+		client.InNamespace(s.namespace), client.MatchingFieldsSelector{Selector: s.eventFieldSelector()},
 	); err != nil {
 		return nil, err
 	}
 
 	var iriEvents []*irievent.Event
-	for _, volumeEvent := range volumeEventList.Items {
-		ironcoreVolume := &storagev1alpha1.Volume{}
-		if err := s.getManagedAndCreated(ctx, volumeEvent.InvolvedObject.Name, ironcoreVolume); err != nil {
-			log.V(1).Info("Unable to get ironcore volume", "VolumeName", volumeEvent.InvolvedObject.Name)
-			continue
-		}
-		volumeObjectMetadata, err := apiutils.GetObjectMetadata(&ironcoreVolume.ObjectMeta)
+	for i := range volumeEventList.Items {
+		volumeEvent := &volumeEventList.Items[i]
+		iriEvent, err := s.convertIronCoreEvent(ctx, volumeEvent)
 		if err != nil {
+			log.V(1).Info("Unable to get ironcore volume", "VolumeName", volumeEvent.InvolvedObject.Name)
 			continue
 		}
-		iriEvent := &irievent.Event{
-			Spec: &irievent.EventSpec{
-				InvolvedObjectMeta: volumeObjectMetadata,
-				Reason:             volumeEvent.Reason,
-				Message:            volumeEvent.Message,
-				Type:               volumeEvent.Type,
-				Action:             volumeEvent.Action,
-				EventTime:          volumeEvent.LastTimestamp.Unix(),
-			},
-		}
 		iriEvents = append(iriEvents, iriEvent)
 	}
 	return iriEvents, nil
@@ -97,7 +109,13 @@ func (s *Server) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*i
 
 	iriEvents = s.filterEvents(iriEvents, req.Filter)
 
+	iriEvents, continueToken, err := paging.PageByOffset(iriEvents, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
+
 	return &iri.ListEventsResponse{
-		Events: iriEvents,
+		Events:        iriEvents,
+		ContinueToken: continueToken,
 	}, nil
 }
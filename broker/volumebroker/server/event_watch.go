@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"github.com/ironcore-dev/ironcore/broker/common/watch"
+	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WatchEvents implements iri.VolumeRuntimeServer on top of an informer.
+// Resuming from a resource version is not supported, only events occurring after the watch was started are sent.
+func (s *Server) WatchEvents(req *iri.WatchEventsRequest, stream iri.VolumeRuntime_WatchEventsServer) error {
+	ctx := stream.Context()
+	log := s.loggerFrom(ctx)
+
+	informer := watch.NewInformer(
+		s.client,
+		&corev1.EventList{},
+		&corev1.Event{},
+		0,
+		client.InNamespace(s.namespace),
+		client.MatchingFieldsSelector{Selector: s.eventFieldSelector()},
+	)
+
+	log.V(1).Info("Watching volume events")
+	return watch.Run(ctx, informer, func(evt watch.Event[*corev1.Event]) error {
+		if !watch.IsNewEventOccurrence(evt) {
+			return nil
+		}
+
+		iriEvent, err := s.convertIronCoreEvent(ctx, evt.Object)
+		if err != nil {
+			log.V(2).Info("Skipping event", "VolumeName", evt.Object.InvolvedObject.Name, "Error", err)
+			return nil
+		}
+		if len(s.filterEvents([]*irievent.Event{iriEvent}, req.Filter)) == 0 {
+			return nil
+		}
+
+		return stream.Send(&iri.WatchEventsResponse{
+			Event:           iriEvent,
+			ResourceVersion: evt.Object.ResourceVersion,
+		})
+	})
+}
@@ -42,7 +42,7 @@ func init() {
 type Server struct {
 	iri.UnimplementedVolumeRuntimeServer
 
-	client client.Client
+	client client.WithWatch
 	idGen  idgen.IDGen
 
 	brokerDownwardAPILabels map[string]string
@@ -105,7 +105,7 @@ var _ iri.VolumeRuntimeServer = (*Server)(nil)
 func New(cfg *rest.Config, opts Options) (*Server, error) {
 	setOptionsDefaults(&opts)
 
-	c, err := client.New(cfg, client.Options{
+	c, err := client.NewWithWatch(cfg, client.Options{
 		Scheme: scheme,
 	})
 	if err != nil {
@@ -11,6 +11,17 @@ import (
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
 )
 
+// runtimeCapabilities are the capabilities of the volumebroker. As it forwards all calls to ironcore,
+// it supports all of them except backups, as an object storage location cannot be forwarded as a Bucket.
+var runtimeCapabilities = []iri.RuntimeCapability{
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_WATCH,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_EXPAND,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_SNAPSHOTS,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_ENCRYPTION,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_CLONE,
+	iri.RuntimeCapability_RUNTIME_CAPABILITY_REVERT,
+}
+
 func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
 	var runtimeVersion string
 	switch {
@@ -30,5 +41,6 @@ func (s *Server) Version(ctx context.Context, req *iri.VersionRequest) (*iri.Ver
 	return &iri.VersionResponse{
 		RuntimeName:    version.RuntimeName,
 		RuntimeVersion: runtimeVersion,
+		Capabilities:   runtimeCapabilities,
 	}, nil
 }
@@ -94,6 +94,7 @@ func (s *Server) convertIronCoreVolumeEncryption(volume *AggregateIronCoreVolume
 func (s *Server) convertIronCoreVolumeDataSource(volume *AggregateIronCoreVolume) *iri.VolumeDataSource {
 	var imageDataSource *iri.ImageDataSource
 	var snapshotDataSource *iri.SnapshotDataSource
+	var cloneDataSource *iri.CloneDataSource
 
 	if osImage := volume.Volume.Spec.DataSource.OSImage; osImage != nil {
 		imageDataSource = &iri.ImageDataSource{
@@ -108,9 +109,16 @@ func (s *Server) convertIronCoreVolumeDataSource(volume *AggregateIronCoreVolume
 		}
 	}
 
+	if source := volume.Volume.Spec.DataSource.VolumeRef; source != nil {
+		cloneDataSource = &iri.CloneDataSource{
+			VolumeId: source.Name,
+		}
+	}
+
 	return &iri.VolumeDataSource{
 		ImageDataSource:    imageDataSource,
 		SnapshotDataSource: snapshotDataSource,
+		CloneDataSource:    cloneDataSource,
 	}
 }
 
@@ -12,6 +12,8 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	brokerutils "github.com/ironcore-dev/ironcore/broker/common/utils"
 	volumebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/volumebroker/api/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/utils/ptr"
 
 	"github.com/ironcore-dev/ironcore/broker/volumebroker/apiutils"
@@ -69,12 +71,17 @@ func (s *Server) getIronCoreVolumeConfig(_ context.Context, volume *iri.Volume)
 	labels[volumepoolletv1alpha1.VolumeUIDLabel] = volume.GetMetadata().GetLabels()[volumepoolletv1alpha1.VolumeUIDLabel]
 
 	var volumeSnapshotRef *corev1.LocalObjectReference
+	var volumeRef *corev1.LocalObjectReference
 	var osImageDataSource *storagev1alpha1.OSDataSource
 
 	if dataSource := volume.Spec.VolumeDataSource; dataSource != nil {
 		switch {
 		case dataSource.SnapshotDataSource != nil:
 			volumeSnapshotRef = &corev1.LocalObjectReference{Name: dataSource.SnapshotDataSource.SnapshotId}
+		case dataSource.CloneDataSource != nil:
+			volumeRef = &corev1.LocalObjectReference{Name: dataSource.CloneDataSource.VolumeId}
+		case dataSource.BackupDataSource != nil:
+			return nil, status.Errorf(codes.Unimplemented, "volume backups are not supported")
 		case dataSource.ImageDataSource != nil:
 			var architecture *string
 			if dataSource.ImageDataSource.Architecture != "" {
@@ -106,6 +113,7 @@ func (s *Server) getIronCoreVolumeConfig(_ context.Context, volume *iri.Volume)
 			Encryption: encryption,
 			DataSource: storagev1alpha1.VolumeDataSource{
 				VolumeSnapshotRef: volumeSnapshotRef,
+				VolumeRef:         volumeRef,
 				OSImage:           osImageDataSource,
 			},
 		},
@@ -6,71 +6,125 @@ package server
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	"github.com/ironcore-dev/ironcore/broker/common"
 	volumebrokerv1alpha1 "github.com/ironcore-dev/ironcore/broker/volumebroker/api/v1alpha1"
+	"github.com/ironcore-dev/ironcore/broker/volumebroker/apiutils"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
+	volumepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/volumepoollet/api/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (s *Server) listManagedAndCreated(ctx context.Context, ironcoreVolumeList *storagev1alpha1.VolumeList, filter *iri.VolumeFilter) error {
+func (s *Server) listManagedAndCreated(
+	ctx context.Context,
+	ironcoreVolumeList *storagev1alpha1.VolumeList,
+	filter *iri.VolumeFilter,
+	opts ...client.ListOption,
+) error {
+	if err := s.client.List(ctx, ironcoreVolumeList, append([]client.ListOption{
+		client.InNamespace(s.namespace),
+		s.managedAndCreatedMatchingLabels(filter),
+	}, opts...)...); err != nil {
+		return err
+	}
+
+	if filter == nil || len(filter.LabelSelector) == 0 {
+		return nil
+	}
+
+	var items []storagev1alpha1.Volume
+	for _, ironcoreVolume := range ironcoreVolumeList.Items {
+		ok, err := s.ironcoreVolumeMatchesLabelSelector(&ironcoreVolume, filter.LabelSelector)
+		if err != nil {
+			return fmt.Errorf("error matching ironcore volume %s labels: %w", ironcoreVolume.Name, err)
+		}
+		if ok {
+			items = append(items, ironcoreVolume)
+		}
+	}
+	ironcoreVolumeList.Items = items
+	return nil
+}
+
+// managedAndCreatedMatchingLabels returns the labels to list the ironcore volumes matching the filter with.
+// Of the label selector, only the volume uid label is mirrored onto the ironcore volumes, the
+// remaining labels have to be matched via ironcoreVolumeMatchesLabelSelector.
+func (s *Server) managedAndCreatedMatchingLabels(filter *iri.VolumeFilter) client.MatchingLabels {
 	matchingLabels := client.MatchingLabels{
 		volumebrokerv1alpha1.ManagerLabel: volumebrokerv1alpha1.VolumeBrokerManager,
 		volumebrokerv1alpha1.CreatedLabel: "true",
 	}
 
-	if filter != nil && filter.LabelSelector != nil {
-		for k := range filter.LabelSelector {
-			matchingLabels[k] = filter.LabelSelector[k]
+	if filter != nil {
+		if volumeUID, ok := filter.LabelSelector[volumepoolletv1alpha1.VolumeUIDLabel]; ok {
+			matchingLabels[volumepoolletv1alpha1.VolumeUIDLabel] = volumeUID
 		}
 	}
+	return matchingLabels
+}
 
-	return s.client.List(ctx, ironcoreVolumeList,
-		client.InNamespace(s.namespace),
-		matchingLabels,
-	)
+func (s *Server) ironcoreVolumeMatchesLabelSelector(ironcoreVolume *storagev1alpha1.Volume, labelSelector map[string]string) (bool, error) {
+	volumeLabels, err := apiutils.GetLabelsAnnotation(ironcoreVolume)
+	if err != nil {
+		return false, err
+	}
+	return labels.SelectorFromSet(labelSelector).Matches(labels.Set(volumeLabels)), nil
 }
 
-func (s *Server) listAggregateIronCoreVolumes(ctx context.Context, filter *iri.VolumeFilter) ([]AggregateIronCoreVolume, error) {
+func (s *Server) listAggregateIronCoreVolumes(
+	ctx context.Context,
+	filter *iri.VolumeFilter,
+	limit int64,
+	continueToken string,
+) ([]AggregateIronCoreVolume, string, error) {
 	ironcoreVolumeList := &storagev1alpha1.VolumeList{}
-	if err := s.listManagedAndCreated(ctx, ironcoreVolumeList, filter); err != nil {
-		return nil, fmt.Errorf("error listing ironcore volumes: %w", err)
+	if err := s.listManagedAndCreated(ctx, ironcoreVolumeList, filter, common.ListPageOptions(limit, continueToken)...); err != nil {
+		return nil, "", fmt.Errorf("error listing ironcore volumes: %w", common.ConvertListPageError(err, continueToken))
 	}
 
-	secretList := &corev1.SecretList{}
-	if err := s.client.List(ctx, secretList,
-		client.InNamespace(s.namespace),
-	); err != nil {
-		return nil, fmt.Errorf("error listing secrets: %w", err)
-	}
+	// The secrets of a single page are fetched one by one, listing them up front only
+	// pays off when aggregating all volumes.
+	getSecret := s.clientGetSecretFunc(ctx)
+	if limit == 0 {
+		secretList := &corev1.SecretList{}
+		if err := s.client.List(ctx, secretList,
+			client.InNamespace(s.namespace),
+		); err != nil {
+			return nil, "", fmt.Errorf("error listing secrets: %w", err)
+		}
 
-	secretByNameGetter, err := common.NewObjectGetter[string, *corev1.Secret](
-		corev1.Resource("secrets"),
-		common.ByObjectName[*corev1.Secret](),
-		common.ObjectSlice[string](secretList.Items),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error constructing secret getter: %w", err)
+		secretByNameGetter, err := common.NewObjectGetter[string, *corev1.Secret](
+			corev1.Resource("secrets"),
+			common.ByObjectName[*corev1.Secret](),
+			common.ObjectSlice[string](secretList.Items),
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("error constructing secret getter: %w", err)
+		}
+		getSecret = secretByNameGetter.Get
 	}
 
 	var res []AggregateIronCoreVolume
 	for i := range ironcoreVolumeList.Items {
 		ironcoreVolume := &ironcoreVolumeList.Items[i]
 
-		aggregateIronCoreVolume, err := s.aggregateIronCoreVolume(ironcoreVolume, secretByNameGetter.Get)
+		aggregateIronCoreVolume, err := s.aggregateIronCoreVolume(ironcoreVolume, getSecret)
 		if err != nil {
-			return nil, fmt.Errorf("error aggregating ironcore volume %s: %w", ironcoreVolume.Name, err)
+			return nil, "", fmt.Errorf("error aggregating ironcore volume %s: %w", ironcoreVolume.Name, err)
 		}
 
 		res = append(res, *aggregateIronCoreVolume)
 	}
 
-	return res, nil
+	return res, ironcoreVolumeList.Continue, nil
 }
 
 func (s *Server) clientGetSecretFunc(ctx context.Context) func(string) (*corev1.Secret, error) {
@@ -150,22 +204,41 @@ func (s *Server) getAggregateIronCoreVolume(ctx context.Context, id string) (*Ag
 	return s.aggregateIronCoreVolume(ironcoreVolume, s.clientGetSecretFunc(ctx))
 }
 
-func (s *Server) listVolumes(ctx context.Context, filter *iri.VolumeFilter) ([]*iri.Volume, error) {
-	ironcoreVolumes, err := s.listAggregateIronCoreVolumes(ctx, filter)
+func (s *Server) listVolumes(ctx context.Context, filter *iri.VolumeFilter, limit int64, continueToken string) ([]*iri.Volume, string, error) {
+	ironcoreVolumes, nextContinueToken, err := s.listAggregateIronCoreVolumes(ctx, filter, limit, continueToken)
 	if err != nil {
-		return nil, fmt.Errorf("error listing volumes: %w", err)
+		return nil, "", fmt.Errorf("error listing volumes: %w", err)
 	}
 
 	var res []*iri.Volume
 	for _, ironcoreVolume := range ironcoreVolumes {
 		volume, err := s.convertAggregateIronCoreVolume(&ironcoreVolume)
 		if err != nil {
-			return nil, err
+			return nil, "", err
+		}
+
+		res = append(res, volume)
+	}
+	return res, nextContinueToken, nil
+}
+
+func (s *Server) filterVolumesByStateAndClass(volumes []*iri.Volume, filter *iri.VolumeFilter) []*iri.Volume {
+	if filter == nil || (len(filter.States) == 0 && len(filter.Classes) == 0) {
+		return volumes
+	}
+
+	var res []*iri.Volume
+	for _, volume := range volumes {
+		if len(filter.States) > 0 && !slices.Contains(filter.States, volume.GetStatus().GetState()) {
+			continue
+		}
+		if len(filter.Classes) > 0 && !slices.Contains(filter.Classes, volume.GetSpec().GetClass()) {
+			continue
 		}
 
 		res = append(res, volume)
 	}
-	return res, nil
+	return res
 }
 
 func (s *Server) getVolume(ctx context.Context, id string) (*iri.Volume, error) {
@@ -177,7 +250,18 @@ func (s *Server) getVolume(ctx context.Context, id string) (*iri.Volume, error)
 	return s.convertAggregateIronCoreVolume(ironcoreVolume)
 }
 
+// ListVolumes lists a page of volumes. The limit and continue token are passed on to the API server,
+// so only the volumes of the requested page are aggregated. Since the label selector, state and class
+// filters are applied afterward, a page may hold fewer volumes than the limit.
 func (s *Server) ListVolumes(ctx context.Context, req *iri.ListVolumesRequest) (*iri.ListVolumesResponse, error) {
+	if err := paging.ValidateLimit(req.Limit); err != nil {
+		return nil, err
+	}
+
+	var (
+		volumes       []*iri.Volume
+		continueToken string
+	)
 	if filter := req.Filter; filter != nil && filter.Id != "" {
 		volume, err := s.getVolume(ctx, filter.Id)
 		if err != nil {
@@ -189,17 +273,17 @@ func (s *Server) ListVolumes(ctx context.Context, req *iri.ListVolumesRequest) (
 			}, nil
 		}
 
-		return &iri.ListVolumesResponse{
-			Volumes: []*iri.Volume{volume},
-		}, nil
-	}
-
-	volumes, err := s.listVolumes(ctx, req.Filter)
-	if err != nil {
-		return nil, err
+		volumes = []*iri.Volume{volume}
+	} else {
+		var err error
+		volumes, continueToken, err = s.listVolumes(ctx, req.Filter, req.Limit, req.ContinueToken)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &iri.ListVolumesResponse{
-		Volumes: volumes,
+		Volumes:       s.filterVolumesByStateAndClass(volumes, req.Filter),
+		ContinueToken: continueToken,
 	}, nil
 }
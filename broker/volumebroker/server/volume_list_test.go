@@ -12,6 +12,8 @@ import (
 	volumepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/volumepoollet/api/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -49,6 +51,67 @@ var _ = Describe("ListVolumes", func() {
 		Expect(srv.ListVolumes(ctx, &iri.ListVolumesRequest{})).To(HaveField("Volumes", ConsistOf(Volumes...)))
 	})
 
+	It("should page and filter volumes", func(ctx SpecContext) {
+		By("creating multiple volumes")
+		const noOfVolumes = 3
+
+		volumes := make([]any, noOfVolumes)
+		for i := 0; i < noOfVolumes; i++ {
+			res, err := srv.CreateVolume(ctx, &iri.CreateVolumeRequest{
+				Volume: &iri.Volume{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels: map[string]string{
+							volumepoolletv1alpha1.VolumeUIDLabel: "foobar",
+						},
+					},
+					Spec: &iri.VolumeSpec{
+						Class: volumeClass.Name,
+						Resources: &iri.VolumeResources{
+							StorageBytes: 100,
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			volumes[i] = res.Volume
+		}
+
+		By("listing the volumes page by page")
+		var (
+			listed        []*iri.Volume
+			continueToken string
+		)
+		for {
+			res, err := srv.ListVolumes(ctx, &iri.ListVolumesRequest{Limit: 2, ContinueToken: continueToken})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(res.Volumes)).To(BeNumerically("<=", 2))
+			listed = append(listed, res.Volumes...)
+
+			if res.ContinueToken == "" {
+				break
+			}
+			continueToken = res.ContinueToken
+		}
+		Expect(listed).To(ConsistOf(volumes...))
+
+		By("rejecting an invalid continue token")
+		_, err := srv.ListVolumes(ctx, &iri.ListVolumesRequest{Limit: 2, ContinueToken: "invalid"})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		By("filtering the volumes by class")
+		Expect(srv.ListVolumes(ctx, &iri.ListVolumesRequest{
+			Filter: &iri.VolumeFilter{Classes: []string{volumeClass.Name}},
+		})).To(HaveField("Volumes", ConsistOf(volumes...)))
+		Expect(srv.ListVolumes(ctx, &iri.ListVolumesRequest{
+			Filter: &iri.VolumeFilter{Classes: []string{"unknown"}},
+		})).To(HaveField("Volumes", BeEmpty()))
+
+		By("filtering the volumes by state")
+		Expect(srv.ListVolumes(ctx, &iri.ListVolumesRequest{
+			Filter: &iri.VolumeFilter{States: []iri.VolumeState{iri.VolumeState_VOLUME_ERROR}},
+		})).To(HaveField("Volumes", BeEmpty()))
+	})
+
 	It("should set volume uid label to all existing volumes", func(ctx SpecContext) {
 		By("creating multiple volumes")
 		res, err := srv.CreateVolume(ctx, &iri.CreateVolumeRequest{
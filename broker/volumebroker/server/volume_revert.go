@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// volumeRevertPollInterval is the interval in which the ironcore volume is checked for the revert
+	// to be applied.
+	volumeRevertPollInterval = 1 * time.Second
+	// volumeRevertTimeout is the time RevertVolume waits for the revert to be applied before the
+	// caller has to retry.
+	volumeRevertTimeout = 1 * time.Minute
+)
+
+// RevertVolume requests the revert of the ironcore volume and waits for it to be applied.
+// Calling it again for the same volume snapshot while the revert is pending does not request
+// another revert but keeps waiting for the pending one.
+func (s *Server) RevertVolume(ctx context.Context, req *iri.RevertVolumeRequest) (*iri.RevertVolumeResponse, error) {
+	volumeID := req.VolumeId
+	volumeSnapshotID := req.VolumeSnapshotId
+	log := s.loggerFrom(ctx, "VolumeID", volumeID, "VolumeSnapshotID", volumeSnapshotID)
+
+	log.V(1).Info("Getting ironcore volume")
+	ironcoreVolume, err := s.getAggregateIronCoreVolume(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(1).Info("Getting ironcore volume snapshot")
+	ironcoreVolumeSnapshot, err := s.getIronCoreVolumeSnapshot(ctx, volumeSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if volumeRef := ironcoreVolumeSnapshot.Spec.VolumeRef; volumeRef == nil || volumeRef.Name != ironcoreVolume.Volume.Name {
+		return nil, status.Errorf(codes.InvalidArgument, "volume snapshot %s is not a snapshot of volume %s", volumeSnapshotID, volumeID)
+	}
+	if ironcoreVolumeSnapshot.Status.State != storagev1alpha1.VolumeSnapshotStateReady {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume snapshot %s is not ready", volumeSnapshotID)
+	}
+
+	if revert := ironcoreVolume.Volume.Spec.Revert; revert != nil &&
+		revert.VolumeSnapshotRef.Name == ironcoreVolumeSnapshot.Name &&
+		ironCoreVolumeRevertPending(ironcoreVolume.Volume) {
+		log.V(1).Info("Revert to volume snapshot already requested, waiting for it")
+	} else {
+		base := ironcoreVolume.Volume.DeepCopy()
+		ironcoreVolume.Volume.Spec.Revert = &storagev1alpha1.VolumeRevert{
+			VolumeSnapshotRef: corev1.LocalObjectReference{Name: ironcoreVolumeSnapshot.Name},
+			RequestedAt:       metav1.Now(),
+		}
+		log.V(1).Info("Patching ironcore volume revert")
+		if err := s.client.Patch(ctx, ironcoreVolume.Volume, client.MergeFrom(base)); err != nil {
+			return nil, fmt.Errorf("error patching ironcore volume revert: %w", err)
+		}
+	}
+
+	log.V(1).Info("Waiting for ironcore volume revert")
+	if err := s.waitForIronCoreVolumeRevert(ctx, ironcoreVolume.Volume); err != nil {
+		return nil, err
+	}
+
+	return &iri.RevertVolumeResponse{}, nil
+}
+
+// ironCoreVolumeRevertPending reports whether the revert requested by the ironcore volume has neither
+// been applied nor failed yet.
+func ironCoreVolumeRevertPending(volume *storagev1alpha1.Volume) bool {
+	revert := volume.Spec.Revert
+	if lastApplied := volume.Status.LastAppliedRevert; lastApplied != nil && lastApplied.RequestedAt.Equal(&revert.RequestedAt) {
+		return false
+	}
+
+	cond := storagev1alpha1.FindVolumeCondition(volume.Status.Conditions, storagev1alpha1.VolumeReverting)
+	return cond == nil ||
+		cond.Status != corev1.ConditionFalse ||
+		cond.Reason != storagev1alpha1.VolumeRevertingReasonFailed ||
+		cond.ObservedGeneration < volume.Generation
+}
+
+// waitForIronCoreVolumeRevert waits until the revert requested by the ironcore volume has been applied.
+// A failed revert is reported as FailedPrecondition, a revert that is still pending after
+// volumeRevertTimeout as DeadlineExceeded so the caller retries.
+func (s *Server) waitForIronCoreVolumeRevert(ctx context.Context, volume *storagev1alpha1.Volume) error {
+	if err := wait.PollUntilContextTimeout(ctx, volumeRevertPollInterval, volumeRevertTimeout, true, func(ctx context.Context) (bool, error) {
+		if err := s.client.Get(ctx, client.ObjectKeyFromObject(volume), volume); err != nil {
+			return false, fmt.Errorf("error getting ironcore volume: %w", err)
+		}
+		return volume.Spec.Revert == nil || !ironCoreVolumeRevertPending(volume), nil
+	}); err != nil {
+		if wait.Interrupted(err) {
+			return status.Errorf(codes.DeadlineExceeded, "volume %s has not been reverted yet", volume.Name)
+		}
+		return err
+	}
+
+	if revert := volume.Spec.Revert; revert == nil {
+		return status.Errorf(codes.Aborted, "revert of volume %s has been cancelled", volume.Name)
+	}
+	if lastApplied := volume.Status.LastAppliedRevert; lastApplied == nil || !lastApplied.RequestedAt.Equal(&volume.Spec.Revert.RequestedAt) {
+		message := "revert failed"
+		if cond := storagev1alpha1.FindVolumeCondition(volume.Status.Conditions, storagev1alpha1.VolumeReverting); cond != nil && cond.Message != "" {
+			message = cond.Message
+		}
+		return status.Errorf(codes.FailedPrecondition, "error reverting volume %s: %s", volume.Name, message)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	volumepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/volumepoollet/api/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RevertVolume", func() {
+	ns, srv := SetupTest()
+	volumeClass := SetupVolumeClass()
+
+	It("should request a revert of the volume to a ready snapshot", func(ctx SpecContext) {
+		By("creating a volume")
+		createRes, err := srv.CreateVolume(ctx, &iri.CreateVolumeRequest{
+			Volume: &iri.Volume{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						volumepoolletv1alpha1.VolumeUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.VolumeSpec{
+					Class: volumeClass.Name,
+					Resources: &iri.VolumeResources{
+						StorageBytes: 100,
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		volumeID := createRes.Volume.Metadata.Id
+
+		By("creating a volume snapshot")
+		snapshotRes, err := srv.CreateVolumeSnapshot(ctx, &iri.CreateVolumeSnapshotRequest{
+			VolumeSnapshot: &iri.VolumeSnapshot{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						volumepoolletv1alpha1.VolumeSnapshotUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.VolumeSnapshotSpec{
+					VolumeId: volumeID,
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		volumeSnapshotID := snapshotRes.VolumeSnapshot.Metadata.Id
+
+		By("reverting the volume to the pending snapshot")
+		_, err = srv.RevertVolume(ctx, &iri.RevertVolumeRequest{
+			VolumeId:         volumeID,
+			VolumeSnapshotId: volumeSnapshotID,
+		})
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+		By("marking the ironcore volume snapshot as ready")
+		ironcoreVolumeSnapshot := &storagev1alpha1.VolumeSnapshot{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: ns.Name, Name: volumeSnapshotID}, ironcoreVolumeSnapshot)).To(Succeed())
+		base := ironcoreVolumeSnapshot.DeepCopy()
+		ironcoreVolumeSnapshot.Status.State = storagev1alpha1.VolumeSnapshotStateReady
+		size := resource.MustParse("100")
+		ironcoreVolumeSnapshot.Status.Size = &size
+		Expect(k8sClient.Status().Patch(ctx, ironcoreVolumeSnapshot, client.MergeFrom(base))).To(Succeed())
+
+		By("reverting the volume")
+		revertErr := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			_, err := srv.RevertVolume(ctx, &iri.RevertVolumeRequest{
+				VolumeId:         volumeID,
+				VolumeSnapshotId: volumeSnapshotID,
+			})
+			revertErr <- err
+		}()
+
+		By("waiting for the revert to be requested on the ironcore volume")
+		ironcoreVolume := &storagev1alpha1.Volume{}
+		Eventually(func(g Gomega) {
+			g.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: ns.Name, Name: volumeID}, ironcoreVolume)).To(Succeed())
+			g.Expect(ironcoreVolume.Spec.Revert).NotTo(BeNil())
+		}).Should(Succeed())
+		Expect(ironcoreVolume.Spec.Revert.VolumeSnapshotRef.Name).To(Equal(volumeSnapshotID))
+		Expect(ironcoreVolume.Spec.Revert.RequestedAt.IsZero()).To(BeFalse())
+		Expect(ironcoreVolume.Spec.Resources).To(Equal(corev1alpha1.ResourceList{
+			corev1alpha1.ResourceStorage: resource.MustParse("100"),
+		}))
+
+		By("asserting the revert does not return before it is applied")
+		Consistently(revertErr).ShouldNot(Receive())
+
+		By("applying the revert on the ironcore volume")
+		volumeBase := ironcoreVolume.DeepCopy()
+		ironcoreVolume.Status.LastAppliedRevert = ironcoreVolume.Spec.Revert.DeepCopy()
+		Expect(k8sClient.Status().Patch(ctx, ironcoreVolume, client.MergeFrom(volumeBase))).To(Succeed())
+
+		By("waiting for the revert to return")
+		Eventually(revertErr).Should(Receive(BeNil()))
+	})
+
+	It("should reject reverting a volume to a snapshot of another volume", func(ctx SpecContext) {
+		By("creating two volumes")
+		var volumeIDs []string
+		for range 2 {
+			createRes, err := srv.CreateVolume(ctx, &iri.CreateVolumeRequest{
+				Volume: &iri.Volume{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels: map[string]string{
+							volumepoolletv1alpha1.VolumeUIDLabel: "foobar",
+						},
+					},
+					Spec: &iri.VolumeSpec{
+						Class: volumeClass.Name,
+						Resources: &iri.VolumeResources{
+							StorageBytes: 100,
+						},
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			volumeIDs = append(volumeIDs, createRes.Volume.Metadata.Id)
+		}
+
+		By("creating a volume snapshot of the first volume")
+		snapshotRes, err := srv.CreateVolumeSnapshot(ctx, &iri.CreateVolumeSnapshotRequest{
+			VolumeSnapshot: &iri.VolumeSnapshot{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						volumepoolletv1alpha1.VolumeSnapshotUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.VolumeSnapshotSpec{
+					VolumeId: volumeIDs[0],
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("reverting the second volume to the snapshot")
+		_, err = srv.RevertVolume(ctx, &iri.RevertVolumeRequest{
+			VolumeId:         volumeIDs[1],
+			VolumeSnapshotId: snapshotRes.VolumeSnapshot.Metadata.Id,
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"time"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/broker/common/watch"
+	"github.com/ironcore-dev/ironcore/broker/volumebroker/apiutils"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// volumeWatchResyncPeriod is the period in which all watched volumes are aggregated and sent again,
+// picking up changes of the secrets the volumes reference.
+const volumeWatchResyncPeriod = 1 * time.Minute
+
+// WatchVolumes implements iri.VolumeRuntimeServer on top of an informer.
+// Resuming from a resource version is not supported, the current volumes are always sent first.
+func (s *Server) WatchVolumes(req *iri.WatchVolumesRequest, stream iri.VolumeRuntime_WatchVolumesServer) error {
+	ctx := stream.Context()
+	log := s.loggerFrom(ctx)
+
+	informer := watch.NewInformer(
+		s.client,
+		&storagev1alpha1.VolumeList{},
+		&storagev1alpha1.Volume{},
+		volumeWatchResyncPeriod,
+		client.InNamespace(s.namespace),
+		s.managedAndCreatedMatchingLabels(req.Filter),
+	)
+
+	log.V(1).Info("Watching ironcore volumes")
+	return watch.Run(ctx, informer, func(evt watch.Event[*storagev1alpha1.Volume]) error {
+		ironcoreVolume := evt.Object
+		if filter := req.Filter; filter != nil {
+			if filter.Id != "" && filter.Id != ironcoreVolume.Name {
+				return nil
+			}
+			if len(filter.LabelSelector) > 0 {
+				ok, err := s.ironcoreVolumeMatchesLabelSelector(ironcoreVolume, filter.LabelSelector)
+				if err != nil {
+					log.V(1).Info("Error matching ironcore volume labels, skipping", "VolumeID", ironcoreVolume.Name, "Error", err)
+					return nil
+				}
+				if !ok {
+					return nil
+				}
+			}
+		}
+
+		var volume *iri.Volume
+		if evt.Type == irimeta.WatchEventType_WATCH_EVENT_DELETED {
+			metadata, err := apiutils.GetObjectMetadata(&ironcoreVolume.ObjectMeta)
+			if err != nil {
+				log.V(1).Info("Error getting ironcore volume metadata, skipping", "VolumeID", ironcoreVolume.Name, "Error", err)
+				return nil
+			}
+			volume = &iri.Volume{Metadata: metadata}
+		} else {
+			aggregateIronCoreVolume, err := s.aggregateIronCoreVolume(ironcoreVolume, s.clientGetSecretFunc(ctx))
+			if err != nil {
+				log.V(1).Info("Error aggregating ironcore volume, skipping", "VolumeID", ironcoreVolume.Name, "Error", err)
+				return nil
+			}
+			volume, err = s.convertAggregateIronCoreVolume(aggregateIronCoreVolume)
+			if err != nil {
+				log.V(1).Info("Error converting ironcore volume, skipping", "VolumeID", ironcoreVolume.Name, "Error", err)
+				return nil
+			}
+		}
+
+		return stream.Send(&iri.WatchVolumesResponse{
+			Type:            evt.Type,
+			Volume:          volume,
+			ResourceVersion: ironcoreVolume.ResourceVersion,
+		})
+	})
+}
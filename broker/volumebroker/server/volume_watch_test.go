@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server_test
+
+import (
+	"context"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	volumepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/volumepoollet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+type watchVolumesServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *iri.WatchVolumesResponse
+}
+
+func (s *watchVolumesServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchVolumesServer) Send(res *iri.WatchVolumesResponse) error {
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.responses <- res:
+		return nil
+	}
+}
+
+var _ = Describe("WatchVolumes", func() {
+	_, srv := SetupTest()
+	volumeClass := SetupVolumeClass()
+
+	It("should send the changes of the volumes", func(ctx SpecContext) {
+		By("creating a volume")
+		res, err := srv.CreateVolume(ctx, &iri.CreateVolumeRequest{
+			Volume: &iri.Volume{
+				Metadata: &irimeta.ObjectMetadata{
+					Labels: map[string]string{
+						volumepoolletv1alpha1.VolumeUIDLabel: "foobar",
+					},
+				},
+				Spec: &iri.VolumeSpec{
+					Class: volumeClass.Name,
+					Resources: &iri.VolumeResources{
+						StorageBytes: 100,
+					},
+				},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		volumeID := res.Volume.Metadata.Id
+
+		By("watching the volumes")
+		watchCtx, cancel := context.WithCancel(ctx)
+		DeferCleanup(cancel)
+		stream := &watchVolumesServer{ctx: watchCtx, responses: make(chan *iri.WatchVolumesResponse, 16)}
+		go func() {
+			defer GinkgoRecover()
+			_ = srv.WatchVolumes(&iri.WatchVolumesRequest{}, stream)
+		}()
+
+		By("waiting for the volume to be reported as added")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_ADDED),
+			HaveField("Volume.Metadata.Id", volumeID),
+			HaveField("ResourceVersion", Not(BeEmpty())),
+		)))
+
+		By("deleting the volume")
+		Expect(srv.DeleteVolume(ctx, &iri.DeleteVolumeRequest{
+			VolumeId: volumeID,
+		})).Error().NotTo(HaveOccurred())
+
+		By("waiting for the volume to be reported as deleted")
+		Eventually(stream.responses).Should(Receive(SatisfyAll(
+			HaveField("Type", irimeta.WatchEventType_WATCH_EVENT_DELETED),
+			HaveField("Volume.Metadata.Id", volumeID),
+		)))
+	})
+})
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AffinityApplyConfiguration represents a declarative configuration of the Affinity type for use
+// with apply.
+//
+// Affinity is a group of affinity scheduling rules of a Machine.
+type AffinityApplyConfiguration struct {
+	// MachineAffinity describes rules to co-locate the machine with other machines.
+	MachineAffinity *MachineAffinityApplyConfiguration `json:"machineAffinity,omitempty"`
+	// MachineAntiAffinity describes rules to keep the machine apart from other machines.
+	MachineAntiAffinity *MachineAntiAffinityApplyConfiguration `json:"machineAntiAffinity,omitempty"`
+}
+
+// AffinityApplyConfiguration constructs a declarative configuration of the Affinity type for use with
+// apply.
+func Affinity() *AffinityApplyConfiguration {
+	return &AffinityApplyConfiguration{}
+}
+
+// WithMachineAffinity sets the MachineAffinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineAffinity field is set to the value of the last call.
+func (b *AffinityApplyConfiguration) WithMachineAffinity(value *MachineAffinityApplyConfiguration) *AffinityApplyConfiguration {
+	b.MachineAffinity = value
+	return b
+}
+
+// WithMachineAntiAffinity sets the MachineAntiAffinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineAntiAffinity field is set to the value of the last call.
+func (b *AffinityApplyConfiguration) WithMachineAntiAffinity(value *MachineAntiAffinityApplyConfiguration) *AffinityApplyConfiguration {
+	b.MachineAntiAffinity = value
+	return b
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineAffinityTermApplyConfiguration represents a declarative configuration of the MachineAffinityTerm type for use
+// with apply.
+//
+// MachineAffinityTerm selects a set of machines the machine should be co-located with (affinity)
+// or not co-located with (anti-affinity). Co-located is defined as running on a machine pool whose
+// value of the label with key TopologyKey matches that of any machine pool a selected machine runs on.
+type MachineAffinityTermApplyConfiguration struct {
+	// LabelSelector selects the machines the term applies to.
+	LabelSelector *v1.LabelSelectorApplyConfiguration `json:"labelSelector,omitempty"`
+	// Namespaces are the namespaces the LabelSelector applies to.
+	// If empty, the namespace of the machine is used.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// TopologyKey is the key of machine pool labels defining the topology domains.
+	// MachinePoolTopologyKey puts every machine pool into its own topology domain.
+	TopologyKey *string `json:"topologyKey,omitempty"`
+}
+
+// MachineAffinityTermApplyConfiguration constructs a declarative configuration of the MachineAffinityTerm type for use with
+// apply.
+func MachineAffinityTerm() *MachineAffinityTermApplyConfiguration {
+	return &MachineAffinityTermApplyConfiguration{}
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *MachineAffinityTermApplyConfiguration) WithLabelSelector(value *v1.LabelSelectorApplyConfiguration) *MachineAffinityTermApplyConfiguration {
+	b.LabelSelector = value
+	return b
+}
+
+// WithNamespaces adds the given value to the Namespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Namespaces field.
+func (b *MachineAffinityTermApplyConfiguration) WithNamespaces(values ...string) *MachineAffinityTermApplyConfiguration {
+	for i := range values {
+		b.Namespaces = append(b.Namespaces, values[i])
+	}
+	return b
+}
+
+// WithTopologyKey sets the TopologyKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyKey field is set to the value of the last call.
+func (b *MachineAffinityTermApplyConfiguration) WithTopologyKey(value string) *MachineAffinityTermApplyConfiguration {
+	b.TopologyKey = &value
+	return b
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MachineAntiAffinityApplyConfiguration represents a declarative configuration of the MachineAntiAffinity type for use
+// with apply.
+//
+// MachineAntiAffinity is a group of machine anti-affinity scheduling rules.
+type MachineAntiAffinityApplyConfiguration struct {
+	// RequiredDuringSchedulingIgnoredDuringExecution are the terms that have to be satisfied
+	// to schedule the machine onto a machine pool. All terms have to be satisfied.
+	RequiredDuringSchedulingIgnoredDuringExecution []MachineAffinityTermApplyConfiguration `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// PreferredDuringSchedulingIgnoredDuringExecution are the terms the scheduler prefers to satisfy.
+	// Machine pools satisfying terms with a higher sum of weights are preferred.
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedMachineAffinityTermApplyConfiguration `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// MachineAntiAffinityApplyConfiguration constructs a declarative configuration of the MachineAntiAffinity type for use with
+// apply.
+func MachineAntiAffinity() *MachineAntiAffinityApplyConfiguration {
+	return &MachineAntiAffinityApplyConfiguration{}
+}
+
+// WithRequiredDuringSchedulingIgnoredDuringExecution adds the given value to the RequiredDuringSchedulingIgnoredDuringExecution field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RequiredDuringSchedulingIgnoredDuringExecution field.
+func (b *MachineAntiAffinityApplyConfiguration) WithRequiredDuringSchedulingIgnoredDuringExecution(values ...*MachineAffinityTermApplyConfiguration) *MachineAntiAffinityApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRequiredDuringSchedulingIgnoredDuringExecution")
+		}
+		b.RequiredDuringSchedulingIgnoredDuringExecution = append(b.RequiredDuringSchedulingIgnoredDuringExecution, *values[i])
+	}
+	return b
+}
+
+// WithPreferredDuringSchedulingIgnoredDuringExecution adds the given value to the PreferredDuringSchedulingIgnoredDuringExecution field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PreferredDuringSchedulingIgnoredDuringExecution field.
+func (b *MachineAntiAffinityApplyConfiguration) WithPreferredDuringSchedulingIgnoredDuringExecution(values ...*WeightedMachineAffinityTermApplyConfiguration) *MachineAntiAffinityApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPreferredDuringSchedulingIgnoredDuringExecution")
+		}
+		b.PreferredDuringSchedulingIgnoredDuringExecution = append(b.PreferredDuringSchedulingIgnoredDuringExecution, *values[i])
+	}
+	return b
+}
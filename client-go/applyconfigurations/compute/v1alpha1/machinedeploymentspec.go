@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineDeploymentSpecApplyConfiguration represents a declarative configuration of the MachineDeploymentSpec type for use
+// with apply.
+//
+// MachineDeploymentSpec defines the desired state of MachineDeployment
+type MachineDeploymentSpecApplyConfiguration struct {
+	// Replicas is the number of desired machines. Defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Selector is a label query over machines of the MachineDeployment.
+	// It must match the labels of the template.
+	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// Template is the template of the machines created by the MachineDeployment.
+	Template *MachineTemplateSpecApplyConfiguration `json:"template,omitempty"`
+	// Strategy is the strategy used to replace old machines by new ones.
+	Strategy *MachineDeploymentStrategyApplyConfiguration `json:"strategy,omitempty"`
+	// RevisionHistoryLimit is the number of old, scaled down MachineSets to retain for a rollback.
+	// Defaults to 10.
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+}
+
+// MachineDeploymentSpecApplyConfiguration constructs a declarative configuration of the MachineDeploymentSpec type for use with
+// apply.
+func MachineDeploymentSpec() *MachineDeploymentSpecApplyConfiguration {
+	return &MachineDeploymentSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithReplicas(value int32) *MachineDeploymentSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithTemplate(value *MachineTemplateSpecApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
+
+// WithStrategy sets the Strategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Strategy field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithStrategy(value *MachineDeploymentStrategyApplyConfiguration) *MachineDeploymentSpecApplyConfiguration {
+	b.Strategy = value
+	return b
+}
+
+// WithRevisionHistoryLimit sets the RevisionHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevisionHistoryLimit field is set to the value of the last call.
+func (b *MachineDeploymentSpecApplyConfiguration) WithRevisionHistoryLimit(value int32) *MachineDeploymentSpecApplyConfiguration {
+	b.RevisionHistoryLimit = &value
+	return b
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MachineDeploymentStatusApplyConfiguration represents a declarative configuration of the MachineDeploymentStatus type for use
+// with apply.
+//
+// MachineDeploymentStatus defines the observed state of MachineDeployment
+type MachineDeploymentStatusApplyConfiguration struct {
+	// ObservedGeneration is the most recent generation observed for this MachineDeployment.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// Replicas is the number of machines owned by the MachineSets of the MachineDeployment.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// UpdatedReplicas is the number of machines created from the current template.
+	UpdatedReplicas *int32 `json:"updatedReplicas,omitempty"`
+	// ReadyReplicas is the number of running machines of the MachineDeployment.
+	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+	// UnavailableReplicas is the number of desired machines that are not yet running.
+	UnavailableReplicas *int32 `json:"unavailableReplicas,omitempty"`
+}
+
+// MachineDeploymentStatusApplyConfiguration constructs a declarative configuration of the MachineDeploymentStatus type for use with
+// apply.
+func MachineDeploymentStatus() *MachineDeploymentStatusApplyConfiguration {
+	return &MachineDeploymentStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithObservedGeneration(value int64) *MachineDeploymentStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithUpdatedReplicas sets the UpdatedReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpdatedReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithUpdatedReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.UpdatedReplicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithReadyReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
+
+// WithUnavailableReplicas sets the UnavailableReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UnavailableReplicas field is set to the value of the last call.
+func (b *MachineDeploymentStatusApplyConfiguration) WithUnavailableReplicas(value int32) *MachineDeploymentStatusApplyConfiguration {
+	b.UnavailableReplicas = &value
+	return b
+}
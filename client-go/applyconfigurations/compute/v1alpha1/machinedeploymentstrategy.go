@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+)
+
+// MachineDeploymentStrategyApplyConfiguration represents a declarative configuration of the MachineDeploymentStrategy type for use
+// with apply.
+//
+// MachineDeploymentStrategy describes how to replace existing machines with new ones.
+type MachineDeploymentStrategyApplyConfiguration struct {
+	// Type is the type of the strategy. Defaults to RollingUpdate.
+	Type *computev1alpha1.MachineDeploymentStrategyType `json:"type,omitempty"`
+	// RollingUpdate configures the RollingUpdate strategy. Only allowed if Type is RollingUpdate.
+	RollingUpdate *RollingUpdateMachineDeploymentApplyConfiguration `json:"rollingUpdate,omitempty"`
+}
+
+// MachineDeploymentStrategyApplyConfiguration constructs a declarative configuration of the MachineDeploymentStrategy type for use with
+// apply.
+func MachineDeploymentStrategy() *MachineDeploymentStrategyApplyConfiguration {
+	return &MachineDeploymentStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *MachineDeploymentStrategyApplyConfiguration) WithType(value computev1alpha1.MachineDeploymentStrategyType) *MachineDeploymentStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithRollingUpdate sets the RollingUpdate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollingUpdate field is set to the value of the last call.
+func (b *MachineDeploymentStrategyApplyConfiguration) WithRollingUpdate(value *RollingUpdateMachineDeploymentApplyConfiguration) *MachineDeploymentStrategyApplyConfiguration {
+	b.RollingUpdate = value
+	return b
+}
@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	internal "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/internal"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineDisruptionBudgetApplyConfiguration represents a declarative configuration of the MachineDisruptionBudget type for use
+// with apply.
+//
+// MachineDisruptionBudget limits the number of machines of a replicated application that are
+// down simultaneously due to voluntary disruptions, i.e. evictions.
+type MachineDisruptionBudgetApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration    `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                             *MachineDisruptionBudgetSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *MachineDisruptionBudgetStatusApplyConfiguration `json:"status,omitempty"`
+}
+
+// MachineDisruptionBudget constructs a declarative configuration of the MachineDisruptionBudget type for use with
+// apply.
+func MachineDisruptionBudget(name, namespace string) *MachineDisruptionBudgetApplyConfiguration {
+	b := &MachineDisruptionBudgetApplyConfiguration{}
+	b.WithName(name)
+	b.WithNamespace(namespace)
+	b.WithKind("MachineDisruptionBudget")
+	b.WithAPIVersion("compute.ironcore.dev/v1alpha1")
+	return b
+}
+
+// ExtractMachineDisruptionBudgetFrom extracts the applied configuration owned by fieldManager from
+// machineDisruptionBudget for the specified subresource. Pass an empty string for subresource to extract
+// the main resource. Common subresources include "status", "scale", etc.
+// machineDisruptionBudget must be a unmodified MachineDisruptionBudget API object that was retrieved from the Kubernetes API.
+// ExtractMachineDisruptionBudgetFrom provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractMachineDisruptionBudgetFrom(machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget, fieldManager string, subresource string) (*MachineDisruptionBudgetApplyConfiguration, error) {
+	b := &MachineDisruptionBudgetApplyConfiguration{}
+	err := managedfields.ExtractInto(machineDisruptionBudget, internal.Parser().Type("com.github.ironcore-dev.ironcore.api.compute.v1alpha1.MachineDisruptionBudget"), fieldManager, b, subresource)
+	if err != nil {
+		return nil, err
+	}
+	b.WithName(machineDisruptionBudget.Name)
+	b.WithNamespace(machineDisruptionBudget.Namespace)
+
+	b.WithKind("MachineDisruptionBudget")
+	b.WithAPIVersion("compute.ironcore.dev/v1alpha1")
+	return b, nil
+}
+
+// ExtractMachineDisruptionBudget extracts the applied configuration owned by fieldManager from
+// machineDisruptionBudget. If no managedFields are found in machineDisruptionBudget for fieldManager, a
+// MachineDisruptionBudgetApplyConfiguration is returned with only the Name, Namespace (if applicable),
+// APIVersion and Kind populated. It is possible that no managed fields were found for because other
+// field managers have taken ownership of all the fields previously owned by fieldManager, or because
+// the fieldManager never owned fields any fields.
+// machineDisruptionBudget must be a unmodified MachineDisruptionBudget API object that was retrieved from the Kubernetes API.
+// ExtractMachineDisruptionBudget provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractMachineDisruptionBudget(machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget, fieldManager string) (*MachineDisruptionBudgetApplyConfiguration, error) {
+	return ExtractMachineDisruptionBudgetFrom(machineDisruptionBudget, fieldManager, "")
+}
+
+// ExtractMachineDisruptionBudgetStatus extracts the applied configuration owned by fieldManager from
+// machineDisruptionBudget for the status subresource.
+func ExtractMachineDisruptionBudgetStatus(machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget, fieldManager string) (*MachineDisruptionBudgetApplyConfiguration, error) {
+	return ExtractMachineDisruptionBudgetFrom(machineDisruptionBudget, fieldManager, "status")
+}
+
+func (b MachineDisruptionBudgetApplyConfiguration) IsApplyConfiguration() {}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithKind(value string) *MachineDisruptionBudgetApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithAPIVersion(value string) *MachineDisruptionBudgetApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithName(value string) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithGenerateName(value string) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithNamespace(value string) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithUID(value types.UID) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithResourceVersion(value string) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithGeneration(value int64) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithCreationTimestamp(value metav1.Time) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithLabels(entries map[string]string) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Labels == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithAnnotations(entries map[string]string) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.ObjectMetaApplyConfiguration.OwnerReferences = append(b.ObjectMetaApplyConfiguration.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithFinalizers(values ...string) *MachineDisruptionBudgetApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.ObjectMetaApplyConfiguration.Finalizers = append(b.ObjectMetaApplyConfiguration.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *MachineDisruptionBudgetApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithSpec(value *MachineDisruptionBudgetSpecApplyConfiguration) *MachineDisruptionBudgetApplyConfiguration {
+	b.Spec = value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *MachineDisruptionBudgetApplyConfiguration) WithStatus(value *MachineDisruptionBudgetStatusApplyConfiguration) *MachineDisruptionBudgetApplyConfiguration {
+	b.Status = value
+	return b
+}
+
+// GetKind retrieves the value of the Kind field in the declarative configuration.
+func (b *MachineDisruptionBudgetApplyConfiguration) GetKind() *string {
+	return b.TypeMetaApplyConfiguration.Kind
+}
+
+// GetAPIVersion retrieves the value of the APIVersion field in the declarative configuration.
+func (b *MachineDisruptionBudgetApplyConfiguration) GetAPIVersion() *string {
+	return b.TypeMetaApplyConfiguration.APIVersion
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *MachineDisruptionBudgetApplyConfiguration) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Name
+}
+
+// GetNamespace retrieves the value of the Namespace field in the declarative configuration.
+func (b *MachineDisruptionBudgetApplyConfiguration) GetNamespace() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Namespace
+}
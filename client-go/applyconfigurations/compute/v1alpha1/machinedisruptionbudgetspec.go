@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineDisruptionBudgetSpecApplyConfiguration represents a declarative configuration of the MachineDisruptionBudgetSpec type for use
+// with apply.
+//
+// MachineDisruptionBudgetSpec defines the desired state of MachineDisruptionBudget
+type MachineDisruptionBudgetSpecApplyConfiguration struct {
+	// Selector selects the machines the budget applies to.
+	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// MinAvailable is the number or percentage of selected machines that have to remain available
+	// after an eviction. Mutually exclusive with MaxUnavailable.
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of selected machines that may be unavailable
+	// after an eviction. Mutually exclusive with MinAvailable.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// MachineDisruptionBudgetSpecApplyConfiguration constructs a declarative configuration of the MachineDisruptionBudgetSpec type for use with
+// apply.
+func MachineDisruptionBudgetSpec() *MachineDisruptionBudgetSpecApplyConfiguration {
+	return &MachineDisruptionBudgetSpecApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *MachineDisruptionBudgetSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *MachineDisruptionBudgetSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithMinAvailable sets the MinAvailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinAvailable field is set to the value of the last call.
+func (b *MachineDisruptionBudgetSpecApplyConfiguration) WithMinAvailable(value intstr.IntOrString) *MachineDisruptionBudgetSpecApplyConfiguration {
+	b.MinAvailable = &value
+	return b
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *MachineDisruptionBudgetSpecApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *MachineDisruptionBudgetSpecApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineDisruptionBudgetStatusApplyConfiguration represents a declarative configuration of the MachineDisruptionBudgetStatus type for use
+// with apply.
+//
+// MachineDisruptionBudgetStatus defines the observed state of MachineDisruptionBudget
+type MachineDisruptionBudgetStatusApplyConfiguration struct {
+	// ObservedGeneration is the most recent generation observed for this MachineDisruptionBudget.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// DisruptedMachines contains the machines whose eviction was accepted but that have not yet been
+	// observed as deleted, mapped to the time of their eviction.
+	DisruptedMachines map[string]v1.Time `json:"disruptedMachines,omitempty"`
+	// DisruptionsAllowed is the number of machine disruptions that are currently allowed.
+	DisruptionsAllowed *int32 `json:"disruptionsAllowed,omitempty"`
+	// CurrentHealthy is the number of currently healthy, i.e. running, selected machines.
+	CurrentHealthy *int32 `json:"currentHealthy,omitempty"`
+	// DesiredHealthy is the minimum desired number of healthy selected machines.
+	DesiredHealthy *int32 `json:"desiredHealthy,omitempty"`
+	// ExpectedMachines is the total number of selected machines.
+	ExpectedMachines *int32 `json:"expectedMachines,omitempty"`
+}
+
+// MachineDisruptionBudgetStatusApplyConfiguration constructs a declarative configuration of the MachineDisruptionBudgetStatus type for use with
+// apply.
+func MachineDisruptionBudgetStatus() *MachineDisruptionBudgetStatusApplyConfiguration {
+	return &MachineDisruptionBudgetStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MachineDisruptionBudgetStatusApplyConfiguration) WithObservedGeneration(value int64) *MachineDisruptionBudgetStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithDisruptedMachines puts the entries into the DisruptedMachines field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the DisruptedMachines field,
+// overwriting an existing map entries in DisruptedMachines field with the same key.
+func (b *MachineDisruptionBudgetStatusApplyConfiguration) WithDisruptedMachines(entries map[string]v1.Time) *MachineDisruptionBudgetStatusApplyConfiguration {
+	if b.DisruptedMachines == nil && len(entries) > 0 {
+		b.DisruptedMachines = make(map[string]v1.Time, len(entries))
+	}
+	for k, v := range entries {
+		b.DisruptedMachines[k] = v
+	}
+	return b
+}
+
+// WithDisruptionsAllowed sets the DisruptionsAllowed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisruptionsAllowed field is set to the value of the last call.
+func (b *MachineDisruptionBudgetStatusApplyConfiguration) WithDisruptionsAllowed(value int32) *MachineDisruptionBudgetStatusApplyConfiguration {
+	b.DisruptionsAllowed = &value
+	return b
+}
+
+// WithCurrentHealthy sets the CurrentHealthy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentHealthy field is set to the value of the last call.
+func (b *MachineDisruptionBudgetStatusApplyConfiguration) WithCurrentHealthy(value int32) *MachineDisruptionBudgetStatusApplyConfiguration {
+	b.CurrentHealthy = &value
+	return b
+}
+
+// WithDesiredHealthy sets the DesiredHealthy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DesiredHealthy field is set to the value of the last call.
+func (b *MachineDisruptionBudgetStatusApplyConfiguration) WithDesiredHealthy(value int32) *MachineDisruptionBudgetStatusApplyConfiguration {
+	b.DesiredHealthy = &value
+	return b
+}
+
+// WithExpectedMachines sets the ExpectedMachines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpectedMachines field is set to the value of the last call.
+func (b *MachineDisruptionBudgetStatusApplyConfiguration) WithExpectedMachines(value int32) *MachineDisruptionBudgetStatusApplyConfiguration {
+	b.ExpectedMachines = &value
+	return b
+}
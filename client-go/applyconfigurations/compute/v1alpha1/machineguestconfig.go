@@ -5,6 +5,10 @@
 
 package v1alpha1
 
+import (
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+)
+
 // MachineGuestConfigApplyConfiguration represents a declarative configuration of the MachineGuestConfig type for use
 // with apply.
 //
@@ -12,6 +16,17 @@ package v1alpha1
 type MachineGuestConfigApplyConfiguration struct {
 	// Hostname is the desired hostname of the machine.
 	Hostname *string `json:"hostname,omitempty"`
+	// SSHPublicKeyRefs are references to secrets containing SSH public keys authorized to log into the machine.
+	// If key is empty, DefaultSSHPublicKeyKey will be used as fallback.
+	SSHPublicKeyRefs []commonv1alpha1.SecretKeySelector `json:"sshPublicKeyRefs,omitempty"`
+	// Timezone is the IANA time zone of the machine, e.g. Europe/Berlin.
+	Timezone *string `json:"timezone,omitempty"`
+	// NTPServers are the NTP servers the machine synchronizes its clock with.
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// UserDataRef is a reference to a secret containing cloud-init user data for the machine.
+	// Mutually exclusive with MachineSpec.IgnitionRef.
+	// If key is empty, DefaultUserDataKey will be used as fallback.
+	UserDataRef *commonv1alpha1.SecretKeySelector `json:"userDataRef,omitempty"`
 }
 
 // MachineGuestConfigApplyConfiguration constructs a declarative configuration of the MachineGuestConfig type for use with
@@ -27,3 +42,39 @@ func (b *MachineGuestConfigApplyConfiguration) WithHostname(value string) *Machi
 	b.Hostname = &value
 	return b
 }
+
+// WithSSHPublicKeyRefs adds the given value to the SSHPublicKeyRefs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SSHPublicKeyRefs field.
+func (b *MachineGuestConfigApplyConfiguration) WithSSHPublicKeyRefs(values ...commonv1alpha1.SecretKeySelector) *MachineGuestConfigApplyConfiguration {
+	for i := range values {
+		b.SSHPublicKeyRefs = append(b.SSHPublicKeyRefs, values[i])
+	}
+	return b
+}
+
+// WithTimezone sets the Timezone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timezone field is set to the value of the last call.
+func (b *MachineGuestConfigApplyConfiguration) WithTimezone(value string) *MachineGuestConfigApplyConfiguration {
+	b.Timezone = &value
+	return b
+}
+
+// WithNTPServers adds the given value to the NTPServers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NTPServers field.
+func (b *MachineGuestConfigApplyConfiguration) WithNTPServers(values ...string) *MachineGuestConfigApplyConfiguration {
+	for i := range values {
+		b.NTPServers = append(b.NTPServers, values[i])
+	}
+	return b
+}
+
+// WithUserDataRef sets the UserDataRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserDataRef field is set to the value of the last call.
+func (b *MachineGuestConfigApplyConfiguration) WithUserDataRef(value commonv1alpha1.SecretKeySelector) *MachineGuestConfigApplyConfiguration {
+	b.UserDataRef = &value
+	return b
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineHealthCheckSpecApplyConfiguration represents a declarative configuration of the MachineHealthCheckSpec type for use
+// with apply.
+//
+// MachineHealthCheckSpec defines the desired state of MachineHealthCheck
+type MachineHealthCheckSpecApplyConfiguration struct {
+	// Selector selects the machines to check.
+	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// UnhealthyStates are the machine states that render a machine unhealthy after a timeout.
+	UnhealthyStates []UnhealthyMachineStateApplyConfiguration `json:"unhealthyStates,omitempty"`
+	// UnhealthyConditions are the machine conditions that render a machine unhealthy after a timeout.
+	UnhealthyConditions []UnhealthyMachineConditionApplyConfiguration `json:"unhealthyConditions,omitempty"`
+	// MaxUnhealthy is the number or percentage of selected machines that may be unhealthy for
+	// remediation to take place. If more machines are unhealthy, remediation is stopped.
+	// Defaults to 100%.
+	MaxUnhealthy *intstr.IntOrString `json:"maxUnhealthy,omitempty"`
+	// RemediationStrategy is the strategy used to remediate unhealthy machines.
+	// Defaults to Recreate.
+	RemediationStrategy *computev1alpha1.MachineRemediationStrategy `json:"remediationStrategy,omitempty"`
+}
+
+// MachineHealthCheckSpecApplyConfiguration constructs a declarative configuration of the MachineHealthCheckSpec type for use with
+// apply.
+func MachineHealthCheckSpec() *MachineHealthCheckSpecApplyConfiguration {
+	return &MachineHealthCheckSpecApplyConfiguration{}
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *MachineHealthCheckSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *MachineHealthCheckSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithUnhealthyStates adds the given value to the UnhealthyStates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnhealthyStates field.
+func (b *MachineHealthCheckSpecApplyConfiguration) WithUnhealthyStates(values ...*UnhealthyMachineStateApplyConfiguration) *MachineHealthCheckSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnhealthyStates")
+		}
+		b.UnhealthyStates = append(b.UnhealthyStates, *values[i])
+	}
+	return b
+}
+
+// WithUnhealthyConditions adds the given value to the UnhealthyConditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnhealthyConditions field.
+func (b *MachineHealthCheckSpecApplyConfiguration) WithUnhealthyConditions(values ...*UnhealthyMachineConditionApplyConfiguration) *MachineHealthCheckSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnhealthyConditions")
+		}
+		b.UnhealthyConditions = append(b.UnhealthyConditions, *values[i])
+	}
+	return b
+}
+
+// WithMaxUnhealthy sets the MaxUnhealthy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnhealthy field is set to the value of the last call.
+func (b *MachineHealthCheckSpecApplyConfiguration) WithMaxUnhealthy(value intstr.IntOrString) *MachineHealthCheckSpecApplyConfiguration {
+	b.MaxUnhealthy = &value
+	return b
+}
+
+// WithRemediationStrategy sets the RemediationStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemediationStrategy field is set to the value of the last call.
+func (b *MachineHealthCheckSpecApplyConfiguration) WithRemediationStrategy(value computev1alpha1.MachineRemediationStrategy) *MachineHealthCheckSpecApplyConfiguration {
+	b.RemediationStrategy = &value
+	return b
+}
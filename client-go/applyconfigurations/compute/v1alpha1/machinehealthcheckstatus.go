@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MachineHealthCheckStatusApplyConfiguration represents a declarative configuration of the MachineHealthCheckStatus type for use
+// with apply.
+//
+// MachineHealthCheckStatus defines the observed state of MachineHealthCheck
+type MachineHealthCheckStatusApplyConfiguration struct {
+	// ObservedGeneration is the most recent generation observed for this MachineHealthCheck.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// ExpectedMachines is the total number of selected machines.
+	ExpectedMachines *int32 `json:"expectedMachines,omitempty"`
+	// CurrentHealthy is the number of selected machines that are not considered unhealthy.
+	CurrentHealthy *int32 `json:"currentHealthy,omitempty"`
+	// RemediationsAllowed is the number of further unhealthy machines that may be remediated
+	// before MaxUnhealthy is exceeded.
+	RemediationsAllowed *int32 `json:"remediationsAllowed,omitempty"`
+	// UnhealthyMachines are the selected machines that are considered unhealthy.
+	UnhealthyMachines []UnhealthyMachineApplyConfiguration `json:"unhealthyMachines,omitempty"`
+	// Conditions are the conditions of the MachineHealthCheck.
+	Conditions []MachineHealthCheckConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// MachineHealthCheckStatusApplyConfiguration constructs a declarative configuration of the MachineHealthCheckStatus type for use with
+// apply.
+func MachineHealthCheckStatus() *MachineHealthCheckStatusApplyConfiguration {
+	return &MachineHealthCheckStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MachineHealthCheckStatusApplyConfiguration) WithObservedGeneration(value int64) *MachineHealthCheckStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithExpectedMachines sets the ExpectedMachines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpectedMachines field is set to the value of the last call.
+func (b *MachineHealthCheckStatusApplyConfiguration) WithExpectedMachines(value int32) *MachineHealthCheckStatusApplyConfiguration {
+	b.ExpectedMachines = &value
+	return b
+}
+
+// WithCurrentHealthy sets the CurrentHealthy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentHealthy field is set to the value of the last call.
+func (b *MachineHealthCheckStatusApplyConfiguration) WithCurrentHealthy(value int32) *MachineHealthCheckStatusApplyConfiguration {
+	b.CurrentHealthy = &value
+	return b
+}
+
+// WithRemediationsAllowed sets the RemediationsAllowed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemediationsAllowed field is set to the value of the last call.
+func (b *MachineHealthCheckStatusApplyConfiguration) WithRemediationsAllowed(value int32) *MachineHealthCheckStatusApplyConfiguration {
+	b.RemediationsAllowed = &value
+	return b
+}
+
+// WithUnhealthyMachines adds the given value to the UnhealthyMachines field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnhealthyMachines field.
+func (b *MachineHealthCheckStatusApplyConfiguration) WithUnhealthyMachines(values ...*UnhealthyMachineApplyConfiguration) *MachineHealthCheckStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithUnhealthyMachines")
+		}
+		b.UnhealthyMachines = append(b.UnhealthyMachines, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *MachineHealthCheckStatusApplyConfiguration) WithConditions(values ...*MachineHealthCheckConditionApplyConfiguration) *MachineHealthCheckStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineIOFreezeApplyConfiguration represents a declarative configuration of the MachineIOFreeze type for use
+// with apply.
+//
+// MachineIOFreeze is a request to freeze the I/O of a Machine.
+type MachineIOFreezeApplyConfiguration struct {
+	// RequestedAt is the time the freeze was requested at.
+	RequestedAt *v1.Time `json:"requestedAt,omitempty"`
+	// Timeout is the duration after RequestedAt after which the I/O is thawed again.
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
+
+// MachineIOFreezeApplyConfiguration constructs a declarative configuration of the MachineIOFreeze type for use with
+// apply.
+func MachineIOFreeze() *MachineIOFreezeApplyConfiguration {
+	return &MachineIOFreezeApplyConfiguration{}
+}
+
+// WithRequestedAt sets the RequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedAt field is set to the value of the last call.
+func (b *MachineIOFreezeApplyConfiguration) WithRequestedAt(value v1.Time) *MachineIOFreezeApplyConfiguration {
+	b.RequestedAt = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *MachineIOFreezeApplyConfiguration) WithTimeout(value v1.Duration) *MachineIOFreezeApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
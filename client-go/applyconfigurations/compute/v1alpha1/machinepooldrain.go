@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachinePoolDrainApplyConfiguration represents a declarative configuration of the MachinePoolDrain type for use
+// with apply.
+//
+// MachinePoolDrain is a request to evict all Machines from a MachinePool.
+type MachinePoolDrainApplyConfiguration struct {
+	// RequestedAt is the time the drain was requested. Changing it restarts the drain.
+	RequestedAt *v1.Time `json:"requestedAt,omitempty"`
+	// EvictionTimeout is the time to wait for an evicted Machine to be gone before
+	// evicting the next Machine. Defaults to 5 minutes.
+	EvictionTimeout *v1.Duration `json:"evictionTimeout,omitempty"`
+}
+
+// MachinePoolDrainApplyConfiguration constructs a declarative configuration of the MachinePoolDrain type for use with
+// apply.
+func MachinePoolDrain() *MachinePoolDrainApplyConfiguration {
+	return &MachinePoolDrainApplyConfiguration{}
+}
+
+// WithRequestedAt sets the RequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedAt field is set to the value of the last call.
+func (b *MachinePoolDrainApplyConfiguration) WithRequestedAt(value v1.Time) *MachinePoolDrainApplyConfiguration {
+	b.RequestedAt = &value
+	return b
+}
+
+// WithEvictionTimeout sets the EvictionTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EvictionTimeout field is set to the value of the last call.
+func (b *MachinePoolDrainApplyConfiguration) WithEvictionTimeout(value v1.Duration) *MachinePoolDrainApplyConfiguration {
+	b.EvictionTimeout = &value
+	return b
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachinePoolDrainStatusApplyConfiguration represents a declarative configuration of the MachinePoolDrainStatus type for use
+// with apply.
+//
+// MachinePoolDrainStatus reports the progress of a MachinePool drain.
+type MachinePoolDrainStatusApplyConfiguration struct {
+	// State is the state of the drain.
+	State *computev1alpha1.MachinePoolDrainState `json:"state,omitempty"`
+	// ObservedRequestedAt is the requestedAt time of the drain this status refers to.
+	ObservedRequestedAt *v1.Time `json:"observedRequestedAt,omitempty"`
+	// StartTime is the time the drain was started.
+	StartTime *v1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time all Machines had been evicted.
+	CompletionTime *v1.Time `json:"completionTime,omitempty"`
+	// RemainingMachines is the number of Machines still bound to the MachinePool.
+	RemainingMachines *int32 `json:"remainingMachines,omitempty"`
+}
+
+// MachinePoolDrainStatusApplyConfiguration constructs a declarative configuration of the MachinePoolDrainStatus type for use with
+// apply.
+func MachinePoolDrainStatus() *MachinePoolDrainStatusApplyConfiguration {
+	return &MachinePoolDrainStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *MachinePoolDrainStatusApplyConfiguration) WithState(value computev1alpha1.MachinePoolDrainState) *MachinePoolDrainStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithObservedRequestedAt sets the ObservedRequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedRequestedAt field is set to the value of the last call.
+func (b *MachinePoolDrainStatusApplyConfiguration) WithObservedRequestedAt(value v1.Time) *MachinePoolDrainStatusApplyConfiguration {
+	b.ObservedRequestedAt = &value
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *MachinePoolDrainStatusApplyConfiguration) WithStartTime(value v1.Time) *MachinePoolDrainStatusApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *MachinePoolDrainStatusApplyConfiguration) WithCompletionTime(value v1.Time) *MachinePoolDrainStatusApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithRemainingMachines sets the RemainingMachines field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemainingMachines field is set to the value of the last call.
+func (b *MachinePoolDrainStatusApplyConfiguration) WithRemainingMachines(value int32) *MachinePoolDrainStatusApplyConfiguration {
+	b.RemainingMachines = &value
+	return b
+}
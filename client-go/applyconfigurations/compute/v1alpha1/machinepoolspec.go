@@ -19,6 +19,11 @@ type MachinePoolSpecApplyConfiguration struct {
 	// Taints of the MachinePool. Only Machines who tolerate all the taints
 	// will land in the MachinePool.
 	Taints []commonv1alpha1.Taint `json:"taints,omitempty"`
+	// Unschedulable marks the MachinePool as unschedulable. No new Machines are scheduled onto an
+	// unschedulable MachinePool, while the Machines already running on it are not affected.
+	Unschedulable *bool `json:"unschedulable,omitempty"`
+	// Drain requests all Machines to be evicted from the MachinePool. A draining MachinePool is unschedulable.
+	Drain *MachinePoolDrainApplyConfiguration `json:"drain,omitempty"`
 }
 
 // MachinePoolSpecApplyConfiguration constructs a declarative configuration of the MachinePoolSpec type for use with
@@ -44,3 +49,19 @@ func (b *MachinePoolSpecApplyConfiguration) WithTaints(values ...commonv1alpha1.
 	}
 	return b
 }
+
+// WithUnschedulable sets the Unschedulable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Unschedulable field is set to the value of the last call.
+func (b *MachinePoolSpecApplyConfiguration) WithUnschedulable(value bool) *MachinePoolSpecApplyConfiguration {
+	b.Unschedulable = &value
+	return b
+}
+
+// WithDrain sets the Drain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Drain field is set to the value of the last call.
+func (b *MachinePoolSpecApplyConfiguration) WithDrain(value *MachinePoolDrainApplyConfiguration) *MachinePoolSpecApplyConfiguration {
+	b.Drain = value
+	return b
+}
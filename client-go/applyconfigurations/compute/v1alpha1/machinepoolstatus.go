@@ -25,6 +25,8 @@ type MachinePoolStatusApplyConfiguration struct {
 	Capacity *corev1alpha1.ResourceList `json:"capacity,omitempty"`
 	// Allocatable represents the resources of a machine pool that are available for scheduling.
 	Allocatable *corev1alpha1.ResourceList `json:"allocatable,omitempty"`
+	// Drain reports the progress of the requested drain of the MachinePool.
+	Drain *MachinePoolDrainStatusApplyConfiguration `json:"drain,omitempty"`
 }
 
 // MachinePoolStatusApplyConfiguration constructs a declarative configuration of the MachinePoolStatus type for use with
@@ -100,3 +102,11 @@ func (b *MachinePoolStatusApplyConfiguration) WithAllocatable(value corev1alpha1
 	b.Allocatable = &value
 	return b
 }
+
+// WithDrain sets the Drain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Drain field is set to the value of the last call.
+func (b *MachinePoolStatusApplyConfiguration) WithDrain(value *MachinePoolDrainStatusApplyConfiguration) *MachinePoolStatusApplyConfiguration {
+	b.Drain = value
+	return b
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineRestartApplyConfiguration represents a declarative configuration of the MachineRestart type for use
+// with apply.
+//
+// MachineRestart is a request to restart a Machine.
+type MachineRestartApplyConfiguration struct {
+	// RequestedAt is the time the restart was requested at.
+	RequestedAt *v1.Time `json:"requestedAt,omitempty"`
+	// Mode is the mode to restart the machine with.
+	// Defaults to RestartModeSoft.
+	Mode *computev1alpha1.RestartMode `json:"mode,omitempty"`
+}
+
+// MachineRestartApplyConfiguration constructs a declarative configuration of the MachineRestart type for use with
+// apply.
+func MachineRestart() *MachineRestartApplyConfiguration {
+	return &MachineRestartApplyConfiguration{}
+}
+
+// WithRequestedAt sets the RequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedAt field is set to the value of the last call.
+func (b *MachineRestartApplyConfiguration) WithRequestedAt(value v1.Time) *MachineRestartApplyConfiguration {
+	b.RequestedAt = &value
+	return b
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *MachineRestartApplyConfiguration) WithMode(value computev1alpha1.RestartMode) *MachineRestartApplyConfiguration {
+	b.Mode = &value
+	return b
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// MachineSetSpecApplyConfiguration represents a declarative configuration of the MachineSetSpec type for use
+// with apply.
+//
+// MachineSetSpec defines the desired state of MachineSet
+type MachineSetSpecApplyConfiguration struct {
+	// Replicas is the number of desired machines. Defaults to 1.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Selector is a label query over machines that should match the replica count.
+	// It must match the labels of the template.
+	Selector *v1.LabelSelectorApplyConfiguration `json:"selector,omitempty"`
+	// Template is the template of the machines created by the MachineSet.
+	Template *MachineTemplateSpecApplyConfiguration `json:"template,omitempty"`
+}
+
+// MachineSetSpecApplyConfiguration constructs a declarative configuration of the MachineSetSpec type for use with
+// apply.
+func MachineSetSpec() *MachineSetSpecApplyConfiguration {
+	return &MachineSetSpecApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithReplicas(value int32) *MachineSetSpecApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithSelector(value *v1.LabelSelectorApplyConfiguration) *MachineSetSpecApplyConfiguration {
+	b.Selector = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *MachineSetSpecApplyConfiguration) WithTemplate(value *MachineTemplateSpecApplyConfiguration) *MachineSetSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MachineSetStatusApplyConfiguration represents a declarative configuration of the MachineSetStatus type for use
+// with apply.
+//
+// MachineSetStatus defines the observed state of MachineSet
+type MachineSetStatusApplyConfiguration struct {
+	// ObservedGeneration is the most recent generation observed for this MachineSet.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// Replicas is the number of machines currently owned by the MachineSet.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// ReadyReplicas is the number of running machines owned by the MachineSet.
+	ReadyReplicas *int32 `json:"readyReplicas,omitempty"`
+}
+
+// MachineSetStatusApplyConfiguration constructs a declarative configuration of the MachineSetStatus type for use with
+// apply.
+func MachineSetStatus() *MachineSetStatusApplyConfiguration {
+	return &MachineSetStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithObservedGeneration(value int64) *MachineSetStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithReplicas(value int32) *MachineSetStatusApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
+
+// WithReadyReplicas sets the ReadyReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReadyReplicas field is set to the value of the last call.
+func (b *MachineSetStatusApplyConfiguration) WithReadyReplicas(value int32) *MachineSetStatusApplyConfiguration {
+	b.ReadyReplicas = &value
+	return b
+}
@@ -40,6 +40,22 @@ type MachineSpecApplyConfiguration struct {
 	Tolerations []commonv1alpha1.Toleration `json:"tolerations,omitempty"`
 	// GuestConfig contains guest OS level configuration for the machine.
 	GuestConfig *MachineGuestConfigApplyConfiguration `json:"guestConfig,omitempty"`
+	// Restart requests a restart of the machine.
+	// Every time Restart.RequestedAt changes, the machine is restarted.
+	Restart *MachineRestartApplyConfiguration `json:"restart,omitempty"`
+	// IOFreeze requests to freeze the I/O of the machine's volumes, e.g. to take consistent snapshots.
+	// The I/O is thawed once IOFreeze is unset or its timeout expired.
+	IOFreeze *MachineIOFreezeApplyConfiguration `json:"ioFreeze,omitempty"`
+	// TopologySpreadConstraints describes how machines matching a label selector should be spread
+	// across topology domains, e.g. zones. All constraints have to be satisfied to schedule the machine.
+	TopologySpreadConstraints []TopologySpreadConstraintApplyConfiguration `json:"topologySpreadConstraints,omitempty"`
+	// Affinity are the affinity scheduling rules of the machine.
+	Affinity *AffinityApplyConfiguration `json:"affinity,omitempty"`
+	// PriorityClassName is the name of the MachinePriorityClass of the machine.
+	// If empty, the MachinePriorityClass marked as global default is used, if any.
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// Priority is the priority of the machine. It is resolved from the PriorityClassName on creation.
+	Priority *int32 `json:"priority,omitempty"`
 }
 
 // MachineSpecApplyConfiguration constructs a declarative configuration of the MachineSpec type for use with
@@ -150,3 +166,56 @@ func (b *MachineSpecApplyConfiguration) WithGuestConfig(value *MachineGuestConfi
 	b.GuestConfig = value
 	return b
 }
+
+// WithRestart sets the Restart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Restart field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithRestart(value *MachineRestartApplyConfiguration) *MachineSpecApplyConfiguration {
+	b.Restart = value
+	return b
+}
+
+// WithIOFreeze sets the IOFreeze field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOFreeze field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithIOFreeze(value *MachineIOFreezeApplyConfiguration) *MachineSpecApplyConfiguration {
+	b.IOFreeze = value
+	return b
+}
+
+// WithTopologySpreadConstraints adds the given value to the TopologySpreadConstraints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TopologySpreadConstraints field.
+func (b *MachineSpecApplyConfiguration) WithTopologySpreadConstraints(values ...*TopologySpreadConstraintApplyConfiguration) *MachineSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTopologySpreadConstraints")
+		}
+		b.TopologySpreadConstraints = append(b.TopologySpreadConstraints, *values[i])
+	}
+	return b
+}
+
+// WithAffinity sets the Affinity field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Affinity field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithAffinity(value *AffinityApplyConfiguration) *MachineSpecApplyConfiguration {
+	b.Affinity = value
+	return b
+}
+
+// WithPriorityClassName sets the PriorityClassName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PriorityClassName field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithPriorityClassName(value string) *MachineSpecApplyConfiguration {
+	b.PriorityClassName = &value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *MachineSpecApplyConfiguration) WithPriority(value int32) *MachineSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
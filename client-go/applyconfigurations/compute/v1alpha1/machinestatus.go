@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // MachineStatusApplyConfiguration represents a declarative configuration of the MachineStatus type for use
@@ -22,6 +23,8 @@ type MachineStatusApplyConfiguration struct {
 	Conditions []MachineConditionApplyConfiguration `json:"conditions,omitempty"`
 	// State is the infrastructure state of the machine.
 	State *computev1alpha1.MachineState `json:"state,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned.
+	LastStateTransitionTime *v1.Time `json:"lastStateTransitionTime,omitempty"`
 	// NetworkInterfaces is the list of network interface states for the machine.
 	NetworkInterfaces []NetworkInterfaceStatusApplyConfiguration `json:"networkInterfaces,omitempty"`
 	// Volumes is the list of volume states for the machine.
@@ -71,6 +74,14 @@ func (b *MachineStatusApplyConfiguration) WithState(value computev1alpha1.Machin
 	return b
 }
 
+// WithLastStateTransitionTime sets the LastStateTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStateTransitionTime field is set to the value of the last call.
+func (b *MachineStatusApplyConfiguration) WithLastStateTransitionTime(value v1.Time) *MachineStatusApplyConfiguration {
+	b.LastStateTransitionTime = &value
+	return b
+}
+
 // WithNetworkInterfaces adds the given value to the NetworkInterfaces field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the NetworkInterfaces field.
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RollingUpdateMachineDeploymentApplyConfiguration represents a declarative configuration of the RollingUpdateMachineDeployment type for use
+// with apply.
+//
+// RollingUpdateMachineDeployment configures a rolling update of a MachineDeployment.
+type RollingUpdateMachineDeploymentApplyConfiguration struct {
+	// MaxUnavailable is the number or percentage of desired machines that may be unavailable
+	// during the update. Percentages are rounded down. Defaults to 25%.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// MaxSurge is the number or percentage of machines that may be created above the desired
+	// number of machines during the update. Percentages are rounded up. Defaults to 25%.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// RollingUpdateMachineDeploymentApplyConfiguration constructs a declarative configuration of the RollingUpdateMachineDeployment type for use with
+// apply.
+func RollingUpdateMachineDeployment() *RollingUpdateMachineDeploymentApplyConfiguration {
+	return &RollingUpdateMachineDeploymentApplyConfiguration{}
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *RollingUpdateMachineDeploymentApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *RollingUpdateMachineDeploymentApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithMaxSurge sets the MaxSurge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSurge field is set to the value of the last call.
+func (b *RollingUpdateMachineDeploymentApplyConfiguration) WithMaxSurge(value intstr.IntOrString) *RollingUpdateMachineDeploymentApplyConfiguration {
+	b.MaxSurge = &value
+	return b
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// TopologySpreadConstraintApplyConfiguration represents a declarative configuration of the TopologySpreadConstraint type for use
+// with apply.
+//
+// TopologySpreadConstraint specifies how to spread matching machines among the given topology.
+type TopologySpreadConstraintApplyConfiguration struct {
+	// MaxSkew is the maximum permitted difference between the number of matching machines
+	// in any two topology domains. It must be greater than zero.
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+	// TopologyKey is the key of machine pool labels. Machine pools with the same value of this label
+	// are considered to be in the same topology domain, e.g. topology.ironcore.dev/zone.
+	TopologyKey *string `json:"topologyKey,omitempty"`
+	// WhenUnsatisfiable indicates how to deal with a machine if it doesn't satisfy the spread constraint.
+	// Defaults to DoNotSchedule.
+	WhenUnsatisfiable *computev1alpha1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+	// LabelSelector is used to find the machines in the namespace of the machine that are counted
+	// to determine the number of machines in their topology domain.
+	LabelSelector *v1.LabelSelectorApplyConfiguration `json:"labelSelector,omitempty"`
+}
+
+// TopologySpreadConstraintApplyConfiguration constructs a declarative configuration of the TopologySpreadConstraint type for use with
+// apply.
+func TopologySpreadConstraint() *TopologySpreadConstraintApplyConfiguration {
+	return &TopologySpreadConstraintApplyConfiguration{}
+}
+
+// WithMaxSkew sets the MaxSkew field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSkew field is set to the value of the last call.
+func (b *TopologySpreadConstraintApplyConfiguration) WithMaxSkew(value int32) *TopologySpreadConstraintApplyConfiguration {
+	b.MaxSkew = &value
+	return b
+}
+
+// WithTopologyKey sets the TopologyKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TopologyKey field is set to the value of the last call.
+func (b *TopologySpreadConstraintApplyConfiguration) WithTopologyKey(value string) *TopologySpreadConstraintApplyConfiguration {
+	b.TopologyKey = &value
+	return b
+}
+
+// WithWhenUnsatisfiable sets the WhenUnsatisfiable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WhenUnsatisfiable field is set to the value of the last call.
+func (b *TopologySpreadConstraintApplyConfiguration) WithWhenUnsatisfiable(value computev1alpha1.UnsatisfiableConstraintAction) *TopologySpreadConstraintApplyConfiguration {
+	b.WhenUnsatisfiable = &value
+	return b
+}
+
+// WithLabelSelector sets the LabelSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelSelector field is set to the value of the last call.
+func (b *TopologySpreadConstraintApplyConfiguration) WithLabelSelector(value *v1.LabelSelectorApplyConfiguration) *TopologySpreadConstraintApplyConfiguration {
+	b.LabelSelector = value
+	return b
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UnhealthyMachineApplyConfiguration represents a declarative configuration of the UnhealthyMachine type for use
+// with apply.
+//
+// UnhealthyMachine is a selected machine that is considered unhealthy.
+type UnhealthyMachineApplyConfiguration struct {
+	// Name is the name of the machine.
+	Name *string `json:"name,omitempty"`
+	// Reason is a machine-readable indication of why the machine is considered unhealthy.
+	Reason *string `json:"reason,omitempty"`
+	// Message is a human-readable explanation of why the machine is considered unhealthy.
+	Message *string `json:"message,omitempty"`
+	// RemediationStrategy is the strategy the machine was last remediated with, if any.
+	RemediationStrategy *computev1alpha1.MachineRemediationStrategy `json:"remediationStrategy,omitempty"`
+	// LastRemediationTime is the last time the machine was remediated.
+	LastRemediationTime *v1.Time `json:"lastRemediationTime,omitempty"`
+}
+
+// UnhealthyMachineApplyConfiguration constructs a declarative configuration of the UnhealthyMachine type for use with
+// apply.
+func UnhealthyMachine() *UnhealthyMachineApplyConfiguration {
+	return &UnhealthyMachineApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *UnhealthyMachineApplyConfiguration) WithName(value string) *UnhealthyMachineApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *UnhealthyMachineApplyConfiguration) WithReason(value string) *UnhealthyMachineApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *UnhealthyMachineApplyConfiguration) WithMessage(value string) *UnhealthyMachineApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithRemediationStrategy sets the RemediationStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemediationStrategy field is set to the value of the last call.
+func (b *UnhealthyMachineApplyConfiguration) WithRemediationStrategy(value computev1alpha1.MachineRemediationStrategy) *UnhealthyMachineApplyConfiguration {
+	b.RemediationStrategy = &value
+	return b
+}
+
+// WithLastRemediationTime sets the LastRemediationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRemediationTime field is set to the value of the last call.
+func (b *UnhealthyMachineApplyConfiguration) WithLastRemediationTime(value v1.Time) *UnhealthyMachineApplyConfiguration {
+	b.LastRemediationTime = &value
+	return b
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UnhealthyMachineConditionApplyConfiguration represents a declarative configuration of the UnhealthyMachineCondition type for use
+// with apply.
+//
+// UnhealthyMachineCondition considers a machine unhealthy if its condition of Type has had Status
+// for longer than Timeout.
+type UnhealthyMachineConditionApplyConfiguration struct {
+	// Type is the type of the machine condition.
+	Type *computev1alpha1.MachineConditionType `json:"type,omitempty"`
+	// Status is the status of the machine condition.
+	Status *v1.ConditionStatus `json:"status,omitempty"`
+	// Timeout is the duration after which a machine with the condition is considered unhealthy.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// UnhealthyMachineConditionApplyConfiguration constructs a declarative configuration of the UnhealthyMachineCondition type for use with
+// apply.
+func UnhealthyMachineCondition() *UnhealthyMachineConditionApplyConfiguration {
+	return &UnhealthyMachineConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *UnhealthyMachineConditionApplyConfiguration) WithType(value computev1alpha1.MachineConditionType) *UnhealthyMachineConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *UnhealthyMachineConditionApplyConfiguration) WithStatus(value v1.ConditionStatus) *UnhealthyMachineConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *UnhealthyMachineConditionApplyConfiguration) WithTimeout(value metav1.Duration) *UnhealthyMachineConditionApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
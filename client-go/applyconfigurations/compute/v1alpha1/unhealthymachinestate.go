@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UnhealthyMachineStateApplyConfiguration represents a declarative configuration of the UnhealthyMachineState type for use
+// with apply.
+//
+// UnhealthyMachineState considers a machine unhealthy if it is in State for longer than Timeout.
+type UnhealthyMachineStateApplyConfiguration struct {
+	// State is the machine state.
+	State *computev1alpha1.MachineState `json:"state,omitempty"`
+	// Timeout is the duration after which a machine in State is considered unhealthy.
+	Timeout *v1.Duration `json:"timeout,omitempty"`
+}
+
+// UnhealthyMachineStateApplyConfiguration constructs a declarative configuration of the UnhealthyMachineState type for use with
+// apply.
+func UnhealthyMachineState() *UnhealthyMachineStateApplyConfiguration {
+	return &UnhealthyMachineStateApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *UnhealthyMachineStateApplyConfiguration) WithState(value computev1alpha1.MachineState) *UnhealthyMachineStateApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *UnhealthyMachineStateApplyConfiguration) WithTimeout(value v1.Duration) *UnhealthyMachineStateApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// WeightedMachineAffinityTermApplyConfiguration represents a declarative configuration of the WeightedMachineAffinityTerm type for use
+// with apply.
+//
+// WeightedMachineAffinityTerm is a MachineAffinityTerm with a weight.
+type WeightedMachineAffinityTermApplyConfiguration struct {
+	// Weight of the term, in the range 1-100.
+	Weight *int32 `json:"weight,omitempty"`
+	// MachineAffinityTerm is the affinity term.
+	MachineAffinityTerm *MachineAffinityTermApplyConfiguration `json:"machineAffinityTerm,omitempty"`
+}
+
+// WeightedMachineAffinityTermApplyConfiguration constructs a declarative configuration of the WeightedMachineAffinityTerm type for use with
+// apply.
+func WeightedMachineAffinityTerm() *WeightedMachineAffinityTermApplyConfiguration {
+	return &WeightedMachineAffinityTermApplyConfiguration{}
+}
+
+// WithWeight sets the Weight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Weight field is set to the value of the last call.
+func (b *WeightedMachineAffinityTermApplyConfiguration) WithWeight(value int32) *WeightedMachineAffinityTermApplyConfiguration {
+	b.Weight = &value
+	return b
+}
+
+// WithMachineAffinityTerm sets the MachineAffinityTerm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineAffinityTerm field is set to the value of the last call.
+func (b *WeightedMachineAffinityTermApplyConfiguration) WithMachineAffinityTerm(value *MachineAffinityTermApplyConfiguration) *WeightedMachineAffinityTermApplyConfiguration {
+	b.MachineAffinityTerm = value
+	return b
+}
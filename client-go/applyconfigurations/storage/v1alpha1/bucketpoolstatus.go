@@ -17,6 +17,8 @@ import (
 type BucketPoolStatusApplyConfiguration struct {
 	// State represents the infrastructure state of a BucketPool.
 	State *storagev1alpha1.BucketPoolState `json:"state,omitempty"`
+	// Conditions are the conditions of a BucketPool.
+	Conditions []BucketPoolConditionApplyConfiguration `json:"conditions,omitempty"`
 	// AvailableBucketClasses list the references of any supported BucketClass of this pool
 	AvailableBucketClasses []v1.LocalObjectReference `json:"availableBucketClasses,omitempty"`
 }
@@ -35,6 +37,19 @@ func (b *BucketPoolStatusApplyConfiguration) WithState(value storagev1alpha1.Buc
 	return b
 }
 
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *BucketPoolStatusApplyConfiguration) WithConditions(values ...*BucketPoolConditionApplyConfiguration) *BucketPoolStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
 // WithAvailableBucketClasses adds the given value to the AvailableBucketClasses field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the AvailableBucketClasses field.
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// VolumeBackupSpecApplyConfiguration represents a declarative configuration of the VolumeBackupSpec type for use
+// with apply.
+//
+// VolumeBackupSpec defines the desired state of VolumeBackup
+type VolumeBackupSpecApplyConfiguration struct {
+	// VolumeSnapshotRef references the VolumeSnapshot to back up.
+	VolumeSnapshotRef *v1.LocalObjectReference `json:"volumeSnapshotRef,omitempty"`
+	// BucketRef references the Bucket to export the VolumeSnapshot to.
+	// The VolumeSnapshot is exported via the access endpoint and credentials of the Bucket.
+	BucketRef *v1.LocalObjectReference `json:"bucketRef,omitempty"`
+}
+
+// VolumeBackupSpecApplyConfiguration constructs a declarative configuration of the VolumeBackupSpec type for use with
+// apply.
+func VolumeBackupSpec() *VolumeBackupSpecApplyConfiguration {
+	return &VolumeBackupSpecApplyConfiguration{}
+}
+
+// WithVolumeSnapshotRef sets the VolumeSnapshotRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSnapshotRef field is set to the value of the last call.
+func (b *VolumeBackupSpecApplyConfiguration) WithVolumeSnapshotRef(value v1.LocalObjectReference) *VolumeBackupSpecApplyConfiguration {
+	b.VolumeSnapshotRef = &value
+	return b
+}
+
+// WithBucketRef sets the BucketRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BucketRef field is set to the value of the last call.
+func (b *VolumeBackupSpecApplyConfiguration) WithBucketRef(value v1.LocalObjectReference) *VolumeBackupSpecApplyConfiguration {
+	b.BucketRef = &value
+	return b
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeBackupStatusApplyConfiguration represents a declarative configuration of the VolumeBackupStatus type for use
+// with apply.
+//
+// VolumeBackupStatus defines the observed state of VolumeBackup
+type VolumeBackupStatusApplyConfiguration struct {
+	// State is the state of the VolumeBackup.
+	State *storagev1alpha1.VolumeBackupState `json:"state,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned between values.
+	LastStateTransitionTime *v1.Time `json:"lastStateTransitionTime,omitempty"`
+	// Message is a human-readable explanation of the State.
+	Message *string `json:"message,omitempty"`
+	// ExportID is the id of the running export of the VolumeSnapshot in the volume runtime.
+	ExportID *string `json:"exportID,omitempty"`
+	// ObjectKey is the key of the object in the Bucket the VolumeSnapshot was exported to.
+	ObjectKey *string `json:"objectKey,omitempty"`
+	// Size is the size of the exported object.
+	Size *resource.Quantity `json:"size,omitempty"`
+	// VolumeStorage is the storage of the backed up Volume. Volumes restoring the VolumeBackup
+	// need at least this storage.
+	VolumeStorage *resource.Quantity `json:"volumeStorage,omitempty"`
+}
+
+// VolumeBackupStatusApplyConfiguration constructs a declarative configuration of the VolumeBackupStatus type for use with
+// apply.
+func VolumeBackupStatus() *VolumeBackupStatusApplyConfiguration {
+	return &VolumeBackupStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *VolumeBackupStatusApplyConfiguration) WithState(value storagev1alpha1.VolumeBackupState) *VolumeBackupStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithLastStateTransitionTime sets the LastStateTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStateTransitionTime field is set to the value of the last call.
+func (b *VolumeBackupStatusApplyConfiguration) WithLastStateTransitionTime(value v1.Time) *VolumeBackupStatusApplyConfiguration {
+	b.LastStateTransitionTime = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VolumeBackupStatusApplyConfiguration) WithMessage(value string) *VolumeBackupStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithExportID sets the ExportID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExportID field is set to the value of the last call.
+func (b *VolumeBackupStatusApplyConfiguration) WithExportID(value string) *VolumeBackupStatusApplyConfiguration {
+	b.ExportID = &value
+	return b
+}
+
+// WithObjectKey sets the ObjectKey field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObjectKey field is set to the value of the last call.
+func (b *VolumeBackupStatusApplyConfiguration) WithObjectKey(value string) *VolumeBackupStatusApplyConfiguration {
+	b.ObjectKey = &value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *VolumeBackupStatusApplyConfiguration) WithSize(value resource.Quantity) *VolumeBackupStatusApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithVolumeStorage sets the VolumeStorage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeStorage field is set to the value of the last call.
+func (b *VolumeBackupStatusApplyConfiguration) WithVolumeStorage(value resource.Quantity) *VolumeBackupStatusApplyConfiguration {
+	b.VolumeStorage = &value
+	return b
+}
@@ -16,6 +16,12 @@ import (
 type VolumeDataSourceApplyConfiguration struct {
 	// VolumeSnapshotRef instructs to use the specified VolumeSnapshot as the data source.
 	VolumeSnapshotRef *v1.LocalObjectReference `json:"volumeSnapshotRef,omitempty"`
+	// VolumeRef instructs to clone the specified Volume of the same namespace.
+	// The storage of the clone must be at least the storage of the source Volume.
+	VolumeRef *v1.LocalObjectReference `json:"volumeRef,omitempty"`
+	// VolumeBackupRef instructs to restore the specified VolumeBackup of the same namespace.
+	// Unlike VolumeSnapshots, VolumeBackups can be restored on any VolumePool.
+	VolumeBackupRef *v1.LocalObjectReference `json:"volumeBackupRef,omitempty"`
 	// OSImage defines an optional os image to bootstrap the volume.
 	OSImage *OSDataSourceApplyConfiguration `json:"osImage,omitempty"`
 }
@@ -34,6 +40,22 @@ func (b *VolumeDataSourceApplyConfiguration) WithVolumeSnapshotRef(value v1.Loca
 	return b
 }
 
+// WithVolumeRef sets the VolumeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeRef field is set to the value of the last call.
+func (b *VolumeDataSourceApplyConfiguration) WithVolumeRef(value v1.LocalObjectReference) *VolumeDataSourceApplyConfiguration {
+	b.VolumeRef = &value
+	return b
+}
+
+// WithVolumeBackupRef sets the VolumeBackupRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeBackupRef field is set to the value of the last call.
+func (b *VolumeDataSourceApplyConfiguration) WithVolumeBackupRef(value v1.LocalObjectReference) *VolumeDataSourceApplyConfiguration {
+	b.VolumeBackupRef = &value
+	return b
+}
+
 // WithOSImage sets the OSImage field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the OSImage field is set to the value of the last call.
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeRevertApplyConfiguration represents a declarative configuration of the VolumeRevert type for use
+// with apply.
+//
+// VolumeRevert is a request to revert a Volume to a VolumeSnapshot.
+type VolumeRevertApplyConfiguration struct {
+	// VolumeSnapshotRef references the VolumeSnapshot to revert the volume to.
+	// The VolumeSnapshot has to be a snapshot of the volume.
+	VolumeSnapshotRef *v1.LocalObjectReference `json:"volumeSnapshotRef,omitempty"`
+	// RequestedAt is the time the revert was requested at.
+	RequestedAt *metav1.Time `json:"requestedAt,omitempty"`
+}
+
+// VolumeRevertApplyConfiguration constructs a declarative configuration of the VolumeRevert type for use with
+// apply.
+func VolumeRevert() *VolumeRevertApplyConfiguration {
+	return &VolumeRevertApplyConfiguration{}
+}
+
+// WithVolumeSnapshotRef sets the VolumeSnapshotRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSnapshotRef field is set to the value of the last call.
+func (b *VolumeRevertApplyConfiguration) WithVolumeSnapshotRef(value v1.LocalObjectReference) *VolumeRevertApplyConfiguration {
+	b.VolumeSnapshotRef = &value
+	return b
+}
+
+// WithRequestedAt sets the RequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedAt field is set to the value of the last call.
+func (b *VolumeRevertApplyConfiguration) WithRequestedAt(value metav1.Time) *VolumeRevertApplyConfiguration {
+	b.RequestedAt = &value
+	return b
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// VolumeSnapshotGroupRestoreSpecApplyConfiguration represents a declarative configuration of the VolumeSnapshotGroupRestoreSpec type for use
+// with apply.
+//
+// VolumeSnapshotGroupRestoreSpec defines the desired state of VolumeSnapshotGroupRestore
+type VolumeSnapshotGroupRestoreSpecApplyConfiguration struct {
+	// VolumeSnapshotGroupRef references the VolumeSnapshotGroup to restore.
+	VolumeSnapshotGroupRef *v1.LocalObjectReference `json:"volumeSnapshotGroupRef,omitempty"`
+}
+
+// VolumeSnapshotGroupRestoreSpecApplyConfiguration constructs a declarative configuration of the VolumeSnapshotGroupRestoreSpec type for use with
+// apply.
+func VolumeSnapshotGroupRestoreSpec() *VolumeSnapshotGroupRestoreSpecApplyConfiguration {
+	return &VolumeSnapshotGroupRestoreSpecApplyConfiguration{}
+}
+
+// WithVolumeSnapshotGroupRef sets the VolumeSnapshotGroupRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSnapshotGroupRef field is set to the value of the last call.
+func (b *VolumeSnapshotGroupRestoreSpecApplyConfiguration) WithVolumeSnapshotGroupRef(value v1.LocalObjectReference) *VolumeSnapshotGroupRestoreSpecApplyConfiguration {
+	b.VolumeSnapshotGroupRef = &value
+	return b
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeSnapshotGroupRestoreStatusApplyConfiguration represents a declarative configuration of the VolumeSnapshotGroupRestoreStatus type for use
+// with apply.
+//
+// VolumeSnapshotGroupRestoreStatus defines the observed state of VolumeSnapshotGroupRestore
+type VolumeSnapshotGroupRestoreStatusApplyConfiguration struct {
+	// State is the state of the VolumeSnapshotGroupRestore.
+	State *storagev1alpha1.VolumeSnapshotGroupRestoreState `json:"state,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned between values.
+	LastStateTransitionTime *v1.Time `json:"lastStateTransitionTime,omitempty"`
+	// Message is a human-readable explanation of the State.
+	Message *string `json:"message,omitempty"`
+	// Volumes are the Volumes restored from the VolumeSnapshotGroup.
+	Volumes []VolumeSnapshotGroupRestoreVolumeApplyConfiguration `json:"volumes,omitempty"`
+}
+
+// VolumeSnapshotGroupRestoreStatusApplyConfiguration constructs a declarative configuration of the VolumeSnapshotGroupRestoreStatus type for use with
+// apply.
+func VolumeSnapshotGroupRestoreStatus() *VolumeSnapshotGroupRestoreStatusApplyConfiguration {
+	return &VolumeSnapshotGroupRestoreStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *VolumeSnapshotGroupRestoreStatusApplyConfiguration) WithState(value storagev1alpha1.VolumeSnapshotGroupRestoreState) *VolumeSnapshotGroupRestoreStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithLastStateTransitionTime sets the LastStateTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStateTransitionTime field is set to the value of the last call.
+func (b *VolumeSnapshotGroupRestoreStatusApplyConfiguration) WithLastStateTransitionTime(value v1.Time) *VolumeSnapshotGroupRestoreStatusApplyConfiguration {
+	b.LastStateTransitionTime = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VolumeSnapshotGroupRestoreStatusApplyConfiguration) WithMessage(value string) *VolumeSnapshotGroupRestoreStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithVolumes adds the given value to the Volumes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Volumes field.
+func (b *VolumeSnapshotGroupRestoreStatusApplyConfiguration) WithVolumes(values ...*VolumeSnapshotGroupRestoreVolumeApplyConfiguration) *VolumeSnapshotGroupRestoreStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVolumes")
+		}
+		b.Volumes = append(b.Volumes, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// VolumeSnapshotGroupRestoreVolumeApplyConfiguration represents a declarative configuration of the VolumeSnapshotGroupRestoreVolume type for use
+// with apply.
+//
+// VolumeSnapshotGroupRestoreVolume is a Volume restored by a VolumeSnapshotGroupRestore.
+type VolumeSnapshotGroupRestoreVolumeApplyConfiguration struct {
+	// SourceVolumeRef references the Volume the restored Volume was snapshotted from.
+	SourceVolumeRef *v1.LocalObjectReference `json:"sourceVolumeRef,omitempty"`
+	// VolumeRef references the restored Volume.
+	VolumeRef *v1.LocalObjectReference `json:"volumeRef,omitempty"`
+}
+
+// VolumeSnapshotGroupRestoreVolumeApplyConfiguration constructs a declarative configuration of the VolumeSnapshotGroupRestoreVolume type for use with
+// apply.
+func VolumeSnapshotGroupRestoreVolume() *VolumeSnapshotGroupRestoreVolumeApplyConfiguration {
+	return &VolumeSnapshotGroupRestoreVolumeApplyConfiguration{}
+}
+
+// WithSourceVolumeRef sets the SourceVolumeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceVolumeRef field is set to the value of the last call.
+func (b *VolumeSnapshotGroupRestoreVolumeApplyConfiguration) WithSourceVolumeRef(value v1.LocalObjectReference) *VolumeSnapshotGroupRestoreVolumeApplyConfiguration {
+	b.SourceVolumeRef = &value
+	return b
+}
+
+// WithVolumeRef sets the VolumeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeRef field is set to the value of the last call.
+func (b *VolumeSnapshotGroupRestoreVolumeApplyConfiguration) WithVolumeRef(value v1.LocalObjectReference) *VolumeSnapshotGroupRestoreVolumeApplyConfiguration {
+	b.VolumeRef = &value
+	return b
+}
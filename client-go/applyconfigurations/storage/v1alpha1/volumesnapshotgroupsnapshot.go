@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// VolumeSnapshotGroupSnapshotApplyConfiguration represents a declarative configuration of the VolumeSnapshotGroupSnapshot type for use
+// with apply.
+//
+// VolumeSnapshotGroupSnapshot is a VolumeSnapshot taken as part of a VolumeSnapshotGroup.
+type VolumeSnapshotGroupSnapshotApplyConfiguration struct {
+	// VolumeRef references the snapshotted Volume.
+	VolumeRef *v1.LocalObjectReference `json:"volumeRef,omitempty"`
+	// VolumeSnapshotRef references the VolumeSnapshot of the Volume.
+	VolumeSnapshotRef *v1.LocalObjectReference `json:"volumeSnapshotRef,omitempty"`
+	// VolumeClassRef is the VolumeClass the Volume had when it was snapshotted.
+	VolumeClassRef *v1.LocalObjectReference `json:"volumeClassRef,omitempty"`
+	// Resources are the resources the Volume had when it was snapshotted.
+	Resources *corev1alpha1.ResourceList `json:"resources,omitempty"`
+}
+
+// VolumeSnapshotGroupSnapshotApplyConfiguration constructs a declarative configuration of the VolumeSnapshotGroupSnapshot type for use with
+// apply.
+func VolumeSnapshotGroupSnapshot() *VolumeSnapshotGroupSnapshotApplyConfiguration {
+	return &VolumeSnapshotGroupSnapshotApplyConfiguration{}
+}
+
+// WithVolumeRef sets the VolumeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeRef field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSnapshotApplyConfiguration) WithVolumeRef(value v1.LocalObjectReference) *VolumeSnapshotGroupSnapshotApplyConfiguration {
+	b.VolumeRef = &value
+	return b
+}
+
+// WithVolumeSnapshotRef sets the VolumeSnapshotRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSnapshotRef field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSnapshotApplyConfiguration) WithVolumeSnapshotRef(value v1.LocalObjectReference) *VolumeSnapshotGroupSnapshotApplyConfiguration {
+	b.VolumeSnapshotRef = &value
+	return b
+}
+
+// WithVolumeClassRef sets the VolumeClassRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeClassRef field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSnapshotApplyConfiguration) WithVolumeClassRef(value v1.LocalObjectReference) *VolumeSnapshotGroupSnapshotApplyConfiguration {
+	b.VolumeClassRef = &value
+	return b
+}
+
+// WithResources sets the Resources field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Resources field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSnapshotApplyConfiguration) WithResources(value corev1alpha1.ResourceList) *VolumeSnapshotGroupSnapshotApplyConfiguration {
+	b.Resources = &value
+	return b
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	apismetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// VolumeSnapshotGroupSpecApplyConfiguration represents a declarative configuration of the VolumeSnapshotGroupSpec type for use
+// with apply.
+//
+// VolumeSnapshotGroupSpec defines the desired state of VolumeSnapshotGroup
+type VolumeSnapshotGroupSpecApplyConfiguration struct {
+	// MachineRef references the Machine whose Volumes to snapshot.
+	// Exactly one of MachineRef and VolumeSelector has to be set.
+	MachineRef *v1.LocalObjectReference `json:"machineRef,omitempty"`
+	// VolumeSelector selects the Volumes to snapshot.
+	// Exactly one of MachineRef and VolumeSelector has to be set.
+	VolumeSelector *metav1.LabelSelectorApplyConfiguration `json:"volumeSelector,omitempty"`
+	// FreezeIO instructs to freeze the I/O of the Machine while its Volumes are snapshotted.
+	// Requires MachineRef to be set.
+	FreezeIO *bool `json:"freezeIO,omitempty"`
+	// FreezeTimeout is the maximum duration the I/O of the Machine is frozen.
+	// Defaults to 30 seconds if FreezeIO is set.
+	FreezeTimeout *apismetav1.Duration `json:"freezeTimeout,omitempty"`
+}
+
+// VolumeSnapshotGroupSpecApplyConfiguration constructs a declarative configuration of the VolumeSnapshotGroupSpec type for use with
+// apply.
+func VolumeSnapshotGroupSpec() *VolumeSnapshotGroupSpecApplyConfiguration {
+	return &VolumeSnapshotGroupSpecApplyConfiguration{}
+}
+
+// WithMachineRef sets the MachineRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineRef field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSpecApplyConfiguration) WithMachineRef(value v1.LocalObjectReference) *VolumeSnapshotGroupSpecApplyConfiguration {
+	b.MachineRef = &value
+	return b
+}
+
+// WithVolumeSelector sets the VolumeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSelector field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSpecApplyConfiguration) WithVolumeSelector(value *metav1.LabelSelectorApplyConfiguration) *VolumeSnapshotGroupSpecApplyConfiguration {
+	b.VolumeSelector = value
+	return b
+}
+
+// WithFreezeIO sets the FreezeIO field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FreezeIO field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSpecApplyConfiguration) WithFreezeIO(value bool) *VolumeSnapshotGroupSpecApplyConfiguration {
+	b.FreezeIO = &value
+	return b
+}
+
+// WithFreezeTimeout sets the FreezeTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FreezeTimeout field is set to the value of the last call.
+func (b *VolumeSnapshotGroupSpecApplyConfiguration) WithFreezeTimeout(value apismetav1.Duration) *VolumeSnapshotGroupSpecApplyConfiguration {
+	b.FreezeTimeout = &value
+	return b
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeSnapshotGroupStatusApplyConfiguration represents a declarative configuration of the VolumeSnapshotGroupStatus type for use
+// with apply.
+//
+// VolumeSnapshotGroupStatus defines the observed state of VolumeSnapshotGroup
+type VolumeSnapshotGroupStatusApplyConfiguration struct {
+	// State is the state of the VolumeSnapshotGroup.
+	State *storagev1alpha1.VolumeSnapshotGroupState `json:"state,omitempty"`
+	// LastStateTransitionTime is the last time the State transitioned between values.
+	LastStateTransitionTime *v1.Time `json:"lastStateTransitionTime,omitempty"`
+	// Message is a human-readable explanation of the State.
+	Message *string `json:"message,omitempty"`
+	// IOFreezeRequestedAt is the time the I/O freeze of the Machine was requested at.
+	IOFreezeRequestedAt *v1.Time `json:"ioFreezeRequestedAt,omitempty"`
+	// Snapshots are the VolumeSnapshots of the VolumeSnapshotGroup.
+	Snapshots []VolumeSnapshotGroupSnapshotApplyConfiguration `json:"snapshots,omitempty"`
+}
+
+// VolumeSnapshotGroupStatusApplyConfiguration constructs a declarative configuration of the VolumeSnapshotGroupStatus type for use with
+// apply.
+func VolumeSnapshotGroupStatus() *VolumeSnapshotGroupStatusApplyConfiguration {
+	return &VolumeSnapshotGroupStatusApplyConfiguration{}
+}
+
+// WithState sets the State field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the State field is set to the value of the last call.
+func (b *VolumeSnapshotGroupStatusApplyConfiguration) WithState(value storagev1alpha1.VolumeSnapshotGroupState) *VolumeSnapshotGroupStatusApplyConfiguration {
+	b.State = &value
+	return b
+}
+
+// WithLastStateTransitionTime sets the LastStateTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastStateTransitionTime field is set to the value of the last call.
+func (b *VolumeSnapshotGroupStatusApplyConfiguration) WithLastStateTransitionTime(value v1.Time) *VolumeSnapshotGroupStatusApplyConfiguration {
+	b.LastStateTransitionTime = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VolumeSnapshotGroupStatusApplyConfiguration) WithMessage(value string) *VolumeSnapshotGroupStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithIOFreezeRequestedAt sets the IOFreezeRequestedAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IOFreezeRequestedAt field is set to the value of the last call.
+func (b *VolumeSnapshotGroupStatusApplyConfiguration) WithIOFreezeRequestedAt(value v1.Time) *VolumeSnapshotGroupStatusApplyConfiguration {
+	b.IOFreezeRequestedAt = &value
+	return b
+}
+
+// WithSnapshots adds the given value to the Snapshots field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Snapshots field.
+func (b *VolumeSnapshotGroupStatusApplyConfiguration) WithSnapshots(values ...*VolumeSnapshotGroupSnapshotApplyConfiguration) *VolumeSnapshotGroupStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSnapshots")
+		}
+		b.Snapshots = append(b.Snapshots, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeSnapshotRetentionApplyConfiguration represents a declarative configuration of the VolumeSnapshotRetention type for use
+// with apply.
+//
+// VolumeSnapshotRetention specifies which VolumeSnapshots of a VolumeSnapshotSchedule to keep.
+// Snapshots matching any of the set limits are deleted.
+type VolumeSnapshotRetentionApplyConfiguration struct {
+	// KeepLast is the number of most recent ready VolumeSnapshots to keep per Volume.
+	// VolumeSnapshots that are not ready are kept until KeepLast more recent ready ones exist.
+	KeepLast *int32 `json:"keepLast,omitempty"`
+	// MaxAge is the duration after which VolumeSnapshots are deleted.
+	MaxAge *v1.Duration `json:"maxAge,omitempty"`
+}
+
+// VolumeSnapshotRetentionApplyConfiguration constructs a declarative configuration of the VolumeSnapshotRetention type for use with
+// apply.
+func VolumeSnapshotRetention() *VolumeSnapshotRetentionApplyConfiguration {
+	return &VolumeSnapshotRetentionApplyConfiguration{}
+}
+
+// WithKeepLast sets the KeepLast field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the KeepLast field is set to the value of the last call.
+func (b *VolumeSnapshotRetentionApplyConfiguration) WithKeepLast(value int32) *VolumeSnapshotRetentionApplyConfiguration {
+	b.KeepLast = &value
+	return b
+}
+
+// WithMaxAge sets the MaxAge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxAge field is set to the value of the last call.
+func (b *VolumeSnapshotRetentionApplyConfiguration) WithMaxAge(value v1.Duration) *VolumeSnapshotRetentionApplyConfiguration {
+	b.MaxAge = &value
+	return b
+}
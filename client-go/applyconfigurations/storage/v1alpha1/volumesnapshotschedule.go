@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	internal "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/internal"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// VolumeSnapshotScheduleApplyConfiguration represents a declarative configuration of the VolumeSnapshotSchedule type for use
+// with apply.
+//
+// VolumeSnapshotSchedule periodically creates VolumeSnapshots of the selected Volumes and prunes
+// them according to its retention.
+type VolumeSnapshotScheduleApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration    `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                             *VolumeSnapshotScheduleSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *VolumeSnapshotScheduleStatusApplyConfiguration `json:"status,omitempty"`
+}
+
+// VolumeSnapshotSchedule constructs a declarative configuration of the VolumeSnapshotSchedule type for use with
+// apply.
+func VolumeSnapshotSchedule(name, namespace string) *VolumeSnapshotScheduleApplyConfiguration {
+	b := &VolumeSnapshotScheduleApplyConfiguration{}
+	b.WithName(name)
+	b.WithNamespace(namespace)
+	b.WithKind("VolumeSnapshotSchedule")
+	b.WithAPIVersion("storage.ironcore.dev/v1alpha1")
+	return b
+}
+
+// ExtractVolumeSnapshotScheduleFrom extracts the applied configuration owned by fieldManager from
+// volumeSnapshotSchedule for the specified subresource. Pass an empty string for subresource to extract
+// the main resource. Common subresources include "status", "scale", etc.
+// volumeSnapshotSchedule must be a unmodified VolumeSnapshotSchedule API object that was retrieved from the Kubernetes API.
+// ExtractVolumeSnapshotScheduleFrom provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractVolumeSnapshotScheduleFrom(volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, fieldManager string, subresource string) (*VolumeSnapshotScheduleApplyConfiguration, error) {
+	b := &VolumeSnapshotScheduleApplyConfiguration{}
+	err := managedfields.ExtractInto(volumeSnapshotSchedule, internal.Parser().Type("com.github.ironcore-dev.ironcore.api.storage.v1alpha1.VolumeSnapshotSchedule"), fieldManager, b, subresource)
+	if err != nil {
+		return nil, err
+	}
+	b.WithName(volumeSnapshotSchedule.Name)
+	b.WithNamespace(volumeSnapshotSchedule.Namespace)
+
+	b.WithKind("VolumeSnapshotSchedule")
+	b.WithAPIVersion("storage.ironcore.dev/v1alpha1")
+	return b, nil
+}
+
+// ExtractVolumeSnapshotSchedule extracts the applied configuration owned by fieldManager from
+// volumeSnapshotSchedule. If no managedFields are found in volumeSnapshotSchedule for fieldManager, a
+// VolumeSnapshotScheduleApplyConfiguration is returned with only the Name, Namespace (if applicable),
+// APIVersion and Kind populated. It is possible that no managed fields were found for because other
+// field managers have taken ownership of all the fields previously owned by fieldManager, or because
+// the fieldManager never owned fields any fields.
+// volumeSnapshotSchedule must be a unmodified VolumeSnapshotSchedule API object that was retrieved from the Kubernetes API.
+// ExtractVolumeSnapshotSchedule provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractVolumeSnapshotSchedule(volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, fieldManager string) (*VolumeSnapshotScheduleApplyConfiguration, error) {
+	return ExtractVolumeSnapshotScheduleFrom(volumeSnapshotSchedule, fieldManager, "")
+}
+
+// ExtractVolumeSnapshotScheduleStatus extracts the applied configuration owned by fieldManager from
+// volumeSnapshotSchedule for the status subresource.
+func ExtractVolumeSnapshotScheduleStatus(volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, fieldManager string) (*VolumeSnapshotScheduleApplyConfiguration, error) {
+	return ExtractVolumeSnapshotScheduleFrom(volumeSnapshotSchedule, fieldManager, "status")
+}
+
+func (b VolumeSnapshotScheduleApplyConfiguration) IsApplyConfiguration() {}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithKind(value string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithAPIVersion(value string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithName(value string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithGenerateName(value string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithNamespace(value string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithUID(value types.UID) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithResourceVersion(value string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithGeneration(value int64) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithCreationTimestamp(value metav1.Time) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithLabels(entries map[string]string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Labels == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithAnnotations(entries map[string]string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.ObjectMetaApplyConfiguration.OwnerReferences = append(b.ObjectMetaApplyConfiguration.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithFinalizers(values ...string) *VolumeSnapshotScheduleApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.ObjectMetaApplyConfiguration.Finalizers = append(b.ObjectMetaApplyConfiguration.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *VolumeSnapshotScheduleApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithSpec(value *VolumeSnapshotScheduleSpecApplyConfiguration) *VolumeSnapshotScheduleApplyConfiguration {
+	b.Spec = value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleApplyConfiguration) WithStatus(value *VolumeSnapshotScheduleStatusApplyConfiguration) *VolumeSnapshotScheduleApplyConfiguration {
+	b.Status = value
+	return b
+}
+
+// GetKind retrieves the value of the Kind field in the declarative configuration.
+func (b *VolumeSnapshotScheduleApplyConfiguration) GetKind() *string {
+	return b.TypeMetaApplyConfiguration.Kind
+}
+
+// GetAPIVersion retrieves the value of the APIVersion field in the declarative configuration.
+func (b *VolumeSnapshotScheduleApplyConfiguration) GetAPIVersion() *string {
+	return b.TypeMetaApplyConfiguration.APIVersion
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *VolumeSnapshotScheduleApplyConfiguration) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Name
+}
+
+// GetNamespace retrieves the value of the Namespace field in the declarative configuration.
+func (b *VolumeSnapshotScheduleApplyConfiguration) GetNamespace() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Namespace
+}
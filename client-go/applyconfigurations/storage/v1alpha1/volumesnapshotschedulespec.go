@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// VolumeSnapshotScheduleSpecApplyConfiguration represents a declarative configuration of the VolumeSnapshotScheduleSpec type for use
+// with apply.
+//
+// VolumeSnapshotScheduleSpec defines the desired state of VolumeSnapshotSchedule
+type VolumeSnapshotScheduleSpecApplyConfiguration struct {
+	// Schedule is the schedule in cron format, e.g. '0 3 * * *'. The schedule is interpreted in UTC.
+	Schedule *string `json:"schedule,omitempty"`
+	// VolumeSelector selects the Volumes to snapshot.
+	VolumeSelector *v1.LabelSelectorApplyConfiguration `json:"volumeSelector,omitempty"`
+	// Retention specifies which VolumeSnapshots to keep.
+	Retention *VolumeSnapshotRetentionApplyConfiguration `json:"retention,omitempty"`
+	// Suspend suspends the creation of VolumeSnapshots. Existing VolumeSnapshots are still pruned.
+	Suspend *bool `json:"suspend,omitempty"`
+}
+
+// VolumeSnapshotScheduleSpecApplyConfiguration constructs a declarative configuration of the VolumeSnapshotScheduleSpec type for use with
+// apply.
+func VolumeSnapshotScheduleSpec() *VolumeSnapshotScheduleSpecApplyConfiguration {
+	return &VolumeSnapshotScheduleSpecApplyConfiguration{}
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleSpecApplyConfiguration) WithSchedule(value string) *VolumeSnapshotScheduleSpecApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithVolumeSelector sets the VolumeSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VolumeSelector field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleSpecApplyConfiguration) WithVolumeSelector(value *v1.LabelSelectorApplyConfiguration) *VolumeSnapshotScheduleSpecApplyConfiguration {
+	b.VolumeSelector = value
+	return b
+}
+
+// WithRetention sets the Retention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Retention field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleSpecApplyConfiguration) WithRetention(value *VolumeSnapshotRetentionApplyConfiguration) *VolumeSnapshotScheduleSpecApplyConfiguration {
+	b.Retention = value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleSpecApplyConfiguration) WithSuspend(value bool) *VolumeSnapshotScheduleSpecApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
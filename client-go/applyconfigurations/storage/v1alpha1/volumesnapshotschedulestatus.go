@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeSnapshotScheduleStatusApplyConfiguration represents a declarative configuration of the VolumeSnapshotScheduleStatus type for use
+// with apply.
+//
+// VolumeSnapshotScheduleStatus defines the observed state of VolumeSnapshotSchedule
+type VolumeSnapshotScheduleStatusApplyConfiguration struct {
+	// ObservedGeneration is the most recent generation observed for this VolumeSnapshotSchedule.
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	// LastScheduleTime is the last time VolumeSnapshots were scheduled.
+	LastScheduleTime *v1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is the last scheduled time for which all VolumeSnapshots became ready.
+	LastSuccessfulTime *v1.Time `json:"lastSuccessfulTime,omitempty"`
+	// LastFailureTime is the last scheduled time a VolumeSnapshot failed or could not be created.
+	LastFailureTime *v1.Time `json:"lastFailureTime,omitempty"`
+	// LastFailureMessage is a human-readable explanation of the last failure.
+	LastFailureMessage *string `json:"lastFailureMessage,omitempty"`
+}
+
+// VolumeSnapshotScheduleStatusApplyConfiguration constructs a declarative configuration of the VolumeSnapshotScheduleStatus type for use with
+// apply.
+func VolumeSnapshotScheduleStatus() *VolumeSnapshotScheduleStatusApplyConfiguration {
+	return &VolumeSnapshotScheduleStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleStatusApplyConfiguration) WithObservedGeneration(value int64) *VolumeSnapshotScheduleStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithLastScheduleTime sets the LastScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScheduleTime field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleStatusApplyConfiguration) WithLastScheduleTime(value v1.Time) *VolumeSnapshotScheduleStatusApplyConfiguration {
+	b.LastScheduleTime = &value
+	return b
+}
+
+// WithLastSuccessfulTime sets the LastSuccessfulTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSuccessfulTime field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleStatusApplyConfiguration) WithLastSuccessfulTime(value v1.Time) *VolumeSnapshotScheduleStatusApplyConfiguration {
+	b.LastSuccessfulTime = &value
+	return b
+}
+
+// WithLastFailureTime sets the LastFailureTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailureTime field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleStatusApplyConfiguration) WithLastFailureTime(value v1.Time) *VolumeSnapshotScheduleStatusApplyConfiguration {
+	b.LastFailureTime = &value
+	return b
+}
+
+// WithLastFailureMessage sets the LastFailureMessage field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailureMessage field is set to the value of the last call.
+func (b *VolumeSnapshotScheduleStatusApplyConfiguration) WithLastFailureMessage(value string) *VolumeSnapshotScheduleStatusApplyConfiguration {
+	b.LastFailureMessage = &value
+	return b
+}
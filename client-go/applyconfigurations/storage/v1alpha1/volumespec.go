@@ -37,6 +37,9 @@ type VolumeSpecApplyConfiguration struct {
 	Encryption *VolumeEncryptionApplyConfiguration `json:"encryption,omitempty"`
 	// DataSource contains the content to prepopulate the Volume with.
 	DataSource *VolumeDataSourceApplyConfiguration `json:"dataSource,omitempty"`
+	// Revert requests to revert the volume to a VolumeSnapshot of itself.
+	// Every time Revert.RequestedAt changes, the volume is reverted as soon as no machine claims it.
+	Revert *VolumeRevertApplyConfiguration `json:"revert,omitempty"`
 }
 
 // VolumeSpecApplyConfiguration constructs a declarative configuration of the VolumeSpec type for use with
@@ -124,3 +127,11 @@ func (b *VolumeSpecApplyConfiguration) WithDataSource(value *VolumeDataSourceApp
 	b.DataSource = value
 	return b
 }
+
+// WithRevert sets the Revert field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revert field is set to the value of the last call.
+func (b *VolumeSpecApplyConfiguration) WithRevert(value *VolumeRevertApplyConfiguration) *VolumeSpecApplyConfiguration {
+	b.Revert = value
+	return b
+}
@@ -29,6 +29,8 @@ type VolumeStatusApplyConfiguration struct {
 	Conditions []VolumeConditionApplyConfiguration `json:"conditions,omitempty"`
 	// Resources is a effective volume's resources.
 	Resources *corev1alpha1.ResourceList `json:"resources,omitempty"`
+	// LastAppliedRevert is the last revert that was applied to the volume.
+	LastAppliedRevert *VolumeRevertApplyConfiguration `json:"lastAppliedRevert,omitempty"`
 }
 
 // VolumeStatusApplyConfiguration constructs a declarative configuration of the VolumeStatus type for use with
@@ -89,3 +91,11 @@ func (b *VolumeStatusApplyConfiguration) WithResources(value corev1alpha1.Resour
 	b.Resources = &value
 	return b
 }
+
+// WithLastAppliedRevert sets the LastAppliedRevert field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastAppliedRevert field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithLastAppliedRevert(value *VolumeRevertApplyConfiguration) *VolumeStatusApplyConfiguration {
+	b.LastAppliedRevert = value
+	return b
+}
@@ -27,6 +27,8 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=compute.ironcore.dev, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("Affinity"):
+		return &computev1alpha1.AffinityApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("DaemonEndpoint"):
 		return &computev1alpha1.DaemonEndpointApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("EFIVar"):
@@ -41,12 +43,42 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &computev1alpha1.LocalDiskVolumeSourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Machine"):
 		return &computev1alpha1.MachineApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineAffinity"):
+		return &computev1alpha1.MachineAffinityApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineAffinityTerm"):
+		return &computev1alpha1.MachineAffinityTermApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineAntiAffinity"):
+		return &computev1alpha1.MachineAntiAffinityApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineClass"):
 		return &computev1alpha1.MachineClassApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineCondition"):
 		return &computev1alpha1.MachineConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeployment"):
+		return &computev1alpha1.MachineDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentSpec"):
+		return &computev1alpha1.MachineDeploymentSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentStatus"):
+		return &computev1alpha1.MachineDeploymentStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDeploymentStrategy"):
+		return &computev1alpha1.MachineDeploymentStrategyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDisruptionBudget"):
+		return &computev1alpha1.MachineDisruptionBudgetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDisruptionBudgetSpec"):
+		return &computev1alpha1.MachineDisruptionBudgetSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineDisruptionBudgetStatus"):
+		return &computev1alpha1.MachineDisruptionBudgetStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineGuestConfig"):
 		return &computev1alpha1.MachineGuestConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineHealthCheck"):
+		return &computev1alpha1.MachineHealthCheckApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineHealthCheckCondition"):
+		return &computev1alpha1.MachineHealthCheckConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineHealthCheckSpec"):
+		return &computev1alpha1.MachineHealthCheckSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineHealthCheckStatus"):
+		return &computev1alpha1.MachineHealthCheckStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineIOFreeze"):
+		return &computev1alpha1.MachineIOFreezeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachinePool"):
 		return &computev1alpha1.MachinePoolApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachinePoolAddress"):
@@ -55,26 +87,54 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &computev1alpha1.MachinePoolConditionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachinePoolDaemonEndpoints"):
 		return &computev1alpha1.MachinePoolDaemonEndpointsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachinePoolDrain"):
+		return &computev1alpha1.MachinePoolDrainApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachinePoolDrainStatus"):
+		return &computev1alpha1.MachinePoolDrainStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachinePoolSpec"):
 		return &computev1alpha1.MachinePoolSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachinePoolStatus"):
 		return &computev1alpha1.MachinePoolStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachinePriorityClass"):
+		return &computev1alpha1.MachinePriorityClassApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineRestart"):
+		return &computev1alpha1.MachineRestartApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSet"):
+		return &computev1alpha1.MachineSetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSetSpec"):
+		return &computev1alpha1.MachineSetSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineSetStatus"):
+		return &computev1alpha1.MachineSetStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineSpec"):
 		return &computev1alpha1.MachineSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MachineStatus"):
 		return &computev1alpha1.MachineStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MachineTemplateSpec"):
+		return &computev1alpha1.MachineTemplateSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterface"):
 		return &computev1alpha1.NetworkInterfaceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceSource"):
 		return &computev1alpha1.NetworkInterfaceSourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceStatus"):
 		return &computev1alpha1.NetworkInterfaceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RollingUpdateMachineDeployment"):
+		return &computev1alpha1.RollingUpdateMachineDeploymentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("TopologySpreadConstraint"):
+		return &computev1alpha1.TopologySpreadConstraintApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UnhealthyMachine"):
+		return &computev1alpha1.UnhealthyMachineApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UnhealthyMachineCondition"):
+		return &computev1alpha1.UnhealthyMachineConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("UnhealthyMachineState"):
+		return &computev1alpha1.UnhealthyMachineStateApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Volume"):
 		return &computev1alpha1.VolumeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSource"):
 		return &computev1alpha1.VolumeSourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeStatus"):
 		return &computev1alpha1.VolumeStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WeightedMachineAffinityTerm"):
+		return &computev1alpha1.WeightedMachineAffinityTermApplyConfiguration{}
 
 		// Group=core.ironcore.dev, Version=v1alpha1
 	case corev1alpha1.SchemeGroupVersion.WithKind("ObjectSelector"):
@@ -203,6 +263,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsstoragev1alpha1.BucketConditionApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("BucketPool"):
 		return &applyconfigurationsstoragev1alpha1.BucketPoolApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("BucketPoolCondition"):
+		return &applyconfigurationsstoragev1alpha1.BucketPoolConditionApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("BucketPoolSpec"):
 		return &applyconfigurationsstoragev1alpha1.BucketPoolSpecApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("BucketPoolStatus"):
@@ -217,6 +279,12 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsstoragev1alpha1.VolumeApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeAccess"):
 		return &applyconfigurationsstoragev1alpha1.VolumeAccessApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeBackup"):
+		return &applyconfigurationsstoragev1alpha1.VolumeBackupApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeBackupSpec"):
+		return &applyconfigurationsstoragev1alpha1.VolumeBackupSpecApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeBackupStatus"):
+		return &applyconfigurationsstoragev1alpha1.VolumeBackupStatusApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeClass"):
 		return &applyconfigurationsstoragev1alpha1.VolumeClassApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeCondition"):
@@ -233,8 +301,34 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &applyconfigurationsstoragev1alpha1.VolumePoolSpecApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumePoolStatus"):
 		return &applyconfigurationsstoragev1alpha1.VolumePoolStatusApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeRevert"):
+		return &applyconfigurationsstoragev1alpha1.VolumeRevertApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshot"):
 		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroup"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupRestore"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupRestoreSpec"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreSpecApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupRestoreStatus"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreStatusApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupRestoreVolume"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreVolumeApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupSnapshot"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupSnapshotApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupSpec"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupSpecApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupStatus"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupStatusApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotRetention"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotRetentionApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotSchedule"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotScheduleApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotScheduleSpec"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotScheduleSpecApplyConfiguration{}
+	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotScheduleStatus"):
+		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotScheduleStatusApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotSpec"):
 		return &applyconfigurationsstoragev1alpha1.VolumeSnapshotSpecApplyConfiguration{}
 	case storagev1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotStatus"):
@@ -15,8 +15,18 @@ type Interface interface {
 	Machines() MachineInformer
 	// MachineClasses returns a MachineClassInformer.
 	MachineClasses() MachineClassInformer
+	// MachineDeployments returns a MachineDeploymentInformer.
+	MachineDeployments() MachineDeploymentInformer
+	// MachineDisruptionBudgets returns a MachineDisruptionBudgetInformer.
+	MachineDisruptionBudgets() MachineDisruptionBudgetInformer
+	// MachineHealthChecks returns a MachineHealthCheckInformer.
+	MachineHealthChecks() MachineHealthCheckInformer
 	// MachinePools returns a MachinePoolInformer.
 	MachinePools() MachinePoolInformer
+	// MachinePriorityClasses returns a MachinePriorityClassInformer.
+	MachinePriorityClasses() MachinePriorityClassInformer
+	// MachineSets returns a MachineSetInformer.
+	MachineSets() MachineSetInformer
 }
 
 type version struct {
@@ -40,7 +50,32 @@ func (v *version) MachineClasses() MachineClassInformer {
 	return &machineClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// MachineDeployments returns a MachineDeploymentInformer.
+func (v *version) MachineDeployments() MachineDeploymentInformer {
+	return &machineDeploymentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// MachineDisruptionBudgets returns a MachineDisruptionBudgetInformer.
+func (v *version) MachineDisruptionBudgets() MachineDisruptionBudgetInformer {
+	return &machineDisruptionBudgetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// MachineHealthChecks returns a MachineHealthCheckInformer.
+func (v *version) MachineHealthChecks() MachineHealthCheckInformer {
+	return &machineHealthCheckInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // MachinePools returns a MachinePoolInformer.
 func (v *version) MachinePools() MachinePoolInformer {
 	return &machinePoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// MachinePriorityClasses returns a MachinePriorityClassInformer.
+func (v *version) MachinePriorityClasses() MachinePriorityClassInformer {
+	return &machinePriorityClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// MachineSets returns a MachineSetInformer.
+func (v *version) MachineSets() MachineSetInformer {
+	return &machineSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -48,8 +48,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().Machines().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("machineclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachineClasses().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("machinedeployments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachineDeployments().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("machinedisruptionbudgets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachineDisruptionBudgets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("machinehealthchecks"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachineHealthChecks().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("machinepools"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachinePools().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("machinepriorityclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachinePriorityClasses().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("machinesets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Compute().V1alpha1().MachineSets().Informer()}, nil
 
 		// Group=core.ironcore.dev, Version=v1alpha1
 	case corev1alpha1.SchemeGroupVersion.WithResource("resourcequotas"):
@@ -86,12 +96,20 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().BucketPools().Informer()}, nil
 	case storagev1alpha1.SchemeGroupVersion.WithResource("volumes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().Volumes().Informer()}, nil
+	case storagev1alpha1.SchemeGroupVersion.WithResource("volumebackups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().VolumeBackups().Informer()}, nil
 	case storagev1alpha1.SchemeGroupVersion.WithResource("volumeclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().VolumeClasses().Informer()}, nil
 	case storagev1alpha1.SchemeGroupVersion.WithResource("volumepools"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().VolumePools().Informer()}, nil
 	case storagev1alpha1.SchemeGroupVersion.WithResource("volumesnapshots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().VolumeSnapshots().Informer()}, nil
+	case storagev1alpha1.SchemeGroupVersion.WithResource("volumesnapshotgroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().VolumeSnapshotGroups().Informer()}, nil
+	case storagev1alpha1.SchemeGroupVersion.WithResource("volumesnapshotgrouprestores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().VolumeSnapshotGroupRestores().Informer()}, nil
+	case storagev1alpha1.SchemeGroupVersion.WithResource("volumesnapshotschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Storage().V1alpha1().VolumeSnapshotSchedules().Informer()}, nil
 
 	}
 
@@ -19,12 +19,20 @@ type Interface interface {
 	BucketPools() BucketPoolInformer
 	// Volumes returns a VolumeInformer.
 	Volumes() VolumeInformer
+	// VolumeBackups returns a VolumeBackupInformer.
+	VolumeBackups() VolumeBackupInformer
 	// VolumeClasses returns a VolumeClassInformer.
 	VolumeClasses() VolumeClassInformer
 	// VolumePools returns a VolumePoolInformer.
 	VolumePools() VolumePoolInformer
 	// VolumeSnapshots returns a VolumeSnapshotInformer.
 	VolumeSnapshots() VolumeSnapshotInformer
+	// VolumeSnapshotGroups returns a VolumeSnapshotGroupInformer.
+	VolumeSnapshotGroups() VolumeSnapshotGroupInformer
+	// VolumeSnapshotGroupRestores returns a VolumeSnapshotGroupRestoreInformer.
+	VolumeSnapshotGroupRestores() VolumeSnapshotGroupRestoreInformer
+	// VolumeSnapshotSchedules returns a VolumeSnapshotScheduleInformer.
+	VolumeSnapshotSchedules() VolumeSnapshotScheduleInformer
 }
 
 type version struct {
@@ -58,6 +66,11 @@ func (v *version) Volumes() VolumeInformer {
 	return &volumeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VolumeBackups returns a VolumeBackupInformer.
+func (v *version) VolumeBackups() VolumeBackupInformer {
+	return &volumeBackupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VolumeClasses returns a VolumeClassInformer.
 func (v *version) VolumeClasses() VolumeClassInformer {
 	return &volumeClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -72,3 +85,18 @@ func (v *version) VolumePools() VolumePoolInformer {
 func (v *version) VolumeSnapshots() VolumeSnapshotInformer {
 	return &volumeSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// VolumeSnapshotGroups returns a VolumeSnapshotGroupInformer.
+func (v *version) VolumeSnapshotGroups() VolumeSnapshotGroupInformer {
+	return &volumeSnapshotGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VolumeSnapshotGroupRestores returns a VolumeSnapshotGroupRestoreInformer.
+func (v *version) VolumeSnapshotGroupRestores() VolumeSnapshotGroupRestoreInformer {
+	return &volumeSnapshotGroupRestoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VolumeSnapshotSchedules returns a VolumeSnapshotScheduleInformer.
+func (v *version) VolumeSnapshotSchedules() VolumeSnapshotScheduleInformer {
+	return &volumeSnapshotScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -17,7 +17,12 @@ type ComputeV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	MachinesGetter
 	MachineClassesGetter
+	MachineDeploymentsGetter
+	MachineDisruptionBudgetsGetter
+	MachineHealthChecksGetter
 	MachinePoolsGetter
+	MachinePriorityClassesGetter
+	MachineSetsGetter
 }
 
 // ComputeV1alpha1Client is used to interact with features provided by the compute.ironcore.dev group.
@@ -33,10 +38,30 @@ func (c *ComputeV1alpha1Client) MachineClasses() MachineClassInterface {
 	return newMachineClasses(c)
 }
 
+func (c *ComputeV1alpha1Client) MachineDeployments(namespace string) MachineDeploymentInterface {
+	return newMachineDeployments(c, namespace)
+}
+
+func (c *ComputeV1alpha1Client) MachineDisruptionBudgets(namespace string) MachineDisruptionBudgetInterface {
+	return newMachineDisruptionBudgets(c, namespace)
+}
+
+func (c *ComputeV1alpha1Client) MachineHealthChecks(namespace string) MachineHealthCheckInterface {
+	return newMachineHealthChecks(c, namespace)
+}
+
 func (c *ComputeV1alpha1Client) MachinePools() MachinePoolInterface {
 	return newMachinePools(c)
 }
 
+func (c *ComputeV1alpha1Client) MachinePriorityClasses() MachinePriorityClassInterface {
+	return newMachinePriorityClasses(c)
+}
+
+func (c *ComputeV1alpha1Client) MachineSets(namespace string) MachineSetInterface {
+	return newMachineSets(c, namespace)
+}
+
 // NewForConfig creates a new ComputeV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
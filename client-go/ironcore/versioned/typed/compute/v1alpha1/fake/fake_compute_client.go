@@ -23,10 +23,30 @@ func (c *FakeComputeV1alpha1) MachineClasses() v1alpha1.MachineClassInterface {
 	return newFakeMachineClasses(c)
 }
 
+func (c *FakeComputeV1alpha1) MachineDeployments(namespace string) v1alpha1.MachineDeploymentInterface {
+	return newFakeMachineDeployments(c, namespace)
+}
+
+func (c *FakeComputeV1alpha1) MachineDisruptionBudgets(namespace string) v1alpha1.MachineDisruptionBudgetInterface {
+	return newFakeMachineDisruptionBudgets(c, namespace)
+}
+
+func (c *FakeComputeV1alpha1) MachineHealthChecks(namespace string) v1alpha1.MachineHealthCheckInterface {
+	return newFakeMachineHealthChecks(c, namespace)
+}
+
 func (c *FakeComputeV1alpha1) MachinePools() v1alpha1.MachinePoolInterface {
 	return newFakeMachinePools(c)
 }
 
+func (c *FakeComputeV1alpha1) MachinePriorityClasses() v1alpha1.MachinePriorityClassInterface {
+	return newFakeMachinePriorityClasses(c)
+}
+
+func (c *FakeComputeV1alpha1) MachineSets(namespace string) v1alpha1.MachineSetInterface {
+	return newFakeMachineSets(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeComputeV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	context "context"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	typedcomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/compute/v1alpha1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+	testing "k8s.io/client-go/testing"
+)
+
+// fakeMachineDeployments implements MachineDeploymentInterface
+type fakeMachineDeployments struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.MachineDeployment, *v1alpha1.MachineDeploymentList, *computev1alpha1.MachineDeploymentApplyConfiguration]
+	Fake *FakeComputeV1alpha1
+}
+
+func newFakeMachineDeployments(fake *FakeComputeV1alpha1, namespace string) typedcomputev1alpha1.MachineDeploymentInterface {
+	return &fakeMachineDeployments{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.MachineDeployment, *v1alpha1.MachineDeploymentList, *computev1alpha1.MachineDeploymentApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("machinedeployments"),
+			v1alpha1.SchemeGroupVersion.WithKind("MachineDeployment"),
+			func() *v1alpha1.MachineDeployment { return &v1alpha1.MachineDeployment{} },
+			func() *v1alpha1.MachineDeploymentList { return &v1alpha1.MachineDeploymentList{} },
+			func(dst, src *v1alpha1.MachineDeploymentList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.MachineDeploymentList) []*v1alpha1.MachineDeployment {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.MachineDeploymentList, items []*v1alpha1.MachineDeployment) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
+
+// GetScale takes name of the machineDeployment, and returns the corresponding scale object, and an error if there is any.
+func (c *fakeMachineDeployments) GetScale(ctx context.Context, machineDeploymentName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceActionWithOptions(c.Resource(), c.Namespace(), "scale", machineDeploymentName, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
+
+// UpdateScale takes the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *fakeMachineDeployments) UpdateScale(ctx context.Context, machineDeploymentName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(c.Resource(), "scale", c.Namespace(), scale, opts), &autoscalingv1.Scale{})
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	typedcomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/compute/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeMachineDisruptionBudgets implements MachineDisruptionBudgetInterface
+type fakeMachineDisruptionBudgets struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.MachineDisruptionBudget, *v1alpha1.MachineDisruptionBudgetList, *computev1alpha1.MachineDisruptionBudgetApplyConfiguration]
+	Fake *FakeComputeV1alpha1
+}
+
+func newFakeMachineDisruptionBudgets(fake *FakeComputeV1alpha1, namespace string) typedcomputev1alpha1.MachineDisruptionBudgetInterface {
+	return &fakeMachineDisruptionBudgets{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.MachineDisruptionBudget, *v1alpha1.MachineDisruptionBudgetList, *computev1alpha1.MachineDisruptionBudgetApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("machinedisruptionbudgets"),
+			v1alpha1.SchemeGroupVersion.WithKind("MachineDisruptionBudget"),
+			func() *v1alpha1.MachineDisruptionBudget { return &v1alpha1.MachineDisruptionBudget{} },
+			func() *v1alpha1.MachineDisruptionBudgetList { return &v1alpha1.MachineDisruptionBudgetList{} },
+			func(dst, src *v1alpha1.MachineDisruptionBudgetList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.MachineDisruptionBudgetList) []*v1alpha1.MachineDisruptionBudget {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.MachineDisruptionBudgetList, items []*v1alpha1.MachineDisruptionBudget) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	typedcomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/compute/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeMachineHealthChecks implements MachineHealthCheckInterface
+type fakeMachineHealthChecks struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.MachineHealthCheck, *v1alpha1.MachineHealthCheckList, *computev1alpha1.MachineHealthCheckApplyConfiguration]
+	Fake *FakeComputeV1alpha1
+}
+
+func newFakeMachineHealthChecks(fake *FakeComputeV1alpha1, namespace string) typedcomputev1alpha1.MachineHealthCheckInterface {
+	return &fakeMachineHealthChecks{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.MachineHealthCheck, *v1alpha1.MachineHealthCheckList, *computev1alpha1.MachineHealthCheckApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("machinehealthchecks"),
+			v1alpha1.SchemeGroupVersion.WithKind("MachineHealthCheck"),
+			func() *v1alpha1.MachineHealthCheck { return &v1alpha1.MachineHealthCheck{} },
+			func() *v1alpha1.MachineHealthCheckList { return &v1alpha1.MachineHealthCheckList{} },
+			func(dst, src *v1alpha1.MachineHealthCheckList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.MachineHealthCheckList) []*v1alpha1.MachineHealthCheck {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.MachineHealthCheckList, items []*v1alpha1.MachineHealthCheck) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	typedcomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/compute/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeMachinePriorityClasses implements MachinePriorityClassInterface
+type fakeMachinePriorityClasses struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.MachinePriorityClass, *v1alpha1.MachinePriorityClassList, *computev1alpha1.MachinePriorityClassApplyConfiguration]
+	Fake *FakeComputeV1alpha1
+}
+
+func newFakeMachinePriorityClasses(fake *FakeComputeV1alpha1) typedcomputev1alpha1.MachinePriorityClassInterface {
+	return &fakeMachinePriorityClasses{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.MachinePriorityClass, *v1alpha1.MachinePriorityClassList, *computev1alpha1.MachinePriorityClassApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("machinepriorityclasses"),
+			v1alpha1.SchemeGroupVersion.WithKind("MachinePriorityClass"),
+			func() *v1alpha1.MachinePriorityClass { return &v1alpha1.MachinePriorityClass{} },
+			func() *v1alpha1.MachinePriorityClassList { return &v1alpha1.MachinePriorityClassList{} },
+			func(dst, src *v1alpha1.MachinePriorityClassList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.MachinePriorityClassList) []*v1alpha1.MachinePriorityClass {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.MachinePriorityClassList, items []*v1alpha1.MachinePriorityClass) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
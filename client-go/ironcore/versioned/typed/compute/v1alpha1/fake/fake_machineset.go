@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	context "context"
+
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	typedcomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/compute/v1alpha1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gentype "k8s.io/client-go/gentype"
+	testing "k8s.io/client-go/testing"
+)
+
+// fakeMachineSets implements MachineSetInterface
+type fakeMachineSets struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.MachineSet, *v1alpha1.MachineSetList, *computev1alpha1.MachineSetApplyConfiguration]
+	Fake *FakeComputeV1alpha1
+}
+
+func newFakeMachineSets(fake *FakeComputeV1alpha1, namespace string) typedcomputev1alpha1.MachineSetInterface {
+	return &fakeMachineSets{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.MachineSet, *v1alpha1.MachineSetList, *computev1alpha1.MachineSetApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("machinesets"),
+			v1alpha1.SchemeGroupVersion.WithKind("MachineSet"),
+			func() *v1alpha1.MachineSet { return &v1alpha1.MachineSet{} },
+			func() *v1alpha1.MachineSetList { return &v1alpha1.MachineSetList{} },
+			func(dst, src *v1alpha1.MachineSetList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.MachineSetList) []*v1alpha1.MachineSet { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.MachineSetList, items []*v1alpha1.MachineSet) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
+
+// GetScale takes name of the machineSet, and returns the corresponding scale object, and an error if there is any.
+func (c *fakeMachineSets) GetScale(ctx context.Context, machineSetName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewGetSubresourceActionWithOptions(c.Resource(), c.Namespace(), "scale", machineSetName, options), emptyResult)
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
+
+// UpdateScale takes the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *fakeMachineSets) UpdateScale(ctx context.Context, machineSetName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	emptyResult := &autoscalingv1.Scale{}
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceActionWithOptions(c.Resource(), "scale", c.Namespace(), scale, opts), &autoscalingv1.Scale{})
+
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*autoscalingv1.Scale), err
+}
@@ -9,4 +9,14 @@ type MachineExpansion interface{}
 
 type MachineClassExpansion interface{}
 
+type MachineDeploymentExpansion interface{}
+
+type MachineDisruptionBudgetExpansion interface{}
+
+type MachineHealthCheckExpansion interface{}
+
 type MachinePoolExpansion interface{}
+
+type MachinePriorityClassExpansion interface{}
+
+type MachineSetExpansion interface{}
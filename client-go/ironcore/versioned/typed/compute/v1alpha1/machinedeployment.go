@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	applyconfigurationscomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// MachineDeploymentsGetter has a method to return a MachineDeploymentInterface.
+// A group's client should implement this interface.
+type MachineDeploymentsGetter interface {
+	MachineDeployments(namespace string) MachineDeploymentInterface
+}
+
+// MachineDeploymentInterface has methods to work with MachineDeployment resources.
+type MachineDeploymentInterface interface {
+	Create(ctx context.Context, machineDeployment *computev1alpha1.MachineDeployment, opts v1.CreateOptions) (*computev1alpha1.MachineDeployment, error)
+	Update(ctx context.Context, machineDeployment *computev1alpha1.MachineDeployment, opts v1.UpdateOptions) (*computev1alpha1.MachineDeployment, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, machineDeployment *computev1alpha1.MachineDeployment, opts v1.UpdateOptions) (*computev1alpha1.MachineDeployment, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*computev1alpha1.MachineDeployment, error)
+	List(ctx context.Context, opts v1.ListOptions) (*computev1alpha1.MachineDeploymentList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *computev1alpha1.MachineDeployment, err error)
+	Apply(ctx context.Context, machineDeployment *applyconfigurationscomputev1alpha1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineDeployment, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineDeployment *applyconfigurationscomputev1alpha1.MachineDeploymentApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineDeployment, err error)
+	GetScale(ctx context.Context, machineDeploymentName string, options v1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, machineDeploymentName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
+
+	MachineDeploymentExpansion
+}
+
+// machineDeployments implements MachineDeploymentInterface
+type machineDeployments struct {
+	*gentype.ClientWithListAndApply[*computev1alpha1.MachineDeployment, *computev1alpha1.MachineDeploymentList, *applyconfigurationscomputev1alpha1.MachineDeploymentApplyConfiguration]
+}
+
+// newMachineDeployments returns a MachineDeployments
+func newMachineDeployments(c *ComputeV1alpha1Client, namespace string) *machineDeployments {
+	return &machineDeployments{
+		gentype.NewClientWithListAndApply[*computev1alpha1.MachineDeployment, *computev1alpha1.MachineDeploymentList, *applyconfigurationscomputev1alpha1.MachineDeploymentApplyConfiguration](
+			"machinedeployments",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *computev1alpha1.MachineDeployment { return &computev1alpha1.MachineDeployment{} },
+			func() *computev1alpha1.MachineDeploymentList { return &computev1alpha1.MachineDeploymentList{} },
+		),
+	}
+}
+
+// GetScale takes name of the machineDeployment, and returns the corresponding autoscalingv1.Scale object, and an error if there is any.
+func (c *machineDeployments) GetScale(ctx context.Context, machineDeploymentName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Get().
+		Namespace(c.GetNamespace()).
+		Resource("machinedeployments").
+		Name(machineDeploymentName).
+		SubResource("scale").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateScale takes the top resource name and the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *machineDeployments) UpdateScale(ctx context.Context, machineDeploymentName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Put().
+		Namespace(c.GetNamespace()).
+		Resource("machinedeployments").
+		Name(machineDeploymentName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	applyconfigurationscomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// MachineDisruptionBudgetsGetter has a method to return a MachineDisruptionBudgetInterface.
+// A group's client should implement this interface.
+type MachineDisruptionBudgetsGetter interface {
+	MachineDisruptionBudgets(namespace string) MachineDisruptionBudgetInterface
+}
+
+// MachineDisruptionBudgetInterface has methods to work with MachineDisruptionBudget resources.
+type MachineDisruptionBudgetInterface interface {
+	Create(ctx context.Context, machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget, opts v1.CreateOptions) (*computev1alpha1.MachineDisruptionBudget, error)
+	Update(ctx context.Context, machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget, opts v1.UpdateOptions) (*computev1alpha1.MachineDisruptionBudget, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget, opts v1.UpdateOptions) (*computev1alpha1.MachineDisruptionBudget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*computev1alpha1.MachineDisruptionBudget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*computev1alpha1.MachineDisruptionBudgetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *computev1alpha1.MachineDisruptionBudget, err error)
+	Apply(ctx context.Context, machineDisruptionBudget *applyconfigurationscomputev1alpha1.MachineDisruptionBudgetApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineDisruptionBudget, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineDisruptionBudget *applyconfigurationscomputev1alpha1.MachineDisruptionBudgetApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineDisruptionBudget, err error)
+	MachineDisruptionBudgetExpansion
+}
+
+// machineDisruptionBudgets implements MachineDisruptionBudgetInterface
+type machineDisruptionBudgets struct {
+	*gentype.ClientWithListAndApply[*computev1alpha1.MachineDisruptionBudget, *computev1alpha1.MachineDisruptionBudgetList, *applyconfigurationscomputev1alpha1.MachineDisruptionBudgetApplyConfiguration]
+}
+
+// newMachineDisruptionBudgets returns a MachineDisruptionBudgets
+func newMachineDisruptionBudgets(c *ComputeV1alpha1Client, namespace string) *machineDisruptionBudgets {
+	return &machineDisruptionBudgets{
+		gentype.NewClientWithListAndApply[*computev1alpha1.MachineDisruptionBudget, *computev1alpha1.MachineDisruptionBudgetList, *applyconfigurationscomputev1alpha1.MachineDisruptionBudgetApplyConfiguration](
+			"machinedisruptionbudgets",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *computev1alpha1.MachineDisruptionBudget { return &computev1alpha1.MachineDisruptionBudget{} },
+			func() *computev1alpha1.MachineDisruptionBudgetList {
+				return &computev1alpha1.MachineDisruptionBudgetList{}
+			},
+		),
+	}
+}
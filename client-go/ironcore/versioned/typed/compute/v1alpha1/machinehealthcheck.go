@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	applyconfigurationscomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// MachineHealthChecksGetter has a method to return a MachineHealthCheckInterface.
+// A group's client should implement this interface.
+type MachineHealthChecksGetter interface {
+	MachineHealthChecks(namespace string) MachineHealthCheckInterface
+}
+
+// MachineHealthCheckInterface has methods to work with MachineHealthCheck resources.
+type MachineHealthCheckInterface interface {
+	Create(ctx context.Context, machineHealthCheck *computev1alpha1.MachineHealthCheck, opts v1.CreateOptions) (*computev1alpha1.MachineHealthCheck, error)
+	Update(ctx context.Context, machineHealthCheck *computev1alpha1.MachineHealthCheck, opts v1.UpdateOptions) (*computev1alpha1.MachineHealthCheck, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, machineHealthCheck *computev1alpha1.MachineHealthCheck, opts v1.UpdateOptions) (*computev1alpha1.MachineHealthCheck, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*computev1alpha1.MachineHealthCheck, error)
+	List(ctx context.Context, opts v1.ListOptions) (*computev1alpha1.MachineHealthCheckList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *computev1alpha1.MachineHealthCheck, err error)
+	Apply(ctx context.Context, machineHealthCheck *applyconfigurationscomputev1alpha1.MachineHealthCheckApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineHealthCheck, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineHealthCheck *applyconfigurationscomputev1alpha1.MachineHealthCheckApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineHealthCheck, err error)
+	MachineHealthCheckExpansion
+}
+
+// machineHealthChecks implements MachineHealthCheckInterface
+type machineHealthChecks struct {
+	*gentype.ClientWithListAndApply[*computev1alpha1.MachineHealthCheck, *computev1alpha1.MachineHealthCheckList, *applyconfigurationscomputev1alpha1.MachineHealthCheckApplyConfiguration]
+}
+
+// newMachineHealthChecks returns a MachineHealthChecks
+func newMachineHealthChecks(c *ComputeV1alpha1Client, namespace string) *machineHealthChecks {
+	return &machineHealthChecks{
+		gentype.NewClientWithListAndApply[*computev1alpha1.MachineHealthCheck, *computev1alpha1.MachineHealthCheckList, *applyconfigurationscomputev1alpha1.MachineHealthCheckApplyConfiguration](
+			"machinehealthchecks",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *computev1alpha1.MachineHealthCheck { return &computev1alpha1.MachineHealthCheck{} },
+			func() *computev1alpha1.MachineHealthCheckList { return &computev1alpha1.MachineHealthCheckList{} },
+		),
+	}
+}
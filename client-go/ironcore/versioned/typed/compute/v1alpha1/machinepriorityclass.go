@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	applyconfigurationscomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// MachinePriorityClassesGetter has a method to return a MachinePriorityClassInterface.
+// A group's client should implement this interface.
+type MachinePriorityClassesGetter interface {
+	MachinePriorityClasses() MachinePriorityClassInterface
+}
+
+// MachinePriorityClassInterface has methods to work with MachinePriorityClass resources.
+type MachinePriorityClassInterface interface {
+	Create(ctx context.Context, machinePriorityClass *computev1alpha1.MachinePriorityClass, opts v1.CreateOptions) (*computev1alpha1.MachinePriorityClass, error)
+	Update(ctx context.Context, machinePriorityClass *computev1alpha1.MachinePriorityClass, opts v1.UpdateOptions) (*computev1alpha1.MachinePriorityClass, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*computev1alpha1.MachinePriorityClass, error)
+	List(ctx context.Context, opts v1.ListOptions) (*computev1alpha1.MachinePriorityClassList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *computev1alpha1.MachinePriorityClass, err error)
+	Apply(ctx context.Context, machinePriorityClass *applyconfigurationscomputev1alpha1.MachinePriorityClassApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachinePriorityClass, err error)
+	MachinePriorityClassExpansion
+}
+
+// machinePriorityClasses implements MachinePriorityClassInterface
+type machinePriorityClasses struct {
+	*gentype.ClientWithListAndApply[*computev1alpha1.MachinePriorityClass, *computev1alpha1.MachinePriorityClassList, *applyconfigurationscomputev1alpha1.MachinePriorityClassApplyConfiguration]
+}
+
+// newMachinePriorityClasses returns a MachinePriorityClasses
+func newMachinePriorityClasses(c *ComputeV1alpha1Client) *machinePriorityClasses {
+	return &machinePriorityClasses{
+		gentype.NewClientWithListAndApply[*computev1alpha1.MachinePriorityClass, *computev1alpha1.MachinePriorityClassList, *applyconfigurationscomputev1alpha1.MachinePriorityClassApplyConfiguration](
+			"machinepriorityclasses",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *computev1alpha1.MachinePriorityClass { return &computev1alpha1.MachinePriorityClass{} },
+			func() *computev1alpha1.MachinePriorityClassList { return &computev1alpha1.MachinePriorityClassList{} },
+		),
+	}
+}
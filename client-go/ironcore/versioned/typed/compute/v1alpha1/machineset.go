@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	applyconfigurationscomputev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/compute/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// MachineSetsGetter has a method to return a MachineSetInterface.
+// A group's client should implement this interface.
+type MachineSetsGetter interface {
+	MachineSets(namespace string) MachineSetInterface
+}
+
+// MachineSetInterface has methods to work with MachineSet resources.
+type MachineSetInterface interface {
+	Create(ctx context.Context, machineSet *computev1alpha1.MachineSet, opts v1.CreateOptions) (*computev1alpha1.MachineSet, error)
+	Update(ctx context.Context, machineSet *computev1alpha1.MachineSet, opts v1.UpdateOptions) (*computev1alpha1.MachineSet, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, machineSet *computev1alpha1.MachineSet, opts v1.UpdateOptions) (*computev1alpha1.MachineSet, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*computev1alpha1.MachineSet, error)
+	List(ctx context.Context, opts v1.ListOptions) (*computev1alpha1.MachineSetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *computev1alpha1.MachineSet, err error)
+	Apply(ctx context.Context, machineSet *applyconfigurationscomputev1alpha1.MachineSetApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineSet, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, machineSet *applyconfigurationscomputev1alpha1.MachineSetApplyConfiguration, opts v1.ApplyOptions) (result *computev1alpha1.MachineSet, err error)
+	GetScale(ctx context.Context, machineSetName string, options v1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, machineSetName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
+
+	MachineSetExpansion
+}
+
+// machineSets implements MachineSetInterface
+type machineSets struct {
+	*gentype.ClientWithListAndApply[*computev1alpha1.MachineSet, *computev1alpha1.MachineSetList, *applyconfigurationscomputev1alpha1.MachineSetApplyConfiguration]
+}
+
+// newMachineSets returns a MachineSets
+func newMachineSets(c *ComputeV1alpha1Client, namespace string) *machineSets {
+	return &machineSets{
+		gentype.NewClientWithListAndApply[*computev1alpha1.MachineSet, *computev1alpha1.MachineSetList, *applyconfigurationscomputev1alpha1.MachineSetApplyConfiguration](
+			"machinesets",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *computev1alpha1.MachineSet { return &computev1alpha1.MachineSet{} },
+			func() *computev1alpha1.MachineSetList { return &computev1alpha1.MachineSetList{} },
+		),
+	}
+}
+
+// GetScale takes name of the machineSet, and returns the corresponding autoscalingv1.Scale object, and an error if there is any.
+func (c *machineSets) GetScale(ctx context.Context, machineSetName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Get().
+		Namespace(c.GetNamespace()).
+		Resource("machinesets").
+		Name(machineSetName).
+		SubResource("scale").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateScale takes the top resource name and the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *machineSets) UpdateScale(ctx context.Context, machineSetName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.GetClient().Put().
+		Namespace(c.GetNamespace()).
+		Resource("machinesets").
+		Name(machineSetName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -31,6 +31,10 @@ func (c *FakeStorageV1alpha1) Volumes(namespace string) v1alpha1.VolumeInterface
 	return newFakeVolumes(c, namespace)
 }
 
+func (c *FakeStorageV1alpha1) VolumeBackups(namespace string) v1alpha1.VolumeBackupInterface {
+	return newFakeVolumeBackups(c, namespace)
+}
+
 func (c *FakeStorageV1alpha1) VolumeClasses() v1alpha1.VolumeClassInterface {
 	return newFakeVolumeClasses(c)
 }
@@ -43,6 +47,18 @@ func (c *FakeStorageV1alpha1) VolumeSnapshots(namespace string) v1alpha1.VolumeS
 	return newFakeVolumeSnapshots(c, namespace)
 }
 
+func (c *FakeStorageV1alpha1) VolumeSnapshotGroups(namespace string) v1alpha1.VolumeSnapshotGroupInterface {
+	return newFakeVolumeSnapshotGroups(c, namespace)
+}
+
+func (c *FakeStorageV1alpha1) VolumeSnapshotGroupRestores(namespace string) v1alpha1.VolumeSnapshotGroupRestoreInterface {
+	return newFakeVolumeSnapshotGroupRestores(c, namespace)
+}
+
+func (c *FakeStorageV1alpha1) VolumeSnapshotSchedules(namespace string) v1alpha1.VolumeSnapshotScheduleInterface {
+	return newFakeVolumeSnapshotSchedules(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeStorageV1alpha1) RESTClient() rest.Interface {
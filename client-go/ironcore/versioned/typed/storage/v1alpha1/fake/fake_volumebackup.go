@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	typedstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/storage/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVolumeBackups implements VolumeBackupInterface
+type fakeVolumeBackups struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.VolumeBackup, *v1alpha1.VolumeBackupList, *storagev1alpha1.VolumeBackupApplyConfiguration]
+	Fake *FakeStorageV1alpha1
+}
+
+func newFakeVolumeBackups(fake *FakeStorageV1alpha1, namespace string) typedstoragev1alpha1.VolumeBackupInterface {
+	return &fakeVolumeBackups{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.VolumeBackup, *v1alpha1.VolumeBackupList, *storagev1alpha1.VolumeBackupApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("volumebackups"),
+			v1alpha1.SchemeGroupVersion.WithKind("VolumeBackup"),
+			func() *v1alpha1.VolumeBackup { return &v1alpha1.VolumeBackup{} },
+			func() *v1alpha1.VolumeBackupList { return &v1alpha1.VolumeBackupList{} },
+			func(dst, src *v1alpha1.VolumeBackupList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.VolumeBackupList) []*v1alpha1.VolumeBackup {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.VolumeBackupList, items []*v1alpha1.VolumeBackup) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	typedstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/storage/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVolumeSnapshotGroups implements VolumeSnapshotGroupInterface
+type fakeVolumeSnapshotGroups struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.VolumeSnapshotGroup, *v1alpha1.VolumeSnapshotGroupList, *storagev1alpha1.VolumeSnapshotGroupApplyConfiguration]
+	Fake *FakeStorageV1alpha1
+}
+
+func newFakeVolumeSnapshotGroups(fake *FakeStorageV1alpha1, namespace string) typedstoragev1alpha1.VolumeSnapshotGroupInterface {
+	return &fakeVolumeSnapshotGroups{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.VolumeSnapshotGroup, *v1alpha1.VolumeSnapshotGroupList, *storagev1alpha1.VolumeSnapshotGroupApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("volumesnapshotgroups"),
+			v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroup"),
+			func() *v1alpha1.VolumeSnapshotGroup { return &v1alpha1.VolumeSnapshotGroup{} },
+			func() *v1alpha1.VolumeSnapshotGroupList { return &v1alpha1.VolumeSnapshotGroupList{} },
+			func(dst, src *v1alpha1.VolumeSnapshotGroupList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.VolumeSnapshotGroupList) []*v1alpha1.VolumeSnapshotGroup {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.VolumeSnapshotGroupList, items []*v1alpha1.VolumeSnapshotGroup) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
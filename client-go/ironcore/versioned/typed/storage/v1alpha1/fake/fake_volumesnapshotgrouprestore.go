@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	typedstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/storage/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVolumeSnapshotGroupRestores implements VolumeSnapshotGroupRestoreInterface
+type fakeVolumeSnapshotGroupRestores struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.VolumeSnapshotGroupRestore, *v1alpha1.VolumeSnapshotGroupRestoreList, *storagev1alpha1.VolumeSnapshotGroupRestoreApplyConfiguration]
+	Fake *FakeStorageV1alpha1
+}
+
+func newFakeVolumeSnapshotGroupRestores(fake *FakeStorageV1alpha1, namespace string) typedstoragev1alpha1.VolumeSnapshotGroupRestoreInterface {
+	return &fakeVolumeSnapshotGroupRestores{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.VolumeSnapshotGroupRestore, *v1alpha1.VolumeSnapshotGroupRestoreList, *storagev1alpha1.VolumeSnapshotGroupRestoreApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("volumesnapshotgrouprestores"),
+			v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotGroupRestore"),
+			func() *v1alpha1.VolumeSnapshotGroupRestore { return &v1alpha1.VolumeSnapshotGroupRestore{} },
+			func() *v1alpha1.VolumeSnapshotGroupRestoreList { return &v1alpha1.VolumeSnapshotGroupRestoreList{} },
+			func(dst, src *v1alpha1.VolumeSnapshotGroupRestoreList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.VolumeSnapshotGroupRestoreList) []*v1alpha1.VolumeSnapshotGroupRestore {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.VolumeSnapshotGroupRestoreList, items []*v1alpha1.VolumeSnapshotGroupRestore) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
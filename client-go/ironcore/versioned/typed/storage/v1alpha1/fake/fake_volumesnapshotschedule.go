@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	typedstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/typed/storage/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVolumeSnapshotSchedules implements VolumeSnapshotScheduleInterface
+type fakeVolumeSnapshotSchedules struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.VolumeSnapshotSchedule, *v1alpha1.VolumeSnapshotScheduleList, *storagev1alpha1.VolumeSnapshotScheduleApplyConfiguration]
+	Fake *FakeStorageV1alpha1
+}
+
+func newFakeVolumeSnapshotSchedules(fake *FakeStorageV1alpha1, namespace string) typedstoragev1alpha1.VolumeSnapshotScheduleInterface {
+	return &fakeVolumeSnapshotSchedules{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.VolumeSnapshotSchedule, *v1alpha1.VolumeSnapshotScheduleList, *storagev1alpha1.VolumeSnapshotScheduleApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("volumesnapshotschedules"),
+			v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotSchedule"),
+			func() *v1alpha1.VolumeSnapshotSchedule { return &v1alpha1.VolumeSnapshotSchedule{} },
+			func() *v1alpha1.VolumeSnapshotScheduleList { return &v1alpha1.VolumeSnapshotScheduleList{} },
+			func(dst, src *v1alpha1.VolumeSnapshotScheduleList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.VolumeSnapshotScheduleList) []*v1alpha1.VolumeSnapshotSchedule {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.VolumeSnapshotScheduleList, items []*v1alpha1.VolumeSnapshotSchedule) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
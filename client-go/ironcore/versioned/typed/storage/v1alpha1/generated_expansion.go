@@ -13,8 +13,16 @@ type BucketPoolExpansion interface{}
 
 type VolumeExpansion interface{}
 
+type VolumeBackupExpansion interface{}
+
 type VolumeClassExpansion interface{}
 
 type VolumePoolExpansion interface{}
 
 type VolumeSnapshotExpansion interface{}
+
+type VolumeSnapshotGroupExpansion interface{}
+
+type VolumeSnapshotGroupRestoreExpansion interface{}
+
+type VolumeSnapshotScheduleExpansion interface{}
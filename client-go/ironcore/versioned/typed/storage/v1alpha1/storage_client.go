@@ -19,9 +19,13 @@ type StorageV1alpha1Interface interface {
 	BucketClassesGetter
 	BucketPoolsGetter
 	VolumesGetter
+	VolumeBackupsGetter
 	VolumeClassesGetter
 	VolumePoolsGetter
 	VolumeSnapshotsGetter
+	VolumeSnapshotGroupsGetter
+	VolumeSnapshotGroupRestoresGetter
+	VolumeSnapshotSchedulesGetter
 }
 
 // StorageV1alpha1Client is used to interact with features provided by the storage.ironcore.dev group.
@@ -45,6 +49,10 @@ func (c *StorageV1alpha1Client) Volumes(namespace string) VolumeInterface {
 	return newVolumes(c, namespace)
 }
 
+func (c *StorageV1alpha1Client) VolumeBackups(namespace string) VolumeBackupInterface {
+	return newVolumeBackups(c, namespace)
+}
+
 func (c *StorageV1alpha1Client) VolumeClasses() VolumeClassInterface {
 	return newVolumeClasses(c)
 }
@@ -57,6 +65,18 @@ func (c *StorageV1alpha1Client) VolumeSnapshots(namespace string) VolumeSnapshot
 	return newVolumeSnapshots(c, namespace)
 }
 
+func (c *StorageV1alpha1Client) VolumeSnapshotGroups(namespace string) VolumeSnapshotGroupInterface {
+	return newVolumeSnapshotGroups(c, namespace)
+}
+
+func (c *StorageV1alpha1Client) VolumeSnapshotGroupRestores(namespace string) VolumeSnapshotGroupRestoreInterface {
+	return newVolumeSnapshotGroupRestores(c, namespace)
+}
+
+func (c *StorageV1alpha1Client) VolumeSnapshotSchedules(namespace string) VolumeSnapshotScheduleInterface {
+	return newVolumeSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new StorageV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
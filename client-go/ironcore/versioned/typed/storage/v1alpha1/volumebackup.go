@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	applyconfigurationsstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VolumeBackupsGetter has a method to return a VolumeBackupInterface.
+// A group's client should implement this interface.
+type VolumeBackupsGetter interface {
+	VolumeBackups(namespace string) VolumeBackupInterface
+}
+
+// VolumeBackupInterface has methods to work with VolumeBackup resources.
+type VolumeBackupInterface interface {
+	Create(ctx context.Context, volumeBackup *storagev1alpha1.VolumeBackup, opts v1.CreateOptions) (*storagev1alpha1.VolumeBackup, error)
+	Update(ctx context.Context, volumeBackup *storagev1alpha1.VolumeBackup, opts v1.UpdateOptions) (*storagev1alpha1.VolumeBackup, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, volumeBackup *storagev1alpha1.VolumeBackup, opts v1.UpdateOptions) (*storagev1alpha1.VolumeBackup, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*storagev1alpha1.VolumeBackup, error)
+	List(ctx context.Context, opts v1.ListOptions) (*storagev1alpha1.VolumeBackupList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *storagev1alpha1.VolumeBackup, err error)
+	Apply(ctx context.Context, volumeBackup *applyconfigurationsstoragev1alpha1.VolumeBackupApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeBackup, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, volumeBackup *applyconfigurationsstoragev1alpha1.VolumeBackupApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeBackup, err error)
+	VolumeBackupExpansion
+}
+
+// volumeBackups implements VolumeBackupInterface
+type volumeBackups struct {
+	*gentype.ClientWithListAndApply[*storagev1alpha1.VolumeBackup, *storagev1alpha1.VolumeBackupList, *applyconfigurationsstoragev1alpha1.VolumeBackupApplyConfiguration]
+}
+
+// newVolumeBackups returns a VolumeBackups
+func newVolumeBackups(c *StorageV1alpha1Client, namespace string) *volumeBackups {
+	return &volumeBackups{
+		gentype.NewClientWithListAndApply[*storagev1alpha1.VolumeBackup, *storagev1alpha1.VolumeBackupList, *applyconfigurationsstoragev1alpha1.VolumeBackupApplyConfiguration](
+			"volumebackups",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *storagev1alpha1.VolumeBackup { return &storagev1alpha1.VolumeBackup{} },
+			func() *storagev1alpha1.VolumeBackupList { return &storagev1alpha1.VolumeBackupList{} },
+		),
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	applyconfigurationsstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VolumeSnapshotGroupsGetter has a method to return a VolumeSnapshotGroupInterface.
+// A group's client should implement this interface.
+type VolumeSnapshotGroupsGetter interface {
+	VolumeSnapshotGroups(namespace string) VolumeSnapshotGroupInterface
+}
+
+// VolumeSnapshotGroupInterface has methods to work with VolumeSnapshotGroup resources.
+type VolumeSnapshotGroupInterface interface {
+	Create(ctx context.Context, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup, opts v1.CreateOptions) (*storagev1alpha1.VolumeSnapshotGroup, error)
+	Update(ctx context.Context, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup, opts v1.UpdateOptions) (*storagev1alpha1.VolumeSnapshotGroup, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup, opts v1.UpdateOptions) (*storagev1alpha1.VolumeSnapshotGroup, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*storagev1alpha1.VolumeSnapshotGroup, error)
+	List(ctx context.Context, opts v1.ListOptions) (*storagev1alpha1.VolumeSnapshotGroupList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *storagev1alpha1.VolumeSnapshotGroup, err error)
+	Apply(ctx context.Context, volumeSnapshotGroup *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeSnapshotGroup, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, volumeSnapshotGroup *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeSnapshotGroup, err error)
+	VolumeSnapshotGroupExpansion
+}
+
+// volumeSnapshotGroups implements VolumeSnapshotGroupInterface
+type volumeSnapshotGroups struct {
+	*gentype.ClientWithListAndApply[*storagev1alpha1.VolumeSnapshotGroup, *storagev1alpha1.VolumeSnapshotGroupList, *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupApplyConfiguration]
+}
+
+// newVolumeSnapshotGroups returns a VolumeSnapshotGroups
+func newVolumeSnapshotGroups(c *StorageV1alpha1Client, namespace string) *volumeSnapshotGroups {
+	return &volumeSnapshotGroups{
+		gentype.NewClientWithListAndApply[*storagev1alpha1.VolumeSnapshotGroup, *storagev1alpha1.VolumeSnapshotGroupList, *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupApplyConfiguration](
+			"volumesnapshotgroups",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *storagev1alpha1.VolumeSnapshotGroup { return &storagev1alpha1.VolumeSnapshotGroup{} },
+			func() *storagev1alpha1.VolumeSnapshotGroupList { return &storagev1alpha1.VolumeSnapshotGroupList{} },
+		),
+	}
+}
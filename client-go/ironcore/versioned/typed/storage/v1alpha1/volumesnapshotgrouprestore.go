@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	applyconfigurationsstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VolumeSnapshotGroupRestoresGetter has a method to return a VolumeSnapshotGroupRestoreInterface.
+// A group's client should implement this interface.
+type VolumeSnapshotGroupRestoresGetter interface {
+	VolumeSnapshotGroupRestores(namespace string) VolumeSnapshotGroupRestoreInterface
+}
+
+// VolumeSnapshotGroupRestoreInterface has methods to work with VolumeSnapshotGroupRestore resources.
+type VolumeSnapshotGroupRestoreInterface interface {
+	Create(ctx context.Context, volumeSnapshotGroupRestore *storagev1alpha1.VolumeSnapshotGroupRestore, opts v1.CreateOptions) (*storagev1alpha1.VolumeSnapshotGroupRestore, error)
+	Update(ctx context.Context, volumeSnapshotGroupRestore *storagev1alpha1.VolumeSnapshotGroupRestore, opts v1.UpdateOptions) (*storagev1alpha1.VolumeSnapshotGroupRestore, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, volumeSnapshotGroupRestore *storagev1alpha1.VolumeSnapshotGroupRestore, opts v1.UpdateOptions) (*storagev1alpha1.VolumeSnapshotGroupRestore, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*storagev1alpha1.VolumeSnapshotGroupRestore, error)
+	List(ctx context.Context, opts v1.ListOptions) (*storagev1alpha1.VolumeSnapshotGroupRestoreList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *storagev1alpha1.VolumeSnapshotGroupRestore, err error)
+	Apply(ctx context.Context, volumeSnapshotGroupRestore *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeSnapshotGroupRestore, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, volumeSnapshotGroupRestore *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeSnapshotGroupRestore, err error)
+	VolumeSnapshotGroupRestoreExpansion
+}
+
+// volumeSnapshotGroupRestores implements VolumeSnapshotGroupRestoreInterface
+type volumeSnapshotGroupRestores struct {
+	*gentype.ClientWithListAndApply[*storagev1alpha1.VolumeSnapshotGroupRestore, *storagev1alpha1.VolumeSnapshotGroupRestoreList, *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreApplyConfiguration]
+}
+
+// newVolumeSnapshotGroupRestores returns a VolumeSnapshotGroupRestores
+func newVolumeSnapshotGroupRestores(c *StorageV1alpha1Client, namespace string) *volumeSnapshotGroupRestores {
+	return &volumeSnapshotGroupRestores{
+		gentype.NewClientWithListAndApply[*storagev1alpha1.VolumeSnapshotGroupRestore, *storagev1alpha1.VolumeSnapshotGroupRestoreList, *applyconfigurationsstoragev1alpha1.VolumeSnapshotGroupRestoreApplyConfiguration](
+			"volumesnapshotgrouprestores",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *storagev1alpha1.VolumeSnapshotGroupRestore {
+				return &storagev1alpha1.VolumeSnapshotGroupRestore{}
+			},
+			func() *storagev1alpha1.VolumeSnapshotGroupRestoreList {
+				return &storagev1alpha1.VolumeSnapshotGroupRestoreList{}
+			},
+		),
+	}
+}
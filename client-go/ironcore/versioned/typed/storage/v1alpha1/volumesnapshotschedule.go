@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	applyconfigurationsstoragev1alpha1 "github.com/ironcore-dev/ironcore/client-go/applyconfigurations/storage/v1alpha1"
+	scheme "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VolumeSnapshotSchedulesGetter has a method to return a VolumeSnapshotScheduleInterface.
+// A group's client should implement this interface.
+type VolumeSnapshotSchedulesGetter interface {
+	VolumeSnapshotSchedules(namespace string) VolumeSnapshotScheduleInterface
+}
+
+// VolumeSnapshotScheduleInterface has methods to work with VolumeSnapshotSchedule resources.
+type VolumeSnapshotScheduleInterface interface {
+	Create(ctx context.Context, volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, opts v1.CreateOptions) (*storagev1alpha1.VolumeSnapshotSchedule, error)
+	Update(ctx context.Context, volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, opts v1.UpdateOptions) (*storagev1alpha1.VolumeSnapshotSchedule, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, opts v1.UpdateOptions) (*storagev1alpha1.VolumeSnapshotSchedule, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*storagev1alpha1.VolumeSnapshotSchedule, error)
+	List(ctx context.Context, opts v1.ListOptions) (*storagev1alpha1.VolumeSnapshotScheduleList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *storagev1alpha1.VolumeSnapshotSchedule, err error)
+	Apply(ctx context.Context, volumeSnapshotSchedule *applyconfigurationsstoragev1alpha1.VolumeSnapshotScheduleApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeSnapshotSchedule, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, volumeSnapshotSchedule *applyconfigurationsstoragev1alpha1.VolumeSnapshotScheduleApplyConfiguration, opts v1.ApplyOptions) (result *storagev1alpha1.VolumeSnapshotSchedule, err error)
+	VolumeSnapshotScheduleExpansion
+}
+
+// volumeSnapshotSchedules implements VolumeSnapshotScheduleInterface
+type volumeSnapshotSchedules struct {
+	*gentype.ClientWithListAndApply[*storagev1alpha1.VolumeSnapshotSchedule, *storagev1alpha1.VolumeSnapshotScheduleList, *applyconfigurationsstoragev1alpha1.VolumeSnapshotScheduleApplyConfiguration]
+}
+
+// newVolumeSnapshotSchedules returns a VolumeSnapshotSchedules
+func newVolumeSnapshotSchedules(c *StorageV1alpha1Client, namespace string) *volumeSnapshotSchedules {
+	return &volumeSnapshotSchedules{
+		gentype.NewClientWithListAndApply[*storagev1alpha1.VolumeSnapshotSchedule, *storagev1alpha1.VolumeSnapshotScheduleList, *applyconfigurationsstoragev1alpha1.VolumeSnapshotScheduleApplyConfiguration](
+			"volumesnapshotschedules",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *storagev1alpha1.VolumeSnapshotSchedule { return &storagev1alpha1.VolumeSnapshotSchedule{} },
+			func() *storagev1alpha1.VolumeSnapshotScheduleList {
+				return &storagev1alpha1.VolumeSnapshotScheduleList{}
+			},
+		),
+	}
+}
@@ -17,6 +17,42 @@ type MachineNamespaceListerExpansion interface{}
 // MachineClassLister.
 type MachineClassListerExpansion interface{}
 
+// MachineDeploymentListerExpansion allows custom methods to be added to
+// MachineDeploymentLister.
+type MachineDeploymentListerExpansion interface{}
+
+// MachineDeploymentNamespaceListerExpansion allows custom methods to be added to
+// MachineDeploymentNamespaceLister.
+type MachineDeploymentNamespaceListerExpansion interface{}
+
+// MachineDisruptionBudgetListerExpansion allows custom methods to be added to
+// MachineDisruptionBudgetLister.
+type MachineDisruptionBudgetListerExpansion interface{}
+
+// MachineDisruptionBudgetNamespaceListerExpansion allows custom methods to be added to
+// MachineDisruptionBudgetNamespaceLister.
+type MachineDisruptionBudgetNamespaceListerExpansion interface{}
+
+// MachineHealthCheckListerExpansion allows custom methods to be added to
+// MachineHealthCheckLister.
+type MachineHealthCheckListerExpansion interface{}
+
+// MachineHealthCheckNamespaceListerExpansion allows custom methods to be added to
+// MachineHealthCheckNamespaceLister.
+type MachineHealthCheckNamespaceListerExpansion interface{}
+
 // MachinePoolListerExpansion allows custom methods to be added to
 // MachinePoolLister.
 type MachinePoolListerExpansion interface{}
+
+// MachinePriorityClassListerExpansion allows custom methods to be added to
+// MachinePriorityClassLister.
+type MachinePriorityClassListerExpansion interface{}
+
+// MachineSetListerExpansion allows custom methods to be added to
+// MachineSetLister.
+type MachineSetListerExpansion interface{}
+
+// MachineSetNamespaceListerExpansion allows custom methods to be added to
+// MachineSetNamespaceLister.
+type MachineSetNamespaceListerExpansion interface{}
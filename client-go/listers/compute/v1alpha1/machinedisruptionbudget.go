@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MachineDisruptionBudgetLister helps list MachineDisruptionBudgets.
+// All objects returned here must be treated as read-only.
+type MachineDisruptionBudgetLister interface {
+	// List lists all MachineDisruptionBudgets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*computev1alpha1.MachineDisruptionBudget, err error)
+	// MachineDisruptionBudgets returns an object that can list and get MachineDisruptionBudgets.
+	MachineDisruptionBudgets(namespace string) MachineDisruptionBudgetNamespaceLister
+	MachineDisruptionBudgetListerExpansion
+}
+
+// machineDisruptionBudgetLister implements the MachineDisruptionBudgetLister interface.
+type machineDisruptionBudgetLister struct {
+	listers.ResourceIndexer[*computev1alpha1.MachineDisruptionBudget]
+}
+
+// NewMachineDisruptionBudgetLister returns a new MachineDisruptionBudgetLister.
+func NewMachineDisruptionBudgetLister(indexer cache.Indexer) MachineDisruptionBudgetLister {
+	return &machineDisruptionBudgetLister{listers.New[*computev1alpha1.MachineDisruptionBudget](indexer, computev1alpha1.Resource("machinedisruptionbudget"))}
+}
+
+// MachineDisruptionBudgets returns an object that can list and get MachineDisruptionBudgets.
+func (s *machineDisruptionBudgetLister) MachineDisruptionBudgets(namespace string) MachineDisruptionBudgetNamespaceLister {
+	return machineDisruptionBudgetNamespaceLister{listers.NewNamespaced[*computev1alpha1.MachineDisruptionBudget](s.ResourceIndexer, namespace)}
+}
+
+// MachineDisruptionBudgetNamespaceLister helps list and get MachineDisruptionBudgets.
+// All objects returned here must be treated as read-only.
+type MachineDisruptionBudgetNamespaceLister interface {
+	// List lists all MachineDisruptionBudgets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*computev1alpha1.MachineDisruptionBudget, err error)
+	// Get retrieves the MachineDisruptionBudget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*computev1alpha1.MachineDisruptionBudget, error)
+	MachineDisruptionBudgetNamespaceListerExpansion
+}
+
+// machineDisruptionBudgetNamespaceLister implements the MachineDisruptionBudgetNamespaceLister
+// interface.
+type machineDisruptionBudgetNamespaceLister struct {
+	listers.ResourceIndexer[*computev1alpha1.MachineDisruptionBudget]
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MachineHealthCheckLister helps list MachineHealthChecks.
+// All objects returned here must be treated as read-only.
+type MachineHealthCheckLister interface {
+	// List lists all MachineHealthChecks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*computev1alpha1.MachineHealthCheck, err error)
+	// MachineHealthChecks returns an object that can list and get MachineHealthChecks.
+	MachineHealthChecks(namespace string) MachineHealthCheckNamespaceLister
+	MachineHealthCheckListerExpansion
+}
+
+// machineHealthCheckLister implements the MachineHealthCheckLister interface.
+type machineHealthCheckLister struct {
+	listers.ResourceIndexer[*computev1alpha1.MachineHealthCheck]
+}
+
+// NewMachineHealthCheckLister returns a new MachineHealthCheckLister.
+func NewMachineHealthCheckLister(indexer cache.Indexer) MachineHealthCheckLister {
+	return &machineHealthCheckLister{listers.New[*computev1alpha1.MachineHealthCheck](indexer, computev1alpha1.Resource("machinehealthcheck"))}
+}
+
+// MachineHealthChecks returns an object that can list and get MachineHealthChecks.
+func (s *machineHealthCheckLister) MachineHealthChecks(namespace string) MachineHealthCheckNamespaceLister {
+	return machineHealthCheckNamespaceLister{listers.NewNamespaced[*computev1alpha1.MachineHealthCheck](s.ResourceIndexer, namespace)}
+}
+
+// MachineHealthCheckNamespaceLister helps list and get MachineHealthChecks.
+// All objects returned here must be treated as read-only.
+type MachineHealthCheckNamespaceLister interface {
+	// List lists all MachineHealthChecks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*computev1alpha1.MachineHealthCheck, err error)
+	// Get retrieves the MachineHealthCheck from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*computev1alpha1.MachineHealthCheck, error)
+	MachineHealthCheckNamespaceListerExpansion
+}
+
+// machineHealthCheckNamespaceLister implements the MachineHealthCheckNamespaceLister
+// interface.
+type machineHealthCheckNamespaceLister struct {
+	listers.ResourceIndexer[*computev1alpha1.MachineHealthCheck]
+}
@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MachinePriorityClassLister helps list MachinePriorityClasses.
+// All objects returned here must be treated as read-only.
+type MachinePriorityClassLister interface {
+	// List lists all MachinePriorityClasses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*computev1alpha1.MachinePriorityClass, err error)
+	// Get retrieves the MachinePriorityClass from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*computev1alpha1.MachinePriorityClass, error)
+	MachinePriorityClassListerExpansion
+}
+
+// machinePriorityClassLister implements the MachinePriorityClassLister interface.
+type machinePriorityClassLister struct {
+	listers.ResourceIndexer[*computev1alpha1.MachinePriorityClass]
+}
+
+// NewMachinePriorityClassLister returns a new MachinePriorityClassLister.
+func NewMachinePriorityClassLister(indexer cache.Indexer) MachinePriorityClassLister {
+	return &machinePriorityClassLister{listers.New[*computev1alpha1.MachinePriorityClass](indexer, computev1alpha1.Resource("machinepriorityclass"))}
+}
@@ -29,6 +29,14 @@ type VolumeListerExpansion interface{}
 // VolumeNamespaceLister.
 type VolumeNamespaceListerExpansion interface{}
 
+// VolumeBackupListerExpansion allows custom methods to be added to
+// VolumeBackupLister.
+type VolumeBackupListerExpansion interface{}
+
+// VolumeBackupNamespaceListerExpansion allows custom methods to be added to
+// VolumeBackupNamespaceLister.
+type VolumeBackupNamespaceListerExpansion interface{}
+
 // VolumeClassListerExpansion allows custom methods to be added to
 // VolumeClassLister.
 type VolumeClassListerExpansion interface{}
@@ -44,3 +52,27 @@ type VolumeSnapshotListerExpansion interface{}
 // VolumeSnapshotNamespaceListerExpansion allows custom methods to be added to
 // VolumeSnapshotNamespaceLister.
 type VolumeSnapshotNamespaceListerExpansion interface{}
+
+// VolumeSnapshotGroupListerExpansion allows custom methods to be added to
+// VolumeSnapshotGroupLister.
+type VolumeSnapshotGroupListerExpansion interface{}
+
+// VolumeSnapshotGroupNamespaceListerExpansion allows custom methods to be added to
+// VolumeSnapshotGroupNamespaceLister.
+type VolumeSnapshotGroupNamespaceListerExpansion interface{}
+
+// VolumeSnapshotGroupRestoreListerExpansion allows custom methods to be added to
+// VolumeSnapshotGroupRestoreLister.
+type VolumeSnapshotGroupRestoreListerExpansion interface{}
+
+// VolumeSnapshotGroupRestoreNamespaceListerExpansion allows custom methods to be added to
+// VolumeSnapshotGroupRestoreNamespaceLister.
+type VolumeSnapshotGroupRestoreNamespaceListerExpansion interface{}
+
+// VolumeSnapshotScheduleListerExpansion allows custom methods to be added to
+// VolumeSnapshotScheduleLister.
+type VolumeSnapshotScheduleListerExpansion interface{}
+
+// VolumeSnapshotScheduleNamespaceListerExpansion allows custom methods to be added to
+// VolumeSnapshotScheduleNamespaceLister.
+type VolumeSnapshotScheduleNamespaceListerExpansion interface{}
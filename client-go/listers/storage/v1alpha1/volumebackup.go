@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VolumeBackupLister helps list VolumeBackups.
+// All objects returned here must be treated as read-only.
+type VolumeBackupLister interface {
+	// List lists all VolumeBackups in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeBackup, err error)
+	// VolumeBackups returns an object that can list and get VolumeBackups.
+	VolumeBackups(namespace string) VolumeBackupNamespaceLister
+	VolumeBackupListerExpansion
+}
+
+// volumeBackupLister implements the VolumeBackupLister interface.
+type volumeBackupLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeBackup]
+}
+
+// NewVolumeBackupLister returns a new VolumeBackupLister.
+func NewVolumeBackupLister(indexer cache.Indexer) VolumeBackupLister {
+	return &volumeBackupLister{listers.New[*storagev1alpha1.VolumeBackup](indexer, storagev1alpha1.Resource("volumebackup"))}
+}
+
+// VolumeBackups returns an object that can list and get VolumeBackups.
+func (s *volumeBackupLister) VolumeBackups(namespace string) VolumeBackupNamespaceLister {
+	return volumeBackupNamespaceLister{listers.NewNamespaced[*storagev1alpha1.VolumeBackup](s.ResourceIndexer, namespace)}
+}
+
+// VolumeBackupNamespaceLister helps list and get VolumeBackups.
+// All objects returned here must be treated as read-only.
+type VolumeBackupNamespaceLister interface {
+	// List lists all VolumeBackups in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeBackup, err error)
+	// Get retrieves the VolumeBackup from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*storagev1alpha1.VolumeBackup, error)
+	VolumeBackupNamespaceListerExpansion
+}
+
+// volumeBackupNamespaceLister implements the VolumeBackupNamespaceLister
+// interface.
+type volumeBackupNamespaceLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeBackup]
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VolumeSnapshotGroupLister helps list VolumeSnapshotGroups.
+// All objects returned here must be treated as read-only.
+type VolumeSnapshotGroupLister interface {
+	// List lists all VolumeSnapshotGroups in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeSnapshotGroup, err error)
+	// VolumeSnapshotGroups returns an object that can list and get VolumeSnapshotGroups.
+	VolumeSnapshotGroups(namespace string) VolumeSnapshotGroupNamespaceLister
+	VolumeSnapshotGroupListerExpansion
+}
+
+// volumeSnapshotGroupLister implements the VolumeSnapshotGroupLister interface.
+type volumeSnapshotGroupLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeSnapshotGroup]
+}
+
+// NewVolumeSnapshotGroupLister returns a new VolumeSnapshotGroupLister.
+func NewVolumeSnapshotGroupLister(indexer cache.Indexer) VolumeSnapshotGroupLister {
+	return &volumeSnapshotGroupLister{listers.New[*storagev1alpha1.VolumeSnapshotGroup](indexer, storagev1alpha1.Resource("volumesnapshotgroup"))}
+}
+
+// VolumeSnapshotGroups returns an object that can list and get VolumeSnapshotGroups.
+func (s *volumeSnapshotGroupLister) VolumeSnapshotGroups(namespace string) VolumeSnapshotGroupNamespaceLister {
+	return volumeSnapshotGroupNamespaceLister{listers.NewNamespaced[*storagev1alpha1.VolumeSnapshotGroup](s.ResourceIndexer, namespace)}
+}
+
+// VolumeSnapshotGroupNamespaceLister helps list and get VolumeSnapshotGroups.
+// All objects returned here must be treated as read-only.
+type VolumeSnapshotGroupNamespaceLister interface {
+	// List lists all VolumeSnapshotGroups in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeSnapshotGroup, err error)
+	// Get retrieves the VolumeSnapshotGroup from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*storagev1alpha1.VolumeSnapshotGroup, error)
+	VolumeSnapshotGroupNamespaceListerExpansion
+}
+
+// volumeSnapshotGroupNamespaceLister implements the VolumeSnapshotGroupNamespaceLister
+// interface.
+type volumeSnapshotGroupNamespaceLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeSnapshotGroup]
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VolumeSnapshotGroupRestoreLister helps list VolumeSnapshotGroupRestores.
+// All objects returned here must be treated as read-only.
+type VolumeSnapshotGroupRestoreLister interface {
+	// List lists all VolumeSnapshotGroupRestores in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeSnapshotGroupRestore, err error)
+	// VolumeSnapshotGroupRestores returns an object that can list and get VolumeSnapshotGroupRestores.
+	VolumeSnapshotGroupRestores(namespace string) VolumeSnapshotGroupRestoreNamespaceLister
+	VolumeSnapshotGroupRestoreListerExpansion
+}
+
+// volumeSnapshotGroupRestoreLister implements the VolumeSnapshotGroupRestoreLister interface.
+type volumeSnapshotGroupRestoreLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeSnapshotGroupRestore]
+}
+
+// NewVolumeSnapshotGroupRestoreLister returns a new VolumeSnapshotGroupRestoreLister.
+func NewVolumeSnapshotGroupRestoreLister(indexer cache.Indexer) VolumeSnapshotGroupRestoreLister {
+	return &volumeSnapshotGroupRestoreLister{listers.New[*storagev1alpha1.VolumeSnapshotGroupRestore](indexer, storagev1alpha1.Resource("volumesnapshotgrouprestore"))}
+}
+
+// VolumeSnapshotGroupRestores returns an object that can list and get VolumeSnapshotGroupRestores.
+func (s *volumeSnapshotGroupRestoreLister) VolumeSnapshotGroupRestores(namespace string) VolumeSnapshotGroupRestoreNamespaceLister {
+	return volumeSnapshotGroupRestoreNamespaceLister{listers.NewNamespaced[*storagev1alpha1.VolumeSnapshotGroupRestore](s.ResourceIndexer, namespace)}
+}
+
+// VolumeSnapshotGroupRestoreNamespaceLister helps list and get VolumeSnapshotGroupRestores.
+// All objects returned here must be treated as read-only.
+type VolumeSnapshotGroupRestoreNamespaceLister interface {
+	// List lists all VolumeSnapshotGroupRestores in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeSnapshotGroupRestore, err error)
+	// Get retrieves the VolumeSnapshotGroupRestore from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*storagev1alpha1.VolumeSnapshotGroupRestore, error)
+	VolumeSnapshotGroupRestoreNamespaceListerExpansion
+}
+
+// volumeSnapshotGroupRestoreNamespaceLister implements the VolumeSnapshotGroupRestoreNamespaceLister
+// interface.
+type volumeSnapshotGroupRestoreNamespaceLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeSnapshotGroupRestore]
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// VolumeSnapshotScheduleLister helps list VolumeSnapshotSchedules.
+// All objects returned here must be treated as read-only.
+type VolumeSnapshotScheduleLister interface {
+	// List lists all VolumeSnapshotSchedules in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeSnapshotSchedule, err error)
+	// VolumeSnapshotSchedules returns an object that can list and get VolumeSnapshotSchedules.
+	VolumeSnapshotSchedules(namespace string) VolumeSnapshotScheduleNamespaceLister
+	VolumeSnapshotScheduleListerExpansion
+}
+
+// volumeSnapshotScheduleLister implements the VolumeSnapshotScheduleLister interface.
+type volumeSnapshotScheduleLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeSnapshotSchedule]
+}
+
+// NewVolumeSnapshotScheduleLister returns a new VolumeSnapshotScheduleLister.
+func NewVolumeSnapshotScheduleLister(indexer cache.Indexer) VolumeSnapshotScheduleLister {
+	return &volumeSnapshotScheduleLister{listers.New[*storagev1alpha1.VolumeSnapshotSchedule](indexer, storagev1alpha1.Resource("volumesnapshotschedule"))}
+}
+
+// VolumeSnapshotSchedules returns an object that can list and get VolumeSnapshotSchedules.
+func (s *volumeSnapshotScheduleLister) VolumeSnapshotSchedules(namespace string) VolumeSnapshotScheduleNamespaceLister {
+	return volumeSnapshotScheduleNamespaceLister{listers.NewNamespaced[*storagev1alpha1.VolumeSnapshotSchedule](s.ResourceIndexer, namespace)}
+}
+
+// VolumeSnapshotScheduleNamespaceLister helps list and get VolumeSnapshotSchedules.
+// All objects returned here must be treated as read-only.
+type VolumeSnapshotScheduleNamespaceLister interface {
+	// List lists all VolumeSnapshotSchedules in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*storagev1alpha1.VolumeSnapshotSchedule, err error)
+	// Get retrieves the VolumeSnapshotSchedule from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*storagev1alpha1.VolumeSnapshotSchedule, error)
+	VolumeSnapshotScheduleNamespaceListerExpansion
+}
+
+// volumeSnapshotScheduleNamespaceLister implements the VolumeSnapshotScheduleNamespaceLister
+// interface.
+type volumeSnapshotScheduleNamespaceLister struct {
+	listers.ResourceIndexer[*storagev1alpha1.VolumeSnapshotSchedule]
+}
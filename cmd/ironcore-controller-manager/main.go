@@ -19,6 +19,7 @@ import (
 	storageclient "github.com/ironcore-dev/ironcore/internal/client/storage"
 	computecontrollers "github.com/ironcore-dev/ironcore/internal/controllers/compute"
 	computescheduler "github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
+	schedulerframework "github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler/framework"
 	corecontrollers "github.com/ironcore-dev/ironcore/internal/controllers/core"
 	certificateironcore "github.com/ironcore-dev/ironcore/internal/controllers/core/certificate/ironcore"
 	quotacontrollergeneric "github.com/ironcore-dev/ironcore/internal/controllers/core/quota/generic"
@@ -29,6 +30,7 @@ import (
 	storagescheduler "github.com/ironcore-dev/ironcore/internal/controllers/storage/scheduler"
 	quotaevaluatorironcore "github.com/ironcore-dev/ironcore/internal/quota/evaluator/ironcore"
 	"github.com/ironcore-dev/ironcore/utils/quota"
+	"golang.org/x/time/rate"
 	"k8s.io/utils/lru"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -67,15 +69,23 @@ const (
 	machineEphemeralVolumeController           = "machineephemeralvolume"
 	machineSchedulerController                 = "machinescheduler"
 	machineEvictionController                  = "machineeviction"
+	machineDisruptionBudgetController          = "machinedisruptionbudget"
 	machineClassController                     = "machineclass"
 	machinePoolLifecycleController             = "machinepoollifecycle"
+	machinePoolDrainController                 = "machinepooldrain"
+	machineSetController                       = "machineset"
+	machineDeploymentController                = "machinedeployment"
+	machineHealthCheckController               = "machinehealthcheck"
 
 	// storage controllers
-	bucketScheduler           = "bucketscheduler"
-	bucketClassController     = "bucketclass"
-	volumeReleaseController   = "volumerelease"
-	volumeSchedulerController = "volumescheduler"
-	volumeClassController     = "volumeclass"
+	bucketScheduler                      = "bucketscheduler"
+	bucketClassController                = "bucketclass"
+	volumeReleaseController              = "volumerelease"
+	volumeSchedulerController            = "volumescheduler"
+	volumeClassController                = "volumeclass"
+	volumeSnapshotScheduleController     = "volumesnapshotschedule"
+	volumeSnapshotGroupController        = "volumesnapshotgroup"
+	volumeSnapshotGroupRestoreController = "volumesnapshotgrouprestore"
 
 	// ipam controllers
 	prefixController          = "prefix"
@@ -120,6 +130,9 @@ func main() {
 	var virtualIPBindTimeout time.Duration
 	var networkInterfaceBindTimeout time.Duration
 	var machinePoolLifecycleGracePeriod time.Duration
+	var machineEvictionRate float64
+	var machineEvictionBurst int
+	var machineSchedulerScoreConfig, volumeSchedulerScoreConfig, bucketSchedulerScoreConfig schedulerframework.Config
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -141,6 +154,11 @@ func main() {
 	flag.DurationVar(&virtualIPBindTimeout, "virtual-ip-bind-timeout", 10*time.Second, "Time to wait until considering a virtual ip bind to be failed.")
 	flag.DurationVar(&networkInterfaceBindTimeout, "network-interface-bind-timeout", 10*time.Second, "Time to wait until considering a network interface bind to be failed.")
 	flag.DurationVar(&machinePoolLifecycleGracePeriod, "machine-pool-lifecycle-grace-period", 50*time.Second, "Grace period without a heartbeat before a machine pool's Ready condition is marked Unknown.")
+	flag.Float64Var(&machineEvictionRate, "machine-eviction-rate", 0.1, "Number of machines per second evicted from machine pools with a NoExecute taint. Zero or less disables the rate limit.")
+	flag.IntVar(&machineEvictionBurst, "machine-eviction-burst", 1, "Number of machines that may be evicted at once before --machine-eviction-rate applies.")
+	machineSchedulerScoreConfig.AddFlags(flag.CommandLine, "machine-scheduler")
+	volumeSchedulerScoreConfig.AddFlags(flag.CommandLine, "volume-scheduler")
+	bucketSchedulerScoreConfig.AddFlags(flag.CommandLine, "bucket-scheduler")
 
 	controllers := switches.New(
 		// compute controllers
@@ -148,8 +166,13 @@ func main() {
 		machineEphemeralVolumeController,
 		machineSchedulerController,
 		machineEvictionController,
+		machineDisruptionBudgetController,
 		machineClassController,
 		machinePoolLifecycleController,
+		machinePoolDrainController,
+		machineSetController,
+		machineDeploymentController,
+		machineHealthCheckController,
 
 		// storage controllers
 		bucketScheduler,
@@ -157,6 +180,9 @@ func main() {
 		volumeReleaseController,
 		volumeSchedulerController,
 		volumeClassController,
+		volumeSnapshotScheduleController,
+		volumeSnapshotGroupController,
+		volumeSnapshotGroupRestoreController,
 
 		// ipam controllers
 		prefixController,
@@ -327,6 +353,7 @@ func main() {
 			Client:        mgr.GetClient(),
 			EventRecorder: mgr.GetEventRecorder("machine-scheduler"),
 			Cache:         schedulerCache,
+			ScoreConfig:   machineSchedulerScoreConfig,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "MachineScheduler")
 			os.Exit(1)
@@ -334,15 +361,30 @@ func main() {
 	}
 
 	if controllers.Enabled(machineEvictionController) {
+		var evictionLimiter *rate.Limiter
+		if machineEvictionRate > 0 {
+			evictionLimiter = rate.NewLimiter(rate.Limit(machineEvictionRate), machineEvictionBurst)
+		}
+
 		if err := (&computecontrollers.MachineEvictionReconciler{
-			Client:        mgr.GetClient(),
-			EventRecorder: mgr.GetEventRecorder("machine-eviction"),
+			Client:          mgr.GetClient(),
+			EventRecorder:   mgr.GetEventRecorder("machine-eviction"),
+			EvictionLimiter: evictionLimiter,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "MachineEviction")
 			os.Exit(1)
 		}
 	}
 
+	if controllers.Enabled(machineDisruptionBudgetController) {
+		if err := (&computecontrollers.MachineDisruptionBudgetReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MachineDisruptionBudget")
+			os.Exit(1)
+		}
+	}
+
 	if controllers.Enabled(machineClassController) {
 		if err := (&computecontrollers.MachineClassReconciler{
 			Client:    mgr.GetClient(),
@@ -363,12 +405,50 @@ func main() {
 		}
 	}
 
+	if controllers.Enabled(machinePoolDrainController) {
+		if err := (&computecontrollers.MachinePoolDrainReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MachinePoolDrain")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(machineSetController) {
+		if err := (&computecontrollers.MachineSetReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MachineSet")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(machineDeploymentController) {
+		if err := (&computecontrollers.MachineDeploymentReconciler{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MachineDeployment")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(machineHealthCheckController) {
+		if err := (&computecontrollers.MachineHealthCheckReconciler{
+			EventRecorder: mgr.GetEventRecorder("machine-health-check"),
+			Client:        mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "MachineHealthCheck")
+			os.Exit(1)
+		}
+	}
+
 	// storage controllers
 
 	if controllers.Enabled(bucketScheduler) {
 		if err := (&storagecontrollers.BucketScheduler{
 			EventRecorder: mgr.GetEventRecorder("bucket-scheduler"),
 			Client:        mgr.GetClient(),
+			ScoreConfig:   bucketSchedulerScoreConfig,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "BucketScheduler")
 			os.Exit(1)
@@ -385,6 +465,36 @@ func main() {
 		}
 	}
 
+	if controllers.Enabled(volumeSnapshotScheduleController) {
+		if err := (&storagecontrollers.VolumeSnapshotScheduleReconciler{
+			EventRecorder: mgr.GetEventRecorder("volume-snapshot-schedule"),
+			Client:        mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VolumeSnapshotSchedule")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(volumeSnapshotGroupController) {
+		if err := (&storagecontrollers.VolumeSnapshotGroupReconciler{
+			EventRecorder: mgr.GetEventRecorder("volume-snapshot-group"),
+			Client:        mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VolumeSnapshotGroup")
+			os.Exit(1)
+		}
+	}
+
+	if controllers.Enabled(volumeSnapshotGroupRestoreController) {
+		if err := (&storagecontrollers.VolumeSnapshotGroupRestoreReconciler{
+			EventRecorder: mgr.GetEventRecorder("volume-snapshot-group-restore"),
+			Client:        mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "VolumeSnapshotGroupRestore")
+			os.Exit(1)
+		}
+	}
+
 	if controllers.Enabled(volumeReleaseController) {
 		if err := (&storagecontrollers.VolumeReleaseReconciler{
 			Client:       mgr.GetClient(),
@@ -407,6 +517,7 @@ func main() {
 			EventRecorder: mgr.GetEventRecorder("volume-scheduler"),
 			Client:        mgr.GetClient(),
 			Cache:         schedulerCache,
+			ScoreConfig:   volumeSchedulerScoreConfig,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "controller", "VolumeScheduler")
 			os.Exit(1)
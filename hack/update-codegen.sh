@@ -57,6 +57,7 @@ kube::codegen::gen_openapi \
     --output-model-name-file "zz_generated.model_name.go" \
     --boilerplate "${PROJECT_ROOT}/hack/boilerplate.go.txt" \
     --extra-pkgs "k8s.io/api/core/v1" \
+    --extra-pkgs "k8s.io/api/autoscaling/v1" \
     "${PROJECT_ROOT}/api"
 
 echo "Generating ${blue}client, lister, informer, and applyconfiguration${normal}"
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machinepriority
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	ironcore "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+// PluginName indicates name of admission plugin.
+const PluginName = "MachinePriority"
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return NewMachinePriority(), nil
+	})
+}
+
+// MachinePriority resolves the priority of machines from their MachinePriorityClass and
+// ensures only a single MachinePriorityClass is marked as global default.
+type MachinePriority struct {
+	client ironcore.Interface
+	*admission.Handler
+}
+
+func NewMachinePriority() *MachinePriority {
+	return &MachinePriority{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}
+}
+
+func (p *MachinePriority) Admit(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetOperation() != admission.Create || a.GetKind().GroupKind() != compute.Kind("Machine") || a.GetSubresource() != "" {
+		return nil
+	}
+
+	machine, ok := a.GetObject().(*compute.Machine)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind Machine but was unable to be converted")
+	}
+
+	priorityClassName, priority, err := p.resolvePriority(ctx, machine.Spec.PriorityClassName)
+	if err != nil {
+		return err
+	}
+
+	if machine.Spec.Priority != nil && *machine.Spec.Priority != priority {
+		return admission.NewForbidden(a, fmt.Errorf("the integer value of priority (%d) must not be provided in spec and priority admission controller computed %d from the given MachinePriorityClass name", *machine.Spec.Priority, priority))
+	}
+
+	machine.Spec.PriorityClassName = priorityClassName
+	machine.Spec.Priority = &priority
+	return nil
+}
+
+// resolvePriority returns the priority class name and priority for the given priority class name.
+// If the name is empty, the MachinePriorityClass marked as global default is used, if any.
+func (p *MachinePriority) resolvePriority(ctx context.Context, priorityClassName string) (string, int32, error) {
+	if priorityClassName == "" {
+		defaultClass, err := p.getDefaultMachinePriorityClass(ctx)
+		if err != nil {
+			return "", 0, apierrors.NewInternalError(fmt.Errorf("error getting default machine priority class: %w", err))
+		}
+		if defaultClass == nil {
+			return "", compute.DefaultMachinePriority, nil
+		}
+		return defaultClass.Name, defaultClass.Value, nil
+	}
+
+	priorityClass, err := p.client.ComputeV1alpha1().MachinePriorityClasses().Get(ctx, priorityClassName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", 0, apierrors.NewBadRequest(fmt.Sprintf("no MachinePriorityClass with name %s was found", priorityClassName))
+		}
+		return "", 0, apierrors.NewInternalError(fmt.Errorf("error getting machine priority class %s: %w", priorityClassName, err))
+	}
+	return priorityClass.Name, priorityClass.Value, nil
+}
+
+// getDefaultMachinePriorityClass returns the MachinePriorityClass marked as global default.
+// If multiple classes are marked as global default, the one with the lowest value is returned.
+func (p *MachinePriority) getDefaultMachinePriorityClass(ctx context.Context) (*computev1alpha1.MachinePriorityClass, error) {
+	list, err := p.client.ComputeV1alpha1().MachinePriorityClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var defaultClass *computev1alpha1.MachinePriorityClass
+	for i := range list.Items {
+		priorityClass := &list.Items[i]
+		if !priorityClass.GlobalDefault {
+			continue
+		}
+		if defaultClass == nil || priorityClass.Value < defaultClass.Value {
+			defaultClass = priorityClass
+		}
+	}
+	return defaultClass, nil
+}
+
+func (p *MachinePriority) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetKind().GroupKind() != compute.Kind("MachinePriorityClass") {
+		return nil
+	}
+
+	priorityClass, ok := a.GetObject().(*compute.MachinePriorityClass)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind MachinePriorityClass but was unable to be converted")
+	}
+	if !priorityClass.GlobalDefault {
+		return nil
+	}
+
+	defaultClass, err := p.getDefaultMachinePriorityClass(ctx)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("error getting default machine priority class: %w", err))
+	}
+	if defaultClass != nil && defaultClass.Name != priorityClass.Name {
+		return admission.NewForbidden(a, fmt.Errorf("MachinePriorityClass %s is already marked as default, only one default can exist", defaultClass.Name))
+	}
+	return nil
+}
+
+func (p *MachinePriority) SetExternalIronCoreClientSet(client ironcore.Interface) {
+	p.client = client
+}
+
+func (p *MachinePriority) ValidateInitialization() error {
+	if p.client == nil {
+		return fmt.Errorf("missing client")
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machinepriority_test
+
+import (
+	"context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/fake"
+	. "github.com/ironcore-dev/ironcore/internal/admission/plugin/machinepriority"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("Admission", func() {
+	var (
+		highPriorityClass = &computev1alpha1.MachinePriorityClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "high"},
+			Value:      1000,
+		}
+		defaultPriorityClass = &computev1alpha1.MachinePriorityClass{
+			ObjectMeta:    metav1.ObjectMeta{Name: "default"},
+			Value:         100,
+			GlobalDefault: true,
+		}
+	)
+
+	newPlugin := func(objects ...runtime.Object) *MachinePriority {
+		plugin := NewMachinePriority()
+		plugin.SetExternalIronCoreClientSet(fake.NewClientset(objects...))
+		Expect(plugin.ValidateInitialization()).To(Succeed())
+		return plugin
+	}
+
+	admitMachine := func(plugin *MachinePriority, machine *compute.Machine) error {
+		return plugin.Admit(
+			context.TODO(),
+			admission.NewAttributesRecord(
+				machine,
+				nil,
+				compute.Kind("Machine").WithVersion("version"),
+				machine.Namespace,
+				machine.Name,
+				compute.Resource("machines").WithVersion("version"),
+				"",
+				admission.Create,
+				&metav1.CreateOptions{},
+				false,
+				nil,
+			),
+			nil,
+		)
+	}
+
+	validatePriorityClass := func(plugin *MachinePriority, priorityClass *compute.MachinePriorityClass) error {
+		return plugin.Validate(
+			context.TODO(),
+			admission.NewAttributesRecord(
+				priorityClass,
+				nil,
+				compute.Kind("MachinePriorityClass").WithVersion("version"),
+				"",
+				priorityClass.Name,
+				compute.Resource("machinepriorityclasses").WithVersion("version"),
+				"",
+				admission.Create,
+				&metav1.CreateOptions{},
+				false,
+				nil,
+			),
+			nil,
+		)
+	}
+
+	It("should resolve the priority from the machine priority class", func() {
+		plugin := newPlugin(highPriorityClass, defaultPriorityClass)
+		machine := &compute.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Spec:       compute.MachineSpec{PriorityClassName: "high"},
+		}
+		Expect(admitMachine(plugin, machine)).To(Succeed())
+		Expect(machine.Spec.Priority).To(Equal(ptr.To[int32](1000)))
+	})
+
+	It("should use the global default machine priority class if no name is set", func() {
+		plugin := newPlugin(highPriorityClass, defaultPriorityClass)
+		machine := &compute.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+		}
+		Expect(admitMachine(plugin, machine)).To(Succeed())
+		Expect(machine.Spec.PriorityClassName).To(Equal("default"))
+		Expect(machine.Spec.Priority).To(Equal(ptr.To[int32](100)))
+	})
+
+	It("should use the default priority if there is no global default machine priority class", func() {
+		plugin := newPlugin(highPriorityClass)
+		machine := &compute.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+		}
+		Expect(admitMachine(plugin, machine)).To(Succeed())
+		Expect(machine.Spec.PriorityClassName).To(BeEmpty())
+		Expect(machine.Spec.Priority).To(Equal(ptr.To(compute.DefaultMachinePriority)))
+	})
+
+	It("should reject machines referencing a non-existent machine priority class", func() {
+		plugin := newPlugin()
+		machine := &compute.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Spec:       compute.MachineSpec{PriorityClassName: "missing"},
+		}
+		Expect(admitMachine(plugin, machine)).To(Satisfy(apierrors.IsBadRequest))
+	})
+
+	It("should reject machines with a priority differing from their machine priority class", func() {
+		plugin := newPlugin(highPriorityClass)
+		machine := &compute.Machine{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+			Spec: compute.MachineSpec{
+				PriorityClassName: "high",
+				Priority:          ptr.To[int32](1),
+			},
+		}
+		Expect(admitMachine(plugin, machine)).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should reject a second global default machine priority class", func() {
+		plugin := newPlugin(defaultPriorityClass)
+		Expect(validatePriorityClass(plugin, &compute.MachinePriorityClass{
+			ObjectMeta:    metav1.ObjectMeta{Name: "other-default"},
+			GlobalDefault: true,
+		})).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should allow updating the global default machine priority class", func() {
+		plugin := newPlugin(defaultPriorityClass)
+		Expect(validatePriorityClass(plugin, &compute.MachinePriorityClass{
+			ObjectMeta:    metav1.ObjectMeta{Name: "default"},
+			Value:         100,
+			GlobalDefault: true,
+			Description:   "updated",
+		})).To(Succeed())
+	})
+})
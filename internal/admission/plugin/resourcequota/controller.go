@@ -313,7 +313,12 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 		return quotas, err
 	}
 
-	var indexes []int
+	var (
+		indexes []int
+		// oldMatches tracks whether the old object already matched the scopes of a quota.
+		// If it did not (e.g. the machine class of a machine changed), the full usage is charged.
+		oldMatches = make(map[int]bool)
+	)
 	for i, resourceQuota := range quotas {
 		match, err := quota.EvaluatorMatchesResourceScopeSelector(evaluator, obj, resourceQuota.Spec.ScopeSelector)
 		if err != nil {
@@ -323,6 +328,14 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 			continue
 		}
 
+		if oldObj != nil {
+			oldMatch, err := quota.EvaluatorMatchesResourceScopeSelector(evaluator, oldObj, resourceQuota.Spec.ScopeSelector)
+			if err != nil {
+				return nil, fmt.Errorf("error matching scopes of quota %s for old object: %w", resourceQuota.Name, err)
+			}
+			oldMatches[i] = oldMatch
+		}
+
 		if !quota.EvaluatorMatchesResourceList(evaluator, resourceQuota.Status.Hard) {
 			continue
 		}
@@ -336,25 +349,27 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 		indexes = append(indexes, i)
 	}
 
-	deltaUsage, err := evaluator.Usage(ctx, obj)
+	usage, err := evaluator.Usage(ctx, obj)
 	if err != nil {
 		return nil, fmt.Errorf("error determining usage: %w", err)
 	}
-	if negativeUsage := quota.IsNegative(deltaUsage); negativeUsage.Len() > 0 {
+	if negativeUsage := quota.IsNegative(usage); negativeUsage.Len() > 0 {
 		return nil, admission.NewForbidden(a, fmt.Errorf("quota usage is negative for resource(s): %v", sets.List(negativeUsage)))
 	}
 
+	deltaUsage := usage
 	if oldObj != nil {
 		prevUsage, err := evaluator.Usage(ctx, oldObj)
 		if err != nil {
 			return nil, fmt.Errorf("error determining old usage: %w", err)
 		}
 
-		deltaUsage = quota.SubtractWithNonNegativeResult(deltaUsage, prevUsage)
+		deltaUsage = quota.SubtractWithNonNegativeResult(usage, prevUsage)
 	}
 
+	usage = quota.RemoveZeros(usage)
 	deltaUsage = quota.RemoveZeros(deltaUsage)
-	if len(deltaUsage) == 0 {
+	if len(usage) == 0 {
 		return quotas, nil
 	}
 
@@ -366,6 +381,14 @@ func (e *EvaluatorController) checkRequest(ctx context.Context, quotas []corev1a
 	for _, i := range indexes {
 		resourceQuota := outQuotas[i]
 
+		deltaUsage := deltaUsage
+		if oldObj != nil && !oldMatches[i] {
+			deltaUsage = usage
+		}
+		if len(deltaUsage) == 0 {
+			continue
+		}
+
 		hardResourceNames := quota.ResourceNames(resourceQuota.Status.Hard)
 		maskedDeltaUsage := quota.Mask(deltaUsage, hardResourceNames)
 		newUsage := quota.Add(resourceQuota.Status.Used, maskedDeltaUsage)
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volumeclone
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	ironcore "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned"
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+// PluginName indicates name of admission plugin.
+const PluginName = "VolumeClone"
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return NewVolumeClone(), nil
+	})
+}
+
+// VolumeClone ensures volumes cloning another volume are at least as large as their source.
+// If the source volume does not exist yet, the check is left to the volume poollet.
+type VolumeClone struct {
+	client ironcore.Interface
+	*admission.Handler
+}
+
+func NewVolumeClone() *VolumeClone {
+	return &VolumeClone{
+		Handler: admission.NewHandler(admission.Create),
+	}
+}
+
+func (v *VolumeClone) Validate(ctx context.Context, a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetKind().GroupKind() != storage.Kind("Volume") || a.GetSubresource() != "" {
+		return nil
+	}
+
+	volume, ok := a.GetObject().(*storage.Volume)
+	if !ok {
+		return apierrors.NewBadRequest("Resource was marked with kind Volume but was unable to be converted")
+	}
+
+	volumeRef := volume.Spec.DataSource.VolumeRef
+	if volumeRef == nil {
+		return nil
+	}
+	if volumeRef.Name == volume.Name {
+		return admission.NewForbidden(a, fmt.Errorf("volume cannot clone itself"))
+	}
+
+	source, err := v.client.StorageV1alpha1().Volumes(a.GetNamespace()).Get(ctx, volumeRef.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return apierrors.NewInternalError(fmt.Errorf("error getting source volume %s: %w", volumeRef.Name, err))
+	}
+
+	sourceSize := source.Spec.Resources.Storage()
+	size := volume.Spec.Resources[core.ResourceStorage]
+	if size.Cmp(*sourceSize) < 0 {
+		return admission.NewForbidden(a, fmt.Errorf("volume storage %s must not be less than the storage %s of source volume %s", size.String(), sourceSize.String(), source.Name))
+	}
+	return nil
+}
+
+func (v *VolumeClone) SetExternalIronCoreClientSet(client ironcore.Interface) {
+	v.client = client
+}
+
+func (v *VolumeClone) ValidateInitialization() error {
+	if v.client == nil {
+		return fmt.Errorf("missing client")
+	}
+	return nil
+}
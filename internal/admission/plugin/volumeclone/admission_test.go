@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volumeclone_test
+
+import (
+	"context"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	"github.com/ironcore-dev/ironcore/client-go/ironcore/versioned/fake"
+	. "github.com/ironcore-dev/ironcore/internal/admission/plugin/volumeclone"
+	"github.com/ironcore-dev/ironcore/internal/apis/core"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+var _ = Describe("Admission", func() {
+	sourceVolume := &storagev1alpha1.Volume{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "golden"},
+		Spec: storagev1alpha1.VolumeSpec{
+			VolumeClassRef: &corev1.LocalObjectReference{Name: "fast"},
+			Resources: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+		},
+	}
+
+	newPlugin := func(objects ...runtime.Object) *VolumeClone {
+		plugin := NewVolumeClone()
+		plugin.SetExternalIronCoreClientSet(fake.NewClientset(objects...))
+		Expect(plugin.ValidateInitialization()).To(Succeed())
+		return plugin
+	}
+
+	newClone := func(name, sourceName, size string) *storage.Volume {
+		return &storage.Volume{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: name},
+			Spec: storage.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: "fast"},
+				Resources: core.ResourceList{
+					core.ResourceStorage: resource.MustParse(size),
+				},
+				DataSource: storage.VolumeDataSource{
+					VolumeRef: &corev1.LocalObjectReference{Name: sourceName},
+				},
+			},
+		}
+	}
+
+	validateVolume := func(plugin *VolumeClone, volume *storage.Volume) error {
+		return plugin.Validate(
+			context.TODO(),
+			admission.NewAttributesRecord(
+				volume,
+				nil,
+				storage.Kind("Volume").WithVersion("version"),
+				volume.Namespace,
+				volume.Name,
+				storage.Resource("volumes").WithVersion("version"),
+				"",
+				admission.Create,
+				&metav1.CreateOptions{},
+				false,
+				nil,
+			),
+			nil,
+		)
+	}
+
+	It("should allow clones at least as large as their source", func() {
+		plugin := newPlugin(sourceVolume)
+		Expect(validateVolume(plugin, newClone("clone", "golden", "10Gi"))).To(Succeed())
+		Expect(validateVolume(plugin, newClone("clone", "golden", "20Gi"))).To(Succeed())
+	})
+
+	It("should reject clones smaller than their source", func() {
+		plugin := newPlugin(sourceVolume)
+		Expect(validateVolume(plugin, newClone("clone", "golden", "5Gi"))).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should reject volumes cloning themselves", func() {
+		plugin := newPlugin()
+		Expect(validateVolume(plugin, newClone("golden", "golden", "10Gi"))).To(Satisfy(apierrors.IsForbidden))
+	})
+
+	It("should allow clones of source volumes that do not exist yet", func() {
+		plugin := newPlugin()
+		Expect(validateVolume(plugin, newClone("clone", "golden", "1Gi"))).To(Succeed())
+	})
+
+	It("should ignore volumes without volume data source", func() {
+		plugin := newPlugin(sourceVolume)
+		volume := newClone("other", "golden", "1Gi")
+		volume.Spec.DataSource.VolumeRef = nil
+		Expect(validateVolume(plugin, volume)).To(Succeed())
+	})
+})
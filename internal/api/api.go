@@ -34,6 +34,9 @@ func init() {
 	storageinstall.Install(Scheme)
 
 	utilruntime.Must(autoscalingv1.AddToScheme(Scheme))
+	// The scale subresources serve autoscaling/v1 Scale objects as is, so the same type is
+	// registered as the internal version to allow decoding scale updates.
+	Scheme.AddKnownTypes(schema.GroupVersion{Group: autoscalingv1.GroupName, Version: runtime.APIVersionInternal}, &autoscalingv1.Scale{})
 
 	metav1.AddToGroupVersion(Scheme, schema.GroupVersion{Version: "v1"})
 
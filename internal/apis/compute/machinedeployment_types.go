@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// MachineDeploymentRevisionAnnotation is the revision annotation of a MachineDeployment and its MachineSets.
+	MachineDeploymentRevisionAnnotation = "compute.ironcore.dev/revision"
+	// MachineTemplateHashLabel is the label added to MachineSets and Machines of a MachineDeployment
+	// to distinguish the machines created from different templates.
+	MachineTemplateHashLabel = "compute.ironcore.dev/machine-template-hash"
+)
+
+// MachineDeploymentStrategyType is the type of strategy used to replace machines of a MachineDeployment.
+// +enum
+type MachineDeploymentStrategyType string
+
+const (
+	// RecreateMachineDeploymentStrategyType deletes all old machines before creating new ones.
+	RecreateMachineDeploymentStrategyType MachineDeploymentStrategyType = "Recreate"
+	// RollingUpdateMachineDeploymentStrategyType gradually replaces old machines by new ones.
+	RollingUpdateMachineDeploymentStrategyType MachineDeploymentStrategyType = "RollingUpdate"
+)
+
+// MachineDeploymentStrategy describes how to replace existing machines with new ones.
+type MachineDeploymentStrategy struct {
+	// Type is the type of the strategy. Defaults to RollingUpdate.
+	Type MachineDeploymentStrategyType
+	// RollingUpdate configures the RollingUpdate strategy. Only allowed if Type is RollingUpdate.
+	RollingUpdate *RollingUpdateMachineDeployment
+}
+
+// RollingUpdateMachineDeployment configures a rolling update of a MachineDeployment.
+type RollingUpdateMachineDeployment struct {
+	// MaxUnavailable is the number or percentage of desired machines that may be unavailable
+	// during the update. Percentages are rounded down. Defaults to 25%.
+	MaxUnavailable *intstr.IntOrString
+	// MaxSurge is the number or percentage of machines that may be created above the desired
+	// number of machines during the update. Percentages are rounded up. Defaults to 25%.
+	MaxSurge *intstr.IntOrString
+}
+
+// MachineDeploymentSpec defines the desired state of MachineDeployment
+type MachineDeploymentSpec struct {
+	// Replicas is the number of desired machines. Defaults to 1.
+	Replicas *int32
+	// Selector is a label query over machines of the MachineDeployment.
+	// It must match the labels of the template.
+	Selector *metav1.LabelSelector
+	// Template is the template of the machines created by the MachineDeployment.
+	Template MachineTemplateSpec
+	// Strategy is the strategy used to replace old machines by new ones.
+	Strategy MachineDeploymentStrategy
+	// RevisionHistoryLimit is the number of old, scaled down MachineSets to retain for a rollback.
+	// Defaults to 10.
+	RevisionHistoryLimit *int32
+}
+
+// MachineDeploymentStatus defines the observed state of MachineDeployment
+type MachineDeploymentStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MachineDeployment.
+	ObservedGeneration int64
+	// Replicas is the number of machines owned by the MachineSets of the MachineDeployment.
+	Replicas int32
+	// UpdatedReplicas is the number of machines created from the current template.
+	UpdatedReplicas int32
+	// ReadyReplicas is the number of running machines of the MachineDeployment.
+	ReadyReplicas int32
+	// UnavailableReplicas is the number of desired machines that are not yet running.
+	UnavailableReplicas int32
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineDeployment manages MachineSets to roll out machine template changes.
+type MachineDeployment struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   MachineDeploymentSpec
+	Status MachineDeploymentStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineDeploymentList contains a list of MachineDeployment
+type MachineDeploymentList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []MachineDeployment
+}
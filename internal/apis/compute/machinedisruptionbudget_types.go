@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// MachineDisruptionBudgetSpec defines the desired state of MachineDisruptionBudget
+type MachineDisruptionBudgetSpec struct {
+	// Selector selects the machines the budget applies to.
+	Selector *metav1.LabelSelector
+	// MinAvailable is the number or percentage of selected machines that have to remain available
+	// after an eviction. Mutually exclusive with MaxUnavailable.
+	MinAvailable *intstr.IntOrString
+	// MaxUnavailable is the number or percentage of selected machines that may be unavailable
+	// after an eviction. Mutually exclusive with MinAvailable.
+	MaxUnavailable *intstr.IntOrString
+}
+
+// MachineDisruptionBudgetStatus defines the observed state of MachineDisruptionBudget
+type MachineDisruptionBudgetStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MachineDisruptionBudget.
+	ObservedGeneration int64
+	// DisruptedMachines contains the machines whose eviction was accepted but that have not yet been
+	// observed as deleted, mapped to the time of their eviction.
+	DisruptedMachines map[string]metav1.Time
+	// DisruptionsAllowed is the number of machine disruptions that are currently allowed.
+	DisruptionsAllowed int32
+	// CurrentHealthy is the number of currently healthy, i.e. running, selected machines.
+	CurrentHealthy int32
+	// DesiredHealthy is the minimum desired number of healthy selected machines.
+	DesiredHealthy int32
+	// ExpectedMachines is the total number of selected machines.
+	ExpectedMachines int32
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineDisruptionBudget limits the number of machines of a replicated application that are
+// down simultaneously due to voluntary disruptions, i.e. evictions.
+type MachineDisruptionBudget struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   MachineDisruptionBudgetSpec
+	Status MachineDisruptionBudgetStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineDisruptionBudgetList contains a list of MachineDisruptionBudget
+type MachineDisruptionBudgetList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []MachineDisruptionBudget
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineEviction evicts a machine while honoring the MachineDisruptionBudgets selecting it.
+// It is created via the eviction subresource of a machine.
+type MachineEviction struct {
+	metav1.TypeMeta
+	// ObjectMeta describes the machine that is being evicted.
+	metav1.ObjectMeta
+
+	// DeleteOptions may be provided for the deletion of the machine.
+	DeleteOptions *metav1.DeleteOptions
+}
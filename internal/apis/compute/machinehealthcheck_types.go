@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// MachineRemediationStrategy is the strategy used to remediate unhealthy machines.
+// +enum
+type MachineRemediationStrategy string
+
+const (
+	// MachineRemediationStrategyRestart hard restarts unhealthy machines.
+	MachineRemediationStrategyRestart MachineRemediationStrategy = "Restart"
+	// MachineRemediationStrategyRecreate deletes unhealthy machines controlled by a MachineSet so that
+	// they are recreated. Machines not controlled by a MachineSet are restarted instead.
+	MachineRemediationStrategyRecreate MachineRemediationStrategy = "Recreate"
+)
+
+// UnhealthyMachineState considers a machine unhealthy if it is in State for longer than Timeout.
+type UnhealthyMachineState struct {
+	// State is the machine state.
+	State MachineState
+	// Timeout is the duration after which a machine in State is considered unhealthy.
+	Timeout metav1.Duration
+}
+
+// UnhealthyMachineCondition considers a machine unhealthy if its condition of Type has had Status
+// for longer than Timeout.
+type UnhealthyMachineCondition struct {
+	// Type is the type of the machine condition.
+	Type MachineConditionType
+	// Status is the status of the machine condition.
+	Status corev1.ConditionStatus
+	// Timeout is the duration after which a machine with the condition is considered unhealthy.
+	Timeout metav1.Duration
+}
+
+// MachineHealthCheckSpec defines the desired state of MachineHealthCheck
+type MachineHealthCheckSpec struct {
+	// Selector selects the machines to check.
+	Selector *metav1.LabelSelector
+	// UnhealthyStates are the machine states that render a machine unhealthy after a timeout.
+	UnhealthyStates []UnhealthyMachineState
+	// UnhealthyConditions are the machine conditions that render a machine unhealthy after a timeout.
+	UnhealthyConditions []UnhealthyMachineCondition
+	// MaxUnhealthy is the number or percentage of selected machines that may be unhealthy for
+	// remediation to take place. If more machines are unhealthy, remediation is stopped.
+	// Defaults to 100%.
+	MaxUnhealthy *intstr.IntOrString
+	// RemediationStrategy is the strategy used to remediate unhealthy machines.
+	// Defaults to Recreate.
+	RemediationStrategy MachineRemediationStrategy
+}
+
+// UnhealthyMachine is a selected machine that is considered unhealthy.
+type UnhealthyMachine struct {
+	// Name is the name of the machine.
+	Name string
+	// Reason is a machine-readable indication of why the machine is considered unhealthy.
+	Reason string
+	// Message is a human-readable explanation of why the machine is considered unhealthy.
+	Message string
+	// RemediationStrategy is the strategy the machine was last remediated with, if any.
+	RemediationStrategy MachineRemediationStrategy
+	// LastRemediationTime is the last time the machine was remediated.
+	LastRemediationTime *metav1.Time
+}
+
+// MachineHealthCheckStatus defines the observed state of MachineHealthCheck
+type MachineHealthCheckStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MachineHealthCheck.
+	ObservedGeneration int64
+	// ExpectedMachines is the total number of selected machines.
+	ExpectedMachines int32
+	// CurrentHealthy is the number of selected machines that are not considered unhealthy.
+	CurrentHealthy int32
+	// RemediationsAllowed is the number of further unhealthy machines that may be remediated
+	// before MaxUnhealthy is exceeded.
+	RemediationsAllowed int32
+	// UnhealthyMachines are the selected machines that are considered unhealthy.
+	UnhealthyMachines []UnhealthyMachine
+	// Conditions are the conditions of the MachineHealthCheck.
+	Conditions []MachineHealthCheckCondition
+}
+
+// MachineHealthCheckConditionType is a type a MachineHealthCheckCondition can have.
+type MachineHealthCheckConditionType string
+
+const (
+	// MachineHealthCheckRemediationAllowed reports whether unhealthy machines are remediated.
+	// It is False with reason TooManyUnhealthy while more machines are unhealthy than MaxUnhealthy allows.
+	MachineHealthCheckRemediationAllowed MachineHealthCheckConditionType = "RemediationAllowed"
+)
+
+// MachineHealthCheckCondition is one of the conditions of a MachineHealthCheck.
+type MachineHealthCheckCondition struct {
+	// Type is the type of the condition.
+	Type MachineHealthCheckConditionType
+	// Status is the status of the condition.
+	Status corev1.ConditionStatus
+	// Reason is a machine-readable indication of why the condition is in a certain state.
+	Reason string
+	// Message is a human-readable explanation of why the condition has a certain reason / state.
+	Message string
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon.
+	ObservedGeneration int64
+	// LastTransitionTime is the last time the status of a condition has transitioned from one state to another.
+	LastTransitionTime metav1.Time
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineHealthCheck remediates selected machines that are unhealthy for too long.
+type MachineHealthCheck struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   MachineHealthCheckSpec
+	Status MachineHealthCheckStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineHealthCheckList contains a list of MachineHealthCheck
+type MachineHealthCheckList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []MachineHealthCheck
+}
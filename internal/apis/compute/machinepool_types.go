@@ -18,6 +18,20 @@ type MachinePoolSpec struct {
 	// Taints of the MachinePool. Only Machines who tolerate all the taints
 	// will land in the MachinePool.
 	Taints []commonv1alpha1.Taint
+	// Unschedulable marks the MachinePool as unschedulable. No new Machines are scheduled onto an
+	// unschedulable MachinePool, while the Machines already running on it are not affected.
+	Unschedulable bool
+	// Drain requests all Machines to be evicted from the MachinePool. A draining MachinePool is unschedulable.
+	Drain *MachinePoolDrain
+}
+
+// MachinePoolDrain is a request to evict all Machines from a MachinePool.
+type MachinePoolDrain struct {
+	// RequestedAt is the time the drain was requested. Changing it restarts the drain.
+	RequestedAt metav1.Time
+	// EvictionTimeout is the time to wait for an evicted Machine to be gone before
+	// evicting the next Machine. Defaults to 5 minutes.
+	EvictionTimeout *metav1.Duration
 }
 
 // MachinePoolStatus defines the observed state of MachinePool
@@ -31,6 +45,33 @@ type MachinePoolStatus struct {
 	Capacity core.ResourceList
 	// Allocatable represents the resources of a machine pool that are available for scheduling.
 	Allocatable core.ResourceList
+	// Drain reports the progress of the requested drain of the MachinePool.
+	Drain *MachinePoolDrainStatus
+}
+
+// MachinePoolDrainState is the state of a MachinePool drain.
+// +enum
+type MachinePoolDrainState string
+
+const (
+	// MachinePoolDrainStateDraining means Machines are being evicted from the MachinePool.
+	MachinePoolDrainStateDraining MachinePoolDrainState = "Draining"
+	// MachinePoolDrainStateDrained means all Machines have been evicted from the MachinePool.
+	MachinePoolDrainStateDrained MachinePoolDrainState = "Drained"
+)
+
+// MachinePoolDrainStatus reports the progress of a MachinePool drain.
+type MachinePoolDrainStatus struct {
+	// State is the state of the drain.
+	State MachinePoolDrainState
+	// ObservedRequestedAt is the requestedAt time of the drain this status refers to.
+	ObservedRequestedAt metav1.Time
+	// StartTime is the time the drain was started.
+	StartTime *metav1.Time
+	// CompletionTime is the time all Machines had been evicted.
+	CompletionTime *metav1.Time
+	// RemainingMachines is the number of Machines still bound to the MachinePool.
+	RemainingMachines int32
 }
 
 // MachinePoolDaemonEndpoints lists ports opened by daemons running on the MachinePool.
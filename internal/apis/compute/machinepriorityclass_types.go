@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// HighestUserDefinableMachinePriority is the highest priority a user defined MachinePriorityClass may have.
+	HighestUserDefinableMachinePriority = int32(1000000000)
+	// DefaultMachinePriority is the priority of machines without a MachinePriorityClass
+	// if no MachinePriorityClass is marked as global default.
+	DefaultMachinePriority = int32(0)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genClient:nonNamespaced
+// +genClient:noStatus
+
+// MachinePriorityClass defines the priority of the machines referencing it. Machines with a higher
+// priority are scheduled first and may preempt machines with a lower priority if no machine pool fits.
+type MachinePriorityClass struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Value is the priority of the machines referencing this class. The higher the value, the higher the priority.
+	Value int32
+	// GlobalDefault specifies whether this class should be used for machines without a priority class name.
+	// Only one MachinePriorityClass may be marked as global default.
+	GlobalDefault bool
+	// Description is an arbitrary string describing when this class should be used.
+	Description string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachinePriorityClassList contains a list of MachinePriorityClass
+type MachinePriorityClassList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []MachinePriorityClass
+}
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineTemplateSpec is the specification of a Machine template.
+type MachineTemplateSpec struct {
+	metav1.ObjectMeta
+	Spec MachineSpec
+}
+
+// MachineSetSpec defines the desired state of MachineSet
+type MachineSetSpec struct {
+	// Replicas is the number of desired machines. Defaults to 1.
+	Replicas *int32
+	// Selector is a label query over machines that should match the replica count.
+	// It must match the labels of the template.
+	Selector *metav1.LabelSelector
+	// Template is the template of the machines created by the MachineSet.
+	Template MachineTemplateSpec
+}
+
+// MachineSetStatus defines the observed state of MachineSet
+type MachineSetStatus struct {
+	// ObservedGeneration is the most recent generation observed for this MachineSet.
+	ObservedGeneration int64
+	// Replicas is the number of machines currently owned by the MachineSet.
+	Replicas int32
+	// ReadyReplicas is the number of running machines owned by the MachineSet.
+	ReadyReplicas int32
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineSet ensures that a specified number of machines created from a template are running.
+type MachineSet struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   MachineSetSpec
+	Status MachineSetStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineSetList contains a list of MachineSet
+type MachineSetList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []MachineSet
+}
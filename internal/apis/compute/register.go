@@ -32,12 +32,25 @@ func Kind(kind string) schema.GroupKind {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Machine{},
+		&MachineConsoleLogOptions{},
+		&MachineStats{},
 		&MachineExecOptions{},
 		&MachineList{},
 		&MachineClass{},
 		&MachineClassList{},
 		&MachinePool{},
 		&MachinePoolList{},
+		&MachinePriorityClass{},
+		&MachinePriorityClassList{},
+		&MachineDisruptionBudget{},
+		&MachineDisruptionBudgetList{},
+		&MachineHealthCheck{},
+		&MachineHealthCheckList{},
+		&MachineSet{},
+		&MachineSetList{},
+		&MachineDeployment{},
+		&MachineDeploymentList{},
+		&MachineEviction{},
 	)
 	return nil
 }
@@ -61,5 +61,14 @@ func MachineSecretNames(machine *Machine) []string {
 		names = append(names, ignitionRef.Name)
 	}
 
+	if guestConfig := machine.Spec.GuestConfig; guestConfig != nil {
+		for _, sshPublicKeyRef := range guestConfig.SSHPublicKeyRefs {
+			names = append(names, sshPublicKeyRef.Name)
+		}
+		if userDataRef := guestConfig.UserDataRef; userDataRef != nil {
+			names = append(names, userDataRef.Name)
+		}
+	}
+
 	return names
 }
@@ -6,6 +6,8 @@ package v1alpha1
 import (
 	"github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
@@ -35,3 +37,54 @@ func SetDefaults_MachineSpec(spec *v1alpha1.MachineSpec) {
 		spec.Power = v1alpha1.PowerOn
 	}
 }
+
+func SetDefaults_MachineRestart(restart *v1alpha1.MachineRestart) {
+	if restart.Mode == "" {
+		restart.Mode = v1alpha1.RestartModeSoft
+	}
+}
+
+func SetDefaults_TopologySpreadConstraint(constraint *v1alpha1.TopologySpreadConstraint) {
+	if constraint.WhenUnsatisfiable == "" {
+		constraint.WhenUnsatisfiable = v1alpha1.DoNotSchedule
+	}
+}
+
+func SetDefaults_MachineSetSpec(spec *v1alpha1.MachineSetSpec) {
+	if spec.Replicas == nil {
+		spec.Replicas = ptr.To[int32](1)
+	}
+}
+
+func SetDefaults_MachineDeploymentSpec(spec *v1alpha1.MachineDeploymentSpec) {
+	if spec.Replicas == nil {
+		spec.Replicas = ptr.To[int32](1)
+	}
+	if spec.RevisionHistoryLimit == nil {
+		spec.RevisionHistoryLimit = ptr.To[int32](10)
+	}
+	if spec.Strategy.Type == "" {
+		spec.Strategy.Type = v1alpha1.RollingUpdateMachineDeploymentStrategyType
+	}
+	if spec.Strategy.Type == v1alpha1.RollingUpdateMachineDeploymentStrategyType && spec.Strategy.RollingUpdate == nil {
+		spec.Strategy.RollingUpdate = &v1alpha1.RollingUpdateMachineDeployment{}
+	}
+}
+
+func SetDefaults_RollingUpdateMachineDeployment(rollingUpdate *v1alpha1.RollingUpdateMachineDeployment) {
+	if rollingUpdate.MaxUnavailable == nil {
+		rollingUpdate.MaxUnavailable = ptr.To(intstr.FromString("25%"))
+	}
+	if rollingUpdate.MaxSurge == nil {
+		rollingUpdate.MaxSurge = ptr.To(intstr.FromString("25%"))
+	}
+}
+
+func SetDefaults_MachineHealthCheckSpec(spec *v1alpha1.MachineHealthCheckSpec) {
+	if spec.MaxUnhealthy == nil {
+		spec.MaxUnhealthy = ptr.To(intstr.FromString("100%"))
+	}
+	if spec.RemediationStrategy == "" {
+		spec.RemediationStrategy = v1alpha1.MachineRemediationStrategyRecreate
+	}
+}
@@ -21,11 +21,12 @@ import (
 	core "github.com/ironcore-dev/ironcore/internal/apis/core"
 	networking "github.com/ironcore-dev/ironcore/internal/apis/networking"
 	storage "github.com/ironcore-dev/ironcore/internal/apis/storage"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -35,6 +36,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.Affinity)(nil), (*compute.Affinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Affinity_To_compute_Affinity(a.(*computev1alpha1.Affinity), b.(*compute.Affinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.Affinity)(nil), (*computev1alpha1.Affinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_Affinity_To_v1alpha1_Affinity(a.(*compute.Affinity), b.(*computev1alpha1.Affinity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.DaemonEndpoint)(nil), (*compute.DaemonEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DaemonEndpoint_To_compute_DaemonEndpoint(a.(*computev1alpha1.DaemonEndpoint), b.(*compute.DaemonEndpoint), scope)
 	}); err != nil {
@@ -105,6 +116,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineAffinity)(nil), (*compute.MachineAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineAffinity_To_compute_MachineAffinity(a.(*computev1alpha1.MachineAffinity), b.(*compute.MachineAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineAffinity)(nil), (*computev1alpha1.MachineAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineAffinity_To_v1alpha1_MachineAffinity(a.(*compute.MachineAffinity), b.(*computev1alpha1.MachineAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineAffinityTerm)(nil), (*compute.MachineAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineAffinityTerm_To_compute_MachineAffinityTerm(a.(*computev1alpha1.MachineAffinityTerm), b.(*compute.MachineAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineAffinityTerm)(nil), (*computev1alpha1.MachineAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineAffinityTerm_To_v1alpha1_MachineAffinityTerm(a.(*compute.MachineAffinityTerm), b.(*computev1alpha1.MachineAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineAntiAffinity)(nil), (*compute.MachineAntiAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineAntiAffinity_To_compute_MachineAntiAffinity(a.(*computev1alpha1.MachineAntiAffinity), b.(*compute.MachineAntiAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineAntiAffinity)(nil), (*computev1alpha1.MachineAntiAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineAntiAffinity_To_v1alpha1_MachineAntiAffinity(a.(*compute.MachineAntiAffinity), b.(*computev1alpha1.MachineAntiAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineCPUStats)(nil), (*compute.MachineCPUStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineCPUStats_To_compute_MachineCPUStats(a.(*computev1alpha1.MachineCPUStats), b.(*compute.MachineCPUStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineCPUStats)(nil), (*computev1alpha1.MachineCPUStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineCPUStats_To_v1alpha1_MachineCPUStats(a.(*compute.MachineCPUStats), b.(*computev1alpha1.MachineCPUStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineClass)(nil), (*compute.MachineClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineClass_To_compute_MachineClass(a.(*computev1alpha1.MachineClass), b.(*compute.MachineClass), scope)
 	}); err != nil {
@@ -135,6 +186,116 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineConsoleLogOptions)(nil), (*compute.MachineConsoleLogOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineConsoleLogOptions_To_compute_MachineConsoleLogOptions(a.(*computev1alpha1.MachineConsoleLogOptions), b.(*compute.MachineConsoleLogOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineConsoleLogOptions)(nil), (*computev1alpha1.MachineConsoleLogOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineConsoleLogOptions_To_v1alpha1_MachineConsoleLogOptions(a.(*compute.MachineConsoleLogOptions), b.(*computev1alpha1.MachineConsoleLogOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDeployment)(nil), (*compute.MachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeployment_To_compute_MachineDeployment(a.(*computev1alpha1.MachineDeployment), b.(*compute.MachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDeployment)(nil), (*computev1alpha1.MachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDeployment_To_v1alpha1_MachineDeployment(a.(*compute.MachineDeployment), b.(*computev1alpha1.MachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDeploymentList)(nil), (*compute.MachineDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentList_To_compute_MachineDeploymentList(a.(*computev1alpha1.MachineDeploymentList), b.(*compute.MachineDeploymentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDeploymentList)(nil), (*computev1alpha1.MachineDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDeploymentList_To_v1alpha1_MachineDeploymentList(a.(*compute.MachineDeploymentList), b.(*computev1alpha1.MachineDeploymentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDeploymentSpec)(nil), (*compute.MachineDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentSpec_To_compute_MachineDeploymentSpec(a.(*computev1alpha1.MachineDeploymentSpec), b.(*compute.MachineDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDeploymentSpec)(nil), (*computev1alpha1.MachineDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec(a.(*compute.MachineDeploymentSpec), b.(*computev1alpha1.MachineDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDeploymentStatus)(nil), (*compute.MachineDeploymentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentStatus_To_compute_MachineDeploymentStatus(a.(*computev1alpha1.MachineDeploymentStatus), b.(*compute.MachineDeploymentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDeploymentStatus)(nil), (*computev1alpha1.MachineDeploymentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentStatus(a.(*compute.MachineDeploymentStatus), b.(*computev1alpha1.MachineDeploymentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDeploymentStrategy)(nil), (*compute.MachineDeploymentStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDeploymentStrategy_To_compute_MachineDeploymentStrategy(a.(*computev1alpha1.MachineDeploymentStrategy), b.(*compute.MachineDeploymentStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDeploymentStrategy)(nil), (*computev1alpha1.MachineDeploymentStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy(a.(*compute.MachineDeploymentStrategy), b.(*computev1alpha1.MachineDeploymentStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDisruptionBudget)(nil), (*compute.MachineDisruptionBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDisruptionBudget_To_compute_MachineDisruptionBudget(a.(*computev1alpha1.MachineDisruptionBudget), b.(*compute.MachineDisruptionBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDisruptionBudget)(nil), (*computev1alpha1.MachineDisruptionBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDisruptionBudget_To_v1alpha1_MachineDisruptionBudget(a.(*compute.MachineDisruptionBudget), b.(*computev1alpha1.MachineDisruptionBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDisruptionBudgetList)(nil), (*compute.MachineDisruptionBudgetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDisruptionBudgetList_To_compute_MachineDisruptionBudgetList(a.(*computev1alpha1.MachineDisruptionBudgetList), b.(*compute.MachineDisruptionBudgetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDisruptionBudgetList)(nil), (*computev1alpha1.MachineDisruptionBudgetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDisruptionBudgetList_To_v1alpha1_MachineDisruptionBudgetList(a.(*compute.MachineDisruptionBudgetList), b.(*computev1alpha1.MachineDisruptionBudgetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDisruptionBudgetSpec)(nil), (*compute.MachineDisruptionBudgetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDisruptionBudgetSpec_To_compute_MachineDisruptionBudgetSpec(a.(*computev1alpha1.MachineDisruptionBudgetSpec), b.(*compute.MachineDisruptionBudgetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDisruptionBudgetSpec)(nil), (*computev1alpha1.MachineDisruptionBudgetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDisruptionBudgetSpec_To_v1alpha1_MachineDisruptionBudgetSpec(a.(*compute.MachineDisruptionBudgetSpec), b.(*computev1alpha1.MachineDisruptionBudgetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineDisruptionBudgetStatus)(nil), (*compute.MachineDisruptionBudgetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineDisruptionBudgetStatus_To_compute_MachineDisruptionBudgetStatus(a.(*computev1alpha1.MachineDisruptionBudgetStatus), b.(*compute.MachineDisruptionBudgetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineDisruptionBudgetStatus)(nil), (*computev1alpha1.MachineDisruptionBudgetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineDisruptionBudgetStatus_To_v1alpha1_MachineDisruptionBudgetStatus(a.(*compute.MachineDisruptionBudgetStatus), b.(*computev1alpha1.MachineDisruptionBudgetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineEviction)(nil), (*compute.MachineEviction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineEviction_To_compute_MachineEviction(a.(*computev1alpha1.MachineEviction), b.(*compute.MachineEviction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineEviction)(nil), (*computev1alpha1.MachineEviction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineEviction_To_v1alpha1_MachineEviction(a.(*compute.MachineEviction), b.(*computev1alpha1.MachineEviction), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineExecOptions)(nil), (*compute.MachineExecOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineExecOptions_To_compute_MachineExecOptions(a.(*computev1alpha1.MachineExecOptions), b.(*compute.MachineExecOptions), scope)
 	}); err != nil {
@@ -155,6 +316,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineHealthCheck)(nil), (*compute.MachineHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineHealthCheck_To_compute_MachineHealthCheck(a.(*computev1alpha1.MachineHealthCheck), b.(*compute.MachineHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineHealthCheck)(nil), (*computev1alpha1.MachineHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineHealthCheck_To_v1alpha1_MachineHealthCheck(a.(*compute.MachineHealthCheck), b.(*computev1alpha1.MachineHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineHealthCheckCondition)(nil), (*compute.MachineHealthCheckCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineHealthCheckCondition_To_compute_MachineHealthCheckCondition(a.(*computev1alpha1.MachineHealthCheckCondition), b.(*compute.MachineHealthCheckCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineHealthCheckCondition)(nil), (*computev1alpha1.MachineHealthCheckCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineHealthCheckCondition_To_v1alpha1_MachineHealthCheckCondition(a.(*compute.MachineHealthCheckCondition), b.(*computev1alpha1.MachineHealthCheckCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineHealthCheckList)(nil), (*compute.MachineHealthCheckList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineHealthCheckList_To_compute_MachineHealthCheckList(a.(*computev1alpha1.MachineHealthCheckList), b.(*compute.MachineHealthCheckList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineHealthCheckList)(nil), (*computev1alpha1.MachineHealthCheckList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineHealthCheckList_To_v1alpha1_MachineHealthCheckList(a.(*compute.MachineHealthCheckList), b.(*computev1alpha1.MachineHealthCheckList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineHealthCheckSpec)(nil), (*compute.MachineHealthCheckSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineHealthCheckSpec_To_compute_MachineHealthCheckSpec(a.(*computev1alpha1.MachineHealthCheckSpec), b.(*compute.MachineHealthCheckSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineHealthCheckSpec)(nil), (*computev1alpha1.MachineHealthCheckSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineHealthCheckSpec_To_v1alpha1_MachineHealthCheckSpec(a.(*compute.MachineHealthCheckSpec), b.(*computev1alpha1.MachineHealthCheckSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineHealthCheckStatus)(nil), (*compute.MachineHealthCheckStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineHealthCheckStatus_To_compute_MachineHealthCheckStatus(a.(*computev1alpha1.MachineHealthCheckStatus), b.(*compute.MachineHealthCheckStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineHealthCheckStatus)(nil), (*computev1alpha1.MachineHealthCheckStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineHealthCheckStatus_To_v1alpha1_MachineHealthCheckStatus(a.(*compute.MachineHealthCheckStatus), b.(*computev1alpha1.MachineHealthCheckStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineIOFreeze)(nil), (*compute.MachineIOFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineIOFreeze_To_compute_MachineIOFreeze(a.(*computev1alpha1.MachineIOFreeze), b.(*compute.MachineIOFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineIOFreeze)(nil), (*computev1alpha1.MachineIOFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineIOFreeze_To_v1alpha1_MachineIOFreeze(a.(*compute.MachineIOFreeze), b.(*computev1alpha1.MachineIOFreeze), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineList)(nil), (*compute.MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineList_To_compute_MachineList(a.(*computev1alpha1.MachineList), b.(*compute.MachineList), scope)
 	}); err != nil {
@@ -165,6 +386,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineMemoryStats)(nil), (*compute.MachineMemoryStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineMemoryStats_To_compute_MachineMemoryStats(a.(*computev1alpha1.MachineMemoryStats), b.(*compute.MachineMemoryStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineMemoryStats)(nil), (*computev1alpha1.MachineMemoryStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineMemoryStats_To_v1alpha1_MachineMemoryStats(a.(*compute.MachineMemoryStats), b.(*computev1alpha1.MachineMemoryStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineNetworkInterfaceStats)(nil), (*compute.MachineNetworkInterfaceStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineNetworkInterfaceStats_To_compute_MachineNetworkInterfaceStats(a.(*computev1alpha1.MachineNetworkInterfaceStats), b.(*compute.MachineNetworkInterfaceStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineNetworkInterfaceStats)(nil), (*computev1alpha1.MachineNetworkInterfaceStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineNetworkInterfaceStats_To_v1alpha1_MachineNetworkInterfaceStats(a.(*compute.MachineNetworkInterfaceStats), b.(*computev1alpha1.MachineNetworkInterfaceStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachinePool)(nil), (*compute.MachinePool)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachinePool_To_compute_MachinePool(a.(*computev1alpha1.MachinePool), b.(*compute.MachinePool), scope)
 	}); err != nil {
@@ -205,6 +446,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachinePoolDrain)(nil), (*compute.MachinePoolDrain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachinePoolDrain_To_compute_MachinePoolDrain(a.(*computev1alpha1.MachinePoolDrain), b.(*compute.MachinePoolDrain), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachinePoolDrain)(nil), (*computev1alpha1.MachinePoolDrain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachinePoolDrain_To_v1alpha1_MachinePoolDrain(a.(*compute.MachinePoolDrain), b.(*computev1alpha1.MachinePoolDrain), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachinePoolDrainStatus)(nil), (*compute.MachinePoolDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachinePoolDrainStatus_To_compute_MachinePoolDrainStatus(a.(*computev1alpha1.MachinePoolDrainStatus), b.(*compute.MachinePoolDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachinePoolDrainStatus)(nil), (*computev1alpha1.MachinePoolDrainStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachinePoolDrainStatus_To_v1alpha1_MachinePoolDrainStatus(a.(*compute.MachinePoolDrainStatus), b.(*computev1alpha1.MachinePoolDrainStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachinePoolList)(nil), (*compute.MachinePoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachinePoolList_To_compute_MachinePoolList(a.(*computev1alpha1.MachinePoolList), b.(*compute.MachinePoolList), scope)
 	}); err != nil {
@@ -235,6 +496,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachinePriorityClass)(nil), (*compute.MachinePriorityClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachinePriorityClass_To_compute_MachinePriorityClass(a.(*computev1alpha1.MachinePriorityClass), b.(*compute.MachinePriorityClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachinePriorityClass)(nil), (*computev1alpha1.MachinePriorityClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachinePriorityClass_To_v1alpha1_MachinePriorityClass(a.(*compute.MachinePriorityClass), b.(*computev1alpha1.MachinePriorityClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachinePriorityClassList)(nil), (*compute.MachinePriorityClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachinePriorityClassList_To_compute_MachinePriorityClassList(a.(*computev1alpha1.MachinePriorityClassList), b.(*compute.MachinePriorityClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachinePriorityClassList)(nil), (*computev1alpha1.MachinePriorityClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachinePriorityClassList_To_v1alpha1_MachinePriorityClassList(a.(*compute.MachinePriorityClassList), b.(*computev1alpha1.MachinePriorityClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineRestart)(nil), (*compute.MachineRestart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineRestart_To_compute_MachineRestart(a.(*computev1alpha1.MachineRestart), b.(*compute.MachineRestart), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineRestart)(nil), (*computev1alpha1.MachineRestart)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineRestart_To_v1alpha1_MachineRestart(a.(*compute.MachineRestart), b.(*computev1alpha1.MachineRestart), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineSet)(nil), (*compute.MachineSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineSet_To_compute_MachineSet(a.(*computev1alpha1.MachineSet), b.(*compute.MachineSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineSet)(nil), (*computev1alpha1.MachineSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineSet_To_v1alpha1_MachineSet(a.(*compute.MachineSet), b.(*computev1alpha1.MachineSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineSetList)(nil), (*compute.MachineSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineSetList_To_compute_MachineSetList(a.(*computev1alpha1.MachineSetList), b.(*compute.MachineSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineSetList)(nil), (*computev1alpha1.MachineSetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineSetList_To_v1alpha1_MachineSetList(a.(*compute.MachineSetList), b.(*computev1alpha1.MachineSetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineSetSpec)(nil), (*compute.MachineSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineSetSpec_To_compute_MachineSetSpec(a.(*computev1alpha1.MachineSetSpec), b.(*compute.MachineSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineSetSpec)(nil), (*computev1alpha1.MachineSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineSetSpec_To_v1alpha1_MachineSetSpec(a.(*compute.MachineSetSpec), b.(*computev1alpha1.MachineSetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineSetStatus)(nil), (*compute.MachineSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineSetStatus_To_compute_MachineSetStatus(a.(*computev1alpha1.MachineSetStatus), b.(*compute.MachineSetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineSetStatus)(nil), (*computev1alpha1.MachineSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineSetStatus_To_v1alpha1_MachineSetStatus(a.(*compute.MachineSetStatus), b.(*computev1alpha1.MachineSetStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineSpec)(nil), (*compute.MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineSpec_To_compute_MachineSpec(a.(*computev1alpha1.MachineSpec), b.(*compute.MachineSpec), scope)
 	}); err != nil {
@@ -245,6 +576,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineStats)(nil), (*compute.MachineStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineStats_To_compute_MachineStats(a.(*computev1alpha1.MachineStats), b.(*compute.MachineStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineStats)(nil), (*computev1alpha1.MachineStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineStats_To_v1alpha1_MachineStats(a.(*compute.MachineStats), b.(*computev1alpha1.MachineStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineStatus)(nil), (*compute.MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineStatus_To_compute_MachineStatus(a.(*computev1alpha1.MachineStatus), b.(*compute.MachineStatus), scope)
 	}); err != nil {
@@ -255,6 +596,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineTemplateSpec)(nil), (*compute.MachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineTemplateSpec_To_compute_MachineTemplateSpec(a.(*computev1alpha1.MachineTemplateSpec), b.(*compute.MachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineTemplateSpec)(nil), (*computev1alpha1.MachineTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(a.(*compute.MachineTemplateSpec), b.(*computev1alpha1.MachineTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.MachineVolumeStats)(nil), (*compute.MachineVolumeStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineVolumeStats_To_compute_MachineVolumeStats(a.(*computev1alpha1.MachineVolumeStats), b.(*compute.MachineVolumeStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.MachineVolumeStats)(nil), (*computev1alpha1.MachineVolumeStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_MachineVolumeStats_To_v1alpha1_MachineVolumeStats(a.(*compute.MachineVolumeStats), b.(*computev1alpha1.MachineVolumeStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.NetworkInterface)(nil), (*compute.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkInterface_To_compute_NetworkInterface(a.(*computev1alpha1.NetworkInterface), b.(*compute.NetworkInterface), scope)
 	}); err != nil {
@@ -285,6 +646,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.RollingUpdateMachineDeployment)(nil), (*compute.RollingUpdateMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollingUpdateMachineDeployment_To_compute_RollingUpdateMachineDeployment(a.(*computev1alpha1.RollingUpdateMachineDeployment), b.(*compute.RollingUpdateMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.RollingUpdateMachineDeployment)(nil), (*computev1alpha1.RollingUpdateMachineDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdateMachineDeployment(a.(*compute.RollingUpdateMachineDeployment), b.(*computev1alpha1.RollingUpdateMachineDeployment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.TopologySpreadConstraint)(nil), (*compute.TopologySpreadConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TopologySpreadConstraint_To_compute_TopologySpreadConstraint(a.(*computev1alpha1.TopologySpreadConstraint), b.(*compute.TopologySpreadConstraint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.TopologySpreadConstraint)(nil), (*computev1alpha1.TopologySpreadConstraint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_TopologySpreadConstraint_To_v1alpha1_TopologySpreadConstraint(a.(*compute.TopologySpreadConstraint), b.(*computev1alpha1.TopologySpreadConstraint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.UnhealthyMachine)(nil), (*compute.UnhealthyMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UnhealthyMachine_To_compute_UnhealthyMachine(a.(*computev1alpha1.UnhealthyMachine), b.(*compute.UnhealthyMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.UnhealthyMachine)(nil), (*computev1alpha1.UnhealthyMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_UnhealthyMachine_To_v1alpha1_UnhealthyMachine(a.(*compute.UnhealthyMachine), b.(*computev1alpha1.UnhealthyMachine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.UnhealthyMachineCondition)(nil), (*compute.UnhealthyMachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UnhealthyMachineCondition_To_compute_UnhealthyMachineCondition(a.(*computev1alpha1.UnhealthyMachineCondition), b.(*compute.UnhealthyMachineCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.UnhealthyMachineCondition)(nil), (*computev1alpha1.UnhealthyMachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_UnhealthyMachineCondition_To_v1alpha1_UnhealthyMachineCondition(a.(*compute.UnhealthyMachineCondition), b.(*computev1alpha1.UnhealthyMachineCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.UnhealthyMachineState)(nil), (*compute.UnhealthyMachineState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_UnhealthyMachineState_To_compute_UnhealthyMachineState(a.(*computev1alpha1.UnhealthyMachineState), b.(*compute.UnhealthyMachineState), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.UnhealthyMachineState)(nil), (*computev1alpha1.UnhealthyMachineState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_UnhealthyMachineState_To_v1alpha1_UnhealthyMachineState(a.(*compute.UnhealthyMachineState), b.(*computev1alpha1.UnhealthyMachineState), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*computev1alpha1.Volume)(nil), (*compute.Volume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Volume_To_compute_Volume(a.(*computev1alpha1.Volume), b.(*compute.Volume), scope)
 	}); err != nil {
@@ -315,6 +726,21 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*computev1alpha1.WeightedMachineAffinityTerm)(nil), (*compute.WeightedMachineAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WeightedMachineAffinityTerm_To_compute_WeightedMachineAffinityTerm(a.(*computev1alpha1.WeightedMachineAffinityTerm), b.(*compute.WeightedMachineAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*compute.WeightedMachineAffinityTerm)(nil), (*computev1alpha1.WeightedMachineAffinityTerm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_compute_WeightedMachineAffinityTerm_To_v1alpha1_WeightedMachineAffinityTerm(a.(*compute.WeightedMachineAffinityTerm), b.(*computev1alpha1.WeightedMachineAffinityTerm), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*computev1alpha1.MachineConsoleLogOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1alpha1_MachineConsoleLogOptions(a.(*url.Values), b.(*computev1alpha1.MachineConsoleLogOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*computev1alpha1.MachineExecOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_url_Values_To_v1alpha1_MachineExecOptions(a.(*url.Values), b.(*computev1alpha1.MachineExecOptions), scope)
 	}); err != nil {
@@ -323,7 +749,29 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
-func autoConvert_v1alpha1_DaemonEndpoint_To_compute_DaemonEndpoint(in *computev1alpha1.DaemonEndpoint, out *compute.DaemonEndpoint, s conversion.Scope) error {
+func autoConvert_v1alpha1_Affinity_To_compute_Affinity(in *computev1alpha1.Affinity, out *compute.Affinity, s conversion.Scope) error {
+	out.MachineAffinity = (*compute.MachineAffinity)(unsafe.Pointer(in.MachineAffinity))
+	out.MachineAntiAffinity = (*compute.MachineAntiAffinity)(unsafe.Pointer(in.MachineAntiAffinity))
+	return nil
+}
+
+// Convert_v1alpha1_Affinity_To_compute_Affinity is an autogenerated conversion function.
+func Convert_v1alpha1_Affinity_To_compute_Affinity(in *computev1alpha1.Affinity, out *compute.Affinity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Affinity_To_compute_Affinity(in, out, s)
+}
+
+func autoConvert_compute_Affinity_To_v1alpha1_Affinity(in *compute.Affinity, out *computev1alpha1.Affinity, s conversion.Scope) error {
+	out.MachineAffinity = (*computev1alpha1.MachineAffinity)(unsafe.Pointer(in.MachineAffinity))
+	out.MachineAntiAffinity = (*computev1alpha1.MachineAntiAffinity)(unsafe.Pointer(in.MachineAntiAffinity))
+	return nil
+}
+
+// Convert_compute_Affinity_To_v1alpha1_Affinity is an autogenerated conversion function.
+func Convert_compute_Affinity_To_v1alpha1_Affinity(in *compute.Affinity, out *computev1alpha1.Affinity, s conversion.Scope) error {
+	return autoConvert_compute_Affinity_To_v1alpha1_Affinity(in, out, s)
+}
+
+func autoConvert_v1alpha1_DaemonEndpoint_To_compute_DaemonEndpoint(in *computev1alpha1.DaemonEndpoint, out *compute.DaemonEndpoint, s conversion.Scope) error {
 	out.Port = in.Port
 	return nil
 }
@@ -481,6 +929,94 @@ func Convert_compute_Machine_To_v1alpha1_Machine(in *compute.Machine, out *compu
 	return autoConvert_compute_Machine_To_v1alpha1_Machine(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineAffinity_To_compute_MachineAffinity(in *computev1alpha1.MachineAffinity, out *compute.MachineAffinity, s conversion.Scope) error {
+	out.RequiredDuringSchedulingIgnoredDuringExecution = *(*[]compute.MachineAffinityTerm)(unsafe.Pointer(&in.RequiredDuringSchedulingIgnoredDuringExecution))
+	out.PreferredDuringSchedulingIgnoredDuringExecution = *(*[]compute.WeightedMachineAffinityTerm)(unsafe.Pointer(&in.PreferredDuringSchedulingIgnoredDuringExecution))
+	return nil
+}
+
+// Convert_v1alpha1_MachineAffinity_To_compute_MachineAffinity is an autogenerated conversion function.
+func Convert_v1alpha1_MachineAffinity_To_compute_MachineAffinity(in *computev1alpha1.MachineAffinity, out *compute.MachineAffinity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineAffinity_To_compute_MachineAffinity(in, out, s)
+}
+
+func autoConvert_compute_MachineAffinity_To_v1alpha1_MachineAffinity(in *compute.MachineAffinity, out *computev1alpha1.MachineAffinity, s conversion.Scope) error {
+	out.RequiredDuringSchedulingIgnoredDuringExecution = *(*[]computev1alpha1.MachineAffinityTerm)(unsafe.Pointer(&in.RequiredDuringSchedulingIgnoredDuringExecution))
+	out.PreferredDuringSchedulingIgnoredDuringExecution = *(*[]computev1alpha1.WeightedMachineAffinityTerm)(unsafe.Pointer(&in.PreferredDuringSchedulingIgnoredDuringExecution))
+	return nil
+}
+
+// Convert_compute_MachineAffinity_To_v1alpha1_MachineAffinity is an autogenerated conversion function.
+func Convert_compute_MachineAffinity_To_v1alpha1_MachineAffinity(in *compute.MachineAffinity, out *computev1alpha1.MachineAffinity, s conversion.Scope) error {
+	return autoConvert_compute_MachineAffinity_To_v1alpha1_MachineAffinity(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineAffinityTerm_To_compute_MachineAffinityTerm(in *computev1alpha1.MachineAffinityTerm, out *compute.MachineAffinityTerm, s conversion.Scope) error {
+	out.LabelSelector = (*v1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+// Convert_v1alpha1_MachineAffinityTerm_To_compute_MachineAffinityTerm is an autogenerated conversion function.
+func Convert_v1alpha1_MachineAffinityTerm_To_compute_MachineAffinityTerm(in *computev1alpha1.MachineAffinityTerm, out *compute.MachineAffinityTerm, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineAffinityTerm_To_compute_MachineAffinityTerm(in, out, s)
+}
+
+func autoConvert_compute_MachineAffinityTerm_To_v1alpha1_MachineAffinityTerm(in *compute.MachineAffinityTerm, out *computev1alpha1.MachineAffinityTerm, s conversion.Scope) error {
+	out.LabelSelector = (*v1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.TopologyKey = in.TopologyKey
+	return nil
+}
+
+// Convert_compute_MachineAffinityTerm_To_v1alpha1_MachineAffinityTerm is an autogenerated conversion function.
+func Convert_compute_MachineAffinityTerm_To_v1alpha1_MachineAffinityTerm(in *compute.MachineAffinityTerm, out *computev1alpha1.MachineAffinityTerm, s conversion.Scope) error {
+	return autoConvert_compute_MachineAffinityTerm_To_v1alpha1_MachineAffinityTerm(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineAntiAffinity_To_compute_MachineAntiAffinity(in *computev1alpha1.MachineAntiAffinity, out *compute.MachineAntiAffinity, s conversion.Scope) error {
+	out.RequiredDuringSchedulingIgnoredDuringExecution = *(*[]compute.MachineAffinityTerm)(unsafe.Pointer(&in.RequiredDuringSchedulingIgnoredDuringExecution))
+	out.PreferredDuringSchedulingIgnoredDuringExecution = *(*[]compute.WeightedMachineAffinityTerm)(unsafe.Pointer(&in.PreferredDuringSchedulingIgnoredDuringExecution))
+	return nil
+}
+
+// Convert_v1alpha1_MachineAntiAffinity_To_compute_MachineAntiAffinity is an autogenerated conversion function.
+func Convert_v1alpha1_MachineAntiAffinity_To_compute_MachineAntiAffinity(in *computev1alpha1.MachineAntiAffinity, out *compute.MachineAntiAffinity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineAntiAffinity_To_compute_MachineAntiAffinity(in, out, s)
+}
+
+func autoConvert_compute_MachineAntiAffinity_To_v1alpha1_MachineAntiAffinity(in *compute.MachineAntiAffinity, out *computev1alpha1.MachineAntiAffinity, s conversion.Scope) error {
+	out.RequiredDuringSchedulingIgnoredDuringExecution = *(*[]computev1alpha1.MachineAffinityTerm)(unsafe.Pointer(&in.RequiredDuringSchedulingIgnoredDuringExecution))
+	out.PreferredDuringSchedulingIgnoredDuringExecution = *(*[]computev1alpha1.WeightedMachineAffinityTerm)(unsafe.Pointer(&in.PreferredDuringSchedulingIgnoredDuringExecution))
+	return nil
+}
+
+// Convert_compute_MachineAntiAffinity_To_v1alpha1_MachineAntiAffinity is an autogenerated conversion function.
+func Convert_compute_MachineAntiAffinity_To_v1alpha1_MachineAntiAffinity(in *compute.MachineAntiAffinity, out *computev1alpha1.MachineAntiAffinity, s conversion.Scope) error {
+	return autoConvert_compute_MachineAntiAffinity_To_v1alpha1_MachineAntiAffinity(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineCPUStats_To_compute_MachineCPUStats(in *computev1alpha1.MachineCPUStats, out *compute.MachineCPUStats, s conversion.Scope) error {
+	out.UsageCoreNanoSeconds = in.UsageCoreNanoSeconds
+	return nil
+}
+
+// Convert_v1alpha1_MachineCPUStats_To_compute_MachineCPUStats is an autogenerated conversion function.
+func Convert_v1alpha1_MachineCPUStats_To_compute_MachineCPUStats(in *computev1alpha1.MachineCPUStats, out *compute.MachineCPUStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineCPUStats_To_compute_MachineCPUStats(in, out, s)
+}
+
+func autoConvert_compute_MachineCPUStats_To_v1alpha1_MachineCPUStats(in *compute.MachineCPUStats, out *computev1alpha1.MachineCPUStats, s conversion.Scope) error {
+	out.UsageCoreNanoSeconds = in.UsageCoreNanoSeconds
+	return nil
+}
+
+// Convert_compute_MachineCPUStats_To_v1alpha1_MachineCPUStats is an autogenerated conversion function.
+func Convert_compute_MachineCPUStats_To_v1alpha1_MachineCPUStats(in *compute.MachineCPUStats, out *computev1alpha1.MachineCPUStats, s conversion.Scope) error {
+	return autoConvert_compute_MachineCPUStats_To_v1alpha1_MachineCPUStats(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineClass_To_compute_MachineClass(in *computev1alpha1.MachineClass, out *compute.MachineClass, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Capabilities = *(*core.ResourceList)(unsafe.Pointer(&in.Capabilities))
@@ -527,7 +1063,7 @@ func Convert_compute_MachineClassList_To_v1alpha1_MachineClassList(in *compute.M
 
 func autoConvert_v1alpha1_MachineCondition_To_compute_MachineCondition(in *computev1alpha1.MachineCondition, out *compute.MachineCondition, s conversion.Scope) error {
 	out.Type = compute.MachineConditionType(in.Type)
-	out.Status = v1.ConditionStatus(in.Status)
+	out.Status = corev1.ConditionStatus(in.Status)
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.LastTransitionTime = in.LastTransitionTime
@@ -541,7 +1077,7 @@ func Convert_v1alpha1_MachineCondition_To_compute_MachineCondition(in *computev1
 
 func autoConvert_compute_MachineCondition_To_v1alpha1_MachineCondition(in *compute.MachineCondition, out *computev1alpha1.MachineCondition, s conversion.Scope) error {
 	out.Type = computev1alpha1.MachineConditionType(in.Type)
-	out.Status = v1.ConditionStatus(in.Status)
+	out.Status = corev1.ConditionStatus(in.Status)
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.LastTransitionTime = in.LastTransitionTime
@@ -553,6 +1089,361 @@ func Convert_compute_MachineCondition_To_v1alpha1_MachineCondition(in *compute.M
 	return autoConvert_compute_MachineCondition_To_v1alpha1_MachineCondition(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineConsoleLogOptions_To_compute_MachineConsoleLogOptions(in *computev1alpha1.MachineConsoleLogOptions, out *compute.MachineConsoleLogOptions, s conversion.Scope) error {
+	out.Follow = in.Follow
+	out.TailLines = (*int64)(unsafe.Pointer(in.TailLines))
+	out.SinceTime = (*v1.Time)(unsafe.Pointer(in.SinceTime))
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_v1alpha1_MachineConsoleLogOptions_To_compute_MachineConsoleLogOptions is an autogenerated conversion function.
+func Convert_v1alpha1_MachineConsoleLogOptions_To_compute_MachineConsoleLogOptions(in *computev1alpha1.MachineConsoleLogOptions, out *compute.MachineConsoleLogOptions, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineConsoleLogOptions_To_compute_MachineConsoleLogOptions(in, out, s)
+}
+
+func autoConvert_compute_MachineConsoleLogOptions_To_v1alpha1_MachineConsoleLogOptions(in *compute.MachineConsoleLogOptions, out *computev1alpha1.MachineConsoleLogOptions, s conversion.Scope) error {
+	out.Follow = in.Follow
+	out.TailLines = (*int64)(unsafe.Pointer(in.TailLines))
+	out.SinceTime = (*v1.Time)(unsafe.Pointer(in.SinceTime))
+	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
+	return nil
+}
+
+// Convert_compute_MachineConsoleLogOptions_To_v1alpha1_MachineConsoleLogOptions is an autogenerated conversion function.
+func Convert_compute_MachineConsoleLogOptions_To_v1alpha1_MachineConsoleLogOptions(in *compute.MachineConsoleLogOptions, out *computev1alpha1.MachineConsoleLogOptions, s conversion.Scope) error {
+	return autoConvert_compute_MachineConsoleLogOptions_To_v1alpha1_MachineConsoleLogOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1alpha1_MachineConsoleLogOptions(in *url.Values, out *computev1alpha1.MachineConsoleLogOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["follow"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Follow, s); err != nil {
+			return err
+		}
+	} else {
+		out.Follow = false
+	}
+	if values, ok := map[string][]string(*in)["tailLines"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_Pointer_int64(&values, &out.TailLines, s); err != nil {
+			return err
+		}
+	} else {
+		out.TailLines = nil
+	}
+	if values, ok := map[string][]string(*in)["sinceTime"]; ok && len(values) > 0 {
+		if err := v1.Convert_Slice_string_To_Pointer_v1_Time(&values, &out.SinceTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.SinceTime = nil
+	}
+	if values, ok := map[string][]string(*in)["insecureSkipTLSVerifyBackend"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.InsecureSkipTLSVerifyBackend, s); err != nil {
+			return err
+		}
+	} else {
+		out.InsecureSkipTLSVerifyBackend = false
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1alpha1_MachineConsoleLogOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1alpha1_MachineConsoleLogOptions(in *url.Values, out *computev1alpha1.MachineConsoleLogOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1alpha1_MachineConsoleLogOptions(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeployment_To_compute_MachineDeployment(in *computev1alpha1.MachineDeployment, out *compute.MachineDeployment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_MachineDeploymentSpec_To_compute_MachineDeploymentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_MachineDeploymentStatus_To_compute_MachineDeploymentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeployment_To_compute_MachineDeployment is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeployment_To_compute_MachineDeployment(in *computev1alpha1.MachineDeployment, out *compute.MachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeployment_To_compute_MachineDeployment(in, out, s)
+}
+
+func autoConvert_compute_MachineDeployment_To_v1alpha1_MachineDeployment(in *compute.MachineDeployment, out *computev1alpha1.MachineDeployment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_compute_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_compute_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_compute_MachineDeployment_To_v1alpha1_MachineDeployment is an autogenerated conversion function.
+func Convert_compute_MachineDeployment_To_v1alpha1_MachineDeployment(in *compute.MachineDeployment, out *computev1alpha1.MachineDeployment, s conversion.Scope) error {
+	return autoConvert_compute_MachineDeployment_To_v1alpha1_MachineDeployment(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeploymentList_To_compute_MachineDeploymentList(in *computev1alpha1.MachineDeploymentList, out *compute.MachineDeploymentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]compute.MachineDeployment, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_MachineDeployment_To_compute_MachineDeployment(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentList_To_compute_MachineDeploymentList is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentList_To_compute_MachineDeploymentList(in *computev1alpha1.MachineDeploymentList, out *compute.MachineDeploymentList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentList_To_compute_MachineDeploymentList(in, out, s)
+}
+
+func autoConvert_compute_MachineDeploymentList_To_v1alpha1_MachineDeploymentList(in *compute.MachineDeploymentList, out *computev1alpha1.MachineDeploymentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]computev1alpha1.MachineDeployment, len(*in))
+		for i := range *in {
+			if err := Convert_compute_MachineDeployment_To_v1alpha1_MachineDeployment(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_compute_MachineDeploymentList_To_v1alpha1_MachineDeploymentList is an autogenerated conversion function.
+func Convert_compute_MachineDeploymentList_To_v1alpha1_MachineDeploymentList(in *compute.MachineDeploymentList, out *computev1alpha1.MachineDeploymentList, s conversion.Scope) error {
+	return autoConvert_compute_MachineDeploymentList_To_v1alpha1_MachineDeploymentList(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeploymentSpec_To_compute_MachineDeploymentSpec(in *computev1alpha1.MachineDeploymentSpec, out *compute.MachineDeploymentSpec, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_v1alpha1_MachineTemplateSpec_To_compute_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_MachineDeploymentStrategy_To_compute_MachineDeploymentStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentSpec_To_compute_MachineDeploymentSpec is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentSpec_To_compute_MachineDeploymentSpec(in *computev1alpha1.MachineDeploymentSpec, out *compute.MachineDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentSpec_To_compute_MachineDeploymentSpec(in, out, s)
+}
+
+func autoConvert_compute_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec(in *compute.MachineDeploymentSpec, out *computev1alpha1.MachineDeploymentSpec, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_compute_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	if err := Convert_compute_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	return nil
+}
+
+// Convert_compute_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec is an autogenerated conversion function.
+func Convert_compute_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec(in *compute.MachineDeploymentSpec, out *computev1alpha1.MachineDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_compute_MachineDeploymentSpec_To_v1alpha1_MachineDeploymentSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeploymentStatus_To_compute_MachineDeploymentStatus(in *computev1alpha1.MachineDeploymentStatus, out *compute.MachineDeploymentStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.UnavailableReplicas = in.UnavailableReplicas
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentStatus_To_compute_MachineDeploymentStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentStatus_To_compute_MachineDeploymentStatus(in *computev1alpha1.MachineDeploymentStatus, out *compute.MachineDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentStatus_To_compute_MachineDeploymentStatus(in, out, s)
+}
+
+func autoConvert_compute_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentStatus(in *compute.MachineDeploymentStatus, out *computev1alpha1.MachineDeploymentStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.UnavailableReplicas = in.UnavailableReplicas
+	return nil
+}
+
+// Convert_compute_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentStatus is an autogenerated conversion function.
+func Convert_compute_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentStatus(in *compute.MachineDeploymentStatus, out *computev1alpha1.MachineDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_compute_MachineDeploymentStatus_To_v1alpha1_MachineDeploymentStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDeploymentStrategy_To_compute_MachineDeploymentStrategy(in *computev1alpha1.MachineDeploymentStrategy, out *compute.MachineDeploymentStrategy, s conversion.Scope) error {
+	out.Type = compute.MachineDeploymentStrategyType(in.Type)
+	out.RollingUpdate = (*compute.RollingUpdateMachineDeployment)(unsafe.Pointer(in.RollingUpdate))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDeploymentStrategy_To_compute_MachineDeploymentStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDeploymentStrategy_To_compute_MachineDeploymentStrategy(in *computev1alpha1.MachineDeploymentStrategy, out *compute.MachineDeploymentStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDeploymentStrategy_To_compute_MachineDeploymentStrategy(in, out, s)
+}
+
+func autoConvert_compute_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy(in *compute.MachineDeploymentStrategy, out *computev1alpha1.MachineDeploymentStrategy, s conversion.Scope) error {
+	out.Type = computev1alpha1.MachineDeploymentStrategyType(in.Type)
+	out.RollingUpdate = (*computev1alpha1.RollingUpdateMachineDeployment)(unsafe.Pointer(in.RollingUpdate))
+	return nil
+}
+
+// Convert_compute_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy is an autogenerated conversion function.
+func Convert_compute_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy(in *compute.MachineDeploymentStrategy, out *computev1alpha1.MachineDeploymentStrategy, s conversion.Scope) error {
+	return autoConvert_compute_MachineDeploymentStrategy_To_v1alpha1_MachineDeploymentStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDisruptionBudget_To_compute_MachineDisruptionBudget(in *computev1alpha1.MachineDisruptionBudget, out *compute.MachineDisruptionBudget, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_MachineDisruptionBudgetSpec_To_compute_MachineDisruptionBudgetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_MachineDisruptionBudgetStatus_To_compute_MachineDisruptionBudgetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineDisruptionBudget_To_compute_MachineDisruptionBudget is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDisruptionBudget_To_compute_MachineDisruptionBudget(in *computev1alpha1.MachineDisruptionBudget, out *compute.MachineDisruptionBudget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDisruptionBudget_To_compute_MachineDisruptionBudget(in, out, s)
+}
+
+func autoConvert_compute_MachineDisruptionBudget_To_v1alpha1_MachineDisruptionBudget(in *compute.MachineDisruptionBudget, out *computev1alpha1.MachineDisruptionBudget, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_compute_MachineDisruptionBudgetSpec_To_v1alpha1_MachineDisruptionBudgetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_compute_MachineDisruptionBudgetStatus_To_v1alpha1_MachineDisruptionBudgetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_compute_MachineDisruptionBudget_To_v1alpha1_MachineDisruptionBudget is an autogenerated conversion function.
+func Convert_compute_MachineDisruptionBudget_To_v1alpha1_MachineDisruptionBudget(in *compute.MachineDisruptionBudget, out *computev1alpha1.MachineDisruptionBudget, s conversion.Scope) error {
+	return autoConvert_compute_MachineDisruptionBudget_To_v1alpha1_MachineDisruptionBudget(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDisruptionBudgetList_To_compute_MachineDisruptionBudgetList(in *computev1alpha1.MachineDisruptionBudgetList, out *compute.MachineDisruptionBudgetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]compute.MachineDisruptionBudget)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDisruptionBudgetList_To_compute_MachineDisruptionBudgetList is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDisruptionBudgetList_To_compute_MachineDisruptionBudgetList(in *computev1alpha1.MachineDisruptionBudgetList, out *compute.MachineDisruptionBudgetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDisruptionBudgetList_To_compute_MachineDisruptionBudgetList(in, out, s)
+}
+
+func autoConvert_compute_MachineDisruptionBudgetList_To_v1alpha1_MachineDisruptionBudgetList(in *compute.MachineDisruptionBudgetList, out *computev1alpha1.MachineDisruptionBudgetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]computev1alpha1.MachineDisruptionBudget)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_compute_MachineDisruptionBudgetList_To_v1alpha1_MachineDisruptionBudgetList is an autogenerated conversion function.
+func Convert_compute_MachineDisruptionBudgetList_To_v1alpha1_MachineDisruptionBudgetList(in *compute.MachineDisruptionBudgetList, out *computev1alpha1.MachineDisruptionBudgetList, s conversion.Scope) error {
+	return autoConvert_compute_MachineDisruptionBudgetList_To_v1alpha1_MachineDisruptionBudgetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDisruptionBudgetSpec_To_compute_MachineDisruptionBudgetSpec(in *computev1alpha1.MachineDisruptionBudgetSpec, out *compute.MachineDisruptionBudgetSpec, s conversion.Scope) error {
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.MinAvailable = (*intstr.IntOrString)(unsafe.Pointer(in.MinAvailable))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_v1alpha1_MachineDisruptionBudgetSpec_To_compute_MachineDisruptionBudgetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDisruptionBudgetSpec_To_compute_MachineDisruptionBudgetSpec(in *computev1alpha1.MachineDisruptionBudgetSpec, out *compute.MachineDisruptionBudgetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDisruptionBudgetSpec_To_compute_MachineDisruptionBudgetSpec(in, out, s)
+}
+
+func autoConvert_compute_MachineDisruptionBudgetSpec_To_v1alpha1_MachineDisruptionBudgetSpec(in *compute.MachineDisruptionBudgetSpec, out *computev1alpha1.MachineDisruptionBudgetSpec, s conversion.Scope) error {
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.MinAvailable = (*intstr.IntOrString)(unsafe.Pointer(in.MinAvailable))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	return nil
+}
+
+// Convert_compute_MachineDisruptionBudgetSpec_To_v1alpha1_MachineDisruptionBudgetSpec is an autogenerated conversion function.
+func Convert_compute_MachineDisruptionBudgetSpec_To_v1alpha1_MachineDisruptionBudgetSpec(in *compute.MachineDisruptionBudgetSpec, out *computev1alpha1.MachineDisruptionBudgetSpec, s conversion.Scope) error {
+	return autoConvert_compute_MachineDisruptionBudgetSpec_To_v1alpha1_MachineDisruptionBudgetSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineDisruptionBudgetStatus_To_compute_MachineDisruptionBudgetStatus(in *computev1alpha1.MachineDisruptionBudgetStatus, out *compute.MachineDisruptionBudgetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.DisruptedMachines = *(*map[string]v1.Time)(unsafe.Pointer(&in.DisruptedMachines))
+	out.DisruptionsAllowed = in.DisruptionsAllowed
+	out.CurrentHealthy = in.CurrentHealthy
+	out.DesiredHealthy = in.DesiredHealthy
+	out.ExpectedMachines = in.ExpectedMachines
+	return nil
+}
+
+// Convert_v1alpha1_MachineDisruptionBudgetStatus_To_compute_MachineDisruptionBudgetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MachineDisruptionBudgetStatus_To_compute_MachineDisruptionBudgetStatus(in *computev1alpha1.MachineDisruptionBudgetStatus, out *compute.MachineDisruptionBudgetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineDisruptionBudgetStatus_To_compute_MachineDisruptionBudgetStatus(in, out, s)
+}
+
+func autoConvert_compute_MachineDisruptionBudgetStatus_To_v1alpha1_MachineDisruptionBudgetStatus(in *compute.MachineDisruptionBudgetStatus, out *computev1alpha1.MachineDisruptionBudgetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.DisruptedMachines = *(*map[string]v1.Time)(unsafe.Pointer(&in.DisruptedMachines))
+	out.DisruptionsAllowed = in.DisruptionsAllowed
+	out.CurrentHealthy = in.CurrentHealthy
+	out.DesiredHealthy = in.DesiredHealthy
+	out.ExpectedMachines = in.ExpectedMachines
+	return nil
+}
+
+// Convert_compute_MachineDisruptionBudgetStatus_To_v1alpha1_MachineDisruptionBudgetStatus is an autogenerated conversion function.
+func Convert_compute_MachineDisruptionBudgetStatus_To_v1alpha1_MachineDisruptionBudgetStatus(in *compute.MachineDisruptionBudgetStatus, out *computev1alpha1.MachineDisruptionBudgetStatus, s conversion.Scope) error {
+	return autoConvert_compute_MachineDisruptionBudgetStatus_To_v1alpha1_MachineDisruptionBudgetStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineEviction_To_compute_MachineEviction(in *computev1alpha1.MachineEviction, out *compute.MachineEviction, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.DeleteOptions = (*v1.DeleteOptions)(unsafe.Pointer(in.DeleteOptions))
+	return nil
+}
+
+// Convert_v1alpha1_MachineEviction_To_compute_MachineEviction is an autogenerated conversion function.
+func Convert_v1alpha1_MachineEviction_To_compute_MachineEviction(in *computev1alpha1.MachineEviction, out *compute.MachineEviction, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineEviction_To_compute_MachineEviction(in, out, s)
+}
+
+func autoConvert_compute_MachineEviction_To_v1alpha1_MachineEviction(in *compute.MachineEviction, out *computev1alpha1.MachineEviction, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.DeleteOptions = (*v1.DeleteOptions)(unsafe.Pointer(in.DeleteOptions))
+	return nil
+}
+
+// Convert_compute_MachineEviction_To_v1alpha1_MachineEviction is an autogenerated conversion function.
+func Convert_compute_MachineEviction_To_v1alpha1_MachineEviction(in *compute.MachineEviction, out *computev1alpha1.MachineEviction, s conversion.Scope) error {
+	return autoConvert_compute_MachineEviction_To_v1alpha1_MachineEviction(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineExecOptions_To_compute_MachineExecOptions(in *computev1alpha1.MachineExecOptions, out *compute.MachineExecOptions, s conversion.Scope) error {
 	out.InsecureSkipTLSVerifyBackend = in.InsecureSkipTLSVerifyBackend
 	return nil
@@ -593,6 +1484,10 @@ func Convert_url_Values_To_v1alpha1_MachineExecOptions(in *url.Values, out *comp
 
 func autoConvert_v1alpha1_MachineGuestConfig_To_compute_MachineGuestConfig(in *computev1alpha1.MachineGuestConfig, out *compute.MachineGuestConfig, s conversion.Scope) error {
 	out.Hostname = in.Hostname
+	out.SSHPublicKeyRefs = *(*[]commonv1alpha1.SecretKeySelector)(unsafe.Pointer(&in.SSHPublicKeyRefs))
+	out.Timezone = in.Timezone
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	out.UserDataRef = (*commonv1alpha1.SecretKeySelector)(unsafe.Pointer(in.UserDataRef))
 	return nil
 }
 
@@ -603,6 +1498,10 @@ func Convert_v1alpha1_MachineGuestConfig_To_compute_MachineGuestConfig(in *compu
 
 func autoConvert_compute_MachineGuestConfig_To_v1alpha1_MachineGuestConfig(in *compute.MachineGuestConfig, out *computev1alpha1.MachineGuestConfig, s conversion.Scope) error {
 	out.Hostname = in.Hostname
+	out.SSHPublicKeyRefs = *(*[]commonv1alpha1.SecretKeySelector)(unsafe.Pointer(&in.SSHPublicKeyRefs))
+	out.Timezone = in.Timezone
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	out.UserDataRef = (*commonv1alpha1.SecretKeySelector)(unsafe.Pointer(in.UserDataRef))
 	return nil
 }
 
@@ -611,6 +1510,170 @@ func Convert_compute_MachineGuestConfig_To_v1alpha1_MachineGuestConfig(in *compu
 	return autoConvert_compute_MachineGuestConfig_To_v1alpha1_MachineGuestConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineHealthCheck_To_compute_MachineHealthCheck(in *computev1alpha1.MachineHealthCheck, out *compute.MachineHealthCheck, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_MachineHealthCheckSpec_To_compute_MachineHealthCheckSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_MachineHealthCheckStatus_To_compute_MachineHealthCheckStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineHealthCheck_To_compute_MachineHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_MachineHealthCheck_To_compute_MachineHealthCheck(in *computev1alpha1.MachineHealthCheck, out *compute.MachineHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineHealthCheck_To_compute_MachineHealthCheck(in, out, s)
+}
+
+func autoConvert_compute_MachineHealthCheck_To_v1alpha1_MachineHealthCheck(in *compute.MachineHealthCheck, out *computev1alpha1.MachineHealthCheck, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_compute_MachineHealthCheckSpec_To_v1alpha1_MachineHealthCheckSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_compute_MachineHealthCheckStatus_To_v1alpha1_MachineHealthCheckStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_compute_MachineHealthCheck_To_v1alpha1_MachineHealthCheck is an autogenerated conversion function.
+func Convert_compute_MachineHealthCheck_To_v1alpha1_MachineHealthCheck(in *compute.MachineHealthCheck, out *computev1alpha1.MachineHealthCheck, s conversion.Scope) error {
+	return autoConvert_compute_MachineHealthCheck_To_v1alpha1_MachineHealthCheck(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineHealthCheckCondition_To_compute_MachineHealthCheckCondition(in *computev1alpha1.MachineHealthCheckCondition, out *compute.MachineHealthCheckCondition, s conversion.Scope) error {
+	out.Type = compute.MachineHealthCheckConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1alpha1_MachineHealthCheckCondition_To_compute_MachineHealthCheckCondition is an autogenerated conversion function.
+func Convert_v1alpha1_MachineHealthCheckCondition_To_compute_MachineHealthCheckCondition(in *computev1alpha1.MachineHealthCheckCondition, out *compute.MachineHealthCheckCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineHealthCheckCondition_To_compute_MachineHealthCheckCondition(in, out, s)
+}
+
+func autoConvert_compute_MachineHealthCheckCondition_To_v1alpha1_MachineHealthCheckCondition(in *compute.MachineHealthCheckCondition, out *computev1alpha1.MachineHealthCheckCondition, s conversion.Scope) error {
+	out.Type = computev1alpha1.MachineHealthCheckConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_compute_MachineHealthCheckCondition_To_v1alpha1_MachineHealthCheckCondition is an autogenerated conversion function.
+func Convert_compute_MachineHealthCheckCondition_To_v1alpha1_MachineHealthCheckCondition(in *compute.MachineHealthCheckCondition, out *computev1alpha1.MachineHealthCheckCondition, s conversion.Scope) error {
+	return autoConvert_compute_MachineHealthCheckCondition_To_v1alpha1_MachineHealthCheckCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineHealthCheckList_To_compute_MachineHealthCheckList(in *computev1alpha1.MachineHealthCheckList, out *compute.MachineHealthCheckList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]compute.MachineHealthCheck)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_MachineHealthCheckList_To_compute_MachineHealthCheckList is an autogenerated conversion function.
+func Convert_v1alpha1_MachineHealthCheckList_To_compute_MachineHealthCheckList(in *computev1alpha1.MachineHealthCheckList, out *compute.MachineHealthCheckList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineHealthCheckList_To_compute_MachineHealthCheckList(in, out, s)
+}
+
+func autoConvert_compute_MachineHealthCheckList_To_v1alpha1_MachineHealthCheckList(in *compute.MachineHealthCheckList, out *computev1alpha1.MachineHealthCheckList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]computev1alpha1.MachineHealthCheck)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_compute_MachineHealthCheckList_To_v1alpha1_MachineHealthCheckList is an autogenerated conversion function.
+func Convert_compute_MachineHealthCheckList_To_v1alpha1_MachineHealthCheckList(in *compute.MachineHealthCheckList, out *computev1alpha1.MachineHealthCheckList, s conversion.Scope) error {
+	return autoConvert_compute_MachineHealthCheckList_To_v1alpha1_MachineHealthCheckList(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineHealthCheckSpec_To_compute_MachineHealthCheckSpec(in *computev1alpha1.MachineHealthCheckSpec, out *compute.MachineHealthCheckSpec, s conversion.Scope) error {
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.UnhealthyStates = *(*[]compute.UnhealthyMachineState)(unsafe.Pointer(&in.UnhealthyStates))
+	out.UnhealthyConditions = *(*[]compute.UnhealthyMachineCondition)(unsafe.Pointer(&in.UnhealthyConditions))
+	out.MaxUnhealthy = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnhealthy))
+	out.RemediationStrategy = compute.MachineRemediationStrategy(in.RemediationStrategy)
+	return nil
+}
+
+// Convert_v1alpha1_MachineHealthCheckSpec_To_compute_MachineHealthCheckSpec is an autogenerated conversion function.
+func Convert_v1alpha1_MachineHealthCheckSpec_To_compute_MachineHealthCheckSpec(in *computev1alpha1.MachineHealthCheckSpec, out *compute.MachineHealthCheckSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineHealthCheckSpec_To_compute_MachineHealthCheckSpec(in, out, s)
+}
+
+func autoConvert_compute_MachineHealthCheckSpec_To_v1alpha1_MachineHealthCheckSpec(in *compute.MachineHealthCheckSpec, out *computev1alpha1.MachineHealthCheckSpec, s conversion.Scope) error {
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.UnhealthyStates = *(*[]computev1alpha1.UnhealthyMachineState)(unsafe.Pointer(&in.UnhealthyStates))
+	out.UnhealthyConditions = *(*[]computev1alpha1.UnhealthyMachineCondition)(unsafe.Pointer(&in.UnhealthyConditions))
+	out.MaxUnhealthy = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnhealthy))
+	out.RemediationStrategy = computev1alpha1.MachineRemediationStrategy(in.RemediationStrategy)
+	return nil
+}
+
+// Convert_compute_MachineHealthCheckSpec_To_v1alpha1_MachineHealthCheckSpec is an autogenerated conversion function.
+func Convert_compute_MachineHealthCheckSpec_To_v1alpha1_MachineHealthCheckSpec(in *compute.MachineHealthCheckSpec, out *computev1alpha1.MachineHealthCheckSpec, s conversion.Scope) error {
+	return autoConvert_compute_MachineHealthCheckSpec_To_v1alpha1_MachineHealthCheckSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineHealthCheckStatus_To_compute_MachineHealthCheckStatus(in *computev1alpha1.MachineHealthCheckStatus, out *compute.MachineHealthCheckStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.ExpectedMachines = in.ExpectedMachines
+	out.CurrentHealthy = in.CurrentHealthy
+	out.RemediationsAllowed = in.RemediationsAllowed
+	out.UnhealthyMachines = *(*[]compute.UnhealthyMachine)(unsafe.Pointer(&in.UnhealthyMachines))
+	out.Conditions = *(*[]compute.MachineHealthCheckCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_MachineHealthCheckStatus_To_compute_MachineHealthCheckStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MachineHealthCheckStatus_To_compute_MachineHealthCheckStatus(in *computev1alpha1.MachineHealthCheckStatus, out *compute.MachineHealthCheckStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineHealthCheckStatus_To_compute_MachineHealthCheckStatus(in, out, s)
+}
+
+func autoConvert_compute_MachineHealthCheckStatus_To_v1alpha1_MachineHealthCheckStatus(in *compute.MachineHealthCheckStatus, out *computev1alpha1.MachineHealthCheckStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.ExpectedMachines = in.ExpectedMachines
+	out.CurrentHealthy = in.CurrentHealthy
+	out.RemediationsAllowed = in.RemediationsAllowed
+	out.UnhealthyMachines = *(*[]computev1alpha1.UnhealthyMachine)(unsafe.Pointer(&in.UnhealthyMachines))
+	out.Conditions = *(*[]computev1alpha1.MachineHealthCheckCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_compute_MachineHealthCheckStatus_To_v1alpha1_MachineHealthCheckStatus is an autogenerated conversion function.
+func Convert_compute_MachineHealthCheckStatus_To_v1alpha1_MachineHealthCheckStatus(in *compute.MachineHealthCheckStatus, out *computev1alpha1.MachineHealthCheckStatus, s conversion.Scope) error {
+	return autoConvert_compute_MachineHealthCheckStatus_To_v1alpha1_MachineHealthCheckStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineIOFreeze_To_compute_MachineIOFreeze(in *computev1alpha1.MachineIOFreeze, out *compute.MachineIOFreeze, s conversion.Scope) error {
+	out.RequestedAt = in.RequestedAt
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_v1alpha1_MachineIOFreeze_To_compute_MachineIOFreeze is an autogenerated conversion function.
+func Convert_v1alpha1_MachineIOFreeze_To_compute_MachineIOFreeze(in *computev1alpha1.MachineIOFreeze, out *compute.MachineIOFreeze, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineIOFreeze_To_compute_MachineIOFreeze(in, out, s)
+}
+
+func autoConvert_compute_MachineIOFreeze_To_v1alpha1_MachineIOFreeze(in *compute.MachineIOFreeze, out *computev1alpha1.MachineIOFreeze, s conversion.Scope) error {
+	out.RequestedAt = in.RequestedAt
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_compute_MachineIOFreeze_To_v1alpha1_MachineIOFreeze is an autogenerated conversion function.
+func Convert_compute_MachineIOFreeze_To_v1alpha1_MachineIOFreeze(in *compute.MachineIOFreeze, out *computev1alpha1.MachineIOFreeze, s conversion.Scope) error {
+	return autoConvert_compute_MachineIOFreeze_To_v1alpha1_MachineIOFreeze(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineList_To_compute_MachineList(in *computev1alpha1.MachineList, out *compute.MachineList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -648,9 +1711,59 @@ func autoConvert_compute_MachineList_To_v1alpha1_MachineList(in *compute.Machine
 	return nil
 }
 
-// Convert_compute_MachineList_To_v1alpha1_MachineList is an autogenerated conversion function.
-func Convert_compute_MachineList_To_v1alpha1_MachineList(in *compute.MachineList, out *computev1alpha1.MachineList, s conversion.Scope) error {
-	return autoConvert_compute_MachineList_To_v1alpha1_MachineList(in, out, s)
+// Convert_compute_MachineList_To_v1alpha1_MachineList is an autogenerated conversion function.
+func Convert_compute_MachineList_To_v1alpha1_MachineList(in *compute.MachineList, out *computev1alpha1.MachineList, s conversion.Scope) error {
+	return autoConvert_compute_MachineList_To_v1alpha1_MachineList(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineMemoryStats_To_compute_MachineMemoryStats(in *computev1alpha1.MachineMemoryStats, out *compute.MachineMemoryStats, s conversion.Scope) error {
+	out.UsageBytes = in.UsageBytes
+	out.AvailableBytes = in.AvailableBytes
+	return nil
+}
+
+// Convert_v1alpha1_MachineMemoryStats_To_compute_MachineMemoryStats is an autogenerated conversion function.
+func Convert_v1alpha1_MachineMemoryStats_To_compute_MachineMemoryStats(in *computev1alpha1.MachineMemoryStats, out *compute.MachineMemoryStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineMemoryStats_To_compute_MachineMemoryStats(in, out, s)
+}
+
+func autoConvert_compute_MachineMemoryStats_To_v1alpha1_MachineMemoryStats(in *compute.MachineMemoryStats, out *computev1alpha1.MachineMemoryStats, s conversion.Scope) error {
+	out.UsageBytes = in.UsageBytes
+	out.AvailableBytes = in.AvailableBytes
+	return nil
+}
+
+// Convert_compute_MachineMemoryStats_To_v1alpha1_MachineMemoryStats is an autogenerated conversion function.
+func Convert_compute_MachineMemoryStats_To_v1alpha1_MachineMemoryStats(in *compute.MachineMemoryStats, out *computev1alpha1.MachineMemoryStats, s conversion.Scope) error {
+	return autoConvert_compute_MachineMemoryStats_To_v1alpha1_MachineMemoryStats(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineNetworkInterfaceStats_To_compute_MachineNetworkInterfaceStats(in *computev1alpha1.MachineNetworkInterfaceStats, out *compute.MachineNetworkInterfaceStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RxBytes = in.RxBytes
+	out.TxBytes = in.TxBytes
+	out.RxPackets = in.RxPackets
+	out.TxPackets = in.TxPackets
+	return nil
+}
+
+// Convert_v1alpha1_MachineNetworkInterfaceStats_To_compute_MachineNetworkInterfaceStats is an autogenerated conversion function.
+func Convert_v1alpha1_MachineNetworkInterfaceStats_To_compute_MachineNetworkInterfaceStats(in *computev1alpha1.MachineNetworkInterfaceStats, out *compute.MachineNetworkInterfaceStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineNetworkInterfaceStats_To_compute_MachineNetworkInterfaceStats(in, out, s)
+}
+
+func autoConvert_compute_MachineNetworkInterfaceStats_To_v1alpha1_MachineNetworkInterfaceStats(in *compute.MachineNetworkInterfaceStats, out *computev1alpha1.MachineNetworkInterfaceStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RxBytes = in.RxBytes
+	out.TxBytes = in.TxBytes
+	out.RxPackets = in.RxPackets
+	out.TxPackets = in.TxPackets
+	return nil
+}
+
+// Convert_compute_MachineNetworkInterfaceStats_To_v1alpha1_MachineNetworkInterfaceStats is an autogenerated conversion function.
+func Convert_compute_MachineNetworkInterfaceStats_To_v1alpha1_MachineNetworkInterfaceStats(in *compute.MachineNetworkInterfaceStats, out *computev1alpha1.MachineNetworkInterfaceStats, s conversion.Scope) error {
+	return autoConvert_compute_MachineNetworkInterfaceStats_To_v1alpha1_MachineNetworkInterfaceStats(in, out, s)
 }
 
 func autoConvert_v1alpha1_MachinePool_To_compute_MachinePool(in *computev1alpha1.MachinePool, out *compute.MachinePool, s conversion.Scope) error {
@@ -709,7 +1822,7 @@ func Convert_compute_MachinePoolAddress_To_v1alpha1_MachinePoolAddress(in *compu
 
 func autoConvert_v1alpha1_MachinePoolCondition_To_compute_MachinePoolCondition(in *computev1alpha1.MachinePoolCondition, out *compute.MachinePoolCondition, s conversion.Scope) error {
 	out.Type = compute.MachinePoolConditionType(in.Type)
-	out.Status = v1.ConditionStatus(in.Status)
+	out.Status = corev1.ConditionStatus(in.Status)
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -725,7 +1838,7 @@ func Convert_v1alpha1_MachinePoolCondition_To_compute_MachinePoolCondition(in *c
 
 func autoConvert_compute_MachinePoolCondition_To_v1alpha1_MachinePoolCondition(in *compute.MachinePoolCondition, out *computev1alpha1.MachinePoolCondition, s conversion.Scope) error {
 	out.Type = computev1alpha1.MachinePoolConditionType(in.Type)
-	out.Status = v1.ConditionStatus(in.Status)
+	out.Status = corev1.ConditionStatus(in.Status)
 	out.Reason = in.Reason
 	out.Message = in.Message
 	out.ObservedGeneration = in.ObservedGeneration
@@ -763,6 +1876,56 @@ func Convert_compute_MachinePoolDaemonEndpoints_To_v1alpha1_MachinePoolDaemonEnd
 	return autoConvert_compute_MachinePoolDaemonEndpoints_To_v1alpha1_MachinePoolDaemonEndpoints(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachinePoolDrain_To_compute_MachinePoolDrain(in *computev1alpha1.MachinePoolDrain, out *compute.MachinePoolDrain, s conversion.Scope) error {
+	out.RequestedAt = in.RequestedAt
+	out.EvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.EvictionTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_MachinePoolDrain_To_compute_MachinePoolDrain is an autogenerated conversion function.
+func Convert_v1alpha1_MachinePoolDrain_To_compute_MachinePoolDrain(in *computev1alpha1.MachinePoolDrain, out *compute.MachinePoolDrain, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachinePoolDrain_To_compute_MachinePoolDrain(in, out, s)
+}
+
+func autoConvert_compute_MachinePoolDrain_To_v1alpha1_MachinePoolDrain(in *compute.MachinePoolDrain, out *computev1alpha1.MachinePoolDrain, s conversion.Scope) error {
+	out.RequestedAt = in.RequestedAt
+	out.EvictionTimeout = (*v1.Duration)(unsafe.Pointer(in.EvictionTimeout))
+	return nil
+}
+
+// Convert_compute_MachinePoolDrain_To_v1alpha1_MachinePoolDrain is an autogenerated conversion function.
+func Convert_compute_MachinePoolDrain_To_v1alpha1_MachinePoolDrain(in *compute.MachinePoolDrain, out *computev1alpha1.MachinePoolDrain, s conversion.Scope) error {
+	return autoConvert_compute_MachinePoolDrain_To_v1alpha1_MachinePoolDrain(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachinePoolDrainStatus_To_compute_MachinePoolDrainStatus(in *computev1alpha1.MachinePoolDrainStatus, out *compute.MachinePoolDrainStatus, s conversion.Scope) error {
+	out.State = compute.MachinePoolDrainState(in.State)
+	out.ObservedRequestedAt = in.ObservedRequestedAt
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.RemainingMachines = in.RemainingMachines
+	return nil
+}
+
+// Convert_v1alpha1_MachinePoolDrainStatus_To_compute_MachinePoolDrainStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MachinePoolDrainStatus_To_compute_MachinePoolDrainStatus(in *computev1alpha1.MachinePoolDrainStatus, out *compute.MachinePoolDrainStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachinePoolDrainStatus_To_compute_MachinePoolDrainStatus(in, out, s)
+}
+
+func autoConvert_compute_MachinePoolDrainStatus_To_v1alpha1_MachinePoolDrainStatus(in *compute.MachinePoolDrainStatus, out *computev1alpha1.MachinePoolDrainStatus, s conversion.Scope) error {
+	out.State = computev1alpha1.MachinePoolDrainState(in.State)
+	out.ObservedRequestedAt = in.ObservedRequestedAt
+	out.StartTime = (*v1.Time)(unsafe.Pointer(in.StartTime))
+	out.CompletionTime = (*v1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.RemainingMachines = in.RemainingMachines
+	return nil
+}
+
+// Convert_compute_MachinePoolDrainStatus_To_v1alpha1_MachinePoolDrainStatus is an autogenerated conversion function.
+func Convert_compute_MachinePoolDrainStatus_To_v1alpha1_MachinePoolDrainStatus(in *compute.MachinePoolDrainStatus, out *computev1alpha1.MachinePoolDrainStatus, s conversion.Scope) error {
+	return autoConvert_compute_MachinePoolDrainStatus_To_v1alpha1_MachinePoolDrainStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachinePoolList_To_compute_MachinePoolList(in *computev1alpha1.MachinePoolList, out *compute.MachinePoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]compute.MachinePool)(unsafe.Pointer(&in.Items))
@@ -788,6 +1951,8 @@ func Convert_compute_MachinePoolList_To_v1alpha1_MachinePoolList(in *compute.Mac
 func autoConvert_v1alpha1_MachinePoolSpec_To_compute_MachinePoolSpec(in *computev1alpha1.MachinePoolSpec, out *compute.MachinePoolSpec, s conversion.Scope) error {
 	out.ProviderID = in.ProviderID
 	out.Taints = *(*[]commonv1alpha1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Unschedulable = in.Unschedulable
+	out.Drain = (*compute.MachinePoolDrain)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -799,6 +1964,8 @@ func Convert_v1alpha1_MachinePoolSpec_To_compute_MachinePoolSpec(in *computev1al
 func autoConvert_compute_MachinePoolSpec_To_v1alpha1_MachinePoolSpec(in *compute.MachinePoolSpec, out *computev1alpha1.MachinePoolSpec, s conversion.Scope) error {
 	out.ProviderID = in.ProviderID
 	out.Taints = *(*[]commonv1alpha1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Unschedulable = in.Unschedulable
+	out.Drain = (*computev1alpha1.MachinePoolDrain)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -810,13 +1977,14 @@ func Convert_compute_MachinePoolSpec_To_v1alpha1_MachinePoolSpec(in *compute.Mac
 func autoConvert_v1alpha1_MachinePoolStatus_To_compute_MachinePoolStatus(in *computev1alpha1.MachinePoolStatus, out *compute.MachinePoolStatus, s conversion.Scope) error {
 	out.State = compute.MachinePoolState(in.State)
 	out.Conditions = *(*[]compute.MachinePoolCondition)(unsafe.Pointer(&in.Conditions))
-	out.AvailableMachineClasses = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.AvailableMachineClasses))
+	out.AvailableMachineClasses = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.AvailableMachineClasses))
 	out.Addresses = *(*[]compute.MachinePoolAddress)(unsafe.Pointer(&in.Addresses))
 	if err := Convert_v1alpha1_MachinePoolDaemonEndpoints_To_compute_MachinePoolDaemonEndpoints(&in.DaemonEndpoints, &out.DaemonEndpoints, s); err != nil {
 		return err
 	}
 	out.Capacity = *(*core.ResourceList)(unsafe.Pointer(&in.Capacity))
 	out.Allocatable = *(*core.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Drain = (*compute.MachinePoolDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -828,13 +1996,14 @@ func Convert_v1alpha1_MachinePoolStatus_To_compute_MachinePoolStatus(in *compute
 func autoConvert_compute_MachinePoolStatus_To_v1alpha1_MachinePoolStatus(in *compute.MachinePoolStatus, out *computev1alpha1.MachinePoolStatus, s conversion.Scope) error {
 	out.State = computev1alpha1.MachinePoolState(in.State)
 	out.Conditions = *(*[]computev1alpha1.MachinePoolCondition)(unsafe.Pointer(&in.Conditions))
-	out.AvailableMachineClasses = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.AvailableMachineClasses))
+	out.AvailableMachineClasses = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.AvailableMachineClasses))
 	out.Addresses = *(*[]computev1alpha1.MachinePoolAddress)(unsafe.Pointer(&in.Addresses))
 	if err := Convert_compute_MachinePoolDaemonEndpoints_To_v1alpha1_MachinePoolDaemonEndpoints(&in.DaemonEndpoints, &out.DaemonEndpoints, s); err != nil {
 		return err
 	}
 	out.Capacity = *(*corev1alpha1.ResourceList)(unsafe.Pointer(&in.Capacity))
 	out.Allocatable = *(*corev1alpha1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Drain = (*computev1alpha1.MachinePoolDrainStatus)(unsafe.Pointer(in.Drain))
 	return nil
 }
 
@@ -843,10 +2012,206 @@ func Convert_compute_MachinePoolStatus_To_v1alpha1_MachinePoolStatus(in *compute
 	return autoConvert_compute_MachinePoolStatus_To_v1alpha1_MachinePoolStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachinePriorityClass_To_compute_MachinePriorityClass(in *computev1alpha1.MachinePriorityClass, out *compute.MachinePriorityClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha1_MachinePriorityClass_To_compute_MachinePriorityClass is an autogenerated conversion function.
+func Convert_v1alpha1_MachinePriorityClass_To_compute_MachinePriorityClass(in *computev1alpha1.MachinePriorityClass, out *compute.MachinePriorityClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachinePriorityClass_To_compute_MachinePriorityClass(in, out, s)
+}
+
+func autoConvert_compute_MachinePriorityClass_To_v1alpha1_MachinePriorityClass(in *compute.MachinePriorityClass, out *computev1alpha1.MachinePriorityClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Value = in.Value
+	out.GlobalDefault = in.GlobalDefault
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_compute_MachinePriorityClass_To_v1alpha1_MachinePriorityClass is an autogenerated conversion function.
+func Convert_compute_MachinePriorityClass_To_v1alpha1_MachinePriorityClass(in *compute.MachinePriorityClass, out *computev1alpha1.MachinePriorityClass, s conversion.Scope) error {
+	return autoConvert_compute_MachinePriorityClass_To_v1alpha1_MachinePriorityClass(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachinePriorityClassList_To_compute_MachinePriorityClassList(in *computev1alpha1.MachinePriorityClassList, out *compute.MachinePriorityClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]compute.MachinePriorityClass)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_MachinePriorityClassList_To_compute_MachinePriorityClassList is an autogenerated conversion function.
+func Convert_v1alpha1_MachinePriorityClassList_To_compute_MachinePriorityClassList(in *computev1alpha1.MachinePriorityClassList, out *compute.MachinePriorityClassList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachinePriorityClassList_To_compute_MachinePriorityClassList(in, out, s)
+}
+
+func autoConvert_compute_MachinePriorityClassList_To_v1alpha1_MachinePriorityClassList(in *compute.MachinePriorityClassList, out *computev1alpha1.MachinePriorityClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]computev1alpha1.MachinePriorityClass)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_compute_MachinePriorityClassList_To_v1alpha1_MachinePriorityClassList is an autogenerated conversion function.
+func Convert_compute_MachinePriorityClassList_To_v1alpha1_MachinePriorityClassList(in *compute.MachinePriorityClassList, out *computev1alpha1.MachinePriorityClassList, s conversion.Scope) error {
+	return autoConvert_compute_MachinePriorityClassList_To_v1alpha1_MachinePriorityClassList(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineRestart_To_compute_MachineRestart(in *computev1alpha1.MachineRestart, out *compute.MachineRestart, s conversion.Scope) error {
+	out.RequestedAt = in.RequestedAt
+	out.Mode = compute.RestartMode(in.Mode)
+	return nil
+}
+
+// Convert_v1alpha1_MachineRestart_To_compute_MachineRestart is an autogenerated conversion function.
+func Convert_v1alpha1_MachineRestart_To_compute_MachineRestart(in *computev1alpha1.MachineRestart, out *compute.MachineRestart, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineRestart_To_compute_MachineRestart(in, out, s)
+}
+
+func autoConvert_compute_MachineRestart_To_v1alpha1_MachineRestart(in *compute.MachineRestart, out *computev1alpha1.MachineRestart, s conversion.Scope) error {
+	out.RequestedAt = in.RequestedAt
+	out.Mode = computev1alpha1.RestartMode(in.Mode)
+	return nil
+}
+
+// Convert_compute_MachineRestart_To_v1alpha1_MachineRestart is an autogenerated conversion function.
+func Convert_compute_MachineRestart_To_v1alpha1_MachineRestart(in *compute.MachineRestart, out *computev1alpha1.MachineRestart, s conversion.Scope) error {
+	return autoConvert_compute_MachineRestart_To_v1alpha1_MachineRestart(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineSet_To_compute_MachineSet(in *computev1alpha1.MachineSet, out *compute.MachineSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_MachineSetSpec_To_compute_MachineSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_MachineSetStatus_To_compute_MachineSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineSet_To_compute_MachineSet is an autogenerated conversion function.
+func Convert_v1alpha1_MachineSet_To_compute_MachineSet(in *computev1alpha1.MachineSet, out *compute.MachineSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineSet_To_compute_MachineSet(in, out, s)
+}
+
+func autoConvert_compute_MachineSet_To_v1alpha1_MachineSet(in *compute.MachineSet, out *computev1alpha1.MachineSet, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_compute_MachineSetSpec_To_v1alpha1_MachineSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_compute_MachineSetStatus_To_v1alpha1_MachineSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_compute_MachineSet_To_v1alpha1_MachineSet is an autogenerated conversion function.
+func Convert_compute_MachineSet_To_v1alpha1_MachineSet(in *compute.MachineSet, out *computev1alpha1.MachineSet, s conversion.Scope) error {
+	return autoConvert_compute_MachineSet_To_v1alpha1_MachineSet(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineSetList_To_compute_MachineSetList(in *computev1alpha1.MachineSetList, out *compute.MachineSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]compute.MachineSet, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_MachineSet_To_compute_MachineSet(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineSetList_To_compute_MachineSetList is an autogenerated conversion function.
+func Convert_v1alpha1_MachineSetList_To_compute_MachineSetList(in *computev1alpha1.MachineSetList, out *compute.MachineSetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineSetList_To_compute_MachineSetList(in, out, s)
+}
+
+func autoConvert_compute_MachineSetList_To_v1alpha1_MachineSetList(in *compute.MachineSetList, out *computev1alpha1.MachineSetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]computev1alpha1.MachineSet, len(*in))
+		for i := range *in {
+			if err := Convert_compute_MachineSet_To_v1alpha1_MachineSet(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_compute_MachineSetList_To_v1alpha1_MachineSetList is an autogenerated conversion function.
+func Convert_compute_MachineSetList_To_v1alpha1_MachineSetList(in *compute.MachineSetList, out *computev1alpha1.MachineSetList, s conversion.Scope) error {
+	return autoConvert_compute_MachineSetList_To_v1alpha1_MachineSetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineSetSpec_To_compute_MachineSetSpec(in *computev1alpha1.MachineSetSpec, out *compute.MachineSetSpec, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_v1alpha1_MachineTemplateSpec_To_compute_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineSetSpec_To_compute_MachineSetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_MachineSetSpec_To_compute_MachineSetSpec(in *computev1alpha1.MachineSetSpec, out *compute.MachineSetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineSetSpec_To_compute_MachineSetSpec(in, out, s)
+}
+
+func autoConvert_compute_MachineSetSpec_To_v1alpha1_MachineSetSpec(in *compute.MachineSetSpec, out *computev1alpha1.MachineSetSpec, s conversion.Scope) error {
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	if err := Convert_compute_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(&in.Template, &out.Template, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_compute_MachineSetSpec_To_v1alpha1_MachineSetSpec is an autogenerated conversion function.
+func Convert_compute_MachineSetSpec_To_v1alpha1_MachineSetSpec(in *compute.MachineSetSpec, out *computev1alpha1.MachineSetSpec, s conversion.Scope) error {
+	return autoConvert_compute_MachineSetSpec_To_v1alpha1_MachineSetSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineSetStatus_To_compute_MachineSetStatus(in *computev1alpha1.MachineSetStatus, out *compute.MachineSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	return nil
+}
+
+// Convert_v1alpha1_MachineSetStatus_To_compute_MachineSetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MachineSetStatus_To_compute_MachineSetStatus(in *computev1alpha1.MachineSetStatus, out *compute.MachineSetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineSetStatus_To_compute_MachineSetStatus(in, out, s)
+}
+
+func autoConvert_compute_MachineSetStatus_To_v1alpha1_MachineSetStatus(in *compute.MachineSetStatus, out *computev1alpha1.MachineSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	return nil
+}
+
+// Convert_compute_MachineSetStatus_To_v1alpha1_MachineSetStatus is an autogenerated conversion function.
+func Convert_compute_MachineSetStatus_To_v1alpha1_MachineSetStatus(in *compute.MachineSetStatus, out *computev1alpha1.MachineSetStatus, s conversion.Scope) error {
+	return autoConvert_compute_MachineSetStatus_To_v1alpha1_MachineSetStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineSpec_To_compute_MachineSpec(in *computev1alpha1.MachineSpec, out *compute.MachineSpec, s conversion.Scope) error {
 	out.MachineClassRef = in.MachineClassRef
 	out.MachinePoolSelector = *(*map[string]string)(unsafe.Pointer(&in.MachinePoolSelector))
-	out.MachinePoolRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.MachinePoolRef))
+	out.MachinePoolRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.MachinePoolRef))
 	out.Power = compute.Power(in.Power)
 	out.NetworkInterfaces = *(*[]compute.NetworkInterface)(unsafe.Pointer(&in.NetworkInterfaces))
 	if in.Volumes != nil {
@@ -864,6 +2229,12 @@ func autoConvert_v1alpha1_MachineSpec_To_compute_MachineSpec(in *computev1alpha1
 	out.EFIVars = *(*[]compute.EFIVar)(unsafe.Pointer(&in.EFIVars))
 	out.Tolerations = *(*[]commonv1alpha1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.GuestConfig = (*compute.MachineGuestConfig)(unsafe.Pointer(in.GuestConfig))
+	out.Restart = (*compute.MachineRestart)(unsafe.Pointer(in.Restart))
+	out.IOFreeze = (*compute.MachineIOFreeze)(unsafe.Pointer(in.IOFreeze))
+	out.TopologySpreadConstraints = *(*[]compute.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.Affinity = (*compute.Affinity)(unsafe.Pointer(in.Affinity))
+	out.PriorityClassName = in.PriorityClassName
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	return nil
 }
 
@@ -875,7 +2246,7 @@ func Convert_v1alpha1_MachineSpec_To_compute_MachineSpec(in *computev1alpha1.Mac
 func autoConvert_compute_MachineSpec_To_v1alpha1_MachineSpec(in *compute.MachineSpec, out *computev1alpha1.MachineSpec, s conversion.Scope) error {
 	out.MachineClassRef = in.MachineClassRef
 	out.MachinePoolSelector = *(*map[string]string)(unsafe.Pointer(&in.MachinePoolSelector))
-	out.MachinePoolRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.MachinePoolRef))
+	out.MachinePoolRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.MachinePoolRef))
 	out.Power = computev1alpha1.Power(in.Power)
 	out.NetworkInterfaces = *(*[]computev1alpha1.NetworkInterface)(unsafe.Pointer(&in.NetworkInterfaces))
 	if in.Volumes != nil {
@@ -893,6 +2264,12 @@ func autoConvert_compute_MachineSpec_To_v1alpha1_MachineSpec(in *compute.Machine
 	out.EFIVars = *(*[]computev1alpha1.EFIVar)(unsafe.Pointer(&in.EFIVars))
 	out.Tolerations = *(*[]commonv1alpha1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.GuestConfig = (*computev1alpha1.MachineGuestConfig)(unsafe.Pointer(in.GuestConfig))
+	out.Restart = (*computev1alpha1.MachineRestart)(unsafe.Pointer(in.Restart))
+	out.IOFreeze = (*computev1alpha1.MachineIOFreeze)(unsafe.Pointer(in.IOFreeze))
+	out.TopologySpreadConstraints = *(*[]computev1alpha1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.Affinity = (*computev1alpha1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.PriorityClassName = in.PriorityClassName
+	out.Priority = (*int32)(unsafe.Pointer(in.Priority))
 	return nil
 }
 
@@ -901,11 +2278,42 @@ func Convert_compute_MachineSpec_To_v1alpha1_MachineSpec(in *compute.MachineSpec
 	return autoConvert_compute_MachineSpec_To_v1alpha1_MachineSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineStats_To_compute_MachineStats(in *computev1alpha1.MachineStats, out *compute.MachineStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Timestamp = in.Timestamp
+	out.CPU = (*compute.MachineCPUStats)(unsafe.Pointer(in.CPU))
+	out.Memory = (*compute.MachineMemoryStats)(unsafe.Pointer(in.Memory))
+	out.Volumes = *(*[]compute.MachineVolumeStats)(unsafe.Pointer(&in.Volumes))
+	out.NetworkInterfaces = *(*[]compute.MachineNetworkInterfaceStats)(unsafe.Pointer(&in.NetworkInterfaces))
+	return nil
+}
+
+// Convert_v1alpha1_MachineStats_To_compute_MachineStats is an autogenerated conversion function.
+func Convert_v1alpha1_MachineStats_To_compute_MachineStats(in *computev1alpha1.MachineStats, out *compute.MachineStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineStats_To_compute_MachineStats(in, out, s)
+}
+
+func autoConvert_compute_MachineStats_To_v1alpha1_MachineStats(in *compute.MachineStats, out *computev1alpha1.MachineStats, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Timestamp = in.Timestamp
+	out.CPU = (*computev1alpha1.MachineCPUStats)(unsafe.Pointer(in.CPU))
+	out.Memory = (*computev1alpha1.MachineMemoryStats)(unsafe.Pointer(in.Memory))
+	out.Volumes = *(*[]computev1alpha1.MachineVolumeStats)(unsafe.Pointer(&in.Volumes))
+	out.NetworkInterfaces = *(*[]computev1alpha1.MachineNetworkInterfaceStats)(unsafe.Pointer(&in.NetworkInterfaces))
+	return nil
+}
+
+// Convert_compute_MachineStats_To_v1alpha1_MachineStats is an autogenerated conversion function.
+func Convert_compute_MachineStats_To_v1alpha1_MachineStats(in *compute.MachineStats, out *computev1alpha1.MachineStats, s conversion.Scope) error {
+	return autoConvert_compute_MachineStats_To_v1alpha1_MachineStats(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineStatus_To_compute_MachineStatus(in *computev1alpha1.MachineStatus, out *compute.MachineStatus, s conversion.Scope) error {
 	out.MachineID = in.MachineID
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Conditions = *(*[]compute.MachineCondition)(unsafe.Pointer(&in.Conditions))
 	out.State = compute.MachineState(in.State)
+	out.LastStateTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	out.NetworkInterfaces = *(*[]compute.NetworkInterfaceStatus)(unsafe.Pointer(&in.NetworkInterfaces))
 	out.Volumes = *(*[]compute.VolumeStatus)(unsafe.Pointer(&in.Volumes))
 	return nil
@@ -921,6 +2329,7 @@ func autoConvert_compute_MachineStatus_To_v1alpha1_MachineStatus(in *compute.Mac
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Conditions = *(*[]computev1alpha1.MachineCondition)(unsafe.Pointer(&in.Conditions))
 	out.State = computev1alpha1.MachineState(in.State)
+	out.LastStateTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	out.NetworkInterfaces = *(*[]computev1alpha1.NetworkInterfaceStatus)(unsafe.Pointer(&in.NetworkInterfaces))
 	out.Volumes = *(*[]computev1alpha1.VolumeStatus)(unsafe.Pointer(&in.Volumes))
 	return nil
@@ -931,6 +2340,60 @@ func Convert_compute_MachineStatus_To_v1alpha1_MachineStatus(in *compute.Machine
 	return autoConvert_compute_MachineStatus_To_v1alpha1_MachineStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineTemplateSpec_To_compute_MachineTemplateSpec(in *computev1alpha1.MachineTemplateSpec, out *compute.MachineTemplateSpec, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_MachineSpec_To_compute_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_MachineTemplateSpec_To_compute_MachineTemplateSpec is an autogenerated conversion function.
+func Convert_v1alpha1_MachineTemplateSpec_To_compute_MachineTemplateSpec(in *computev1alpha1.MachineTemplateSpec, out *compute.MachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineTemplateSpec_To_compute_MachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_compute_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(in *compute.MachineTemplateSpec, out *computev1alpha1.MachineTemplateSpec, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_compute_MachineSpec_To_v1alpha1_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_compute_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec is an autogenerated conversion function.
+func Convert_compute_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(in *compute.MachineTemplateSpec, out *computev1alpha1.MachineTemplateSpec, s conversion.Scope) error {
+	return autoConvert_compute_MachineTemplateSpec_To_v1alpha1_MachineTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_MachineVolumeStats_To_compute_MachineVolumeStats(in *computev1alpha1.MachineVolumeStats, out *compute.MachineVolumeStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ReadBytes = in.ReadBytes
+	out.WriteBytes = in.WriteBytes
+	out.ReadOps = in.ReadOps
+	out.WriteOps = in.WriteOps
+	return nil
+}
+
+// Convert_v1alpha1_MachineVolumeStats_To_compute_MachineVolumeStats is an autogenerated conversion function.
+func Convert_v1alpha1_MachineVolumeStats_To_compute_MachineVolumeStats(in *computev1alpha1.MachineVolumeStats, out *compute.MachineVolumeStats, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineVolumeStats_To_compute_MachineVolumeStats(in, out, s)
+}
+
+func autoConvert_compute_MachineVolumeStats_To_v1alpha1_MachineVolumeStats(in *compute.MachineVolumeStats, out *computev1alpha1.MachineVolumeStats, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ReadBytes = in.ReadBytes
+	out.WriteBytes = in.WriteBytes
+	out.ReadOps = in.ReadOps
+	out.WriteOps = in.WriteOps
+	return nil
+}
+
+// Convert_compute_MachineVolumeStats_To_v1alpha1_MachineVolumeStats is an autogenerated conversion function.
+func Convert_compute_MachineVolumeStats_To_v1alpha1_MachineVolumeStats(in *compute.MachineVolumeStats, out *computev1alpha1.MachineVolumeStats, s conversion.Scope) error {
+	return autoConvert_compute_MachineVolumeStats_To_v1alpha1_MachineVolumeStats(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkInterface_To_compute_NetworkInterface(in *computev1alpha1.NetworkInterface, out *compute.NetworkInterface, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_NetworkInterfaceSource_To_compute_NetworkInterfaceSource(&in.NetworkInterfaceSource, &out.NetworkInterfaceSource, s); err != nil {
@@ -958,7 +2421,7 @@ func Convert_compute_NetworkInterface_To_v1alpha1_NetworkInterface(in *compute.N
 }
 
 func autoConvert_v1alpha1_NetworkInterfaceSource_To_compute_NetworkInterfaceSource(in *computev1alpha1.NetworkInterfaceSource, out *compute.NetworkInterfaceSource, s conversion.Scope) error {
-	out.NetworkInterfaceRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkInterfaceRef))
+	out.NetworkInterfaceRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.NetworkInterfaceRef))
 	out.Ephemeral = (*compute.EphemeralNetworkInterfaceSource)(unsafe.Pointer(in.Ephemeral))
 	return nil
 }
@@ -969,7 +2432,7 @@ func Convert_v1alpha1_NetworkInterfaceSource_To_compute_NetworkInterfaceSource(i
 }
 
 func autoConvert_compute_NetworkInterfaceSource_To_v1alpha1_NetworkInterfaceSource(in *compute.NetworkInterfaceSource, out *computev1alpha1.NetworkInterfaceSource, s conversion.Scope) error {
-	out.NetworkInterfaceRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.NetworkInterfaceRef))
+	out.NetworkInterfaceRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.NetworkInterfaceRef))
 	out.Ephemeral = (*computev1alpha1.EphemeralNetworkInterfaceSource)(unsafe.Pointer(in.Ephemeral))
 	return nil
 }
@@ -984,7 +2447,7 @@ func autoConvert_v1alpha1_NetworkInterfaceStatus_To_compute_NetworkInterfaceStat
 	out.Handle = in.Handle
 	out.State = compute.NetworkInterfaceState(in.State)
 	out.NetworkInterfaceRef = in.NetworkInterfaceRef
-	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.LastStateTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	return nil
 }
 
@@ -998,7 +2461,7 @@ func autoConvert_compute_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStat
 	out.Handle = in.Handle
 	out.State = computev1alpha1.NetworkInterfaceState(in.State)
 	out.NetworkInterfaceRef = in.NetworkInterfaceRef
-	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.LastStateTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	return nil
 }
 
@@ -1007,9 +2470,131 @@ func Convert_compute_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(i
 	return autoConvert_compute_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_RollingUpdateMachineDeployment_To_compute_RollingUpdateMachineDeployment(in *computev1alpha1.RollingUpdateMachineDeployment, out *compute.RollingUpdateMachineDeployment, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_v1alpha1_RollingUpdateMachineDeployment_To_compute_RollingUpdateMachineDeployment is an autogenerated conversion function.
+func Convert_v1alpha1_RollingUpdateMachineDeployment_To_compute_RollingUpdateMachineDeployment(in *computev1alpha1.RollingUpdateMachineDeployment, out *compute.RollingUpdateMachineDeployment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollingUpdateMachineDeployment_To_compute_RollingUpdateMachineDeployment(in, out, s)
+}
+
+func autoConvert_compute_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdateMachineDeployment(in *compute.RollingUpdateMachineDeployment, out *computev1alpha1.RollingUpdateMachineDeployment, s conversion.Scope) error {
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	return nil
+}
+
+// Convert_compute_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdateMachineDeployment is an autogenerated conversion function.
+func Convert_compute_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdateMachineDeployment(in *compute.RollingUpdateMachineDeployment, out *computev1alpha1.RollingUpdateMachineDeployment, s conversion.Scope) error {
+	return autoConvert_compute_RollingUpdateMachineDeployment_To_v1alpha1_RollingUpdateMachineDeployment(in, out, s)
+}
+
+func autoConvert_v1alpha1_TopologySpreadConstraint_To_compute_TopologySpreadConstraint(in *computev1alpha1.TopologySpreadConstraint, out *compute.TopologySpreadConstraint, s conversion.Scope) error {
+	out.MaxSkew = in.MaxSkew
+	out.TopologyKey = in.TopologyKey
+	out.WhenUnsatisfiable = compute.UnsatisfiableConstraintAction(in.WhenUnsatisfiable)
+	out.LabelSelector = (*v1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	return nil
+}
+
+// Convert_v1alpha1_TopologySpreadConstraint_To_compute_TopologySpreadConstraint is an autogenerated conversion function.
+func Convert_v1alpha1_TopologySpreadConstraint_To_compute_TopologySpreadConstraint(in *computev1alpha1.TopologySpreadConstraint, out *compute.TopologySpreadConstraint, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TopologySpreadConstraint_To_compute_TopologySpreadConstraint(in, out, s)
+}
+
+func autoConvert_compute_TopologySpreadConstraint_To_v1alpha1_TopologySpreadConstraint(in *compute.TopologySpreadConstraint, out *computev1alpha1.TopologySpreadConstraint, s conversion.Scope) error {
+	out.MaxSkew = in.MaxSkew
+	out.TopologyKey = in.TopologyKey
+	out.WhenUnsatisfiable = computev1alpha1.UnsatisfiableConstraintAction(in.WhenUnsatisfiable)
+	out.LabelSelector = (*v1.LabelSelector)(unsafe.Pointer(in.LabelSelector))
+	return nil
+}
+
+// Convert_compute_TopologySpreadConstraint_To_v1alpha1_TopologySpreadConstraint is an autogenerated conversion function.
+func Convert_compute_TopologySpreadConstraint_To_v1alpha1_TopologySpreadConstraint(in *compute.TopologySpreadConstraint, out *computev1alpha1.TopologySpreadConstraint, s conversion.Scope) error {
+	return autoConvert_compute_TopologySpreadConstraint_To_v1alpha1_TopologySpreadConstraint(in, out, s)
+}
+
+func autoConvert_v1alpha1_UnhealthyMachine_To_compute_UnhealthyMachine(in *computev1alpha1.UnhealthyMachine, out *compute.UnhealthyMachine, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.RemediationStrategy = compute.MachineRemediationStrategy(in.RemediationStrategy)
+	out.LastRemediationTime = (*v1.Time)(unsafe.Pointer(in.LastRemediationTime))
+	return nil
+}
+
+// Convert_v1alpha1_UnhealthyMachine_To_compute_UnhealthyMachine is an autogenerated conversion function.
+func Convert_v1alpha1_UnhealthyMachine_To_compute_UnhealthyMachine(in *computev1alpha1.UnhealthyMachine, out *compute.UnhealthyMachine, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UnhealthyMachine_To_compute_UnhealthyMachine(in, out, s)
+}
+
+func autoConvert_compute_UnhealthyMachine_To_v1alpha1_UnhealthyMachine(in *compute.UnhealthyMachine, out *computev1alpha1.UnhealthyMachine, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.RemediationStrategy = computev1alpha1.MachineRemediationStrategy(in.RemediationStrategy)
+	out.LastRemediationTime = (*v1.Time)(unsafe.Pointer(in.LastRemediationTime))
+	return nil
+}
+
+// Convert_compute_UnhealthyMachine_To_v1alpha1_UnhealthyMachine is an autogenerated conversion function.
+func Convert_compute_UnhealthyMachine_To_v1alpha1_UnhealthyMachine(in *compute.UnhealthyMachine, out *computev1alpha1.UnhealthyMachine, s conversion.Scope) error {
+	return autoConvert_compute_UnhealthyMachine_To_v1alpha1_UnhealthyMachine(in, out, s)
+}
+
+func autoConvert_v1alpha1_UnhealthyMachineCondition_To_compute_UnhealthyMachineCondition(in *computev1alpha1.UnhealthyMachineCondition, out *compute.UnhealthyMachineCondition, s conversion.Scope) error {
+	out.Type = compute.MachineConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_v1alpha1_UnhealthyMachineCondition_To_compute_UnhealthyMachineCondition is an autogenerated conversion function.
+func Convert_v1alpha1_UnhealthyMachineCondition_To_compute_UnhealthyMachineCondition(in *computev1alpha1.UnhealthyMachineCondition, out *compute.UnhealthyMachineCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UnhealthyMachineCondition_To_compute_UnhealthyMachineCondition(in, out, s)
+}
+
+func autoConvert_compute_UnhealthyMachineCondition_To_v1alpha1_UnhealthyMachineCondition(in *compute.UnhealthyMachineCondition, out *computev1alpha1.UnhealthyMachineCondition, s conversion.Scope) error {
+	out.Type = computev1alpha1.MachineConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_compute_UnhealthyMachineCondition_To_v1alpha1_UnhealthyMachineCondition is an autogenerated conversion function.
+func Convert_compute_UnhealthyMachineCondition_To_v1alpha1_UnhealthyMachineCondition(in *compute.UnhealthyMachineCondition, out *computev1alpha1.UnhealthyMachineCondition, s conversion.Scope) error {
+	return autoConvert_compute_UnhealthyMachineCondition_To_v1alpha1_UnhealthyMachineCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_UnhealthyMachineState_To_compute_UnhealthyMachineState(in *computev1alpha1.UnhealthyMachineState, out *compute.UnhealthyMachineState, s conversion.Scope) error {
+	out.State = compute.MachineState(in.State)
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_v1alpha1_UnhealthyMachineState_To_compute_UnhealthyMachineState is an autogenerated conversion function.
+func Convert_v1alpha1_UnhealthyMachineState_To_compute_UnhealthyMachineState(in *computev1alpha1.UnhealthyMachineState, out *compute.UnhealthyMachineState, s conversion.Scope) error {
+	return autoConvert_v1alpha1_UnhealthyMachineState_To_compute_UnhealthyMachineState(in, out, s)
+}
+
+func autoConvert_compute_UnhealthyMachineState_To_v1alpha1_UnhealthyMachineState(in *compute.UnhealthyMachineState, out *computev1alpha1.UnhealthyMachineState, s conversion.Scope) error {
+	out.State = computev1alpha1.MachineState(in.State)
+	out.Timeout = in.Timeout
+	return nil
+}
+
+// Convert_compute_UnhealthyMachineState_To_v1alpha1_UnhealthyMachineState is an autogenerated conversion function.
+func Convert_compute_UnhealthyMachineState_To_v1alpha1_UnhealthyMachineState(in *compute.UnhealthyMachineState, out *computev1alpha1.UnhealthyMachineState, s conversion.Scope) error {
+	return autoConvert_compute_UnhealthyMachineState_To_v1alpha1_UnhealthyMachineState(in, out, s)
+}
+
 func autoConvert_v1alpha1_Volume_To_compute_Volume(in *computev1alpha1.Volume, out *compute.Volume, s conversion.Scope) error {
 	out.Name = in.Name
-	if err := metav1.Convert_Pointer_string_To_string(&in.Device, &out.Device, s); err != nil {
+	if err := v1.Convert_Pointer_string_To_string(&in.Device, &out.Device, s); err != nil {
 		return err
 	}
 	if err := Convert_v1alpha1_VolumeSource_To_compute_VolumeSource(&in.VolumeSource, &out.VolumeSource, s); err != nil {
@@ -1025,7 +2610,7 @@ func Convert_v1alpha1_Volume_To_compute_Volume(in *computev1alpha1.Volume, out *
 
 func autoConvert_compute_Volume_To_v1alpha1_Volume(in *compute.Volume, out *computev1alpha1.Volume, s conversion.Scope) error {
 	out.Name = in.Name
-	if err := metav1.Convert_string_To_Pointer_string(&in.Device, &out.Device, s); err != nil {
+	if err := v1.Convert_string_To_Pointer_string(&in.Device, &out.Device, s); err != nil {
 		return err
 	}
 	if err := Convert_compute_VolumeSource_To_v1alpha1_VolumeSource(&in.VolumeSource, &out.VolumeSource, s); err != nil {
@@ -1040,7 +2625,7 @@ func Convert_compute_Volume_To_v1alpha1_Volume(in *compute.Volume, out *computev
 }
 
 func autoConvert_v1alpha1_VolumeSource_To_compute_VolumeSource(in *computev1alpha1.VolumeSource, out *compute.VolumeSource, s conversion.Scope) error {
-	out.VolumeRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeRef))
+	out.VolumeRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.VolumeRef))
 	out.EmptyDisk = (*compute.EmptyDiskVolumeSource)(unsafe.Pointer(in.EmptyDisk))
 	out.LocalDisk = (*compute.LocalDiskVolumeSource)(unsafe.Pointer(in.LocalDisk))
 	out.Ephemeral = (*compute.EphemeralVolumeSource)(unsafe.Pointer(in.Ephemeral))
@@ -1053,7 +2638,7 @@ func Convert_v1alpha1_VolumeSource_To_compute_VolumeSource(in *computev1alpha1.V
 }
 
 func autoConvert_compute_VolumeSource_To_v1alpha1_VolumeSource(in *compute.VolumeSource, out *computev1alpha1.VolumeSource, s conversion.Scope) error {
-	out.VolumeRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeRef))
+	out.VolumeRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.VolumeRef))
 	out.EmptyDisk = (*computev1alpha1.EmptyDiskVolumeSource)(unsafe.Pointer(in.EmptyDisk))
 	out.LocalDisk = (*computev1alpha1.LocalDiskVolumeSource)(unsafe.Pointer(in.LocalDisk))
 	out.Ephemeral = (*computev1alpha1.EphemeralVolumeSource)(unsafe.Pointer(in.Ephemeral))
@@ -1069,7 +2654,7 @@ func autoConvert_v1alpha1_VolumeStatus_To_compute_VolumeStatus(in *computev1alph
 	out.Name = in.Name
 	out.Handle = in.Handle
 	out.State = compute.VolumeState(in.State)
-	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.LastStateTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	out.VolumeRef = in.VolumeRef
 	return nil
 }
@@ -1083,7 +2668,7 @@ func autoConvert_compute_VolumeStatus_To_v1alpha1_VolumeStatus(in *compute.Volum
 	out.Name = in.Name
 	out.Handle = in.Handle
 	out.State = computev1alpha1.VolumeState(in.State)
-	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.LastStateTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
 	out.VolumeRef = in.VolumeRef
 	return nil
 }
@@ -1092,3 +2677,29 @@ func autoConvert_compute_VolumeStatus_To_v1alpha1_VolumeStatus(in *compute.Volum
 func Convert_compute_VolumeStatus_To_v1alpha1_VolumeStatus(in *compute.VolumeStatus, out *computev1alpha1.VolumeStatus, s conversion.Scope) error {
 	return autoConvert_compute_VolumeStatus_To_v1alpha1_VolumeStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_WeightedMachineAffinityTerm_To_compute_WeightedMachineAffinityTerm(in *computev1alpha1.WeightedMachineAffinityTerm, out *compute.WeightedMachineAffinityTerm, s conversion.Scope) error {
+	out.Weight = in.Weight
+	if err := Convert_v1alpha1_MachineAffinityTerm_To_compute_MachineAffinityTerm(&in.MachineAffinityTerm, &out.MachineAffinityTerm, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_WeightedMachineAffinityTerm_To_compute_WeightedMachineAffinityTerm is an autogenerated conversion function.
+func Convert_v1alpha1_WeightedMachineAffinityTerm_To_compute_WeightedMachineAffinityTerm(in *computev1alpha1.WeightedMachineAffinityTerm, out *compute.WeightedMachineAffinityTerm, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WeightedMachineAffinityTerm_To_compute_WeightedMachineAffinityTerm(in, out, s)
+}
+
+func autoConvert_compute_WeightedMachineAffinityTerm_To_v1alpha1_WeightedMachineAffinityTerm(in *compute.WeightedMachineAffinityTerm, out *computev1alpha1.WeightedMachineAffinityTerm, s conversion.Scope) error {
+	out.Weight = in.Weight
+	if err := Convert_compute_MachineAffinityTerm_To_v1alpha1_MachineAffinityTerm(&in.MachineAffinityTerm, &out.MachineAffinityTerm, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_compute_WeightedMachineAffinityTerm_To_v1alpha1_WeightedMachineAffinityTerm is an autogenerated conversion function.
+func Convert_compute_WeightedMachineAffinityTerm_To_v1alpha1_WeightedMachineAffinityTerm(in *compute.WeightedMachineAffinityTerm, out *computev1alpha1.WeightedMachineAffinityTerm, s conversion.Scope) error {
+	return autoConvert_compute_WeightedMachineAffinityTerm_To_v1alpha1_WeightedMachineAffinityTerm(in, out, s)
+}
@@ -20,7 +20,17 @@ import (
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&computev1alpha1.Machine{}, func(obj interface{}) { SetObjectDefaults_Machine(obj.(*computev1alpha1.Machine)) })
+	scheme.AddTypeDefaultingFunc(&computev1alpha1.MachineDeployment{}, func(obj interface{}) { SetObjectDefaults_MachineDeployment(obj.(*computev1alpha1.MachineDeployment)) })
+	scheme.AddTypeDefaultingFunc(&computev1alpha1.MachineDeploymentList{}, func(obj interface{}) {
+		SetObjectDefaults_MachineDeploymentList(obj.(*computev1alpha1.MachineDeploymentList))
+	})
+	scheme.AddTypeDefaultingFunc(&computev1alpha1.MachineHealthCheck{}, func(obj interface{}) { SetObjectDefaults_MachineHealthCheck(obj.(*computev1alpha1.MachineHealthCheck)) })
+	scheme.AddTypeDefaultingFunc(&computev1alpha1.MachineHealthCheckList{}, func(obj interface{}) {
+		SetObjectDefaults_MachineHealthCheckList(obj.(*computev1alpha1.MachineHealthCheckList))
+	})
 	scheme.AddTypeDefaultingFunc(&computev1alpha1.MachineList{}, func(obj interface{}) { SetObjectDefaults_MachineList(obj.(*computev1alpha1.MachineList)) })
+	scheme.AddTypeDefaultingFunc(&computev1alpha1.MachineSet{}, func(obj interface{}) { SetObjectDefaults_MachineSet(obj.(*computev1alpha1.MachineSet)) })
+	scheme.AddTypeDefaultingFunc(&computev1alpha1.MachineSetList{}, func(obj interface{}) { SetObjectDefaults_MachineSetList(obj.(*computev1alpha1.MachineSetList)) })
 	return nil
 }
 
@@ -50,6 +60,13 @@ func SetObjectDefaults_Machine(in *computev1alpha1.Machine) {
 			}
 		}
 	}
+	if in.Spec.Restart != nil {
+		SetDefaults_MachineRestart(in.Spec.Restart)
+	}
+	for i := range in.Spec.TopologySpreadConstraints {
+		a := &in.Spec.TopologySpreadConstraints[i]
+		SetDefaults_TopologySpreadConstraint(a)
+	}
 	SetDefaults_MachineStatus(&in.Status)
 	for i := range in.Status.NetworkInterfaces {
 		a := &in.Status.NetworkInterfaces[i]
@@ -61,9 +78,109 @@ func SetObjectDefaults_Machine(in *computev1alpha1.Machine) {
 	}
 }
 
+func SetObjectDefaults_MachineDeployment(in *computev1alpha1.MachineDeployment) {
+	SetDefaults_MachineDeploymentSpec(&in.Spec)
+	SetDefaults_MachineSpec(&in.Spec.Template.Spec)
+	for i := range in.Spec.Template.Spec.NetworkInterfaces {
+		a := &in.Spec.Template.Spec.NetworkInterfaces[i]
+		if a.NetworkInterfaceSource.Ephemeral != nil {
+			if a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate != nil {
+				networkingv1alpha1.SetDefaults_NetworkInterfaceSpec(&a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec)
+				for j := range a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.IPs {
+					b := &a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.IPs[j]
+					if b.Ephemeral != nil {
+						if b.Ephemeral.PrefixTemplate != nil {
+							ipamv1alpha1.SetDefaults_PrefixSpec(&b.Ephemeral.PrefixTemplate.Spec)
+						}
+					}
+				}
+				for j := range a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.Prefixes {
+					b := &a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.Prefixes[j]
+					if b.Ephemeral != nil {
+						if b.Ephemeral.PrefixTemplate != nil {
+							ipamv1alpha1.SetDefaults_PrefixSpec(&b.Ephemeral.PrefixTemplate.Spec)
+						}
+					}
+				}
+			}
+		}
+	}
+	if in.Spec.Template.Spec.Restart != nil {
+		SetDefaults_MachineRestart(in.Spec.Template.Spec.Restart)
+	}
+	for i := range in.Spec.Template.Spec.TopologySpreadConstraints {
+		a := &in.Spec.Template.Spec.TopologySpreadConstraints[i]
+		SetDefaults_TopologySpreadConstraint(a)
+	}
+	if in.Spec.Strategy.RollingUpdate != nil {
+		SetDefaults_RollingUpdateMachineDeployment(in.Spec.Strategy.RollingUpdate)
+	}
+}
+
+func SetObjectDefaults_MachineDeploymentList(in *computev1alpha1.MachineDeploymentList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_MachineDeployment(a)
+	}
+}
+
+func SetObjectDefaults_MachineHealthCheck(in *computev1alpha1.MachineHealthCheck) {
+	SetDefaults_MachineHealthCheckSpec(&in.Spec)
+}
+
+func SetObjectDefaults_MachineHealthCheckList(in *computev1alpha1.MachineHealthCheckList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_MachineHealthCheck(a)
+	}
+}
+
 func SetObjectDefaults_MachineList(in *computev1alpha1.MachineList) {
 	for i := range in.Items {
 		a := &in.Items[i]
 		SetObjectDefaults_Machine(a)
 	}
 }
+
+func SetObjectDefaults_MachineSet(in *computev1alpha1.MachineSet) {
+	SetDefaults_MachineSetSpec(&in.Spec)
+	SetDefaults_MachineSpec(&in.Spec.Template.Spec)
+	for i := range in.Spec.Template.Spec.NetworkInterfaces {
+		a := &in.Spec.Template.Spec.NetworkInterfaces[i]
+		if a.NetworkInterfaceSource.Ephemeral != nil {
+			if a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate != nil {
+				networkingv1alpha1.SetDefaults_NetworkInterfaceSpec(&a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec)
+				for j := range a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.IPs {
+					b := &a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.IPs[j]
+					if b.Ephemeral != nil {
+						if b.Ephemeral.PrefixTemplate != nil {
+							ipamv1alpha1.SetDefaults_PrefixSpec(&b.Ephemeral.PrefixTemplate.Spec)
+						}
+					}
+				}
+				for j := range a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.Prefixes {
+					b := &a.NetworkInterfaceSource.Ephemeral.NetworkInterfaceTemplate.Spec.Prefixes[j]
+					if b.Ephemeral != nil {
+						if b.Ephemeral.PrefixTemplate != nil {
+							ipamv1alpha1.SetDefaults_PrefixSpec(&b.Ephemeral.PrefixTemplate.Spec)
+						}
+					}
+				}
+			}
+		}
+	}
+	if in.Spec.Template.Spec.Restart != nil {
+		SetDefaults_MachineRestart(in.Spec.Template.Spec.Restart)
+	}
+	for i := range in.Spec.Template.Spec.TopologySpreadConstraints {
+		a := &in.Spec.Template.Spec.TopologySpreadConstraints[i]
+		SetDefaults_TopologySpreadConstraint(a)
+	}
+}
+
+func SetObjectDefaults_MachineSetList(in *computev1alpha1.MachineSetList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_MachineSet(a)
+	}
+}
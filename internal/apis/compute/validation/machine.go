@@ -5,7 +5,10 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"regexp"
 
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	"github.com/ironcore-dev/ironcore/internal/admission/plugin/machinevolumedevices/device"
 	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
 	"github.com/ironcore-dev/ironcore/internal/apis/compute"
@@ -17,7 +20,9 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 )
 
 // ValidateMachine validates a Machine object.
@@ -60,12 +65,148 @@ func ValidateMachineUpdate(newMachine, oldMachine *compute.Machine) field.ErrorL
 var supportedMachinePowers = sets.New(
 	compute.PowerOn,
 	compute.PowerOff,
+	compute.PowerSuspended,
 )
 
 func validateMachinePower(power compute.Power, fldPath *field.Path) field.ErrorList {
 	return ironcorevalidation.ValidateEnum(supportedMachinePowers, power, fldPath, "must specify machine power")
 }
 
+var supportedRestartModes = sets.New(
+	compute.RestartModeSoft,
+	compute.RestartModeHard,
+)
+
+func validateMachineRestart(restart *compute.MachineRestart, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if restart.RequestedAt.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("requestedAt"), "must specify requestedAt"))
+	}
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateEnum(supportedRestartModes, restart.Mode, fldPath.Child("mode"), "must specify restart mode")...)
+
+	return allErrs
+}
+
+func validateMachineIOFreeze(ioFreeze *compute.MachineIOFreeze, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if ioFreeze.RequestedAt.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("requestedAt"), "must specify requestedAt"))
+	}
+
+	if ioFreeze.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), ioFreeze.Timeout.Duration.String(), "must be greater than zero"))
+	}
+
+	return allErrs
+}
+
+var supportedUnsatisfiableConstraintActions = sets.New(
+	compute.DoNotSchedule,
+	compute.ScheduleAnyway,
+)
+
+func validateTopologySpreadConstraints(constraints []compute.TopologySpreadConstraint, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	type constraintKey struct {
+		topologyKey       string
+		whenUnsatisfiable compute.UnsatisfiableConstraintAction
+	}
+	seenKeys := sets.New[constraintKey]()
+	for i, constraint := range constraints {
+		idxPath := fldPath.Index(i)
+
+		if constraint.MaxSkew <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxSkew"), constraint.MaxSkew, "must be greater than zero"))
+		}
+
+		if constraint.TopologyKey == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("topologyKey"), "must specify topology key"))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(constraint.TopologyKey, idxPath.Child("topologyKey"))...)
+		}
+
+		allErrs = append(allErrs, ironcorevalidation.ValidateEnum(supportedUnsatisfiableConstraintActions, constraint.WhenUnsatisfiable, idxPath.Child("whenUnsatisfiable"), "must specify when unsatisfiable")...)
+
+		if constraint.LabelSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(constraint.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("labelSelector"))...)
+		}
+
+		key := constraintKey{constraint.TopologyKey, constraint.WhenUnsatisfiable}
+		if seenKeys.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, fmt.Sprintf("{%s, %s}", constraint.TopologyKey, constraint.WhenUnsatisfiable)))
+		} else {
+			seenKeys.Insert(key)
+		}
+	}
+
+	return allErrs
+}
+
+func validateAffinity(affinity *compute.Affinity, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if affinity.MachineAffinity != nil {
+		allErrs = append(allErrs, validateMachineAffinityTerms(affinity.MachineAffinity.RequiredDuringSchedulingIgnoredDuringExecution, fldPath.Child("machineAffinity", "requiredDuringSchedulingIgnoredDuringExecution"))...)
+		allErrs = append(allErrs, validateWeightedMachineAffinityTerms(affinity.MachineAffinity.PreferredDuringSchedulingIgnoredDuringExecution, fldPath.Child("machineAffinity", "preferredDuringSchedulingIgnoredDuringExecution"))...)
+	}
+	if affinity.MachineAntiAffinity != nil {
+		allErrs = append(allErrs, validateMachineAffinityTerms(affinity.MachineAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, fldPath.Child("machineAntiAffinity", "requiredDuringSchedulingIgnoredDuringExecution"))...)
+		allErrs = append(allErrs, validateWeightedMachineAffinityTerms(affinity.MachineAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, fldPath.Child("machineAntiAffinity", "preferredDuringSchedulingIgnoredDuringExecution"))...)
+	}
+
+	return allErrs
+}
+
+func validateMachineAffinityTerms(terms []compute.MachineAffinityTerm, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i := range terms {
+		allErrs = append(allErrs, validateMachineAffinityTerm(&terms[i], fldPath.Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateWeightedMachineAffinityTerms(terms []compute.WeightedMachineAffinityTerm, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, term := range terms {
+		idxPath := fldPath.Index(i)
+		if term.Weight < 1 || term.Weight > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), term.Weight, "must be in the range 1-100"))
+		}
+		allErrs = append(allErrs, validateMachineAffinityTerm(&term.MachineAffinityTerm, idxPath.Child("machineAffinityTerm"))...)
+	}
+
+	return allErrs
+}
+
+func validateMachineAffinityTerm(term *compute.MachineAffinityTerm, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if term.TopologyKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("topologyKey"), "must specify topology key"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(term.TopologyKey, fldPath.Child("topologyKey"))...)
+	}
+
+	if term.LabelSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(term.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("labelSelector"))...)
+	}
+
+	for i, namespace := range term.Namespaces {
+		for _, msg := range apivalidation.ValidateNamespaceName(namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, msg))
+		}
+	}
+
+	return allErrs
+}
+
 // validateMachineSpec validates the spec of a Machine object.
 func validateMachineSpec(machineSpec *compute.MachineSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -86,6 +227,20 @@ func validateMachineSpec(machineSpec *compute.MachineSpec, fldPath *field.Path)
 
 	allErrs = append(allErrs, validateMachinePower(machineSpec.Power, fldPath.Child("power"))...)
 
+	if machineSpec.Restart != nil {
+		allErrs = append(allErrs, validateMachineRestart(machineSpec.Restart, fldPath.Child("restart"))...)
+	}
+
+	if machineSpec.IOFreeze != nil {
+		allErrs = append(allErrs, validateMachineIOFreeze(machineSpec.IOFreeze, fldPath.Child("ioFreeze"))...)
+	}
+
+	if machineSpec.PriorityClassName != "" {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(machineSpec.PriorityClassName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("priorityClassName"), machineSpec.PriorityClassName, msg))
+		}
+	}
+
 	if machineSpec.IgnitionRef != nil && machineSpec.IgnitionRef.Name != "" {
 		for _, msg := range apivalidation.NameIsDNSSubdomain(machineSpec.IgnitionRef.Name, false) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("ignitionRef").Child("name"), machineSpec.IgnitionRef.Name, msg))
@@ -109,6 +264,11 @@ func validateMachineSpec(machineSpec *compute.MachineSpec, fldPath *field.Path)
 	}
 
 	allErrs = append(allErrs, metav1validation.ValidateLabels(machineSpec.MachinePoolSelector, fldPath.Child("machinePoolSelector"))...)
+	allErrs = append(allErrs, validateTopologySpreadConstraints(machineSpec.TopologySpreadConstraints, fldPath.Child("topologySpreadConstraints"))...)
+
+	if machineSpec.Affinity != nil {
+		allErrs = append(allErrs, validateAffinity(machineSpec.Affinity, fldPath.Child("affinity"))...)
+	}
 
 	seenNwiNames := sets.NewString()
 	for i, nwi := range machineSpec.NetworkInterfaces {
@@ -121,13 +281,80 @@ func validateMachineSpec(machineSpec *compute.MachineSpec, fldPath *field.Path)
 	}
 
 	if machineSpec.GuestConfig != nil {
-		if machineSpec.GuestConfig.Hostname != "" {
-			for _, msg := range apivalidation.NameIsDNSLabel(machineSpec.GuestConfig.Hostname, false) {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("guestConfig").Child("hostname"), machineSpec.GuestConfig.Hostname, msg))
+		allErrs = append(allErrs, validateMachineGuestConfig(machineSpec.GuestConfig, machineSpec.IgnitionRef, fldPath.Child("guestConfig"))...)
+	}
+
+	return allErrs
+}
+
+var timezoneRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_+-]*(/[A-Za-z0-9_+-]+)*$`)
+
+func validateMachineGuestConfig(guestConfig *compute.MachineGuestConfig, ignitionRef *commonv1alpha1.SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if guestConfig.Hostname != "" {
+		for _, msg := range apivalidation.NameIsDNSLabel(guestConfig.Hostname, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("hostname"), guestConfig.Hostname, msg))
+		}
+	}
+
+	seenSSHPublicKeyRefs := sets.New[commonv1alpha1.SecretKeySelector]()
+	for i, sshPublicKeyRef := range guestConfig.SSHPublicKeyRefs {
+		fldPath := fldPath.Child("sshPublicKeyRefs").Index(i)
+		if seenSSHPublicKeyRefs.Has(sshPublicKeyRef) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, sshPublicKeyRef))
+		} else {
+			seenSSHPublicKeyRefs.Insert(sshPublicKeyRef)
+		}
+		allErrs = append(allErrs, validateSecretKeySelector(sshPublicKeyRef, fldPath)...)
+	}
+
+	if guestConfig.Timezone != "" && !timezoneRegex.MatchString(guestConfig.Timezone) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timezone"), guestConfig.Timezone, "must be an IANA time zone name, e.g. 'Europe/Berlin'"))
+	}
+
+	seenNTPServers := sets.New[string]()
+	for i, ntpServer := range guestConfig.NTPServers {
+		fldPath := fldPath.Child("ntpServers").Index(i)
+		if seenNTPServers.Has(ntpServer) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, ntpServer))
+		} else {
+			seenNTPServers.Insert(ntpServer)
+		}
+		if net.ParseIP(ntpServer) == nil {
+			for _, msg := range validation.IsDNS1123Subdomain(ntpServer) {
+				allErrs = append(allErrs, field.Invalid(fldPath, ntpServer, msg))
 			}
 		}
 	}
 
+	if userDataRef := guestConfig.UserDataRef; userDataRef != nil {
+		if ignitionRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("userDataRef"), "must not specify userDataRef if ignitionRef is specified"))
+		}
+		allErrs = append(allErrs, validateSecretKeySelector(*userDataRef, fldPath.Child("userDataRef"))...)
+	}
+
+	return allErrs
+}
+
+func validateSecretKeySelector(selector commonv1alpha1.SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if selector.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must specify name"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(selector.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), selector.Name, msg))
+		}
+	}
+
+	if selector.Key != "" {
+		for _, msg := range validation.IsConfigMapKey(selector.Key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("key"), selector.Key, msg))
+		}
+	}
+
 	return allErrs
 }
 
@@ -302,8 +529,9 @@ func validateVolumeTemplateSpecForMachine(template *storage.VolumeTemplateSpec,
 func validateMachineSpecUpdate(new, old *compute.MachineSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
-	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(new.MachineClassRef, old.MachineClassRef, fldPath.Child("machineClassRef"))...)
 	allErrs = append(allErrs, ironcorevalidation.ValidateSetOnceField(new.MachinePoolRef, old.MachinePoolRef, fldPath.Child("machinePoolRef"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(new.PriorityClassName, old.PriorityClassName, fldPath.Child("priorityClassName"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(new.Priority, old.Priority, fldPath.Child("priority"))...)
 
 	newVolumesByName := map[string]compute.Volume{}
 	for _, v := range new.Volumes {
@@ -355,5 +583,31 @@ func validateMachineSpecUpdate(new, old *compute.MachineSpec, fldPath *field.Pat
 		}
 	}
 
+	allErrs = append(allErrs, validateMachineGuestConfigUpdate(ptr.Deref(new.GuestConfig, compute.MachineGuestConfig{}), ptr.Deref(old.GuestConfig, compute.MachineGuestConfig{}), fldPath.Child("guestConfig"))...)
+
+	return allErrs
+}
+
+// validateMachineGuestConfigUpdate validates that the guest configuration, which is only applied
+// when a machine is created, is not changed afterward.
+func validateMachineGuestConfigUpdate(new, old compute.MachineGuestConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(new.SSHPublicKeyRefs, old.SSHPublicKeyRefs, fldPath.Child("sshPublicKeyRefs"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(new.Timezone, old.Timezone, fldPath.Child("timezone"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(new.NTPServers, old.NTPServers, fldPath.Child("ntpServers"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(new.UserDataRef, old.UserDataRef, fldPath.Child("userDataRef"))...)
+
+	return allErrs
+}
+
+// ValidateMachineConsoleLogOptions validates the options of a Machine console log request.
+func ValidateMachineConsoleLogOptions(opts *compute.MachineConsoleLogOptions) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if opts.TailLines != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*opts.TailLines, field.NewPath("tailLines"))...)
+	}
+
 	return allErrs
 }
@@ -4,6 +4,8 @@
 package validation
 
 import (
+	"time"
+
 	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	"github.com/ironcore-dev/ironcore/internal/apis/compute"
 	"github.com/ironcore-dev/ironcore/internal/apis/ipam"
@@ -15,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func mustParseNewQuantity(s string) *resource.Quantity {
@@ -60,6 +63,202 @@ var _ = Describe("Machine", func() {
 			},
 			Not(ContainElement(NotSupportedField("spec.power"))),
 		),
+		Entry("suspended machine power",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Power: compute.PowerSuspended,
+				},
+			},
+			Not(ContainElement(NotSupportedField("spec.power"))),
+		),
+		Entry("restart without requestedAt",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Restart: &compute.MachineRestart{
+						Mode: compute.RestartModeSoft,
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.restart.requestedAt")),
+		),
+		Entry("invalid priority class name",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					PriorityClassName: "foo*",
+				},
+			},
+			ContainElement(InvalidField("spec.priorityClassName")),
+		),
+		Entry("invalid restart mode",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Restart: &compute.MachineRestart{
+						RequestedAt: metav1.Now(),
+						Mode:        "invalid",
+					},
+				},
+			},
+			ContainElement(NotSupportedField("spec.restart.mode")),
+		),
+		Entry("valid restart",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Restart: &compute.MachineRestart{
+						RequestedAt: metav1.Now(),
+						Mode:        compute.RestartModeHard,
+					},
+				},
+			},
+			Not(ContainElement(HaveField("Field", HavePrefix("spec.restart")))),
+		),
+		Entry("io freeze without requestedAt",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					IOFreeze: &compute.MachineIOFreeze{
+						Timeout: metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.ioFreeze.requestedAt")),
+		),
+		Entry("io freeze without timeout",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					IOFreeze: &compute.MachineIOFreeze{
+						RequestedAt: metav1.Now(),
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.ioFreeze.timeout")),
+		),
+		Entry("valid io freeze",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					IOFreeze: &compute.MachineIOFreeze{
+						RequestedAt: metav1.Now(),
+						Timeout:     metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			Not(ContainElement(HaveField("Field", HavePrefix("spec.ioFreeze")))),
+		),
+		Entry("invalid topology spread constraint max skew",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					TopologySpreadConstraints: []compute.TopologySpreadConstraint{
+						{MaxSkew: 0, TopologyKey: "topology.ironcore.dev/zone", WhenUnsatisfiable: compute.DoNotSchedule},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.topologySpreadConstraints[0].maxSkew")),
+		),
+		Entry("missing topology spread constraint topology key",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					TopologySpreadConstraints: []compute.TopologySpreadConstraint{
+						{MaxSkew: 1, WhenUnsatisfiable: compute.DoNotSchedule},
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.topologySpreadConstraints[0].topologyKey")),
+		),
+		Entry("invalid topology spread constraint when unsatisfiable",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					TopologySpreadConstraints: []compute.TopologySpreadConstraint{
+						{MaxSkew: 1, TopologyKey: "topology.ironcore.dev/zone", WhenUnsatisfiable: "invalid"},
+					},
+				},
+			},
+			ContainElement(NotSupportedField("spec.topologySpreadConstraints[0].whenUnsatisfiable")),
+		),
+		Entry("duplicate topology spread constraints",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					TopologySpreadConstraints: []compute.TopologySpreadConstraint{
+						{MaxSkew: 1, TopologyKey: "topology.ironcore.dev/zone", WhenUnsatisfiable: compute.DoNotSchedule},
+						{MaxSkew: 2, TopologyKey: "topology.ironcore.dev/zone", WhenUnsatisfiable: compute.DoNotSchedule},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.topologySpreadConstraints[1]")),
+		),
+		Entry("valid topology spread constraints",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					TopologySpreadConstraints: []compute.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       "topology.ironcore.dev/zone",
+							WhenUnsatisfiable: compute.DoNotSchedule,
+							LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+						},
+						{MaxSkew: 1, TopologyKey: "topology.ironcore.dev/zone", WhenUnsatisfiable: compute.ScheduleAnyway},
+					},
+				},
+			},
+			Not(ContainElement(HaveField("Field", HavePrefix("spec.topologySpreadConstraints")))),
+		),
+		Entry("missing affinity term topology key",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Affinity: &compute.Affinity{
+						MachineAntiAffinity: &compute.MachineAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []compute.MachineAffinityTerm{{}},
+						},
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.affinity.machineAntiAffinity.requiredDuringSchedulingIgnoredDuringExecution[0].topologyKey")),
+		),
+		Entry("invalid affinity term weight",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Affinity: &compute.Affinity{
+						MachineAffinity: &compute.MachineAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []compute.WeightedMachineAffinityTerm{
+								{
+									Weight:              101,
+									MachineAffinityTerm: compute.MachineAffinityTerm{TopologyKey: compute.MachinePoolTopologyKey},
+								},
+							},
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.affinity.machineAffinity.preferredDuringSchedulingIgnoredDuringExecution[0].weight")),
+		),
+		Entry("invalid affinity term namespace",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Affinity: &compute.Affinity{
+						MachineAffinity: &compute.MachineAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []compute.MachineAffinityTerm{
+								{TopologyKey: compute.MachinePoolTopologyKey, Namespaces: []string{"foo*"}},
+							},
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.affinity.machineAffinity.requiredDuringSchedulingIgnoredDuringExecution[0].namespaces[0]")),
+		),
+		Entry("valid affinity",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Affinity: &compute.Affinity{
+						MachineAntiAffinity: &compute.MachineAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []compute.MachineAffinityTerm{
+								{
+									TopologyKey:   compute.MachinePoolTopologyKey,
+									LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "etcd"}},
+								},
+							},
+						},
+					},
+				},
+			},
+			Not(ContainElement(HaveField("Field", HavePrefix("spec.affinity")))),
+		),
 		Entry("no image",
 			&compute.Machine{},
 			Not(ContainElement(RequiredField("spec.image"))),
@@ -194,6 +393,104 @@ var _ = Describe("Machine", func() {
 			},
 			ContainElement(InvalidField("spec.ignitionRef.name")),
 		),
+		Entry("missing ssh public key ref name",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						SSHPublicKeyRefs: []commonv1alpha1.SecretKeySelector{{}},
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.guestConfig.sshPublicKeyRefs[0].name")),
+		),
+		Entry("duplicate ssh public key ref",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						SSHPublicKeyRefs: []commonv1alpha1.SecretKeySelector{
+							{Name: "foo", Key: "bar"},
+							{Name: "foo", Key: "bar"},
+						},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.guestConfig.sshPublicKeyRefs[1]")),
+		),
+		Entry("invalid ssh public key ref key",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						SSHPublicKeyRefs: []commonv1alpha1.SecretKeySelector{{Name: "foo", Key: "bar*"}},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.guestConfig.sshPublicKeyRefs[0].key")),
+		),
+		Entry("invalid timezone",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						Timezone: "Europe/Berlin ",
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.guestConfig.timezone")),
+		),
+		Entry("valid timezone",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						Timezone: "America/Argentina/Buenos_Aires",
+					},
+				},
+			},
+			Not(ContainElement(InvalidField("spec.guestConfig.timezone"))),
+		),
+		Entry("invalid ntp server",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						NTPServers: []string{"10.0.0.1", "ntp.example.org", "not a server"},
+					},
+				},
+			},
+			SatisfyAll(
+				ContainElement(InvalidField("spec.guestConfig.ntpServers[2]")),
+				Not(ContainElement(InvalidField("spec.guestConfig.ntpServers[0]"))),
+				Not(ContainElement(InvalidField("spec.guestConfig.ntpServers[1]"))),
+			),
+		),
+		Entry("duplicate ntp server",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						NTPServers: []string{"ntp.example.org", "ntp.example.org"},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.guestConfig.ntpServers[1]")),
+		),
+		Entry("user data ref and ignition ref",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					IgnitionRef: &commonv1alpha1.SecretKeySelector{Name: "foo"},
+					GuestConfig: &compute.MachineGuestConfig{
+						UserDataRef: &commonv1alpha1.SecretKeySelector{Name: "bar"},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.guestConfig.userDataRef")),
+		),
+		Entry("invalid user data ref name",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						UserDataRef: &commonv1alpha1.SecretKeySelector{Name: "bar*"},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.guestConfig.userDataRef.name")),
+		),
 	)
 
 	DescribeTable("ValidateMachineNetworkInterface",
@@ -445,7 +742,7 @@ var _ = Describe("Machine", func() {
 			errList := ValidateMachineUpdate(newMachine, oldMachine)
 			Expect(errList).To(match)
 		},
-		Entry("immutable machineClassRef",
+		Entry("mutable machineClassRef",
 			&compute.Machine{
 				Spec: compute.MachineSpec{
 					MachineClassRef: corev1.LocalObjectReference{Name: "foo"},
@@ -456,7 +753,7 @@ var _ = Describe("Machine", func() {
 					MachineClassRef: corev1.LocalObjectReference{Name: "bar"},
 				},
 			},
-			ContainElement(ImmutableField("spec.machineClassRef")),
+			Not(ContainElement(ImmutableField("spec.machineClassRef"))),
 		),
 		Entry("immutable machinePoolRef if set",
 			&compute.Machine{
@@ -480,6 +777,32 @@ var _ = Describe("Machine", func() {
 			&compute.Machine{},
 			Not(ContainElement(ImmutableField("spec.machinePoolRef"))),
 		),
+		Entry("immutable priorityClassName",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					PriorityClassName: "foo",
+				},
+			},
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					PriorityClassName: "bar",
+				},
+			},
+			ContainElement(ImmutableField("spec.priorityClassName")),
+		),
+		Entry("immutable priority",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Priority: ptr.To[int32](1),
+				},
+			},
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					Priority: ptr.To[int32](2),
+				},
+			},
+			ContainElement(ImmutableField("spec.priority")),
+		),
 		Entry("mutate local boot disk image",
 			&compute.Machine{
 				Spec: compute.MachineSpec{
@@ -624,5 +947,46 @@ var _ = Describe("Machine", func() {
 			},
 			ContainElement(ImmutableField("spec.guestConfig.hostname")),
 		),
+		Entry("immutable guest config",
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						SSHPublicKeyRefs: []commonv1alpha1.SecretKeySelector{{Name: "bar"}},
+						Timezone:         "Europe/Paris",
+						NTPServers:       []string{"ntp.example.org"},
+						UserDataRef:      &commonv1alpha1.SecretKeySelector{Name: "bar"},
+					},
+				},
+			},
+			&compute.Machine{
+				Spec: compute.MachineSpec{
+					GuestConfig: &compute.MachineGuestConfig{
+						SSHPublicKeyRefs: []commonv1alpha1.SecretKeySelector{{Name: "foo"}},
+						Timezone:         "Europe/Berlin",
+					},
+				},
+			},
+			SatisfyAll(
+				ContainElement(ImmutableField("spec.guestConfig.sshPublicKeyRefs")),
+				ContainElement(ImmutableField("spec.guestConfig.timezone")),
+				ContainElement(ImmutableField("spec.guestConfig.ntpServers")),
+				ContainElement(ImmutableField("spec.guestConfig.userDataRef")),
+			),
+		),
+	)
+
+	DescribeTable("ValidateMachineConsoleLogOptions",
+		func(opts *compute.MachineConsoleLogOptions, match types.GomegaMatcher) {
+			errList := ValidateMachineConsoleLogOptions(opts)
+			Expect(errList).To(match)
+		},
+		Entry("negative tail lines",
+			&compute.MachineConsoleLogOptions{TailLines: ptr.To[int64](-1)},
+			ContainElement(InvalidField("tailLines")),
+		),
+		Entry("valid options",
+			&compute.MachineConsoleLogOptions{Follow: true, TailLines: ptr.To[int64](10)},
+			BeEmpty(),
+		),
 	)
 })
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateMachineDeployment validates a MachineDeployment object.
+func ValidateMachineDeployment(machineDeployment *compute.MachineDeployment) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(machineDeployment, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateMachineDeploymentSpec(&machineDeployment.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateMachineDeploymentSpec(spec *compute.MachineDeploymentSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Replicas != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.Replicas), fldPath.Child("replicas"))...)
+	}
+	if spec.RevisionHistoryLimit != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.RevisionHistoryLimit), fldPath.Child("revisionHistoryLimit"))...)
+	}
+	allErrs = append(allErrs, validateMachineTemplateSpecWithSelector(&spec.Template, spec.Selector, fldPath.Child("template"), fldPath.Child("selector"))...)
+	allErrs = append(allErrs, validateMachineDeploymentStrategy(&spec.Strategy, fldPath.Child("strategy"))...)
+
+	return allErrs
+}
+
+var supportedMachineDeploymentStrategyTypes = sets.New(
+	compute.RecreateMachineDeploymentStrategyType,
+	compute.RollingUpdateMachineDeploymentStrategyType,
+)
+
+func validateMachineDeploymentStrategy(strategy *compute.MachineDeploymentStrategy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateEnum(supportedMachineDeploymentStrategyTypes, strategy.Type, fldPath.Child("type"), "must specify strategy type")...)
+
+	switch strategy.Type {
+	case compute.RecreateMachineDeploymentStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollingUpdate"), "may not be specified when strategy type is Recreate"))
+		}
+	case compute.RollingUpdateMachineDeploymentStrategyType:
+		if strategy.RollingUpdate == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("rollingUpdate"), "must specify rollingUpdate when strategy type is RollingUpdate"))
+			break
+		}
+		allErrs = append(allErrs, validateRollingUpdateMachineDeployment(strategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
+	}
+
+	return allErrs
+}
+
+func validateRollingUpdateMachineDeployment(rollingUpdate *compute.RollingUpdateMachineDeployment, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if rollingUpdate.MaxUnavailable != nil {
+		allErrs = append(allErrs, validateIntOrPercent(*rollingUpdate.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+	}
+	if rollingUpdate.MaxSurge != nil {
+		allErrs = append(allErrs, validateIntOrPercent(*rollingUpdate.MaxSurge, fldPath.Child("maxSurge"))...)
+	}
+	if isZeroIntOrPercent(rollingUpdate.MaxUnavailable) && isZeroIntOrPercent(rollingUpdate.MaxSurge) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable, "may not be 0 when maxSurge is 0"))
+	}
+
+	return allErrs
+}
+
+func isZeroIntOrPercent(value *intstr.IntOrString) bool {
+	if value == nil {
+		return true
+	}
+	v, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	return err == nil && v == 0
+}
+
+// ValidateMachineDeploymentUpdate validates a MachineDeployment object before an update.
+func ValidateMachineDeploymentUpdate(newMachineDeployment, oldMachineDeployment *compute.MachineDeployment) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineDeployment, oldMachineDeployment, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newMachineDeployment.Spec.Selector, oldMachineDeployment.Spec.Selector, field.NewPath("spec", "selector"))...)
+	allErrs = append(allErrs, ValidateMachineDeployment(newMachineDeployment)...)
+
+	return allErrs
+}
+
+// ValidateMachineDeploymentStatusUpdate validates a MachineDeployment status before an update.
+func ValidateMachineDeploymentStatusUpdate(newMachineDeployment, oldMachineDeployment *compute.MachineDeployment) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineDeployment, oldMachineDeployment, field.NewPath("metadata"))...)
+
+	statusPath := field.NewPath("status")
+	status := &newMachineDeployment.Status
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.Replicas), statusPath.Child("replicas"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.UpdatedReplicas), statusPath.Child("updatedReplicas"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.ReadyReplicas), statusPath.Child("readyReplicas"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.UnavailableReplicas), statusPath.Child("unavailableReplicas"))...)
+
+	return allErrs
+}
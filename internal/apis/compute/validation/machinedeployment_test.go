@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("MachineDeployment", func() {
+	DescribeTable("ValidateMachineDeployment",
+		func(machineDeployment *compute.MachineDeployment, match types.GomegaMatcher) {
+			errList := ValidateMachineDeployment(machineDeployment)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&compute.MachineDeployment{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing selector",
+			&compute.MachineDeployment{},
+			ContainElement(RequiredField("spec.selector")),
+		),
+		Entry("missing strategy type",
+			&compute.MachineDeployment{},
+			ContainElement(RequiredField("spec.strategy.type")),
+		),
+		Entry("unsupported strategy type",
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					Strategy: compute.MachineDeploymentStrategy{Type: "foo"},
+				},
+			},
+			ContainElement(NotSupportedField("spec.strategy.type")),
+		),
+		Entry("rolling update with recreate strategy",
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					Strategy: compute.MachineDeploymentStrategy{
+						Type:          compute.RecreateMachineDeploymentStrategyType,
+						RollingUpdate: &compute.RollingUpdateMachineDeployment{},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.strategy.rollingUpdate")),
+		),
+		Entry("missing rolling update with rolling update strategy",
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					Strategy: compute.MachineDeploymentStrategy{Type: compute.RollingUpdateMachineDeploymentStrategyType},
+				},
+			},
+			ContainElement(RequiredField("spec.strategy.rollingUpdate")),
+		),
+		Entry("zero maxUnavailable and maxSurge",
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					Strategy: compute.MachineDeploymentStrategy{
+						Type: compute.RollingUpdateMachineDeploymentStrategyType,
+						RollingUpdate: &compute.RollingUpdateMachineDeployment{
+							MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+							MaxSurge:       ptr.To(intstr.FromString("0%")),
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.strategy.rollingUpdate.maxUnavailable")),
+		),
+		Entry("invalid maxSurge percentage",
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					Strategy: compute.MachineDeploymentStrategy{
+						Type: compute.RollingUpdateMachineDeploymentStrategyType,
+						RollingUpdate: &compute.RollingUpdateMachineDeployment{
+							MaxSurge: ptr.To(intstr.FromString("foo")),
+						},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.strategy.rollingUpdate.maxSurge")),
+		),
+		Entry("negative revision history limit",
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					RevisionHistoryLimit: ptr.To[int32](-1),
+				},
+			},
+			ContainElement(InvalidField("spec.revisionHistoryLimit")),
+		),
+	)
+
+	DescribeTable("ValidateMachineDeploymentUpdate",
+		func(newMachineDeployment, oldMachineDeployment *compute.MachineDeployment, match types.GomegaMatcher) {
+			errList := ValidateMachineDeploymentUpdate(newMachineDeployment, oldMachineDeployment)
+			Expect(errList).To(match)
+		},
+		Entry("immutable selector",
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				},
+			},
+			&compute.MachineDeployment{
+				Spec: compute.MachineDeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "bar"}},
+				},
+			},
+			ContainElement(ImmutableField("spec.selector")),
+		),
+	)
+})
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateMachineDisruptionBudget validates a MachineDisruptionBudget object.
+func ValidateMachineDisruptionBudget(machineDisruptionBudget *compute.MachineDisruptionBudget) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(machineDisruptionBudget, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateMachineDisruptionBudgetSpec(&machineDisruptionBudget.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateMachineDisruptionBudgetSpec(spec *compute.MachineDisruptionBudgetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), "must specify selector"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.Selector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector"))...)
+	}
+
+	switch {
+	case spec.MinAvailable != nil && spec.MaxUnavailable != nil:
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, "minAvailable and maxUnavailable are mutually exclusive"))
+	case spec.MinAvailable == nil && spec.MaxUnavailable == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify either minAvailable or maxUnavailable"))
+	case spec.MinAvailable != nil:
+		allErrs = append(allErrs, validateIntOrPercent(*spec.MinAvailable, fldPath.Child("minAvailable"))...)
+	case spec.MaxUnavailable != nil:
+		allErrs = append(allErrs, validateIntOrPercent(*spec.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+	}
+
+	return allErrs
+}
+
+func validateIntOrPercent(value intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch value.Type {
+	case intstr.Int:
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(value.IntValue()), fldPath)...)
+	case intstr.String:
+		v, err := intstr.GetScaledValueFromIntOrPercent(&value, 100, false)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, "must be an integer or a percentage, e.g. '10%'"))
+			break
+		}
+		if v < 0 || v > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, validation.InclusiveRangeError(0, 100)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMachineDisruptionBudgetUpdate validates a MachineDisruptionBudget object before an update.
+func ValidateMachineDisruptionBudgetUpdate(newMachineDisruptionBudget, oldMachineDisruptionBudget *compute.MachineDisruptionBudget) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineDisruptionBudget, oldMachineDisruptionBudget, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateMachineDisruptionBudget(newMachineDisruptionBudget)...)
+
+	return allErrs
+}
+
+// ValidateMachineDisruptionBudgetStatusUpdate validates a MachineDisruptionBudget status before an update.
+func ValidateMachineDisruptionBudgetStatusUpdate(newMachineDisruptionBudget, oldMachineDisruptionBudget *compute.MachineDisruptionBudget) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineDisruptionBudget, oldMachineDisruptionBudget, field.NewPath("metadata"))...)
+
+	statusPath := field.NewPath("status")
+	status := &newMachineDisruptionBudget.Status
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.DisruptionsAllowed), statusPath.Child("disruptionsAllowed"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.CurrentHealthy), statusPath.Child("currentHealthy"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.DesiredHealthy), statusPath.Child("desiredHealthy"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.ExpectedMachines), statusPath.Child("expectedMachines"))...)
+
+	return allErrs
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("MachineDisruptionBudget", func() {
+	DescribeTable("ValidateMachineDisruptionBudget",
+		func(machineDisruptionBudget *compute.MachineDisruptionBudget, match types.GomegaMatcher) {
+			errList := ValidateMachineDisruptionBudget(machineDisruptionBudget)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&compute.MachineDisruptionBudget{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&compute.MachineDisruptionBudget{},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("missing selector",
+			&compute.MachineDisruptionBudget{},
+			ContainElement(RequiredField("spec.selector")),
+		),
+		Entry("invalid selector",
+			&compute.MachineDisruptionBudget{
+				Spec: compute.MachineDisruptionBudgetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo*": "bar"}},
+				},
+			},
+			ContainElement(InvalidField("spec.selector.matchLabels")),
+		),
+		Entry("neither minAvailable nor maxUnavailable",
+			&compute.MachineDisruptionBudget{},
+			ContainElement(RequiredField("spec")),
+		),
+		Entry("both minAvailable and maxUnavailable",
+			&compute.MachineDisruptionBudget{
+				Spec: compute.MachineDisruptionBudgetSpec{
+					MinAvailable:   ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				},
+			},
+			ContainElement(InvalidField("spec")),
+		),
+		Entry("negative minAvailable",
+			&compute.MachineDisruptionBudget{
+				Spec: compute.MachineDisruptionBudgetSpec{
+					MinAvailable: ptr.To(intstr.FromInt32(-1)),
+				},
+			},
+			ContainElement(InvalidField("spec.minAvailable")),
+		),
+		Entry("invalid maxUnavailable percentage",
+			&compute.MachineDisruptionBudget{
+				Spec: compute.MachineDisruptionBudgetSpec{
+					MaxUnavailable: ptr.To(intstr.FromString("120%")),
+				},
+			},
+			ContainElement(InvalidField("spec.maxUnavailable")),
+		),
+		Entry("malformed maxUnavailable",
+			&compute.MachineDisruptionBudget{
+				Spec: compute.MachineDisruptionBudgetSpec{
+					MaxUnavailable: ptr.To(intstr.FromString("foo")),
+				},
+			},
+			ContainElement(InvalidField("spec.maxUnavailable")),
+		),
+		Entry("valid maxUnavailable percentage",
+			&compute.MachineDisruptionBudget{
+				Spec: compute.MachineDisruptionBudgetSpec{
+					MaxUnavailable: ptr.To(intstr.FromString("50%")),
+				},
+			},
+			Not(ContainElement(InvalidField("spec.maxUnavailable"))),
+		),
+	)
+
+	DescribeTable("ValidateMachineDisruptionBudgetStatusUpdate",
+		func(newMachineDisruptionBudget, oldMachineDisruptionBudget *compute.MachineDisruptionBudget, match types.GomegaMatcher) {
+			errList := ValidateMachineDisruptionBudgetStatusUpdate(newMachineDisruptionBudget, oldMachineDisruptionBudget)
+			Expect(errList).To(match)
+		},
+		Entry("negative disruptionsAllowed",
+			&compute.MachineDisruptionBudget{Status: compute.MachineDisruptionBudgetStatus{DisruptionsAllowed: -1}},
+			&compute.MachineDisruptionBudget{},
+			ContainElement(InvalidField("status.disruptionsAllowed")),
+		),
+		Entry("valid disruptionsAllowed",
+			&compute.MachineDisruptionBudget{Status: compute.MachineDisruptionBudgetStatus{DisruptionsAllowed: 1}},
+			&compute.MachineDisruptionBudget{},
+			Not(ContainElement(InvalidField("status.disruptionsAllowed"))),
+		),
+	)
+})
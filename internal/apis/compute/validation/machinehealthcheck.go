@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateMachineHealthCheck validates a MachineHealthCheck object.
+func ValidateMachineHealthCheck(machineHealthCheck *compute.MachineHealthCheck) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(machineHealthCheck, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateMachineHealthCheckSpec(&machineHealthCheck.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+var supportedUnhealthyMachineStates = sets.New(
+	compute.MachineStatePending,
+	compute.MachineStateShutdown,
+	compute.MachineStateTerminating,
+	compute.MachineStateTerminated,
+)
+
+var supportedUnhealthyConditionStatuses = sets.New(
+	corev1.ConditionTrue,
+	corev1.ConditionFalse,
+	corev1.ConditionUnknown,
+)
+
+var supportedMachineRemediationStrategies = sets.New(
+	compute.MachineRemediationStrategyRestart,
+	compute.MachineRemediationStrategyRecreate,
+)
+
+func validateMachineHealthCheckSpec(spec *compute.MachineHealthCheckSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), "must specify selector"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.Selector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("selector"))...)
+	}
+
+	if len(spec.UnhealthyStates) == 0 && len(spec.UnhealthyConditions) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify unhealthyStates or unhealthyConditions"))
+	}
+
+	seenStates := sets.New[compute.MachineState]()
+	for i, unhealthyState := range spec.UnhealthyStates {
+		fldPath := fldPath.Child("unhealthyStates").Index(i)
+		if seenStates.Has(unhealthyState.State) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("state"), unhealthyState.State))
+		} else {
+			seenStates.Insert(unhealthyState.State)
+		}
+		allErrs = append(allErrs, ironcorevalidation.ValidateEnum(supportedUnhealthyMachineStates, unhealthyState.State, fldPath.Child("state"), "must specify state")...)
+		allErrs = append(allErrs, validateUnhealthyTimeout(unhealthyState.Timeout, fldPath.Child("timeout"))...)
+	}
+
+	seenConditions := sets.New[compute.UnhealthyMachineCondition]()
+	for i, unhealthyCondition := range spec.UnhealthyConditions {
+		fldPath := fldPath.Child("unhealthyConditions").Index(i)
+		key := compute.UnhealthyMachineCondition{Type: unhealthyCondition.Type, Status: unhealthyCondition.Status}
+		if seenConditions.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, unhealthyCondition))
+		} else {
+			seenConditions.Insert(key)
+		}
+		if unhealthyCondition.Type == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must specify type"))
+		}
+		allErrs = append(allErrs, ironcorevalidation.ValidateEnum(supportedUnhealthyConditionStatuses, unhealthyCondition.Status, fldPath.Child("status"), "must specify status")...)
+		allErrs = append(allErrs, validateUnhealthyTimeout(unhealthyCondition.Timeout, fldPath.Child("timeout"))...)
+	}
+
+	if spec.MaxUnhealthy != nil {
+		allErrs = append(allErrs, validateIntOrPercent(*spec.MaxUnhealthy, fldPath.Child("maxUnhealthy"))...)
+	}
+
+	allErrs = append(allErrs, ironcorevalidation.ValidateEnum(supportedMachineRemediationStrategies, spec.RemediationStrategy, fldPath.Child("remediationStrategy"), "must specify remediation strategy")...)
+
+	return allErrs
+}
+
+func validateUnhealthyTimeout(timeout metav1.Duration, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, timeout.Duration.String(), "must be greater than zero"))
+	}
+
+	return allErrs
+}
+
+// ValidateMachineHealthCheckUpdate validates a MachineHealthCheck object before an update.
+func ValidateMachineHealthCheckUpdate(newMachineHealthCheck, oldMachineHealthCheck *compute.MachineHealthCheck) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineHealthCheck, oldMachineHealthCheck, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateMachineHealthCheck(newMachineHealthCheck)...)
+
+	return allErrs
+}
+
+// ValidateMachineHealthCheckStatusUpdate validates a MachineHealthCheck status before an update.
+func ValidateMachineHealthCheckStatusUpdate(newMachineHealthCheck, oldMachineHealthCheck *compute.MachineHealthCheck) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineHealthCheck, oldMachineHealthCheck, field.NewPath("metadata"))...)
+
+	statusPath := field.NewPath("status")
+	status := &newMachineHealthCheck.Status
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.ExpectedMachines), statusPath.Child("expectedMachines"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.CurrentHealthy), statusPath.Child("currentHealthy"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.RemediationsAllowed), statusPath.Child("remediationsAllowed"))...)
+
+	return allErrs
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"time"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("MachineHealthCheck", func() {
+	DescribeTable("ValidateMachineHealthCheck",
+		func(machineHealthCheck *compute.MachineHealthCheck, match types.GomegaMatcher) {
+			errList := ValidateMachineHealthCheck(machineHealthCheck)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&compute.MachineHealthCheck{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&compute.MachineHealthCheck{},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("missing selector",
+			&compute.MachineHealthCheck{},
+			ContainElement(RequiredField("spec.selector")),
+		),
+		Entry("invalid selector",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo*": "bar"}},
+				},
+			},
+			ContainElement(InvalidField("spec.selector.matchLabels")),
+		),
+		Entry("neither unhealthyStates nor unhealthyConditions",
+			&compute.MachineHealthCheck{},
+			ContainElement(RequiredField("spec")),
+		),
+		Entry("unsupported unhealthy state",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					UnhealthyStates: []compute.UnhealthyMachineState{
+						{State: compute.MachineStateRunning, Timeout: metav1.Duration{Duration: time.Minute}},
+					},
+				},
+			},
+			ContainElement(NotSupportedField("spec.unhealthyStates[0].state")),
+		),
+		Entry("duplicate unhealthy state",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					UnhealthyStates: []compute.UnhealthyMachineState{
+						{State: compute.MachineStatePending, Timeout: metav1.Duration{Duration: time.Minute}},
+						{State: compute.MachineStatePending, Timeout: metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.unhealthyStates[1].state")),
+		),
+		Entry("non-positive unhealthy state timeout",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					UnhealthyStates: []compute.UnhealthyMachineState{
+						{State: compute.MachineStateShutdown},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.unhealthyStates[0].timeout")),
+		),
+		Entry("missing unhealthy condition type",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					UnhealthyConditions: []compute.UnhealthyMachineCondition{
+						{Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: time.Minute}},
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.unhealthyConditions[0].type")),
+		),
+		Entry("unsupported unhealthy condition status",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					UnhealthyConditions: []compute.UnhealthyMachineCondition{
+						{Type: "Ready", Status: "Maybe", Timeout: metav1.Duration{Duration: time.Minute}},
+					},
+				},
+			},
+			ContainElement(NotSupportedField("spec.unhealthyConditions[0].status")),
+		),
+		Entry("duplicate unhealthy condition",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					UnhealthyConditions: []compute.UnhealthyMachineCondition{
+						{Type: "Ready", Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: time.Minute}},
+						{Type: "Ready", Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			},
+			ContainElement(DuplicateField("spec.unhealthyConditions[1]")),
+		),
+		Entry("invalid maxUnhealthy",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					MaxUnhealthy: ptr.To(intstr.FromString("120%")),
+				},
+			},
+			ContainElement(InvalidField("spec.maxUnhealthy")),
+		),
+		Entry("unsupported remediation strategy",
+			&compute.MachineHealthCheck{
+				Spec: compute.MachineHealthCheckSpec{
+					RemediationStrategy: "Pray",
+				},
+			},
+			ContainElement(NotSupportedField("spec.remediationStrategy")),
+		),
+		Entry("valid machine health check",
+			&compute.MachineHealthCheck{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "bar"},
+				Spec: compute.MachineHealthCheckSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					UnhealthyStates: []compute.UnhealthyMachineState{
+						{State: compute.MachineStatePending, Timeout: metav1.Duration{Duration: 10 * time.Minute}},
+					},
+					UnhealthyConditions: []compute.UnhealthyMachineCondition{
+						{Type: "Ready", Status: corev1.ConditionFalse, Timeout: metav1.Duration{Duration: 5 * time.Minute}},
+					},
+					MaxUnhealthy:        ptr.To(intstr.FromString("40%")),
+					RemediationStrategy: compute.MachineRemediationStrategyRecreate,
+				},
+			},
+			BeEmpty(),
+		),
+	)
+})
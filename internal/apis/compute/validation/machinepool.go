@@ -24,6 +24,24 @@ func ValidateMachinePool(machinePool *compute.MachinePool) field.ErrorList {
 func validateMachinePoolSpec(machinePoolSpec *compute.MachinePoolSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
+	if drain := machinePoolSpec.Drain; drain != nil {
+		allErrs = append(allErrs, validateMachinePoolDrain(drain, fldPath.Child("drain"))...)
+	}
+
+	return allErrs
+}
+
+func validateMachinePoolDrain(drain *compute.MachinePoolDrain, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if drain.RequestedAt.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("requestedAt"), "must specify requestedAt"))
+	}
+
+	if drain.EvictionTimeout != nil && drain.EvictionTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("evictionTimeout"), drain.EvictionTimeout.Duration.String(), "must be greater than zero"))
+	}
+
 	return allErrs
 }
 
@@ -4,6 +4,8 @@
 package validation
 
 import (
+	"time"
+
 	"github.com/ironcore-dev/ironcore/internal/apis/compute"
 	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
 	. "github.com/onsi/ginkgo/v2"
@@ -30,6 +32,36 @@ var _ = Describe("MachinePool", func() {
 			&compute.MachinePool{ObjectMeta: metav1.ObjectMeta{Name: "foo.bar.baz"}},
 			Not(ContainElement(InvalidField("metadata.name"))),
 		),
+		Entry("drain without requestedAt",
+			&compute.MachinePool{
+				Spec: compute.MachinePoolSpec{
+					Drain: &compute.MachinePoolDrain{},
+				},
+			},
+			ContainElement(RequiredField("spec.drain.requestedAt")),
+		),
+		Entry("drain with non-positive eviction timeout",
+			&compute.MachinePool{
+				Spec: compute.MachinePoolSpec{
+					Drain: &compute.MachinePoolDrain{
+						RequestedAt:     metav1.Now(),
+						EvictionTimeout: &metav1.Duration{},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.drain.evictionTimeout")),
+		),
+		Entry("valid drain",
+			&compute.MachinePool{
+				Spec: compute.MachinePoolSpec{
+					Drain: &compute.MachinePoolDrain{
+						RequestedAt:     metav1.Now(),
+						EvictionTimeout: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			Not(ContainElement(HaveField("Field", HavePrefix("spec.drain")))),
+		),
 	)
 
 	DescribeTable("ValidateMachinePoolUpdate",
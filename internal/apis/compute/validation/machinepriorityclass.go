@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"fmt"
+
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateMachinePriorityClass validates a MachinePriorityClass object.
+func ValidateMachinePriorityClass(machinePriorityClass *compute.MachinePriorityClass) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(machinePriorityClass, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+
+	if machinePriorityClass.Value > compute.HighestUserDefinableMachinePriority {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("value"), machinePriorityClass.Value, fmt.Sprintf("must be less than or equal to %d", compute.HighestUserDefinableMachinePriority)))
+	}
+
+	return allErrs
+}
+
+// ValidateMachinePriorityClassUpdate validates a MachinePriorityClass object before an update.
+func ValidateMachinePriorityClassUpdate(newMachinePriorityClass, oldMachinePriorityClass *compute.MachinePriorityClass) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachinePriorityClass, oldMachinePriorityClass, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newMachinePriorityClass.Value, oldMachinePriorityClass.Value, field.NewPath("value"))...)
+	allErrs = append(allErrs, ValidateMachinePriorityClass(newMachinePriorityClass)...)
+
+	return allErrs
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("MachinePriorityClass", func() {
+	DescribeTable("ValidateMachinePriorityClass",
+		func(machinePriorityClass *compute.MachinePriorityClass, match types.GomegaMatcher) {
+			errList := ValidateMachinePriorityClass(machinePriorityClass)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&compute.MachinePriorityClass{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("bad name",
+			&compute.MachinePriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "foo*"}},
+			ContainElement(InvalidField("metadata.name")),
+		),
+		Entry("value too high",
+			&compute.MachinePriorityClass{Value: compute.HighestUserDefinableMachinePriority + 1},
+			ContainElement(InvalidField("value")),
+		),
+		Entry("valid value",
+			&compute.MachinePriorityClass{Value: 1000},
+			Not(ContainElement(InvalidField("value"))),
+		),
+	)
+
+	DescribeTable("ValidateMachinePriorityClassUpdate",
+		func(newMachinePriorityClass, oldMachinePriorityClass *compute.MachinePriorityClass, match types.GomegaMatcher) {
+			errList := ValidateMachinePriorityClassUpdate(newMachinePriorityClass, oldMachinePriorityClass)
+			Expect(errList).To(match)
+		},
+		Entry("immutable value",
+			&compute.MachinePriorityClass{Value: 1},
+			&compute.MachinePriorityClass{Value: 2},
+			ContainElement(ImmutableField("value")),
+		),
+		Entry("mutable description",
+			&compute.MachinePriorityClass{Description: "foo"},
+			&compute.MachinePriorityClass{Description: "bar"},
+			Not(ContainElement(ImmutableField("description"))),
+		),
+	)
+})
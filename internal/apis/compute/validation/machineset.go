@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateMachineSet validates a MachineSet object.
+func ValidateMachineSet(machineSet *compute.MachineSet) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(machineSet, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateMachineSetSpec(&machineSet.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateMachineSetSpec(spec *compute.MachineSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.Replicas != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.Replicas), fldPath.Child("replicas"))...)
+	}
+	allErrs = append(allErrs, validateMachineTemplateSpecWithSelector(&spec.Template, spec.Selector, fldPath.Child("template"), fldPath.Child("selector"))...)
+
+	return allErrs
+}
+
+var allowedMachineTemplateObjectMetaFields = sets.New(
+	"Annotations",
+	"Labels",
+)
+
+// validateMachineTemplateSpecWithSelector validates a machine template and the selector
+// that has to select the machines created from it.
+func validateMachineTemplateSpecWithSelector(template *compute.MachineTemplateSpec, selector *metav1.LabelSelector, templatePath, selectorPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if selector == nil {
+		allErrs = append(allErrs, field.Required(selectorPath, "must specify selector"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(selector, metav1validation.LabelSelectorValidationOptions{}, selectorPath)...)
+
+		if len(selector.MatchLabels)+len(selector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Invalid(selectorPath, selector, "empty selector is not valid"))
+		} else if sel, err := metav1.LabelSelectorAsSelector(selector); err == nil && !sel.Matches(labels.Set(template.Labels)) {
+			allErrs = append(allErrs, field.Invalid(templatePath.Child("metadata", "labels"), template.Labels, "selector does not match template labels"))
+		}
+	}
+
+	metadataPath := templatePath.Child("metadata")
+	allErrs = append(allErrs, metav1validation.ValidateLabels(template.Labels, metadataPath.Child("labels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(template.Annotations, metadataPath.Child("annotations"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateFieldAllowList(template.ObjectMeta, allowedMachineTemplateObjectMetaFields, "cannot be set for a machine template", metadataPath)...)
+
+	allErrs = append(allErrs, validateMachineSpec(&template.Spec, templatePath.Child("spec"))...)
+
+	return allErrs
+}
+
+// ValidateMachineSetUpdate validates a MachineSet object before an update.
+func ValidateMachineSetUpdate(newMachineSet, oldMachineSet *compute.MachineSet) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineSet, oldMachineSet, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newMachineSet.Spec.Selector, oldMachineSet.Spec.Selector, field.NewPath("spec", "selector"))...)
+	allErrs = append(allErrs, ValidateMachineSet(newMachineSet)...)
+
+	return allErrs
+}
+
+// ValidateMachineSetStatusUpdate validates a MachineSet status before an update.
+func ValidateMachineSetStatusUpdate(newMachineSet, oldMachineSet *compute.MachineSet) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newMachineSet, oldMachineSet, field.NewPath("metadata"))...)
+
+	statusPath := field.NewPath("status")
+	status := &newMachineSet.Status
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.Replicas), statusPath.Child("replicas"))...)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(status.ReadyReplicas), statusPath.Child("readyReplicas"))...)
+
+	return allErrs
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("MachineSet", func() {
+	DescribeTable("ValidateMachineSet",
+		func(machineSet *compute.MachineSet, match types.GomegaMatcher) {
+			errList := ValidateMachineSet(machineSet)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&compute.MachineSet{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&compute.MachineSet{},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("missing selector",
+			&compute.MachineSet{},
+			ContainElement(RequiredField("spec.selector")),
+		),
+		Entry("empty selector",
+			&compute.MachineSet{
+				Spec: compute.MachineSetSpec{
+					Selector: &metav1.LabelSelector{},
+				},
+			},
+			ContainElement(InvalidField("spec.selector")),
+		),
+		Entry("selector not matching template labels",
+			&compute.MachineSet{
+				Spec: compute.MachineSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+					Template: compute.MachineTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "bar"}},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.template.metadata.labels")),
+		),
+		Entry("selector matching template labels",
+			&compute.MachineSet{
+				Spec: compute.MachineSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+					Template: compute.MachineTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "foo"}},
+					},
+				},
+			},
+			Not(ContainElement(InvalidField("spec.template.metadata.labels"))),
+		),
+		Entry("template with name",
+			&compute.MachineSet{
+				Spec: compute.MachineSetSpec{
+					Template: compute.MachineTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.template.metadata.name")),
+		),
+		Entry("template without machine class ref",
+			&compute.MachineSet{},
+			ContainElement(RequiredField("spec.template.spec.machineClassRef")),
+		),
+		Entry("negative replicas",
+			&compute.MachineSet{
+				Spec: compute.MachineSetSpec{
+					Replicas: ptr.To[int32](-1),
+				},
+			},
+			ContainElement(InvalidField("spec.replicas")),
+		),
+		Entry("valid machine set",
+			&compute.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: compute.MachineSetSpec{
+					Replicas: ptr.To[int32](2),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+					Template: compute.MachineTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "foo"}},
+						Spec: compute.MachineSpec{
+							MachineClassRef: corev1.LocalObjectReference{Name: "foo"},
+							Power:           compute.PowerOn,
+						},
+					},
+				},
+			},
+			BeEmpty(),
+		),
+	)
+
+	DescribeTable("ValidateMachineSetUpdate",
+		func(newMachineSet, oldMachineSet *compute.MachineSet, match types.GomegaMatcher) {
+			errList := ValidateMachineSetUpdate(newMachineSet, oldMachineSet)
+			Expect(errList).To(match)
+		},
+		Entry("immutable selector",
+			&compute.MachineSet{
+				Spec: compute.MachineSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				},
+			},
+			&compute.MachineSet{
+				Spec: compute.MachineSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "bar"}},
+				},
+			},
+			ContainElement(ImmutableField("spec.selector")),
+		),
+	)
+})
@@ -23,6 +23,8 @@ type BucketPoolSpec struct {
 type BucketPoolStatus struct {
 	// State represents the infrastructure state of a BucketPool.
 	State BucketPoolState
+	// Conditions are the conditions of a BucketPool.
+	Conditions []BucketPoolCondition
 	// AvailableBucketClasses list the references of any supported BucketClass of this pool
 	AvailableBucketClasses []corev1.LocalObjectReference
 }
@@ -35,6 +37,25 @@ const (
 	BucketPoolStateUnavailable BucketPoolState = "Unavailable"
 )
 
+// BucketPoolConditionType is a type a BucketPoolCondition can have.
+type BucketPoolConditionType string
+
+// BucketPoolCondition is one of the conditions of a BucketPool.
+type BucketPoolCondition struct {
+	// Type is the type of the condition.
+	Type BucketPoolConditionType
+	// Status is the status of the condition.
+	Status corev1.ConditionStatus
+	// Reason is a machine-readable indication of why the condition is in a certain state.
+	Reason string
+	// Message is a human-readable explanation of why the condition has a certain reason / state.
+	Message string
+	// ObservedGeneration represents the .metadata.generation that the condition was set based upon.
+	ObservedGeneration int64
+	// LastTransitionTime is the last time the status of a condition has transitioned from one state to another.
+	LastTransitionTime metav1.Time
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +genclient
 // +genclient:nonNamespaced
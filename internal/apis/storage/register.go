@@ -39,6 +39,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VolumeList{},
 		&VolumeSnapshot{},
 		&VolumeSnapshotList{},
+		&VolumeBackup{},
+		&VolumeBackupList{},
+		&VolumeSnapshotSchedule{},
+		&VolumeSnapshotScheduleList{},
+		&VolumeSnapshotGroup{},
+		&VolumeSnapshotGroupList{},
+		&VolumeSnapshotGroupRestore{},
+		&VolumeSnapshotGroupRestoreList{},
 		&BucketClass{},
 		&BucketClassList{},
 		&BucketPool{},
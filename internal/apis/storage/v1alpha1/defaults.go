@@ -4,7 +4,10 @@
 package v1alpha1
 
 import (
+	"time"
+
 	"github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -24,6 +27,12 @@ func SetDefaults_VolumeSnapshotStatus(status *v1alpha1.VolumeSnapshotStatus) {
 	}
 }
 
+func SetDefaults_VolumeBackupStatus(status *v1alpha1.VolumeBackupStatus) {
+	if status.State == "" {
+		status.State = v1alpha1.VolumeBackupStatePending
+	}
+}
+
 func SetDefaults_BucketStatus(status *v1alpha1.BucketStatus) {
 	if status.State == "" {
 		status.State = v1alpha1.BucketStatePending
@@ -35,3 +44,21 @@ func SetDefaults_VolumeClass(volumeClass *v1alpha1.VolumeClass) {
 		volumeClass.ResizePolicy = v1alpha1.ResizePolicyStatic
 	}
 }
+
+func SetDefaults_VolumeSnapshotGroupSpec(spec *v1alpha1.VolumeSnapshotGroupSpec) {
+	if spec.FreezeIO && spec.FreezeTimeout == nil {
+		spec.FreezeTimeout = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+func SetDefaults_VolumeSnapshotGroupStatus(status *v1alpha1.VolumeSnapshotGroupStatus) {
+	if status.State == "" {
+		status.State = v1alpha1.VolumeSnapshotGroupStatePending
+	}
+}
+
+func SetDefaults_VolumeSnapshotGroupRestoreStatus(status *v1alpha1.VolumeSnapshotGroupRestoreStatus) {
+	if status.State == "" {
+		status.State = v1alpha1.VolumeSnapshotGroupRestoreStatePending
+	}
+}
@@ -4,6 +4,8 @@
 package v1alpha1_test
 
 import (
+	"time"
+
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	. "github.com/ironcore-dev/ironcore/internal/apis/storage/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -22,4 +24,14 @@ var _ = Describe("Defaults", func() {
 		SetDefaults_VolumeClass(class)
 		Expect(class.ResizePolicy).To(Equal(storagev1alpha1.ResizePolicyStatic))
 	})
+
+	It("Should default the VolumeSnapshotGroup freeze timeout if I/O is frozen", func() {
+		spec := &storagev1alpha1.VolumeSnapshotGroupSpec{FreezeIO: true}
+		SetDefaults_VolumeSnapshotGroupSpec(spec)
+		Expect(spec.FreezeTimeout).To(Equal(&metav1.Duration{Duration: 30 * time.Second}))
+
+		spec = &storagev1alpha1.VolumeSnapshotGroupSpec{}
+		SetDefaults_VolumeSnapshotGroupSpec(spec)
+		Expect(spec.FreezeTimeout).To(BeNil())
+	})
 })
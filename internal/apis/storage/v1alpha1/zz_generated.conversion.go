@@ -100,6 +100,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.BucketPoolCondition)(nil), (*storage.BucketPoolCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BucketPoolCondition_To_storage_BucketPoolCondition(a.(*storagev1alpha1.BucketPoolCondition), b.(*storage.BucketPoolCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.BucketPoolCondition)(nil), (*storagev1alpha1.BucketPoolCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_BucketPoolCondition_To_v1alpha1_BucketPoolCondition(a.(*storage.BucketPoolCondition), b.(*storagev1alpha1.BucketPoolCondition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.BucketPoolList)(nil), (*storage.BucketPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BucketPoolList_To_storage_BucketPoolList(a.(*storagev1alpha1.BucketPoolList), b.(*storage.BucketPoolList), scope)
 	}); err != nil {
@@ -190,6 +200,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeBackup)(nil), (*storage.VolumeBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeBackup_To_storage_VolumeBackup(a.(*storagev1alpha1.VolumeBackup), b.(*storage.VolumeBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeBackup)(nil), (*storagev1alpha1.VolumeBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeBackup_To_v1alpha1_VolumeBackup(a.(*storage.VolumeBackup), b.(*storagev1alpha1.VolumeBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeBackupList)(nil), (*storage.VolumeBackupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeBackupList_To_storage_VolumeBackupList(a.(*storagev1alpha1.VolumeBackupList), b.(*storage.VolumeBackupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeBackupList)(nil), (*storagev1alpha1.VolumeBackupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeBackupList_To_v1alpha1_VolumeBackupList(a.(*storage.VolumeBackupList), b.(*storagev1alpha1.VolumeBackupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeBackupSpec)(nil), (*storage.VolumeBackupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeBackupSpec_To_storage_VolumeBackupSpec(a.(*storagev1alpha1.VolumeBackupSpec), b.(*storage.VolumeBackupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeBackupSpec)(nil), (*storagev1alpha1.VolumeBackupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeBackupSpec_To_v1alpha1_VolumeBackupSpec(a.(*storage.VolumeBackupSpec), b.(*storagev1alpha1.VolumeBackupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeBackupStatus)(nil), (*storage.VolumeBackupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeBackupStatus_To_storage_VolumeBackupStatus(a.(*storagev1alpha1.VolumeBackupStatus), b.(*storage.VolumeBackupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeBackupStatus)(nil), (*storagev1alpha1.VolumeBackupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeBackupStatus_To_v1alpha1_VolumeBackupStatus(a.(*storage.VolumeBackupStatus), b.(*storagev1alpha1.VolumeBackupStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeClass)(nil), (*storage.VolumeClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VolumeClass_To_storage_VolumeClass(a.(*storagev1alpha1.VolumeClass), b.(*storage.VolumeClass), scope)
 	}); err != nil {
@@ -300,6 +350,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeRevert)(nil), (*storage.VolumeRevert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeRevert_To_storage_VolumeRevert(a.(*storagev1alpha1.VolumeRevert), b.(*storage.VolumeRevert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeRevert)(nil), (*storagev1alpha1.VolumeRevert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeRevert_To_v1alpha1_VolumeRevert(a.(*storage.VolumeRevert), b.(*storagev1alpha1.VolumeRevert), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshot)(nil), (*storage.VolumeSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VolumeSnapshot_To_storage_VolumeSnapshot(a.(*storagev1alpha1.VolumeSnapshot), b.(*storage.VolumeSnapshot), scope)
 	}); err != nil {
@@ -310,6 +370,106 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroup)(nil), (*storage.VolumeSnapshotGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroup_To_storage_VolumeSnapshotGroup(a.(*storagev1alpha1.VolumeSnapshotGroup), b.(*storage.VolumeSnapshotGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroup)(nil), (*storagev1alpha1.VolumeSnapshotGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroup_To_v1alpha1_VolumeSnapshotGroup(a.(*storage.VolumeSnapshotGroup), b.(*storagev1alpha1.VolumeSnapshotGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupList)(nil), (*storage.VolumeSnapshotGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupList_To_storage_VolumeSnapshotGroupList(a.(*storagev1alpha1.VolumeSnapshotGroupList), b.(*storage.VolumeSnapshotGroupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupList)(nil), (*storagev1alpha1.VolumeSnapshotGroupList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupList_To_v1alpha1_VolumeSnapshotGroupList(a.(*storage.VolumeSnapshotGroupList), b.(*storagev1alpha1.VolumeSnapshotGroupList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupRestore)(nil), (*storage.VolumeSnapshotGroupRestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupRestore_To_storage_VolumeSnapshotGroupRestore(a.(*storagev1alpha1.VolumeSnapshotGroupRestore), b.(*storage.VolumeSnapshotGroupRestore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupRestore)(nil), (*storagev1alpha1.VolumeSnapshotGroupRestore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupRestore_To_v1alpha1_VolumeSnapshotGroupRestore(a.(*storage.VolumeSnapshotGroupRestore), b.(*storagev1alpha1.VolumeSnapshotGroupRestore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupRestoreList)(nil), (*storage.VolumeSnapshotGroupRestoreList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupRestoreList_To_storage_VolumeSnapshotGroupRestoreList(a.(*storagev1alpha1.VolumeSnapshotGroupRestoreList), b.(*storage.VolumeSnapshotGroupRestoreList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupRestoreList)(nil), (*storagev1alpha1.VolumeSnapshotGroupRestoreList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupRestoreList_To_v1alpha1_VolumeSnapshotGroupRestoreList(a.(*storage.VolumeSnapshotGroupRestoreList), b.(*storagev1alpha1.VolumeSnapshotGroupRestoreList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupRestoreSpec)(nil), (*storage.VolumeSnapshotGroupRestoreSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupRestoreSpec_To_storage_VolumeSnapshotGroupRestoreSpec(a.(*storagev1alpha1.VolumeSnapshotGroupRestoreSpec), b.(*storage.VolumeSnapshotGroupRestoreSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupRestoreSpec)(nil), (*storagev1alpha1.VolumeSnapshotGroupRestoreSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupRestoreSpec_To_v1alpha1_VolumeSnapshotGroupRestoreSpec(a.(*storage.VolumeSnapshotGroupRestoreSpec), b.(*storagev1alpha1.VolumeSnapshotGroupRestoreSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupRestoreStatus)(nil), (*storage.VolumeSnapshotGroupRestoreStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupRestoreStatus_To_storage_VolumeSnapshotGroupRestoreStatus(a.(*storagev1alpha1.VolumeSnapshotGroupRestoreStatus), b.(*storage.VolumeSnapshotGroupRestoreStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupRestoreStatus)(nil), (*storagev1alpha1.VolumeSnapshotGroupRestoreStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupRestoreStatus_To_v1alpha1_VolumeSnapshotGroupRestoreStatus(a.(*storage.VolumeSnapshotGroupRestoreStatus), b.(*storagev1alpha1.VolumeSnapshotGroupRestoreStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupRestoreVolume)(nil), (*storage.VolumeSnapshotGroupRestoreVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupRestoreVolume_To_storage_VolumeSnapshotGroupRestoreVolume(a.(*storagev1alpha1.VolumeSnapshotGroupRestoreVolume), b.(*storage.VolumeSnapshotGroupRestoreVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupRestoreVolume)(nil), (*storagev1alpha1.VolumeSnapshotGroupRestoreVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupRestoreVolume_To_v1alpha1_VolumeSnapshotGroupRestoreVolume(a.(*storage.VolumeSnapshotGroupRestoreVolume), b.(*storagev1alpha1.VolumeSnapshotGroupRestoreVolume), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupSnapshot)(nil), (*storage.VolumeSnapshotGroupSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupSnapshot_To_storage_VolumeSnapshotGroupSnapshot(a.(*storagev1alpha1.VolumeSnapshotGroupSnapshot), b.(*storage.VolumeSnapshotGroupSnapshot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupSnapshot)(nil), (*storagev1alpha1.VolumeSnapshotGroupSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupSnapshot_To_v1alpha1_VolumeSnapshotGroupSnapshot(a.(*storage.VolumeSnapshotGroupSnapshot), b.(*storagev1alpha1.VolumeSnapshotGroupSnapshot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupSpec)(nil), (*storage.VolumeSnapshotGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupSpec_To_storage_VolumeSnapshotGroupSpec(a.(*storagev1alpha1.VolumeSnapshotGroupSpec), b.(*storage.VolumeSnapshotGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupSpec)(nil), (*storagev1alpha1.VolumeSnapshotGroupSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupSpec_To_v1alpha1_VolumeSnapshotGroupSpec(a.(*storage.VolumeSnapshotGroupSpec), b.(*storagev1alpha1.VolumeSnapshotGroupSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotGroupStatus)(nil), (*storage.VolumeSnapshotGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotGroupStatus_To_storage_VolumeSnapshotGroupStatus(a.(*storagev1alpha1.VolumeSnapshotGroupStatus), b.(*storage.VolumeSnapshotGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotGroupStatus)(nil), (*storagev1alpha1.VolumeSnapshotGroupStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotGroupStatus_To_v1alpha1_VolumeSnapshotGroupStatus(a.(*storage.VolumeSnapshotGroupStatus), b.(*storagev1alpha1.VolumeSnapshotGroupStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotList)(nil), (*storage.VolumeSnapshotList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VolumeSnapshotList_To_storage_VolumeSnapshotList(a.(*storagev1alpha1.VolumeSnapshotList), b.(*storage.VolumeSnapshotList), scope)
 	}); err != nil {
@@ -320,6 +480,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotRetention)(nil), (*storage.VolumeSnapshotRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotRetention_To_storage_VolumeSnapshotRetention(a.(*storagev1alpha1.VolumeSnapshotRetention), b.(*storage.VolumeSnapshotRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotRetention)(nil), (*storagev1alpha1.VolumeSnapshotRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotRetention_To_v1alpha1_VolumeSnapshotRetention(a.(*storage.VolumeSnapshotRetention), b.(*storagev1alpha1.VolumeSnapshotRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotSchedule)(nil), (*storage.VolumeSnapshotSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotSchedule_To_storage_VolumeSnapshotSchedule(a.(*storagev1alpha1.VolumeSnapshotSchedule), b.(*storage.VolumeSnapshotSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotSchedule)(nil), (*storagev1alpha1.VolumeSnapshotSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotSchedule_To_v1alpha1_VolumeSnapshotSchedule(a.(*storage.VolumeSnapshotSchedule), b.(*storagev1alpha1.VolumeSnapshotSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotScheduleList)(nil), (*storage.VolumeSnapshotScheduleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotScheduleList_To_storage_VolumeSnapshotScheduleList(a.(*storagev1alpha1.VolumeSnapshotScheduleList), b.(*storage.VolumeSnapshotScheduleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotScheduleList)(nil), (*storagev1alpha1.VolumeSnapshotScheduleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotScheduleList_To_v1alpha1_VolumeSnapshotScheduleList(a.(*storage.VolumeSnapshotScheduleList), b.(*storagev1alpha1.VolumeSnapshotScheduleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotScheduleSpec)(nil), (*storage.VolumeSnapshotScheduleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotScheduleSpec_To_storage_VolumeSnapshotScheduleSpec(a.(*storagev1alpha1.VolumeSnapshotScheduleSpec), b.(*storage.VolumeSnapshotScheduleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotScheduleSpec)(nil), (*storagev1alpha1.VolumeSnapshotScheduleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotScheduleSpec_To_v1alpha1_VolumeSnapshotScheduleSpec(a.(*storage.VolumeSnapshotScheduleSpec), b.(*storagev1alpha1.VolumeSnapshotScheduleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotScheduleStatus)(nil), (*storage.VolumeSnapshotScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotScheduleStatus_To_storage_VolumeSnapshotScheduleStatus(a.(*storagev1alpha1.VolumeSnapshotScheduleStatus), b.(*storage.VolumeSnapshotScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*storage.VolumeSnapshotScheduleStatus)(nil), (*storagev1alpha1.VolumeSnapshotScheduleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_storage_VolumeSnapshotScheduleStatus_To_v1alpha1_VolumeSnapshotScheduleStatus(a.(*storage.VolumeSnapshotScheduleStatus), b.(*storagev1alpha1.VolumeSnapshotScheduleStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*storagev1alpha1.VolumeSnapshotSpec)(nil), (*storage.VolumeSnapshotSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VolumeSnapshotSpec_To_storage_VolumeSnapshotSpec(a.(*storagev1alpha1.VolumeSnapshotSpec), b.(*storage.VolumeSnapshotSpec), scope)
 	}); err != nil {
@@ -555,6 +765,36 @@ func Convert_storage_BucketPool_To_v1alpha1_BucketPool(in *storage.BucketPool, o
 	return autoConvert_storage_BucketPool_To_v1alpha1_BucketPool(in, out, s)
 }
 
+func autoConvert_v1alpha1_BucketPoolCondition_To_storage_BucketPoolCondition(in *storagev1alpha1.BucketPoolCondition, out *storage.BucketPoolCondition, s conversion.Scope) error {
+	out.Type = storage.BucketPoolConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1alpha1_BucketPoolCondition_To_storage_BucketPoolCondition is an autogenerated conversion function.
+func Convert_v1alpha1_BucketPoolCondition_To_storage_BucketPoolCondition(in *storagev1alpha1.BucketPoolCondition, out *storage.BucketPoolCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BucketPoolCondition_To_storage_BucketPoolCondition(in, out, s)
+}
+
+func autoConvert_storage_BucketPoolCondition_To_v1alpha1_BucketPoolCondition(in *storage.BucketPoolCondition, out *storagev1alpha1.BucketPoolCondition, s conversion.Scope) error {
+	out.Type = storagev1alpha1.BucketPoolConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_storage_BucketPoolCondition_To_v1alpha1_BucketPoolCondition is an autogenerated conversion function.
+func Convert_storage_BucketPoolCondition_To_v1alpha1_BucketPoolCondition(in *storage.BucketPoolCondition, out *storagev1alpha1.BucketPoolCondition, s conversion.Scope) error {
+	return autoConvert_storage_BucketPoolCondition_To_v1alpha1_BucketPoolCondition(in, out, s)
+}
+
 func autoConvert_v1alpha1_BucketPoolList_To_storage_BucketPoolList(in *storagev1alpha1.BucketPoolList, out *storage.BucketPoolList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]storage.BucketPool)(unsafe.Pointer(&in.Items))
@@ -601,6 +841,7 @@ func Convert_storage_BucketPoolSpec_To_v1alpha1_BucketPoolSpec(in *storage.Bucke
 
 func autoConvert_v1alpha1_BucketPoolStatus_To_storage_BucketPoolStatus(in *storagev1alpha1.BucketPoolStatus, out *storage.BucketPoolStatus, s conversion.Scope) error {
 	out.State = storage.BucketPoolState(in.State)
+	out.Conditions = *(*[]storage.BucketPoolCondition)(unsafe.Pointer(&in.Conditions))
 	out.AvailableBucketClasses = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.AvailableBucketClasses))
 	return nil
 }
@@ -612,6 +853,7 @@ func Convert_v1alpha1_BucketPoolStatus_To_storage_BucketPoolStatus(in *storagev1
 
 func autoConvert_storage_BucketPoolStatus_To_v1alpha1_BucketPoolStatus(in *storage.BucketPoolStatus, out *storagev1alpha1.BucketPoolStatus, s conversion.Scope) error {
 	out.State = storagev1alpha1.BucketPoolState(in.State)
+	out.Conditions = *(*[]storagev1alpha1.BucketPoolCondition)(unsafe.Pointer(&in.Conditions))
 	out.AvailableBucketClasses = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.AvailableBucketClasses))
 	return nil
 }
@@ -781,6 +1023,114 @@ func Convert_storage_VolumeAccess_To_v1alpha1_VolumeAccess(in *storage.VolumeAcc
 	return autoConvert_storage_VolumeAccess_To_v1alpha1_VolumeAccess(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeBackup_To_storage_VolumeBackup(in *storagev1alpha1.VolumeBackup, out *storage.VolumeBackup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VolumeBackupSpec_To_storage_VolumeBackupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VolumeBackupStatus_To_storage_VolumeBackupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VolumeBackup_To_storage_VolumeBackup is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeBackup_To_storage_VolumeBackup(in *storagev1alpha1.VolumeBackup, out *storage.VolumeBackup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeBackup_To_storage_VolumeBackup(in, out, s)
+}
+
+func autoConvert_storage_VolumeBackup_To_v1alpha1_VolumeBackup(in *storage.VolumeBackup, out *storagev1alpha1.VolumeBackup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_storage_VolumeBackupSpec_To_v1alpha1_VolumeBackupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_storage_VolumeBackupStatus_To_v1alpha1_VolumeBackupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_storage_VolumeBackup_To_v1alpha1_VolumeBackup is an autogenerated conversion function.
+func Convert_storage_VolumeBackup_To_v1alpha1_VolumeBackup(in *storage.VolumeBackup, out *storagev1alpha1.VolumeBackup, s conversion.Scope) error {
+	return autoConvert_storage_VolumeBackup_To_v1alpha1_VolumeBackup(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeBackupList_To_storage_VolumeBackupList(in *storagev1alpha1.VolumeBackupList, out *storage.VolumeBackupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storage.VolumeBackup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeBackupList_To_storage_VolumeBackupList is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeBackupList_To_storage_VolumeBackupList(in *storagev1alpha1.VolumeBackupList, out *storage.VolumeBackupList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeBackupList_To_storage_VolumeBackupList(in, out, s)
+}
+
+func autoConvert_storage_VolumeBackupList_To_v1alpha1_VolumeBackupList(in *storage.VolumeBackupList, out *storagev1alpha1.VolumeBackupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storagev1alpha1.VolumeBackup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_storage_VolumeBackupList_To_v1alpha1_VolumeBackupList is an autogenerated conversion function.
+func Convert_storage_VolumeBackupList_To_v1alpha1_VolumeBackupList(in *storage.VolumeBackupList, out *storagev1alpha1.VolumeBackupList, s conversion.Scope) error {
+	return autoConvert_storage_VolumeBackupList_To_v1alpha1_VolumeBackupList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeBackupSpec_To_storage_VolumeBackupSpec(in *storagev1alpha1.VolumeBackupSpec, out *storage.VolumeBackupSpec, s conversion.Scope) error {
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	out.BucketRef = in.BucketRef
+	return nil
+}
+
+// Convert_v1alpha1_VolumeBackupSpec_To_storage_VolumeBackupSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeBackupSpec_To_storage_VolumeBackupSpec(in *storagev1alpha1.VolumeBackupSpec, out *storage.VolumeBackupSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeBackupSpec_To_storage_VolumeBackupSpec(in, out, s)
+}
+
+func autoConvert_storage_VolumeBackupSpec_To_v1alpha1_VolumeBackupSpec(in *storage.VolumeBackupSpec, out *storagev1alpha1.VolumeBackupSpec, s conversion.Scope) error {
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	out.BucketRef = in.BucketRef
+	return nil
+}
+
+// Convert_storage_VolumeBackupSpec_To_v1alpha1_VolumeBackupSpec is an autogenerated conversion function.
+func Convert_storage_VolumeBackupSpec_To_v1alpha1_VolumeBackupSpec(in *storage.VolumeBackupSpec, out *storagev1alpha1.VolumeBackupSpec, s conversion.Scope) error {
+	return autoConvert_storage_VolumeBackupSpec_To_v1alpha1_VolumeBackupSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeBackupStatus_To_storage_VolumeBackupStatus(in *storagev1alpha1.VolumeBackupStatus, out *storage.VolumeBackupStatus, s conversion.Scope) error {
+	out.State = storage.VolumeBackupState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Message = in.Message
+	out.ExportID = in.ExportID
+	out.ObjectKey = in.ObjectKey
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.VolumeStorage = (*resource.Quantity)(unsafe.Pointer(in.VolumeStorage))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeBackupStatus_To_storage_VolumeBackupStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeBackupStatus_To_storage_VolumeBackupStatus(in *storagev1alpha1.VolumeBackupStatus, out *storage.VolumeBackupStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeBackupStatus_To_storage_VolumeBackupStatus(in, out, s)
+}
+
+func autoConvert_storage_VolumeBackupStatus_To_v1alpha1_VolumeBackupStatus(in *storage.VolumeBackupStatus, out *storagev1alpha1.VolumeBackupStatus, s conversion.Scope) error {
+	out.State = storagev1alpha1.VolumeBackupState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Message = in.Message
+	out.ExportID = in.ExportID
+	out.ObjectKey = in.ObjectKey
+	out.Size = (*resource.Quantity)(unsafe.Pointer(in.Size))
+	out.VolumeStorage = (*resource.Quantity)(unsafe.Pointer(in.VolumeStorage))
+	return nil
+}
+
+// Convert_storage_VolumeBackupStatus_To_v1alpha1_VolumeBackupStatus is an autogenerated conversion function.
+func Convert_storage_VolumeBackupStatus_To_v1alpha1_VolumeBackupStatus(in *storage.VolumeBackupStatus, out *storagev1alpha1.VolumeBackupStatus, s conversion.Scope) error {
+	return autoConvert_storage_VolumeBackupStatus_To_v1alpha1_VolumeBackupStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_VolumeClass_To_storage_VolumeClass(in *storagev1alpha1.VolumeClass, out *storage.VolumeClass, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Capabilities = *(*core.ResourceList)(unsafe.Pointer(&in.Capabilities))
@@ -859,6 +1209,8 @@ func Convert_storage_VolumeCondition_To_v1alpha1_VolumeCondition(in *storage.Vol
 
 func autoConvert_v1alpha1_VolumeDataSource_To_storage_VolumeDataSource(in *storagev1alpha1.VolumeDataSource, out *storage.VolumeDataSource, s conversion.Scope) error {
 	out.VolumeSnapshotRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeSnapshotRef))
+	out.VolumeRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeRef))
+	out.VolumeBackupRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeBackupRef))
 	out.OSImage = (*storage.OSDataSource)(unsafe.Pointer(in.OSImage))
 	return nil
 }
@@ -870,6 +1222,8 @@ func Convert_v1alpha1_VolumeDataSource_To_storage_VolumeDataSource(in *storagev1
 
 func autoConvert_storage_VolumeDataSource_To_v1alpha1_VolumeDataSource(in *storage.VolumeDataSource, out *storagev1alpha1.VolumeDataSource, s conversion.Scope) error {
 	out.VolumeSnapshotRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeSnapshotRef))
+	out.VolumeRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeRef))
+	out.VolumeBackupRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeBackupRef))
 	out.OSImage = (*storagev1alpha1.OSDataSource)(unsafe.Pointer(in.OSImage))
 	return nil
 }
@@ -1055,6 +1409,28 @@ func Convert_storage_VolumePoolStatus_To_v1alpha1_VolumePoolStatus(in *storage.V
 	return autoConvert_storage_VolumePoolStatus_To_v1alpha1_VolumePoolStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeRevert_To_storage_VolumeRevert(in *storagev1alpha1.VolumeRevert, out *storage.VolumeRevert, s conversion.Scope) error {
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	out.RequestedAt = in.RequestedAt
+	return nil
+}
+
+// Convert_v1alpha1_VolumeRevert_To_storage_VolumeRevert is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeRevert_To_storage_VolumeRevert(in *storagev1alpha1.VolumeRevert, out *storage.VolumeRevert, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeRevert_To_storage_VolumeRevert(in, out, s)
+}
+
+func autoConvert_storage_VolumeRevert_To_v1alpha1_VolumeRevert(in *storage.VolumeRevert, out *storagev1alpha1.VolumeRevert, s conversion.Scope) error {
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	out.RequestedAt = in.RequestedAt
+	return nil
+}
+
+// Convert_storage_VolumeRevert_To_v1alpha1_VolumeRevert is an autogenerated conversion function.
+func Convert_storage_VolumeRevert_To_v1alpha1_VolumeRevert(in *storage.VolumeRevert, out *storagev1alpha1.VolumeRevert, s conversion.Scope) error {
+	return autoConvert_storage_VolumeRevert_To_v1alpha1_VolumeRevert(in, out, s)
+}
+
 func autoConvert_v1alpha1_VolumeSnapshot_To_storage_VolumeSnapshot(in *storagev1alpha1.VolumeSnapshot, out *storage.VolumeSnapshot, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_VolumeSnapshotSpec_To_storage_VolumeSnapshotSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1087,6 +1463,262 @@ func Convert_storage_VolumeSnapshot_To_v1alpha1_VolumeSnapshot(in *storage.Volum
 	return autoConvert_storage_VolumeSnapshot_To_v1alpha1_VolumeSnapshot(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeSnapshotGroup_To_storage_VolumeSnapshotGroup(in *storagev1alpha1.VolumeSnapshotGroup, out *storage.VolumeSnapshotGroup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VolumeSnapshotGroupSpec_To_storage_VolumeSnapshotGroupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VolumeSnapshotGroupStatus_To_storage_VolumeSnapshotGroupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroup_To_storage_VolumeSnapshotGroup is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroup_To_storage_VolumeSnapshotGroup(in *storagev1alpha1.VolumeSnapshotGroup, out *storage.VolumeSnapshotGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroup_To_storage_VolumeSnapshotGroup(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroup_To_v1alpha1_VolumeSnapshotGroup(in *storage.VolumeSnapshotGroup, out *storagev1alpha1.VolumeSnapshotGroup, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_storage_VolumeSnapshotGroupSpec_To_v1alpha1_VolumeSnapshotGroupSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_storage_VolumeSnapshotGroupStatus_To_v1alpha1_VolumeSnapshotGroupStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroup_To_v1alpha1_VolumeSnapshotGroup is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroup_To_v1alpha1_VolumeSnapshotGroup(in *storage.VolumeSnapshotGroup, out *storagev1alpha1.VolumeSnapshotGroup, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroup_To_v1alpha1_VolumeSnapshotGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupList_To_storage_VolumeSnapshotGroupList(in *storagev1alpha1.VolumeSnapshotGroupList, out *storage.VolumeSnapshotGroupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storage.VolumeSnapshotGroup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupList_To_storage_VolumeSnapshotGroupList is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupList_To_storage_VolumeSnapshotGroupList(in *storagev1alpha1.VolumeSnapshotGroupList, out *storage.VolumeSnapshotGroupList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupList_To_storage_VolumeSnapshotGroupList(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupList_To_v1alpha1_VolumeSnapshotGroupList(in *storage.VolumeSnapshotGroupList, out *storagev1alpha1.VolumeSnapshotGroupList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storagev1alpha1.VolumeSnapshotGroup)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupList_To_v1alpha1_VolumeSnapshotGroupList is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupList_To_v1alpha1_VolumeSnapshotGroupList(in *storage.VolumeSnapshotGroupList, out *storagev1alpha1.VolumeSnapshotGroupList, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupList_To_v1alpha1_VolumeSnapshotGroupList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupRestore_To_storage_VolumeSnapshotGroupRestore(in *storagev1alpha1.VolumeSnapshotGroupRestore, out *storage.VolumeSnapshotGroupRestore, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VolumeSnapshotGroupRestoreSpec_To_storage_VolumeSnapshotGroupRestoreSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VolumeSnapshotGroupRestoreStatus_To_storage_VolumeSnapshotGroupRestoreStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupRestore_To_storage_VolumeSnapshotGroupRestore is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupRestore_To_storage_VolumeSnapshotGroupRestore(in *storagev1alpha1.VolumeSnapshotGroupRestore, out *storage.VolumeSnapshotGroupRestore, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupRestore_To_storage_VolumeSnapshotGroupRestore(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupRestore_To_v1alpha1_VolumeSnapshotGroupRestore(in *storage.VolumeSnapshotGroupRestore, out *storagev1alpha1.VolumeSnapshotGroupRestore, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_storage_VolumeSnapshotGroupRestoreSpec_To_v1alpha1_VolumeSnapshotGroupRestoreSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_storage_VolumeSnapshotGroupRestoreStatus_To_v1alpha1_VolumeSnapshotGroupRestoreStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupRestore_To_v1alpha1_VolumeSnapshotGroupRestore is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupRestore_To_v1alpha1_VolumeSnapshotGroupRestore(in *storage.VolumeSnapshotGroupRestore, out *storagev1alpha1.VolumeSnapshotGroupRestore, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupRestore_To_v1alpha1_VolumeSnapshotGroupRestore(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupRestoreList_To_storage_VolumeSnapshotGroupRestoreList(in *storagev1alpha1.VolumeSnapshotGroupRestoreList, out *storage.VolumeSnapshotGroupRestoreList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storage.VolumeSnapshotGroupRestore)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupRestoreList_To_storage_VolumeSnapshotGroupRestoreList is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupRestoreList_To_storage_VolumeSnapshotGroupRestoreList(in *storagev1alpha1.VolumeSnapshotGroupRestoreList, out *storage.VolumeSnapshotGroupRestoreList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupRestoreList_To_storage_VolumeSnapshotGroupRestoreList(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupRestoreList_To_v1alpha1_VolumeSnapshotGroupRestoreList(in *storage.VolumeSnapshotGroupRestoreList, out *storagev1alpha1.VolumeSnapshotGroupRestoreList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storagev1alpha1.VolumeSnapshotGroupRestore)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupRestoreList_To_v1alpha1_VolumeSnapshotGroupRestoreList is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupRestoreList_To_v1alpha1_VolumeSnapshotGroupRestoreList(in *storage.VolumeSnapshotGroupRestoreList, out *storagev1alpha1.VolumeSnapshotGroupRestoreList, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupRestoreList_To_v1alpha1_VolumeSnapshotGroupRestoreList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupRestoreSpec_To_storage_VolumeSnapshotGroupRestoreSpec(in *storagev1alpha1.VolumeSnapshotGroupRestoreSpec, out *storage.VolumeSnapshotGroupRestoreSpec, s conversion.Scope) error {
+	out.VolumeSnapshotGroupRef = in.VolumeSnapshotGroupRef
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupRestoreSpec_To_storage_VolumeSnapshotGroupRestoreSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupRestoreSpec_To_storage_VolumeSnapshotGroupRestoreSpec(in *storagev1alpha1.VolumeSnapshotGroupRestoreSpec, out *storage.VolumeSnapshotGroupRestoreSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupRestoreSpec_To_storage_VolumeSnapshotGroupRestoreSpec(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupRestoreSpec_To_v1alpha1_VolumeSnapshotGroupRestoreSpec(in *storage.VolumeSnapshotGroupRestoreSpec, out *storagev1alpha1.VolumeSnapshotGroupRestoreSpec, s conversion.Scope) error {
+	out.VolumeSnapshotGroupRef = in.VolumeSnapshotGroupRef
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupRestoreSpec_To_v1alpha1_VolumeSnapshotGroupRestoreSpec is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupRestoreSpec_To_v1alpha1_VolumeSnapshotGroupRestoreSpec(in *storage.VolumeSnapshotGroupRestoreSpec, out *storagev1alpha1.VolumeSnapshotGroupRestoreSpec, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupRestoreSpec_To_v1alpha1_VolumeSnapshotGroupRestoreSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupRestoreStatus_To_storage_VolumeSnapshotGroupRestoreStatus(in *storagev1alpha1.VolumeSnapshotGroupRestoreStatus, out *storage.VolumeSnapshotGroupRestoreStatus, s conversion.Scope) error {
+	out.State = storage.VolumeSnapshotGroupRestoreState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Message = in.Message
+	out.Volumes = *(*[]storage.VolumeSnapshotGroupRestoreVolume)(unsafe.Pointer(&in.Volumes))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupRestoreStatus_To_storage_VolumeSnapshotGroupRestoreStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupRestoreStatus_To_storage_VolumeSnapshotGroupRestoreStatus(in *storagev1alpha1.VolumeSnapshotGroupRestoreStatus, out *storage.VolumeSnapshotGroupRestoreStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupRestoreStatus_To_storage_VolumeSnapshotGroupRestoreStatus(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupRestoreStatus_To_v1alpha1_VolumeSnapshotGroupRestoreStatus(in *storage.VolumeSnapshotGroupRestoreStatus, out *storagev1alpha1.VolumeSnapshotGroupRestoreStatus, s conversion.Scope) error {
+	out.State = storagev1alpha1.VolumeSnapshotGroupRestoreState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Message = in.Message
+	out.Volumes = *(*[]storagev1alpha1.VolumeSnapshotGroupRestoreVolume)(unsafe.Pointer(&in.Volumes))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupRestoreStatus_To_v1alpha1_VolumeSnapshotGroupRestoreStatus is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupRestoreStatus_To_v1alpha1_VolumeSnapshotGroupRestoreStatus(in *storage.VolumeSnapshotGroupRestoreStatus, out *storagev1alpha1.VolumeSnapshotGroupRestoreStatus, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupRestoreStatus_To_v1alpha1_VolumeSnapshotGroupRestoreStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupRestoreVolume_To_storage_VolumeSnapshotGroupRestoreVolume(in *storagev1alpha1.VolumeSnapshotGroupRestoreVolume, out *storage.VolumeSnapshotGroupRestoreVolume, s conversion.Scope) error {
+	out.SourceVolumeRef = in.SourceVolumeRef
+	out.VolumeRef = in.VolumeRef
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupRestoreVolume_To_storage_VolumeSnapshotGroupRestoreVolume is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupRestoreVolume_To_storage_VolumeSnapshotGroupRestoreVolume(in *storagev1alpha1.VolumeSnapshotGroupRestoreVolume, out *storage.VolumeSnapshotGroupRestoreVolume, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupRestoreVolume_To_storage_VolumeSnapshotGroupRestoreVolume(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupRestoreVolume_To_v1alpha1_VolumeSnapshotGroupRestoreVolume(in *storage.VolumeSnapshotGroupRestoreVolume, out *storagev1alpha1.VolumeSnapshotGroupRestoreVolume, s conversion.Scope) error {
+	out.SourceVolumeRef = in.SourceVolumeRef
+	out.VolumeRef = in.VolumeRef
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupRestoreVolume_To_v1alpha1_VolumeSnapshotGroupRestoreVolume is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupRestoreVolume_To_v1alpha1_VolumeSnapshotGroupRestoreVolume(in *storage.VolumeSnapshotGroupRestoreVolume, out *storagev1alpha1.VolumeSnapshotGroupRestoreVolume, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupRestoreVolume_To_v1alpha1_VolumeSnapshotGroupRestoreVolume(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupSnapshot_To_storage_VolumeSnapshotGroupSnapshot(in *storagev1alpha1.VolumeSnapshotGroupSnapshot, out *storage.VolumeSnapshotGroupSnapshot, s conversion.Scope) error {
+	out.VolumeRef = in.VolumeRef
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	out.VolumeClassRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeClassRef))
+	out.Resources = *(*core.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupSnapshot_To_storage_VolumeSnapshotGroupSnapshot is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupSnapshot_To_storage_VolumeSnapshotGroupSnapshot(in *storagev1alpha1.VolumeSnapshotGroupSnapshot, out *storage.VolumeSnapshotGroupSnapshot, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupSnapshot_To_storage_VolumeSnapshotGroupSnapshot(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupSnapshot_To_v1alpha1_VolumeSnapshotGroupSnapshot(in *storage.VolumeSnapshotGroupSnapshot, out *storagev1alpha1.VolumeSnapshotGroupSnapshot, s conversion.Scope) error {
+	out.VolumeRef = in.VolumeRef
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	out.VolumeClassRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeClassRef))
+	out.Resources = *(*corev1alpha1.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupSnapshot_To_v1alpha1_VolumeSnapshotGroupSnapshot is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupSnapshot_To_v1alpha1_VolumeSnapshotGroupSnapshot(in *storage.VolumeSnapshotGroupSnapshot, out *storagev1alpha1.VolumeSnapshotGroupSnapshot, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupSnapshot_To_v1alpha1_VolumeSnapshotGroupSnapshot(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupSpec_To_storage_VolumeSnapshotGroupSpec(in *storagev1alpha1.VolumeSnapshotGroupSpec, out *storage.VolumeSnapshotGroupSpec, s conversion.Scope) error {
+	out.MachineRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.MachineRef))
+	out.VolumeSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.VolumeSelector))
+	out.FreezeIO = in.FreezeIO
+	out.FreezeTimeout = (*metav1.Duration)(unsafe.Pointer(in.FreezeTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupSpec_To_storage_VolumeSnapshotGroupSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupSpec_To_storage_VolumeSnapshotGroupSpec(in *storagev1alpha1.VolumeSnapshotGroupSpec, out *storage.VolumeSnapshotGroupSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupSpec_To_storage_VolumeSnapshotGroupSpec(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupSpec_To_v1alpha1_VolumeSnapshotGroupSpec(in *storage.VolumeSnapshotGroupSpec, out *storagev1alpha1.VolumeSnapshotGroupSpec, s conversion.Scope) error {
+	out.MachineRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.MachineRef))
+	out.VolumeSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.VolumeSelector))
+	out.FreezeIO = in.FreezeIO
+	out.FreezeTimeout = (*metav1.Duration)(unsafe.Pointer(in.FreezeTimeout))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupSpec_To_v1alpha1_VolumeSnapshotGroupSpec is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupSpec_To_v1alpha1_VolumeSnapshotGroupSpec(in *storage.VolumeSnapshotGroupSpec, out *storagev1alpha1.VolumeSnapshotGroupSpec, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupSpec_To_v1alpha1_VolumeSnapshotGroupSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotGroupStatus_To_storage_VolumeSnapshotGroupStatus(in *storagev1alpha1.VolumeSnapshotGroupStatus, out *storage.VolumeSnapshotGroupStatus, s conversion.Scope) error {
+	out.State = storage.VolumeSnapshotGroupState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Message = in.Message
+	out.IOFreezeRequestedAt = (*metav1.Time)(unsafe.Pointer(in.IOFreezeRequestedAt))
+	out.Snapshots = *(*[]storage.VolumeSnapshotGroupSnapshot)(unsafe.Pointer(&in.Snapshots))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotGroupStatus_To_storage_VolumeSnapshotGroupStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotGroupStatus_To_storage_VolumeSnapshotGroupStatus(in *storagev1alpha1.VolumeSnapshotGroupStatus, out *storage.VolumeSnapshotGroupStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotGroupStatus_To_storage_VolumeSnapshotGroupStatus(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotGroupStatus_To_v1alpha1_VolumeSnapshotGroupStatus(in *storage.VolumeSnapshotGroupStatus, out *storagev1alpha1.VolumeSnapshotGroupStatus, s conversion.Scope) error {
+	out.State = storagev1alpha1.VolumeSnapshotGroupState(in.State)
+	out.LastStateTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastStateTransitionTime))
+	out.Message = in.Message
+	out.IOFreezeRequestedAt = (*metav1.Time)(unsafe.Pointer(in.IOFreezeRequestedAt))
+	out.Snapshots = *(*[]storagev1alpha1.VolumeSnapshotGroupSnapshot)(unsafe.Pointer(&in.Snapshots))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotGroupStatus_To_v1alpha1_VolumeSnapshotGroupStatus is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotGroupStatus_To_v1alpha1_VolumeSnapshotGroupStatus(in *storage.VolumeSnapshotGroupStatus, out *storagev1alpha1.VolumeSnapshotGroupStatus, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotGroupStatus_To_v1alpha1_VolumeSnapshotGroupStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_VolumeSnapshotList_To_storage_VolumeSnapshotList(in *storagev1alpha1.VolumeSnapshotList, out *storage.VolumeSnapshotList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]storage.VolumeSnapshot)(unsafe.Pointer(&in.Items))
@@ -1109,6 +1741,140 @@ func Convert_storage_VolumeSnapshotList_To_v1alpha1_VolumeSnapshotList(in *stora
 	return autoConvert_storage_VolumeSnapshotList_To_v1alpha1_VolumeSnapshotList(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeSnapshotRetention_To_storage_VolumeSnapshotRetention(in *storagev1alpha1.VolumeSnapshotRetention, out *storage.VolumeSnapshotRetention, s conversion.Scope) error {
+	out.KeepLast = (*int32)(unsafe.Pointer(in.KeepLast))
+	out.MaxAge = (*metav1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotRetention_To_storage_VolumeSnapshotRetention is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotRetention_To_storage_VolumeSnapshotRetention(in *storagev1alpha1.VolumeSnapshotRetention, out *storage.VolumeSnapshotRetention, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotRetention_To_storage_VolumeSnapshotRetention(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotRetention_To_v1alpha1_VolumeSnapshotRetention(in *storage.VolumeSnapshotRetention, out *storagev1alpha1.VolumeSnapshotRetention, s conversion.Scope) error {
+	out.KeepLast = (*int32)(unsafe.Pointer(in.KeepLast))
+	out.MaxAge = (*metav1.Duration)(unsafe.Pointer(in.MaxAge))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotRetention_To_v1alpha1_VolumeSnapshotRetention is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotRetention_To_v1alpha1_VolumeSnapshotRetention(in *storage.VolumeSnapshotRetention, out *storagev1alpha1.VolumeSnapshotRetention, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotRetention_To_v1alpha1_VolumeSnapshotRetention(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotSchedule_To_storage_VolumeSnapshotSchedule(in *storagev1alpha1.VolumeSnapshotSchedule, out *storage.VolumeSnapshotSchedule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VolumeSnapshotScheduleSpec_To_storage_VolumeSnapshotScheduleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VolumeSnapshotScheduleStatus_To_storage_VolumeSnapshotScheduleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotSchedule_To_storage_VolumeSnapshotSchedule is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotSchedule_To_storage_VolumeSnapshotSchedule(in *storagev1alpha1.VolumeSnapshotSchedule, out *storage.VolumeSnapshotSchedule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotSchedule_To_storage_VolumeSnapshotSchedule(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotSchedule_To_v1alpha1_VolumeSnapshotSchedule(in *storage.VolumeSnapshotSchedule, out *storagev1alpha1.VolumeSnapshotSchedule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_storage_VolumeSnapshotScheduleSpec_To_v1alpha1_VolumeSnapshotScheduleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_storage_VolumeSnapshotScheduleStatus_To_v1alpha1_VolumeSnapshotScheduleStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotSchedule_To_v1alpha1_VolumeSnapshotSchedule is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotSchedule_To_v1alpha1_VolumeSnapshotSchedule(in *storage.VolumeSnapshotSchedule, out *storagev1alpha1.VolumeSnapshotSchedule, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotSchedule_To_v1alpha1_VolumeSnapshotSchedule(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotScheduleList_To_storage_VolumeSnapshotScheduleList(in *storagev1alpha1.VolumeSnapshotScheduleList, out *storage.VolumeSnapshotScheduleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storage.VolumeSnapshotSchedule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotScheduleList_To_storage_VolumeSnapshotScheduleList is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotScheduleList_To_storage_VolumeSnapshotScheduleList(in *storagev1alpha1.VolumeSnapshotScheduleList, out *storage.VolumeSnapshotScheduleList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotScheduleList_To_storage_VolumeSnapshotScheduleList(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotScheduleList_To_v1alpha1_VolumeSnapshotScheduleList(in *storage.VolumeSnapshotScheduleList, out *storagev1alpha1.VolumeSnapshotScheduleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]storagev1alpha1.VolumeSnapshotSchedule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotScheduleList_To_v1alpha1_VolumeSnapshotScheduleList is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotScheduleList_To_v1alpha1_VolumeSnapshotScheduleList(in *storage.VolumeSnapshotScheduleList, out *storagev1alpha1.VolumeSnapshotScheduleList, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotScheduleList_To_v1alpha1_VolumeSnapshotScheduleList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotScheduleSpec_To_storage_VolumeSnapshotScheduleSpec(in *storagev1alpha1.VolumeSnapshotScheduleSpec, out *storage.VolumeSnapshotScheduleSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.VolumeSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.VolumeSelector))
+	if err := Convert_v1alpha1_VolumeSnapshotRetention_To_storage_VolumeSnapshotRetention(&in.Retention, &out.Retention, s); err != nil {
+		return err
+	}
+	out.Suspend = in.Suspend
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotScheduleSpec_To_storage_VolumeSnapshotScheduleSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotScheduleSpec_To_storage_VolumeSnapshotScheduleSpec(in *storagev1alpha1.VolumeSnapshotScheduleSpec, out *storage.VolumeSnapshotScheduleSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotScheduleSpec_To_storage_VolumeSnapshotScheduleSpec(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotScheduleSpec_To_v1alpha1_VolumeSnapshotScheduleSpec(in *storage.VolumeSnapshotScheduleSpec, out *storagev1alpha1.VolumeSnapshotScheduleSpec, s conversion.Scope) error {
+	out.Schedule = in.Schedule
+	out.VolumeSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.VolumeSelector))
+	if err := Convert_storage_VolumeSnapshotRetention_To_v1alpha1_VolumeSnapshotRetention(&in.Retention, &out.Retention, s); err != nil {
+		return err
+	}
+	out.Suspend = in.Suspend
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotScheduleSpec_To_v1alpha1_VolumeSnapshotScheduleSpec is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotScheduleSpec_To_v1alpha1_VolumeSnapshotScheduleSpec(in *storage.VolumeSnapshotScheduleSpec, out *storagev1alpha1.VolumeSnapshotScheduleSpec, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotScheduleSpec_To_v1alpha1_VolumeSnapshotScheduleSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeSnapshotScheduleStatus_To_storage_VolumeSnapshotScheduleStatus(in *storagev1alpha1.VolumeSnapshotScheduleStatus, out *storage.VolumeSnapshotScheduleStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastScheduleTime = (*metav1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	out.LastSuccessfulTime = (*metav1.Time)(unsafe.Pointer(in.LastSuccessfulTime))
+	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.LastFailureMessage = in.LastFailureMessage
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotScheduleStatus_To_storage_VolumeSnapshotScheduleStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotScheduleStatus_To_storage_VolumeSnapshotScheduleStatus(in *storagev1alpha1.VolumeSnapshotScheduleStatus, out *storage.VolumeSnapshotScheduleStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotScheduleStatus_To_storage_VolumeSnapshotScheduleStatus(in, out, s)
+}
+
+func autoConvert_storage_VolumeSnapshotScheduleStatus_To_v1alpha1_VolumeSnapshotScheduleStatus(in *storage.VolumeSnapshotScheduleStatus, out *storagev1alpha1.VolumeSnapshotScheduleStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.LastScheduleTime = (*metav1.Time)(unsafe.Pointer(in.LastScheduleTime))
+	out.LastSuccessfulTime = (*metav1.Time)(unsafe.Pointer(in.LastSuccessfulTime))
+	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.LastFailureMessage = in.LastFailureMessage
+	return nil
+}
+
+// Convert_storage_VolumeSnapshotScheduleStatus_To_v1alpha1_VolumeSnapshotScheduleStatus is an autogenerated conversion function.
+func Convert_storage_VolumeSnapshotScheduleStatus_To_v1alpha1_VolumeSnapshotScheduleStatus(in *storage.VolumeSnapshotScheduleStatus, out *storagev1alpha1.VolumeSnapshotScheduleStatus, s conversion.Scope) error {
+	return autoConvert_storage_VolumeSnapshotScheduleStatus_To_v1alpha1_VolumeSnapshotScheduleStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_VolumeSnapshotSpec_To_storage_VolumeSnapshotSpec(in *storagev1alpha1.VolumeSnapshotSpec, out *storage.VolumeSnapshotSpec, s conversion.Scope) error {
 	out.VolumeRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.VolumeRef))
 	return nil
@@ -1167,6 +1933,7 @@ func autoConvert_v1alpha1_VolumeSpec_To_storage_VolumeSpec(in *storagev1alpha1.V
 	if err := Convert_v1alpha1_VolumeDataSource_To_storage_VolumeDataSource(&in.DataSource, &out.DataSource, s); err != nil {
 		return err
 	}
+	out.Revert = (*storage.VolumeRevert)(unsafe.Pointer(in.Revert))
 	return nil
 }
 
@@ -1187,6 +1954,7 @@ func autoConvert_storage_VolumeSpec_To_v1alpha1_VolumeSpec(in *storage.VolumeSpe
 	if err := Convert_storage_VolumeDataSource_To_v1alpha1_VolumeDataSource(&in.DataSource, &out.DataSource, s); err != nil {
 		return err
 	}
+	out.Revert = (*storagev1alpha1.VolumeRevert)(unsafe.Pointer(in.Revert))
 	return nil
 }
 
@@ -1202,6 +1970,7 @@ func autoConvert_v1alpha1_VolumeStatus_To_storage_VolumeStatus(in *storagev1alph
 	out.Access = (*storage.VolumeAccess)(unsafe.Pointer(in.Access))
 	out.Conditions = *(*[]storage.VolumeCondition)(unsafe.Pointer(&in.Conditions))
 	out.Resources = *(*core.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.LastAppliedRevert = (*storage.VolumeRevert)(unsafe.Pointer(in.LastAppliedRevert))
 	return nil
 }
 
@@ -1217,6 +1986,7 @@ func autoConvert_storage_VolumeStatus_To_v1alpha1_VolumeStatus(in *storage.Volum
 	out.Access = (*storagev1alpha1.VolumeAccess)(unsafe.Pointer(in.Access))
 	out.Conditions = *(*[]storagev1alpha1.VolumeCondition)(unsafe.Pointer(&in.Conditions))
 	out.Resources = *(*corev1alpha1.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.LastAppliedRevert = (*storagev1alpha1.VolumeRevert)(unsafe.Pointer(in.LastAppliedRevert))
 	return nil
 }
 
@@ -20,10 +20,24 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.Bucket{}, func(obj interface{}) { SetObjectDefaults_Bucket(obj.(*storagev1alpha1.Bucket)) })
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.BucketList{}, func(obj interface{}) { SetObjectDefaults_BucketList(obj.(*storagev1alpha1.BucketList)) })
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.Volume{}, func(obj interface{}) { SetObjectDefaults_Volume(obj.(*storagev1alpha1.Volume)) })
+	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeBackup{}, func(obj interface{}) { SetObjectDefaults_VolumeBackup(obj.(*storagev1alpha1.VolumeBackup)) })
+	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeBackupList{}, func(obj interface{}) { SetObjectDefaults_VolumeBackupList(obj.(*storagev1alpha1.VolumeBackupList)) })
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeClass{}, func(obj interface{}) { SetObjectDefaults_VolumeClass(obj.(*storagev1alpha1.VolumeClass)) })
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeClassList{}, func(obj interface{}) { SetObjectDefaults_VolumeClassList(obj.(*storagev1alpha1.VolumeClassList)) })
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeList{}, func(obj interface{}) { SetObjectDefaults_VolumeList(obj.(*storagev1alpha1.VolumeList)) })
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeSnapshot{}, func(obj interface{}) { SetObjectDefaults_VolumeSnapshot(obj.(*storagev1alpha1.VolumeSnapshot)) })
+	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeSnapshotGroup{}, func(obj interface{}) {
+		SetObjectDefaults_VolumeSnapshotGroup(obj.(*storagev1alpha1.VolumeSnapshotGroup))
+	})
+	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeSnapshotGroupList{}, func(obj interface{}) {
+		SetObjectDefaults_VolumeSnapshotGroupList(obj.(*storagev1alpha1.VolumeSnapshotGroupList))
+	})
+	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeSnapshotGroupRestore{}, func(obj interface{}) {
+		SetObjectDefaults_VolumeSnapshotGroupRestore(obj.(*storagev1alpha1.VolumeSnapshotGroupRestore))
+	})
+	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeSnapshotGroupRestoreList{}, func(obj interface{}) {
+		SetObjectDefaults_VolumeSnapshotGroupRestoreList(obj.(*storagev1alpha1.VolumeSnapshotGroupRestoreList))
+	})
 	scheme.AddTypeDefaultingFunc(&storagev1alpha1.VolumeSnapshotList{}, func(obj interface{}) { SetObjectDefaults_VolumeSnapshotList(obj.(*storagev1alpha1.VolumeSnapshotList)) })
 	return nil
 }
@@ -43,6 +57,17 @@ func SetObjectDefaults_Volume(in *storagev1alpha1.Volume) {
 	SetDefaults_VolumeStatus(&in.Status)
 }
 
+func SetObjectDefaults_VolumeBackup(in *storagev1alpha1.VolumeBackup) {
+	SetDefaults_VolumeBackupStatus(&in.Status)
+}
+
+func SetObjectDefaults_VolumeBackupList(in *storagev1alpha1.VolumeBackupList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_VolumeBackup(a)
+	}
+}
+
 func SetObjectDefaults_VolumeClass(in *storagev1alpha1.VolumeClass) {
 	SetDefaults_VolumeClass(in)
 }
@@ -65,6 +90,29 @@ func SetObjectDefaults_VolumeSnapshot(in *storagev1alpha1.VolumeSnapshot) {
 	SetDefaults_VolumeSnapshotStatus(&in.Status)
 }
 
+func SetObjectDefaults_VolumeSnapshotGroup(in *storagev1alpha1.VolumeSnapshotGroup) {
+	SetDefaults_VolumeSnapshotGroupSpec(&in.Spec)
+	SetDefaults_VolumeSnapshotGroupStatus(&in.Status)
+}
+
+func SetObjectDefaults_VolumeSnapshotGroupList(in *storagev1alpha1.VolumeSnapshotGroupList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_VolumeSnapshotGroup(a)
+	}
+}
+
+func SetObjectDefaults_VolumeSnapshotGroupRestore(in *storagev1alpha1.VolumeSnapshotGroupRestore) {
+	SetDefaults_VolumeSnapshotGroupRestoreStatus(&in.Status)
+}
+
+func SetObjectDefaults_VolumeSnapshotGroupRestoreList(in *storagev1alpha1.VolumeSnapshotGroupRestoreList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_VolumeSnapshotGroupRestore(a)
+	}
+}
+
 func SetObjectDefaults_VolumeSnapshotList(in *storagev1alpha1.VolumeSnapshotList) {
 	for i := range in.Items {
 		a := &in.Items[i]
@@ -68,6 +68,18 @@ func validateVolumeSpec(spec *storage.VolumeSpec, fldPath *field.Path) field.Err
 		if spec.DataSource.OSImage != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataSource").Child("osImage"), "must not specify if volume class is empty"))
 		}
+
+		if spec.DataSource.VolumeRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataSource").Child("volumeRef"), "must not specify if volume class is empty"))
+		}
+
+		if spec.DataSource.VolumeBackupRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("dataSource").Child("volumeBackupRef"), "must not specify if volume class is empty"))
+		}
+
+		if spec.Revert != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("revert"), "must not specify if volume class is empty"))
+		}
 	}
 
 	if spec.Unclaimable {
@@ -88,7 +100,25 @@ func validateVolumeSpec(spec *storage.VolumeSpec, fldPath *field.Path) field.Err
 		}
 	}
 
-	allErrs = append(allErrs, validateVolumeDataSource(&spec.DataSource, fldPath)...)
+	allErrs = append(allErrs, validateVolumeDataSource(&spec.DataSource, fldPath.Child("dataSource"))...)
+
+	if spec.Revert != nil {
+		allErrs = append(allErrs, validateVolumeRevert(spec.Revert, fldPath.Child("revert"))...)
+	}
+
+	return allErrs
+}
+
+func validateVolumeRevert(revert *storage.VolumeRevert, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, msg := range apivalidation.NameIsDNSSubdomain(revert.VolumeSnapshotRef.Name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeSnapshotRef").Child("name"), revert.VolumeSnapshotRef.Name, msg))
+	}
+
+	if revert.RequestedAt.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("requestedAt"), "must specify requestedAt"))
+	}
 
 	return allErrs
 }
@@ -100,11 +130,32 @@ func validateVolumeDataSource(source *storage.VolumeDataSource, fldPath *field.P
 		if source.OSImage != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("osImage"), "must only specify one volume data source"))
 		}
+		if source.VolumeRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("volumeRef"), "must only specify one volume data source"))
+		}
 		for _, msg := range apivalidation.NameIsDNSSubdomain(source.VolumeSnapshotRef.Name, false) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeSnapshotRef").Child("name"), source.VolumeSnapshotRef.Name, msg))
 		}
 	}
 
+	if source.VolumeRef != nil {
+		if source.OSImage != nil && source.VolumeSnapshotRef == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("osImage"), "must only specify one volume data source"))
+		}
+		for _, msg := range apivalidation.NameIsDNSSubdomain(source.VolumeRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeRef").Child("name"), source.VolumeRef.Name, msg))
+		}
+	}
+
+	if source.VolumeBackupRef != nil {
+		if source.VolumeSnapshotRef != nil || source.VolumeRef != nil || source.OSImage != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("volumeBackupRef"), "must only specify one volume data source"))
+		}
+		for _, msg := range apivalidation.NameIsDNSSubdomain(source.VolumeBackupRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeBackupRef").Child("name"), source.VolumeBackupRef.Name, msg))
+		}
+	}
+
 	return allErrs
 }
 
@@ -124,6 +175,8 @@ func validateVolumeSpecUpdate(newSpec, oldSpec *storage.VolumeSpec, fldPath *fie
 	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.VolumeClassRef, oldSpec.VolumeClassRef, fldPath.Child("volumeClassRef"))...)
 	allErrs = append(allErrs, ironcorevalidation.ValidateSetOnceField(newSpec.VolumePoolRef, oldSpec.VolumePoolRef, fldPath.Child("volumePoolRef"))...)
 	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.Encryption, oldSpec.Encryption, fldPath.Child("encryption"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.DataSource.VolumeRef, oldSpec.DataSource.VolumeRef, fldPath.Child("dataSource").Child("volumeRef"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newSpec.DataSource.VolumeBackupRef, oldSpec.DataSource.VolumeBackupRef, fldPath.Child("dataSource").Child("volumeBackupRef"))...)
 
 	return allErrs
 }
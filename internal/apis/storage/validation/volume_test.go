@@ -123,7 +123,7 @@ var _ = Describe("Volume", func() {
 					},
 				},
 			},
-			Not(ContainElement(InvalidField("spec.volumeSnapshotRef.name"))),
+			Not(ContainElement(InvalidField("spec.dataSource.volumeSnapshotRef.name"))),
 		),
 		Entry("invalid volumeSnapshotRef name",
 			&storage.Volume{
@@ -133,7 +133,7 @@ var _ = Describe("Volume", func() {
 					},
 				},
 			},
-			ContainElement(InvalidField("spec.volumeSnapshotRef.name")),
+			ContainElement(InvalidField("spec.dataSource.volumeSnapshotRef.name")),
 		),
 		Entry("classfull: valid os image as single volume data source",
 			&storage.Volume{
@@ -163,6 +163,7 @@ var _ = Describe("Volume", func() {
 		Entry("invalid os image as single volume data source",
 			&storage.Volume{
 				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
 					DataSource: storage.VolumeDataSource{
 						VolumeSnapshotRef: &corev1.LocalObjectReference{Name: "foo"},
 						OSImage: &storage.OSDataSource{
@@ -173,6 +174,162 @@ var _ = Describe("Volume", func() {
 			},
 			ContainElement(ForbiddenField("spec.dataSource.osImage")),
 		),
+		Entry("classful: valid volumeRef as single volume data source",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeRef: &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			Not(ContainElement(Or(
+				InvalidField("spec.dataSource.volumeRef.name"),
+				ForbiddenField("spec.dataSource.volumeRef"),
+			))),
+		),
+		Entry("invalid volumeRef name",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeRef: &corev1.LocalObjectReference{Name: "foo*"},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.dataSource.volumeRef.name")),
+		),
+		Entry("classless: invalid volumeRef as volume data source",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					DataSource: storage.VolumeDataSource{
+						VolumeRef: &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.dataSource.volumeRef")),
+		),
+		Entry("invalid volumeRef and volumeSnapshotRef as volume data source",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeSnapshotRef: &corev1.LocalObjectReference{Name: "foo"},
+						VolumeRef:         &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.dataSource.volumeRef")),
+		),
+		Entry("invalid volumeRef and os image as volume data source",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeRef: &corev1.LocalObjectReference{Name: "foo"},
+						OSImage:   &storage.OSDataSource{Image: "test-image"},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.dataSource.osImage")),
+		),
+		Entry("classful: valid volumeBackupRef as single volume data source",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeBackupRef: &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			Not(ContainElement(Or(
+				InvalidField("spec.dataSource.volumeBackupRef.name"),
+				ForbiddenField("spec.dataSource.volumeBackupRef"),
+			))),
+		),
+		Entry("invalid volumeBackupRef name",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeBackupRef: &corev1.LocalObjectReference{Name: "foo*"},
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.dataSource.volumeBackupRef.name")),
+		),
+		Entry("classless: invalid volumeBackupRef as volume data source",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					DataSource: storage.VolumeDataSource{
+						VolumeBackupRef: &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.dataSource.volumeBackupRef")),
+		),
+		Entry("invalid volumeBackupRef and volumeSnapshotRef as volume data source",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeSnapshotRef: &corev1.LocalObjectReference{Name: "foo"},
+						VolumeBackupRef:   &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.dataSource.volumeBackupRef")),
+		),
+		Entry("classful: valid revert",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					Revert: &storage.VolumeRevert{
+						VolumeSnapshotRef: corev1.LocalObjectReference{Name: "foo"},
+						RequestedAt:       metav1.Now(),
+					},
+				},
+			},
+			Not(ContainElement(Or(
+				InvalidField("spec.revert.volumeSnapshotRef.name"),
+				RequiredField("spec.revert.requestedAt"),
+				ForbiddenField("spec.revert"),
+			))),
+		),
+		Entry("invalid revert volumeSnapshotRef name",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					Revert: &storage.VolumeRevert{
+						VolumeSnapshotRef: corev1.LocalObjectReference{Name: "foo*"},
+						RequestedAt:       metav1.Now(),
+					},
+				},
+			},
+			ContainElement(InvalidField("spec.revert.volumeSnapshotRef.name")),
+		),
+		Entry("revert without requestedAt",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					Revert: &storage.VolumeRevert{
+						VolumeSnapshotRef: corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			ContainElement(RequiredField("spec.revert.requestedAt")),
+		),
+		Entry("classless: revert",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					Revert: &storage.VolumeRevert{
+						VolumeSnapshotRef: corev1.LocalObjectReference{Name: "foo"},
+						RequestedAt:       metav1.Now(),
+					},
+				},
+			},
+			ContainElement(ForbiddenField("spec.revert")),
+		),
 	)
 
 	DescribeTable("ValidateVolumeUpdate",
@@ -265,5 +422,43 @@ var _ = Describe("Volume", func() {
 			},
 			ContainElement(ImmutableField("spec.encryption")),
 		),
+		Entry("immutable volumeRef",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeRef: &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeRef: &corev1.LocalObjectReference{Name: "bar"},
+					},
+				},
+			},
+			ContainElement(ImmutableField("spec.dataSource.volumeRef")),
+		),
+		Entry("immutable volumeBackupRef",
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeBackupRef: &corev1.LocalObjectReference{Name: "foo"},
+					},
+				},
+			},
+			&storage.Volume{
+				Spec: storage.VolumeSpec{
+					VolumeClassRef: &corev1.LocalObjectReference{Name: "foo"},
+					DataSource: storage.VolumeDataSource{
+						VolumeBackupRef: &corev1.LocalObjectReference{Name: "bar"},
+					},
+				},
+			},
+			ContainElement(ImmutableField("spec.dataSource.volumeBackupRef")),
+		),
 	)
 })
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateVolumeBackup validates a VolumeBackup object.
+func ValidateVolumeBackup(volumeBackup *storage.VolumeBackup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(volumeBackup, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateVolumeBackupSpec(&volumeBackup.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateVolumeBackupSpec(spec *storage.VolumeBackupSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.VolumeSnapshotRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("volumeSnapshotRef").Child("name"), "must specify volume snapshot"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(spec.VolumeSnapshotRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeSnapshotRef").Child("name"), spec.VolumeSnapshotRef.Name, msg))
+		}
+	}
+
+	if spec.BucketRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("bucketRef").Child("name"), "must specify bucket"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(spec.BucketRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bucketRef").Child("name"), spec.BucketRef.Name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVolumeBackupUpdate validates a VolumeBackup object before an update.
+func ValidateVolumeBackupUpdate(newVolumeBackup, oldVolumeBackup *storage.VolumeBackup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeBackup, oldVolumeBackup, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newVolumeBackup.Spec, oldVolumeBackup.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateVolumeBackup(newVolumeBackup)...)
+
+	return allErrs
+}
+
+// ValidateVolumeBackupStatusUpdate validates a VolumeBackup status before an update.
+func ValidateVolumeBackupStatusUpdate(newVolumeBackup, oldVolumeBackup *storage.VolumeBackup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeBackup, oldVolumeBackup, field.NewPath("metadata"))...)
+
+	return allErrs
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VolumeBackup", func() {
+	DescribeTable("ValidateVolumeBackup",
+		func(volumeBackup *storage.VolumeBackup, match types.GomegaMatcher) {
+			errList := ValidateVolumeBackup(volumeBackup)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&storage.VolumeBackup{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("missing volume snapshot ref",
+			&storage.VolumeBackup{},
+			ContainElement(RequiredField("spec.volumeSnapshotRef.name")),
+		),
+		Entry("missing bucket ref",
+			&storage.VolumeBackup{},
+			ContainElement(RequiredField("spec.bucketRef.name")),
+		),
+		Entry("invalid volume snapshot ref name",
+			&storage.VolumeBackup{
+				Spec: storage.VolumeBackupSpec{
+					VolumeSnapshotRef: corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.volumeSnapshotRef.name")),
+		),
+		Entry("invalid bucket ref name",
+			&storage.VolumeBackup{
+				Spec: storage.VolumeBackupSpec{
+					BucketRef: corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.bucketRef.name")),
+		),
+		Entry("valid volume backup",
+			&storage.VolumeBackup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: storage.VolumeBackupSpec{
+					VolumeSnapshotRef: corev1.LocalObjectReference{Name: "foo"},
+					BucketRef:         corev1.LocalObjectReference{Name: "bar"},
+				},
+			},
+			BeEmpty(),
+		),
+	)
+
+	DescribeTable("ValidateVolumeBackupUpdate",
+		func(newVolumeBackup, oldVolumeBackup *storage.VolumeBackup, match types.GomegaMatcher) {
+			errList := ValidateVolumeBackupUpdate(newVolumeBackup, oldVolumeBackup)
+			Expect(errList).To(match)
+		},
+		Entry("immutable spec",
+			&storage.VolumeBackup{
+				Spec: storage.VolumeBackupSpec{BucketRef: corev1.LocalObjectReference{Name: "foo"}},
+			},
+			&storage.VolumeBackup{
+				Spec: storage.VolumeBackupSpec{BucketRef: corev1.LocalObjectReference{Name: "bar"}},
+			},
+			ContainElement(ForbiddenField("spec")),
+		),
+	)
+})
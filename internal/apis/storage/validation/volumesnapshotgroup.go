@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateVolumeSnapshotGroup validates a VolumeSnapshotGroup object.
+func ValidateVolumeSnapshotGroup(volumeSnapshotGroup *storage.VolumeSnapshotGroup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(volumeSnapshotGroup, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateVolumeSnapshotGroupSpec(&volumeSnapshotGroup.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateVolumeSnapshotGroupSpec(spec *storage.VolumeSnapshotGroupSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case spec.MachineRef == nil && spec.VolumeSelector == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify machineRef or volumeSelector"))
+	case spec.MachineRef != nil && spec.VolumeSelector != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("volumeSelector"), "must not specify volumeSelector if machineRef is specified"))
+	}
+
+	if spec.MachineRef != nil {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(spec.MachineRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineRef").Child("name"), spec.MachineRef.Name, msg))
+		}
+	}
+
+	if spec.VolumeSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.VolumeSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("volumeSelector"))...)
+	}
+
+	if spec.FreezeIO && spec.MachineRef == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("freezeIO"), "can only freeze I/O of a machine referenced by machineRef"))
+	}
+
+	if spec.FreezeTimeout != nil {
+		if !spec.FreezeIO {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("freezeTimeout"), "must not specify freezeTimeout if freezeIO is not set"))
+		} else if spec.FreezeTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("freezeTimeout"), spec.FreezeTimeout.Duration.String(), "must be greater than zero"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVolumeSnapshotGroupUpdate validates a VolumeSnapshotGroup object before an update.
+func ValidateVolumeSnapshotGroupUpdate(newVolumeSnapshotGroup, oldVolumeSnapshotGroup *storage.VolumeSnapshotGroup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeSnapshotGroup, oldVolumeSnapshotGroup, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newVolumeSnapshotGroup.Spec, oldVolumeSnapshotGroup.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateVolumeSnapshotGroup(newVolumeSnapshotGroup)...)
+
+	return allErrs
+}
+
+// ValidateVolumeSnapshotGroupStatusUpdate validates a VolumeSnapshotGroup status before an update.
+func ValidateVolumeSnapshotGroupStatusUpdate(newVolumeSnapshotGroup, oldVolumeSnapshotGroup *storage.VolumeSnapshotGroup) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeSnapshotGroup, oldVolumeSnapshotGroup, field.NewPath("metadata"))...)
+
+	return allErrs
+}
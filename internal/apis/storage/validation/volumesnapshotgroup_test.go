@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VolumeSnapshotGroup", func() {
+	DescribeTable("ValidateVolumeSnapshotGroup",
+		func(volumeSnapshotGroup *storage.VolumeSnapshotGroup, match types.GomegaMatcher) {
+			errList := ValidateVolumeSnapshotGroup(volumeSnapshotGroup)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&storage.VolumeSnapshotGroup{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("name longer than a label value",
+			&storage.VolumeSnapshotGroup{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 64)}},
+			ContainElement(InvalidField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&storage.VolumeSnapshotGroup{},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("missing machine ref and volume selector",
+			&storage.VolumeSnapshotGroup{},
+			ContainElement(RequiredField("spec")),
+		),
+		Entry("machine ref and volume selector",
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{
+					MachineRef:     &corev1.LocalObjectReference{Name: "foo"},
+					VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				},
+			},
+			ContainElement(ForbiddenField("spec.volumeSelector")),
+		),
+		Entry("invalid machine ref name",
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{
+					MachineRef: &corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.machineRef.name")),
+		),
+		Entry("invalid volume selector",
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{
+					VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo*": "bar"}},
+				},
+			},
+			ContainElement(InvalidField("spec.volumeSelector.matchLabels")),
+		),
+		Entry("freeze io without machine ref",
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{
+					VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					FreezeIO:       true,
+				},
+			},
+			ContainElement(ForbiddenField("spec.freezeIO")),
+		),
+		Entry("freeze timeout without freeze io",
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{
+					MachineRef:    &corev1.LocalObjectReference{Name: "foo"},
+					FreezeTimeout: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			ContainElement(ForbiddenField("spec.freezeTimeout")),
+		),
+		Entry("non-positive freeze timeout",
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{
+					MachineRef:    &corev1.LocalObjectReference{Name: "foo"},
+					FreezeIO:      true,
+					FreezeTimeout: &metav1.Duration{},
+				},
+			},
+			ContainElement(InvalidField("spec.freezeTimeout")),
+		),
+		Entry("valid volume snapshot group",
+			&storage.VolumeSnapshotGroup{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: storage.VolumeSnapshotGroupSpec{
+					MachineRef:    &corev1.LocalObjectReference{Name: "foo"},
+					FreezeIO:      true,
+					FreezeTimeout: &metav1.Duration{Duration: time.Minute},
+				},
+			},
+			BeEmpty(),
+		),
+	)
+
+	DescribeTable("ValidateVolumeSnapshotGroupUpdate",
+		func(newVolumeSnapshotGroup, oldVolumeSnapshotGroup *storage.VolumeSnapshotGroup, match types.GomegaMatcher) {
+			errList := ValidateVolumeSnapshotGroupUpdate(newVolumeSnapshotGroup, oldVolumeSnapshotGroup)
+			Expect(errList).To(match)
+		},
+		Entry("immutable spec",
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{MachineRef: &corev1.LocalObjectReference{Name: "foo"}},
+			},
+			&storage.VolumeSnapshotGroup{
+				Spec: storage.VolumeSnapshotGroupSpec{MachineRef: &corev1.LocalObjectReference{Name: "bar"}},
+			},
+			ContainElement(ForbiddenField("spec")),
+		),
+	)
+})
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	ironcorevalidation "github.com/ironcore-dev/ironcore/internal/api/validation"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateVolumeSnapshotGroupRestore validates a VolumeSnapshotGroupRestore object.
+func ValidateVolumeSnapshotGroupRestore(volumeSnapshotGroupRestore *storage.VolumeSnapshotGroupRestore) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(volumeSnapshotGroupRestore, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateVolumeSnapshotGroupRestoreSpec(&volumeSnapshotGroupRestore.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateVolumeSnapshotGroupRestoreSpec(spec *storage.VolumeSnapshotGroupRestoreSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.VolumeSnapshotGroupRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("volumeSnapshotGroupRef").Child("name"), "must specify volume snapshot group"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(spec.VolumeSnapshotGroupRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeSnapshotGroupRef").Child("name"), spec.VolumeSnapshotGroupRef.Name, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVolumeSnapshotGroupRestoreUpdate validates a VolumeSnapshotGroupRestore object before an update.
+func ValidateVolumeSnapshotGroupRestoreUpdate(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore *storage.VolumeSnapshotGroupRestore) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ironcorevalidation.ValidateImmutableField(newVolumeSnapshotGroupRestore.Spec, oldVolumeSnapshotGroupRestore.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateVolumeSnapshotGroupRestore(newVolumeSnapshotGroupRestore)...)
+
+	return allErrs
+}
+
+// ValidateVolumeSnapshotGroupRestoreStatusUpdate validates a VolumeSnapshotGroupRestore status before an update.
+func ValidateVolumeSnapshotGroupRestoreStatusUpdate(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore *storage.VolumeSnapshotGroupRestore) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore, field.NewPath("metadata"))...)
+
+	return allErrs
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("VolumeSnapshotGroupRestore", func() {
+	DescribeTable("ValidateVolumeSnapshotGroupRestore",
+		func(volumeSnapshotGroupRestore *storage.VolumeSnapshotGroupRestore, match types.GomegaMatcher) {
+			errList := ValidateVolumeSnapshotGroupRestore(volumeSnapshotGroupRestore)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&storage.VolumeSnapshotGroupRestore{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("name longer than a label value",
+			&storage.VolumeSnapshotGroupRestore{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 64)}},
+			ContainElement(InvalidField("metadata.name")),
+		),
+		Entry("missing volume snapshot group ref",
+			&storage.VolumeSnapshotGroupRestore{},
+			ContainElement(RequiredField("spec.volumeSnapshotGroupRef.name")),
+		),
+		Entry("invalid volume snapshot group ref name",
+			&storage.VolumeSnapshotGroupRestore{
+				Spec: storage.VolumeSnapshotGroupRestoreSpec{
+					VolumeSnapshotGroupRef: corev1.LocalObjectReference{Name: "foo*"},
+				},
+			},
+			ContainElement(InvalidField("spec.volumeSnapshotGroupRef.name")),
+		),
+		Entry("valid volume snapshot group restore",
+			&storage.VolumeSnapshotGroupRestore{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: storage.VolumeSnapshotGroupRestoreSpec{
+					VolumeSnapshotGroupRef: corev1.LocalObjectReference{Name: "foo"},
+				},
+			},
+			BeEmpty(),
+		),
+	)
+
+	DescribeTable("ValidateVolumeSnapshotGroupRestoreUpdate",
+		func(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore *storage.VolumeSnapshotGroupRestore, match types.GomegaMatcher) {
+			errList := ValidateVolumeSnapshotGroupRestoreUpdate(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore)
+			Expect(errList).To(match)
+		},
+		Entry("immutable spec",
+			&storage.VolumeSnapshotGroupRestore{
+				Spec: storage.VolumeSnapshotGroupRestoreSpec{VolumeSnapshotGroupRef: corev1.LocalObjectReference{Name: "foo"}},
+			},
+			&storage.VolumeSnapshotGroupRestore{
+				Spec: storage.VolumeSnapshotGroupRestoreSpec{VolumeSnapshotGroupRef: corev1.LocalObjectReference{Name: "bar"}},
+			},
+			ContainElement(ForbiddenField("spec")),
+		),
+	)
+})
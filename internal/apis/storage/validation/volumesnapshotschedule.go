@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	"github.com/robfig/cron/v3"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateVolumeSnapshotSchedule validates a VolumeSnapshotSchedule object.
+func ValidateVolumeSnapshotSchedule(volumeSnapshotSchedule *storage.VolumeSnapshotSchedule) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessor(volumeSnapshotSchedule, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateVolumeSnapshotScheduleSpec(&volumeSnapshotSchedule.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateVolumeSnapshotScheduleSpec(spec *storage.VolumeSnapshotScheduleSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateSnapshotCronSchedule(spec.Schedule, fldPath.Child("schedule"))...)
+
+	if spec.VolumeSelector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("volumeSelector"), "must specify volume selector"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.VolumeSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("volumeSelector"))...)
+	}
+
+	allErrs = append(allErrs, validateVolumeSnapshotRetention(&spec.Retention, fldPath.Child("retention"))...)
+
+	return allErrs
+}
+
+func validateSnapshotCronSchedule(schedule string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case schedule == "":
+		allErrs = append(allErrs, field.Required(fldPath, "must specify schedule"))
+	case strings.Contains(schedule, "TZ"):
+		allErrs = append(allErrs, field.Invalid(fldPath, schedule, "time zones are not supported, the schedule is interpreted in UTC"))
+	default:
+		if _, err := cron.ParseStandard(schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, schedule, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateVolumeSnapshotRetention(retention *storage.VolumeSnapshotRetention, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if retention.KeepLast == nil && retention.MaxAge == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify keepLast or maxAge"))
+	}
+
+	if retention.KeepLast != nil && *retention.KeepLast < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("keepLast"), *retention.KeepLast, "must be at least 1"))
+	}
+
+	if retention.MaxAge != nil && retention.MaxAge.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAge"), retention.MaxAge.Duration.String(), "must be greater than zero"))
+	}
+
+	return allErrs
+}
+
+// ValidateVolumeSnapshotScheduleUpdate validates a VolumeSnapshotSchedule object before an update.
+func ValidateVolumeSnapshotScheduleUpdate(newVolumeSnapshotSchedule, oldVolumeSnapshotSchedule *storage.VolumeSnapshotSchedule) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeSnapshotSchedule, oldVolumeSnapshotSchedule, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateVolumeSnapshotSchedule(newVolumeSnapshotSchedule)...)
+
+	return allErrs
+}
+
+// ValidateVolumeSnapshotScheduleStatusUpdate validates a VolumeSnapshotSchedule status before an update.
+func ValidateVolumeSnapshotScheduleStatusUpdate(newVolumeSnapshotSchedule, oldVolumeSnapshotSchedule *storage.VolumeSnapshotSchedule) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaAccessorUpdate(newVolumeSnapshotSchedule, oldVolumeSnapshotSchedule, field.NewPath("metadata"))...)
+
+	return allErrs
+}
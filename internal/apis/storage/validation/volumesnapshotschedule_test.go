@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	. "github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	. "github.com/ironcore-dev/ironcore/internal/testutils/validation"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("VolumeSnapshotSchedule", func() {
+	DescribeTable("ValidateVolumeSnapshotSchedule",
+		func(volumeSnapshotSchedule *storage.VolumeSnapshotSchedule, match types.GomegaMatcher) {
+			errList := ValidateVolumeSnapshotSchedule(volumeSnapshotSchedule)
+			Expect(errList).To(match)
+		},
+		Entry("missing name",
+			&storage.VolumeSnapshotSchedule{},
+			ContainElement(RequiredField("metadata.name")),
+		),
+		Entry("name longer than a label value",
+			&storage.VolumeSnapshotSchedule{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 64)}},
+			ContainElement(InvalidField("metadata.name")),
+		),
+		Entry("missing namespace",
+			&storage.VolumeSnapshotSchedule{},
+			ContainElement(RequiredField("metadata.namespace")),
+		),
+		Entry("missing schedule",
+			&storage.VolumeSnapshotSchedule{},
+			ContainElement(RequiredField("spec.schedule")),
+		),
+		Entry("invalid schedule",
+			&storage.VolumeSnapshotSchedule{
+				Spec: storage.VolumeSnapshotScheduleSpec{Schedule: "every day"},
+			},
+			ContainElement(InvalidField("spec.schedule")),
+		),
+		Entry("schedule with time zone",
+			&storage.VolumeSnapshotSchedule{
+				Spec: storage.VolumeSnapshotScheduleSpec{Schedule: "CRON_TZ=Europe/Berlin 0 3 * * *"},
+			},
+			ContainElement(InvalidField("spec.schedule")),
+		),
+		Entry("valid schedule",
+			&storage.VolumeSnapshotSchedule{
+				Spec: storage.VolumeSnapshotScheduleSpec{Schedule: "0 3 * * *"},
+			},
+			Not(ContainElement(InvalidField("spec.schedule"))),
+		),
+		Entry("valid schedule descriptor",
+			&storage.VolumeSnapshotSchedule{
+				Spec: storage.VolumeSnapshotScheduleSpec{Schedule: "@hourly"},
+			},
+			Not(ContainElement(InvalidField("spec.schedule"))),
+		),
+		Entry("missing volume selector",
+			&storage.VolumeSnapshotSchedule{},
+			ContainElement(RequiredField("spec.volumeSelector")),
+		),
+		Entry("invalid volume selector",
+			&storage.VolumeSnapshotSchedule{
+				Spec: storage.VolumeSnapshotScheduleSpec{
+					VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo*": "bar"}},
+				},
+			},
+			ContainElement(InvalidField("spec.volumeSelector.matchLabels")),
+		),
+		Entry("missing retention",
+			&storage.VolumeSnapshotSchedule{},
+			ContainElement(RequiredField("spec.retention")),
+		),
+		Entry("keep last less than one",
+			&storage.VolumeSnapshotSchedule{
+				Spec: storage.VolumeSnapshotScheduleSpec{
+					Retention: storage.VolumeSnapshotRetention{KeepLast: ptr.To[int32](0)},
+				},
+			},
+			ContainElement(InvalidField("spec.retention.keepLast")),
+		),
+		Entry("non-positive max age",
+			&storage.VolumeSnapshotSchedule{
+				Spec: storage.VolumeSnapshotScheduleSpec{
+					Retention: storage.VolumeSnapshotRetention{MaxAge: &metav1.Duration{}},
+				},
+			},
+			ContainElement(InvalidField("spec.retention.maxAge")),
+		),
+		Entry("valid volume snapshot schedule",
+			&storage.VolumeSnapshotSchedule{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec: storage.VolumeSnapshotScheduleSpec{
+					Schedule:       "0 3 * * *",
+					VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+					Retention: storage.VolumeSnapshotRetention{
+						KeepLast: ptr.To[int32](7),
+						MaxAge:   &metav1.Duration{Duration: 30 * 24 * time.Hour},
+					},
+				},
+			},
+			BeEmpty(),
+		),
+	)
+})
@@ -39,12 +39,30 @@ type VolumeSpec struct {
 	Encryption *VolumeEncryption
 	// DataSource contains the content to prepopulate the Volume with.
 	DataSource VolumeDataSource
+	// Revert requests to revert the volume to a VolumeSnapshot of itself.
+	// Every time Revert.RequestedAt changes, the volume is reverted as soon as no machine claims it.
+	Revert *VolumeRevert
+}
+
+// VolumeRevert is a request to revert a Volume to a VolumeSnapshot.
+type VolumeRevert struct {
+	// VolumeSnapshotRef references the VolumeSnapshot to revert the volume to.
+	// The VolumeSnapshot has to be a snapshot of the volume.
+	VolumeSnapshotRef corev1.LocalObjectReference
+	// RequestedAt is the time the revert was requested at.
+	RequestedAt metav1.Time
 }
 
 // VolumeDataSource specifies the source to use for a Volume.
 type VolumeDataSource struct {
 	// VolumeSnapshotRef instructs to use the specified VolumeSnapshot as the data source.
 	VolumeSnapshotRef *corev1.LocalObjectReference
+	// VolumeRef instructs to clone the specified Volume of the same namespace.
+	// The storage of the clone must be at least the storage of the source Volume.
+	VolumeRef *corev1.LocalObjectReference
+	// VolumeBackupRef instructs to restore the specified VolumeBackup of the same namespace.
+	// Unlike VolumeSnapshots, VolumeBackups can be restored on any VolumePool.
+	VolumeBackupRef *corev1.LocalObjectReference
 	// OSImage defines an optional os image to bootstrap the volume.
 	OSImage *OSDataSource
 }
@@ -87,11 +105,19 @@ type VolumeStatus struct {
 
 	// Resources is a effective volume's resources.
 	Resources core.ResourceList
+
+	// LastAppliedRevert is the last revert that was applied to the volume.
+	LastAppliedRevert *VolumeRevert
 }
 
 // VolumeConditionType is a type a VolumeCondition can have.
 type VolumeConditionType string
 
+const (
+	// VolumeReverting reports whether a revert of the volume is in progress.
+	VolumeReverting VolumeConditionType = "Reverting"
+)
+
 // VolumeCondition is one of the conditions of a volume.
 type VolumeCondition struct {
 	// Type is the type of the condition.
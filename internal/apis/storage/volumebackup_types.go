@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeBackupSpec defines the desired state of VolumeBackup
+type VolumeBackupSpec struct {
+	// VolumeSnapshotRef references the VolumeSnapshot to back up.
+	VolumeSnapshotRef corev1.LocalObjectReference
+	// BucketRef references the Bucket to export the VolumeSnapshot to.
+	// The VolumeSnapshot is exported via the access endpoint and credentials of the Bucket.
+	BucketRef corev1.LocalObjectReference
+}
+
+// VolumeBackupStatus defines the observed state of VolumeBackup
+type VolumeBackupStatus struct {
+	// State is the state of the VolumeBackup.
+	State VolumeBackupState
+	// LastStateTransitionTime is the last time the State transitioned between values.
+	LastStateTransitionTime *metav1.Time
+	// Message is a human-readable explanation of the State.
+	Message string
+	// ExportID is the id of the running export of the VolumeSnapshot in the volume runtime.
+	ExportID string
+	// ObjectKey is the key of the object in the Bucket the VolumeSnapshot was exported to.
+	ObjectKey string
+	// Size is the size of the exported object.
+	Size *resource.Quantity
+	// VolumeStorage is the storage of the backed up Volume. Volumes restoring the VolumeBackup
+	// need at least this storage.
+	VolumeStorage *resource.Quantity
+}
+
+// VolumeBackupState is the state of a VolumeBackup.
+type VolumeBackupState string
+
+const (
+	// VolumeBackupStatePending reports that a VolumeBackup is about to be exported.
+	VolumeBackupStatePending VolumeBackupState = "Pending"
+	// VolumeBackupStateReady reports that a VolumeBackup has been exported and can be restored.
+	VolumeBackupStateReady VolumeBackupState = "Ready"
+	// VolumeBackupStateFailed reports that a VolumeBackup could not be exported.
+	VolumeBackupStateFailed VolumeBackupState = "Failed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeBackup exports a VolumeSnapshot to a Bucket so that it outlives the VolumePool of its Volume.
+type VolumeBackup struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   VolumeBackupSpec
+	Status VolumeBackupStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeBackupList contains a list of VolumeBackup
+type VolumeBackupList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []VolumeBackup
+}
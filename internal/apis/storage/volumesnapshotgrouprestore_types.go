@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeSnapshotGroupRestoreNameLabel is the label added to Volumes created by a VolumeSnapshotGroupRestore.
+// Its value is the name of the VolumeSnapshotGroupRestore.
+const VolumeSnapshotGroupRestoreNameLabel = "storage.ironcore.dev/volume-snapshot-group-restore"
+
+// VolumeSnapshotGroupRestoreSpec defines the desired state of VolumeSnapshotGroupRestore
+type VolumeSnapshotGroupRestoreSpec struct {
+	// VolumeSnapshotGroupRef references the VolumeSnapshotGroup to restore.
+	VolumeSnapshotGroupRef corev1.LocalObjectReference
+}
+
+// VolumeSnapshotGroupRestoreVolume is a Volume restored by a VolumeSnapshotGroupRestore.
+type VolumeSnapshotGroupRestoreVolume struct {
+	// SourceVolumeRef references the Volume the restored Volume was snapshotted from.
+	SourceVolumeRef corev1.LocalObjectReference
+	// VolumeRef references the restored Volume.
+	VolumeRef corev1.LocalObjectReference
+}
+
+// VolumeSnapshotGroupRestoreStatus defines the observed state of VolumeSnapshotGroupRestore
+type VolumeSnapshotGroupRestoreStatus struct {
+	// State is the state of the VolumeSnapshotGroupRestore.
+	State VolumeSnapshotGroupRestoreState
+	// LastStateTransitionTime is the last time the State transitioned between values.
+	LastStateTransitionTime *metav1.Time
+	// Message is a human-readable explanation of the State.
+	Message string
+	// Volumes are the Volumes restored from the VolumeSnapshotGroup.
+	Volumes []VolumeSnapshotGroupRestoreVolume
+}
+
+// VolumeSnapshotGroupRestoreState is the state of a VolumeSnapshotGroupRestore.
+type VolumeSnapshotGroupRestoreState string
+
+const (
+	// VolumeSnapshotGroupRestoreStatePending reports that the Volumes of a VolumeSnapshotGroupRestore are being created.
+	VolumeSnapshotGroupRestoreStatePending VolumeSnapshotGroupRestoreState = "Pending"
+	// VolumeSnapshotGroupRestoreStateRestored reports that all Volumes of a VolumeSnapshotGroupRestore have been created.
+	VolumeSnapshotGroupRestoreStateRestored VolumeSnapshotGroupRestoreState = "Restored"
+	// VolumeSnapshotGroupRestoreStateFailed reports that a VolumeSnapshotGroupRestore cannot be restored.
+	VolumeSnapshotGroupRestoreStateFailed VolumeSnapshotGroupRestoreState = "Failed"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshotGroupRestore restores the VolumeSnapshots of a VolumeSnapshotGroup into a matching set of Volumes.
+type VolumeSnapshotGroupRestore struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   VolumeSnapshotGroupRestoreSpec
+	Status VolumeSnapshotGroupRestoreStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VolumeSnapshotGroupRestoreList contains a list of VolumeSnapshotGroupRestore
+type VolumeSnapshotGroupRestoreList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	Items []VolumeSnapshotGroupRestore
+}
@@ -12,6 +12,7 @@ import (
 	v1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	core "github.com/ironcore-dev/ironcore/internal/apis/core"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -208,6 +209,23 @@ func (in *BucketPool) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketPoolCondition) DeepCopyInto(out *BucketPoolCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPoolCondition.
+func (in *BucketPoolCondition) DeepCopy() *BucketPoolCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketPoolCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketPoolList) DeepCopyInto(out *BucketPoolList) {
 	*out = *in
@@ -265,6 +283,13 @@ func (in *BucketPoolSpec) DeepCopy() *BucketPoolSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketPoolStatus) DeepCopyInto(out *BucketPoolStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]BucketPoolCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AvailableBucketClasses != nil {
 		in, out := &in.AvailableBucketClasses, &out.AvailableBucketClasses
 		*out = make([]v1.LocalObjectReference, len(*in))
@@ -448,6 +473,115 @@ func (in *VolumeAccess) DeepCopy() *VolumeAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeBackup) DeepCopyInto(out *VolumeBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackup.
+func (in *VolumeBackup) DeepCopy() *VolumeBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeBackupList) DeepCopyInto(out *VolumeBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupList.
+func (in *VolumeBackupList) DeepCopy() *VolumeBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeBackupSpec) DeepCopyInto(out *VolumeBackupSpec) {
+	*out = *in
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	out.BucketRef = in.BucketRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupSpec.
+func (in *VolumeBackupSpec) DeepCopy() *VolumeBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeBackupStatus) DeepCopyInto(out *VolumeBackupStatus) {
+	*out = *in
+	if in.LastStateTransitionTime != nil {
+		in, out := &in.LastStateTransitionTime, &out.LastStateTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.VolumeStorage != nil {
+		in, out := &in.VolumeStorage, &out.VolumeStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupStatus.
+func (in *VolumeBackupStatus) DeepCopy() *VolumeBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeClass) DeepCopyInto(out *VolumeClass) {
 	*out = *in
@@ -539,6 +673,16 @@ func (in *VolumeDataSource) DeepCopyInto(out *VolumeDataSource) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.VolumeRef != nil {
+		in, out := &in.VolumeRef, &out.VolumeRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.VolumeBackupRef != nil {
+		in, out := &in.VolumeBackupRef, &out.VolumeBackupRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
 	if in.OSImage != nil {
 		in, out := &in.OSImage, &out.OSImage
 		*out = new(OSDataSource)
@@ -748,6 +892,24 @@ func (in *VolumePoolStatus) DeepCopy() *VolumePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeRevert) DeepCopyInto(out *VolumeRevert) {
+	*out = *in
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRevert.
+func (in *VolumeRevert) DeepCopy() *VolumeRevert {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeRevert)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshot) DeepCopyInto(out *VolumeSnapshot) {
 	*out = *in
@@ -776,6 +938,280 @@ func (in *VolumeSnapshot) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroup) DeepCopyInto(out *VolumeSnapshotGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroup.
+func (in *VolumeSnapshotGroup) DeepCopy() *VolumeSnapshotGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupList) DeepCopyInto(out *VolumeSnapshotGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeSnapshotGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupList.
+func (in *VolumeSnapshotGroupList) DeepCopy() *VolumeSnapshotGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupRestore) DeepCopyInto(out *VolumeSnapshotGroupRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupRestore.
+func (in *VolumeSnapshotGroupRestore) DeepCopy() *VolumeSnapshotGroupRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotGroupRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupRestoreList) DeepCopyInto(out *VolumeSnapshotGroupRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeSnapshotGroupRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupRestoreList.
+func (in *VolumeSnapshotGroupRestoreList) DeepCopy() *VolumeSnapshotGroupRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotGroupRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupRestoreSpec) DeepCopyInto(out *VolumeSnapshotGroupRestoreSpec) {
+	*out = *in
+	out.VolumeSnapshotGroupRef = in.VolumeSnapshotGroupRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupRestoreSpec.
+func (in *VolumeSnapshotGroupRestoreSpec) DeepCopy() *VolumeSnapshotGroupRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupRestoreStatus) DeepCopyInto(out *VolumeSnapshotGroupRestoreStatus) {
+	*out = *in
+	if in.LastStateTransitionTime != nil {
+		in, out := &in.LastStateTransitionTime, &out.LastStateTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeSnapshotGroupRestoreVolume, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupRestoreStatus.
+func (in *VolumeSnapshotGroupRestoreStatus) DeepCopy() *VolumeSnapshotGroupRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupRestoreVolume) DeepCopyInto(out *VolumeSnapshotGroupRestoreVolume) {
+	*out = *in
+	out.SourceVolumeRef = in.SourceVolumeRef
+	out.VolumeRef = in.VolumeRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupRestoreVolume.
+func (in *VolumeSnapshotGroupRestoreVolume) DeepCopy() *VolumeSnapshotGroupRestoreVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupRestoreVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupSnapshot) DeepCopyInto(out *VolumeSnapshotGroupSnapshot) {
+	*out = *in
+	out.VolumeRef = in.VolumeRef
+	out.VolumeSnapshotRef = in.VolumeSnapshotRef
+	if in.VolumeClassRef != nil {
+		in, out := &in.VolumeClassRef, &out.VolumeClassRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(core.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupSnapshot.
+func (in *VolumeSnapshotGroupSnapshot) DeepCopy() *VolumeSnapshotGroupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupSpec) DeepCopyInto(out *VolumeSnapshotGroupSpec) {
+	*out = *in
+	if in.MachineRef != nil {
+		in, out := &in.MachineRef, &out.MachineRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.VolumeSelector != nil {
+		in, out := &in.VolumeSelector, &out.VolumeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreezeTimeout != nil {
+		in, out := &in.FreezeTimeout, &out.FreezeTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupSpec.
+func (in *VolumeSnapshotGroupSpec) DeepCopy() *VolumeSnapshotGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotGroupStatus) DeepCopyInto(out *VolumeSnapshotGroupStatus) {
+	*out = *in
+	if in.LastStateTransitionTime != nil {
+		in, out := &in.LastStateTransitionTime, &out.LastStateTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IOFreezeRequestedAt != nil {
+		in, out := &in.IOFreezeRequestedAt, &out.IOFreezeRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]VolumeSnapshotGroupSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotGroupStatus.
+func (in *VolumeSnapshotGroupStatus) DeepCopy() *VolumeSnapshotGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotList) DeepCopyInto(out *VolumeSnapshotList) {
 	*out = *in
@@ -809,6 +1245,143 @@ func (in *VolumeSnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotRetention) DeepCopyInto(out *VolumeSnapshotRetention) {
+	*out = *in
+	if in.KeepLast != nil {
+		in, out := &in.KeepLast, &out.KeepLast
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotRetention.
+func (in *VolumeSnapshotRetention) DeepCopy() *VolumeSnapshotRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotSchedule) DeepCopyInto(out *VolumeSnapshotSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotSchedule.
+func (in *VolumeSnapshotSchedule) DeepCopy() *VolumeSnapshotSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotScheduleList) DeepCopyInto(out *VolumeSnapshotScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeSnapshotSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotScheduleList.
+func (in *VolumeSnapshotScheduleList) DeepCopy() *VolumeSnapshotScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotScheduleSpec) DeepCopyInto(out *VolumeSnapshotScheduleSpec) {
+	*out = *in
+	if in.VolumeSelector != nil {
+		in, out := &in.VolumeSelector, &out.VolumeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Retention.DeepCopyInto(&out.Retention)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotScheduleSpec.
+func (in *VolumeSnapshotScheduleSpec) DeepCopy() *VolumeSnapshotScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotScheduleStatus) DeepCopyInto(out *VolumeSnapshotScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailureTime != nil {
+		in, out := &in.LastFailureTime, &out.LastFailureTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotScheduleStatus.
+func (in *VolumeSnapshotScheduleStatus) DeepCopy() *VolumeSnapshotScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotSpec) DeepCopyInto(out *VolumeSnapshotSpec) {
 	*out = *in
@@ -898,6 +1471,11 @@ func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
 		**out = **in
 	}
 	in.DataSource.DeepCopyInto(&out.DataSource)
+	if in.Revert != nil {
+		in, out := &in.Revert, &out.Revert
+		*out = new(VolumeRevert)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -937,6 +1515,11 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.LastAppliedRevert != nil {
+		in, out := &in.LastAppliedRevert, &out.LastAppliedRevert
+		*out = new(VolumeRevert)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -20,8 +20,10 @@ import (
 	clientset "github.com/ironcore-dev/ironcore/client-go/ironcore/versioned"
 	ironcoreopenapi "github.com/ironcore-dev/ironcore/client-go/openapi"
 	ironcoreinitializer "github.com/ironcore-dev/ironcore/internal/admission/initializer"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/machinepriority"
 	"github.com/ironcore-dev/ironcore/internal/admission/plugin/machinevolumedevices"
 	"github.com/ironcore-dev/ironcore/internal/admission/plugin/resourcequota"
+	"github.com/ironcore-dev/ironcore/internal/admission/plugin/volumeclone"
 	"github.com/ironcore-dev/ironcore/internal/admission/plugin/volumeresizepolicy"
 	"github.com/ironcore-dev/ironcore/internal/api"
 	"github.com/ironcore-dev/ironcore/internal/apis/compute"
@@ -164,14 +166,18 @@ func (o *IronCoreAPIServerOptions) Validate(args []string) error {
 
 func (o *IronCoreAPIServerOptions) Complete() error {
 	machinevolumedevices.Register(o.RecommendedOptions.Admission.Plugins)
+	machinepriority.Register(o.RecommendedOptions.Admission.Plugins)
 	resourcequota.Register(o.RecommendedOptions.Admission.Plugins)
 	volumeresizepolicy.Register(o.RecommendedOptions.Admission.Plugins)
+	volumeclone.Register(o.RecommendedOptions.Admission.Plugins)
 
 	o.RecommendedOptions.Admission.RecommendedPluginOrder = append(
 		o.RecommendedOptions.Admission.RecommendedPluginOrder,
 		machinevolumedevices.PluginName,
+		machinepriority.PluginName,
 		resourcequota.PluginName,
 		volumeresizepolicy.PluginName,
+		volumeclone.PluginName,
 	)
 
 	return nil
@@ -81,5 +81,132 @@ var _ = Describe("Core", func() {
 				}))
 			}).Should(Succeed())
 		})
+
+		It("should account for the delta in cpu and memory when resizing a machine", func() {
+			By("creating a larger machine class")
+			largeMachineClass := &computev1alpha1.MachineClass{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "machine-class-large-",
+				},
+				Capabilities: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceCPU:    resource.MustParse("2"),
+					corev1alpha1.ResourceMemory: resource.MustParse("2Gi"),
+				},
+			}
+			Expect(k8sClient.Create(ctx, largeMachineClass)).To(Succeed())
+			DeferCleanup(k8sClient.Delete, ctx, largeMachineClass)
+
+			By("creating a resource quota")
+			resourceQuota := &corev1alpha1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "resource-quota-",
+				},
+				Spec: corev1alpha1.ResourceQuotaSpec{
+					Hard: corev1alpha1.ResourceList{
+						corev1alpha1.ResourceRequestsCPU:    resource.MustParse("2"),
+						corev1alpha1.ResourceRequestsMemory: resource.MustParse("2Gi"),
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resourceQuota)).To(Succeed())
+
+			By("manually updating the resource quota status")
+			baseResourceQuota := resourceQuota.DeepCopy()
+			resourceQuota.Status.Hard = resourceQuota.Spec.Hard
+			resourceQuota.Status.Used = corev1alpha1.ResourceList{
+				corev1alpha1.ResourceRequestsCPU:    resource.MustParse("0"),
+				corev1alpha1.ResourceRequestsMemory: resource.MustParse("0"),
+			}
+			Expect(k8sClient.Status().Patch(ctx, resourceQuota, client.MergeFrom(baseResourceQuota))).To(Succeed())
+
+			By("creating a machine")
+			machine := &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "machine-",
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+
+			By("resizing the machine to the larger machine class")
+			baseMachine := machine.DeepCopy()
+			machine.Spec.MachineClassRef = corev1.LocalObjectReference{Name: largeMachineClass.Name}
+			Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(baseMachine))).To(Succeed())
+
+			By("waiting for the resource quota to account for the delta")
+			resourceQuotaKey := client.ObjectKeyFromObject(resourceQuota)
+			Eventually(ctx, func(g Gomega) {
+				Expect(k8sClient.Get(ctx, resourceQuotaKey, resourceQuota)).To(Succeed())
+				g.Expect(resourceQuota.Status.Used).To(Equal(corev1alpha1.ResourceList{
+					corev1alpha1.ResourceRequestsCPU:    resource.MustParse("2"),
+					corev1alpha1.ResourceRequestsMemory: resource.MustParse("2Gi"),
+				}))
+			}).Should(Succeed())
+
+			By("creating a second machine exceeding the quota")
+			secondMachine := &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "machine-",
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, secondMachine)).To(HaveOccurred())
+		})
+
+		It("should not account cpu for suspended machines", func() {
+			By("creating a resource quota")
+			resourceQuota := &corev1alpha1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "resource-quota-",
+				},
+				Spec: corev1alpha1.ResourceQuotaSpec{
+					Hard: corev1alpha1.ResourceList{
+						corev1alpha1.ResourceRequestsCPU:    resource.MustParse("2"),
+						corev1alpha1.ResourceRequestsMemory: resource.MustParse("2Gi"),
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, resourceQuota)).To(Succeed())
+
+			By("manually updating the resource quota status")
+			baseResourceQuota := resourceQuota.DeepCopy()
+			resourceQuota.Status.Hard = resourceQuota.Spec.Hard
+			resourceQuota.Status.Used = corev1alpha1.ResourceList{
+				corev1alpha1.ResourceRequestsCPU:    resource.MustParse("0"),
+				corev1alpha1.ResourceRequestsMemory: resource.MustParse("0"),
+			}
+			Expect(k8sClient.Status().Patch(ctx, resourceQuota, client.MergeFrom(baseResourceQuota))).To(Succeed())
+
+			By("creating a suspended machine")
+			machine := &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "machine-",
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+					Power:           computev1alpha1.PowerSuspended,
+				},
+			}
+			Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+
+			By("waiting for the resource quota to only account the memory of the machine")
+			resourceQuotaKey := client.ObjectKeyFromObject(resourceQuota)
+			Eventually(ctx, func(g Gomega) {
+				Expect(k8sClient.Get(ctx, resourceQuotaKey, resourceQuota)).To(Succeed())
+				g.Expect(resourceQuota.Status.Used).To(Equal(corev1alpha1.ResourceList{
+					corev1alpha1.ResourceRequestsCPU:    resource.MustParse("0"),
+					corev1alpha1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+				}))
+			}).Should(Succeed())
+		})
 	})
 })
@@ -14,6 +14,8 @@ const (
 	VolumeSpecVolumeClassRefNameField    = storagev1alpha1.VolumeVolumeClassRefNameField
 	VolumeSpecVolumePoolRefNameField     = storagev1alpha1.VolumeVolumePoolRefNameField
 	VolumeSpecVolumeSnapshotRefNameField = storagev1alpha1.VolumeVolumeSnapshotRefNameField
+	VolumeSpecVolumeRefNameField         = storagev1alpha1.VolumeVolumeRefNameField
+	VolumeSpecVolumeBackupRefNameField   = storagev1alpha1.VolumeVolumeBackupRefNameField
 )
 
 func SetupVolumeSpecVolumeClassRefNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
@@ -47,3 +49,23 @@ func SetupVolumeSpecVolumeSnapshotRefNameFieldIndexer(ctx context.Context, index
 		return nil
 	})
 }
+
+func SetupVolumeSpecVolumeRefNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &storagev1alpha1.Volume{}, VolumeSpecVolumeRefNameField, func(obj client.Object) []string {
+		volume := obj.(*storagev1alpha1.Volume)
+		if volume.Spec.DataSource.VolumeRef != nil {
+			return []string{volume.Spec.DataSource.VolumeRef.Name}
+		}
+		return nil
+	})
+}
+
+func SetupVolumeSpecVolumeBackupRefNameFieldIndexer(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &storagev1alpha1.Volume{}, VolumeSpecVolumeBackupRefNameField, func(obj client.Object) []string {
+		volume := obj.(*storagev1alpha1.Volume)
+		if volume.Spec.DataSource.VolumeBackupRef != nil {
+			return []string{volume.Spec.DataSource.VolumeBackupRef.Name}
+		}
+		return nil
+	})
+}
@@ -6,12 +6,16 @@ package compute
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -22,12 +26,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// machineEvictionRetryInterval is the interval after which an eviction rejected by a
+// MachineDisruptionBudget is retried.
+const machineEvictionRetryInterval = 10 * time.Second
+
 type MachineEvictionReconciler struct {
 	events.EventRecorder
 	client.Client
+
+	// EvictionLimiter limits the rate at which machines are evicted from tainted machine pools.
+	// If nil, machines are evicted without any rate limit.
+	EvictionLimiter *rate.Limiter
 }
 
 // +kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;delete
+// +kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/eviction,verbs=create
 // +kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinepools,verbs=get;list;watch
 
 // Reconcile reconciles the desired with the actual state.
@@ -55,18 +68,50 @@ func (r *MachineEvictionReconciler) reconcileExists(ctx context.Context, log log
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if shouldEvict(machinePool.Spec.Taints, machine.Spec.Tolerations) {
-		log.V(2).Info("Evicting machine", "machine", machine.Name)
-		if err := r.Delete(ctx, machine); err != nil {
+	if !shouldEvict(machinePool.Spec.Taints, machine.Spec.Tolerations) {
+		return ctrl.Result{}, nil
+	}
+
+	if r.EvictionLimiter != nil {
+		if reservation := r.EvictionLimiter.Reserve(); reservation.Delay() > 0 {
+			delay := reservation.Delay()
+			reservation.Cancel()
+			log.V(2).Info("Eviction rate limited", "Delay", delay)
+			return ctrl.Result{RequeueAfter: delay}, nil
+		}
+	}
+
+	log.V(2).Info("Evicting machine", "machine", machine.Name)
+	if err := createMachineEviction(ctx, r.Client, machine); err != nil {
+		switch {
+		case apierrors.IsNotFound(err):
+			return ctrl.Result{}, nil
+		case apierrors.IsTooManyRequests(err):
+			log.V(1).Info("Eviction blocked by machine disruption budget", "Reason", err.Error())
+			r.Eventf(machine, nil, corev1.EventTypeWarning, "EvictionBlocked", "Eviction",
+				"Eviction from MachinePool %s blocked: %v", machinePool.Name, err)
+			return ctrl.Result{RequeueAfter: machineEvictionRetryInterval}, nil
+		default:
 			return ctrl.Result{}, fmt.Errorf("error evicting machine: %w", err)
 		}
-		r.Eventf(machine, nil, corev1.EventTypeNormal, "Evicted", "Eviction",
-			"Evicted from MachinePool %s: does not tolerate NoExecute taint", machinePool.Name)
 	}
 
+	r.Eventf(machine, nil, corev1.EventTypeNormal, "Evicted", "Eviction",
+		"Evicted from MachinePool %s: does not tolerate NoExecute taint", machinePool.Name)
 	return ctrl.Result{}, nil
 }
 
+// createMachineEviction evicts the machine via its eviction subresource, honoring any
+// MachineDisruptionBudget covering it. An eviction blocked by a budget yields a TooManyRequests error.
+func createMachineEviction(ctx context.Context, c client.Client, machine *computev1alpha1.Machine) error {
+	return c.SubResource("eviction").Create(ctx, machine, &computev1alpha1.MachineEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: machine.Namespace,
+			Name:      machine.Name,
+		},
+	})
+}
+
 func shouldEvict(taints []commonv1alpha1.Taint, tolerations []commonv1alpha1.Toleration) bool {
 	for _, taint := range taints {
 		if taint.Effect != commonv1alpha1.TaintEffectNoExecute {
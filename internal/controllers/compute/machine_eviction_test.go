@@ -12,6 +12,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
@@ -73,6 +76,73 @@ var _ = Describe("MachineEviction", func() {
 		Eventually(Get(machine)).Should(Satisfy(apierrors.IsNotFound))
 	})
 
+	It("should not evict more machines than allowed by their machine disruption budget", func(ctx SpecContext) {
+		By("creating a machine pool")
+		machinePool := &computev1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, machinePool)).To(Succeed(), "failed to create machine pool")
+
+		By("creating two running machines bound to the pool")
+		var machines []*computev1alpha1.Machine
+		for range 2 {
+			machine := &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "test-machine-",
+					Labels:       map[string]string{"app": "evict"},
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+					MachinePoolRef:  &corev1.LocalObjectReference{Name: machinePool.Name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+			Eventually(UpdateStatus(machine, func() {
+				machine.Status.State = computev1alpha1.MachineStateRunning
+			})).Should(Succeed())
+			machines = append(machines, machine)
+		}
+
+		By("creating a machine disruption budget keeping one machine available")
+		machineDisruptionBudget := &computev1alpha1.MachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-mdb-",
+			},
+			Spec: computev1alpha1.MachineDisruptionBudgetSpec{
+				Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "evict"}},
+				MinAvailable: ptr.To(intstr.FromInt32(1)),
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineDisruptionBudget)).To(Succeed(), "failed to create machine disruption budget")
+		Eventually(Object(machineDisruptionBudget)).Should(HaveField("Status.DisruptionsAllowed", int32(1)))
+
+		By("adding a NoExecute taint to the machine pool")
+		Eventually(Update(machinePool, func() {
+			machinePool.Spec.Taints = []commonv1alpha1.Taint{
+				{
+					Key:    "maintenance",
+					Effect: commonv1alpha1.TaintEffectNoExecute,
+				},
+			}
+		})).Should(Succeed())
+
+		By("asserting exactly one machine is evicted")
+		Eventually(func(g Gomega) {
+			machineList := &computev1alpha1.MachineList{}
+			g.Expect(k8sClient.List(ctx, machineList, client.InNamespace(ns.Name), client.MatchingLabels{"app": "evict"})).To(Succeed())
+			g.Expect(machineList.Items).To(HaveLen(1))
+		}).Should(Succeed())
+		Consistently(func(g Gomega) {
+			machineList := &computev1alpha1.MachineList{}
+			g.Expect(k8sClient.List(ctx, machineList, client.InNamespace(ns.Name), client.MatchingLabels{"app": "evict"})).To(Succeed())
+			g.Expect(machineList.Items).To(HaveLen(1))
+		}).Should(Succeed())
+	})
+
 	It("should evict a machine whose toleration does not match the NoExecute taint but retain one that tolerates it", func(ctx SpecContext) {
 		By("creating a machine pool")
 		machinePool := &computev1alpha1.MachinePool{
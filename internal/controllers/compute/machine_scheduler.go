@@ -12,10 +12,12 @@ import (
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
+	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler/framework"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,14 +37,23 @@ type MachineScheduler struct {
 	events.EventRecorder
 	client.Client
 
-	Cache    *scheduler.Cache
-	snapshot *scheduler.Snapshot
+	Cache *scheduler.Cache
+	// ScoreConfig configures the score plugins used to rank the machine pools a machine may be placed on.
+	// If no score plugins are configured, the machine pools are ranked by their remaining capacity.
+	ScoreConfig framework.Config
+
+	snapshot  *scheduler.Snapshot
+	framework *framework.Framework[*scheduler.ContainerInfo, *computev1alpha1.Machine]
+	// preemptionFramework filters the machine pools a machine could be placed on after preempting
+	// machines with a lower priority.
+	preemptionFramework *framework.Framework[*scheduler.ContainerInfo, *computev1alpha1.Machine]
 }
 
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 //+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
-//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;update;patch;delete
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/eviction,verbs=create
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinepools,verbs=get;list;watch
 
 // Reconcile reconciles the desired with the actual state.
@@ -82,12 +93,17 @@ func (s *MachineScheduler) matchesLabels(_ context.Context, pool *scheduler.Cont
 	return machinePoolSelector.Matches(nodeLabels)
 }
 
-func (s *MachineScheduler) poolReady(_ context.Context, pool *scheduler.ContainerInfo) bool {
+func (s *MachineScheduler) poolReady(_ context.Context, pool *scheduler.ContainerInfo, _ *computev1alpha1.Machine) bool {
 	cond := computev1alpha1.FindMachinePoolCondition(pool.Node().Status.Conditions, computev1alpha1.MachinePoolReady)
 
 	return cond != nil && cond.Status == corev1.ConditionTrue
 }
 
+func (s *MachineScheduler) poolSchedulable(_ context.Context, pool *scheduler.ContainerInfo, _ *computev1alpha1.Machine) bool {
+	spec := pool.Node().Spec
+	return !spec.Unschedulable && spec.Drain == nil
+}
+
 func (s *MachineScheduler) tolerateTaints(_ context.Context, pool *scheduler.ContainerInfo, machine *computev1alpha1.Machine) bool {
 	return v1alpha1.TolerateTaints(machine.Spec.Tolerations, pool.Node().Spec.Taints)
 }
@@ -96,6 +112,15 @@ func (s *MachineScheduler) fitsPool(_ context.Context, pool *scheduler.Container
 	return pool.MaxAllocatable(machine.Spec.MachineClassRef.Name) > 0
 }
 
+// topologyDomain returns the topology domain of the pool for the given topology key.
+func topologyDomain(pool *scheduler.ContainerInfo, topologyKey string) (string, bool) {
+	if topologyKey == computev1alpha1.MachinePoolTopologyKey {
+		return pool.Node().Name, true
+	}
+	domain, ok := pool.Labels()[topologyKey]
+	return domain, ok
+}
+
 func (s *MachineScheduler) reconcileExists(ctx context.Context, log logr.Logger, machine *computev1alpha1.Machine) (ctrl.Result, error) {
 	s.updateSnapshot()
 
@@ -105,43 +130,23 @@ func (s *MachineScheduler) reconcileExists(ctx context.Context, log logr.Logger,
 		return ctrl.Result{}, nil
 	}
 
-	var filteredNodes []*scheduler.ContainerInfo
-	for _, node := range nodes {
-		if !s.poolReady(ctx, node) {
-			log.Info("node filtered", "reason", "pool not ready")
-			continue
+	selectedNode, ok := s.framework.Select(ctx, nodes, machine)
+	if !ok {
+		requeueAfter, err := s.preempt(ctx, log, nodes, machine)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("error preempting machines: %w", err)
 		}
-		if !s.tolerateTaints(ctx, node, machine) {
-			log.Info("node filtered", "reason", "taints do not match")
-			continue
-		}
-		if !s.matchesLabels(ctx, node, machine) {
-			log.Info("node filtered", "reason", "label do not match")
-			continue
-		}
-		if !s.fitsPool(ctx, node, machine) {
-			log.Info("node filtered", "reason", "resources do not match")
-			continue
+		if requeueAfter > 0 {
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
 		}
 
-		filteredNodes = append(filteredNodes, node)
-	}
-
-	if len(filteredNodes) == 0 {
 		s.Eventf(machine, nil, corev1.EventTypeNormal, outOfCapacity, "No nodes available after filtering to schedule %s on", machine.Name)
 		return ctrl.Result{}, nil
 	}
-
-	maxAllocatableNode := filteredNodes[0]
-	for _, node := range filteredNodes[1:] {
-		if node.MaxAllocatable(machine.Spec.MachineClassRef.Name) > maxAllocatableNode.MaxAllocatable(machine.Spec.MachineClassRef.Name) {
-			maxAllocatableNode = node
-		}
-	}
-	log.V(1).Info("Determined node to schedule on", "NodeName", maxAllocatableNode.Node().Name, "Instances", maxAllocatableNode.NumInstances(), "Allocatable", maxAllocatableNode.MaxAllocatable(machine.Spec.MachineClassRef.Name))
+	log.V(1).Info("Determined node to schedule on", "NodeName", selectedNode.Node().Name, "Instances", selectedNode.NumInstances(), "Allocatable", selectedNode.MaxAllocatable(machine.Spec.MachineClassRef.Name))
 
 	log.V(1).Info("Assuming machine to be on node")
-	if err := s.assume(machine, maxAllocatableNode.Node().Name); err != nil {
+	if err := s.assume(machine, selectedNode.Node().Name); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -210,7 +215,7 @@ func (s *MachineScheduler) enqueueUnscheduledMachines(ctx context.Context, queue
 		if machine.Spec.MachinePoolRef != nil {
 			continue
 		}
-		queue.Add(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&machine)})
+		addWithPriority(queue, &machine)
 	}
 }
 
@@ -291,14 +296,57 @@ func (s *MachineScheduler) handleMachinePool() handler.EventHandler {
 }
 
 func (s *MachineScheduler) SetupWithManager(mgr manager.Manager) error {
+	scorers, err := framework.NewScorePlugins[*scheduler.ContainerInfo, *computev1alpha1.Machine](s.ScoreConfig)
+	if err != nil {
+		return fmt.Errorf("error creating score plugins: %w", err)
+	}
+	if len(scorers) == 0 {
+		scorers = []framework.WeightedScorePlugin[*scheduler.ContainerInfo, *computev1alpha1.Machine]{
+			{ScorePlugin: framework.Spread[*scheduler.ContainerInfo, *computev1alpha1.Machine]{}, Weight: 1},
+		}
+	}
+	topologySpread := &topologySpread{
+		eligible: func(ctx context.Context, pool *scheduler.ContainerInfo, machine *computev1alpha1.Machine) bool {
+			return s.poolReady(ctx, pool, machine) && s.matchesLabels(ctx, pool, machine)
+		},
+	}
+	scorers = append(scorers, framework.WeightedScorePlugin[*scheduler.ContainerInfo, *computev1alpha1.Machine]{
+		ScorePlugin: topologySpread,
+		Weight:      topologySpreadScoreWeight,
+	})
+	machineAffinity := &machineAffinity{}
+	scorers = append(scorers, framework.WeightedScorePlugin[*scheduler.ContainerInfo, *computev1alpha1.Machine]{
+		ScorePlugin: machineAffinity,
+		Weight:      machineAffinityScoreWeight,
+	})
+	preemptionFilters := []framework.FilterPlugin[*scheduler.ContainerInfo, *computev1alpha1.Machine]{
+		framework.FilterFunc("pool-ready", s.poolReady),
+		framework.FilterFunc("pool-schedulable", s.poolSchedulable),
+		framework.FilterFunc("tolerate-taints", s.tolerateTaints),
+		framework.FilterFunc("matches-labels", s.matchesLabels),
+		topologySpread,
+		machineAffinity,
+	}
+	s.framework = framework.New(
+		append([]framework.FilterPlugin[*scheduler.ContainerInfo, *computev1alpha1.Machine]{
+			framework.FilterFunc("fits-pool", s.fitsPool),
+		}, preemptionFilters...),
+		scorers,
+	)
+	s.preemptionFramework = framework.New(preemptionFilters, nil)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("machine-scheduler").
 		WithOptions(controller.Options{
 			// Only a single concurrent reconcile since it is serialized on the scheduling algorithm's node fitting.
 			MaxConcurrentReconciles: 1,
+			// Schedule machines with a higher priority first.
+			UsePriorityQueue: ptr.To(true),
 		}).
-		// Enqueue unscheduled machines.
-		For(&computev1alpha1.Machine{},
+		// Enqueue unscheduled machines by their priority.
+		Watches(
+			&computev1alpha1.Machine{},
+			s.enqueueByPriority(),
 			builder.WithPredicates(
 				s.isMachineNotAssigned(),
 			),
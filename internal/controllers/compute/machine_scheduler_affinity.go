@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"slices"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	machineAffinityName = "machine-affinity"
+
+	// machineAffinityScoreWeight is the weight of the machine affinity score relative to the configured score plugins.
+	machineAffinityScoreWeight = 2
+)
+
+// machineAffinity filters and scores machine pools by the affinity of a machine and
+// the required anti-affinity of the machines already placed on machine pools.
+type machineAffinity struct {
+	requiredAffinity      []affinityTermState
+	requiredAntiAffinity  []affinityTermState
+	preferredAffinity     []affinityTermState
+	preferredAntiAffinity []affinityTermState
+	// existingAntiAffinity are the required anti-affinity terms of placed machines matching the scheduled machine.
+	existingAntiAffinity []affinityTermState
+}
+
+type affinityTermState struct {
+	topologyKey string
+	weight      int64
+	// domains are the topology domains containing at least one machine matching the term.
+	domains sets.Set[string]
+	// satisfiedAnywhere indicates a required affinity term is satisfied on any machine pool. This is the
+	// case if no machine matches the term but the scheduled machine itself, e.g. for the first replica.
+	satisfiedAnywhere bool
+}
+
+// affinityTermMatches reports whether the machine matches the term specified by a machine in the given namespace.
+func affinityTermMatches(term *computev1alpha1.MachineAffinityTerm, selector labels.Selector, namespace string, machine *computev1alpha1.Machine) bool {
+	namespaces := term.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{namespace}
+	}
+	return slices.Contains(namespaces, machine.Namespace) && selector.Matches(labels.Set(machine.Labels))
+}
+
+func newAffinityTermState(
+	ctx context.Context,
+	pools []*scheduler.ContainerInfo,
+	machine *computev1alpha1.Machine,
+	term *computev1alpha1.MachineAffinityTerm,
+	weight int64,
+) affinityTermState {
+	log := ctrl.LoggerFrom(ctx)
+
+	selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+	if err != nil {
+		log.Error(err, "Invalid machine affinity term label selector", "TopologyKey", term.TopologyKey)
+		selector = labels.Nothing()
+	}
+
+	state := affinityTermState{
+		topologyKey: term.TopologyKey,
+		weight:      weight,
+		domains:     sets.New[string](),
+	}
+	for _, pool := range pools {
+		domain, ok := topologyDomain(pool, term.TopologyKey)
+		if !ok {
+			continue
+		}
+
+		for _, instance := range pool.Instances() {
+			if instance.UID != machine.UID && affinityTermMatches(term, selector, machine.Namespace, instance) {
+				state.domains.Insert(domain)
+				break
+			}
+		}
+	}
+	state.satisfiedAnywhere = state.domains.Len() == 0 && affinityTermMatches(term, selector, machine.Namespace, machine)
+	return state
+}
+
+func (a *machineAffinity) Name() string {
+	return machineAffinityName
+}
+
+func (a *machineAffinity) PreFilter(ctx context.Context, pools []*scheduler.ContainerInfo, machine *computev1alpha1.Machine) {
+	*a = machineAffinity{}
+
+	if affinity := machine.Spec.Affinity; affinity != nil {
+		if machineAffinity := affinity.MachineAffinity; machineAffinity != nil {
+			for i := range machineAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+				term := &machineAffinity.RequiredDuringSchedulingIgnoredDuringExecution[i]
+				a.requiredAffinity = append(a.requiredAffinity, newAffinityTermState(ctx, pools, machine, term, 0))
+			}
+			for i := range machineAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+				term := &machineAffinity.PreferredDuringSchedulingIgnoredDuringExecution[i]
+				a.preferredAffinity = append(a.preferredAffinity, newAffinityTermState(ctx, pools, machine, &term.MachineAffinityTerm, int64(term.Weight)))
+			}
+		}
+		if machineAntiAffinity := affinity.MachineAntiAffinity; machineAntiAffinity != nil {
+			for i := range machineAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+				term := &machineAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution[i]
+				a.requiredAntiAffinity = append(a.requiredAntiAffinity, newAffinityTermState(ctx, pools, machine, term, 0))
+			}
+			for i := range machineAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+				term := &machineAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[i]
+				a.preferredAntiAffinity = append(a.preferredAntiAffinity, newAffinityTermState(ctx, pools, machine, &term.MachineAffinityTerm, int64(term.Weight)))
+			}
+		}
+	}
+
+	a.existingAntiAffinity = existingAntiAffinityTermStates(ctx, pools, machine)
+}
+
+// existingAntiAffinityTermStates returns the required anti-affinity terms of the machines placed on the pools
+// that match the given machine, together with the topology domain of the machine specifying them.
+func existingAntiAffinityTermStates(ctx context.Context, pools []*scheduler.ContainerInfo, machine *computev1alpha1.Machine) []affinityTermState {
+	log := ctrl.LoggerFrom(ctx)
+
+	var states []affinityTermState
+	for _, pool := range pools {
+		for _, instance := range pool.Instances() {
+			if instance.UID == machine.UID || instance.Spec.Affinity == nil || instance.Spec.Affinity.MachineAntiAffinity == nil {
+				continue
+			}
+
+			for _, term := range instance.Spec.Affinity.MachineAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+				selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+				if err != nil {
+					log.Error(err, "Invalid machine affinity term label selector", "TopologyKey", term.TopologyKey)
+					continue
+				}
+				if !affinityTermMatches(&term, selector, instance.Namespace, machine) {
+					continue
+				}
+
+				domain, ok := topologyDomain(pool, term.TopologyKey)
+				if !ok {
+					continue
+				}
+				states = append(states, affinityTermState{
+					topologyKey: term.TopologyKey,
+					domains:     sets.New(domain),
+				})
+			}
+		}
+	}
+	return states
+}
+
+// inDomains reports whether the pool is in any of the topology domains of the term.
+func (s *affinityTermState) inDomains(pool *scheduler.ContainerInfo) bool {
+	domain, ok := topologyDomain(pool, s.topologyKey)
+	return ok && s.domains.Has(domain)
+}
+
+func (a *machineAffinity) Filter(_ context.Context, pool *scheduler.ContainerInfo, _ *computev1alpha1.Machine) bool {
+	for _, term := range a.requiredAffinity {
+		if !term.satisfiedAnywhere && !term.inDomains(pool) {
+			return false
+		}
+	}
+	for _, term := range a.requiredAntiAffinity {
+		if term.inDomains(pool) {
+			return false
+		}
+	}
+	for _, term := range a.existingAntiAffinity {
+		if term.inDomains(pool) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *machineAffinity) Score(_ context.Context, pool *scheduler.ContainerInfo, _ *computev1alpha1.Machine) int64 {
+	var score int64
+	for _, term := range a.preferredAffinity {
+		if term.inDomains(pool) {
+			score += term.weight
+		}
+	}
+	for _, term := range a.preferredAntiAffinity {
+		if term.inDomains(pool) {
+			score -= term.weight
+		}
+	}
+	return score
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	preempted         = "Preempted"
+	preemptionBlocked = "PreemptionBlocked"
+
+	// preemptionRequeueInterval is the interval after which a machine that preempted other machines
+	// is scheduled again.
+	preemptionRequeueInterval = 1 * time.Second
+)
+
+// machinePriority returns the priority of the machine.
+func machinePriority(machine *computev1alpha1.Machine) int32 {
+	return ptr.Deref(machine.Spec.Priority, computev1alpha1.DefaultMachinePriority)
+}
+
+// addWithPriority adds a request for the machine to the queue. If the queue is a priority queue,
+// machines with a higher priority are dequeued first.
+func addWithPriority(queue workqueue.TypedRateLimitingInterface[reconcile.Request], machine *computev1alpha1.Machine) {
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(machine)}
+	if priorityQueue, ok := queue.(priorityqueue.PriorityQueue[reconcile.Request]); ok {
+		priorityQueue.AddWithOpts(priorityqueue.AddOpts{Priority: ptr.To(int(machinePriority(machine)))}, req)
+		return
+	}
+	queue.Add(req)
+}
+
+func (s *MachineScheduler) enqueueByPriority() handler.EventHandler {
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, evt event.CreateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			addWithPriority(queue, evt.Object.(*computev1alpha1.Machine))
+		},
+		UpdateFunc: func(ctx context.Context, evt event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			addWithPriority(queue, evt.ObjectNew.(*computev1alpha1.Machine))
+		},
+		GenericFunc: func(ctx context.Context, evt event.GenericEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			addWithPriority(queue, evt.Object.(*computev1alpha1.Machine))
+		},
+	}
+}
+
+// preemptionVictim is a machine that may be preempted to make room for a machine with a higher priority.
+type preemptionVictim struct {
+	pool    *scheduler.ContainerInfo
+	machine *computev1alpha1.Machine
+}
+
+// selectPreemptionVictims selects the machines that may be preempted on the given pools to make room for
+// the given machine, ordered by preference.
+// Only machines of the same machine class with a lower priority are considered, as only those are guaranteed
+// to free capacity for the machine. Machines with the lowest priority come first, preferring the most
+// recently created ones on ties.
+// If a victim of an earlier preemption is still being deleted, no victims are selected and pending is true.
+func selectPreemptionVictims(pools []*scheduler.ContainerInfo, machine *computev1alpha1.Machine) (victims []preemptionVictim, pending bool) {
+	priority := machinePriority(machine)
+	className := machine.Spec.MachineClassRef.Name
+
+	for _, pool := range pools {
+		if _, ok := pool.Node().Status.Allocatable[corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, className)]; !ok {
+			continue
+		}
+
+		for _, instance := range pool.Instances() {
+			if instance.Spec.MachineClassRef.Name != className || machinePriority(instance) >= priority {
+				continue
+			}
+			if !instance.DeletionTimestamp.IsZero() {
+				return nil, true
+			}
+
+			victims = append(victims, preemptionVictim{pool: pool, machine: instance})
+		}
+	}
+
+	slices.SortStableFunc(victims, func(a, b preemptionVictim) int {
+		switch {
+		case isBetterPreemptionVictim(a.machine, b.machine):
+			return -1
+		case isBetterPreemptionVictim(b.machine, a.machine):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return victims, false
+}
+
+func isBetterPreemptionVictim(machine, other *computev1alpha1.Machine) bool {
+	if priority, otherPriority := machinePriority(machine), machinePriority(other); priority != otherPriority {
+		return priority < otherPriority
+	}
+	return other.CreationTimestamp.Before(&machine.CreationTimestamp)
+}
+
+// preempt evicts a machine with a lower priority than the given machine to make room for it.
+// Preemption honors MachineDisruptionBudgets: victims whose eviction is blocked by a budget are
+// skipped in favor of the next candidate.
+// It returns the interval after which the machine should be scheduled again, or zero if no machine
+// can be preempted for it.
+func (s *MachineScheduler) preempt(ctx context.Context, log logr.Logger, pools []*scheduler.ContainerInfo, machine *computev1alpha1.Machine) (time.Duration, error) {
+	victims, pending := selectPreemptionVictims(s.preemptionFramework.Filter(ctx, pools, machine), machine)
+	if pending {
+		log.V(1).Info("Waiting for preempted machine to be deleted")
+		return preemptionRequeueInterval, nil
+	}
+	if len(victims) == 0 {
+		return 0, nil
+	}
+
+	for _, victim := range victims {
+		log.V(1).Info("Preempting machine", "Victim", client.ObjectKeyFromObject(victim.machine), "NodeName", victim.pool.Node().Name)
+		if err := createMachineEviction(ctx, s.Client, victim.machine); err != nil {
+			switch {
+			case apierrors.IsNotFound(err):
+				// The victim is already gone, its capacity is freed up.
+				return preemptionRequeueInterval, nil
+			case apierrors.IsTooManyRequests(err):
+				log.V(1).Info("Preemption blocked by machine disruption budget", "Victim", client.ObjectKeyFromObject(victim.machine), "Reason", err.Error())
+				continue
+			default:
+				return 0, fmt.Errorf("error preempting machine %s: %w", client.ObjectKeyFromObject(victim.machine), err)
+			}
+		}
+
+		s.Eventf(victim.machine, nil, corev1.EventTypeNormal, "Evicted", "Eviction",
+			"Preempted from MachinePool %s by machine %s/%s with higher priority",
+			victim.pool.Node().Name, machine.Namespace, machine.Name)
+		s.Eventf(machine, nil, corev1.EventTypeNormal, preempted, "Preemption",
+			"Preempted machine %s/%s on MachinePool %s", victim.machine.Namespace, victim.machine.Name, victim.pool.Node().Name)
+		return preemptionRequeueInterval, nil
+	}
+
+	s.Eventf(machine, nil, corev1.EventTypeWarning, preemptionBlocked, "Preemption",
+		"Preemption of %d machine(s) with a lower priority blocked by machine disruption budgets", len(victims))
+	return machineEvictionRetryInterval, nil
+}
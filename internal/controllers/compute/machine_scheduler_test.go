@@ -11,8 +11,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
 
@@ -260,6 +263,318 @@ var _ = Describe("MachineScheduler", func() {
 		))
 	})
 
+	It("should spread machines across topology domains", func(ctx SpecContext) {
+		By("creating a machine pool in each zone")
+		var machinePools []*computev1alpha1.MachinePool
+		for _, zone := range []string{"zone-a", "zone-b"} {
+			machinePool := &computev1alpha1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-pool-",
+					Labels: map[string]string{
+						"topology-test":                          ns.Name,
+						string(commonv1alpha1.TopologyLabelZone): zone,
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machinePool)).To(Succeed(), "failed to create machine pool")
+
+			Eventually(UpdateStatus(machinePool, func() {
+				machinePool.Status.AvailableMachineClasses = []corev1.LocalObjectReference{{Name: machineClass.Name}}
+				machinePool.Status.Allocatable = corev1alpha1.ResourceList{
+					corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, machineClass.Name): resource.MustParse("10"),
+				}
+				setMachinePoolReady(machinePool, corev1.ConditionTrue)
+			})).Should(Succeed())
+			machinePools = append(machinePools, machinePool)
+		}
+
+		newMachine := func() *computev1alpha1.Machine {
+			return &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "test-machine-",
+					Labels:       map[string]string{"app": "ha"},
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef:     corev1.LocalObjectReference{Name: machineClass.Name},
+					MachinePoolSelector: map[string]string{"topology-test": ns.Name},
+					TopologySpreadConstraints: []computev1alpha1.TopologySpreadConstraint{
+						{
+							MaxSkew:           1,
+							TopologyKey:       string(commonv1alpha1.TopologyLabelZone),
+							WhenUnsatisfiable: computev1alpha1.DoNotSchedule,
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "ha"},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		By("creating a first machine and waiting for it to be scheduled")
+		machine1 := newMachine()
+		Expect(k8sClient.Create(ctx, machine1)).To(Succeed(), "failed to create machine")
+		Eventually(Object(machine1)).Should(HaveField("Spec.MachinePoolRef", Not(BeNil())))
+
+		By("creating a second machine")
+		machine2 := newMachine()
+		Expect(k8sClient.Create(ctx, machine2)).To(Succeed(), "failed to create machine")
+
+		By("waiting for the second machine to be scheduled onto the other zone")
+		var otherPoolName string
+		for _, machinePool := range machinePools {
+			if machinePool.Name != machine1.Spec.MachinePoolRef.Name {
+				otherPoolName = machinePool.Name
+			}
+		}
+		Eventually(Object(machine2)).Should(
+			HaveField("Spec.MachinePoolRef", Equal(&corev1.LocalObjectReference{Name: otherPoolName})),
+		)
+	})
+
+	It("should not schedule machines with machine anti-affinity onto the same machine pool", func(ctx SpecContext) {
+		By("creating two machine pools")
+		var machinePools []*computev1alpha1.MachinePool
+		for range 2 {
+			machinePool := &computev1alpha1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-pool-",
+					Labels:       map[string]string{"affinity-test": ns.Name},
+				},
+			}
+			Expect(k8sClient.Create(ctx, machinePool)).To(Succeed(), "failed to create machine pool")
+
+			Eventually(UpdateStatus(machinePool, func() {
+				machinePool.Status.AvailableMachineClasses = []corev1.LocalObjectReference{{Name: machineClass.Name}}
+				machinePool.Status.Allocatable = corev1alpha1.ResourceList{
+					corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, machineClass.Name): resource.MustParse("10"),
+				}
+				setMachinePoolReady(machinePool, corev1.ConditionTrue)
+			})).Should(Succeed())
+			machinePools = append(machinePools, machinePool)
+		}
+
+		newMachine := func() *computev1alpha1.Machine {
+			return &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "test-machine-",
+					Labels:       map[string]string{"app": "db"},
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef:     corev1.LocalObjectReference{Name: machineClass.Name},
+					MachinePoolSelector: map[string]string{"affinity-test": ns.Name},
+					Affinity: &computev1alpha1.Affinity{
+						MachineAntiAffinity: &computev1alpha1.MachineAntiAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: []computev1alpha1.MachineAffinityTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "db"},
+									},
+									TopologyKey: computev1alpha1.MachinePoolTopologyKey,
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		By("creating a first machine and waiting for it to be scheduled")
+		machine1 := newMachine()
+		Expect(k8sClient.Create(ctx, machine1)).To(Succeed(), "failed to create machine")
+		Eventually(Object(machine1)).Should(HaveField("Spec.MachinePoolRef", Not(BeNil())))
+
+		By("creating a second machine")
+		machine2 := newMachine()
+		Expect(k8sClient.Create(ctx, machine2)).To(Succeed(), "failed to create machine")
+
+		By("waiting for the second machine to be scheduled onto the other machine pool")
+		var otherPoolName string
+		for _, machinePool := range machinePools {
+			if machinePool.Name != machine1.Spec.MachinePoolRef.Name {
+				otherPoolName = machinePool.Name
+			}
+		}
+		Eventually(Object(machine2)).Should(
+			HaveField("Spec.MachinePoolRef", Equal(&corev1.LocalObjectReference{Name: otherPoolName})),
+		)
+
+		By("creating a third machine")
+		machine3 := newMachine()
+		Expect(k8sClient.Create(ctx, machine3)).To(Succeed(), "failed to create machine")
+
+		By("asserting the third machine stays unscheduled")
+		Consistently(Object(machine3)).Should(HaveField("Spec.MachinePoolRef", BeNil()))
+	})
+
+	It("should preempt machines with a lower priority if no machine pool fits", func(ctx SpecContext) {
+		By("creating a low and a high machine priority class")
+		lowPriorityClass := &computev1alpha1.MachinePriorityClass{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "low-"},
+			Value:      10,
+		}
+		Expect(k8sClient.Create(ctx, lowPriorityClass)).To(Succeed(), "failed to create low machine priority class")
+		DeferCleanup(k8sClient.Delete, lowPriorityClass)
+
+		highPriorityClass := &computev1alpha1.MachinePriorityClass{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "high-"},
+			Value:      1000,
+		}
+		Expect(k8sClient.Create(ctx, highPriorityClass)).To(Succeed(), "failed to create high machine priority class")
+		DeferCleanup(k8sClient.Delete, highPriorityClass)
+
+		By("creating a machine pool with room for a single machine")
+		machinePool := &computev1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+				Labels:       map[string]string{"preemption-test": ns.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machinePool)).To(Succeed(), "failed to create machine pool")
+
+		Eventually(UpdateStatus(machinePool, func() {
+			machinePool.Status.AvailableMachineClasses = []corev1.LocalObjectReference{{Name: machineClass.Name}}
+			machinePool.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, machineClass.Name): resource.MustParse("1"),
+			}
+			setMachinePoolReady(machinePool, corev1.ConditionTrue)
+		})).Should(Succeed())
+
+		newMachine := func(priorityClassName string) *computev1alpha1.Machine {
+			return &computev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "test-machine-",
+				},
+				Spec: computev1alpha1.MachineSpec{
+					MachineClassRef:     corev1.LocalObjectReference{Name: machineClass.Name},
+					MachinePoolSelector: map[string]string{"preemption-test": ns.Name},
+					PriorityClassName:   priorityClassName,
+				},
+			}
+		}
+
+		By("creating a low priority machine and waiting for it to be scheduled")
+		lowPriorityMachine := newMachine(lowPriorityClass.Name)
+		Expect(k8sClient.Create(ctx, lowPriorityMachine)).To(Succeed(), "failed to create low priority machine")
+		Expect(lowPriorityMachine.Spec.Priority).To(HaveValue(Equal(int32(10))))
+		Eventually(Object(lowPriorityMachine)).Should(
+			HaveField("Spec.MachinePoolRef", Equal(&corev1.LocalObjectReference{Name: machinePool.Name})),
+		)
+
+		By("creating a high priority machine")
+		highPriorityMachine := newMachine(highPriorityClass.Name)
+		Expect(k8sClient.Create(ctx, highPriorityMachine)).To(Succeed(), "failed to create high priority machine")
+
+		By("waiting for the low priority machine to be preempted")
+		Eventually(Get(lowPriorityMachine)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("waiting for the high priority machine to be scheduled onto the machine pool")
+		Eventually(Object(highPriorityMachine)).Should(
+			HaveField("Spec.MachinePoolRef", Equal(&corev1.LocalObjectReference{Name: machinePool.Name})),
+		)
+	})
+
+	It("should not preempt machines protected by a machine disruption budget", func(ctx SpecContext) {
+		By("creating a low and a high machine priority class")
+		lowPriorityClass := &computev1alpha1.MachinePriorityClass{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "low-"},
+			Value:      10,
+		}
+		Expect(k8sClient.Create(ctx, lowPriorityClass)).To(Succeed(), "failed to create low machine priority class")
+		DeferCleanup(k8sClient.Delete, lowPriorityClass)
+
+		highPriorityClass := &computev1alpha1.MachinePriorityClass{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "high-"},
+			Value:      1000,
+		}
+		Expect(k8sClient.Create(ctx, highPriorityClass)).To(Succeed(), "failed to create high machine priority class")
+		DeferCleanup(k8sClient.Delete, highPriorityClass)
+
+		By("creating a machine pool with room for a single machine")
+		machinePool := &computev1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+				Labels:       map[string]string{"preemption-budget-test": ns.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machinePool)).To(Succeed(), "failed to create machine pool")
+
+		Eventually(UpdateStatus(machinePool, func() {
+			machinePool.Status.AvailableMachineClasses = []corev1.LocalObjectReference{{Name: machineClass.Name}}
+			machinePool.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, machineClass.Name): resource.MustParse("1"),
+			}
+			setMachinePoolReady(machinePool, corev1.ConditionTrue)
+		})).Should(Succeed())
+
+		By("creating a low priority machine and waiting for it to be scheduled")
+		lowPriorityMachine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+				Labels:       map[string]string{"app": "protected"},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef:     corev1.LocalObjectReference{Name: machineClass.Name},
+				MachinePoolSelector: map[string]string{"preemption-budget-test": ns.Name},
+				PriorityClassName:   lowPriorityClass.Name,
+			},
+		}
+		Expect(k8sClient.Create(ctx, lowPriorityMachine)).To(Succeed(), "failed to create low priority machine")
+		Eventually(Object(lowPriorityMachine)).Should(
+			HaveField("Spec.MachinePoolRef", Equal(&corev1.LocalObjectReference{Name: machinePool.Name})),
+		)
+
+		By("creating a machine disruption budget that does not allow any disruption")
+		machineDisruptionBudget := &computev1alpha1.MachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-mdb-",
+			},
+			Spec: computev1alpha1.MachineDisruptionBudgetSpec{
+				Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "protected"}},
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineDisruptionBudget)).To(Succeed(), "failed to create machine disruption budget")
+		Eventually(Object(machineDisruptionBudget)).Should(SatisfyAll(
+			HaveField("Status.ObservedGeneration", machineDisruptionBudget.Generation),
+			HaveField("Status.DisruptionsAllowed", int32(0)),
+		))
+
+		By("creating a high priority machine")
+		highPriorityMachine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef:     corev1.LocalObjectReference{Name: machineClass.Name},
+				MachinePoolSelector: map[string]string{"preemption-budget-test": ns.Name},
+				PriorityClassName:   highPriorityClass.Name,
+			},
+		}
+		Expect(k8sClient.Create(ctx, highPriorityMachine)).To(Succeed(), "failed to create high priority machine")
+
+		By("asserting the low priority machine is not preempted")
+		Consistently(Object(lowPriorityMachine)).Should(HaveField("DeletionTimestamp", BeNil()))
+		Expect(highPriorityMachine).To(HaveField("Spec.MachinePoolRef", BeNil()))
+
+		By("deleting the machine disruption budget")
+		Expect(k8sClient.Delete(ctx, machineDisruptionBudget)).To(Succeed())
+
+		By("waiting for the low priority machine to be preempted")
+		Eventually(Get(lowPriorityMachine)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("waiting for the high priority machine to be scheduled onto the machine pool")
+		Eventually(Object(highPriorityMachine)).Should(
+			HaveField("Spec.MachinePoolRef", Equal(&corev1.LocalObjectReference{Name: machinePool.Name})),
+		)
+	})
+
 	It("should schedule machine on pool with most allocatable resources", func(ctx SpecContext) {
 		By("creating a machine pool")
 		machinePool := &computev1alpha1.MachinePool{
@@ -682,4 +997,52 @@ var _ = Describe("MachineScheduler", func() {
 			HaveField("Status.State", Equal(computev1alpha1.MachineStatePending)),
 		))
 	})
+
+	It("should not schedule machines onto an unschedulable machine pool", func(ctx SpecContext) {
+		By("creating an unschedulable machine pool")
+		machinePool := &computev1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+			},
+			Spec: computev1alpha1.MachinePoolSpec{
+				Unschedulable: true,
+			},
+		}
+		Expect(k8sClient.Create(ctx, machinePool)).To(Succeed(), "failed to create machine pool")
+
+		By("patching the machine pool status to contain a machine class")
+		Eventually(UpdateStatus(machinePool, func() {
+			machinePool.Status.AvailableMachineClasses = []corev1.LocalObjectReference{{Name: machineClass.Name}}
+			machinePool.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, machineClass.Name): resource.MustParse("10"),
+			}
+			setMachinePoolReady(machinePool, corev1.ConditionTrue)
+		})).Should(Succeed())
+
+		By("creating a machine w/ the requested machine class")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+
+		By("observing the machine isn't scheduled onto the unschedulable machine pool")
+		Consistently(Object(machine)).Should(HaveField("Spec.MachinePoolRef", BeNil()))
+
+		By("uncordoning the machine pool")
+		Eventually(Update(machinePool, func() {
+			machinePool.Spec.Unschedulable = false
+		})).Should(Succeed())
+
+		By("waiting for the machine to be scheduled once the pool is schedulable")
+		Eventually(Object(machine)).Should(SatisfyAll(
+			HaveField("Spec.MachinePoolRef", Equal(&corev1.LocalObjectReference{Name: machinePool.Name})),
+			HaveField("Status.State", Equal(computev1alpha1.MachineStatePending)),
+		))
+	})
 })
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	topologySpreadName = "topology-spread"
+
+	// topologySpreadScoreWeight is the weight of the topology spread score relative to the configured score plugins.
+	topologySpreadScoreWeight = 2
+)
+
+// topologySpread filters and scores machine pools by the topology spread constraints of a machine.
+// Constraints with DoNotSchedule filter out machine pools that would exceed the maximum skew, while
+// constraints with ScheduleAnyway prefer machine pools in topology domains with fewer matching machines.
+type topologySpread struct {
+	// eligible reports whether a machine pool is taken into account as topology domain for a machine.
+	eligible func(ctx context.Context, pool *scheduler.ContainerInfo, machine *computev1alpha1.Machine) bool
+
+	constraints []topologySpreadConstraintState
+}
+
+type topologySpreadConstraintState struct {
+	computev1alpha1.TopologySpreadConstraint
+
+	// selfMatch is 1 if the scheduled machine matches the label selector of the constraint, 0 otherwise.
+	selfMatch int
+	// counts maps topology domains to the number of matching machines in them.
+	counts   map[string]int
+	minCount int
+	maxCount int
+}
+
+func (t *topologySpread) Name() string {
+	return topologySpreadName
+}
+
+func (t *topologySpread) PreFilter(ctx context.Context, pools []*scheduler.ContainerInfo, machine *computev1alpha1.Machine) {
+	log := ctrl.LoggerFrom(ctx)
+
+	t.constraints = nil
+	for _, constraint := range machine.Spec.TopologySpreadConstraints {
+		selector, err := metav1.LabelSelectorAsSelector(constraint.LabelSelector)
+		if err != nil {
+			log.Error(err, "Invalid topology spread constraint label selector", "TopologyKey", constraint.TopologyKey)
+			selector = labels.Nothing()
+		}
+
+		state := topologySpreadConstraintState{
+			TopologySpreadConstraint: constraint,
+			counts:                   make(map[string]int),
+		}
+		if selector.Matches(labels.Set(machine.Labels)) {
+			state.selfMatch = 1
+		}
+
+		for _, pool := range pools {
+			domain, ok := topologyDomain(pool, constraint.TopologyKey)
+			if !ok || !t.eligible(ctx, pool, machine) {
+				continue
+			}
+
+			count := state.counts[domain]
+			for _, instance := range pool.Instances() {
+				if instance.Namespace == machine.Namespace &&
+					instance.UID != machine.UID &&
+					selector.Matches(labels.Set(instance.Labels)) {
+					count++
+				}
+			}
+			state.counts[domain] = count
+		}
+
+		first := true
+		for _, count := range state.counts {
+			if first {
+				state.minCount, state.maxCount = count, count
+				first = false
+				continue
+			}
+			state.minCount = min(state.minCount, count)
+			state.maxCount = max(state.maxCount, count)
+		}
+
+		t.constraints = append(t.constraints, state)
+	}
+}
+
+func (t *topologySpread) Filter(_ context.Context, pool *scheduler.ContainerInfo, _ *computev1alpha1.Machine) bool {
+	for _, constraint := range t.constraints {
+		if constraint.WhenUnsatisfiable == computev1alpha1.ScheduleAnyway {
+			continue
+		}
+
+		domain, ok := topologyDomain(pool, constraint.TopologyKey)
+		if !ok {
+			return false
+		}
+
+		skew := constraint.counts[domain] + constraint.selfMatch - constraint.minCount
+		if skew > int(constraint.MaxSkew) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *topologySpread) Score(_ context.Context, pool *scheduler.ContainerInfo, _ *computev1alpha1.Machine) int64 {
+	var score int64
+	for _, constraint := range t.constraints {
+		if constraint.WhenUnsatisfiable != computev1alpha1.ScheduleAnyway {
+			continue
+		}
+
+		domain, ok := topologyDomain(pool, constraint.TopologyKey)
+		if !ok {
+			// Machine pools outside any topology domain are ranked below all others.
+			score -= int64(constraint.maxCount + 1)
+			continue
+		}
+		score -= int64(constraint.counts[domain])
+	}
+	return score
+}
@@ -0,0 +1,435 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// MachineDeploymentReconciler rolls out the machine template of MachineDeployments via MachineSets.
+type MachineDeploymentReconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinedeployments,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinedeployments/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinesets,verbs=get;list;watch;create;update;patch;delete
+
+func (r *MachineDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	machineDeployment := &computev1alpha1.MachineDeployment{}
+	if err := r.Get(ctx, req.NamespacedName, machineDeployment); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !machineDeployment.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, machineDeployment)
+}
+
+func (r *MachineDeploymentReconciler) reconcileExists(ctx context.Context, log logr.Logger, machineDeployment *computev1alpha1.MachineDeployment) (ctrl.Result, error) {
+	log.V(1).Info("Listing machine sets of machine deployment")
+	machineSets, err := r.listMachineSets(ctx, machineDeployment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	newMachineSet, oldMachineSets, err := r.getOrCreateNewMachineSet(ctx, log, machineDeployment, machineSets)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.patchRevision(ctx, machineDeployment, machineSetRevision(newMachineSet)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	switch machineDeployment.Spec.Strategy.Type {
+	case computev1alpha1.RecreateMachineDeploymentStrategyType:
+		err = r.rolloutRecreate(ctx, log, machineDeployment, newMachineSet, oldMachineSets)
+	default:
+		err = r.rolloutRolling(ctx, log, machineDeployment, newMachineSet, oldMachineSets)
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.cleanupMachineSets(ctx, log, machineDeployment, oldMachineSets); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.updateStatus(ctx, machineDeployment, newMachineSet, oldMachineSets); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *MachineDeploymentReconciler) listMachineSets(ctx context.Context, machineDeployment *computev1alpha1.MachineDeployment) ([]*computev1alpha1.MachineSet, error) {
+	machineSetList := &computev1alpha1.MachineSetList{}
+	if err := r.List(ctx, machineSetList, client.InNamespace(machineDeployment.Namespace)); err != nil {
+		return nil, fmt.Errorf("error listing machine sets: %w", err)
+	}
+
+	var machineSets []*computev1alpha1.MachineSet
+	for i := range machineSetList.Items {
+		machineSet := &machineSetList.Items[i]
+		if metav1.IsControlledBy(machineSet, machineDeployment) && machineSet.DeletionTimestamp.IsZero() {
+			machineSets = append(machineSets, machineSet)
+		}
+	}
+	return machineSets, nil
+}
+
+// getOrCreateNewMachineSet returns the machine set matching the current machine template, creating it if
+// it does not exist yet, and the remaining old machine sets. The new machine set gets the highest revision.
+func (r *MachineDeploymentReconciler) getOrCreateNewMachineSet(
+	ctx context.Context,
+	log logr.Logger,
+	machineDeployment *computev1alpha1.MachineDeployment,
+	machineSets []*computev1alpha1.MachineSet,
+) (*computev1alpha1.MachineSet, []*computev1alpha1.MachineSet, error) {
+	templateHash, err := computeMachineTemplateHash(&machineDeployment.Spec.Template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		newMachineSet  *computev1alpha1.MachineSet
+		oldMachineSets []*computev1alpha1.MachineSet
+		maxOldRevision int64
+	)
+	for _, machineSet := range machineSets {
+		if machineSet.Labels[computev1alpha1.MachineTemplateHashLabel] == templateHash {
+			newMachineSet = machineSet
+			continue
+		}
+		oldMachineSets = append(oldMachineSets, machineSet)
+		maxOldRevision = max(maxOldRevision, machineSetRevision(machineSet))
+	}
+	revision := strconv.FormatInt(maxOldRevision+1, 10)
+
+	if newMachineSet != nil {
+		if machineSetRevision(newMachineSet) <= maxOldRevision {
+			log.V(1).Info("Rolling back to existing machine set", "MachineSet", newMachineSet.Name, "Revision", revision)
+			base := newMachineSet.DeepCopy()
+			metav1.SetMetaDataAnnotation(&newMachineSet.ObjectMeta, computev1alpha1.MachineDeploymentRevisionAnnotation, revision)
+			if err := r.Patch(ctx, newMachineSet, client.MergeFrom(base)); err != nil {
+				return nil, nil, fmt.Errorf("error updating revision of machine set %s: %w", newMachineSet.Name, err)
+			}
+		}
+		return newMachineSet, oldMachineSets, nil
+	}
+
+	var replicas int32
+	if machineDeployment.Spec.Strategy.Type != computev1alpha1.RecreateMachineDeploymentStrategyType && len(oldMachineSets) == 0 {
+		replicas = ptr.Deref(machineDeployment.Spec.Replicas, 1)
+	}
+
+	template := machineDeployment.Spec.Template.DeepCopy()
+	template.Labels = withLabel(template.Labels, computev1alpha1.MachineTemplateHashLabel, templateHash)
+	selector := machineDeployment.Spec.Selector.DeepCopy()
+	selector.MatchLabels = withLabel(selector.MatchLabels, computev1alpha1.MachineTemplateHashLabel, templateHash)
+
+	newMachineSet = &computev1alpha1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   machineDeployment.Namespace,
+			Name:        fmt.Sprintf("%s-%s", machineDeployment.Name, templateHash),
+			Labels:      template.Labels,
+			Annotations: map[string]string{computev1alpha1.MachineDeploymentRevisionAnnotation: revision},
+		},
+		Spec: computev1alpha1.MachineSetSpec{
+			Replicas: ptr.To(replicas),
+			Selector: selector,
+			Template: *template,
+		},
+	}
+	if err := controllerutil.SetControllerReference(machineDeployment, newMachineSet, r.Scheme()); err != nil {
+		return nil, nil, fmt.Errorf("error setting controller reference: %w", err)
+	}
+
+	log.V(1).Info("Creating new machine set", "MachineSet", newMachineSet.Name, "Revision", revision)
+	if err := r.Create(ctx, newMachineSet); err != nil {
+		return nil, nil, fmt.Errorf("error creating machine set: %w", err)
+	}
+	return newMachineSet, oldMachineSets, nil
+}
+
+func (r *MachineDeploymentReconciler) patchRevision(ctx context.Context, machineDeployment *computev1alpha1.MachineDeployment, revision int64) error {
+	value := strconv.FormatInt(revision, 10)
+	if machineDeployment.Annotations[computev1alpha1.MachineDeploymentRevisionAnnotation] == value {
+		return nil
+	}
+
+	base := machineDeployment.DeepCopy()
+	metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, computev1alpha1.MachineDeploymentRevisionAnnotation, value)
+	if err := r.Patch(ctx, machineDeployment, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error updating machine deployment revision: %w", err)
+	}
+	return nil
+}
+
+// rolloutRecreate scales down all old machine sets and scales up the new machine set
+// once all old machines are gone.
+func (r *MachineDeploymentReconciler) rolloutRecreate(
+	ctx context.Context,
+	log logr.Logger,
+	machineDeployment *computev1alpha1.MachineDeployment,
+	newMachineSet *computev1alpha1.MachineSet,
+	oldMachineSets []*computev1alpha1.MachineSet,
+) error {
+	oldMachinesRunning := false
+	for _, machineSet := range oldMachineSets {
+		if err := r.scaleMachineSet(ctx, log, machineSet, 0); err != nil {
+			return err
+		}
+		if machineSet.Status.Replicas > 0 {
+			oldMachinesRunning = true
+		}
+	}
+	if oldMachinesRunning {
+		log.V(1).Info("Waiting for old machines to be gone")
+		return nil
+	}
+
+	return r.scaleMachineSet(ctx, log, newMachineSet, ptr.Deref(machineDeployment.Spec.Replicas, 1))
+}
+
+// rolloutRolling scales up the new machine set and scales down the old machine sets
+// within the bounds of maxSurge and maxUnavailable.
+func (r *MachineDeploymentReconciler) rolloutRolling(
+	ctx context.Context,
+	log logr.Logger,
+	machineDeployment *computev1alpha1.MachineDeployment,
+	newMachineSet *computev1alpha1.MachineSet,
+	oldMachineSets []*computev1alpha1.MachineSet,
+) error {
+	desired := ptr.Deref(machineDeployment.Spec.Replicas, 1)
+	maxSurge, maxUnavailable, err := resolveMaxSurgeAndMaxUnavailable(machineDeployment.Spec.Strategy.RollingUpdate, desired)
+	if err != nil {
+		return err
+	}
+
+	allMachineSets := append([]*computev1alpha1.MachineSet{newMachineSet}, oldMachineSets...)
+
+	// Scale up the new machine set as far as maxSurge allows.
+	newReplicas := ptr.Deref(newMachineSet.Spec.Replicas, 0)
+	if total := totalSpecReplicas(allMachineSets); newReplicas < desired && total < desired+maxSurge {
+		newReplicas = min(newReplicas+desired+maxSurge-total, desired)
+	} else if newReplicas > desired {
+		newReplicas = desired
+	}
+	if err := r.scaleMachineSet(ctx, log, newMachineSet, newReplicas); err != nil {
+		return err
+	}
+
+	// Scale down old machine sets as far as maxUnavailable allows, unhealthy machines first.
+	minAvailable := desired - maxUnavailable
+	newUnavailable := max(ptr.Deref(newMachineSet.Spec.Replicas, 0)-newMachineSet.Status.ReadyReplicas, 0)
+	maxScaledDown := totalSpecReplicas(allMachineSets) - minAvailable - newUnavailable
+	if maxScaledDown <= 0 {
+		return nil
+	}
+
+	slices.SortFunc(oldMachineSets, func(a, b *computev1alpha1.MachineSet) int {
+		return int(machineSetRevision(a) - machineSetRevision(b))
+	})
+
+	cleanedUp := false
+	for _, machineSet := range oldMachineSets {
+		if maxScaledDown <= 0 {
+			break
+		}
+		replicas := ptr.Deref(machineSet.Spec.Replicas, 0)
+		unhealthy := max(replicas-machineSet.Status.ReadyReplicas, 0)
+		if unhealthy == 0 {
+			continue
+		}
+		scaleDown := min(unhealthy, maxScaledDown)
+		if err := r.scaleMachineSet(ctx, log, machineSet, replicas-scaleDown); err != nil {
+			return err
+		}
+		maxScaledDown -= scaleDown
+		cleanedUp = true
+	}
+	if cleanedUp {
+		return nil
+	}
+
+	scaleDownCount := totalReadyReplicas(allMachineSets) - minAvailable
+	for _, machineSet := range oldMachineSets {
+		if scaleDownCount <= 0 {
+			break
+		}
+		replicas := ptr.Deref(machineSet.Spec.Replicas, 0)
+		if replicas == 0 {
+			continue
+		}
+		scaleDown := min(replicas, scaleDownCount)
+		if err := r.scaleMachineSet(ctx, log, machineSet, replicas-scaleDown); err != nil {
+			return err
+		}
+		scaleDownCount -= scaleDown
+	}
+	return nil
+}
+
+func (r *MachineDeploymentReconciler) scaleMachineSet(ctx context.Context, log logr.Logger, machineSet *computev1alpha1.MachineSet, replicas int32) error {
+	if ptr.Deref(machineSet.Spec.Replicas, 0) == replicas {
+		return nil
+	}
+
+	log.V(1).Info("Scaling machine set", "MachineSet", machineSet.Name, "Replicas", replicas)
+	base := machineSet.DeepCopy()
+	machineSet.Spec.Replicas = ptr.To(replicas)
+	if err := r.Patch(ctx, machineSet, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error scaling machine set %s: %w", machineSet.Name, err)
+	}
+	return nil
+}
+
+// cleanupMachineSets deletes the oldest scaled down machine sets exceeding the revision history limit.
+func (r *MachineDeploymentReconciler) cleanupMachineSets(ctx context.Context, log logr.Logger, machineDeployment *computev1alpha1.MachineDeployment, oldMachineSets []*computev1alpha1.MachineSet) error {
+	var inactive []*computev1alpha1.MachineSet
+	for _, machineSet := range oldMachineSets {
+		if ptr.Deref(machineSet.Spec.Replicas, 0) == 0 && machineSet.Status.Replicas == 0 {
+			inactive = append(inactive, machineSet)
+		}
+	}
+
+	limit := int(ptr.Deref(machineDeployment.Spec.RevisionHistoryLimit, 10))
+	if len(inactive) <= limit {
+		return nil
+	}
+
+	slices.SortFunc(inactive, func(a, b *computev1alpha1.MachineSet) int {
+		return int(machineSetRevision(a) - machineSetRevision(b))
+	})
+	for _, machineSet := range inactive[:len(inactive)-limit] {
+		log.V(1).Info("Deleting old machine set", "MachineSet", machineSet.Name)
+		if err := r.Delete(ctx, machineSet); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("error deleting machine set %s: %w", machineSet.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *MachineDeploymentReconciler) updateStatus(
+	ctx context.Context,
+	machineDeployment *computev1alpha1.MachineDeployment,
+	newMachineSet *computev1alpha1.MachineSet,
+	oldMachineSets []*computev1alpha1.MachineSet,
+) error {
+	allMachineSets := append([]*computev1alpha1.MachineSet{newMachineSet}, oldMachineSets...)
+
+	var replicas int32
+	for _, machineSet := range allMachineSets {
+		replicas += machineSet.Status.Replicas
+	}
+	readyReplicas := totalReadyReplicas(allMachineSets)
+
+	base := machineDeployment.DeepCopy()
+	machineDeployment.Status = computev1alpha1.MachineDeploymentStatus{
+		ObservedGeneration:  machineDeployment.Generation,
+		Replicas:            replicas,
+		UpdatedReplicas:     newMachineSet.Status.Replicas,
+		ReadyReplicas:       readyReplicas,
+		UnavailableReplicas: max(ptr.Deref(machineDeployment.Spec.Replicas, 1)-readyReplicas, 0),
+	}
+	if err := r.Status().Patch(ctx, machineDeployment, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error updating machine deployment status: %w", err)
+	}
+	return nil
+}
+
+// resolveMaxSurgeAndMaxUnavailable returns the absolute maxSurge and maxUnavailable for the given replicas.
+// If both resolve to zero, maxUnavailable is set to one to allow the rollout to progress.
+func resolveMaxSurgeAndMaxUnavailable(rollingUpdate *computev1alpha1.RollingUpdateMachineDeployment, replicas int32) (int32, int32, error) {
+	if rollingUpdate == nil {
+		rollingUpdate = &computev1alpha1.RollingUpdateMachineDeployment{}
+	}
+
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(ptr.To(ptr.Deref(rollingUpdate.MaxSurge, intstr.FromString("25%"))), int(replicas), true)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error determining max surge: %w", err)
+	}
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(ptr.To(ptr.Deref(rollingUpdate.MaxUnavailable, intstr.FromString("25%"))), int(replicas), false)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error determining max unavailable: %w", err)
+	}
+
+	if maxSurge == 0 && maxUnavailable == 0 {
+		maxUnavailable = 1
+	}
+	return int32(maxSurge), int32(min(maxUnavailable, int(replicas))), nil
+}
+
+// computeMachineTemplateHash returns a hash of the given machine template that is safe to use as label value.
+func computeMachineTemplateHash(template *computev1alpha1.MachineTemplateSpec) (string, error) {
+	data, err := json.Marshal(template)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling machine template: %w", err)
+	}
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write(data)
+	return rand.SafeEncodeString(strconv.FormatUint(uint64(hasher.Sum32()), 10)), nil
+}
+
+func machineSetRevision(machineSet *computev1alpha1.MachineSet) int64 {
+	revision, _ := strconv.ParseInt(machineSet.Annotations[computev1alpha1.MachineDeploymentRevisionAnnotation], 10, 64)
+	return revision
+}
+
+func totalSpecReplicas(machineSets []*computev1alpha1.MachineSet) int32 {
+	var total int32
+	for _, machineSet := range machineSets {
+		total += ptr.Deref(machineSet.Spec.Replicas, 0)
+	}
+	return total
+}
+
+func totalReadyReplicas(machineSets []*computev1alpha1.MachineSet) int32 {
+	var total int32
+	for _, machineSet := range machineSets {
+		total += machineSet.Status.ReadyReplicas
+	}
+	return total
+}
+
+func withLabel(labels map[string]string, key, value string) map[string]string {
+	res := maps.Clone(labels)
+	if res == nil {
+		res = make(map[string]string)
+	}
+	res[key] = value
+	return res
+}
+
+func (r *MachineDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(
+			&computev1alpha1.MachineDeployment{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Owns(&computev1alpha1.MachineSet{}).
+		Complete(r)
+}
@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("MachineDeploymentReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+	machineClass := SetupMachineClass()
+
+	// markMachinesRunning sets all machines of the namespace to running, simulating a machinepoollet.
+	markMachinesRunning := func(ctx context.Context) error {
+		machineList := &computev1alpha1.MachineList{}
+		if err := k8sClient.List(ctx, machineList, client.InNamespace(ns.Name)); err != nil {
+			return err
+		}
+		for _, machine := range machineList.Items {
+			if machine.Status.State == computev1alpha1.MachineStateRunning {
+				continue
+			}
+			base := machine.DeepCopy()
+			machine.Status.State = computev1alpha1.MachineStateRunning
+			if err := k8sClient.Status().Patch(ctx, &machine, client.MergeFrom(base)); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	It("should roll out a template change to a new machine set", func(ctx SpecContext) {
+		By("creating a machine deployment")
+		machineDeployment := &computev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-md-",
+			},
+			Spec: computev1alpha1.MachineDeploymentSpec{
+				Replicas: ptr.To[int32](2),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Template: computev1alpha1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web", "version": "v1"}},
+					Spec: computev1alpha1.MachineSpec{
+						MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+					},
+				},
+				Strategy: computev1alpha1.MachineDeploymentStrategy{
+					Type: computev1alpha1.RollingUpdateMachineDeploymentStrategyType,
+					RollingUpdate: &computev1alpha1.RollingUpdateMachineDeployment{
+						MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+						MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineDeployment)).To(Succeed(), "failed to create machine deployment")
+
+		By("waiting for the initial machine set to be created")
+		machineSetList := &computev1alpha1.MachineSetList{}
+		Eventually(ObjectList(machineSetList, client.InNamespace(ns.Name))).Should(HaveField("Items", ConsistOf(SatisfyAll(
+			HaveField("ObjectMeta.OwnerReferences", ConsistOf(HaveField("UID", machineDeployment.UID))),
+			HaveField("ObjectMeta.Annotations", HaveKeyWithValue(computev1alpha1.MachineDeploymentRevisionAnnotation, "1")),
+			HaveField("Spec.Replicas", PointTo(Equal(int32(2)))),
+		))))
+		oldMachineSet := machineSetList.Items[0].DeepCopy()
+
+		By("waiting for the machines to be running")
+		Eventually(func(g Gomega) {
+			g.Expect(markMachinesRunning(ctx)).To(Succeed())
+			g.Expect(Object(machineDeployment)()).To(HaveField("Status", SatisfyAll(
+				HaveField("Replicas", int32(2)),
+				HaveField("UpdatedReplicas", int32(2)),
+				HaveField("ReadyReplicas", int32(2)),
+				HaveField("UnavailableReplicas", int32(0)),
+			)))
+		}).Should(Succeed())
+
+		By("updating the machine template")
+		Eventually(Update(machineDeployment, func() {
+			machineDeployment.Spec.Template.Labels["version"] = "v2"
+		})).Should(Succeed())
+
+		By("waiting for the new machine set to replace the old one")
+		Eventually(func(g Gomega) {
+			g.Expect(markMachinesRunning(ctx)).To(Succeed())
+			g.Expect(ObjectList(machineSetList, client.InNamespace(ns.Name))()).To(HaveField("Items", ConsistOf(
+				SatisfyAll(
+					HaveField("Name", oldMachineSet.Name),
+					HaveField("Spec.Replicas", PointTo(Equal(int32(0)))),
+				),
+				SatisfyAll(
+					HaveField("Name", Not(Equal(oldMachineSet.Name))),
+					HaveField("ObjectMeta.Annotations", HaveKeyWithValue(computev1alpha1.MachineDeploymentRevisionAnnotation, "2")),
+					HaveField("Spec.Replicas", PointTo(Equal(int32(2)))),
+					HaveField("Spec.Template.Labels", HaveKeyWithValue("version", "v2")),
+				),
+			)))
+			g.Expect(Object(machineDeployment)()).To(SatisfyAll(
+				HaveField("ObjectMeta.Annotations", HaveKeyWithValue(computev1alpha1.MachineDeploymentRevisionAnnotation, "2")),
+				HaveField("Status", SatisfyAll(
+					HaveField("Replicas", int32(2)),
+					HaveField("UpdatedReplicas", int32(2)),
+					HaveField("ReadyReplicas", int32(2)),
+				)),
+			))
+		}).Should(Succeed())
+
+		By("asserting all remaining machines use the new template")
+		machineList := &computev1alpha1.MachineList{}
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name))).Should(HaveField("Items", HaveEach(
+			HaveField("Labels", HaveKeyWithValue("version", "v2")),
+		)))
+	})
+
+	It("should replace all machines at once with the recreate strategy", func(ctx SpecContext) {
+		By("creating a machine deployment")
+		machineDeployment := &computev1alpha1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-md-",
+			},
+			Spec: computev1alpha1.MachineDeploymentSpec{
+				Replicas: ptr.To[int32](1),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				Template: computev1alpha1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "db", "version": "v1"}},
+					Spec: computev1alpha1.MachineSpec{
+						MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+					},
+				},
+				Strategy: computev1alpha1.MachineDeploymentStrategy{
+					Type: computev1alpha1.RecreateMachineDeploymentStrategyType,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineDeployment)).To(Succeed(), "failed to create machine deployment")
+
+		By("waiting for the machine to be created")
+		machineList := &computev1alpha1.MachineList{}
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name))).Should(HaveField("Items", HaveLen(1)))
+
+		By("updating the machine template")
+		Eventually(Update(machineDeployment, func() {
+			machineDeployment.Spec.Template.Labels["version"] = "v2"
+		})).Should(Succeed())
+
+		By("waiting for the machine to be recreated from the new template")
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name))).Should(HaveField("Items", ConsistOf(
+			HaveField("Labels", HaveKeyWithValue("version", "v2")),
+		)))
+	})
+})
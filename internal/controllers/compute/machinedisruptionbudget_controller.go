@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// disruptedMachineTimeout is the time after which a machine recorded as disrupted by an eviction
+// but not yet deleted is considered healthy again.
+const disruptedMachineTimeout = 2 * time.Minute
+
+// MachineDisruptionBudgetReconciler computes the status of MachineDisruptionBudgets.
+type MachineDisruptionBudgetReconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinedisruptionbudgets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinedisruptionbudgets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch
+
+func (r *MachineDisruptionBudgetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	machineDisruptionBudget := &computev1alpha1.MachineDisruptionBudget{}
+	if err := r.Get(ctx, req.NamespacedName, machineDisruptionBudget); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !machineDisruptionBudget.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, machineDisruptionBudget)
+}
+
+func (r *MachineDisruptionBudgetReconciler) reconcileExists(ctx context.Context, log logr.Logger, machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget) (ctrl.Result, error) {
+	log.V(1).Info("Listing selected machines")
+	selector, err := metav1.LabelSelectorAsSelector(machineDisruptionBudget.Spec.Selector)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error parsing selector: %w", err)
+	}
+
+	machineList := &computev1alpha1.MachineList{}
+	if err := r.List(ctx, machineList,
+		client.InNamespace(machineDisruptionBudget.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing machines: %w", err)
+	}
+
+	now := time.Now()
+	disruptedMachines, requeueAfter := r.activeDisruptedMachines(machineDisruptionBudget.Status.DisruptedMachines, machineList.Items, now)
+
+	expected := int32(len(machineList.Items))
+	var currentHealthy int32
+	for _, machine := range machineList.Items {
+		if isMachineHealthy(&machine) {
+			if _, disrupted := disruptedMachines[machine.Name]; !disrupted {
+				currentHealthy++
+			}
+		}
+	}
+
+	desiredHealthy, err := getDesiredHealthyMachines(machineDisruptionBudget, expected)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	disruptionsAllowed := max(currentHealthy-desiredHealthy, 0)
+
+	log.V(1).Info("Updating machine disruption budget status",
+		"ExpectedMachines", expected,
+		"CurrentHealthy", currentHealthy,
+		"DesiredHealthy", desiredHealthy,
+		"DisruptionsAllowed", disruptionsAllowed,
+	)
+	base := machineDisruptionBudget.DeepCopy()
+	machineDisruptionBudget.Status = computev1alpha1.MachineDisruptionBudgetStatus{
+		ObservedGeneration: machineDisruptionBudget.Generation,
+		DisruptedMachines:  disruptedMachines,
+		DisruptionsAllowed: disruptionsAllowed,
+		CurrentHealthy:     currentHealthy,
+		DesiredHealthy:     desiredHealthy,
+		ExpectedMachines:   expected,
+	}
+	if err := r.Status().Patch(ctx, machineDisruptionBudget, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating machine disruption budget status: %w", err)
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// activeDisruptedMachines returns the disrupted machines that still exist, are not yet being deleted and
+// whose disruption has not yet timed out, together with the duration after which the next one times out.
+func (r *MachineDisruptionBudgetReconciler) activeDisruptedMachines(
+	disruptedMachines map[string]metav1.Time,
+	machines []computev1alpha1.Machine,
+	now time.Time,
+) (map[string]metav1.Time, time.Duration) {
+	if len(disruptedMachines) == 0 {
+		return nil, 0
+	}
+
+	machineByName := make(map[string]*computev1alpha1.Machine, len(machines))
+	for i := range machines {
+		machineByName[machines[i].Name] = &machines[i]
+	}
+
+	var (
+		res          map[string]metav1.Time
+		requeueAfter time.Duration
+	)
+	for name, disruptedAt := range disruptedMachines {
+		machine, ok := machineByName[name]
+		if !ok || !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		expiresIn := disruptedAt.Add(disruptedMachineTimeout).Sub(now)
+		if expiresIn <= 0 {
+			continue
+		}
+
+		if res == nil {
+			res = make(map[string]metav1.Time)
+		}
+		res[name] = disruptedAt
+		if requeueAfter == 0 || expiresIn < requeueAfter {
+			requeueAfter = expiresIn
+		}
+	}
+	return res, requeueAfter
+}
+
+func isMachineHealthy(machine *computev1alpha1.Machine) bool {
+	return machine.DeletionTimestamp.IsZero() && machine.Status.State == computev1alpha1.MachineStateRunning
+}
+
+func getDesiredHealthyMachines(machineDisruptionBudget *computev1alpha1.MachineDisruptionBudget, expected int32) (int32, error) {
+	switch {
+	case machineDisruptionBudget.Spec.MinAvailable != nil:
+		minAvailable, err := intstr.GetScaledValueFromIntOrPercent(machineDisruptionBudget.Spec.MinAvailable, int(expected), true)
+		if err != nil {
+			return 0, fmt.Errorf("error determining min available machines: %w", err)
+		}
+		return int32(minAvailable), nil
+	case machineDisruptionBudget.Spec.MaxUnavailable != nil:
+		maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(machineDisruptionBudget.Spec.MaxUnavailable, int(expected), true)
+		if err != nil {
+			return 0, fmt.Errorf("error determining max unavailable machines: %w", err)
+		}
+		return max(expected-int32(maxUnavailable), 0), nil
+	default:
+		return expected, nil
+	}
+}
+
+func (r *MachineDisruptionBudgetReconciler) enqueueByMachine() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		log := ctrl.LoggerFrom(ctx)
+		machine := obj.(*computev1alpha1.Machine)
+
+		machineDisruptionBudgetList := &computev1alpha1.MachineDisruptionBudgetList{}
+		if err := r.List(ctx, machineDisruptionBudgetList,
+			client.InNamespace(machine.Namespace),
+		); err != nil {
+			log.Error(err, "Error listing machine disruption budgets")
+			return nil
+		}
+
+		var reqs []reconcile.Request
+		for _, machineDisruptionBudget := range machineDisruptionBudgetList.Items {
+			selector, err := metav1.LabelSelectorAsSelector(machineDisruptionBudget.Spec.Selector)
+			if err != nil {
+				continue
+			}
+
+			if selector.Matches(labels.Set(machine.Labels)) {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&machineDisruptionBudget)})
+			}
+		}
+		return reqs
+	})
+}
+
+func (r *MachineDisruptionBudgetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&computev1alpha1.MachineDisruptionBudget{}).
+		Watches(
+			&computev1alpha1.Machine{},
+			r.enqueueByMachine(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("MachineDisruptionBudgetReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+	machineClass := SetupMachineClass()
+
+	createRunningMachine := func(ctx SpecContext) *computev1alpha1.Machine {
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+				Labels:       map[string]string{"app": "foo"},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+
+		Eventually(UpdateStatus(machine, func() {
+			machine.Status.State = computev1alpha1.MachineStateRunning
+		})).Should(Succeed())
+		return machine
+	}
+
+	It("should compute the status and honor it when evicting machines", func(ctx SpecContext) {
+		By("creating two running machines")
+		machine1 := createRunningMachine(ctx)
+		machine2 := createRunningMachine(ctx)
+
+		By("creating a machine disruption budget")
+		machineDisruptionBudget := &computev1alpha1.MachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-mdb-",
+			},
+			Spec: computev1alpha1.MachineDisruptionBudgetSpec{
+				Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				MinAvailable: ptr.To(intstr.FromInt32(1)),
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineDisruptionBudget)).To(Succeed(), "failed to create machine disruption budget")
+
+		By("waiting for the machine disruption budget status to be computed")
+		Eventually(Object(machineDisruptionBudget)).Should(HaveField("Status", SatisfyAll(
+			HaveField("ObservedGeneration", machineDisruptionBudget.Generation),
+			HaveField("ExpectedMachines", int32(2)),
+			HaveField("CurrentHealthy", int32(2)),
+			HaveField("DesiredHealthy", int32(1)),
+			HaveField("DisruptionsAllowed", int32(1)),
+		)))
+
+		By("evicting the first machine")
+		Expect(k8sClient.SubResource("eviction").Create(ctx, machine1, &computev1alpha1.MachineEviction{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: machine1.Name},
+		})).To(Succeed())
+		Eventually(Get(machine1)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("waiting for the machine disruption budget to disallow further disruptions")
+		Eventually(Object(machineDisruptionBudget)).Should(HaveField("Status", SatisfyAll(
+			HaveField("ExpectedMachines", int32(1)),
+			HaveField("DisruptionsAllowed", int32(0)),
+		)))
+
+		By("asserting the eviction of the second machine is rejected")
+		err := k8sClient.SubResource("eviction").Create(ctx, machine2, &computev1alpha1.MachineEviction{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: machine2.Name},
+		})
+		Expect(apierrors.IsTooManyRequests(err)).To(BeTrue(), "expected too many requests error but got %v", err)
+		Consistently(Get(machine2)).Should(Succeed())
+	})
+
+	It("should evict machines that are not selected by any machine disruption budget", func(ctx SpecContext) {
+		By("creating a running machine")
+		machine := createRunningMachine(ctx)
+
+		By("evicting the machine")
+		Expect(k8sClient.SubResource("eviction").Create(ctx, machine, &computev1alpha1.MachineEviction{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: machine.Name},
+		})).To(Succeed())
+		Eventually(Get(machine)).Should(Satisfy(apierrors.IsNotFound))
+	})
+
+	It("should reject evictions for a machine disruption budget that has not been processed yet", func(ctx SpecContext) {
+		By("creating a running machine")
+		machine := createRunningMachine(ctx)
+
+		By("creating a machine disruption budget")
+		machineDisruptionBudget := &computev1alpha1.MachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-mdb-",
+			},
+			Spec: computev1alpha1.MachineDisruptionBudgetSpec{
+				Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineDisruptionBudget)).To(Succeed(), "failed to create machine disruption budget")
+
+		By("waiting for the machine disruption budget to allow a disruption")
+		Eventually(Object(machineDisruptionBudget)).Should(HaveField("Status.DisruptionsAllowed", int32(1)))
+
+		By("updating the machine disruption budget spec")
+		Eventually(Update(machineDisruptionBudget, func() {
+			machineDisruptionBudget.Spec.MaxUnavailable = nil
+			machineDisruptionBudget.Spec.MinAvailable = ptr.To(intstr.FromString("100%"))
+		})).Should(Succeed())
+
+		By("asserting the machine cannot be evicted")
+		Eventually(func() error {
+			return k8sClient.SubResource("eviction").Create(ctx, machine, &computev1alpha1.MachineEviction{
+				ObjectMeta: metav1.ObjectMeta{Namespace: ns.Name, Name: machine.Name},
+			})
+		}).Should(Satisfy(apierrors.IsTooManyRequests))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(machine), machine)).To(Succeed())
+	})
+})
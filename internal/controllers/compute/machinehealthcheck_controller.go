@@ -0,0 +1,360 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	controllerutils "github.com/ironcore-dev/ironcore/internal/controllers/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	machineHealthCheckReasonUnhealthyState     = "UnhealthyState"
+	machineHealthCheckReasonUnhealthyCondition = "UnhealthyCondition"
+
+	machineHealthCheckEventRemediated            = "Remediated"
+	machineHealthCheckEventRemediationRestricted = "RemediationRestricted"
+	machineHealthCheckEventRemediationResumed    = "RemediationResumed"
+
+	machineHealthCheckReasonRemediationAllowed = "RemediationAllowed"
+	machineHealthCheckReasonTooManyUnhealthy   = "TooManyUnhealthy"
+)
+
+// MachineHealthCheckReconciler remediates machines selected by MachineHealthChecks that are unhealthy for too long.
+type MachineHealthCheckReconciler struct {
+	events.EventRecorder
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinehealthchecks,verbs=get;list;watch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinehealthchecks/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;update;patch;delete
+
+func (r *MachineHealthCheckReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	machineHealthCheck := &computev1alpha1.MachineHealthCheck{}
+	if err := r.Get(ctx, req.NamespacedName, machineHealthCheck); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !machineHealthCheck.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, machineHealthCheck)
+}
+
+// machineHealth is the result of checking a single machine.
+type machineHealth struct {
+	machine *computev1alpha1.Machine
+	// timeout is the timeout of the rule that rendered the machine unhealthy.
+	timeout time.Duration
+	reason  string
+	message string
+}
+
+func (r *MachineHealthCheckReconciler) reconcileExists(ctx context.Context, log logr.Logger, machineHealthCheck *computev1alpha1.MachineHealthCheck) (ctrl.Result, error) {
+	log.V(1).Info("Listing selected machines")
+	selector, err := metav1.LabelSelectorAsSelector(machineHealthCheck.Spec.Selector)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error parsing selector: %w", err)
+	}
+
+	machineList := &computev1alpha1.MachineList{}
+	if err := r.List(ctx, machineList,
+		client.InNamespace(machineHealthCheck.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing machines: %w", err)
+	}
+
+	now := time.Now()
+	var (
+		expected     int32
+		unhealthy    []machineHealth
+		requeueAfter time.Duration
+	)
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		if !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		expected++
+
+		health, expiresIn := checkMachineHealth(machineHealthCheck, machine, now)
+		if health != nil {
+			unhealthy = append(unhealthy, *health)
+			continue
+		}
+		requeueAfter = controllerutils.MinRequeueAfter(requeueAfter, expiresIn)
+	}
+
+	maxUnhealthy, err := intstr.GetScaledValueFromIntOrPercent(
+		ptr.To(ptr.Deref(machineHealthCheck.Spec.MaxUnhealthy, intstr.FromString("100%"))), int(expected), false,
+	)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("error determining max unhealthy machines: %w", err)
+	}
+	remediationAllowed := len(unhealthy) <= maxUnhealthy
+	if !remediationAllowed {
+		log.V(1).Info("Too many unhealthy machines, not remediating", "Unhealthy", len(unhealthy), "MaxUnhealthy", maxUnhealthy)
+	}
+	remediationAllowedCond := r.remediationAllowedCondition(machineHealthCheck, remediationAllowed, len(unhealthy), expected, maxUnhealthy)
+
+	previous := make(map[string]computev1alpha1.UnhealthyMachine, len(machineHealthCheck.Status.UnhealthyMachines))
+	for _, unhealthyMachine := range machineHealthCheck.Status.UnhealthyMachines {
+		previous[unhealthyMachine.Name] = unhealthyMachine
+	}
+
+	unhealthyMachines := make([]computev1alpha1.UnhealthyMachine, 0, len(unhealthy))
+	for _, health := range unhealthy {
+		unhealthyMachine := computev1alpha1.UnhealthyMachine{
+			Name:    health.machine.Name,
+			Reason:  health.reason,
+			Message: health.message,
+		}
+		if prev, ok := previous[health.machine.Name]; ok {
+			unhealthyMachine.RemediationStrategy = prev.RemediationStrategy
+			unhealthyMachine.LastRemediationTime = prev.LastRemediationTime
+		}
+
+		if remediationAllowed {
+			strategy, retryAfter, err := r.remediate(ctx, log, machineHealthCheck, &health, now)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if strategy != "" {
+				unhealthyMachine.RemediationStrategy = strategy
+				unhealthyMachine.LastRemediationTime = &metav1.Time{Time: now}
+			}
+			requeueAfter = controllerutils.MinRequeueAfter(requeueAfter, retryAfter)
+		}
+		unhealthyMachines = append(unhealthyMachines, unhealthyMachine)
+	}
+	slices.SortFunc(unhealthyMachines, func(a, b computev1alpha1.UnhealthyMachine) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	currentHealthy := expected - int32(len(unhealthy))
+	remediationsAllowed := max(int32(maxUnhealthy)-int32(len(unhealthy)), 0)
+
+	log.V(1).Info("Updating machine health check status",
+		"ExpectedMachines", expected,
+		"CurrentHealthy", currentHealthy,
+		"RemediationsAllowed", remediationsAllowed,
+	)
+	base := machineHealthCheck.DeepCopy()
+	machineHealthCheck.Status = computev1alpha1.MachineHealthCheckStatus{
+		ObservedGeneration:  machineHealthCheck.Generation,
+		ExpectedMachines:    expected,
+		CurrentHealthy:      currentHealthy,
+		RemediationsAllowed: remediationsAllowed,
+		UnhealthyMachines:   unhealthyMachines,
+		Conditions:          computev1alpha1.SetMachineHealthCheckCondition(slices.Clone(base.Status.Conditions), remediationAllowedCond),
+	}
+	if err := r.Status().Patch(ctx, machineHealthCheck, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating machine health check status: %w", err)
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// remediationAllowedCondition returns the RemediationAllowed condition of the machine health check.
+// Events are only emitted when remediation is stopped or resumed, not on every reconcile.
+func (r *MachineHealthCheckReconciler) remediationAllowedCondition(
+	machineHealthCheck *computev1alpha1.MachineHealthCheck,
+	remediationAllowed bool,
+	unhealthy int,
+	expected int32,
+	maxUnhealthy int,
+) computev1alpha1.MachineHealthCheckCondition {
+	prev := computev1alpha1.FindMachineHealthCheckCondition(machineHealthCheck.Status.Conditions, computev1alpha1.MachineHealthCheckRemediationAllowed)
+	wasAllowed := prev == nil || prev.Status != corev1.ConditionFalse
+
+	if !remediationAllowed {
+		message := fmt.Sprintf("Not remediating: %d of %d machines are unhealthy, at most %d may be", unhealthy, expected, maxUnhealthy)
+		if wasAllowed {
+			r.Eventf(machineHealthCheck, nil, corev1.EventTypeWarning, machineHealthCheckEventRemediationRestricted, "Remediation", "%s", message)
+		}
+		return computev1alpha1.MachineHealthCheckCondition{
+			Type:               computev1alpha1.MachineHealthCheckRemediationAllowed,
+			Status:             corev1.ConditionFalse,
+			Reason:             machineHealthCheckReasonTooManyUnhealthy,
+			Message:            message,
+			ObservedGeneration: machineHealthCheck.Generation,
+		}
+	}
+
+	if !wasAllowed {
+		r.Eventf(machineHealthCheck, nil, corev1.EventTypeNormal, machineHealthCheckEventRemediationResumed, "Remediation",
+			"Resuming remediation: %d of %d machines are unhealthy, at most %d may be", unhealthy, expected, maxUnhealthy)
+	}
+	return computev1alpha1.MachineHealthCheckCondition{
+		Type:               computev1alpha1.MachineHealthCheckRemediationAllowed,
+		Status:             corev1.ConditionTrue,
+		Reason:             machineHealthCheckReasonRemediationAllowed,
+		Message:            fmt.Sprintf("%d of %d machines are unhealthy, at most %d may be", unhealthy, expected, maxUnhealthy),
+		ObservedGeneration: machineHealthCheck.Generation,
+	}
+}
+
+// checkMachineHealth checks the machine against the rules of the machine health check.
+// If the machine is unhealthy, its health is returned. Otherwise, the duration after which
+// the machine may become unhealthy is returned, or zero if no rule currently applies.
+func checkMachineHealth(machineHealthCheck *computev1alpha1.MachineHealthCheck, machine *computev1alpha1.Machine, now time.Time) (*machineHealth, time.Duration) {
+	// Machines that are intentionally powered off or suspended are not checked.
+	if machine.Spec.Power != "" && machine.Spec.Power != computev1alpha1.PowerOn {
+		return nil, 0
+	}
+
+	var expiresIn time.Duration
+	for _, unhealthyState := range machineHealthCheck.Spec.UnhealthyStates {
+		if machine.Status.State != unhealthyState.State {
+			continue
+		}
+
+		since := machine.CreationTimestamp
+		if machine.Status.LastStateTransitionTime != nil {
+			since = *machine.Status.LastStateTransitionTime
+		}
+		if remaining := since.Add(unhealthyState.Timeout.Duration).Sub(now); remaining > 0 {
+			expiresIn = controllerutils.MinRequeueAfter(expiresIn, remaining)
+			continue
+		}
+		return &machineHealth{
+			machine: machine,
+			timeout: unhealthyState.Timeout.Duration,
+			reason:  machineHealthCheckReasonUnhealthyState,
+			message: fmt.Sprintf("Machine has been in state %s for longer than %s", unhealthyState.State, unhealthyState.Timeout.Duration),
+		}, 0
+	}
+
+	for _, unhealthyCondition := range machineHealthCheck.Spec.UnhealthyConditions {
+		cond := computev1alpha1.FindMachineCondition(machine.Status.Conditions, unhealthyCondition.Type)
+		if cond == nil || cond.Status != unhealthyCondition.Status {
+			continue
+		}
+
+		if remaining := cond.LastTransitionTime.Add(unhealthyCondition.Timeout.Duration).Sub(now); remaining > 0 {
+			expiresIn = controllerutils.MinRequeueAfter(expiresIn, remaining)
+			continue
+		}
+		return &machineHealth{
+			machine: machine,
+			timeout: unhealthyCondition.Timeout.Duration,
+			reason:  machineHealthCheckReasonUnhealthyCondition,
+			message: fmt.Sprintf("Machine has had condition %s=%s for longer than %s", unhealthyCondition.Type, unhealthyCondition.Status, unhealthyCondition.Timeout.Duration),
+		}, 0
+	}
+
+	return nil, expiresIn
+}
+
+// remediate remediates the unhealthy machine. It returns the strategy the machine was remediated with,
+// or an empty strategy together with the duration after which to retry if the machine was recently remediated.
+func (r *MachineHealthCheckReconciler) remediate(
+	ctx context.Context,
+	log logr.Logger,
+	machineHealthCheck *computev1alpha1.MachineHealthCheck,
+	health *machineHealth,
+	now time.Time,
+) (computev1alpha1.MachineRemediationStrategy, time.Duration, error) {
+	machine := health.machine
+
+	if machineHealthCheck.Spec.RemediationStrategy != computev1alpha1.MachineRemediationStrategyRestart && isControlledByMachineSet(machine) {
+		log.V(1).Info("Deleting unhealthy machine", "Machine", machine.Name)
+		if err := r.Delete(ctx, machine); client.IgnoreNotFound(err) != nil {
+			return "", 0, fmt.Errorf("error deleting machine %s: %w", machine.Name, err)
+		}
+		r.Eventf(machine, machineHealthCheck, corev1.EventTypeNormal, machineHealthCheckEventRemediated, "Recreate",
+			"Deleted unhealthy machine to be recreated by its MachineSet: %s", health.message)
+		return computev1alpha1.MachineRemediationStrategyRecreate, 0, nil
+	}
+
+	// Give a restarted machine the time of the rule that rendered it unhealthy to recover.
+	if restart := machine.Spec.Restart; restart != nil {
+		if remaining := restart.RequestedAt.Add(health.timeout).Sub(now); remaining > 0 {
+			log.V(1).Info("Machine was restarted recently, waiting for it to recover", "Machine", machine.Name)
+			return "", remaining, nil
+		}
+	}
+
+	log.V(1).Info("Restarting unhealthy machine", "Machine", machine.Name)
+	base := machine.DeepCopy()
+	machine.Spec.Restart = &computev1alpha1.MachineRestart{
+		RequestedAt: metav1.Time{Time: now},
+		Mode:        computev1alpha1.RestartModeHard,
+	}
+	if err := r.Patch(ctx, machine, client.MergeFrom(base)); err != nil {
+		return "", 0, fmt.Errorf("error restarting machine %s: %w", machine.Name, err)
+	}
+	r.Eventf(machine, machineHealthCheck, corev1.EventTypeNormal, machineHealthCheckEventRemediated, "Restart",
+		"Restarted unhealthy machine: %s", health.message)
+	return computev1alpha1.MachineRemediationStrategyRestart, health.timeout, nil
+}
+
+func isControlledByMachineSet(machine *computev1alpha1.Machine) bool {
+	controllerRef := metav1.GetControllerOf(machine)
+	return controllerRef != nil &&
+		controllerRef.APIVersion == computev1alpha1.SchemeGroupVersion.String() &&
+		controllerRef.Kind == "MachineSet"
+}
+
+func (r *MachineHealthCheckReconciler) enqueueByMachine() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		log := ctrl.LoggerFrom(ctx)
+		machine := obj.(*computev1alpha1.Machine)
+
+		machineHealthCheckList := &computev1alpha1.MachineHealthCheckList{}
+		if err := r.List(ctx, machineHealthCheckList,
+			client.InNamespace(machine.Namespace),
+		); err != nil {
+			log.Error(err, "Error listing machine health checks")
+			return nil
+		}
+
+		var reqs []reconcile.Request
+		for _, machineHealthCheck := range machineHealthCheckList.Items {
+			selector, err := metav1.LabelSelectorAsSelector(machineHealthCheck.Spec.Selector)
+			if err != nil {
+				continue
+			}
+
+			if selector.Matches(labels.Set(machine.Labels)) {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&machineHealthCheck)})
+			}
+		}
+		return reqs
+	})
+}
+
+func (r *MachineHealthCheckReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(
+			&computev1alpha1.MachineHealthCheck{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&computev1alpha1.Machine{},
+			r.enqueueByMachine(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("MachineHealthCheckReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+	machineClass := SetupMachineClass()
+
+	newMachineHealthCheck := func(app string, strategy computev1alpha1.MachineRemediationStrategy) *computev1alpha1.MachineHealthCheck {
+		return &computev1alpha1.MachineHealthCheck{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-mhc-",
+			},
+			Spec: computev1alpha1.MachineHealthCheckSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+				UnhealthyStates: []computev1alpha1.UnhealthyMachineState{
+					{State: computev1alpha1.MachineStateShutdown, Timeout: metav1.Duration{Duration: time.Minute}},
+				},
+				RemediationStrategy: strategy,
+			},
+		}
+	}
+
+	shutDownLongAgo := func(machine *computev1alpha1.Machine) {
+		Eventually(UpdateStatus(machine, func() {
+			machine.Status.State = computev1alpha1.MachineStateShutdown
+			machine.Status.LastStateTransitionTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+		})).Should(Succeed())
+	}
+
+	It("should restart a machine that is shut down for too long", func(ctx SpecContext) {
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+				Labels:       map[string]string{"app": "restart"},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+
+		By("creating a machine health check")
+		machineHealthCheck := newMachineHealthCheck("restart", computev1alpha1.MachineRemediationStrategyRestart)
+		Expect(k8sClient.Create(ctx, machineHealthCheck)).To(Succeed(), "failed to create machine health check")
+
+		By("waiting for the machine to be reported healthy")
+		Eventually(Object(machineHealthCheck)).Should(HaveField("Status", SatisfyAll(
+			HaveField("ExpectedMachines", int32(1)),
+			HaveField("CurrentHealthy", int32(1)),
+			HaveField("UnhealthyMachines", BeEmpty()),
+		)))
+
+		By("shutting the machine down an hour ago")
+		shutDownLongAgo(machine)
+
+		By("waiting for the machine to be restarted")
+		Eventually(Object(machine)).Should(HaveField("Spec.Restart", SatisfyAll(
+			Not(BeNil()),
+			HaveField("Mode", computev1alpha1.RestartModeHard),
+		)))
+
+		By("waiting for the remediation to be recorded")
+		Eventually(Object(machineHealthCheck)).Should(HaveField("Status", SatisfyAll(
+			HaveField("ExpectedMachines", int32(1)),
+			HaveField("CurrentHealthy", int32(0)),
+			HaveField("UnhealthyMachines", ConsistOf(SatisfyAll(
+				HaveField("Name", machine.Name),
+				HaveField("Reason", "UnhealthyState"),
+				HaveField("RemediationStrategy", computev1alpha1.MachineRemediationStrategyRestart),
+				HaveField("LastRemediationTime", Not(BeNil())),
+			))),
+		)))
+	})
+
+	It("should restart a machine that has an unhealthy condition for too long", func(ctx SpecContext) {
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+				Labels:       map[string]string{"app": "condition"},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+
+		By("creating a machine health check with an unhealthy condition")
+		machineHealthCheck := newMachineHealthCheck("condition", computev1alpha1.MachineRemediationStrategyRestart)
+		machineHealthCheck.Spec.UnhealthyConditions = []computev1alpha1.UnhealthyMachineCondition{
+			{Type: computev1alpha1.MachineIOFrozen, Status: corev1.ConditionTrue, Timeout: metav1.Duration{Duration: time.Hour}},
+		}
+		Expect(k8sClient.Create(ctx, machineHealthCheck)).To(Succeed(), "failed to create machine health check")
+
+		By("setting the condition just now")
+		Eventually(UpdateStatus(machine, func() {
+			machine.Status.Conditions = []computev1alpha1.MachineCondition{
+				{
+					Type:               computev1alpha1.MachineIOFrozen,
+					Status:             corev1.ConditionTrue,
+					Reason:             "Frozen",
+					LastTransitionTime: metav1.Now(),
+				},
+			}
+		})).Should(Succeed())
+
+		By("asserting the machine is not restarted within the timeout")
+		Eventually(Object(machineHealthCheck)).Should(HaveField("Status.CurrentHealthy", int32(1)))
+		Consistently(Object(machine)).Should(HaveField("Spec.Restart", BeNil()))
+
+		By("setting the condition two hours ago")
+		Eventually(UpdateStatus(machine, func() {
+			machine.Status.Conditions[0].LastTransitionTime = metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+		})).Should(Succeed())
+
+		By("waiting for the machine to be restarted")
+		Eventually(Object(machine)).Should(HaveField("Spec.Restart", Not(BeNil())))
+
+		By("waiting for the remediation to be recorded")
+		Eventually(Object(machineHealthCheck)).Should(HaveField("Status", SatisfyAll(
+			HaveField("CurrentHealthy", int32(0)),
+			HaveField("UnhealthyMachines", ConsistOf(SatisfyAll(
+				HaveField("Name", machine.Name),
+				HaveField("Reason", "UnhealthyCondition"),
+				HaveField("RemediationStrategy", computev1alpha1.MachineRemediationStrategyRestart),
+			))),
+		)))
+	})
+
+	It("should recreate an unhealthy machine controlled by a machine set", func(ctx SpecContext) {
+		By("creating a machine set")
+		machineSet := &computev1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-ms-",
+			},
+			Spec: computev1alpha1.MachineSetSpec{
+				Replicas: ptr.To[int32](1),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "recreate"}},
+				Template: computev1alpha1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "recreate"}},
+					Spec: computev1alpha1.MachineSpec{
+						MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineSet)).To(Succeed(), "failed to create machine set")
+
+		By("waiting for the machine to be created")
+		machineList := &computev1alpha1.MachineList{}
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name), client.MatchingLabels{"app": "recreate"})).
+			Should(HaveField("Items", HaveLen(1)))
+		machine := machineList.Items[0].DeepCopy()
+
+		By("creating a machine health check")
+		machineHealthCheck := newMachineHealthCheck("recreate", computev1alpha1.MachineRemediationStrategyRecreate)
+		Expect(k8sClient.Create(ctx, machineHealthCheck)).To(Succeed(), "failed to create machine health check")
+
+		By("shutting the machine down an hour ago")
+		shutDownLongAgo(machine)
+
+		By("waiting for the machine to be replaced")
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name), client.MatchingLabels{"app": "recreate"})).
+			Should(HaveField("Items", ConsistOf(HaveField("Name", Not(Equal(machine.Name))))))
+	})
+
+	It("should not remediate if more machines than maxUnhealthy are unhealthy", func(ctx SpecContext) {
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+				Labels:       map[string]string{"app": "restricted"},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+
+		By("shutting the machine down an hour ago")
+		shutDownLongAgo(machine)
+
+		By("creating a machine health check not allowing any unhealthy machine")
+		machineHealthCheck := newMachineHealthCheck("restricted", computev1alpha1.MachineRemediationStrategyRestart)
+		machineHealthCheck.Spec.MaxUnhealthy = ptr.To(intstr.FromInt32(0))
+		Expect(k8sClient.Create(ctx, machineHealthCheck)).To(Succeed(), "failed to create machine health check")
+
+		By("waiting for the machine to be reported unhealthy")
+		Eventually(Object(machineHealthCheck)).Should(HaveField("Status", SatisfyAll(
+			HaveField("ExpectedMachines", int32(1)),
+			HaveField("RemediationsAllowed", int32(0)),
+			HaveField("UnhealthyMachines", ConsistOf(SatisfyAll(
+				HaveField("Name", machine.Name),
+				HaveField("LastRemediationTime", BeNil()),
+			))),
+			HaveField("Conditions", ConsistOf(SatisfyAll(
+				HaveField("Type", computev1alpha1.MachineHealthCheckRemediationAllowed),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", "TooManyUnhealthy"),
+			))),
+		)))
+
+		By("asserting the machine is not restarted and the condition does not transition again")
+		lastTransitionTime := machineHealthCheck.Status.Conditions[0].LastTransitionTime
+		Consistently(Object(machine)).Should(HaveField("Spec.Restart", BeNil()))
+		Expect(Object(machineHealthCheck)()).To(HaveField("Status.Conditions", ConsistOf(
+			HaveField("LastTransitionTime", lastTransitionTime),
+		)))
+	})
+})
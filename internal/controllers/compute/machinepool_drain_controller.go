@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// defaultMachinePoolDrainEvictionTimeout is the time to wait for an evicted machine to be gone
+// if the drain request does not specify an eviction timeout.
+const defaultMachinePoolDrainEvictionTimeout = 5 * time.Minute
+
+const (
+	machinePoolDrainReasonDraining        = "Draining"
+	machinePoolDrainReasonDrained         = "Drained"
+	machinePoolDrainReasonEvictionBlocked = "EvictionBlocked"
+	machinePoolDrainReasonEvictionTimeout = "EvictionTimeout"
+)
+
+// MachinePoolDrainReconciler evicts all machines from machine pools with a drain request,
+// one machine at a time.
+type MachinePoolDrainReconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinepools,verbs=get;list;watch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinepools/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/eviction,verbs=create
+
+func (r *MachinePoolDrainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	machinePool := &computev1alpha1.MachinePool{}
+	if err := r.Get(ctx, req.NamespacedName, machinePool); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !machinePool.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	if machinePool.Spec.Drain == nil {
+		return ctrl.Result{}, r.reconcileNotDraining(ctx, log, machinePool)
+	}
+	return r.reconcileDraining(ctx, log, machinePool)
+}
+
+func (r *MachinePoolDrainReconciler) reconcileNotDraining(ctx context.Context, log logr.Logger, machinePool *computev1alpha1.MachinePool) error {
+	if machinePool.Status.Drain == nil &&
+		computev1alpha1.FindMachinePoolCondition(machinePool.Status.Conditions, computev1alpha1.MachinePoolDrained) == nil {
+		return nil
+	}
+
+	log.V(1).Info("Drain request removed, clearing drain status")
+	base := machinePool.DeepCopy()
+	machinePool.Status.Drain = nil
+	machinePool.Status.Conditions = computev1alpha1.RemoveMachinePoolCondition(machinePool.Status.Conditions, computev1alpha1.MachinePoolDrained)
+	// A merge patch replaces the whole condition list, so guard against overwriting conditions of other controllers.
+	if err := r.Status().Patch(ctx, machinePool, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("error clearing machine pool drain status: %w", err)
+	}
+	return nil
+}
+
+func (r *MachinePoolDrainReconciler) reconcileDraining(ctx context.Context, log logr.Logger, machinePool *computev1alpha1.MachinePool) (ctrl.Result, error) {
+	drain := machinePool.Spec.Drain
+	now := metav1.Now()
+
+	log.V(1).Info("Listing machines bound to machine pool")
+	machineList := &computev1alpha1.MachineList{}
+	if err := r.List(ctx, machineList,
+		client.MatchingFields{computeclient.MachineSpecMachinePoolRefNameField: machinePool.Name},
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing machines bound to machine pool: %w", err)
+	}
+	machines := machineList.Items
+	slices.SortFunc(machines, func(a, b computev1alpha1.Machine) int {
+		return strings.Compare(client.ObjectKeyFromObject(&a).String(), client.ObjectKeyFromObject(&b).String())
+	})
+
+	drainStatus := &computev1alpha1.MachinePoolDrainStatus{}
+	if status := machinePool.Status.Drain; status != nil && status.ObservedRequestedAt.Equal(&drain.RequestedAt) {
+		drainStatus = status.DeepCopy()
+	} else {
+		log.V(1).Info("Starting drain", "RequestedAt", drain.RequestedAt)
+		drainStatus.ObservedRequestedAt = drain.RequestedAt
+		drainStatus.StartTime = &now
+	}
+	drainStatus.RemainingMachines = int32(len(machines))
+
+	var (
+		cond   computev1alpha1.MachinePoolCondition
+		result ctrl.Result
+	)
+	if len(machines) == 0 {
+		log.V(1).Info("All machines evicted, machine pool is drained")
+		drainStatus.State = computev1alpha1.MachinePoolDrainStateDrained
+		if drainStatus.CompletionTime == nil {
+			drainStatus.CompletionTime = &now
+		}
+		cond = computev1alpha1.MachinePoolCondition{
+			Type:    computev1alpha1.MachinePoolDrained,
+			Status:  corev1.ConditionTrue,
+			Reason:  machinePoolDrainReasonDrained,
+			Message: "All machines have been evicted from the machine pool.",
+		}
+	} else {
+		drainStatus.State = computev1alpha1.MachinePoolDrainStateDraining
+		drainStatus.CompletionTime = nil
+
+		var err error
+		cond, result, err = r.evictNextMachine(ctx, log, machines, evictionTimeout(drain), now.Time)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	cond.ObservedGeneration = machinePool.Generation
+
+	base := machinePool.DeepCopy()
+	machinePool.Status.Drain = drainStatus
+	if existing := computev1alpha1.FindMachinePoolCondition(machinePool.Status.Conditions, computev1alpha1.MachinePoolDrained); machinePoolDrainedConditionDiffers(existing, &cond) {
+		machinePool.Status.Conditions = computev1alpha1.SetMachinePoolCondition(machinePool.Status.Conditions, cond)
+	}
+	if err := r.Status().Patch(ctx, machinePool, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating machine pool drain status: %w", err)
+	}
+	return result, nil
+}
+
+// evictNextMachine evicts the next machine of the given machines if no eviction is in flight anymore.
+// An eviction is in flight while an evicted machine is being deleted and its eviction timeout has not passed.
+// It returns the Drained condition describing the progress of the drain.
+func (r *MachinePoolDrainReconciler) evictNextMachine(
+	ctx context.Context,
+	log logr.Logger,
+	machines []computev1alpha1.Machine,
+	timeout time.Duration,
+	now time.Time,
+) (computev1alpha1.MachinePoolCondition, ctrl.Result, error) {
+	var (
+		next     *computev1alpha1.Machine
+		timedOut []string
+	)
+	for i := range machines {
+		machine := &machines[i]
+		if machine.DeletionTimestamp.IsZero() {
+			if next == nil {
+				next = machine
+			}
+			continue
+		}
+
+		if remaining := machine.DeletionTimestamp.Add(timeout).Sub(now); remaining > 0 {
+			log.V(1).Info("Waiting for evicted machine to be gone", "Machine", client.ObjectKeyFromObject(machine))
+			return computev1alpha1.MachinePoolCondition{
+				Type:    computev1alpha1.MachinePoolDrained,
+				Status:  corev1.ConditionFalse,
+				Reason:  machinePoolDrainReasonDraining,
+				Message: fmt.Sprintf("Waiting for evicted machine %s to be gone.", client.ObjectKeyFromObject(machine)),
+			}, ctrl.Result{RequeueAfter: remaining}, nil
+		}
+		timedOut = append(timedOut, client.ObjectKeyFromObject(machine).String())
+	}
+
+	if next == nil {
+		return computev1alpha1.MachinePoolCondition{
+			Type:    computev1alpha1.MachinePoolDrained,
+			Status:  corev1.ConditionFalse,
+			Reason:  machinePoolDrainReasonEvictionTimeout,
+			Message: fmt.Sprintf("Evicted machines not gone after %s: %s.", timeout, strings.Join(timedOut, ", ")),
+		}, ctrl.Result{RequeueAfter: machineEvictionRetryInterval}, nil
+	}
+
+	log.V(1).Info("Evicting machine", "Machine", client.ObjectKeyFromObject(next))
+	if err := r.SubResource("eviction").Create(ctx, next, &computev1alpha1.MachineEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: next.Namespace,
+			Name:      next.Name,
+		},
+	}); err != nil {
+		switch {
+		case apierrors.IsNotFound(err):
+			return computev1alpha1.MachinePoolCondition{
+				Type:    computev1alpha1.MachinePoolDrained,
+				Status:  corev1.ConditionFalse,
+				Reason:  machinePoolDrainReasonDraining,
+				Message: "Evicting machines from the machine pool.",
+			}, ctrl.Result{Requeue: true}, nil
+		case apierrors.IsTooManyRequests(err):
+			log.V(1).Info("Eviction blocked by machine disruption budget", "Reason", err.Error())
+			return computev1alpha1.MachinePoolCondition{
+				Type:    computev1alpha1.MachinePoolDrained,
+				Status:  corev1.ConditionFalse,
+				Reason:  machinePoolDrainReasonEvictionBlocked,
+				Message: fmt.Sprintf("Eviction of machine %s blocked: %v", client.ObjectKeyFromObject(next), err),
+			}, ctrl.Result{RequeueAfter: machineEvictionRetryInterval}, nil
+		default:
+			return computev1alpha1.MachinePoolCondition{}, ctrl.Result{}, fmt.Errorf("error evicting machine %s: %w", client.ObjectKeyFromObject(next), err)
+		}
+	}
+
+	if len(timedOut) > 0 {
+		return computev1alpha1.MachinePoolCondition{
+			Type:    computev1alpha1.MachinePoolDrained,
+			Status:  corev1.ConditionFalse,
+			Reason:  machinePoolDrainReasonEvictionTimeout,
+			Message: fmt.Sprintf("Evicted machines not gone after %s: %s.", timeout, strings.Join(timedOut, ", ")),
+		}, ctrl.Result{}, nil
+	}
+	return computev1alpha1.MachinePoolCondition{
+		Type:    computev1alpha1.MachinePoolDrained,
+		Status:  corev1.ConditionFalse,
+		Reason:  machinePoolDrainReasonDraining,
+		Message: fmt.Sprintf("Evicted machine %s.", client.ObjectKeyFromObject(next)),
+	}, ctrl.Result{}, nil
+}
+
+func evictionTimeout(drain *computev1alpha1.MachinePoolDrain) time.Duration {
+	if drain.EvictionTimeout != nil {
+		return drain.EvictionTimeout.Duration
+	}
+	return defaultMachinePoolDrainEvictionTimeout
+}
+
+// machinePoolDrainedConditionDiffers reports whether the desired Drained condition differs from the existing one.
+// Conditions are only rewritten on a change to avoid status patches triggering endless reconciles.
+func machinePoolDrainedConditionDiffers(existing, desired *computev1alpha1.MachinePoolCondition) bool {
+	if existing == nil {
+		return true
+	}
+	return existing.Status != desired.Status ||
+		existing.Reason != desired.Reason ||
+		existing.Message != desired.Message ||
+		existing.ObservedGeneration != desired.ObservedGeneration
+}
+
+func (r *MachinePoolDrainReconciler) enqueueByMachine() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		machine := obj.(*computev1alpha1.Machine)
+		if machine.Spec.MachinePoolRef == nil {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: machine.Spec.MachinePoolRef.Name}}}
+	})
+}
+
+func (r *MachinePoolDrainReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("machinepool-drain").
+		For(
+			&computev1alpha1.MachinePool{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&computev1alpha1.Machine{},
+			r.enqueueByMachine(),
+		).
+		Complete(r)
+}
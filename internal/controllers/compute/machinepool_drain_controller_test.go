@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("MachinePoolDrainReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+	machineClass := SetupMachineClass()
+
+	createMachinePool := func(ctx SpecContext) *computev1alpha1.MachinePool {
+		machinePool := &computev1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, machinePool)).To(Succeed(), "failed to create machine pool")
+		DeferCleanup(k8sClient.Delete, machinePool)
+		return machinePool
+	}
+
+	createBoundMachine := func(ctx SpecContext, machinePool *computev1alpha1.MachinePool) *computev1alpha1.Machine {
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+				Labels:       map[string]string{"app": "drain"},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: machinePool.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+
+		Eventually(UpdateStatus(machine, func() {
+			machine.Status.State = computev1alpha1.MachineStateRunning
+		})).Should(Succeed())
+		return machine
+	}
+
+	It("should evict all machines from a draining machine pool", func(ctx SpecContext) {
+		machinePool := createMachinePool(ctx)
+
+		By("creating two machines bound to the machine pool")
+		machine1 := createBoundMachine(ctx, machinePool)
+		machine2 := createBoundMachine(ctx, machinePool)
+
+		By("reporting a condition of another controller on the machine pool")
+		Eventually(UpdateStatus(machinePool, func() {
+			machinePool.Status.Conditions = computev1alpha1.SetMachinePoolCondition(machinePool.Status.Conditions, computev1alpha1.MachinePoolCondition{
+				Type:   computev1alpha1.MachinePoolRuntimeCapabilities,
+				Status: corev1.ConditionTrue,
+				Reason: "Discovered",
+			})
+		})).Should(Succeed())
+
+		By("requesting the machine pool to be drained")
+		Eventually(Update(machinePool, func() {
+			machinePool.Spec.Drain = &computev1alpha1.MachinePoolDrain{RequestedAt: metav1.Now()}
+		})).Should(Succeed())
+
+		By("waiting for the machines to be evicted")
+		Eventually(Get(machine1)).Should(Satisfy(apierrors.IsNotFound))
+		Eventually(Get(machine2)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("waiting for the machine pool to report the drain as completed")
+		Eventually(Object(machinePool)).Should(SatisfyAll(
+			HaveField("Status.Drain", PointTo(SatisfyAll(
+				HaveField("State", computev1alpha1.MachinePoolDrainStateDrained),
+				HaveField("ObservedRequestedAt", machinePool.Spec.Drain.RequestedAt),
+				HaveField("StartTime", Not(BeNil())),
+				HaveField("CompletionTime", Not(BeNil())),
+				HaveField("RemainingMachines", int32(0)),
+			))),
+			HaveField("Status.Conditions", ContainElements(
+				SatisfyAll(
+					HaveField("Type", computev1alpha1.MachinePoolDrained),
+					HaveField("Status", corev1.ConditionTrue),
+				),
+				HaveField("Type", computev1alpha1.MachinePoolRuntimeCapabilities),
+			)),
+		))
+
+		By("removing the drain request")
+		Eventually(Update(machinePool, func() {
+			machinePool.Spec.Drain = nil
+		})).Should(Succeed())
+
+		By("waiting for the drain status to be cleared")
+		Eventually(Object(machinePool)).Should(SatisfyAll(
+			HaveField("Status.Drain", BeNil()),
+			HaveField("Status.Conditions", Not(ContainElement(HaveField("Type", computev1alpha1.MachinePoolDrained)))),
+			HaveField("Status.Conditions", ContainElement(HaveField("Type", computev1alpha1.MachinePoolRuntimeCapabilities))),
+		))
+	})
+
+	It("should report evictions blocked by a machine disruption budget", func(ctx SpecContext) {
+		machinePool := createMachinePool(ctx)
+
+		By("creating a machine bound to the machine pool")
+		machine := createBoundMachine(ctx, machinePool)
+
+		By("creating a machine disruption budget not allowing any disruption")
+		machineDisruptionBudget := &computev1alpha1.MachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-mdb-",
+			},
+			Spec: computev1alpha1.MachineDisruptionBudgetSpec{
+				Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "drain"}},
+				MinAvailable: ptr.To(intstr.FromInt32(1)),
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineDisruptionBudget)).To(Succeed(), "failed to create machine disruption budget")
+		Eventually(Object(machineDisruptionBudget)).Should(HaveField("Status.ExpectedMachines", int32(1)))
+
+		By("requesting the machine pool to be drained")
+		Eventually(Update(machinePool, func() {
+			machinePool.Spec.Drain = &computev1alpha1.MachinePoolDrain{RequestedAt: metav1.Now()}
+		})).Should(Succeed())
+
+		By("waiting for the machine pool to report the blocked eviction")
+		Eventually(Object(machinePool)).Should(SatisfyAll(
+			HaveField("Status.Drain", PointTo(SatisfyAll(
+				HaveField("State", computev1alpha1.MachinePoolDrainStateDraining),
+				HaveField("RemainingMachines", int32(1)),
+			))),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", computev1alpha1.MachinePoolDrained),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", "EvictionBlocked"),
+			))),
+		))
+		Consistently(Get(machine)).Should(Succeed())
+
+		By("deleting the machine disruption budget")
+		Expect(k8sClient.Delete(ctx, machineDisruptionBudget)).To(Succeed())
+
+		By("waiting for the machine to be evicted and the drain to complete")
+		Eventually(Get(machine)).Should(Satisfy(apierrors.IsNotFound))
+		Eventually(Object(machinePool)).Should(HaveField("Status.Drain.State", computev1alpha1.MachinePoolDrainStateDrained))
+	})
+})
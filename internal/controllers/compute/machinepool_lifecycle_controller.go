@@ -175,7 +175,7 @@ func (r *MachinePoolLifecycleReconciler) reconcileExists(ctx context.Context, lo
 			} else {
 				log.Info("Grace period exceeded without health update, marking machine pool status unknown",
 					"gracePeriod", r.GracePeriod, "lastChangeDetected", prev.lastChangeDetectedTime)
-				patch := client.StrategicMergeFrom(machinePool.DeepCopy())
+				patch := client.StrategicMergeFrom(machinePool.DeepCopy(), client.MergeFromWithOptimisticLock{})
 				newReadyCondition := computev1alpha1.MachinePoolCondition{
 					Type:               computev1alpha1.MachinePoolReady,
 					Status:             corev1.ConditionUnknown,
@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// machineSetExpectationsTimeout is the time after which machine creations / deletions that were not
+// observed are no longer waited for, e.g. if a created machine was deleted before it was observed.
+const machineSetExpectationsTimeout = 5 * time.Minute
+
+// MachineSetReconciler creates and deletes machines to match the replicas of MachineSets.
+type MachineSetReconciler struct {
+	client.Client
+
+	expectationsMu sync.Mutex
+	expectations   map[client.ObjectKey]*machineSetExpectations
+}
+
+// machineSetExpectations are the machines a machine set created / deleted that were not yet observed in the cache.
+// As long as there are pending expectations, the machines listed from the cache are stale and
+// the machine set must not create / delete further machines.
+type machineSetExpectations struct {
+	creates   sets.Set[string]
+	deletes   sets.Set[string]
+	timestamp time.Time
+}
+
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinesets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinesets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;create;delete
+
+func (r *MachineSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	machineSet := &computev1alpha1.MachineSet{}
+	if err := r.Get(ctx, req.NamespacedName, machineSet); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.deleteMachineSetExpectations(req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !machineSet.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, machineSet)
+}
+
+func (r *MachineSetReconciler) reconcileExists(ctx context.Context, log logr.Logger, machineSet *computev1alpha1.MachineSet) (ctrl.Result, error) {
+	log.V(1).Info("Listing machines of machine set")
+	machines, err := r.listMachines(ctx, machineSet)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	var result ctrl.Result
+	key := client.ObjectKeyFromObject(machineSet)
+	if r.machineSetExpectationsSatisfied(key, machines) {
+		machines, err = r.manageMachines(ctx, log, machineSet, activeMachines(machines))
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	} else {
+		log.V(1).Info("Waiting for created / deleted machines to be observed")
+		machines = activeMachines(machines)
+		result.RequeueAfter = machineSetExpectationsTimeout
+	}
+
+	var readyReplicas int32
+	for _, machine := range machines {
+		if isMachineHealthy(&machine) {
+			readyReplicas++
+		}
+	}
+
+	log.V(1).Info("Updating machine set status", "Replicas", len(machines), "ReadyReplicas", readyReplicas)
+	base := machineSet.DeepCopy()
+	machineSet.Status = computev1alpha1.MachineSetStatus{
+		ObservedGeneration: machineSet.Generation,
+		Replicas:           int32(len(machines)),
+		ReadyReplicas:      readyReplicas,
+	}
+	if err := r.Status().Patch(ctx, machineSet, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating machine set status: %w", err)
+	}
+	return result, nil
+}
+
+// manageMachines creates / deletes machines so that the machine set has the desired number of active machines.
+// It returns the active machines after doing so.
+func (r *MachineSetReconciler) manageMachines(
+	ctx context.Context,
+	log logr.Logger,
+	machineSet *computev1alpha1.MachineSet,
+	machines []computev1alpha1.Machine,
+) ([]computev1alpha1.Machine, error) {
+	key := client.ObjectKeyFromObject(machineSet)
+	desired := int(ptr.Deref(machineSet.Spec.Replicas, 1))
+	switch diff := desired - len(machines); {
+	case diff > 0:
+		log.V(1).Info("Creating machines", "Count", diff)
+		for range diff {
+			machine, err := r.createMachine(ctx, machineSet)
+			if err != nil {
+				return nil, err
+			}
+			r.expectMachineSetCreate(key, machine.Name)
+			machines = append(machines, *machine)
+		}
+	case diff < 0:
+		log.V(1).Info("Deleting machines", "Count", -diff)
+		sortMachinesForDeletion(machines)
+		for i := range -diff {
+			if err := r.Delete(ctx, &machines[i]); client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("error deleting machine %s: %w", machines[i].Name, err)
+			}
+			r.expectMachineSetDelete(key, machines[i].Name)
+		}
+		machines = machines[-diff:]
+	}
+	return machines, nil
+}
+
+// activeMachines returns the machines that are not being deleted.
+func activeMachines(machines []computev1alpha1.Machine) []computev1alpha1.Machine {
+	var active []computev1alpha1.Machine
+	for _, machine := range machines {
+		if machine.DeletionTimestamp.IsZero() {
+			active = append(active, machine)
+		}
+	}
+	return active
+}
+
+func (r *MachineSetReconciler) getOrCreateMachineSetExpectations(key client.ObjectKey) *machineSetExpectations {
+	if r.expectations == nil {
+		r.expectations = make(map[client.ObjectKey]*machineSetExpectations)
+	}
+
+	expectations, ok := r.expectations[key]
+	if !ok {
+		expectations = &machineSetExpectations{
+			creates: sets.New[string](),
+			deletes: sets.New[string](),
+		}
+		r.expectations[key] = expectations
+	}
+	expectations.timestamp = time.Now()
+	return expectations
+}
+
+func (r *MachineSetReconciler) expectMachineSetCreate(key client.ObjectKey, machineName string) {
+	r.expectationsMu.Lock()
+	defer r.expectationsMu.Unlock()
+
+	r.getOrCreateMachineSetExpectations(key).creates.Insert(machineName)
+}
+
+func (r *MachineSetReconciler) expectMachineSetDelete(key client.ObjectKey, machineName string) {
+	r.expectationsMu.Lock()
+	defer r.expectationsMu.Unlock()
+
+	r.getOrCreateMachineSetExpectations(key).deletes.Insert(machineName)
+}
+
+func (r *MachineSetReconciler) deleteMachineSetExpectations(key client.ObjectKey) {
+	r.expectationsMu.Lock()
+	defer r.expectationsMu.Unlock()
+
+	delete(r.expectations, key)
+}
+
+// machineSetExpectationsSatisfied observes the given machines of the machine set and reports whether
+// all machines created / deleted by the machine set have been observed or the expectations timed out.
+func (r *MachineSetReconciler) machineSetExpectationsSatisfied(key client.ObjectKey, machines []computev1alpha1.Machine) bool {
+	r.expectationsMu.Lock()
+	defer r.expectationsMu.Unlock()
+
+	expectations, ok := r.expectations[key]
+	if !ok {
+		return true
+	}
+
+	for _, machine := range machines {
+		expectations.creates.Delete(machine.Name)
+	}
+	for name := range expectations.deletes {
+		if !slices.ContainsFunc(machines, func(machine computev1alpha1.Machine) bool {
+			return machine.Name == name && machine.DeletionTimestamp.IsZero()
+		}) {
+			expectations.deletes.Delete(name)
+		}
+	}
+
+	if (expectations.creates.Len() > 0 || expectations.deletes.Len() > 0) &&
+		time.Since(expectations.timestamp) < machineSetExpectationsTimeout {
+		return false
+	}
+	delete(r.expectations, key)
+	return true
+}
+
+// listMachines lists the machines controlled by the machine set, including the ones being deleted.
+func (r *MachineSetReconciler) listMachines(ctx context.Context, machineSet *computev1alpha1.MachineSet) ([]computev1alpha1.Machine, error) {
+	selector, err := metav1.LabelSelectorAsSelector(machineSet.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing selector: %w", err)
+	}
+
+	machineList := &computev1alpha1.MachineList{}
+	if err := r.List(ctx, machineList,
+		client.InNamespace(machineSet.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return nil, fmt.Errorf("error listing machines: %w", err)
+	}
+
+	var machines []computev1alpha1.Machine
+	for _, machine := range machineList.Items {
+		if metav1.IsControlledBy(&machine, machineSet) {
+			machines = append(machines, machine)
+		}
+	}
+	return machines, nil
+}
+
+func (r *MachineSetReconciler) createMachine(ctx context.Context, machineSet *computev1alpha1.MachineSet) (*computev1alpha1.Machine, error) {
+	template := machineSet.Spec.Template.DeepCopy()
+	machine := &computev1alpha1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    machineSet.Namespace,
+			GenerateName: machineSet.Name + "-",
+			Labels:       template.Labels,
+			Annotations:  template.Annotations,
+		},
+		Spec: template.Spec,
+	}
+	if err := controllerutil.SetControllerReference(machineSet, machine, r.Scheme()); err != nil {
+		return nil, fmt.Errorf("error setting controller reference: %w", err)
+	}
+	if err := r.Create(ctx, machine); err != nil {
+		return nil, fmt.Errorf("error creating machine: %w", err)
+	}
+	return machine, nil
+}
+
+// sortMachinesForDeletion sorts the machines so that the ones to be deleted first come first:
+// Unscheduled before scheduled, not running before running and newer before older machines.
+func sortMachinesForDeletion(machines []computev1alpha1.Machine) {
+	rank := func(machine *computev1alpha1.Machine) int {
+		switch {
+		case machine.Spec.MachinePoolRef == nil:
+			return 0
+		case !isMachineHealthy(machine):
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	slices.SortStableFunc(machines, func(a, b computev1alpha1.Machine) int {
+		if d := rank(&a) - rank(&b); d != 0 {
+			return d
+		}
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+}
+
+func (r *MachineSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(
+			&computev1alpha1.MachineSet{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Owns(&computev1alpha1.Machine{}).
+		Complete(r)
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("MachineSetReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+	machineClass := SetupMachineClass()
+
+	It("should create and delete machines to match the replicas", func(ctx SpecContext) {
+		By("creating a machine set")
+		machineSet := &computev1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-ms-",
+			},
+			Spec: computev1alpha1.MachineSetSpec{
+				Replicas: ptr.To[int32](2),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Template: computev1alpha1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+					Spec: computev1alpha1.MachineSpec{
+						MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineSet)).To(Succeed(), "failed to create machine set")
+
+		By("waiting for the machines to be created")
+		machineList := &computev1alpha1.MachineList{}
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name))).Should(HaveField("Items", ConsistOf(
+			HaveField("ObjectMeta.OwnerReferences", ConsistOf(HaveField("UID", machineSet.UID))),
+			HaveField("ObjectMeta.OwnerReferences", ConsistOf(HaveField("UID", machineSet.UID))),
+		)))
+		Expect(machineList.Items).To(HaveEach(SatisfyAll(
+			HaveField("Labels", HaveKeyWithValue("app", "web")),
+			HaveField("Spec.MachineClassRef.Name", machineClass.Name),
+		)))
+
+		By("waiting for the machine set status to be updated")
+		Eventually(Object(machineSet)).Should(HaveField("Status", SatisfyAll(
+			HaveField("ObservedGeneration", machineSet.Generation),
+			HaveField("Replicas", int32(2)),
+		)))
+
+		By("scaling the machine set down via the scale subresource")
+		scale := &autoscalingv1.Scale{}
+		Expect(k8sClient.SubResource("scale").Get(ctx, machineSet, scale)).To(Succeed())
+		Expect(scale.Spec.Replicas).To(Equal(int32(2)))
+		Expect(scale.Status.Selector).To(Equal("app=web"))
+
+		scale.Spec.Replicas = 1
+		Expect(k8sClient.SubResource("scale").Update(ctx, machineSet, client.WithSubResourceBody(scale))).To(Succeed())
+
+		By("waiting for a machine to be deleted")
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name))).Should(HaveField("Items", HaveLen(1)))
+		Eventually(Object(machineSet)).Should(SatisfyAll(
+			HaveField("Spec.Replicas", PointTo(Equal(int32(1)))),
+			HaveField("Status.Replicas", int32(1)),
+		))
+	})
+
+	It("should replace machines deleted out of band", func(ctx SpecContext) {
+		By("creating a machine set")
+		machineSet := &computev1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-ms-",
+			},
+			Spec: computev1alpha1.MachineSetSpec{
+				Replicas: ptr.To[int32](1),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				Template: computev1alpha1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "db"}},
+					Spec: computev1alpha1.MachineSpec{
+						MachineClassRef: corev1.LocalObjectReference{Name: machineClass.Name},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machineSet)).To(Succeed(), "failed to create machine set")
+
+		By("waiting for the machine to be created")
+		machineList := &computev1alpha1.MachineList{}
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name))).Should(HaveField("Items", HaveLen(1)))
+		machine := machineList.Items[0].DeepCopy()
+
+		By("deleting the machine")
+		Expect(k8sClient.Delete(ctx, machine)).To(Succeed())
+
+		By("waiting for a replacement machine to be created")
+		Eventually(ObjectList(machineList, client.InNamespace(ns.Name))).Should(HaveField("Items", ConsistOf(
+			HaveField("Name", Not(Equal(machine.Name))),
+		)))
+	})
+})
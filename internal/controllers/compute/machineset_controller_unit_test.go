@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package compute
+
+import (
+	"context"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+)
+
+var _ = Describe("MachineSetReconciler expectations", func() {
+	It("creates exactly the desired number of machines when the cache lags behind", func() {
+		machineSet := &computev1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "web",
+				UID:       "web-uid",
+			},
+			Spec: computev1alpha1.MachineSetSpec{
+				Replicas: ptr.To[int32](2),
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Template: computev1alpha1.MachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+					Spec: computev1alpha1.MachineSpec{
+						MachineClassRef: corev1.LocalObjectReference{Name: "machine-class"},
+					},
+				},
+			},
+		}
+
+		fakeClient := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(machineSet).
+			WithStatusSubresource(machineSet).
+			Build()
+
+		// While stale, listing machines returns none, like a cache that has not yet observed the created machines.
+		var stale atomic.Bool
+		stale.Store(true)
+		c := interceptor.NewClient(fakeClient, interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if _, ok := list.(*computev1alpha1.MachineList); ok && stale.Load() {
+					return nil
+				}
+				return c.List(ctx, list, opts...)
+			},
+		})
+
+		r := &MachineSetReconciler{Client: c}
+		req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(machineSet)}
+
+		By("reconciling twice in quick succession")
+		_, err := r.Reconcile(context.Background(), req)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = r.Reconcile(context.Background(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		machineList := &computev1alpha1.MachineList{}
+		Expect(fakeClient.List(context.Background(), machineList)).To(Succeed())
+		Expect(machineList.Items).To(HaveLen(2))
+
+		By("reconciling once the machines are observed")
+		stale.Store(false)
+		_, err = r.Reconcile(context.Background(), req)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.List(context.Background(), machineList)).To(Succeed())
+		Expect(machineList.Items).To(HaveLen(2))
+		Expect(r.expectations).NotTo(HaveKey(req.NamespacedName))
+	})
+})
@@ -78,6 +78,23 @@ func (n *ContainerInfo) MaxAllocatable(className string) int64 {
 	return class.Value() - assigned
 }
 
+// Allocatable returns the number of instances of the machine class of the given instance that still fit the node.
+func (n *ContainerInfo) Allocatable(instance *v1alpha1.Machine) int64 {
+	return n.MaxAllocatable(instance.Spec.MachineClassRef.Name)
+}
+
+func (n *ContainerInfo) Labels() map[string]string {
+	return n.node.Labels
+}
+
+func (n *ContainerInfo) Instances() []*v1alpha1.Machine {
+	res := make([]*v1alpha1.Machine, 0, len(n.instances))
+	for _, instance := range n.instances {
+		res = append(res, instance.instance)
+	}
+	return res
+}
+
 func (n *ContainerInfo) NumInstances() int {
 	return len(n.instances)
 }
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"flag"
+	"fmt"
+	"slices"
+	"strconv"
+
+	cliflag "k8s.io/component-base/cli/flag"
+)
+
+// ScorePluginNames are the names of all built-in score plugins.
+var ScorePluginNames = []string{
+	SpreadName,
+	BinPackName,
+	LeastInstancesName,
+	LabelPreferenceName,
+}
+
+// Config configures the score plugins of a scheduler.
+type Config struct {
+	// Scorers maps the names of the score plugins to use to their weights.
+	// If empty, the default score plugins of the scheduler are used.
+	Scorers map[string]string
+	// PreferredLabels are the pool labels preferred by the label-preference score plugin.
+	PreferredLabels map[string]string
+}
+
+// AddFlags adds the flags of the Config to the given flag set, prefixed with the given prefix.
+func (c *Config) AddFlags(fs *flag.FlagSet, prefix string) {
+	fs.Var(cliflag.NewMapStringString(&c.Scorers), prefix+"-scorers",
+		fmt.Sprintf("Score plugins and their weights as name=weight pairs. Available score plugins: %v.", ScorePluginNames))
+	fs.Var(cliflag.NewMapStringString(&c.PreferredLabels), prefix+"-preferred-pool-labels",
+		"Pool labels preferred by the label-preference score plugin as key=value pairs.")
+}
+
+// NewScorePlugins creates the weighted score plugins configured by the given Config.
+// If the Config has no score plugins configured, nil is returned.
+func NewScorePlugins[P PoolInfo[I], I any](cfg Config) ([]WeightedScorePlugin[P, I], error) {
+	names := make([]string, 0, len(cfg.Scorers))
+	for name := range cfg.Scorers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var scorers []WeightedScorePlugin[P, I]
+	for _, name := range names {
+		weight, err := strconv.ParseInt(cfg.Scorers[name], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight %q for score plugin %s: %w", cfg.Scorers[name], name, err)
+		}
+		if weight <= 0 {
+			return nil, fmt.Errorf("weight of score plugin %s must be positive", name)
+		}
+
+		var plugin ScorePlugin[P, I]
+		switch name {
+		case SpreadName:
+			plugin = Spread[P, I]{}
+		case BinPackName:
+			plugin = BinPack[P, I]{}
+		case LeastInstancesName:
+			plugin = LeastInstances[P, I]{}
+		case LabelPreferenceName:
+			plugin = LabelPreference[P, I]{PreferredLabels: cfg.PreferredLabels}
+		default:
+			return nil, fmt.Errorf("unknown score plugin %s", name)
+		}
+
+		scorers = append(scorers, WeightedScorePlugin[P, I]{ScorePlugin: plugin, Weight: weight})
+	}
+	return scorers, nil
+}
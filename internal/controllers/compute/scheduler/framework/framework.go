@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package framework implements a small filter and score plugin framework used by the ironcore schedulers
+// to select the pool an instance is placed on.
+package framework
+
+import (
+	"context"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// MaxScore is the maximum normalized score a ScorePlugin contributes per unit of weight.
+const MaxScore int64 = 100
+
+// Plugin is the common interface of all scheduling plugins.
+type Plugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+}
+
+// FilterPlugin decides whether an instance may be placed on a pool.
+type FilterPlugin[P, I any] interface {
+	Plugin
+	// Filter reports whether the instance may be placed on the pool.
+	Filter(ctx context.Context, pool P, instance I) bool
+}
+
+// PreFilterPlugin is a FilterPlugin that needs to compute state from all pools before filtering.
+type PreFilterPlugin[P, I any] interface {
+	FilterPlugin[P, I]
+	// PreFilter is called with all pools once per scheduling cycle before any pool is filtered or scored.
+	PreFilter(ctx context.Context, pools []P, instance I)
+}
+
+// ScorePlugin ranks the pools an instance may be placed on.
+type ScorePlugin[P, I any] interface {
+	Plugin
+	// Score returns the raw score of placing the instance on the pool. Higher scores are preferred.
+	// Raw scores are normalized to [0, MaxScore] across all candidate pools.
+	Score(ctx context.Context, pool P, instance I) int64
+}
+
+// WeightedScorePlugin is a ScorePlugin with the weight its normalized score is multiplied with.
+type WeightedScorePlugin[P, I any] struct {
+	ScorePlugin[P, I]
+	Weight int64
+}
+
+// Framework runs filter and score plugins to select a pool for an instance.
+type Framework[P, I any] struct {
+	filters []FilterPlugin[P, I]
+	scorers []WeightedScorePlugin[P, I]
+}
+
+// New creates a new Framework running the given filter and score plugins.
+func New[P, I any](filters []FilterPlugin[P, I], scorers []WeightedScorePlugin[P, I]) *Framework[P, I] {
+	return &Framework[P, I]{
+		filters: filters,
+		scorers: scorers,
+	}
+}
+
+// Filter returns the pools that pass all filter plugins, retaining their order.
+// Filter starts a new scheduling cycle and has to be called before Score.
+func (f *Framework[P, I]) Filter(ctx context.Context, pools []P, instance I) []P {
+	log := ctrl.LoggerFrom(ctx)
+
+	for _, filter := range f.filters {
+		if preFilter, ok := filter.(PreFilterPlugin[P, I]); ok {
+			preFilter.PreFilter(ctx, pools, instance)
+		}
+	}
+
+	var filtered []P
+outer:
+	for _, pool := range pools {
+		for _, filter := range f.filters {
+			if !filter.Filter(ctx, pool, instance) {
+				log.V(1).Info("Pool filtered", "Plugin", filter.Name())
+				continue outer
+			}
+		}
+		filtered = append(filtered, pool)
+	}
+	return filtered
+}
+
+// Score returns the weighted, normalized scores of the given pools, in the order of the pools.
+func (f *Framework[P, I]) Score(ctx context.Context, pools []P, instance I) []int64 {
+	totals := make([]int64, len(pools))
+	raw := make([]int64, len(pools))
+	for _, scorer := range f.scorers {
+		for i, pool := range pools {
+			raw[i] = scorer.Score(ctx, pool, instance)
+		}
+		for i, score := range normalize(raw) {
+			totals[i] += scorer.Weight * score
+		}
+	}
+	return totals
+}
+
+// Select filters the given pools and returns the one with the highest score.
+// Ties are resolved in favor of the pool that comes first. If no pool passes
+// filtering, false is returned.
+func (f *Framework[P, I]) Select(ctx context.Context, pools []P, instance I) (P, bool) {
+	filtered := f.Filter(ctx, pools, instance)
+	if len(filtered) == 0 {
+		var zero P
+		return zero, false
+	}
+
+	scores := f.Score(ctx, filtered, instance)
+	best := 0
+	for i, score := range scores[1:] {
+		if score > scores[best] {
+			best = i + 1
+		}
+	}
+	return filtered[best], true
+}
+
+// normalize maps the given scores linearly to [0, MaxScore]. If all scores are equal, all of them are MaxScore.
+func normalize(scores []int64) []int64 {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	lowest, highest := scores[0], scores[0]
+	for _, score := range scores[1:] {
+		lowest = min(lowest, score)
+		highest = max(highest, score)
+	}
+
+	res := make([]int64, len(scores))
+	for i, score := range scores {
+		if highest == lowest {
+			res[i] = MaxScore
+			continue
+		}
+		res[i] = int64(float64(score-lowest) / float64(highest-lowest) * float64(MaxScore))
+	}
+	return res
+}
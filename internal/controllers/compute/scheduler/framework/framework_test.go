@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package framework_test
+
+import (
+	"context"
+
+	. "github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler/framework"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type pool struct {
+	name         string
+	labels       map[string]string
+	numInstances int
+	allocatable  int64
+	ready        bool
+}
+
+func (p *pool) Labels() map[string]string { return p.labels }
+func (p *pool) NumInstances() int         { return p.numInstances }
+func (p *pool) Allocatable(string) int64  { return p.allocatable }
+
+func poolReady(_ context.Context, p *pool, _ string) bool {
+	return p.ready
+}
+
+type preFilterPlugin struct {
+	pools []*pool
+}
+
+func (p *preFilterPlugin) Name() string { return "pre-filter" }
+
+func (p *preFilterPlugin) PreFilter(_ context.Context, pools []*pool, _ string) {
+	p.pools = pools
+}
+
+func (p *preFilterPlugin) Filter(_ context.Context, pool *pool, _ string) bool {
+	return len(p.pools) > 1
+}
+
+func newFramework(cfg Config) *Framework[*pool, string] {
+	scorers, err := NewScorePlugins[*pool, string](cfg)
+	Expect(err).NotTo(HaveOccurred())
+	return New([]FilterPlugin[*pool, string]{FilterFunc("pool-ready", poolReady)}, scorers)
+}
+
+var _ = Describe("Framework", func() {
+	var (
+		small, large, busy, notReady *pool
+		pools                        []*pool
+	)
+
+	BeforeEach(func() {
+		small = &pool{name: "small", allocatable: 1, ready: true, labels: map[string]string{"zone": "a"}}
+		large = &pool{name: "large", allocatable: 10, numInstances: 5, ready: true}
+		busy = &pool{name: "busy", allocatable: 5, numInstances: 20, ready: true, labels: map[string]string{"zone": "a", "ssd": "true"}}
+		notReady = &pool{name: "not-ready", allocatable: 100}
+		pools = []*pool{small, large, busy, notReady}
+	})
+
+	It("should filter out pools that do not pass all filter plugins", func(ctx SpecContext) {
+		Expect(newFramework(Config{}).Filter(ctx, pools, "")).To(Equal([]*pool{small, large, busy}))
+	})
+
+	It("should run pre-filter plugins with all pools before filtering", func(ctx SpecContext) {
+		plugin := &preFilterPlugin{}
+		f := New([]FilterPlugin[*pool, string]{plugin}, nil)
+		Expect(f.Filter(ctx, pools, "")).To(Equal(pools))
+		Expect(plugin.pools).To(Equal(pools))
+	})
+
+	It("should return false if no pool passes filtering", func(ctx SpecContext) {
+		_, ok := newFramework(Config{}).Select(ctx, []*pool{notReady}, "")
+		Expect(ok).To(BeFalse())
+	})
+
+	DescribeTable("Select",
+		func(ctx SpecContext, cfg Config, expected string) {
+			selected, ok := newFramework(cfg).Select(ctx, pools, "")
+			Expect(ok).To(BeTrue())
+			Expect(selected.name).To(Equal(expected))
+		},
+		Entry("no score plugins select the first pool", Config{}, "small"),
+		Entry("spread", Config{Scorers: map[string]string{SpreadName: "1"}}, "large"),
+		Entry("bin-pack", Config{Scorers: map[string]string{BinPackName: "1"}}, "small"),
+		Entry("least-instances", Config{Scorers: map[string]string{LeastInstancesName: "1"}}, "small"),
+		Entry("label-preference",
+			Config{
+				Scorers:         map[string]string{LabelPreferenceName: "1"},
+				PreferredLabels: map[string]string{"zone": "a", "ssd": "true"},
+			},
+			"busy",
+		),
+		Entry("weighted score plugins",
+			Config{Scorers: map[string]string{SpreadName: "3", LeastInstancesName: "1"}},
+			"large",
+		),
+	)
+
+	DescribeTable("NewScorePlugins errors",
+		func(cfg Config) {
+			_, err := NewScorePlugins[*pool, string](cfg)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("unknown plugin", Config{Scorers: map[string]string{"foo": "1"}}),
+		Entry("invalid weight", Config{Scorers: map[string]string{SpreadName: "x"}}),
+		Entry("non-positive weight", Config{Scorers: map[string]string{SpreadName: "0"}}),
+	)
+})
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package framework
+
+import (
+	"context"
+)
+
+const (
+	// SpreadName is the name of the Spread score plugin.
+	SpreadName = "spread"
+	// BinPackName is the name of the BinPack score plugin.
+	BinPackName = "bin-pack"
+	// LeastInstancesName is the name of the LeastInstances score plugin.
+	LeastInstancesName = "least-instances"
+	// LabelPreferenceName is the name of the LabelPreference score plugin.
+	LabelPreferenceName = "label-preference"
+)
+
+// PoolInfo is the information about a pool the built-in score plugins operate on.
+type PoolInfo[I any] interface {
+	// Labels returns the labels of the pool.
+	Labels() map[string]string
+	// NumInstances returns the number of instances placed on the pool.
+	NumInstances() int
+	// Allocatable returns the remaining capacity of the pool for the given instance.
+	Allocatable(instance I) int64
+}
+
+type filterFunc[P, I any] struct {
+	name   string
+	filter func(ctx context.Context, pool P, instance I) bool
+}
+
+// FilterFunc creates a FilterPlugin with the given name from a function.
+func FilterFunc[P, I any](name string, filter func(ctx context.Context, pool P, instance I) bool) FilterPlugin[P, I] {
+	return filterFunc[P, I]{name: name, filter: filter}
+}
+
+func (f filterFunc[P, I]) Name() string {
+	return f.name
+}
+
+func (f filterFunc[P, I]) Filter(ctx context.Context, pool P, instance I) bool {
+	return f.filter(ctx, pool, instance)
+}
+
+// Spread prefers pools with the most remaining capacity for an instance.
+type Spread[P PoolInfo[I], I any] struct{}
+
+func (Spread[P, I]) Name() string {
+	return SpreadName
+}
+
+func (Spread[P, I]) Score(_ context.Context, pool P, instance I) int64 {
+	return pool.Allocatable(instance)
+}
+
+// BinPack prefers pools with the least remaining capacity for an instance.
+type BinPack[P PoolInfo[I], I any] struct{}
+
+func (BinPack[P, I]) Name() string {
+	return BinPackName
+}
+
+func (BinPack[P, I]) Score(_ context.Context, pool P, instance I) int64 {
+	return -pool.Allocatable(instance)
+}
+
+// LeastInstances prefers pools with the fewest instances placed on them.
+type LeastInstances[P PoolInfo[I], I any] struct{}
+
+func (LeastInstances[P, I]) Name() string {
+	return LeastInstancesName
+}
+
+func (LeastInstances[P, I]) Score(_ context.Context, pool P, _ I) int64 {
+	return -int64(pool.NumInstances())
+}
+
+// LabelPreference prefers pools matching the most of the preferred labels.
+type LabelPreference[P PoolInfo[I], I any] struct {
+	PreferredLabels map[string]string
+}
+
+func (LabelPreference[P, I]) Name() string {
+	return LabelPreferenceName
+}
+
+func (p LabelPreference[P, I]) Score(_ context.Context, pool P, _ I) int64 {
+	lbls := pool.Labels()
+
+	var matches int64
+	for key, value := range p.PreferredLabels {
+		if actual, ok := lbls[key]; ok && actual == value {
+			matches++
+		}
+	}
+	return matches
+}
@@ -180,6 +180,27 @@ var _ = BeforeSuite(func() {
 		Client:        k8sManager.GetClient(),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	Expect((&MachineDisruptionBudgetReconciler{
+		Client: k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&MachinePoolDrainReconciler{
+		Client: k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&MachineSetReconciler{
+		Client: k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&MachineDeploymentReconciler{
+		Client: k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&MachineHealthCheckReconciler{
+		EventRecorder: &events.FakeRecorder{},
+		Client:        k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	go func() {
 		defer GinkgoRecover()
 		Expect(k8sManager.Start(ctx)).To(Succeed(), "failed to start manager")
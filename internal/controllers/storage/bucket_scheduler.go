@@ -6,12 +6,12 @@ package storage
 import (
 	"context"
 	"fmt"
-	"math/rand"
 
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	storageclient "github.com/ironcore-dev/ironcore/internal/client/storage"
+	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler/framework"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -27,6 +27,31 @@ import (
 type BucketScheduler struct {
 	events.EventRecorder
 	client.Client
+
+	// ScoreConfig configures the score plugins used to rank the bucket pools a bucket may be placed on.
+	// If no score plugins are configured, the bucket pools are ranked by their number of buckets.
+	ScoreConfig framework.Config
+
+	framework *framework.Framework[*bucketPoolInfo, *storagev1alpha1.Bucket]
+}
+
+// bucketPoolInfo is the scheduling information about a BucketPool.
+type bucketPoolInfo struct {
+	pool         *storagev1alpha1.BucketPool
+	numInstances int
+}
+
+func (p *bucketPoolInfo) Labels() map[string]string {
+	return p.pool.Labels
+}
+
+func (p *bucketPoolInfo) NumInstances() int {
+	return p.numInstances
+}
+
+// Allocatable always returns zero as bucket pools don't report their remaining capacity.
+func (p *bucketPoolInfo) Allocatable(*storagev1alpha1.Bucket) int64 {
+	return 0
 }
 
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -65,11 +90,23 @@ func (s *BucketScheduler) schedule(ctx context.Context, log logr.Logger, bucket
 		return ctrl.Result{}, fmt.Errorf("error listing bucket pools: %w", err)
 	}
 
-	var available []storagev1alpha1.BucketPool
+	var available []*bucketPoolInfo
 	for _, bucketPool := range list.Items {
-		if bucketPool.DeletionTimestamp.IsZero() {
-			available = append(available, bucketPool)
+		if !bucketPool.DeletionTimestamp.IsZero() {
+			continue
 		}
+
+		bucketList := &storagev1alpha1.BucketList{}
+		if err := s.List(ctx, bucketList,
+			client.MatchingFields{storageclient.BucketSpecBucketPoolRefNameField: bucketPool.Name},
+		); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error listing buckets on bucket pool %s: %w", bucketPool.Name, err)
+		}
+
+		available = append(available, &bucketPoolInfo{
+			pool:         &bucketPool,
+			numInstances: len(bucketList.Items),
+		})
 	}
 	if len(available) == 0 {
 		log.Info("No bucket pool available for bucket class", "BucketClass", bucket.Spec.BucketClassRef.Name)
@@ -77,24 +114,14 @@ func (s *BucketScheduler) schedule(ctx context.Context, log logr.Logger, bucket
 		return ctrl.Result{}, nil
 	}
 
-	// Filter bucket pools by checking if the bucket tolerates all the taints of a bucket pool
-	var filtered []storagev1alpha1.BucketPool
-	for _, pool := range available {
-		if v1alpha1.TolerateTaints(bucket.Spec.Tolerations, pool.Spec.Taints) {
-			filtered = append(filtered, pool)
-		}
-	}
-	if len(filtered) == 0 {
+	selected, ok := s.framework.Select(ctx, available, bucket)
+	if !ok {
 		log.Info("No bucket pool tolerated by the bucket", "Tolerations", bucket.Spec.Tolerations)
 		s.Eventf(bucket, nil, corev1.EventTypeNormal, "CannotSchedule", "No BucketPoolRef tolerated by the %s", bucket.Name)
 		return ctrl.Result{}, nil
 	}
-	available = filtered
 
-	// Get a random pool to distribute evenly.
-	// TODO: Instead of random distribution, try to come up w/ metrics that include usage of each pool to
-	// avoid unfortunate random distribution of items.
-	pool := available[rand.Intn(len(available))]
+	pool := selected.pool
 	log = log.WithValues("BucketPoolRef", pool.Name)
 	base := bucket.DeepCopy()
 	bucket.Spec.BucketPoolRef = &corev1.LocalObjectReference{Name: pool.Name}
@@ -152,7 +179,27 @@ func (s *BucketScheduler) enqueueRequestsByBucketPool() handler.EventHandler {
 	})
 }
 
+func (s *BucketScheduler) tolerateTaints(_ context.Context, pool *bucketPoolInfo, bucket *storagev1alpha1.Bucket) bool {
+	return v1alpha1.TolerateTaints(bucket.Spec.Tolerations, pool.pool.Spec.Taints)
+}
+
 func (s *BucketScheduler) SetupWithManager(mgr manager.Manager) error {
+	scorers, err := framework.NewScorePlugins[*bucketPoolInfo, *storagev1alpha1.Bucket](s.ScoreConfig)
+	if err != nil {
+		return fmt.Errorf("error creating score plugins: %w", err)
+	}
+	if len(scorers) == 0 {
+		scorers = []framework.WeightedScorePlugin[*bucketPoolInfo, *storagev1alpha1.Bucket]{
+			{ScorePlugin: framework.LeastInstances[*bucketPoolInfo, *storagev1alpha1.Bucket]{}, Weight: 1},
+		}
+	}
+	s.framework = framework.New(
+		[]framework.FilterPlugin[*bucketPoolInfo, *storagev1alpha1.Bucket]{
+			framework.FilterFunc("tolerate-taints", s.tolerateTaints),
+		},
+		scorers,
+	)
+
 	// Only schedule buckets that are not deleting, have no bucket pool and no bucket class set
 
 	return ctrl.NewControllerManagedBy(mgr).
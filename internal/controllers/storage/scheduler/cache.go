@@ -79,6 +79,19 @@ func (n *ContainerInfo) MaxAllocatable(className string) resource.Quantity {
 	return allocatable
 }
 
+// Allocatable returns the remaining storage of the node for the volume class of the given instance in bytes.
+func (n *ContainerInfo) Allocatable(instance *v1alpha1.Volume) int64 {
+	if instance.Spec.VolumeClassRef == nil {
+		return 0
+	}
+	allocatable := n.MaxAllocatable(instance.Spec.VolumeClassRef.Name)
+	return allocatable.Value()
+}
+
+func (n *ContainerInfo) Labels() map[string]string {
+	return n.node.Labels
+}
+
 func (n *ContainerInfo) NumInstances() int {
 	return len(n.instances)
 }
@@ -175,6 +175,21 @@ var _ = BeforeSuite(func() {
 		APIReader: k8sManager.GetAPIReader(),
 	}).SetupWithManager(k8sManager)).To(Succeed())
 
+	Expect((&VolumeSnapshotScheduleReconciler{
+		EventRecorder: &events.FakeRecorder{},
+		Client:        k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&VolumeSnapshotGroupReconciler{
+		EventRecorder: &events.FakeRecorder{},
+		Client:        k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
+	Expect((&VolumeSnapshotGroupRestoreReconciler{
+		EventRecorder: &events.FakeRecorder{},
+		Client:        k8sManager.GetClient(),
+	}).SetupWithManager(k8sManager)).To(Succeed())
+
 	Expect((&BucketScheduler{
 		Client:        k8sManager.GetClient(),
 		EventRecorder: &events.FakeRecorder{},
@@ -6,13 +6,16 @@ package storage
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	storageclient "github.com/ironcore-dev/ironcore/internal/client/storage"
+	"github.com/ironcore-dev/ironcore/internal/controllers/compute/scheduler/framework"
 	"github.com/ironcore-dev/ironcore/internal/controllers/storage/scheduler"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/util/workqueue"
@@ -28,15 +31,29 @@ import (
 )
 
 const (
-	outOfCapacity = "OutOfCapacity"
+	outOfCapacity               = "OutOfCapacity"
+	waitingForSourceVolume      = "WaitingForSourceVolume"
+	sourceVolumePoolNotEligible = "SourceVolumePoolNotEligible"
+
+	// sourceVolumeRequeueInterval is the interval after which a clone whose source volume is not scheduled
+	// yet is scheduled again.
+	sourceVolumeRequeueInterval = 5 * time.Second
+
+	// backupCapabilityLabel is the label of volume pools whose runtime can restore volume backups.
+	backupCapabilityLabel = v1alpha1.RuntimeCapabilityLabelPrefix + "backup"
 )
 
 type VolumeScheduler struct {
 	events.EventRecorder
 	client.Client
 
-	Cache    *scheduler.Cache
-	snapshot *scheduler.Snapshot
+	Cache *scheduler.Cache
+	// ScoreConfig configures the score plugins used to rank the volume pools a volume may be placed on.
+	// If no score plugins are configured, the volume pools are ranked by their remaining capacity.
+	ScoreConfig framework.Config
+
+	snapshot  *scheduler.Snapshot
+	framework *framework.Framework[*scheduler.ContainerInfo, *storagev1alpha1.Volume]
 }
 
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -93,11 +110,48 @@ func (s *VolumeScheduler) tolerateTaints(_ context.Context, pool *scheduler.Cont
 	return v1alpha1.TolerateTaints(volume.Spec.Tolerations, pool.Node().Spec.Taints)
 }
 
+// supportsBackup only admits volume pools that can restore volume backups for volumes restoring a volume backup.
+func (s *VolumeScheduler) supportsBackup(_ context.Context, pool *scheduler.ContainerInfo, volume *storagev1alpha1.Volume) bool {
+	if volume.Spec.DataSource.VolumeBackupRef == nil {
+		return true
+	}
+	return pool.Node().Labels[backupCapabilityLabel] == "true"
+}
+
 func (s *VolumeScheduler) fitsPool(_ context.Context, pool *scheduler.ContainerInfo, volume *storagev1alpha1.Volume) bool {
 	remaining := pool.MaxAllocatable(volume.Spec.VolumeClassRef.Name)
 	return remaining.Cmp(*volume.Spec.Resources.Storage()) >= 0
 }
 
+// sourceVolumePoolName returns the name of the volume pool of the volume the given volume clones. It returns
+// an empty name if the source volume does not exist or is not scheduled yet.
+func (s *VolumeScheduler) sourceVolumePoolName(ctx context.Context, volume *storagev1alpha1.Volume) (string, error) {
+	volumeRef := volume.Spec.DataSource.VolumeRef
+	sourceVolume := &storagev1alpha1.Volume{}
+	if err := s.Get(ctx, client.ObjectKey{Namespace: volume.Namespace, Name: volumeRef.Name}, sourceVolume); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("error getting source volume %s: %w", volumeRef.Name, err)
+		}
+		return "", nil
+	}
+
+	if sourceVolume.Spec.VolumePoolRef == nil {
+		return "", nil
+	}
+	return sourceVolume.Spec.VolumePoolRef.Name, nil
+}
+
+// selectSourceNode selects the node of the source volume pool of a clone if it passes filtering.
+// Clones are only placed on the volume pool of their source volume, as volumes can only be cloned within a pool.
+func (s *VolumeScheduler) selectSourceNode(ctx context.Context, nodes []*scheduler.ContainerInfo, volume *storagev1alpha1.Volume, sourcePoolName string) (*scheduler.ContainerInfo, bool) {
+	for _, node := range s.framework.Filter(ctx, nodes, volume) {
+		if node.Node().Name == sourcePoolName {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
 func (s *VolumeScheduler) updateSnapshot() {
 	if s.snapshot == nil {
 		s.snapshot = s.Cache.Snapshot()
@@ -146,40 +200,40 @@ func (s *VolumeScheduler) reconcileExists(ctx context.Context, log logr.Logger,
 		return ctrl.Result{}, nil
 	}
 
-	var filteredNodes []*scheduler.ContainerInfo
-	for _, node := range nodes {
-		if !s.tolerateTaints(ctx, node, volume) {
-			log.Info("node filtered", "reason", "taints do not match")
-			continue
+	var (
+		selectedNode *scheduler.ContainerInfo
+		ok           bool
+	)
+	if sourceVolumeRef := volume.Spec.DataSource.VolumeRef; sourceVolumeRef != nil {
+		sourcePoolName, err := s.sourceVolumePoolName(ctx, volume)
+		if err != nil {
+			return ctrl.Result{}, err
 		}
-		if !s.matchesLabels(ctx, node, volume) {
-			log.Info("node filtered", "reason", "label do not match")
-			continue
+		if sourcePoolName == "" {
+			log.V(1).Info("Source volume is not scheduled yet", "SourceVolume", sourceVolumeRef.Name)
+			s.Eventf(volume, nil, corev1.EventTypeNormal, waitingForSourceVolume, "Scheduling",
+				"Source volume %s does not exist or is not scheduled yet", sourceVolumeRef.Name)
+			return ctrl.Result{RequeueAfter: sourceVolumeRequeueInterval}, nil
 		}
-		if !s.fitsPool(ctx, node, volume) {
-			log.Info("node filtered", "reason", "resources do not match")
-			continue
-		}
-
-		filteredNodes = append(filteredNodes, node)
-	}
-
-	if len(filteredNodes) == 0 {
-		s.Eventf(volume, nil, corev1.EventTypeNormal, outOfCapacity, "No nodes available after filtering to schedule %s on", volume.Name)
-		return ctrl.Result{}, nil
-	}
 
-	maxAllocatableNode := filteredNodes[0]
-	for _, node := range filteredNodes[1:] {
-		current := node.MaxAllocatable(volume.Spec.VolumeClassRef.Name)
-		if current.Cmp(maxAllocatableNode.MaxAllocatable(volume.Spec.VolumeClassRef.Name)) == 1 {
-			maxAllocatableNode = node
+		selectedNode, ok = s.selectSourceNode(ctx, nodes, volume, sourcePoolName)
+		if !ok {
+			log.V(1).Info("Volume pool of source volume not eligible", "SourceVolumePool", sourcePoolName)
+			s.Eventf(volume, nil, corev1.EventTypeWarning, sourceVolumePoolNotEligible, "Scheduling",
+				"Volume pool %s of source volume %s is not eligible to schedule %s on", sourcePoolName, sourceVolumeRef.Name, volume.Name)
+			return ctrl.Result{}, nil
+		}
+	} else {
+		selectedNode, ok = s.framework.Select(ctx, nodes, volume)
+		if !ok {
+			s.Eventf(volume, nil, corev1.EventTypeNormal, outOfCapacity, "No nodes available after filtering to schedule %s on", volume.Name)
+			return ctrl.Result{}, nil
 		}
 	}
-	log.V(1).Info("Determined node to schedule on", "NodeName", maxAllocatableNode.Node().Name, "Instances", maxAllocatableNode.NumInstances(), "Allocatable", maxAllocatableNode.MaxAllocatable(volume.Spec.VolumeClassRef.Name))
+	log.V(1).Info("Determined node to schedule on", "NodeName", selectedNode.Node().Name, "Instances", selectedNode.NumInstances(), "Allocatable", selectedNode.MaxAllocatable(volume.Spec.VolumeClassRef.Name))
 
 	log.V(1).Info("Assuming volume to be on node")
-	if err := s.assume(volume, maxAllocatableNode.Node().Name); err != nil {
+	if err := s.assume(volume, selectedNode.Node().Name); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -290,6 +344,25 @@ func (s *VolumeScheduler) handleVolumePool() handler.EventHandler {
 }
 
 func (s *VolumeScheduler) SetupWithManager(mgr manager.Manager) error {
+	scorers, err := framework.NewScorePlugins[*scheduler.ContainerInfo, *storagev1alpha1.Volume](s.ScoreConfig)
+	if err != nil {
+		return fmt.Errorf("error creating score plugins: %w", err)
+	}
+	if len(scorers) == 0 {
+		scorers = []framework.WeightedScorePlugin[*scheduler.ContainerInfo, *storagev1alpha1.Volume]{
+			{ScorePlugin: framework.Spread[*scheduler.ContainerInfo, *storagev1alpha1.Volume]{}, Weight: 1},
+		}
+	}
+	s.framework = framework.New(
+		[]framework.FilterPlugin[*scheduler.ContainerInfo, *storagev1alpha1.Volume]{
+			framework.FilterFunc("tolerate-taints", s.tolerateTaints),
+			framework.FilterFunc("matches-labels", s.matchesLabels),
+			framework.FilterFunc("supports-backup", s.supportsBackup),
+			framework.FilterFunc("fits-pool", s.fitsPool),
+		},
+		scorers,
+	)
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("volume-scheduler").
 		WithOptions(controller.Options{
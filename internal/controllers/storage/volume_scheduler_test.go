@@ -121,6 +121,78 @@ var _ = Describe("VolumeScheduler", func() {
 		}).Should(Equal(&corev1.LocalObjectReference{Name: volumePool.Name}))
 	})
 
+	It("should only schedule clones onto the volume pool of their source volume", func(ctx SpecContext) {
+		By("creating a source volume pool w/o the volume class")
+		sourceVolumePool := &storagev1alpha1.VolumePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-source-pool-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceVolumePool)).To(Succeed(), "failed to create source volume pool")
+
+		By("creating another volume pool w/ the volume class")
+		volumePool := &storagev1alpha1.VolumePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumePool)).To(Succeed(), "failed to create volume pool")
+		Eventually(UpdateStatus(volumePool, func() {
+			volumePool.Status.AvailableVolumeClasses = []corev1.LocalObjectReference{{Name: volumeClass.Name}}
+			volumePool.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeVolumeClass, volumeClass.Name): resource.MustParse("100Gi"),
+			}
+		})).Should(Succeed())
+
+		By("creating a source volume on the source volume pool")
+		sourceVolume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-source-volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
+				VolumePoolRef:  &corev1.LocalObjectReference{Name: sourceVolumePool.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceVolume)).To(Succeed(), "failed to create source volume")
+
+		By("creating a clone of the source volume")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+				DataSource: storagev1alpha1.VolumeDataSource{
+					VolumeRef: &corev1.LocalObjectReference{Name: sourceVolume.Name},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed(), "failed to create volume")
+
+		By("asserting the clone is not scheduled onto the other volume pool")
+		Consistently(Object(volume)).Should(HaveField("Spec.VolumePoolRef", BeNil()))
+
+		By("making the source volume pool eligible")
+		Eventually(UpdateStatus(sourceVolumePool, func() {
+			sourceVolumePool.Status.AvailableVolumeClasses = []corev1.LocalObjectReference{{Name: volumeClass.Name}}
+			sourceVolumePool.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeVolumeClass, volumeClass.Name): resource.MustParse("10Gi"),
+			}
+		})).Should(Succeed())
+
+		By("waiting for the clone to be scheduled onto the source volume pool")
+		Eventually(Object(volume)).Should(HaveField("Spec.VolumePoolRef", Equal(&corev1.LocalObjectReference{Name: sourceVolumePool.Name})))
+	})
+
 	It("should schedule onto volume pools with matching labels", func(ctx SpecContext) {
 		By("creating a volume pool w/o matching labels")
 		volumePoolNoMatchingLabels := &storagev1alpha1.VolumePool{
@@ -183,6 +255,67 @@ var _ = Describe("VolumeScheduler", func() {
 		}).Should(Succeed())
 	})
 
+	It("should only schedule volumes restoring a volume backup onto volume pools supporting backups", func(ctx SpecContext) {
+		By("creating a volume pool w/o backup support")
+		volumePoolNoBackup := &storagev1alpha1.VolumePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumePoolNoBackup)).To(Succeed(), "failed to create volume pool")
+
+		By("patching the volume pool status to contain a volume class")
+		Eventually(UpdateStatus(volumePoolNoBackup, func() {
+			volumePoolNoBackup.Status.AvailableVolumeClasses = []corev1.LocalObjectReference{{Name: volumeClass.Name}}
+			volumePoolNoBackup.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeVolumeClass, volumeClass.Name): resource.MustParse("100Gi"),
+			}
+		})).Should(Succeed())
+
+		By("creating a volume restoring a volume backup")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+				DataSource: storagev1alpha1.VolumeDataSource{
+					VolumeBackupRef: &corev1.LocalObjectReference{Name: "volume-backup"},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed(), "failed to create volume")
+
+		By("asserting the volume is not scheduled")
+		Consistently(Object(volume)).Should(HaveField("Spec.VolumePoolRef", BeNil()))
+
+		By("creating a volume pool w/ backup support")
+		volumePoolBackup := &storagev1alpha1.VolumePool{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-pool-",
+				Labels: map[string]string{
+					commonv1alpha1.RuntimeCapabilityLabelPrefix + "backup": "true",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumePoolBackup)).To(Succeed(), "failed to create volume pool")
+
+		By("patching the volume pool status to contain a volume class")
+		Eventually(UpdateStatus(volumePoolBackup, func() {
+			volumePoolBackup.Status.AvailableVolumeClasses = []corev1.LocalObjectReference{{Name: volumeClass.Name}}
+			volumePoolBackup.Status.Allocatable = corev1alpha1.ResourceList{
+				corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeVolumeClass, volumeClass.Name): resource.MustParse("100Gi"),
+			}
+		})).Should(Succeed())
+
+		By("waiting for the volume to be scheduled onto the volume pool supporting backups")
+		Eventually(Object(volume)).Should(HaveField("Spec.VolumePoolRef", Equal(&corev1.LocalObjectReference{Name: volumePoolBackup.Name})))
+	})
+
 	It("should schedule a volume with corresponding tolerations onto a volume pool with taints", func(ctx SpecContext) {
 		By("creating a volume pool w/ taints")
 		taintedVolumePool := &storagev1alpha1.VolumePool{
@@ -465,6 +598,65 @@ var _ = Describe("VolumeScheduler", func() {
 		))
 	})
 
+	It("should schedule a clone onto the volume pool of its source volume", func(ctx SpecContext) {
+		By("creating two volume pools, the first one with more allocatable resources")
+		var volumePools []*storagev1alpha1.VolumePool
+		for _, allocatable := range []string{"100Gi", "10Gi"} {
+			volumePool := &storagev1alpha1.VolumePool{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "test-pool-",
+				},
+			}
+			Expect(k8sClient.Create(ctx, volumePool)).To(Succeed(), "failed to create volume pool")
+
+			Eventually(UpdateStatus(volumePool, func() {
+				volumePool.Status.AvailableVolumeClasses = []corev1.LocalObjectReference{{Name: volumeClass.Name}}
+				volumePool.Status.Allocatable = corev1alpha1.ResourceList{
+					corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeVolumeClass, volumeClass.Name): resource.MustParse(allocatable),
+				}
+			})).Should(Succeed())
+			volumePools = append(volumePools, volumePool)
+		}
+		sourceVolumePool := volumePools[1]
+
+		By("creating a source volume on the second volume pool")
+		sourceVolume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
+				VolumePoolRef:  &corev1.LocalObjectReference{Name: sourceVolumePool.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceVolume)).To(Succeed(), "failed to create source volume")
+
+		By("creating a clone of the source volume")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: volumeClass.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+				DataSource: storagev1alpha1.VolumeDataSource{
+					VolumeRef: &corev1.LocalObjectReference{Name: sourceVolume.Name},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed(), "failed to create volume")
+
+		By("waiting for the clone to be scheduled onto the volume pool of the source volume")
+		Eventually(Object(volume)).Should(HaveField("Spec.VolumePoolRef", Equal(&corev1.LocalObjectReference{Name: sourceVolumePool.Name})))
+	})
+
 	It("should correctly track cache state", func(ctx SpecContext) {
 		By("creating a volume pool")
 		volumePool := &storagev1alpha1.VolumePool{
@@ -0,0 +1,473 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	metautils "github.com/ironcore-dev/ironcore/utils/meta"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	volumeSnapshotGroupEventSnapshotsCreated      = "SnapshotsCreated"
+	volumeSnapshotGroupEventFailedCreateSnapshots = "FailedCreateSnapshots"
+	volumeSnapshotGroupEventIOFreezeRequested     = "IOFreezeRequested"
+	volumeSnapshotGroupEventIOFreezeReleased      = "IOFreezeReleased"
+	volumeSnapshotGroupEventFailed                = "Failed"
+)
+
+// VolumeSnapshotGroupReconciler snapshots the volumes of VolumeSnapshotGroups, optionally freezing the I/O
+// of the snapshotted machine while doing so.
+type VolumeSnapshotGroupReconciler struct {
+	events.EventRecorder
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshotgroups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshotgroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshots,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;update;patch
+
+func (r *VolumeSnapshotGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{}
+	if err := r.Get(ctx, req.NamespacedName, volumeSnapshotGroup); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !volumeSnapshotGroup.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, volumeSnapshotGroup)
+}
+
+func (r *VolumeSnapshotGroupReconciler) reconcileExists(ctx context.Context, log logr.Logger, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup) (ctrl.Result, error) {
+	switch volumeSnapshotGroup.Status.State {
+	case storagev1alpha1.VolumeSnapshotGroupStateReady:
+		log.V(1).Info("Volume snapshot group is ready")
+		return ctrl.Result{}, nil
+	case storagev1alpha1.VolumeSnapshotGroupStateFailed:
+		log.V(1).Info("Volume snapshot group failed, ensuring the I/O freeze is released")
+		return ctrl.Result{}, r.releaseIOFreeze(ctx, log, volumeSnapshotGroup)
+	}
+
+	base := volumeSnapshotGroup.DeepCopy()
+	res, err := r.reconcilePending(ctx, log, volumeSnapshotGroup)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if volumeSnapshotGroup.Status.State == storagev1alpha1.VolumeSnapshotGroupStateFailed {
+		r.Eventf(volumeSnapshotGroup, nil, corev1.EventTypeWarning, volumeSnapshotGroupEventFailed, "Snapshot", "%s", volumeSnapshotGroup.Status.Message)
+		if err := r.releaseIOFreeze(ctx, log, volumeSnapshotGroup); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	log.V(1).Info("Updating volume snapshot group status")
+	if err := r.Status().Patch(ctx, volumeSnapshotGroup, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating volume snapshot group status: %w", err)
+	}
+	return res, nil
+}
+
+// reconcilePending takes the snapshots of a pending volume snapshot group and updates its status in-memory.
+func (r *VolumeSnapshotGroupReconciler) reconcilePending(ctx context.Context, log logr.Logger, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup) (ctrl.Result, error) {
+	if len(volumeSnapshotGroup.Status.Snapshots) == 0 {
+		log.V(1).Info("Resolving volumes to snapshot")
+		snapshots, msg, err := r.resolveSnapshots(ctx, volumeSnapshotGroup)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if msg != "" {
+			setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStateFailed, msg)
+			return ctrl.Result{}, nil
+		}
+		volumeSnapshotGroup.Status.Snapshots = snapshots
+	}
+
+	log.V(1).Info("Listing volume snapshots of group")
+	volumeSnapshotList := &storagev1alpha1.VolumeSnapshotList{}
+	if err := r.List(ctx, volumeSnapshotList,
+		client.InNamespace(volumeSnapshotGroup.Namespace),
+		client.MatchingLabels{storagev1alpha1.VolumeSnapshotGroupNameLabel: volumeSnapshotGroup.Name},
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing volume snapshots: %w", err)
+	}
+	volumeSnapshotByName := make(map[string]*storagev1alpha1.VolumeSnapshot)
+	for i := range volumeSnapshotList.Items {
+		volumeSnapshot := &volumeSnapshotList.Items[i]
+		volumeSnapshotByName[volumeSnapshot.Name] = volumeSnapshot
+	}
+
+	var requeueAfter time.Duration
+	if len(volumeSnapshotByName) < len(volumeSnapshotGroup.Status.Snapshots) {
+		if volumeSnapshotGroup.Spec.FreezeIO {
+			log.V(1).Info("Freezing machine I/O")
+			frozen, res, err := r.freezeIO(ctx, log, volumeSnapshotGroup)
+			if err != nil || !frozen {
+				return res, err
+			}
+			requeueAfter = res.RequeueAfter
+		}
+
+		log.V(1).Info("Creating volume snapshots")
+		msg, err := r.createSnapshots(ctx, log, volumeSnapshotGroup, volumeSnapshotByName)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if msg != "" {
+			setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStateFailed, msg)
+			return ctrl.Result{}, nil
+		}
+	}
+
+	var (
+		taken, ready int
+		failed       []string
+	)
+	for _, snapshot := range volumeSnapshotGroup.Status.Snapshots {
+		volumeSnapshot, ok := volumeSnapshotByName[snapshot.VolumeSnapshotRef.Name]
+		if !ok {
+			continue
+		}
+		switch {
+		case volumeSnapshot.Status.State == storagev1alpha1.VolumeSnapshotStateFailed:
+			failed = append(failed, volumeSnapshot.Name)
+		case volumeSnapshot.Status.State == storagev1alpha1.VolumeSnapshotStateReady:
+			ready++
+			taken++
+		case volumeSnapshot.Status.SnapshotID != "":
+			taken++
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStateFailed,
+			fmt.Sprintf("Volume snapshots %v failed", failed))
+		return ctrl.Result{}, nil
+	case taken < len(volumeSnapshotGroup.Status.Snapshots):
+		if ioFreezeRequestedAt := volumeSnapshotGroup.Status.IOFreezeRequestedAt; ioFreezeRequestedAt != nil &&
+			!time.Now().Before(ioFreezeRequestedAt.Add(volumeSnapshotGroupFreezeTimeout(volumeSnapshotGroup))) {
+			setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStateFailed,
+				"Machine I/O freeze timed out before all volumes were snapshotted")
+			return ctrl.Result{}, nil
+		}
+		setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStatePending,
+			fmt.Sprintf("Taken %d of %d volume snapshots", taken, len(volumeSnapshotGroup.Status.Snapshots)))
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	// All volumes have been snapshotted, the machine I/O does not have to be frozen anymore.
+	if err := r.releaseIOFreeze(ctx, log, volumeSnapshotGroup); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if ready < len(volumeSnapshotGroup.Status.Snapshots) {
+		setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStatePending,
+			fmt.Sprintf("%d of %d volume snapshots are ready", ready, len(volumeSnapshotGroup.Status.Snapshots)))
+		return ctrl.Result{}, nil
+	}
+	setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStateReady, "All volume snapshots are ready")
+	return ctrl.Result{}, nil
+}
+
+func setVolumeSnapshotGroupState(volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup, state storagev1alpha1.VolumeSnapshotGroupState, message string) {
+	if volumeSnapshotGroup.Status.State != state {
+		now := metav1.Now()
+		volumeSnapshotGroup.Status.LastStateTransitionTime = &now
+	}
+	volumeSnapshotGroup.Status.State = state
+	volumeSnapshotGroup.Status.Message = message
+}
+
+func volumeSnapshotGroupFreezeTimeout(volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup) time.Duration {
+	if freezeTimeout := volumeSnapshotGroup.Spec.FreezeTimeout; freezeTimeout != nil {
+		return freezeTimeout.Duration
+	}
+	return 30 * time.Second
+}
+
+// VolumeSnapshotGroupSnapshotName returns the name of the VolumeSnapshot of a volume of a VolumeSnapshotGroup.
+func VolumeSnapshotGroupSnapshotName(volumeSnapshotGroupName, volumeName string) string {
+	return metautils.BoundedName(volumeSnapshotGroupName, volumeName)
+}
+
+// resolveSnapshots determines the volumes to snapshot. If the volumes cannot be snapshotted, a message
+// explaining why is returned.
+func (r *VolumeSnapshotGroupReconciler) resolveSnapshots(ctx context.Context, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup) ([]storagev1alpha1.VolumeSnapshotGroupSnapshot, string, error) {
+	var volumes []storagev1alpha1.Volume
+	if machineRef := volumeSnapshotGroup.Spec.MachineRef; machineRef != nil {
+		machine := &computev1alpha1.Machine{}
+		if err := r.Get(ctx, client.ObjectKey{Namespace: volumeSnapshotGroup.Namespace, Name: machineRef.Name}, machine); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, "", fmt.Errorf("error getting machine %s: %w", machineRef.Name, err)
+			}
+			return nil, fmt.Sprintf("Machine %s not found", machineRef.Name), nil
+		}
+
+		for _, volumeName := range computev1alpha1.MachineVolumeNames(machine) {
+			volume := &storagev1alpha1.Volume{}
+			if err := r.Get(ctx, client.ObjectKey{Namespace: volumeSnapshotGroup.Namespace, Name: volumeName}, volume); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, "", fmt.Errorf("error getting volume %s: %w", volumeName, err)
+				}
+				return nil, fmt.Sprintf("Volume %s of machine %s not found", volumeName, machine.Name), nil
+			}
+			volumes = append(volumes, *volume)
+		}
+	} else {
+		selector, err := metav1.LabelSelectorAsSelector(volumeSnapshotGroup.Spec.VolumeSelector)
+		if err != nil {
+			return nil, fmt.Sprintf("Invalid volume selector: %v", err), nil
+		}
+
+		volumeList := &storagev1alpha1.VolumeList{}
+		if err := r.List(ctx, volumeList,
+			client.InNamespace(volumeSnapshotGroup.Namespace),
+			client.MatchingLabelsSelector{Selector: selector},
+		); err != nil {
+			return nil, "", fmt.Errorf("error listing volumes: %w", err)
+		}
+		for _, volume := range volumeList.Items {
+			if volume.DeletionTimestamp.IsZero() {
+				volumes = append(volumes, volume)
+			}
+		}
+	}
+
+	if len(volumes) == 0 {
+		return nil, "No volumes to snapshot", nil
+	}
+
+	snapshots := make([]storagev1alpha1.VolumeSnapshotGroupSnapshot, 0, len(volumes))
+	for _, volume := range volumes {
+		snapshots = append(snapshots, storagev1alpha1.VolumeSnapshotGroupSnapshot{
+			VolumeRef:         corev1.LocalObjectReference{Name: volume.Name},
+			VolumeSnapshotRef: corev1.LocalObjectReference{Name: VolumeSnapshotGroupSnapshotName(volumeSnapshotGroup.Name, volume.Name)},
+			VolumeClassRef:    volume.Spec.VolumeClassRef,
+			Resources:         volume.Spec.Resources,
+		})
+	}
+	return snapshots, "", nil
+}
+
+// freezeIO requests the I/O freeze of the machine of the volume snapshot group. It reports whether the machine
+// I/O is frozen.
+func (r *VolumeSnapshotGroupReconciler) freezeIO(ctx context.Context, log logr.Logger, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup) (bool, ctrl.Result, error) {
+	machine := &computev1alpha1.Machine{}
+	machineKey := client.ObjectKey{Namespace: volumeSnapshotGroup.Namespace, Name: volumeSnapshotGroup.Spec.MachineRef.Name}
+	if err := r.Get(ctx, machineKey, machine); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, ctrl.Result{}, fmt.Errorf("error getting machine %s: %w", machineKey.Name, err)
+		}
+		setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStateFailed,
+			fmt.Sprintf("Machine %s not found", machineKey.Name))
+		return false, ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	frozenCond := computev1alpha1.FindMachineCondition(machine.Status.Conditions, computev1alpha1.MachineIOFrozen)
+	ioFreezeRequestedAt := volumeSnapshotGroup.Status.IOFreezeRequestedAt
+	if ioFreezeRequestedAt == nil {
+		// Wait for any other I/O freeze of the machine to be released to be able to tell when our freeze applies.
+		if ioFreeze := machine.Spec.IOFreeze; ioFreeze != nil && now.Before(computev1alpha1.MachineIOFreezeDeadline(ioFreeze)) {
+			log.V(1).Info("Machine I/O freeze is requested by someone else, waiting for it to expire")
+			setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStatePending,
+				fmt.Sprintf("Waiting for the current I/O freeze of machine %s to be released", machine.Name))
+			return false, ctrl.Result{RequeueAfter: computev1alpha1.MachineIOFreezeDeadline(ioFreeze).Sub(now)}, nil
+		}
+		if frozenCond != nil && frozenCond.Status == corev1.ConditionTrue {
+			log.V(1).Info("Machine I/O is still frozen, waiting for it to be thawed")
+			setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStatePending,
+				fmt.Sprintf("Waiting for the I/O of machine %s to be thawed", machine.Name))
+			return false, ctrl.Result{}, nil
+		}
+
+		// Record the freeze before requesting it so that it is released even if the group fails meanwhile.
+		requestedAt := metav1.NewTime(now.Truncate(time.Second))
+		base := volumeSnapshotGroup.DeepCopy()
+		volumeSnapshotGroup.Status.IOFreezeRequestedAt = &requestedAt
+		if err := r.Status().Patch(ctx, volumeSnapshotGroup, client.MergeFrom(base)); err != nil {
+			return false, ctrl.Result{}, fmt.Errorf("error recording machine I/O freeze: %w", err)
+		}
+		ioFreezeRequestedAt = &requestedAt
+	}
+
+	deadline := ioFreezeRequestedAt.Add(volumeSnapshotGroupFreezeTimeout(volumeSnapshotGroup))
+	if !now.Before(deadline) {
+		setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStateFailed,
+			fmt.Sprintf("Machine %s I/O freeze timed out", machine.Name))
+		return false, ctrl.Result{}, nil
+	}
+
+	if ioFreeze := machine.Spec.IOFreeze; ioFreeze == nil || !ioFreeze.RequestedAt.Equal(ioFreezeRequestedAt) {
+		log.V(1).Info("Requesting machine I/O freeze", "RequestedAt", ioFreezeRequestedAt)
+		base := machine.DeepCopy()
+		machine.Spec.IOFreeze = &computev1alpha1.MachineIOFreeze{
+			RequestedAt: *ioFreezeRequestedAt,
+			Timeout:     metav1.Duration{Duration: volumeSnapshotGroupFreezeTimeout(volumeSnapshotGroup)},
+		}
+		if err := r.Patch(ctx, machine, client.MergeFrom(base)); err != nil {
+			return false, ctrl.Result{}, fmt.Errorf("error requesting machine I/O freeze: %w", err)
+		}
+		r.Eventf(volumeSnapshotGroup, machine, corev1.EventTypeNormal, volumeSnapshotGroupEventIOFreezeRequested, "Freeze",
+			"Requested I/O freeze of machine %s", machine.Name)
+	}
+
+	if frozenCond == nil || frozenCond.Status != corev1.ConditionTrue || frozenCond.LastTransitionTime.Before(ioFreezeRequestedAt) {
+		log.V(1).Info("Machine I/O is not frozen yet")
+		setVolumeSnapshotGroupState(volumeSnapshotGroup, storagev1alpha1.VolumeSnapshotGroupStatePending,
+			fmt.Sprintf("Waiting for the I/O of machine %s to be frozen", machine.Name))
+		return false, ctrl.Result{RequeueAfter: deadline.Sub(now)}, nil
+	}
+	return true, ctrl.Result{RequeueAfter: deadline.Sub(now)}, nil
+}
+
+// releaseIOFreeze removes the I/O freeze requested by the volume snapshot group from its machine, if any.
+func (r *VolumeSnapshotGroupReconciler) releaseIOFreeze(ctx context.Context, log logr.Logger, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup) error {
+	ioFreezeRequestedAt := volumeSnapshotGroup.Status.IOFreezeRequestedAt
+	if ioFreezeRequestedAt == nil {
+		return nil
+	}
+
+	machine := &computev1alpha1.Machine{}
+	machineKey := client.ObjectKey{Namespace: volumeSnapshotGroup.Namespace, Name: volumeSnapshotGroup.Spec.MachineRef.Name}
+	if err := r.Get(ctx, machineKey, machine); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if ioFreeze := machine.Spec.IOFreeze; ioFreeze == nil || !ioFreeze.RequestedAt.Equal(ioFreezeRequestedAt) {
+		return nil
+	}
+
+	log.V(1).Info("Releasing machine I/O freeze", "RequestedAt", ioFreezeRequestedAt)
+	base := machine.DeepCopy()
+	machine.Spec.IOFreeze = nil
+	if err := r.Patch(ctx, machine, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("error releasing machine I/O freeze: %w", err)
+	}
+	r.Eventf(volumeSnapshotGroup, machine, corev1.EventTypeNormal, volumeSnapshotGroupEventIOFreezeReleased, "Thaw",
+		"Released I/O freeze of machine %s", machine.Name)
+	return nil
+}
+
+// createSnapshots creates the volume snapshots of the volume snapshot group that do not exist yet.
+// If volume snapshots of the same name exist that are not controlled by the volume snapshot group,
+// a message explaining why the group cannot be snapshotted is returned.
+func (r *VolumeSnapshotGroupReconciler) createSnapshots(
+	ctx context.Context,
+	log logr.Logger,
+	volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup,
+	volumeSnapshotByName map[string]*storagev1alpha1.VolumeSnapshot,
+) (string, error) {
+	var (
+		created   int
+		conflicts []string
+		errs      []error
+	)
+	for _, snapshot := range volumeSnapshotGroup.Status.Snapshots {
+		if _, ok := volumeSnapshotByName[snapshot.VolumeSnapshotRef.Name]; ok {
+			continue
+		}
+
+		volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: volumeSnapshotGroup.Namespace,
+				Name:      snapshot.VolumeSnapshotRef.Name,
+				Labels: map[string]string{
+					storagev1alpha1.VolumeSnapshotGroupNameLabel: volumeSnapshotGroup.Name,
+				},
+			},
+			Spec: storagev1alpha1.VolumeSnapshotSpec{
+				VolumeRef: &corev1.LocalObjectReference{Name: snapshot.VolumeRef.Name},
+			},
+		}
+		if err := controllerutil.SetControllerReference(volumeSnapshotGroup, volumeSnapshot, r.Scheme()); err != nil {
+			return "", fmt.Errorf("error setting controller reference: %w", err)
+		}
+
+		log.V(1).Info("Creating volume snapshot", "Volume", snapshot.VolumeRef.Name, "VolumeSnapshot", volumeSnapshot.Name)
+		if err := r.Create(ctx, volumeSnapshot); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				errs = append(errs, fmt.Errorf("error creating volume snapshot of volume %s: %w", snapshot.VolumeRef.Name, err))
+				continue
+			}
+
+			existing := &storagev1alpha1.VolumeSnapshot{}
+			if err := r.Get(ctx, client.ObjectKeyFromObject(volumeSnapshot), existing); err != nil {
+				errs = append(errs, fmt.Errorf("error getting existing volume snapshot %s: %w", volumeSnapshot.Name, err))
+				continue
+			}
+			if !metav1.IsControlledBy(existing, volumeSnapshotGroup) {
+				conflicts = append(conflicts, existing.Name)
+			}
+			continue
+		}
+		volumeSnapshotByName[volumeSnapshot.Name] = volumeSnapshot
+		created++
+	}
+
+	if created > 0 {
+		r.Eventf(volumeSnapshotGroup, nil, corev1.EventTypeNormal, volumeSnapshotGroupEventSnapshotsCreated, "Snapshot",
+			"Created %d volume snapshots", created)
+	}
+	if len(conflicts) > 0 {
+		return fmt.Sprintf("Volume snapshots %v already exist and are not controlled by the volume snapshot group", conflicts), nil
+	}
+	if err := errors.Join(errs...); err != nil {
+		r.Eventf(volumeSnapshotGroup, nil, corev1.EventTypeWarning, volumeSnapshotGroupEventFailedCreateSnapshots, "Snapshot",
+			"Error creating volume snapshots: %v", err)
+		return "", err
+	}
+	return "", nil
+}
+
+func (r *VolumeSnapshotGroupReconciler) enqueueByMachine() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		log := ctrl.LoggerFrom(ctx)
+
+		volumeSnapshotGroupList := &storagev1alpha1.VolumeSnapshotGroupList{}
+		if err := r.List(ctx, volumeSnapshotGroupList, client.InNamespace(obj.GetNamespace())); err != nil {
+			log.Error(err, "Error listing volume snapshot groups")
+			return nil
+		}
+
+		var res []reconcile.Request
+		for _, volumeSnapshotGroup := range volumeSnapshotGroupList.Items {
+			if machineRef := volumeSnapshotGroup.Spec.MachineRef; machineRef != nil && machineRef.Name == obj.GetName() {
+				res = append(res, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&volumeSnapshotGroup)})
+			}
+		}
+		return res
+	})
+}
+
+func (r *VolumeSnapshotGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&storagev1alpha1.VolumeSnapshotGroup{}).
+		Owns(&storagev1alpha1.VolumeSnapshot{}).
+		Watches(
+			&computev1alpha1.Machine{},
+			r.enqueueByMachine(),
+		).
+		Complete(r)
+}
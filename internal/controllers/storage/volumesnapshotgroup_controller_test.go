@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"fmt"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("VolumeSnapshotGroupReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+
+	createVolume := func(ctx SpecContext, app string) *storagev1alpha1.Volume {
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+				Labels:       map[string]string{"app": app},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed(), "failed to create volume")
+		return volume
+	}
+
+	groupVolumeSnapshots := func(ctx SpecContext, volumeSnapshotGroup *storagev1alpha1.VolumeSnapshotGroup) func() ([]storagev1alpha1.VolumeSnapshot, error) {
+		return func() ([]storagev1alpha1.VolumeSnapshot, error) {
+			volumeSnapshotList := &storagev1alpha1.VolumeSnapshotList{}
+			if err := k8sClient.List(ctx, volumeSnapshotList,
+				client.InNamespace(ns.Name),
+				client.MatchingLabels{storagev1alpha1.VolumeSnapshotGroupNameLabel: volumeSnapshotGroup.Name},
+			); err != nil {
+				return nil, err
+			}
+			return volumeSnapshotList.Items, nil
+		}
+	}
+
+	takeVolumeSnapshots := func(ctx SpecContext, volumeSnapshots []storagev1alpha1.VolumeSnapshot) {
+		for i := range volumeSnapshots {
+			volumeSnapshot := &volumeSnapshots[i]
+			Eventually(UpdateStatus(volumeSnapshot, func() {
+				volumeSnapshot.Status.SnapshotID = fmt.Sprintf("test://%s", volumeSnapshot.Name)
+				volumeSnapshot.Status.State = storagev1alpha1.VolumeSnapshotStateReady
+			})).Should(Succeed())
+		}
+	}
+
+	It("should snapshot the selected volumes and become ready", func(ctx SpecContext) {
+		By("creating two selected volumes and an unselected one")
+		volume1 := createVolume(ctx, "group")
+		volume2 := createVolume(ctx, "group")
+		createVolume(ctx, "other")
+
+		By("creating a volume snapshot group")
+		volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-vsg-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupSpec{
+				VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "group"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroup)).To(Succeed(), "failed to create volume snapshot group")
+
+		By("waiting for the volume snapshots of the selected volumes to be created")
+		Eventually(groupVolumeSnapshots(ctx, volumeSnapshotGroup)).Should(ConsistOf(
+			HaveField("Spec.VolumeRef", Equal(&corev1.LocalObjectReference{Name: volume1.Name})),
+			HaveField("Spec.VolumeRef", Equal(&corev1.LocalObjectReference{Name: volume2.Name})),
+		))
+		Expect(Object(volumeSnapshotGroup)()).To(SatisfyAll(
+			HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupStatePending),
+			HaveField("Status.Snapshots", HaveLen(2)),
+		))
+
+		By("taking the volume snapshots")
+		volumeSnapshots, err := groupVolumeSnapshots(ctx, volumeSnapshotGroup)()
+		Expect(err).NotTo(HaveOccurred())
+		takeVolumeSnapshots(ctx, volumeSnapshots)
+
+		By("waiting for the volume snapshot group to become ready")
+		Eventually(Object(volumeSnapshotGroup)).Should(HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupStateReady))
+	})
+
+	It("should freeze the machine I/O while snapshotting its volumes", func(ctx SpecContext) {
+		By("creating a machine with a volume")
+		volume := createVolume(ctx, "freeze")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: "machine-class"},
+				Volumes: []computev1alpha1.Volume{
+					{
+						Name:         "data",
+						VolumeSource: computev1alpha1.VolumeSource{VolumeRef: &corev1.LocalObjectReference{Name: volume.Name}},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed(), "failed to create machine")
+
+		By("creating a volume snapshot group freezing the machine I/O")
+		volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-vsg-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupSpec{
+				MachineRef: &corev1.LocalObjectReference{Name: machine.Name},
+				FreezeIO:   true,
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroup)).To(Succeed(), "failed to create volume snapshot group")
+
+		By("waiting for the machine I/O freeze to be requested")
+		Eventually(Object(machine)).Should(HaveField("Spec.IOFreeze", Not(BeNil())))
+		Consistently(groupVolumeSnapshots(ctx, volumeSnapshotGroup)).Should(BeEmpty())
+
+		By("reporting the machine I/O as frozen")
+		Eventually(UpdateStatus(machine, func() {
+			machine.Status.Conditions = append(machine.Status.Conditions, computev1alpha1.MachineCondition{
+				Type:               computev1alpha1.MachineIOFrozen,
+				Status:             corev1.ConditionTrue,
+				Reason:             "Frozen",
+				LastTransitionTime: metav1.Now(),
+			})
+		})).Should(Succeed())
+
+		By("waiting for the volume snapshot to be created")
+		Eventually(groupVolumeSnapshots(ctx, volumeSnapshotGroup)).Should(ConsistOf(
+			HaveField("Spec.VolumeRef", Equal(&corev1.LocalObjectReference{Name: volume.Name})),
+		))
+
+		By("taking the volume snapshot")
+		volumeSnapshots, err := groupVolumeSnapshots(ctx, volumeSnapshotGroup)()
+		Expect(err).NotTo(HaveOccurred())
+		takeVolumeSnapshots(ctx, volumeSnapshots)
+
+		By("waiting for the machine I/O freeze to be released and the group to become ready")
+		Eventually(Object(machine)).Should(HaveField("Spec.IOFreeze", BeNil()))
+		Eventually(Object(volumeSnapshotGroup)).Should(HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupStateReady))
+	})
+
+	It("should fail if the machine does not exist", func(ctx SpecContext) {
+		By("creating a volume snapshot group of a non-existing machine")
+		volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-vsg-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupSpec{
+				MachineRef: &corev1.LocalObjectReference{Name: "does-not-exist"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroup)).To(Succeed(), "failed to create volume snapshot group")
+
+		By("waiting for the volume snapshot group to fail")
+		Eventually(Object(volumeSnapshotGroup)).Should(HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupStateFailed))
+	})
+
+	It("should fail if a volume snapshot of the same name is not controlled by the group", func(ctx SpecContext) {
+		By("creating a volume")
+		volume := createVolume(ctx, "conflict")
+
+		By("creating a volume snapshot with the name of the group's volume snapshot")
+		const volumeSnapshotGroupName = "test-vsg-conflict"
+		volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      VolumeSnapshotGroupSnapshotName(volumeSnapshotGroupName, volume.Name),
+			},
+			Spec: storagev1alpha1.VolumeSnapshotSpec{
+				VolumeRef: &corev1.LocalObjectReference{Name: volume.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshot)).To(Succeed(), "failed to create volume snapshot")
+
+		By("creating a volume snapshot group")
+		volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      volumeSnapshotGroupName,
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupSpec{
+				VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "conflict"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroup)).To(Succeed(), "failed to create volume snapshot group")
+
+		By("waiting for the volume snapshot group to fail")
+		Eventually(Object(volumeSnapshotGroup)).Should(SatisfyAll(
+			HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupStateFailed),
+			HaveField("Status.Message", ContainSubstring(volumeSnapshot.Name)),
+		))
+		Expect(Object(volumeSnapshot)()).To(HaveField("OwnerReferences", BeEmpty()))
+	})
+})
@@ -0,0 +1,212 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	metautils "github.com/ironcore-dev/ironcore/utils/meta"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	volumeSnapshotGroupRestoreEventVolumesCreated      = "VolumesCreated"
+	volumeSnapshotGroupRestoreEventFailedCreateVolumes = "FailedCreateVolumes"
+)
+
+// VolumeSnapshotGroupRestoreReconciler creates the Volumes of VolumeSnapshotGroupRestores from the VolumeSnapshots
+// of their VolumeSnapshotGroup.
+type VolumeSnapshotGroupRestoreReconciler struct {
+	events.EventRecorder
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshotgrouprestores,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshotgrouprestores/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshotgroups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes,verbs=get;list;watch;create
+
+func (r *VolumeSnapshotGroupRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	volumeSnapshotGroupRestore := &storagev1alpha1.VolumeSnapshotGroupRestore{}
+	if err := r.Get(ctx, req.NamespacedName, volumeSnapshotGroupRestore); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !volumeSnapshotGroupRestore.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, volumeSnapshotGroupRestore)
+}
+
+func (r *VolumeSnapshotGroupRestoreReconciler) reconcileExists(ctx context.Context, log logr.Logger, volumeSnapshotGroupRestore *storagev1alpha1.VolumeSnapshotGroupRestore) (ctrl.Result, error) {
+	switch volumeSnapshotGroupRestore.Status.State {
+	case storagev1alpha1.VolumeSnapshotGroupRestoreStateRestored, storagev1alpha1.VolumeSnapshotGroupRestoreStateFailed:
+		log.V(1).Info("Volume snapshot group restore is done", "State", volumeSnapshotGroupRestore.Status.State)
+		return ctrl.Result{}, nil
+	}
+
+	base := volumeSnapshotGroupRestore.DeepCopy()
+	if err := r.restore(ctx, log, volumeSnapshotGroupRestore); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.V(1).Info("Updating volume snapshot group restore status")
+	if err := r.Status().Patch(ctx, volumeSnapshotGroupRestore, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating volume snapshot group restore status: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+func setVolumeSnapshotGroupRestoreState(volumeSnapshotGroupRestore *storagev1alpha1.VolumeSnapshotGroupRestore, state storagev1alpha1.VolumeSnapshotGroupRestoreState, message string) {
+	if volumeSnapshotGroupRestore.Status.State != state {
+		now := metav1.Now()
+		volumeSnapshotGroupRestore.Status.LastStateTransitionTime = &now
+	}
+	volumeSnapshotGroupRestore.Status.State = state
+	volumeSnapshotGroupRestore.Status.Message = message
+}
+
+// VolumeSnapshotGroupRestoreVolumeName returns the name of the Volume a VolumeSnapshotGroupRestore restores
+// the given Volume into.
+func VolumeSnapshotGroupRestoreVolumeName(volumeSnapshotGroupRestoreName, sourceVolumeName string) string {
+	return metautils.BoundedName(volumeSnapshotGroupRestoreName, sourceVolumeName)
+}
+
+// restore creates the volumes of the volume snapshot group restore and updates its status in-memory.
+func (r *VolumeSnapshotGroupRestoreReconciler) restore(ctx context.Context, log logr.Logger, volumeSnapshotGroupRestore *storagev1alpha1.VolumeSnapshotGroupRestore) error {
+	volumeSnapshotGroupName := volumeSnapshotGroupRestore.Spec.VolumeSnapshotGroupRef.Name
+	volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: volumeSnapshotGroupRestore.Namespace, Name: volumeSnapshotGroupName}, volumeSnapshotGroup); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting volume snapshot group %s: %w", volumeSnapshotGroupName, err)
+		}
+		setVolumeSnapshotGroupRestoreState(volumeSnapshotGroupRestore, storagev1alpha1.VolumeSnapshotGroupRestoreStatePending,
+			fmt.Sprintf("Volume snapshot group %s not found", volumeSnapshotGroupName))
+		return nil
+	}
+
+	switch volumeSnapshotGroup.Status.State {
+	case storagev1alpha1.VolumeSnapshotGroupStateReady:
+	case storagev1alpha1.VolumeSnapshotGroupStateFailed:
+		setVolumeSnapshotGroupRestoreState(volumeSnapshotGroupRestore, storagev1alpha1.VolumeSnapshotGroupRestoreStateFailed,
+			fmt.Sprintf("Volume snapshot group %s failed", volumeSnapshotGroupName))
+		return nil
+	default:
+		log.V(1).Info("Volume snapshot group is not ready yet")
+		setVolumeSnapshotGroupRestoreState(volumeSnapshotGroupRestore, storagev1alpha1.VolumeSnapshotGroupRestoreStatePending,
+			fmt.Sprintf("Waiting for volume snapshot group %s to become ready", volumeSnapshotGroupName))
+		return nil
+	}
+
+	var (
+		volumes   []storagev1alpha1.VolumeSnapshotGroupRestoreVolume
+		created   int
+		conflicts []string
+		errs      []error
+	)
+	for _, snapshot := range volumeSnapshotGroup.Status.Snapshots {
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: volumeSnapshotGroupRestore.Namespace,
+				Name:      VolumeSnapshotGroupRestoreVolumeName(volumeSnapshotGroupRestore.Name, snapshot.VolumeRef.Name),
+				Labels: map[string]string{
+					storagev1alpha1.VolumeSnapshotGroupRestoreNameLabel: volumeSnapshotGroupRestore.Name,
+				},
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: snapshot.VolumeClassRef,
+				Resources:      snapshot.Resources,
+				DataSource: storagev1alpha1.VolumeDataSource{
+					VolumeSnapshotRef: &corev1.LocalObjectReference{Name: snapshot.VolumeSnapshotRef.Name},
+				},
+			},
+		}
+
+		log.V(1).Info("Creating volume", "SourceVolume", snapshot.VolumeRef.Name, "Volume", volume.Name)
+		if err := r.Create(ctx, volume); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				errs = append(errs, fmt.Errorf("error creating volume from volume snapshot %s: %w", snapshot.VolumeSnapshotRef.Name, err))
+				continue
+			}
+
+			existing := &storagev1alpha1.Volume{}
+			if err := r.Get(ctx, client.ObjectKeyFromObject(volume), existing); err != nil {
+				errs = append(errs, fmt.Errorf("error getting existing volume %s: %w", volume.Name, err))
+				continue
+			}
+			if existing.Labels[storagev1alpha1.VolumeSnapshotGroupRestoreNameLabel] != volumeSnapshotGroupRestore.Name {
+				conflicts = append(conflicts, existing.Name)
+				continue
+			}
+		} else {
+			created++
+		}
+		volumes = append(volumes, storagev1alpha1.VolumeSnapshotGroupRestoreVolume{
+			SourceVolumeRef: snapshot.VolumeRef,
+			VolumeRef:       corev1.LocalObjectReference{Name: volume.Name},
+		})
+	}
+	volumeSnapshotGroupRestore.Status.Volumes = volumes
+
+	if created > 0 {
+		r.Eventf(volumeSnapshotGroupRestore, volumeSnapshotGroup, corev1.EventTypeNormal, volumeSnapshotGroupRestoreEventVolumesCreated, "Restore",
+			"Created %d volumes", created)
+	}
+	if len(conflicts) > 0 {
+		setVolumeSnapshotGroupRestoreState(volumeSnapshotGroupRestore, storagev1alpha1.VolumeSnapshotGroupRestoreStateFailed,
+			fmt.Sprintf("Volumes %v already exist and were not created by the volume snapshot group restore", conflicts))
+		return nil
+	}
+	if err := errors.Join(errs...); err != nil {
+		r.Eventf(volumeSnapshotGroupRestore, volumeSnapshotGroup, corev1.EventTypeWarning, volumeSnapshotGroupRestoreEventFailedCreateVolumes, "Restore",
+			"Error creating volumes: %v", err)
+		return err
+	}
+
+	setVolumeSnapshotGroupRestoreState(volumeSnapshotGroupRestore, storagev1alpha1.VolumeSnapshotGroupRestoreStateRestored,
+		fmt.Sprintf("Restored %d volumes", len(volumes)))
+	return nil
+}
+
+func (r *VolumeSnapshotGroupRestoreReconciler) enqueueByVolumeSnapshotGroup() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		log := ctrl.LoggerFrom(ctx)
+
+		volumeSnapshotGroupRestoreList := &storagev1alpha1.VolumeSnapshotGroupRestoreList{}
+		if err := r.List(ctx, volumeSnapshotGroupRestoreList, client.InNamespace(obj.GetNamespace())); err != nil {
+			log.Error(err, "Error listing volume snapshot group restores")
+			return nil
+		}
+
+		var res []reconcile.Request
+		for _, volumeSnapshotGroupRestore := range volumeSnapshotGroupRestoreList.Items {
+			if volumeSnapshotGroupRestore.Spec.VolumeSnapshotGroupRef.Name == obj.GetName() {
+				res = append(res, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&volumeSnapshotGroupRestore)})
+			}
+		}
+		return res
+	})
+}
+
+func (r *VolumeSnapshotGroupRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&storagev1alpha1.VolumeSnapshotGroupRestore{}).
+		Watches(
+			&storagev1alpha1.VolumeSnapshotGroup{},
+			r.enqueueByVolumeSnapshotGroup(),
+		).
+		Complete(r)
+}
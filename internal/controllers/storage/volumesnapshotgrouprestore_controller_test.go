@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("VolumeSnapshotGroupRestoreReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+
+	It("should restore the volumes of a ready volume snapshot group", func(ctx SpecContext) {
+		By("creating a volume")
+		resources := corev1alpha1.ResourceList{corev1alpha1.ResourceStorage: resource.MustParse("10Gi")}
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+				Labels:       map[string]string{"app": "restore"},
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: "volume-class"},
+				Resources:      resources,
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed(), "failed to create volume")
+
+		By("creating a volume snapshot group")
+		volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-vsg-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupSpec{
+				VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "restore"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroup)).To(Succeed(), "failed to create volume snapshot group")
+
+		By("creating a volume snapshot group restore")
+		volumeSnapshotGroupRestore := &storagev1alpha1.VolumeSnapshotGroupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-vsgr-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupRestoreSpec{
+				VolumeSnapshotGroupRef: corev1.LocalObjectReference{Name: volumeSnapshotGroup.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroupRestore)).To(Succeed(), "failed to create volume snapshot group restore")
+
+		By("waiting for the restore to wait for the volume snapshot group")
+		Eventually(Object(volumeSnapshotGroupRestore)).Should(SatisfyAll(
+			HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupRestoreStatePending),
+			HaveField("Status.Message", Not(BeEmpty())),
+		))
+
+		By("taking the volume snapshot of the group")
+		volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      VolumeSnapshotGroupSnapshotName(volumeSnapshotGroup.Name, volume.Name),
+			},
+		}
+		Eventually(UpdateStatus(volumeSnapshot, func() {
+			volumeSnapshot.Status.SnapshotID = "test://snapshot"
+			volumeSnapshot.Status.State = storagev1alpha1.VolumeSnapshotStateReady
+		})).Should(Succeed())
+		Eventually(Object(volumeSnapshotGroup)).Should(HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupStateReady))
+
+		By("waiting for the restore to be done")
+		restoredVolumeName := VolumeSnapshotGroupRestoreVolumeName(volumeSnapshotGroupRestore.Name, volume.Name)
+		Eventually(Object(volumeSnapshotGroupRestore)).Should(SatisfyAll(
+			HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupRestoreStateRestored),
+			HaveField("Status.Volumes", ConsistOf(storagev1alpha1.VolumeSnapshotGroupRestoreVolume{
+				SourceVolumeRef: corev1.LocalObjectReference{Name: volume.Name},
+				VolumeRef:       corev1.LocalObjectReference{Name: restoredVolumeName},
+			})),
+		))
+
+		By("inspecting the restored volume")
+		restoredVolume := &storagev1alpha1.Volume{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: ns.Name, Name: restoredVolumeName}, restoredVolume)).To(Succeed())
+		Expect(restoredVolume.Labels).To(HaveKeyWithValue(storagev1alpha1.VolumeSnapshotGroupRestoreNameLabel, volumeSnapshotGroupRestore.Name))
+		Expect(restoredVolume.Spec.VolumeClassRef).To(Equal(&corev1.LocalObjectReference{Name: "volume-class"}))
+		Expect(restoredVolume.Spec.Resources).To(HaveKeyWithValue(corev1alpha1.ResourceStorage, resource.MustParse("10Gi")))
+		Expect(restoredVolume.Spec.DataSource.VolumeSnapshotRef).To(Equal(&corev1.LocalObjectReference{Name: volumeSnapshot.Name}))
+	})
+
+	It("should fail if a volume of the same name was not created by the restore", func(ctx SpecContext) {
+		By("creating a volume")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+				Labels:       map[string]string{"app": "restore-conflict"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed(), "failed to create volume")
+
+		By("creating a volume snapshot group and taking its volume snapshot")
+		volumeSnapshotGroup := &storagev1alpha1.VolumeSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-vsg-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupSpec{
+				VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "restore-conflict"}},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroup)).To(Succeed(), "failed to create volume snapshot group")
+
+		volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      VolumeSnapshotGroupSnapshotName(volumeSnapshotGroup.Name, volume.Name),
+			},
+		}
+		Eventually(UpdateStatus(volumeSnapshot, func() {
+			volumeSnapshot.Status.SnapshotID = "test://snapshot"
+			volumeSnapshot.Status.State = storagev1alpha1.VolumeSnapshotStateReady
+		})).Should(Succeed())
+		Eventually(Object(volumeSnapshotGroup)).Should(HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupStateReady))
+
+		By("creating a volume with the name of the restored volume")
+		const volumeSnapshotGroupRestoreName = "test-vsgr-conflict"
+		existingVolume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      VolumeSnapshotGroupRestoreVolumeName(volumeSnapshotGroupRestoreName, volume.Name),
+			},
+		}
+		Expect(k8sClient.Create(ctx, existingVolume)).To(Succeed(), "failed to create volume")
+
+		By("creating a volume snapshot group restore")
+		volumeSnapshotGroupRestore := &storagev1alpha1.VolumeSnapshotGroupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      volumeSnapshotGroupRestoreName,
+			},
+			Spec: storagev1alpha1.VolumeSnapshotGroupRestoreSpec{
+				VolumeSnapshotGroupRef: corev1.LocalObjectReference{Name: volumeSnapshotGroup.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshotGroupRestore)).To(Succeed(), "failed to create volume snapshot group restore")
+
+		By("waiting for the restore to fail")
+		Eventually(Object(volumeSnapshotGroupRestore)).Should(SatisfyAll(
+			HaveField("Status.State", storagev1alpha1.VolumeSnapshotGroupRestoreStateFailed),
+			HaveField("Status.Message", ContainSubstring(existingVolume.Name)),
+		))
+	})
+})
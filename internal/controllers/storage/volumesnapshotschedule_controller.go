@@ -0,0 +1,380 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	controllerutils "github.com/ironcore-dev/ironcore/internal/controllers/utils"
+	metautils "github.com/ironcore-dev/ironcore/utils/meta"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	volumeSnapshotScheduleEventSnapshotsCreated      = "SnapshotsCreated"
+	volumeSnapshotScheduleEventFailedCreateSnapshots = "FailedCreateSnapshots"
+	volumeSnapshotScheduleEventSnapshotsPruned       = "SnapshotsPruned"
+	volumeSnapshotScheduleEventSnapshotFailed        = "SnapshotFailed"
+)
+
+// maxMissedScheduleTimes is the maximum number of missed schedule times that are walked, like for CronJobs.
+const maxMissedScheduleTimes = 100
+
+// VolumeSnapshotScheduleReconciler creates VolumeSnapshots of the volumes selected by VolumeSnapshotSchedules
+// and prunes them according to their retention.
+type VolumeSnapshotScheduleReconciler struct {
+	events.EventRecorder
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshotschedules,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshotschedules/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshots,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes,verbs=get;list;watch
+
+func (r *VolumeSnapshotScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	volumeSnapshotSchedule := &storagev1alpha1.VolumeSnapshotSchedule{}
+	if err := r.Get(ctx, req.NamespacedName, volumeSnapshotSchedule); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !volumeSnapshotSchedule.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, volumeSnapshotSchedule)
+}
+
+func (r *VolumeSnapshotScheduleReconciler) reconcileExists(ctx context.Context, log logr.Logger, volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule) (ctrl.Result, error) {
+	schedule, err := cron.ParseStandard(volumeSnapshotSchedule.Spec.Schedule)
+	if err != nil {
+		log.Error(err, "Invalid schedule, not reconciling")
+		return ctrl.Result{}, nil
+	}
+
+	log.V(1).Info("Listing volume snapshots of schedule")
+	volumeSnapshotList := &storagev1alpha1.VolumeSnapshotList{}
+	if err := r.List(ctx, volumeSnapshotList,
+		client.InNamespace(volumeSnapshotSchedule.Namespace),
+		client.MatchingLabels{storagev1alpha1.VolumeSnapshotScheduleNameLabel: volumeSnapshotSchedule.Name},
+	); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error listing volume snapshots: %w", err)
+	}
+
+	// Schedules are interpreted in UTC, and the cron schedule evaluates in the location of the times passed to it.
+	now := time.Now().UTC()
+	base := volumeSnapshotSchedule.DeepCopy()
+	status := &volumeSnapshotSchedule.Status
+	status.ObservedGeneration = volumeSnapshotSchedule.Generation
+	r.updateResults(volumeSnapshotSchedule, volumeSnapshotList.Items)
+
+	var (
+		requeueAfter time.Duration
+		createErr    error
+	)
+	if !volumeSnapshotSchedule.Spec.Suspend {
+		if scheduledTime, ok := lastMissedScheduleTime(schedule, volumeSnapshotSchedule, now); ok {
+			// Only advance the last schedule time once all volume snapshots have been created, so that
+			// a failed run is retried instead of being skipped.
+			if err := r.createSnapshots(ctx, log, volumeSnapshotSchedule, scheduledTime); err != nil {
+				status.LastFailureTime = &metav1.Time{Time: scheduledTime}
+				status.LastFailureMessage = err.Error()
+				createErr = fmt.Errorf("error creating volume snapshots: %w", err)
+			} else {
+				status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+			}
+		}
+		requeueAfter = schedule.Next(now).Sub(now)
+	}
+
+	expiresIn, err := r.pruneSnapshots(ctx, log, volumeSnapshotSchedule, volumeSnapshotList.Items, now)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	requeueAfter = controllerutils.MinRequeueAfter(requeueAfter, expiresIn)
+
+	log.V(1).Info("Updating volume snapshot schedule status")
+	if err := r.Status().Patch(ctx, volumeSnapshotSchedule, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating volume snapshot schedule status: %w", err)
+	}
+	if createErr != nil {
+		return ctrl.Result{}, createErr
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// lastMissedScheduleTime returns the most recent time the schedule was due at since it was last scheduled.
+// If the schedule was due more than maxMissedScheduleTimes times, the missed times are not all walked;
+// instead, the most recent time is looked up in growing windows before now.
+func lastMissedScheduleTime(schedule cron.Schedule, volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, now time.Time) (time.Time, bool) {
+	since := volumeSnapshotSchedule.CreationTimestamp.UTC()
+	if lastScheduleTime := volumeSnapshotSchedule.Status.LastScheduleTime; lastScheduleTime != nil {
+		since = lastScheduleTime.UTC()
+	}
+
+	last, ok, more := walkScheduleTimes(schedule, since, now)
+	if !more {
+		return last, ok
+	}
+
+	for window := schedule.Next(last).Sub(last); ; window *= 2 {
+		from := now.Add(-window)
+		if from.Before(last) {
+			from = last
+		}
+
+		recent, ok, more := walkScheduleTimes(schedule, from, now)
+		for more {
+			recent, _, more = walkScheduleTimes(schedule, recent, now)
+		}
+		if ok {
+			return recent, true
+		}
+	}
+}
+
+// walkScheduleTimes walks at most maxMissedScheduleTimes times the schedule was due at after since and not after now.
+// It returns the last time walked and whether the schedule was due more often.
+func walkScheduleTimes(schedule cron.Schedule, since, now time.Time) (last time.Time, ok, more bool) {
+	t := schedule.Next(since)
+	for i := 0; i < maxMissedScheduleTimes && !t.IsZero() && !t.After(now); i++ {
+		last, ok = t, true
+		t = schedule.Next(t)
+	}
+	return last, ok, !t.IsZero() && !t.After(now)
+}
+
+// volumeSnapshotScheduledTime returns the scheduled time the volume snapshot was created for.
+func volumeSnapshotScheduledTime(volumeSnapshot *storagev1alpha1.VolumeSnapshot) time.Time {
+	if t, err := time.Parse(time.RFC3339, volumeSnapshot.Annotations[storagev1alpha1.VolumeSnapshotScheduledTimeAnnotation]); err == nil {
+		return t
+	}
+	return volumeSnapshot.CreationTimestamp.Time
+}
+
+// updateResults updates the last successful and failure time of the schedule from the states of its volume snapshots.
+// A scheduled time is successful once all its volume snapshots are ready.
+func (r *VolumeSnapshotScheduleReconciler) updateResults(volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule, volumeSnapshots []storagev1alpha1.VolumeSnapshot) {
+	status := &volumeSnapshotSchedule.Status
+	// ready maps the unix time of each scheduled time to whether all its volume snapshots are ready.
+	ready := make(map[int64]bool)
+	for i := range volumeSnapshots {
+		volumeSnapshot := &volumeSnapshots[i]
+		scheduledTime := volumeSnapshotScheduledTime(volumeSnapshot)
+
+		switch volumeSnapshot.Status.State {
+		case storagev1alpha1.VolumeSnapshotStateReady:
+			if _, ok := ready[scheduledTime.Unix()]; !ok {
+				ready[scheduledTime.Unix()] = true
+			}
+		case storagev1alpha1.VolumeSnapshotStateFailed:
+			ready[scheduledTime.Unix()] = false
+			if status.LastFailureTime == nil || status.LastFailureTime.Time.Before(scheduledTime) {
+				status.LastFailureTime = &metav1.Time{Time: scheduledTime}
+				status.LastFailureMessage = fmt.Sprintf("Volume snapshot %s of volume %s failed", volumeSnapshot.Name, volumeSnapshotVolumeName(volumeSnapshot))
+				r.Eventf(volumeSnapshotSchedule, volumeSnapshot, corev1.EventTypeWarning, volumeSnapshotScheduleEventSnapshotFailed, "Snapshot",
+					"Volume snapshot %s failed", volumeSnapshot.Name)
+			}
+		default:
+			ready[scheduledTime.Unix()] = false
+		}
+	}
+
+	for unix, ok := range ready {
+		scheduledTime := time.Unix(unix, 0)
+		if ok && (status.LastSuccessfulTime == nil || status.LastSuccessfulTime.Time.Before(scheduledTime)) {
+			status.LastSuccessfulTime = &metav1.Time{Time: scheduledTime}
+		}
+	}
+}
+
+func volumeSnapshotVolumeName(volumeSnapshot *storagev1alpha1.VolumeSnapshot) string {
+	if volumeRef := volumeSnapshot.Spec.VolumeRef; volumeRef != nil {
+		return volumeRef.Name
+	}
+	return ""
+}
+
+// createSnapshots creates a volume snapshot of every selected volume for the scheduled time.
+func (r *VolumeSnapshotScheduleReconciler) createSnapshots(
+	ctx context.Context,
+	log logr.Logger,
+	volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule,
+	scheduledTime time.Time,
+) error {
+	selector, err := metav1.LabelSelectorAsSelector(volumeSnapshotSchedule.Spec.VolumeSelector)
+	if err != nil {
+		return fmt.Errorf("error parsing volume selector: %w", err)
+	}
+
+	volumeList := &storagev1alpha1.VolumeList{}
+	if err := r.List(ctx, volumeList,
+		client.InNamespace(volumeSnapshotSchedule.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return fmt.Errorf("error listing volumes: %w", err)
+	}
+
+	var (
+		created int
+		errs    []error
+	)
+	for _, volume := range volumeList.Items {
+		if !volume.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: volumeSnapshotSchedule.Namespace,
+				Name:      scheduledVolumeSnapshotName(volumeSnapshotSchedule.Name, volume.Name, scheduledTime),
+				Labels: map[string]string{
+					storagev1alpha1.VolumeSnapshotScheduleNameLabel: volumeSnapshotSchedule.Name,
+				},
+				Annotations: map[string]string{
+					storagev1alpha1.VolumeSnapshotScheduledTimeAnnotation: scheduledTime.UTC().Format(time.RFC3339),
+				},
+			},
+			Spec: storagev1alpha1.VolumeSnapshotSpec{
+				VolumeRef: &corev1.LocalObjectReference{Name: volume.Name},
+			},
+		}
+		log.V(1).Info("Creating volume snapshot", "Volume", volume.Name, "VolumeSnapshot", volumeSnapshot.Name)
+		if err := r.Create(ctx, volumeSnapshot); err != nil {
+			if !apierrors.IsAlreadyExists(err) {
+				errs = append(errs, fmt.Errorf("error creating volume snapshot of volume %s: %w", volume.Name, err))
+			}
+			continue
+		}
+		created++
+	}
+
+	if created > 0 {
+		r.Eventf(volumeSnapshotSchedule, nil, corev1.EventTypeNormal, volumeSnapshotScheduleEventSnapshotsCreated, "Schedule",
+			"Created %d volume snapshots scheduled at %s", created, scheduledTime.UTC().Format(time.RFC3339))
+	}
+	if err := errors.Join(errs...); err != nil {
+		r.Eventf(volumeSnapshotSchedule, nil, corev1.EventTypeWarning, volumeSnapshotScheduleEventFailedCreateSnapshots, "Schedule",
+			"Error creating volume snapshots: %v", err)
+		return err
+	}
+	return nil
+}
+
+// scheduledVolumeSnapshotName returns the name of the volume snapshot of the volume for the scheduled time.
+func scheduledVolumeSnapshotName(volumeSnapshotScheduleName, volumeName string, scheduledTime time.Time) string {
+	return metautils.BoundedName(volumeSnapshotScheduleName, volumeName, strconv.FormatInt(scheduledTime.Unix(), 10))
+}
+
+// pruneSnapshots deletes the volume snapshots exceeding the retention of the schedule. It returns the duration
+// after which the next volume snapshot expires, or zero if none expires.
+// Only ready volume snapshots count towards KeepLast: volume snapshots that are not ready are kept unless
+// KeepLast more recent ready ones exist, so failed volume snapshots never displace the last ready ones.
+func (r *VolumeSnapshotScheduleReconciler) pruneSnapshots(
+	ctx context.Context,
+	log logr.Logger,
+	volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule,
+	volumeSnapshots []storagev1alpha1.VolumeSnapshot,
+	now time.Time,
+) (time.Duration, error) {
+	retention := volumeSnapshotSchedule.Spec.Retention
+
+	byVolume := make(map[string][]*storagev1alpha1.VolumeSnapshot)
+	for i := range volumeSnapshots {
+		volumeSnapshot := &volumeSnapshots[i]
+		if !volumeSnapshot.DeletionTimestamp.IsZero() {
+			continue
+		}
+		volumeName := volumeSnapshotVolumeName(volumeSnapshot)
+		byVolume[volumeName] = append(byVolume[volumeName], volumeSnapshot)
+	}
+
+	var (
+		pruned    int
+		expiresIn time.Duration
+	)
+	for _, volumeSnapshots := range byVolume {
+		// Sort the volume snapshots of a volume from the most recent to the oldest.
+		slices.SortFunc(volumeSnapshots, func(a, b *storagev1alpha1.VolumeSnapshot) int {
+			return volumeSnapshotScheduledTime(b).Compare(volumeSnapshotScheduledTime(a))
+		})
+
+		var ready int
+		for _, volumeSnapshot := range volumeSnapshots {
+			var expired bool
+			if keepLast := retention.KeepLast; keepLast != nil {
+				if volumeSnapshot.Status.State == storagev1alpha1.VolumeSnapshotStateReady {
+					ready++
+					expired = ready > int(*keepLast)
+				} else {
+					expired = ready >= int(*keepLast)
+				}
+			}
+			if maxAge := retention.MaxAge; maxAge != nil && !expired {
+				remaining := volumeSnapshotScheduledTime(volumeSnapshot).Add(maxAge.Duration).Sub(now)
+				if remaining <= 0 {
+					expired = true
+				} else {
+					expiresIn = controllerutils.MinRequeueAfter(expiresIn, remaining)
+				}
+			}
+			if !expired {
+				continue
+			}
+
+			log.V(1).Info("Deleting expired volume snapshot", "VolumeSnapshot", volumeSnapshot.Name)
+			if err := r.Delete(ctx, volumeSnapshot); client.IgnoreNotFound(err) != nil {
+				return 0, fmt.Errorf("error deleting volume snapshot %s: %w", volumeSnapshot.Name, err)
+			}
+			pruned++
+		}
+	}
+
+	if pruned > 0 {
+		r.Eventf(volumeSnapshotSchedule, nil, corev1.EventTypeNormal, volumeSnapshotScheduleEventSnapshotsPruned, "Prune",
+			"Deleted %d expired volume snapshots", pruned)
+	}
+	return expiresIn, nil
+}
+
+func (r *VolumeSnapshotScheduleReconciler) enqueueByVolumeSnapshot() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		volumeSnapshotScheduleName, ok := obj.GetLabels()[storagev1alpha1.VolumeSnapshotScheduleNameLabel]
+		if !ok {
+			return nil
+		}
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: obj.GetNamespace(), Name: volumeSnapshotScheduleName}}}
+	})
+}
+
+func (r *VolumeSnapshotScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(
+			&storagev1alpha1.VolumeSnapshotSchedule{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&storagev1alpha1.VolumeSnapshot{},
+			r.enqueueByVolumeSnapshot(),
+		).
+		Complete(r)
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"time"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/utils/testing"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("VolumeSnapshotScheduleReconciler", func() {
+	ns := SetupNamespace(&k8sClient)
+
+	newVolumeSnapshotSchedule := func(app string, retention storagev1alpha1.VolumeSnapshotRetention) *storagev1alpha1.VolumeSnapshotSchedule {
+		return &storagev1alpha1.VolumeSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-vss-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotScheduleSpec{
+				Schedule:       "@every 1s",
+				VolumeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+				Retention:      retention,
+			},
+		}
+	}
+
+	createVolume := func(ctx SpecContext, app string) *storagev1alpha1.Volume {
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "test-volume-",
+				Labels:       map[string]string{"app": app},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed(), "failed to create volume")
+		return volume
+	}
+
+	scheduleVolumeSnapshots := func(ctx SpecContext, volumeSnapshotSchedule *storagev1alpha1.VolumeSnapshotSchedule) func() ([]storagev1alpha1.VolumeSnapshot, error) {
+		return func() ([]storagev1alpha1.VolumeSnapshot, error) {
+			volumeSnapshotList := &storagev1alpha1.VolumeSnapshotList{}
+			if err := k8sClient.List(ctx, volumeSnapshotList,
+				client.InNamespace(ns.Name),
+				client.MatchingLabels{storagev1alpha1.VolumeSnapshotScheduleNameLabel: volumeSnapshotSchedule.Name},
+			); err != nil {
+				return nil, err
+			}
+			return volumeSnapshotList.Items, nil
+		}
+	}
+
+	It("should create volume snapshots of the selected volumes", func(ctx SpecContext) {
+		By("creating a selected and an unselected volume")
+		volume := createVolume(ctx, "keep-last")
+		createVolume(ctx, "other")
+
+		By("creating a volume snapshot schedule")
+		volumeSnapshotSchedule := newVolumeSnapshotSchedule("keep-last", storagev1alpha1.VolumeSnapshotRetention{
+			KeepLast: ptr.To[int32](2),
+		})
+		Expect(k8sClient.Create(ctx, volumeSnapshotSchedule)).To(Succeed(), "failed to create volume snapshot schedule")
+
+		By("waiting for volume snapshots of the selected volume to be created")
+		Eventually(scheduleVolumeSnapshots(ctx, volumeSnapshotSchedule)).WithTimeout(10 * time.Second).Should(SatisfyAll(
+			Not(BeEmpty()),
+			HaveEach(SatisfyAll(
+				HaveField("Spec.VolumeRef", Equal(&corev1.LocalObjectReference{Name: volume.Name})),
+				HaveField("ObjectMeta.Annotations", HaveKey(storagev1alpha1.VolumeSnapshotScheduledTimeAnnotation)),
+			)),
+		))
+		Expect(Object(volumeSnapshotSchedule)()).To(HaveField("Status.LastScheduleTime", Not(BeNil())))
+	})
+
+	It("should report the last successful and failed schedules", func(ctx SpecContext) {
+		By("creating a volume")
+		createVolume(ctx, "results")
+
+		By("creating a volume snapshot schedule")
+		volumeSnapshotSchedule := newVolumeSnapshotSchedule("results", storagev1alpha1.VolumeSnapshotRetention{
+			KeepLast: ptr.To[int32](10),
+		})
+		Expect(k8sClient.Create(ctx, volumeSnapshotSchedule)).To(Succeed(), "failed to create volume snapshot schedule")
+
+		By("waiting for two volume snapshots to be created")
+		Eventually(scheduleVolumeSnapshots(ctx, volumeSnapshotSchedule)).WithTimeout(10 * time.Second).Should(HaveLen(2))
+
+		By("suspending the volume snapshot schedule")
+		Eventually(Update(volumeSnapshotSchedule, func() {
+			volumeSnapshotSchedule.Spec.Suspend = true
+		})).Should(Succeed())
+
+		volumeSnapshots, err := scheduleVolumeSnapshots(ctx, volumeSnapshotSchedule)()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(volumeSnapshots).NotTo(BeEmpty())
+
+		By("marking a volume snapshot as ready")
+		readyVolumeSnapshot := &volumeSnapshots[0]
+		Eventually(UpdateStatus(readyVolumeSnapshot, func() {
+			readyVolumeSnapshot.Status.State = storagev1alpha1.VolumeSnapshotStateReady
+		})).Should(Succeed())
+
+		By("waiting for the schedule to report the successful time")
+		Eventually(Object(volumeSnapshotSchedule)).Should(HaveField("Status.LastSuccessfulTime", Not(BeNil())))
+
+		By("marking another volume snapshot as failed")
+		failedVolumeSnapshot := &volumeSnapshots[len(volumeSnapshots)-1]
+		Eventually(UpdateStatus(failedVolumeSnapshot, func() {
+			failedVolumeSnapshot.Status.State = storagev1alpha1.VolumeSnapshotStateFailed
+		})).Should(Succeed())
+
+		By("waiting for the schedule to report the failure")
+		Eventually(Object(volumeSnapshotSchedule)).Should(HaveField("Status", SatisfyAll(
+			HaveField("LastFailureTime", Not(BeNil())),
+			HaveField("LastFailureMessage", ContainSubstring(failedVolumeSnapshot.Name)),
+		)))
+	})
+
+	It("should delete volume snapshots older than the max age", func(ctx SpecContext) {
+		By("creating a suspended volume snapshot schedule")
+		volumeSnapshotSchedule := newVolumeSnapshotSchedule("max-age", storagev1alpha1.VolumeSnapshotRetention{
+			MaxAge: &metav1.Duration{Duration: time.Hour},
+		})
+		volumeSnapshotSchedule.Spec.Suspend = true
+		Expect(k8sClient.Create(ctx, volumeSnapshotSchedule)).To(Succeed(), "failed to create volume snapshot schedule")
+
+		newScheduledVolumeSnapshot := func(scheduledTime time.Time) *storagev1alpha1.VolumeSnapshot {
+			return &storagev1alpha1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "test-snapshot-",
+					Labels: map[string]string{
+						storagev1alpha1.VolumeSnapshotScheduleNameLabel: volumeSnapshotSchedule.Name,
+					},
+					Annotations: map[string]string{
+						storagev1alpha1.VolumeSnapshotScheduledTimeAnnotation: scheduledTime.UTC().Format(time.RFC3339),
+					},
+				},
+				Spec: storagev1alpha1.VolumeSnapshotSpec{
+					VolumeRef: &corev1.LocalObjectReference{Name: "my-volume"},
+				},
+			}
+		}
+
+		By("creating an expired and a recent volume snapshot of the schedule")
+		expiredVolumeSnapshot := newScheduledVolumeSnapshot(time.Now().Add(-2 * time.Hour))
+		Expect(k8sClient.Create(ctx, expiredVolumeSnapshot)).To(Succeed(), "failed to create expired volume snapshot")
+		recentVolumeSnapshot := newScheduledVolumeSnapshot(time.Now())
+		Expect(k8sClient.Create(ctx, recentVolumeSnapshot)).To(Succeed(), "failed to create recent volume snapshot")
+
+		By("waiting for the expired volume snapshot to be deleted")
+		Eventually(Get(expiredVolumeSnapshot)).Should(Satisfy(apierrors.IsNotFound))
+		Expect(Get(recentVolumeSnapshot)()).To(Succeed())
+	})
+
+	It("should only count ready volume snapshots towards keep last", func(ctx SpecContext) {
+		By("creating a suspended volume snapshot schedule")
+		volumeSnapshotSchedule := newVolumeSnapshotSchedule("keep-last-ready", storagev1alpha1.VolumeSnapshotRetention{
+			KeepLast: ptr.To[int32](1),
+		})
+		volumeSnapshotSchedule.Spec.Suspend = true
+		Expect(k8sClient.Create(ctx, volumeSnapshotSchedule)).To(Succeed(), "failed to create volume snapshot schedule")
+
+		now := time.Now()
+		createScheduledVolumeSnapshot := func(scheduledTime time.Time, state storagev1alpha1.VolumeSnapshotState) *storagev1alpha1.VolumeSnapshot {
+			volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:    ns.Name,
+					GenerateName: "test-snapshot-",
+					Labels: map[string]string{
+						storagev1alpha1.VolumeSnapshotScheduleNameLabel: volumeSnapshotSchedule.Name,
+					},
+					Annotations: map[string]string{
+						storagev1alpha1.VolumeSnapshotScheduledTimeAnnotation: scheduledTime.UTC().Format(time.RFC3339),
+					},
+				},
+				Spec: storagev1alpha1.VolumeSnapshotSpec{
+					VolumeRef: &corev1.LocalObjectReference{Name: "my-volume"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, volumeSnapshot)).To(Succeed(), "failed to create volume snapshot")
+			Eventually(UpdateStatus(volumeSnapshot, func() {
+				volumeSnapshot.Status.State = state
+			})).Should(Succeed())
+			return volumeSnapshot
+		}
+
+		By("creating ready volume snapshots followed by more recent failed ones")
+		failedOldVolumeSnapshot := createScheduledVolumeSnapshot(now.Add(-5*time.Minute), storagev1alpha1.VolumeSnapshotStateFailed)
+		readyOldVolumeSnapshot := createScheduledVolumeSnapshot(now.Add(-4*time.Minute), storagev1alpha1.VolumeSnapshotStateReady)
+		readyVolumeSnapshot := createScheduledVolumeSnapshot(now.Add(-3*time.Minute), storagev1alpha1.VolumeSnapshotStateReady)
+		failedVolumeSnapshot1 := createScheduledVolumeSnapshot(now.Add(-2*time.Minute), storagev1alpha1.VolumeSnapshotStateFailed)
+		failedVolumeSnapshot2 := createScheduledVolumeSnapshot(now.Add(-1*time.Minute), storagev1alpha1.VolumeSnapshotStateFailed)
+
+		By("waiting for the volume snapshots older than the last ready one to be deleted")
+		Eventually(Get(failedOldVolumeSnapshot)).Should(Satisfy(apierrors.IsNotFound))
+		Eventually(Get(readyOldVolumeSnapshot)).Should(Satisfy(apierrors.IsNotFound))
+
+		By("asserting the last ready volume snapshot and the more recent failed ones are kept")
+		Consistently(Get(readyVolumeSnapshot)).Should(Succeed())
+		Expect(Get(failedVolumeSnapshot1)()).To(Succeed())
+		Expect(Get(failedVolumeSnapshot2)()).To(Succeed())
+	})
+})
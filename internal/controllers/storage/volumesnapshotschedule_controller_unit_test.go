@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+)
+
+var _ = Describe("lastMissedScheduleTime", func() {
+	now := time.Date(2026, 10, 18, 12, 34, 0, 0, time.UTC)
+
+	DescribeTable("returning the most recent missed schedule time",
+		func(spec string, since time.Time, expected time.Time) {
+			schedule, err := cron.ParseStandard(spec)
+			Expect(err).NotTo(HaveOccurred())
+
+			volumeSnapshotSchedule := &storagev1alpha1.VolumeSnapshotSchedule{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(since)},
+			}
+			scheduledTime, ok := lastMissedScheduleTime(schedule, volumeSnapshotSchedule, now)
+			Expect(ok).To(BeTrue())
+			Expect(scheduledTime).To(Equal(expected))
+		},
+		Entry("few missed times",
+			"0 3 * * *", now.Add(-48*time.Hour), time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)),
+		Entry("creation time in another location",
+			"0 3 * * *", now.In(time.FixedZone("UTC+5", 5*60*60)).Add(-12*time.Hour), time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)),
+		Entry("more missed times than are walked",
+			"*/5 * * * *", now.Add(-200*time.Hour), time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)),
+		Entry("more missed times than are walked with an irregular schedule",
+			"* 0 * * *", now.Add(-10*24*time.Hour), time.Date(2026, 10, 18, 0, 59, 0, 0, time.UTC)),
+	)
+
+	It("should report no missed schedule time if the schedule was not due yet", func() {
+		schedule, err := cron.ParseStandard("0 3 * * *")
+		Expect(err).NotTo(HaveOccurred())
+
+		volumeSnapshotSchedule := &storagev1alpha1.VolumeSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+		}
+		_, ok := lastMissedScheduleTime(schedule, volumeSnapshotSchedule, now)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package utils contains helpers shared by the ironcore controllers.
+package utils
+
+import "time"
+
+// MinRequeueAfter returns the smaller non-zero duration of a and b, or zero if both are zero.
+func MinRequeueAfter(a, b time.Duration) time.Duration {
+	switch {
+	case a <= 0:
+		return b
+	case b <= 0:
+		return a
+	default:
+		return min(a, b)
+	}
+}
@@ -108,9 +108,15 @@ func (m *machineEvaluator) Usage(ctx context.Context, item client.Object) (corev
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("machine class %q not found", machineClassName))
 	}
 
-	return corev1alpha1.ResourceList{
+	usage := corev1alpha1.ResourceList{
 		machineCountResourceName:            resource.MustParse("1"),
 		corev1alpha1.ResourceRequestsCPU:    *capabilities.CPU(),
 		corev1alpha1.ResourceRequestsMemory: *capabilities.Memory(),
-	}, nil
+	}
+
+	// A suspended machine retains its memory but does not consume any cpu.
+	if machine.Spec.Power == computev1alpha1.PowerSuspended {
+		delete(usage, corev1alpha1.ResourceRequestsCPU)
+	}
+	return usage, nil
 }
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// evictionRetryAfterSeconds is the number of seconds a client should wait before retrying a rejected eviction.
+	evictionRetryAfterSeconds = 10
+
+	// maxDisruptedMachines is the maximum number of machines that may be tracked as disrupted by a
+	// MachineDisruptionBudget before further evictions are rejected.
+	maxDisruptedMachines = 2000
+)
+
+// EvictionREST implements the eviction subresource of machines. Evicting a machine deletes it if
+// this does not violate the MachineDisruptionBudget selecting the machine.
+type EvictionREST struct {
+	store                          *genericregistry.Store
+	machineDisruptionBudgetLister  rest.Lister
+	machineDisruptionBudgetUpdater rest.Updater
+}
+
+var _ rest.NamedCreater = (*EvictionREST)(nil)
+
+func (r *EvictionREST) New() runtime.Object {
+	return &compute.MachineEviction{}
+}
+
+func (r *EvictionREST) Destroy() {}
+
+func (r *EvictionREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	eviction, ok := obj.(*compute.MachineEviction)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a MachineEviction: %#v", obj))
+	}
+	if eviction.Name != "" && eviction.Name != name {
+		return nil, apierrors.NewBadRequest("name in URL does not match name in MachineEviction object")
+	}
+
+	if createValidation != nil {
+		if err := createValidation(ctx, eviction.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	deleteOptions := &metav1.DeleteOptions{}
+	if eviction.DeleteOptions != nil {
+		deleteOptions = eviction.DeleteOptions.DeepCopy()
+	}
+	if len(options.DryRun) > 0 {
+		deleteOptions.DryRun = options.DryRun
+	}
+
+	obj, err := r.store.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	machine := obj.(*compute.Machine)
+
+	// Machines that are already being deleted or that are not running do not count as available
+	// and can thus be evicted without consulting any disruption budget.
+	if machine.DeletionTimestamp == nil && machine.Status.State == compute.MachineStateRunning {
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			machineDisruptionBudget, err := r.getMachineDisruptionBudget(ctx, machine)
+			if err != nil || machineDisruptionBudget == nil {
+				return err
+			}
+
+			return r.checkAndDecrement(ctx, machine.Name, machineDisruptionBudget, len(deleteOptions.DryRun) > 0)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, _, err := r.store.Delete(ctx, name, rest.ValidateAllObjectFunc, deleteOptions); err != nil {
+		return nil, err
+	}
+	return &metav1.Status{Status: metav1.StatusSuccess}, nil
+}
+
+// getMachineDisruptionBudget returns the MachineDisruptionBudget selecting the machine, if any.
+func (r *EvictionREST) getMachineDisruptionBudget(ctx context.Context, machine *compute.Machine) (*compute.MachineDisruptionBudget, error) {
+	obj, err := r.machineDisruptionBudgetLister.List(ctx, &metainternalversion.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing machine disruption budgets: %w", err)
+	}
+	machineDisruptionBudgetList := obj.(*compute.MachineDisruptionBudgetList)
+
+	var matching []compute.MachineDisruptionBudget
+	for _, machineDisruptionBudget := range machineDisruptionBudgetList.Items {
+		selector, err := metav1.LabelSelectorAsSelector(machineDisruptionBudget.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(machine.Labels)) {
+			matching = append(matching, machineDisruptionBudget)
+		}
+	}
+
+	switch len(matching) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matching[0], nil
+	default:
+		return nil, apierrors.NewInternalError(fmt.Errorf("machine %s is selected by more than one machine disruption budget, which is not supported by the eviction subresource", machine.Name))
+	}
+}
+
+// checkAndDecrement rejects the eviction if the MachineDisruptionBudget does not allow any further
+// disruptions. Otherwise, it records the machine as disrupted and decrements the allowed disruptions.
+func (r *EvictionREST) checkAndDecrement(ctx context.Context, machineName string, machineDisruptionBudget *compute.MachineDisruptionBudget, dryRun bool) error {
+	if machineDisruptionBudget.Status.ObservedGeneration < machineDisruptionBudget.Generation {
+		return apierrors.NewTooManyRequests(
+			fmt.Sprintf("Cannot evict machine as machine disruption budget %s has not been processed yet.", machineDisruptionBudget.Name),
+			evictionRetryAfterSeconds,
+		)
+	}
+	if machineDisruptionBudget.Status.DisruptionsAllowed <= 0 {
+		return apierrors.NewTooManyRequests(
+			fmt.Sprintf("Cannot evict machine as it would violate machine disruption budget %s.", machineDisruptionBudget.Name),
+			evictionRetryAfterSeconds,
+		)
+	}
+	if len(machineDisruptionBudget.Status.DisruptedMachines) >= maxDisruptedMachines {
+		return apierrors.NewForbidden(compute.Resource("machines"), machineName,
+			fmt.Errorf("machine disruption budget %s tracks too many disrupted machines", machineDisruptionBudget.Name),
+		)
+	}
+
+	machineDisruptionBudget.Status.DisruptionsAllowed--
+	if machineDisruptionBudget.Status.DisruptedMachines == nil {
+		machineDisruptionBudget.Status.DisruptedMachines = make(map[string]metav1.Time)
+	}
+	machineDisruptionBudget.Status.DisruptedMachines[machineName] = metav1.Now()
+
+	if dryRun {
+		return nil
+	}
+
+	if _, _, err := r.machineDisruptionBudgetUpdater.Update(ctx,
+		machineDisruptionBudget.Name,
+		rest.DefaultUpdatedObjectInfo(machineDisruptionBudget),
+		rest.ValidateAllObjectFunc,
+		rest.ValidateAllObjectUpdateFunc,
+		false,
+		&metav1.UpdateOptions{},
+	); err != nil {
+		return err
+	}
+	return nil
+}
@@ -9,30 +9,42 @@ import (
 	"net/http"
 	"net/url"
 
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
 	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute/validation"
 	"github.com/ironcore-dev/ironcore/internal/machinepoollet/client"
 	"github.com/ironcore-dev/ironcore/internal/registry/compute/machine"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/proxy"
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	genericrest "k8s.io/apiserver/pkg/registry/generic/rest"
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
 	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
 )
 
 type MachineStorage struct {
-	Machine *REST
-	Status  *StatusREST
-	Exec    *ExecREST
+	Machine    *REST
+	Status     *StatusREST
+	Exec       *ExecREST
+	ConsoleLog *ConsoleLogREST
+	Eviction   *EvictionREST
+	Stats      *StatsREST
 }
 
 type REST struct {
 	*genericregistry.Store
 }
 
-func NewStorage(optsGetter generic.RESTOptionsGetter, k client.ConnectionInfoGetter) (MachineStorage, error) {
+func NewStorage(
+	optsGetter generic.RESTOptionsGetter,
+	k client.ConnectionInfoGetter,
+	machineDisruptionBudgetLister rest.Lister,
+	machineDisruptionBudgetStatusUpdater rest.Updater,
+) (MachineStorage, error) {
 	store := &genericregistry.Store{
 		NewFunc: func() runtime.Object {
 			return &compute.Machine{}
@@ -68,9 +80,12 @@ func NewStorage(optsGetter generic.RESTOptionsGetter, k client.ConnectionInfoGet
 	statusStore.ResetFieldsStrategy = machine.StatusStrategy
 
 	return MachineStorage{
-		Machine: &REST{store},
-		Status:  &StatusREST{&statusStore},
-		Exec:    &ExecREST{store, k},
+		Machine:    &REST{store},
+		Status:     &StatusREST{&statusStore},
+		Exec:       &ExecREST{store, k},
+		ConsoleLog: &ConsoleLogREST{store, k},
+		Stats:      &StatsREST{store, k},
+		Eviction:   &EvictionREST{store, machineDisruptionBudgetLister, machineDisruptionBudgetStatusUpdater},
 	}, nil
 }
 
@@ -136,3 +151,92 @@ func (r *ExecREST) ConnectMethods() []string {
 }
 
 func (r *ExecREST) Destroy() {}
+
+type ConsoleLogREST struct {
+	Store       *genericregistry.Store
+	MachineConn client.ConnectionInfoGetter
+}
+
+var _ rest.GetterWithOptions = (*ConsoleLogREST)(nil)
+
+func (r *ConsoleLogREST) New() runtime.Object {
+	return &compute.Machine{}
+}
+
+func (r *ConsoleLogREST) ProducesMIMETypes(verb string) []string {
+	return []string{"text/plain"}
+}
+
+func (r *ConsoleLogREST) ProducesObject(verb string) interface{} {
+	return ""
+}
+
+func (r *ConsoleLogREST) Get(ctx context.Context, name string, opts runtime.Object) (runtime.Object, error) {
+	consoleLogOpts, ok := opts.(*compute.MachineConsoleLogOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options objects: %#v", opts)
+	}
+
+	if errs := validation.ValidateMachineConsoleLogOptions(consoleLogOpts); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(compute.Kind("MachineConsoleLogOptions"), name, errs)
+	}
+
+	location, transport, err := machine.ConsoleLogLocation(ctx, r.Store, r.MachineConn, name, consoleLogOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genericrest.LocationStreamer{
+		Location:        location,
+		Transport:       transport,
+		ContentType:     "text/plain",
+		Flush:           consoleLogOpts.Follow,
+		ResponseChecker: genericrest.NewGenericHttpResponseChecker(compute.Resource("machines/consolelog"), name),
+		RedirectChecker: genericrest.PreventRedirects,
+	}, nil
+}
+
+func (r *ConsoleLogREST) NewGetOptions() (runtime.Object, bool, string) {
+	return &compute.MachineConsoleLogOptions{}, false, ""
+}
+
+func (r *ConsoleLogREST) Destroy() {}
+
+type StatsREST struct {
+	Store       *genericregistry.Store
+	MachineConn client.ConnectionInfoGetter
+}
+
+var (
+	_ rest.Getter          = (*StatsREST)(nil)
+	_ rest.StorageMetadata = (*StatsREST)(nil)
+)
+
+func (r *StatsREST) New() runtime.Object {
+	return &compute.MachineStats{}
+}
+
+func (r *StatsREST) ProducesMIMETypes(verb string) []string {
+	return []string{"application/json"}
+}
+
+func (r *StatsREST) ProducesObject(verb string) interface{} {
+	return computev1alpha1.MachineStats{}
+}
+
+func (r *StatsREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	location, transport, err := machine.StatsLocation(ctx, r.Store, r.MachineConn, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genericrest.LocationStreamer{
+		Location:        location,
+		Transport:       transport,
+		ContentType:     "application/json",
+		ResponseChecker: genericrest.NewGenericHttpResponseChecker(compute.Resource("machines/stats"), name),
+		RedirectChecker: genericrest.PreventRedirects,
+	}, nil
+}
+
+func (r *StatsREST) Destroy() {}
@@ -9,6 +9,8 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/ironcore-dev/ironcore/internal/api"
 	"github.com/ironcore-dev/ironcore/internal/apis/compute"
@@ -197,6 +199,84 @@ func ExecLocation(
 	return loc, transport, nil
 }
 
+func ConsoleLogLocation(
+	ctx context.Context,
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	name string,
+	opts *compute.MachineConsoleLogOptions,
+) (*url.URL, http.RoundTripper, error) {
+	machine, err := getMachine(ctx, getter, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	machinePoolRef := machine.Spec.MachinePoolRef
+	if machinePoolRef == nil {
+		return nil, nil, apierrors.NewBadRequest(fmt.Sprintf("machine %s has no machine pool assigned", name))
+	}
+
+	machinePoolName := machinePoolRef.Name
+	machinePoolInfo, err := connInfo.GetConnectionInfo(ctx, machinePoolName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	if opts.Follow {
+		params.Add("follow", "true")
+	}
+	if opts.TailLines != nil {
+		params.Add("tailLines", strconv.FormatInt(*opts.TailLines, 10))
+	}
+	if opts.SinceTime != nil {
+		params.Add("sinceTime", opts.SinceTime.Format(time.RFC3339))
+	}
+
+	loc := &url.URL{
+		Scheme:   machinePoolInfo.Scheme,
+		Host:     net.JoinHostPort(machinePoolInfo.Hostname, machinePoolInfo.Port),
+		Path:     fmt.Sprintf("/apis/compute.ironcore.dev/namespaces/%s/machines/%s/consolelog", machine.Namespace, machine.Name),
+		RawQuery: params.Encode(),
+	}
+	transport := machinePoolInfo.Transport
+	if opts.InsecureSkipTLSVerifyBackend {
+		transport = machinePoolInfo.InsecureSkipTLSVerifyTransport
+	}
+
+	return loc, transport, nil
+}
+
+func StatsLocation(
+	ctx context.Context,
+	getter ResourceGetter,
+	connInfo client.ConnectionInfoGetter,
+	name string,
+) (*url.URL, http.RoundTripper, error) {
+	machine, err := getMachine(ctx, getter, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	machinePoolRef := machine.Spec.MachinePoolRef
+	if machinePoolRef == nil {
+		return nil, nil, apierrors.NewBadRequest(fmt.Sprintf("machine %s has no machine pool assigned", name))
+	}
+
+	machinePoolName := machinePoolRef.Name
+	machinePoolInfo, err := connInfo.GetConnectionInfo(ctx, machinePoolName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loc := &url.URL{
+		Scheme: machinePoolInfo.Scheme,
+		Host:   net.JoinHostPort(machinePoolInfo.Hostname, machinePoolInfo.Port),
+		Path:   fmt.Sprintf("/apis/compute.ironcore.dev/namespaces/%s/machines/%s/stats", machine.Namespace, machine.Name),
+	}
+	return loc, machinePoolInfo.Transport, nil
+}
+
 func getMachine(ctx context.Context, getter ResourceGetter, name string) (*compute.Machine, error) {
 	obj, err := getter.Get(ctx, name, &metav1.GetOptions{})
 	if err != nil {
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/utils/ptr"
+)
+
+// ScaleREST implements the scale subresource of machine deployments.
+type ScaleREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Patcher                  = (*ScaleREST)(nil)
+	_ rest.GroupVersionKindProvider = (*ScaleREST)(nil)
+)
+
+func (r *ScaleREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return autoscalingv1.SchemeGroupVersion.WithKind("Scale")
+}
+
+func (r *ScaleREST) New() runtime.Object {
+	return &autoscalingv1.Scale{}
+}
+
+func (r *ScaleREST) Destroy() {}
+
+func (r *ScaleREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := r.store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return scaleFromMachineDeployment(obj.(*compute.MachineDeployment))
+}
+
+func (r *ScaleREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	obj, _, err := r.store.Update(
+		ctx,
+		name,
+		&scaleUpdatedObjectInfo{name: name, reqObjInfo: objInfo},
+		toScaleCreateValidation(createValidation),
+		toScaleUpdateValidation(updateValidation),
+		false,
+		options,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	scale, err := scaleFromMachineDeployment(obj.(*compute.MachineDeployment))
+	if err != nil {
+		return nil, false, apierrors.NewBadRequest(fmt.Sprintf("%v", err))
+	}
+	return scale, false, nil
+}
+
+func toScaleCreateValidation(f rest.ValidateObjectFunc) rest.ValidateObjectFunc {
+	return func(ctx context.Context, obj runtime.Object) error {
+		scale, err := scaleFromMachineDeployment(obj.(*compute.MachineDeployment))
+		if err != nil {
+			return err
+		}
+		return f(ctx, scale)
+	}
+}
+
+func toScaleUpdateValidation(f rest.ValidateObjectUpdateFunc) rest.ValidateObjectUpdateFunc {
+	return func(ctx context.Context, obj, old runtime.Object) error {
+		newScale, err := scaleFromMachineDeployment(obj.(*compute.MachineDeployment))
+		if err != nil {
+			return err
+		}
+		oldScale, err := scaleFromMachineDeployment(old.(*compute.MachineDeployment))
+		if err != nil {
+			return err
+		}
+		return f(ctx, newScale, oldScale)
+	}
+}
+
+func scaleFromMachineDeployment(machineDeployment *compute.MachineDeployment) (*autoscalingv1.Scale, error) {
+	selector, err := metav1.LabelSelectorAsSelector(machineDeployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              machineDeployment.Name,
+			Namespace:         machineDeployment.Namespace,
+			UID:               machineDeployment.UID,
+			ResourceVersion:   machineDeployment.ResourceVersion,
+			CreationTimestamp: machineDeployment.CreationTimestamp,
+		},
+		Spec: autoscalingv1.ScaleSpec{
+			Replicas: ptr.Deref(machineDeployment.Spec.Replicas, 0),
+		},
+		Status: autoscalingv1.ScaleStatus{
+			Replicas: machineDeployment.Status.Replicas,
+			Selector: selector.String(),
+		},
+	}, nil
+}
+
+// scaleUpdatedObjectInfo transforms an update of a scale into an update of the machine deployment.
+type scaleUpdatedObjectInfo struct {
+	name       string
+	reqObjInfo rest.UpdatedObjectInfo
+}
+
+func (i *scaleUpdatedObjectInfo) Preconditions() *metav1.Preconditions {
+	return i.reqObjInfo.Preconditions()
+}
+
+func (i *scaleUpdatedObjectInfo) UpdatedObject(ctx context.Context, oldObj runtime.Object) (runtime.Object, error) {
+	machineDeployment, ok := oldObj.DeepCopyObject().(*compute.MachineDeployment)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected existing object type to be MachineDeployment, got %T", oldObj))
+	}
+
+	oldScale, err := scaleFromMachineDeployment(machineDeployment)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := i.reqObjInfo.UpdatedObject(ctx, oldScale)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, apierrors.NewBadRequest("nil update passed to Scale")
+	}
+	scale, ok := obj.(*autoscalingv1.Scale)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected input object type to be Scale, but %T", obj))
+	}
+
+	if errs := apivalidation.ValidateNonnegativeField(int64(scale.Spec.Replicas), field.NewPath("spec", "replicas")); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(autoscalingv1.SchemeGroupVersion.WithKind("Scale").GroupKind(), i.name, errs)
+	}
+
+	machineDeployment.Spec.Replicas = ptr.To(scale.Spec.Replicas)
+	machineDeployment.ResourceVersion = scale.ResourceVersion
+	return machineDeployment, nil
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Ready", Type: "string", Description: "The number of running out of desired machines."},
+		{Name: "Up-To-Date", Type: "integer", Description: "The number of machines created from the current template."},
+		{Name: "Strategy", Type: "string", Description: "The strategy used to replace machines."},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		machineDeployment := obj.(*compute.MachineDeployment)
+
+		cells = append(cells, name)
+		cells = append(cells, fmt.Sprintf("%d/%d", machineDeployment.Status.ReadyReplicas, ptr.Deref(machineDeployment.Spec.Replicas, 0)))
+		cells = append(cells, machineDeployment.Status.UpdatedReplicas)
+		cells = append(cells, machineDeployment.Spec.Strategy.Type)
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
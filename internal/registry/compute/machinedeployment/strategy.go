@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machinedeployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	machineDeployment, ok := obj.(*compute.MachineDeployment)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a MachineDeployment")
+	}
+	return machineDeployment.Labels, SelectableFields(machineDeployment), nil
+}
+
+func MatchMachineDeployment(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(machineDeployment *compute.MachineDeployment) fields.Set {
+	return generic.ObjectMetaFieldsSet(&machineDeployment.ObjectMeta, true)
+}
+
+type machineDeploymentStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = machineDeploymentStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (machineDeploymentStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (machineDeploymentStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	machineDeployment := obj.(*compute.MachineDeployment)
+	machineDeployment.Status = compute.MachineDeploymentStatus{}
+	machineDeployment.Generation = 1
+}
+
+func (machineDeploymentStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineDeployment := obj.(*compute.MachineDeployment)
+	oldMachineDeployment := old.(*compute.MachineDeployment)
+	newMachineDeployment.Status = oldMachineDeployment.Status
+
+	if !apiequality.Semantic.DeepEqual(newMachineDeployment.Spec, oldMachineDeployment.Spec) {
+		newMachineDeployment.Generation = oldMachineDeployment.Generation + 1
+	}
+}
+
+func (machineDeploymentStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	machineDeployment := obj.(*compute.MachineDeployment)
+	return validation.ValidateMachineDeployment(machineDeployment)
+}
+
+func (machineDeploymentStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (machineDeploymentStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (machineDeploymentStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (machineDeploymentStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (machineDeploymentStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineDeployment := obj.(*compute.MachineDeployment)
+	oldMachineDeployment := old.(*compute.MachineDeployment)
+	return validation.ValidateMachineDeploymentUpdate(newMachineDeployment, oldMachineDeployment)
+}
+
+func (machineDeploymentStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type machineDeploymentStatusStrategy struct {
+	machineDeploymentStrategy
+}
+
+var StatusStrategy = machineDeploymentStatusStrategy{Strategy}
+
+func (machineDeploymentStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"compute.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (machineDeploymentStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineDeployment := obj.(*compute.MachineDeployment)
+	oldMachineDeployment := old.(*compute.MachineDeployment)
+	newMachineDeployment.Spec = oldMachineDeployment.Spec
+}
+
+func (machineDeploymentStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineDeployment := obj.(*compute.MachineDeployment)
+	oldMachineDeployment := old.(*compute.MachineDeployment)
+	return validation.ValidateMachineDeploymentStatusUpdate(newMachineDeployment, oldMachineDeployment)
+}
+
+func (machineDeploymentStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machinedisruptionbudget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	machineDisruptionBudget, ok := obj.(*compute.MachineDisruptionBudget)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a MachineDisruptionBudget")
+	}
+	return machineDisruptionBudget.Labels, SelectableFields(machineDisruptionBudget), nil
+}
+
+func MatchMachineDisruptionBudget(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(machineDisruptionBudget *compute.MachineDisruptionBudget) fields.Set {
+	return generic.ObjectMetaFieldsSet(&machineDisruptionBudget.ObjectMeta, true)
+}
+
+type machineDisruptionBudgetStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = machineDisruptionBudgetStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (machineDisruptionBudgetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (machineDisruptionBudgetStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	machineDisruptionBudget := obj.(*compute.MachineDisruptionBudget)
+	machineDisruptionBudget.Status = compute.MachineDisruptionBudgetStatus{}
+	machineDisruptionBudget.Generation = 1
+}
+
+func (machineDisruptionBudgetStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineDisruptionBudget := obj.(*compute.MachineDisruptionBudget)
+	oldMachineDisruptionBudget := old.(*compute.MachineDisruptionBudget)
+	newMachineDisruptionBudget.Status = oldMachineDisruptionBudget.Status
+
+	if !apiequality.Semantic.DeepEqual(newMachineDisruptionBudget.Spec, oldMachineDisruptionBudget.Spec) {
+		newMachineDisruptionBudget.Generation = oldMachineDisruptionBudget.Generation + 1
+	}
+}
+
+func (machineDisruptionBudgetStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	machineDisruptionBudget := obj.(*compute.MachineDisruptionBudget)
+	return validation.ValidateMachineDisruptionBudget(machineDisruptionBudget)
+}
+
+func (machineDisruptionBudgetStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (machineDisruptionBudgetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (machineDisruptionBudgetStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (machineDisruptionBudgetStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (machineDisruptionBudgetStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineDisruptionBudget := obj.(*compute.MachineDisruptionBudget)
+	oldMachineDisruptionBudget := old.(*compute.MachineDisruptionBudget)
+	return validation.ValidateMachineDisruptionBudgetUpdate(newMachineDisruptionBudget, oldMachineDisruptionBudget)
+}
+
+func (machineDisruptionBudgetStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type machineDisruptionBudgetStatusStrategy struct {
+	machineDisruptionBudgetStrategy
+}
+
+var StatusStrategy = machineDisruptionBudgetStatusStrategy{Strategy}
+
+func (machineDisruptionBudgetStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"compute.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (machineDisruptionBudgetStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineDisruptionBudget := obj.(*compute.MachineDisruptionBudget)
+	oldMachineDisruptionBudget := old.(*compute.MachineDisruptionBudget)
+	newMachineDisruptionBudget.Spec = oldMachineDisruptionBudget.Spec
+}
+
+func (machineDisruptionBudgetStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineDisruptionBudget := obj.(*compute.MachineDisruptionBudget)
+	oldMachineDisruptionBudget := old.(*compute.MachineDisruptionBudget)
+	return validation.ValidateMachineDisruptionBudgetStatusUpdate(newMachineDisruptionBudget, oldMachineDisruptionBudget)
+}
+
+func (machineDisruptionBudgetStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Max-Unhealthy", Type: "string", Description: "The maximum number of unhealthy machines to still remediate."},
+		{Name: "Expected-Machines", Type: "integer", Description: "The number of selected machines."},
+		{Name: "Current-Healthy", Type: "integer", Description: "The number of healthy selected machines."},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		machineHealthCheck := obj.(*compute.MachineHealthCheck)
+
+		cells = append(cells, name)
+		if maxUnhealthy := machineHealthCheck.Spec.MaxUnhealthy; maxUnhealthy != nil {
+			cells = append(cells, maxUnhealthy.String())
+		} else {
+			cells = append(cells, "N/A")
+		}
+		cells = append(cells, machineHealthCheck.Status.ExpectedMachines)
+		cells = append(cells, machineHealthCheck.Status.CurrentHealthy)
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
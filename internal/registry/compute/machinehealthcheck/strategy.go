@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machinehealthcheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	machineHealthCheck, ok := obj.(*compute.MachineHealthCheck)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a MachineHealthCheck")
+	}
+	return machineHealthCheck.Labels, SelectableFields(machineHealthCheck), nil
+}
+
+func MatchMachineHealthCheck(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(machineHealthCheck *compute.MachineHealthCheck) fields.Set {
+	return generic.ObjectMetaFieldsSet(&machineHealthCheck.ObjectMeta, true)
+}
+
+type machineHealthCheckStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = machineHealthCheckStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (machineHealthCheckStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (machineHealthCheckStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	machineHealthCheck := obj.(*compute.MachineHealthCheck)
+	machineHealthCheck.Status = compute.MachineHealthCheckStatus{}
+	machineHealthCheck.Generation = 1
+}
+
+func (machineHealthCheckStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineHealthCheck := obj.(*compute.MachineHealthCheck)
+	oldMachineHealthCheck := old.(*compute.MachineHealthCheck)
+	newMachineHealthCheck.Status = oldMachineHealthCheck.Status
+
+	if !apiequality.Semantic.DeepEqual(newMachineHealthCheck.Spec, oldMachineHealthCheck.Spec) {
+		newMachineHealthCheck.Generation = oldMachineHealthCheck.Generation + 1
+	}
+}
+
+func (machineHealthCheckStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	machineHealthCheck := obj.(*compute.MachineHealthCheck)
+	return validation.ValidateMachineHealthCheck(machineHealthCheck)
+}
+
+func (machineHealthCheckStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (machineHealthCheckStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (machineHealthCheckStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (machineHealthCheckStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (machineHealthCheckStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineHealthCheck := obj.(*compute.MachineHealthCheck)
+	oldMachineHealthCheck := old.(*compute.MachineHealthCheck)
+	return validation.ValidateMachineHealthCheckUpdate(newMachineHealthCheck, oldMachineHealthCheck)
+}
+
+func (machineHealthCheckStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type machineHealthCheckStatusStrategy struct {
+	machineHealthCheckStrategy
+}
+
+var StatusStrategy = machineHealthCheckStatusStrategy{Strategy}
+
+func (machineHealthCheckStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"compute.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (machineHealthCheckStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineHealthCheck := obj.(*compute.MachineHealthCheck)
+	oldMachineHealthCheck := old.(*compute.MachineHealthCheck)
+	newMachineHealthCheck.Spec = oldMachineHealthCheck.Spec
+}
+
+func (machineHealthCheckStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineHealthCheck := obj.(*compute.MachineHealthCheck)
+	oldMachineHealthCheck := old.(*compute.MachineHealthCheck)
+	return validation.ValidateMachineHealthCheckStatusUpdate(newMachineHealthCheck, oldMachineHealthCheck)
+}
+
+func (machineHealthCheckStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
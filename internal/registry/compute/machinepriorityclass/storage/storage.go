@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/registry/compute/machinepriorityclass"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+)
+
+type MachinePriorityClassStorage struct {
+	MachinePriorityClass *REST
+}
+
+type REST struct {
+	*genericregistry.Store
+}
+
+func NewStorage(optsGetter generic.RESTOptionsGetter) (MachinePriorityClassStorage, error) {
+	store := &genericregistry.Store{
+		NewFunc: func() runtime.Object {
+			return &compute.MachinePriorityClass{}
+		},
+		NewListFunc: func() runtime.Object {
+			return &compute.MachinePriorityClassList{}
+		},
+		PredicateFunc:             machinepriorityclass.MatchMachinePriorityClass,
+		DefaultQualifiedResource:  compute.Resource("machinepriorityclasses"),
+		SingularQualifiedResource: compute.Resource("machinepriorityclass"),
+
+		CreateStrategy: machinepriorityclass.Strategy,
+		UpdateStrategy: machinepriorityclass.Strategy,
+		DeleteStrategy: machinepriorityclass.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter, AttrFunc: machinepriorityclass.GetAttrs}
+	if err := store.CompleteWithOptions(options); err != nil {
+		return MachinePriorityClassStorage{}, err
+	}
+
+	return MachinePriorityClassStorage{
+		MachinePriorityClass: &REST{store},
+	}, nil
+}
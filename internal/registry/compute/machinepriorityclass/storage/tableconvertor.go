@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Value", Type: "integer", Description: "Priority of the machines referencing the class."},
+		{Name: "Global-Default", Type: "boolean", Description: "Whether the class is used for machines without a priority class name."},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		machinePriorityClass := obj.(*compute.MachinePriorityClass)
+
+		cells = append(cells, name)
+		cells = append(cells, machinePriorityClass.Value)
+		cells = append(cells, machinePriorityClass.GlobalDefault)
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
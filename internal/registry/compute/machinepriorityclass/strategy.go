@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machinepriorityclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute/validation"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	machinePriorityClass, ok := obj.(*compute.MachinePriorityClass)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a MachinePriorityClass")
+	}
+	return machinePriorityClass.Labels, SelectableFields(machinePriorityClass), nil
+}
+
+func MatchMachinePriorityClass(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(machinePriorityClass *compute.MachinePriorityClass) fields.Set {
+	return generic.ObjectMetaFieldsSet(&machinePriorityClass.ObjectMeta, false)
+}
+
+type machinePriorityClassStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = machinePriorityClassStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (machinePriorityClassStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (machinePriorityClassStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	machinePriorityClass := obj.(*compute.MachinePriorityClass)
+	machinePriorityClass.Generation = 1
+}
+
+func (machinePriorityClassStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachinePriorityClass := obj.(*compute.MachinePriorityClass)
+	oldMachinePriorityClass := old.(*compute.MachinePriorityClass)
+
+	if newMachinePriorityClass.Value != oldMachinePriorityClass.Value ||
+		newMachinePriorityClass.GlobalDefault != oldMachinePriorityClass.GlobalDefault ||
+		newMachinePriorityClass.Description != oldMachinePriorityClass.Description {
+		newMachinePriorityClass.Generation = oldMachinePriorityClass.Generation + 1
+	}
+}
+
+func (machinePriorityClassStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	machinePriorityClass := obj.(*compute.MachinePriorityClass)
+	return validation.ValidateMachinePriorityClass(machinePriorityClass)
+}
+
+func (machinePriorityClassStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (machinePriorityClassStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (machinePriorityClassStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (machinePriorityClassStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (machinePriorityClassStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachinePriorityClass := obj.(*compute.MachinePriorityClass)
+	oldMachinePriorityClass := old.(*compute.MachinePriorityClass)
+	return validation.ValidateMachinePriorityClassUpdate(newMachinePriorityClass, oldMachinePriorityClass)
+}
+
+func (machinePriorityClassStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machineset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute"
+	"github.com/ironcore-dev/ironcore/internal/apis/compute/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	machineSet, ok := obj.(*compute.MachineSet)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a MachineSet")
+	}
+	return machineSet.Labels, SelectableFields(machineSet), nil
+}
+
+func MatchMachineSet(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(machineSet *compute.MachineSet) fields.Set {
+	return generic.ObjectMetaFieldsSet(&machineSet.ObjectMeta, true)
+}
+
+type machineSetStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = machineSetStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (machineSetStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (machineSetStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	machineSet := obj.(*compute.MachineSet)
+	machineSet.Status = compute.MachineSetStatus{}
+	machineSet.Generation = 1
+}
+
+func (machineSetStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineSet := obj.(*compute.MachineSet)
+	oldMachineSet := old.(*compute.MachineSet)
+	newMachineSet.Status = oldMachineSet.Status
+
+	if !apiequality.Semantic.DeepEqual(newMachineSet.Spec, oldMachineSet.Spec) {
+		newMachineSet.Generation = oldMachineSet.Generation + 1
+	}
+}
+
+func (machineSetStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	machineSet := obj.(*compute.MachineSet)
+	return validation.ValidateMachineSet(machineSet)
+}
+
+func (machineSetStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (machineSetStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (machineSetStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (machineSetStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (machineSetStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineSet := obj.(*compute.MachineSet)
+	oldMachineSet := old.(*compute.MachineSet)
+	return validation.ValidateMachineSetUpdate(newMachineSet, oldMachineSet)
+}
+
+func (machineSetStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type machineSetStatusStrategy struct {
+	machineSetStrategy
+}
+
+var StatusStrategy = machineSetStatusStrategy{Strategy}
+
+func (machineSetStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"compute.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (machineSetStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newMachineSet := obj.(*compute.MachineSet)
+	oldMachineSet := old.(*compute.MachineSet)
+	newMachineSet.Spec = oldMachineSet.Spec
+}
+
+func (machineSetStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newMachineSet := obj.(*compute.MachineSet)
+	oldMachineSet := old.(*compute.MachineSet)
+	return validation.ValidateMachineSetStatusUpdate(newMachineSet, oldMachineSet)
+}
+
+func (machineSetStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
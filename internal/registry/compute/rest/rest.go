@@ -10,7 +10,12 @@ import (
 	machinepoolletclient "github.com/ironcore-dev/ironcore/internal/machinepoollet/client"
 	machinestorage "github.com/ironcore-dev/ironcore/internal/registry/compute/machine/storage"
 	machineclassstore "github.com/ironcore-dev/ironcore/internal/registry/compute/machineclass/storage"
+	machinedeploymentstorage "github.com/ironcore-dev/ironcore/internal/registry/compute/machinedeployment/storage"
+	machinedisruptionbudgetstorage "github.com/ironcore-dev/ironcore/internal/registry/compute/machinedisruptionbudget/storage"
+	machinehealthcheckstorage "github.com/ironcore-dev/ironcore/internal/registry/compute/machinehealthcheck/storage"
 	machinepoolstorage "github.com/ironcore-dev/ironcore/internal/registry/compute/machinepool/storage"
+	machinepriorityclassstorage "github.com/ironcore-dev/ironcore/internal/registry/compute/machinepriorityclass/storage"
+	machinesetstorage "github.com/ironcore-dev/ironcore/internal/registry/compute/machineset/storage"
 	ironcoreserializer "github.com/ironcore-dev/ironcore/internal/serializer"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -51,6 +56,13 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 
 	storageMap["machineclasses"] = machineClassStorage.MachineClass
 
+	machinePriorityClassStorage, err := machinepriorityclassstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["machinepriorityclasses"] = machinePriorityClassStorage.MachinePriorityClass
+
 	machinePoolStorage, err := machinepoolstorage.NewStorage(restOptionsGetter, p.MachinePoolletClientConfig)
 	if err != nil {
 		return storageMap, err
@@ -59,7 +71,28 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["machinepools"] = machinePoolStorage.MachinePool
 	storageMap["machinepools/status"] = machinePoolStorage.Status
 
-	machineStorage, err := machinestorage.NewStorage(restOptionsGetter, machinePoolStorage.MachinePoolletConnectionInfo)
+	machineDisruptionBudgetStorage, err := machinedisruptionbudgetstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["machinedisruptionbudgets"] = machineDisruptionBudgetStorage.MachineDisruptionBudget
+	storageMap["machinedisruptionbudgets/status"] = machineDisruptionBudgetStorage.Status
+
+	machineHealthCheckStorage, err := machinehealthcheckstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["machinehealthchecks"] = machineHealthCheckStorage.MachineHealthCheck
+	storageMap["machinehealthchecks/status"] = machineHealthCheckStorage.Status
+
+	machineStorage, err := machinestorage.NewStorage(
+		restOptionsGetter,
+		machinePoolStorage.MachinePoolletConnectionInfo,
+		machineDisruptionBudgetStorage.MachineDisruptionBudget,
+		machineDisruptionBudgetStorage.Status,
+	)
 	if err != nil {
 		return storageMap, err
 	}
@@ -67,6 +100,27 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["machines"] = machineStorage.Machine
 	storageMap["machines/status"] = machineStorage.Status
 	storageMap["machines/exec"] = machineStorage.Exec
+	storageMap["machines/consolelog"] = machineStorage.ConsoleLog
+	storageMap["machines/stats"] = machineStorage.Stats
+	storageMap["machines/eviction"] = machineStorage.Eviction
+
+	machineSetStorage, err := machinesetstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["machinesets"] = machineSetStorage.MachineSet
+	storageMap["machinesets/status"] = machineSetStorage.Status
+	storageMap["machinesets/scale"] = machineSetStorage.Scale
+
+	machineDeploymentStorage, err := machinedeploymentstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["machinedeployments"] = machineDeploymentStorage.MachineDeployment
+	storageMap["machinedeployments/status"] = machineDeploymentStorage.Status
+	storageMap["machinedeployments/scale"] = machineDeploymentStorage.Scale
 
 	return storageMap, nil
 }
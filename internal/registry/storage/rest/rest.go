@@ -11,9 +11,13 @@ import (
 	bucketclassstore "github.com/ironcore-dev/ironcore/internal/registry/storage/bucketclass/storage"
 	bucketpoolstorage "github.com/ironcore-dev/ironcore/internal/registry/storage/bucketpool/storage"
 	volumestorage "github.com/ironcore-dev/ironcore/internal/registry/storage/volume/storage"
+	volumebackupstorage "github.com/ironcore-dev/ironcore/internal/registry/storage/volumebackup/storage"
 	volumeclassstore "github.com/ironcore-dev/ironcore/internal/registry/storage/volumeclass/storage"
 	volumepoolstorage "github.com/ironcore-dev/ironcore/internal/registry/storage/volumepool/storage"
 	volumesnapshotstorage "github.com/ironcore-dev/ironcore/internal/registry/storage/volumesnapshot/storage"
+	volumesnapshotgroupstorage "github.com/ironcore-dev/ironcore/internal/registry/storage/volumesnapshotgroup/storage"
+	volumesnapshotgrouprestorestorage "github.com/ironcore-dev/ironcore/internal/registry/storage/volumesnapshotgrouprestore/storage"
+	volumesnapshotschedulestorage "github.com/ironcore-dev/ironcore/internal/registry/storage/volumesnapshotschedule/storage"
 	ironcoreserializer "github.com/ironcore-dev/ironcore/internal/serializer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/registry/generic"
@@ -100,5 +104,37 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storageMap["volumesnapshots"] = volumeSnapshotStorage.VolumeSnapshot
 	storageMap["volumesnapshots/status"] = volumeSnapshotStorage.Status
 
+	volumeSnapshotScheduleStorage, err := volumesnapshotschedulestorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["volumesnapshotschedules"] = volumeSnapshotScheduleStorage.VolumeSnapshotSchedule
+	storageMap["volumesnapshotschedules/status"] = volumeSnapshotScheduleStorage.Status
+
+	volumeSnapshotGroupStorage, err := volumesnapshotgroupstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["volumesnapshotgroups"] = volumeSnapshotGroupStorage.VolumeSnapshotGroup
+	storageMap["volumesnapshotgroups/status"] = volumeSnapshotGroupStorage.Status
+
+	volumeSnapshotGroupRestoreStorage, err := volumesnapshotgrouprestorestorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["volumesnapshotgrouprestores"] = volumeSnapshotGroupRestoreStorage.VolumeSnapshotGroupRestore
+	storageMap["volumesnapshotgrouprestores/status"] = volumeSnapshotGroupRestoreStorage.Status
+
+	volumeBackupStorage, err := volumebackupstorage.NewStorage(restOptionsGetter)
+	if err != nil {
+		return storageMap, err
+	}
+
+	storageMap["volumebackups"] = volumeBackupStorage.VolumeBackup
+	storageMap["volumebackups/status"] = volumeBackupStorage.Status
+
 	return storageMap, nil
 }
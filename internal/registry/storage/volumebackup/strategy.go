@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volumebackup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	volumeBackup, ok := obj.(*storage.VolumeBackup)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a VolumeBackup")
+	}
+	return volumeBackup.Labels, SelectableFields(volumeBackup), nil
+}
+
+func MatchVolumeBackup(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(volumeBackup *storage.VolumeBackup) fields.Set {
+	return generic.ObjectMetaFieldsSet(&volumeBackup.ObjectMeta, true)
+}
+
+type volumeBackupStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = volumeBackupStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (volumeBackupStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (volumeBackupStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	volumeBackup := obj.(*storage.VolumeBackup)
+	volumeBackup.Status = storage.VolumeBackupStatus{}
+	volumeBackup.Generation = 1
+}
+
+func (volumeBackupStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeBackup := obj.(*storage.VolumeBackup)
+	oldVolumeBackup := old.(*storage.VolumeBackup)
+	newVolumeBackup.Status = oldVolumeBackup.Status
+
+	if !apiequality.Semantic.DeepEqual(newVolumeBackup.Spec, oldVolumeBackup.Spec) {
+		newVolumeBackup.Generation = oldVolumeBackup.Generation + 1
+	}
+}
+
+func (volumeBackupStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	volumeBackup := obj.(*storage.VolumeBackup)
+	return validation.ValidateVolumeBackup(volumeBackup)
+}
+
+func (volumeBackupStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (volumeBackupStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (volumeBackupStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (volumeBackupStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (volumeBackupStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeBackup := obj.(*storage.VolumeBackup)
+	oldVolumeBackup := old.(*storage.VolumeBackup)
+	return validation.ValidateVolumeBackupUpdate(newVolumeBackup, oldVolumeBackup)
+}
+
+func (volumeBackupStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type volumeBackupStatusStrategy struct {
+	volumeBackupStrategy
+}
+
+var StatusStrategy = volumeBackupStatusStrategy{Strategy}
+
+func (volumeBackupStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"storage.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (volumeBackupStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeBackup := obj.(*storage.VolumeBackup)
+	oldVolumeBackup := old.(*storage.VolumeBackup)
+	newVolumeBackup.Spec = oldVolumeBackup.Spec
+}
+
+func (volumeBackupStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeBackup := obj.(*storage.VolumeBackup)
+	oldVolumeBackup := old.(*storage.VolumeBackup)
+	return validation.ValidateVolumeBackupStatusUpdate(newVolumeBackup, oldVolumeBackup)
+}
+
+func (volumeBackupStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
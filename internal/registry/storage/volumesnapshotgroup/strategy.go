@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volumesnapshotgroup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	volumeSnapshotGroup, ok := obj.(*storage.VolumeSnapshotGroup)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a VolumeSnapshotGroup")
+	}
+	return volumeSnapshotGroup.Labels, SelectableFields(volumeSnapshotGroup), nil
+}
+
+func MatchVolumeSnapshotGroup(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(volumeSnapshotGroup *storage.VolumeSnapshotGroup) fields.Set {
+	return generic.ObjectMetaFieldsSet(&volumeSnapshotGroup.ObjectMeta, true)
+}
+
+type volumeSnapshotGroupStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = volumeSnapshotGroupStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (volumeSnapshotGroupStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (volumeSnapshotGroupStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	volumeSnapshotGroup := obj.(*storage.VolumeSnapshotGroup)
+	volumeSnapshotGroup.Status = storage.VolumeSnapshotGroupStatus{}
+	volumeSnapshotGroup.Generation = 1
+}
+
+func (volumeSnapshotGroupStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeSnapshotGroup := obj.(*storage.VolumeSnapshotGroup)
+	oldVolumeSnapshotGroup := old.(*storage.VolumeSnapshotGroup)
+	newVolumeSnapshotGroup.Status = oldVolumeSnapshotGroup.Status
+
+	if !apiequality.Semantic.DeepEqual(newVolumeSnapshotGroup.Spec, oldVolumeSnapshotGroup.Spec) {
+		newVolumeSnapshotGroup.Generation = oldVolumeSnapshotGroup.Generation + 1
+	}
+}
+
+func (volumeSnapshotGroupStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	volumeSnapshotGroup := obj.(*storage.VolumeSnapshotGroup)
+	return validation.ValidateVolumeSnapshotGroup(volumeSnapshotGroup)
+}
+
+func (volumeSnapshotGroupStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (volumeSnapshotGroupStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (volumeSnapshotGroupStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (volumeSnapshotGroupStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (volumeSnapshotGroupStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeSnapshotGroup := obj.(*storage.VolumeSnapshotGroup)
+	oldVolumeSnapshotGroup := old.(*storage.VolumeSnapshotGroup)
+	return validation.ValidateVolumeSnapshotGroupUpdate(newVolumeSnapshotGroup, oldVolumeSnapshotGroup)
+}
+
+func (volumeSnapshotGroupStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type volumeSnapshotGroupStatusStrategy struct {
+	volumeSnapshotGroupStrategy
+}
+
+var StatusStrategy = volumeSnapshotGroupStatusStrategy{Strategy}
+
+func (volumeSnapshotGroupStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"storage.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (volumeSnapshotGroupStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeSnapshotGroup := obj.(*storage.VolumeSnapshotGroup)
+	oldVolumeSnapshotGroup := old.(*storage.VolumeSnapshotGroup)
+	newVolumeSnapshotGroup.Spec = oldVolumeSnapshotGroup.Spec
+}
+
+func (volumeSnapshotGroupStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeSnapshotGroup := obj.(*storage.VolumeSnapshotGroup)
+	oldVolumeSnapshotGroup := old.(*storage.VolumeSnapshotGroup)
+	return validation.ValidateVolumeSnapshotGroupStatusUpdate(newVolumeSnapshotGroup, oldVolumeSnapshotGroup)
+}
+
+func (volumeSnapshotGroupStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
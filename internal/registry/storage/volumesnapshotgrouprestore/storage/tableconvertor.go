@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "VolumeSnapshotGroupRef", Type: "string", Description: "The volume snapshot group to restore"},
+		{Name: "Volumes", Type: "integer", Description: "The number of restored volumes"},
+		{Name: "State", Type: "string", Description: "The state of the volume snapshot group restore"},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		volumeSnapshotGroupRestore := obj.(*storage.VolumeSnapshotGroupRestore)
+
+		cells = append(cells, name)
+		cells = append(cells, volumeSnapshotGroupRestore.Spec.VolumeSnapshotGroupRef.Name)
+		cells = append(cells, len(volumeSnapshotGroupRestore.Status.Volumes))
+		if state := volumeSnapshotGroupRestore.Status.State; state != "" {
+			cells = append(cells, state)
+		} else {
+			cells = append(cells, "<unknown>")
+		}
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volumesnapshotgrouprestore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	volumeSnapshotGroupRestore, ok := obj.(*storage.VolumeSnapshotGroupRestore)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a VolumeSnapshotGroupRestore")
+	}
+	return volumeSnapshotGroupRestore.Labels, SelectableFields(volumeSnapshotGroupRestore), nil
+}
+
+func MatchVolumeSnapshotGroupRestore(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(volumeSnapshotGroupRestore *storage.VolumeSnapshotGroupRestore) fields.Set {
+	return generic.ObjectMetaFieldsSet(&volumeSnapshotGroupRestore.ObjectMeta, true)
+}
+
+type volumeSnapshotGroupRestoreStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = volumeSnapshotGroupRestoreStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (volumeSnapshotGroupRestoreStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (volumeSnapshotGroupRestoreStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	volumeSnapshotGroupRestore := obj.(*storage.VolumeSnapshotGroupRestore)
+	volumeSnapshotGroupRestore.Status = storage.VolumeSnapshotGroupRestoreStatus{}
+	volumeSnapshotGroupRestore.Generation = 1
+}
+
+func (volumeSnapshotGroupRestoreStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeSnapshotGroupRestore := obj.(*storage.VolumeSnapshotGroupRestore)
+	oldVolumeSnapshotGroupRestore := old.(*storage.VolumeSnapshotGroupRestore)
+	newVolumeSnapshotGroupRestore.Status = oldVolumeSnapshotGroupRestore.Status
+
+	if !apiequality.Semantic.DeepEqual(newVolumeSnapshotGroupRestore.Spec, oldVolumeSnapshotGroupRestore.Spec) {
+		newVolumeSnapshotGroupRestore.Generation = oldVolumeSnapshotGroupRestore.Generation + 1
+	}
+}
+
+func (volumeSnapshotGroupRestoreStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	volumeSnapshotGroupRestore := obj.(*storage.VolumeSnapshotGroupRestore)
+	return validation.ValidateVolumeSnapshotGroupRestore(volumeSnapshotGroupRestore)
+}
+
+func (volumeSnapshotGroupRestoreStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (volumeSnapshotGroupRestoreStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (volumeSnapshotGroupRestoreStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (volumeSnapshotGroupRestoreStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (volumeSnapshotGroupRestoreStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeSnapshotGroupRestore := obj.(*storage.VolumeSnapshotGroupRestore)
+	oldVolumeSnapshotGroupRestore := old.(*storage.VolumeSnapshotGroupRestore)
+	return validation.ValidateVolumeSnapshotGroupRestoreUpdate(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore)
+}
+
+func (volumeSnapshotGroupRestoreStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type volumeSnapshotGroupRestoreStatusStrategy struct {
+	volumeSnapshotGroupRestoreStrategy
+}
+
+var StatusStrategy = volumeSnapshotGroupRestoreStatusStrategy{Strategy}
+
+func (volumeSnapshotGroupRestoreStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"storage.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (volumeSnapshotGroupRestoreStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeSnapshotGroupRestore := obj.(*storage.VolumeSnapshotGroupRestore)
+	oldVolumeSnapshotGroupRestore := old.(*storage.VolumeSnapshotGroupRestore)
+	newVolumeSnapshotGroupRestore.Spec = oldVolumeSnapshotGroupRestore.Spec
+}
+
+func (volumeSnapshotGroupRestoreStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeSnapshotGroupRestore := obj.(*storage.VolumeSnapshotGroupRestore)
+	oldVolumeSnapshotGroupRestore := old.(*storage.VolumeSnapshotGroupRestore)
+	return validation.ValidateVolumeSnapshotGroupRestoreStatusUpdate(newVolumeSnapshotGroupRestore, oldVolumeSnapshotGroupRestore)
+}
+
+func (volumeSnapshotGroupRestoreStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+type convertor struct{}
+
+var (
+	objectMetaSwaggerDoc = metav1.ObjectMeta{}.SwaggerDoc()
+
+	headers = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: objectMetaSwaggerDoc["name"]},
+		{Name: "Schedule", Type: "string", Description: "The schedule in cron format."},
+		{Name: "Suspend", Type: "boolean", Description: "Whether the creation of volume snapshots is suspended."},
+		{Name: "Last-Schedule", Type: "string", Description: "The time since the last schedule."},
+		{Name: "Last-Success", Type: "string", Description: "The time since the last successful schedule."},
+		{Name: "Age", Type: "string", Format: "date", Description: objectMetaSwaggerDoc["creationTimestamp"]},
+	}
+)
+
+func newTableConvertor() *convertor {
+	return &convertor{}
+}
+
+func sinceOrNone(t *metav1.Time) string {
+	if t == nil {
+		return "<none>"
+	}
+	return duration.HumanDuration(metav1.Now().Sub(t.Time))
+}
+
+func (c *convertor) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tab := &metav1.Table{
+		ColumnDefinitions: headers,
+	}
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		tab.ResourceVersion = m.GetResourceVersion()
+		tab.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			tab.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	tab.Rows, err = table.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) (cells []interface{}, err error) {
+		volumeSnapshotSchedule := obj.(*storage.VolumeSnapshotSchedule)
+
+		cells = append(cells, name)
+		cells = append(cells, volumeSnapshotSchedule.Spec.Schedule)
+		cells = append(cells, volumeSnapshotSchedule.Spec.Suspend)
+		cells = append(cells, sinceOrNone(volumeSnapshotSchedule.Status.LastScheduleTime))
+		cells = append(cells, sinceOrNone(volumeSnapshotSchedule.Status.LastSuccessfulTime))
+		cells = append(cells, age)
+
+		return cells, nil
+	})
+	return tab, err
+}
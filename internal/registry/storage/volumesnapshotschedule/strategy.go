@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volumesnapshotschedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ironcore-dev/ironcore/internal/api"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage"
+	"github.com/ironcore-dev/ironcore/internal/apis/storage/validation"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	apisrvstorage "k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	volumeSnapshotSchedule, ok := obj.(*storage.VolumeSnapshotSchedule)
+	if !ok {
+		return nil, nil, fmt.Errorf("given object is not a VolumeSnapshotSchedule")
+	}
+	return volumeSnapshotSchedule.Labels, SelectableFields(volumeSnapshotSchedule), nil
+}
+
+func MatchVolumeSnapshotSchedule(label labels.Selector, field fields.Selector) apisrvstorage.SelectionPredicate {
+	return apisrvstorage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
+
+func SelectableFields(volumeSnapshotSchedule *storage.VolumeSnapshotSchedule) fields.Set {
+	return generic.ObjectMetaFieldsSet(&volumeSnapshotSchedule.ObjectMeta, true)
+}
+
+type volumeSnapshotScheduleStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var Strategy = volumeSnapshotScheduleStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (volumeSnapshotScheduleStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (volumeSnapshotScheduleStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	volumeSnapshotSchedule := obj.(*storage.VolumeSnapshotSchedule)
+	volumeSnapshotSchedule.Status = storage.VolumeSnapshotScheduleStatus{}
+	volumeSnapshotSchedule.Generation = 1
+}
+
+func (volumeSnapshotScheduleStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeSnapshotSchedule := obj.(*storage.VolumeSnapshotSchedule)
+	oldVolumeSnapshotSchedule := old.(*storage.VolumeSnapshotSchedule)
+	newVolumeSnapshotSchedule.Status = oldVolumeSnapshotSchedule.Status
+
+	if !apiequality.Semantic.DeepEqual(newVolumeSnapshotSchedule.Spec, oldVolumeSnapshotSchedule.Spec) {
+		newVolumeSnapshotSchedule.Generation = oldVolumeSnapshotSchedule.Generation + 1
+	}
+}
+
+func (volumeSnapshotScheduleStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	volumeSnapshotSchedule := obj.(*storage.VolumeSnapshotSchedule)
+	return validation.ValidateVolumeSnapshotSchedule(volumeSnapshotSchedule)
+}
+
+func (volumeSnapshotScheduleStrategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+func (volumeSnapshotScheduleStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (volumeSnapshotScheduleStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+func (volumeSnapshotScheduleStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (volumeSnapshotScheduleStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeSnapshotSchedule := obj.(*storage.VolumeSnapshotSchedule)
+	oldVolumeSnapshotSchedule := old.(*storage.VolumeSnapshotSchedule)
+	return validation.ValidateVolumeSnapshotScheduleUpdate(newVolumeSnapshotSchedule, oldVolumeSnapshotSchedule)
+}
+
+func (volumeSnapshotScheduleStrategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+type volumeSnapshotScheduleStatusStrategy struct {
+	volumeSnapshotScheduleStrategy
+}
+
+var StatusStrategy = volumeSnapshotScheduleStatusStrategy{Strategy}
+
+func (volumeSnapshotScheduleStatusStrategy) GetResetFields() map[fieldpath.APIVersion]*fieldpath.Set {
+	return map[fieldpath.APIVersion]*fieldpath.Set{
+		"storage.ironcore.dev/v1alpha1": fieldpath.NewSet(
+			fieldpath.MakePathOrDie("spec"),
+		),
+	}
+}
+
+func (volumeSnapshotScheduleStatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newVolumeSnapshotSchedule := obj.(*storage.VolumeSnapshotSchedule)
+	oldVolumeSnapshotSchedule := old.(*storage.VolumeSnapshotSchedule)
+	newVolumeSnapshotSchedule.Spec = oldVolumeSnapshotSchedule.Spec
+}
+
+func (volumeSnapshotScheduleStatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newVolumeSnapshotSchedule := obj.(*storage.VolumeSnapshotSchedule)
+	oldVolumeSnapshotSchedule := old.(*storage.VolumeSnapshotSchedule)
+	return validation.ValidateVolumeSnapshotScheduleStatusUpdate(newVolumeSnapshotSchedule, oldVolumeSnapshotSchedule)
+}
+
+func (volumeSnapshotScheduleStatusStrategy) WarningsOnUpdate(cxt context.Context, obj, old runtime.Object) []string {
+	return nil
+}
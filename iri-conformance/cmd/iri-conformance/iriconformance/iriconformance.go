@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package iriconformance implements a command running the IRI conformance specs against a runtime
+// served via gRPC.
+package iriconformance
+
+import (
+	"errors"
+	goflag "flag"
+	"fmt"
+	"strings"
+
+	remotebucket "github.com/ironcore-dev/ironcore/iri/remote/bucket"
+	remotemachine "github.com/ironcore-dev/ironcore/iri/remote/machine"
+	remotevolume "github.com/ironcore-dev/ironcore/iri/remote/volume"
+	"github.com/ironcore-dev/ironcore/iri/testing/conformance"
+	"github.com/onsi/ginkgo/v2"
+	"github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type Options struct {
+	Address string
+	conformance.Config
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Address, "address", "", "Address of the iri server, e.g. unix:///var/run/iri.sock.")
+	fs.DurationVar(&o.Timeout, "timeout", conformance.DefaultTimeout, "Time the runtime may take until changes show up in list responses.")
+	fs.DurationVar(&o.ReadyTimeout, "ready-timeout", 0, "Time the runtime may take until created objects are ready. If zero, readiness is not verified.")
+}
+
+func Command() *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:   "iri-conformance",
+		Short: "Verify an iri runtime against the iri conformance specs.",
+		Long: `Verify an iri runtime against the iri conformance specs.
+
+The specs create, modify and delete objects in the runtime. All objects created by
+the specs are labeled with ` + conformance.ScopeLabel + ` and deleted afterward.
+Ginkgo flags, e.g. --ginkgo.focus or --ginkgo.v, can be used to control the run.`,
+		SilenceUsage: true,
+	}
+
+	opts.AddFlags(cmd.PersistentFlags())
+	addGinkgoFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(
+		machineCommand(&opts),
+		volumeCommand(&opts),
+		bucketCommand(&opts),
+	)
+
+	return cmd
+}
+
+// addGinkgoFlags adds the flags Ginkgo registered on the go command line flag set.
+func addGinkgoFlags(fs *pflag.FlagSet) {
+	goflag.CommandLine.VisitAll(func(f *goflag.Flag) {
+		if strings.HasPrefix(f.Name, "ginkgo.") {
+			fs.AddGoFlag(f)
+		}
+	})
+}
+
+func machineCommand(opts *Options) *cobra.Command {
+	var machineClass string
+
+	cmd := &cobra.Command{
+		Use:   "machine",
+		Short: "Verify an iri machine runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runtime, err := remotemachine.NewRemoteRuntime(opts.Address)
+			if err != nil {
+				return fmt.Errorf("error creating remote machine runtime: %w", err)
+			}
+
+			conformance.DescribeMachineRuntime(func() conformance.MachineRuntimeConfig {
+				return conformance.MachineRuntimeConfig{
+					Config:       opts.Config,
+					Runtime:      runtime,
+					MachineClass: machineClass,
+				}
+			})
+			return run("Machine Runtime Conformance")
+		},
+	}
+
+	cmd.Flags().StringVar(&machineClass, "machine-class", "", "Class of the machines to create.")
+	_ = cmd.MarkFlagRequired("machine-class")
+
+	return cmd
+}
+
+func volumeCommand(opts *Options) *cobra.Command {
+	var (
+		volumeClass  string
+		storageBytes int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "volume",
+		Short: "Verify an iri volume runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runtime, err := remotevolume.NewRemoteRuntime(opts.Address)
+			if err != nil {
+				return fmt.Errorf("error creating remote volume runtime: %w", err)
+			}
+
+			conformance.DescribeVolumeRuntime(func() conformance.VolumeRuntimeConfig {
+				return conformance.VolumeRuntimeConfig{
+					Config:       opts.Config,
+					Runtime:      runtime,
+					VolumeClass:  volumeClass,
+					StorageBytes: storageBytes,
+				}
+			})
+			return run("Volume Runtime Conformance")
+		},
+	}
+
+	cmd.Flags().StringVar(&volumeClass, "volume-class", "", "Class of the volumes to create.")
+	cmd.Flags().Int64Var(&storageBytes, "storage-bytes", conformance.DefaultVolumeStorageBytes, "Size of the volumes to create.")
+	_ = cmd.MarkFlagRequired("volume-class")
+
+	return cmd
+}
+
+func bucketCommand(opts *Options) *cobra.Command {
+	var bucketClass string
+
+	cmd := &cobra.Command{
+		Use:   "bucket",
+		Short: "Verify an iri bucket runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			runtime, err := remotebucket.NewRemoteRuntime(opts.Address)
+			if err != nil {
+				return fmt.Errorf("error creating remote bucket runtime: %w", err)
+			}
+
+			conformance.DescribeBucketRuntime(func() conformance.BucketRuntimeConfig {
+				return conformance.BucketRuntimeConfig{
+					Config:      opts.Config,
+					Runtime:     runtime,
+					BucketClass: bucketClass,
+				}
+			})
+			return run("Bucket Runtime Conformance")
+		},
+	}
+
+	cmd.Flags().StringVar(&bucketClass, "bucket-class", "", "Class of the buckets to create.")
+	_ = cmd.MarkFlagRequired("bucket-class")
+
+	return cmd
+}
+
+// failer records failures of the specs. Ginkgo reports the details of the failures itself.
+type failer struct {
+	failed bool
+}
+
+func (f *failer) Fail() {
+	f.failed = true
+}
+
+func run(description string) error {
+	gomega.RegisterFailHandler(ginkgo.Fail)
+
+	f := &failer{}
+	if !ginkgo.RunSpecs(f, description) || f.failed {
+		return errors.New("conformance specs failed")
+	}
+	return nil
+}
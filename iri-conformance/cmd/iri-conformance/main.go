@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	"github.com/ironcore-dev/ironcore/iri-conformance/cmd/iri-conformance/iriconformance"
+)
+
+func main() {
+	if err := iriconformance.Command().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package irifake
+
+import (
+	"fmt"
+	"strings"
+
+	iribucket "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irivolume "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/bucket"
+	"github.com/ironcore-dev/ironcore/iri/testing/machine"
+	"github.com/ironcore-dev/ironcore/iri/testing/volume"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// parseQuantities parses quantities in the format <key>=<value>;...
+func parseQuantities(s string) (map[string]int64, error) {
+	res := make(map[string]int64)
+	if s == "" {
+		return res, nil
+	}
+
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid quantity %q, expected <key>=<value>", part)
+		}
+
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q: %w", part, err)
+		}
+		res[key] = quantity.Value()
+	}
+	return res, nil
+}
+
+// parseClass splits a class in the format <name>[:<field>...] into its name and fields.
+func parseClass(s string, maxFields int) (string, []string, error) {
+	parts := strings.Split(s, ":")
+	if parts[0] == "" || len(parts)-1 > maxFields {
+		return "", nil, fmt.Errorf("invalid class %q", s)
+	}
+	return parts[0], parts[1:], nil
+}
+
+func parseQuantity(class, s string) (int64, error) {
+	quantity, err := resource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantity of class %q: %w", class, err)
+	}
+	return quantity.Value(), nil
+}
+
+func parseMachineClasses(classes []string) ([]*machine.FakeMachineClassStatus, error) {
+	var res []*machine.FakeMachineClassStatus
+	for _, class := range classes {
+		name, fields, err := parseClass(class, 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("class %q has no quantity", name)
+		}
+
+		quantity, err := parseQuantity(name, fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		var resources map[string]int64
+		if len(fields) > 1 {
+			if resources, err = parseQuantities(fields[1]); err != nil {
+				return nil, fmt.Errorf("invalid resources of class %q: %w", name, err)
+			}
+		}
+
+		res = append(res, &machine.FakeMachineClassStatus{
+			MachineClassStatus: irimachine.MachineClassStatus{
+				MachineClass: &irimachine.MachineClass{
+					Name: name,
+					Capabilities: &irimachine.MachineClassCapabilities{
+						Resources: resources,
+					},
+				},
+				Quantity: quantity,
+			},
+		})
+	}
+	return res, nil
+}
+
+func parseVolumeClasses(classes []string) ([]*volume.FakeVolumeClassStatus, error) {
+	var res []*volume.FakeVolumeClassStatus
+	for _, class := range classes {
+		name, fields, err := parseClass(class, 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("class %q has no quantity", name)
+		}
+
+		quantity, err := parseQuantity(name, fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		capabilities := map[string]int64{}
+		if len(fields) > 1 {
+			if capabilities, err = parseQuantities(fields[1]); err != nil {
+				return nil, fmt.Errorf("invalid capabilities of class %q: %w", name, err)
+			}
+		}
+
+		res = append(res, &volume.FakeVolumeClassStatus{
+			VolumeClassStatus: irivolume.VolumeClassStatus{
+				VolumeClass: &irivolume.VolumeClass{
+					Name: name,
+					Capabilities: &irivolume.VolumeClassCapabilities{
+						Tps:  capabilities["tps"],
+						Iops: capabilities["iops"],
+					},
+				},
+				Quantity: quantity,
+			},
+		})
+	}
+	return res, nil
+}
+
+func parseBucketClasses(classes []string) ([]*bucket.FakeBucketClass, error) {
+	var res []*bucket.FakeBucketClass
+	for _, class := range classes {
+		name, fields, err := parseClass(class, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		capabilities := map[string]int64{}
+		if len(fields) > 0 {
+			if capabilities, err = parseQuantities(fields[0]); err != nil {
+				return nil, fmt.Errorf("invalid capabilities of class %q: %w", name, err)
+			}
+		}
+
+		res = append(res, &bucket.FakeBucketClass{
+			BucketClass: iribucket.BucketClass{
+				Name: name,
+				Capabilities: &iribucket.BucketClassCapabilities{
+					Tps:  capabilities["tps"],
+					Iops: capabilities["iops"],
+				},
+			},
+		})
+	}
+	return res, nil
+}
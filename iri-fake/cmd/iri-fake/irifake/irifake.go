@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package irifake implements a command serving the fake iri runtimes via gRPC, e.g. to develop
+// and test poollets without a real provider.
+package irifake
+
+import (
+	"context"
+	goflag "flag"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ironcore-dev/ironcore/broker/common"
+	commongrpc "github.com/ironcore-dev/ironcore/broker/common/grpc"
+	iribucket "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irivolume "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/bucket"
+	"github.com/ironcore-dev/ironcore/iri/testing/fault"
+	"github.com/ironcore-dev/ironcore/iri/testing/machine"
+	"github.com/ironcore-dev/ironcore/iri/testing/volume"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+type Options struct {
+	Address string
+
+	ChaosFailureRate float64
+	ChaosCodes       []string
+	ChaosMaxLatency  time.Duration
+	ChaosMethods     []string
+	ChaosSeed        uint64
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Address, "address", "/var/run/iri-fake.sock", "Address to listen on.")
+	fs.Float64Var(&o.ChaosFailureRate, "chaos-failure-rate", 0, "Probability in [0, 1] of a call to fail.")
+	fs.StringSliceVar(&o.ChaosCodes, "chaos-codes", nil, "Codes of the failing calls, e.g. UNAVAILABLE. Defaults to UNAVAILABLE.")
+	fs.DurationVar(&o.ChaosMaxLatency, "chaos-max-latency", 0, "Maximum random latency added to every call.")
+	fs.StringSliceVar(&o.ChaosMethods, "chaos-methods", nil, "Methods affected by chaos, e.g. CreateMachine. If empty, all methods are affected.")
+	fs.Uint64Var(&o.ChaosSeed, "chaos-seed", 0, "Seed of the random failures and latency. If zero, a random seed is used.")
+}
+
+// Chaos returns the chaos configured by the options, if any.
+func (o *Options) Chaos() (*fault.Chaos, error) {
+	if o.ChaosFailureRate < 0 || o.ChaosFailureRate > 1 {
+		return nil, fmt.Errorf("chaos failure rate %v is not in [0, 1]", o.ChaosFailureRate)
+	}
+	if o.ChaosFailureRate == 0 && o.ChaosMaxLatency == 0 {
+		return nil, nil
+	}
+
+	var chaosCodes []codes.Code
+	for _, name := range o.ChaosCodes {
+		var code codes.Code
+		if err := code.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(name)))); err != nil {
+			return nil, fmt.Errorf("invalid chaos code %q: %w", name, err)
+		}
+		chaosCodes = append(chaosCodes, code)
+	}
+
+	return &fault.Chaos{
+		FailureRate: o.ChaosFailureRate,
+		Codes:       chaosCodes,
+		MaxLatency:  o.ChaosMaxLatency,
+		Methods:     o.ChaosMethods,
+		Seed:        o.ChaosSeed,
+	}, nil
+}
+
+func Command() *cobra.Command {
+	var (
+		zapOpts = zap.Options{Development: true}
+		opts    Options
+	)
+
+	cmd := &cobra.Command{
+		Use:   "iri-fake",
+		Short: "Serve a fake iri runtime.",
+		Long: `Serve a fake iri runtime.
+
+The fake runtimes keep their state in memory. They can delay state transitions,
+reject objects exceeding the capacity of their classes and inject random failures
+and latency into their calls.`,
+		SilenceUsage: true,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			logger := zap.New(zap.UseFlagOptions(&zapOpts))
+			ctrl.SetLogger(logger)
+			cmd.SetContext(ctrl.LoggerInto(cmd.Context(), ctrl.Log))
+		},
+	}
+
+	goFlags := goflag.NewFlagSet("", 0)
+	zapOpts.BindFlags(goFlags)
+	cmd.PersistentFlags().AddGoFlagSet(goFlags)
+
+	opts.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(
+		machineCommand(&opts),
+		volumeCommand(&opts),
+		bucketCommand(&opts),
+	)
+
+	return cmd
+}
+
+func machineCommand(opts *Options) *cobra.Command {
+	var (
+		machineClasses  []string
+		transitions     machine.Transitions
+		enforceCapacity bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "machine",
+		Short: "Serve a fake iri machine runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			classes, err := parseMachineClasses(machineClasses)
+			if err != nil {
+				return err
+			}
+			chaos, err := opts.Chaos()
+			if err != nil {
+				return err
+			}
+
+			runtime := machine.NewFakeRuntimeService()
+			runtime.SetMachineClasses(classes)
+			runtime.SetTransitions(&transitions)
+			runtime.SetEnforceCapacity(enforceCapacity)
+			runtime.Faults.SetChaos(chaos)
+
+			return serve(cmd.Context(), opts.Address, func(srv *grpc.Server) {
+				irimachine.RegisterMachineRuntimeServer(srv, machine.NewServer(runtime))
+			})
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.StringSliceVar(&machineClasses, "machine-class", nil, "Machine classes in the format <name>:<quantity>[:<resource>=<value>;...], "+
+		"e.g. x3-xlarge:10:cpu=4;memory=8Gi.")
+	fs.DurationVar(&transitions.Boot, "boot-delay", 3*time.Second, "Time a machine takes to boot.")
+	fs.DurationVar(&transitions.VolumeAttach, "volume-attach-delay", time.Second, "Time a volume takes to attach.")
+	fs.DurationVar(&transitions.NetworkInterfaceAttach, "network-interface-attach-delay", time.Second, "Time a network interface takes to attach.")
+	fs.DurationVar(&transitions.Termination, "termination-delay", time.Second, "Time a deleted machine takes to terminate.")
+	fs.BoolVar(&enforceCapacity, "enforce-capacity", true, "Whether to reject machines exceeding the quantity of their machine class.")
+
+	return cmd
+}
+
+func volumeCommand(opts *Options) *cobra.Command {
+	var (
+		volumeClasses   []string
+		transitions     volume.Transitions
+		enforceCapacity bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "volume",
+		Short: "Serve a fake iri volume runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			classes, err := parseVolumeClasses(volumeClasses)
+			if err != nil {
+				return err
+			}
+			chaos, err := opts.Chaos()
+			if err != nil {
+				return err
+			}
+
+			runtime := volume.NewFakeRuntimeService()
+			runtime.SetVolumeClasses(classes)
+			runtime.SetTransitions(&transitions)
+			runtime.SetEnforceCapacity(enforceCapacity)
+			runtime.Faults.SetChaos(chaos)
+
+			return serve(cmd.Context(), opts.Address, func(srv *grpc.Server) {
+				irivolume.RegisterVolumeRuntimeServer(srv, volume.NewServer(runtime))
+			})
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.StringSliceVar(&volumeClasses, "volume-class", nil, "Volume classes in the format <name>:<quantity>[:tps=<value>;iops=<value>], "+
+		"e.g. fast:10Ti:tps=100Mi;iops=1000.")
+	fs.DurationVar(&transitions.Provisioning, "provisioning-delay", 2*time.Second, "Time a volume takes to become available.")
+	fs.DurationVar(&transitions.Snapshot, "snapshot-delay", 2*time.Second, "Time a volume snapshot takes to become ready.")
+	fs.BoolVar(&enforceCapacity, "enforce-capacity", true, "Whether to reject volumes exceeding the quantity of their volume class.")
+
+	return cmd
+}
+
+func bucketCommand(opts *Options) *cobra.Command {
+	var bucketClasses []string
+
+	cmd := &cobra.Command{
+		Use:   "bucket",
+		Short: "Serve a fake iri bucket runtime.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			classes, err := parseBucketClasses(bucketClasses)
+			if err != nil {
+				return err
+			}
+			chaos, err := opts.Chaos()
+			if err != nil {
+				return err
+			}
+
+			runtime := bucket.NewFakeRuntimeService()
+			runtime.SetBucketClasses(classes)
+			runtime.Faults.SetChaos(chaos)
+
+			return serve(cmd.Context(), opts.Address, func(srv *grpc.Server) {
+				iribucket.RegisterBucketRuntimeServer(srv, bucket.NewServer(runtime))
+			})
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&bucketClasses, "bucket-class", nil, "Bucket classes in the format <name>[:tps=<value>;iops=<value>], "+
+		"e.g. fast:tps=100Mi;iops=1000.")
+
+	return cmd
+}
+
+func serve(ctx context.Context, address string, register func(srv *grpc.Server)) error {
+	log := ctrl.LoggerFrom(ctx)
+	setupLog := log.WithName("setup")
+
+	log.V(1).Info("Cleaning up any previous socket")
+	if err := common.CleanupSocketIfExists(address); err != nil {
+		return fmt.Errorf("error cleaning up socket: %w", err)
+	}
+
+	grpcSrv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			commongrpc.InjectLogger(log),
+			commongrpc.LogRequest,
+		),
+	)
+	register(grpcSrv)
+
+	log.V(1).Info("Start listening on unix socket", "Address", address)
+	l, err := net.Listen("unix", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	setupLog.Info("Starting grpc server", "Address", l.Addr().String())
+	go func() {
+		<-ctx.Done()
+		setupLog.Info("Shutting down grpc server")
+		grpcSrv.GracefulStop()
+		setupLog.Info("Shut down grpc server")
+	}()
+	if err := grpcSrv.Serve(l); err != nil {
+		return fmt.Errorf("error serving grpc: %w", err)
+	}
+	return nil
+}
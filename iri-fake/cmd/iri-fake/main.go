@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+
+	"github.com/ironcore-dev/ironcore/iri-fake/cmd/iri-fake/irifake"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func main() {
+	ctx := ctrl.SetupSignalHandler()
+
+	if err := irifake.Command().ExecuteContext(ctx); err != nil {
+		os.Exit(1)
+	}
+}
@@ -12,7 +12,9 @@ import (
 type RuntimeService interface {
 	Version(context.Context, *api.VersionRequest) (*api.VersionResponse, error)
 	ListEvents(context.Context, *api.ListEventsRequest) (*api.ListEventsResponse, error)
+	WatchEvents(context.Context, *api.WatchEventsRequest) (api.BucketRuntime_WatchEventsClient, error)
 	ListBuckets(context.Context, *api.ListBucketsRequest) (*api.ListBucketsResponse, error)
+	WatchBuckets(context.Context, *api.WatchBucketsRequest) (api.BucketRuntime_WatchBucketsClient, error)
 	CreateBucket(context.Context, *api.CreateBucketRequest) (*api.CreateBucketResponse, error)
 	ListBucketClasses(ctx context.Context, request *api.ListBucketClassesRequest) (*api.ListBucketClassesResponse, error)
 	DeleteBucket(context.Context, *api.DeleteBucketRequest) (*api.DeleteBucketResponse, error)
@@ -73,6 +73,54 @@ func (BucketState) EnumDescriptor() ([]byte, []int) {
 	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{0}
 }
 
+// RuntimeCapability is an optional feature of a bucket runtime.
+type RuntimeCapability int32
+
+const (
+	RuntimeCapability_RUNTIME_CAPABILITY_UNSPECIFIED RuntimeCapability = 0
+	// The runtime implements WatchBuckets and WatchEvents.
+	RuntimeCapability_RUNTIME_CAPABILITY_WATCH RuntimeCapability = 1
+)
+
+// Enum value maps for RuntimeCapability.
+var (
+	RuntimeCapability_name = map[int32]string{
+		0: "RUNTIME_CAPABILITY_UNSPECIFIED",
+		1: "RUNTIME_CAPABILITY_WATCH",
+	}
+	RuntimeCapability_value = map[string]int32{
+		"RUNTIME_CAPABILITY_UNSPECIFIED": 0,
+		"RUNTIME_CAPABILITY_WATCH":       1,
+	}
+)
+
+func (x RuntimeCapability) Enum() *RuntimeCapability {
+	p := new(RuntimeCapability)
+	*p = x
+	return p
+}
+
+func (x RuntimeCapability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuntimeCapability) Descriptor() protoreflect.EnumDescriptor {
+	return file_bucket_v1alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (RuntimeCapability) Type() protoreflect.EnumType {
+	return &file_bucket_v1alpha1_api_proto_enumTypes[1]
+}
+
+func (x RuntimeCapability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuntimeCapability.Descriptor instead.
+func (RuntimeCapability) EnumDescriptor() ([]byte, []int) {
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
 type EventFilter struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -145,6 +193,10 @@ type BucketFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	LabelSelector map[string]string      `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// states restricts the listed buckets to the given states. If empty, buckets in any state are listed.
+	States []BucketState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=bucket.v1alpha1.BucketState" json:"states,omitempty"`
+	// classes restricts the listed buckets to the given classes. If empty, buckets of any class are listed.
+	Classes       []string `protobuf:"bytes,4,rep,name=classes,proto3" json:"classes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -193,6 +245,20 @@ func (x *BucketFilter) GetLabelSelector() map[string]string {
 	return nil
 }
 
+func (x *BucketFilter) GetStates() []BucketState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *BucketFilter) GetClasses() []string {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
 type BucketSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Class         string                 `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
@@ -506,8 +572,12 @@ func (x *BucketAccess) GetSecretData() map[string][]byte {
 }
 
 type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// limit is the maximum number of events to return. If zero, all events are returned.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue_token is the continue_token of a previous response to list the next page of events.
+	ContinueToken string `protobuf:"bytes,3,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -549,9 +619,25 @@ func (x *ListEventsRequest) GetFilter() *EventFilter {
 	return nil
 }
 
+func (x *ListEventsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
 type ListEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*v1alpha11.Event     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*v1alpha11.Event     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// continue_token is set if there are more events to list.
+	ContinueToken string `protobuf:"bytes,2,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -593,6 +679,119 @@ func (x *ListEventsResponse) GetEvents() []*v1alpha11.Event {
 	return nil
 }
 
+func (x *ListEventsResponse) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+type WatchEventsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version of the last event received by the client.
+	// Events that occurred before the watch was started are not sent; clients catch up via ListEvents.
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchEventsRequest) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type WatchEventsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Event           *v1alpha11.Event       `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ResourceVersion string                 `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsResponse) ProtoMessage() {}
+
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchEventsResponse) GetEvent() *v1alpha11.Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
 type VersionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
@@ -602,7 +801,7 @@ type VersionRequest struct {
 
 func (x *VersionRequest) Reset() {
 	*x = VersionRequest{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[10]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +813,7 @@ func (x *VersionRequest) String() string {
 func (*VersionRequest) ProtoMessage() {}
 
 func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[10]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +826,7 @@ func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
 func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{10}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *VersionRequest) GetVersion() string {
@@ -644,13 +843,15 @@ type VersionResponse struct {
 	// Version of the bucket runtime. The string must be
 	// semver-compatible.
 	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Optional features supported by the bucket runtime.
+	Capabilities  []RuntimeCapability `protobuf:"varint,3,rep,packed,name=capabilities,proto3,enum=bucket.v1alpha1.RuntimeCapability" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VersionResponse) Reset() {
 	*x = VersionResponse{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[11]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +863,7 @@ func (x *VersionResponse) String() string {
 func (*VersionResponse) ProtoMessage() {}
 
 func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[11]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +876,7 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
 func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{11}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *VersionResponse) GetRuntimeName() string {
@@ -692,16 +893,27 @@ func (x *VersionResponse) GetRuntimeVersion() string {
 	return ""
 }
 
+func (x *VersionResponse) GetCapabilities() []RuntimeCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 type ListBucketsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *BucketFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *BucketFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// limit is the maximum number of buckets to return. If zero, all buckets are returned.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue_token is the continue_token of a previous response to list the next page of buckets.
+	ContinueToken string `protobuf:"bytes,3,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListBucketsRequest) Reset() {
 	*x = ListBucketsRequest{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[12]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +925,7 @@ func (x *ListBucketsRequest) String() string {
 func (*ListBucketsRequest) ProtoMessage() {}
 
 func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[12]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +938,7 @@ func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
 func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{12}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListBucketsRequest) GetFilter() *BucketFilter {
@@ -736,16 +948,32 @@ func (x *ListBucketsRequest) GetFilter() *BucketFilter {
 	return nil
 }
 
+func (x *ListBucketsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListBucketsRequest) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
 type ListBucketsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Buckets       []*Bucket              `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Buckets []*Bucket              `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// continue_token is set if there are more buckets to list.
+	ContinueToken string `protobuf:"bytes,2,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListBucketsResponse) Reset() {
 	*x = ListBucketsResponse{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[13]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -757,7 +985,7 @@ func (x *ListBucketsResponse) String() string {
 func (*ListBucketsResponse) ProtoMessage() {}
 
 func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[13]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +998,7 @@ func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
 func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{13}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListBucketsResponse) GetBuckets() []*Bucket {
@@ -780,6 +1008,130 @@ func (x *ListBucketsResponse) GetBuckets() []*Bucket {
 	return nil
 }
 
+func (x *ListBucketsResponse) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+type WatchBucketsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *BucketFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version of the last event received by the client.
+	// If empty, the server first sends the current state as WATCH_EVENT_ADDED events.
+	// Servers that cannot resume from the given resource version do the same.
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchBucketsRequest) Reset() {
+	*x = WatchBucketsRequest{}
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBucketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBucketsRequest) ProtoMessage() {}
+
+func (x *WatchBucketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBucketsRequest.ProtoReflect.Descriptor instead.
+func (*WatchBucketsRequest) Descriptor() ([]byte, []int) {
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchBucketsRequest) GetFilter() *BucketFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchBucketsRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type WatchBucketsResponse struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Type  v1alpha1.WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=meta.v1alpha1.WatchEventType" json:"type,omitempty"`
+	// bucket is the bucket the event is about. For WATCH_EVENT_DELETED, servers may only
+	// populate the metadata.
+	Bucket          *Bucket `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	ResourceVersion string  `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchBucketsResponse) Reset() {
+	*x = WatchBucketsResponse{}
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBucketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBucketsResponse) ProtoMessage() {}
+
+func (x *WatchBucketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBucketsResponse.ProtoReflect.Descriptor instead.
+func (*WatchBucketsResponse) Descriptor() ([]byte, []int) {
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WatchBucketsResponse) GetType() v1alpha1.WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return v1alpha1.WatchEventType(0)
+}
+
+func (x *WatchBucketsResponse) GetBucket() *Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *WatchBucketsResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
 type CreateBucketRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bucket        *Bucket                `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
@@ -789,7 +1141,7 @@ type CreateBucketRequest struct {
 
 func (x *CreateBucketRequest) Reset() {
 	*x = CreateBucketRequest{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[14]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +1153,7 @@ func (x *CreateBucketRequest) String() string {
 func (*CreateBucketRequest) ProtoMessage() {}
 
 func (x *CreateBucketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[14]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1166,7 @@ func (x *CreateBucketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBucketRequest.ProtoReflect.Descriptor instead.
 func (*CreateBucketRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{14}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateBucketRequest) GetBucket() *Bucket {
@@ -833,7 +1185,7 @@ type CreateBucketResponse struct {
 
 func (x *CreateBucketResponse) Reset() {
 	*x = CreateBucketResponse{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[15]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -845,7 +1197,7 @@ func (x *CreateBucketResponse) String() string {
 func (*CreateBucketResponse) ProtoMessage() {}
 
 func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[15]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +1210,7 @@ func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBucketResponse.ProtoReflect.Descriptor instead.
 func (*CreateBucketResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{15}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateBucketResponse) GetBucket() *Bucket {
@@ -877,7 +1229,7 @@ type DeleteBucketRequest struct {
 
 func (x *DeleteBucketRequest) Reset() {
 	*x = DeleteBucketRequest{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[16]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -889,7 +1241,7 @@ func (x *DeleteBucketRequest) String() string {
 func (*DeleteBucketRequest) ProtoMessage() {}
 
 func (x *DeleteBucketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[16]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1254,7 @@ func (x *DeleteBucketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBucketRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBucketRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{16}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteBucketRequest) GetBucketId() string {
@@ -920,7 +1272,7 @@ type DeleteBucketResponse struct {
 
 func (x *DeleteBucketResponse) Reset() {
 	*x = DeleteBucketResponse{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[17]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -932,7 +1284,7 @@ func (x *DeleteBucketResponse) String() string {
 func (*DeleteBucketResponse) ProtoMessage() {}
 
 func (x *DeleteBucketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[17]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -945,7 +1297,7 @@ func (x *DeleteBucketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBucketResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBucketResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{17}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{21}
 }
 
 type ListBucketClassesRequest struct {
@@ -956,7 +1308,7 @@ type ListBucketClassesRequest struct {
 
 func (x *ListBucketClassesRequest) Reset() {
 	*x = ListBucketClassesRequest{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[18]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -968,7 +1320,7 @@ func (x *ListBucketClassesRequest) String() string {
 func (*ListBucketClassesRequest) ProtoMessage() {}
 
 func (x *ListBucketClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[18]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,7 +1333,7 @@ func (x *ListBucketClassesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketClassesRequest.ProtoReflect.Descriptor instead.
 func (*ListBucketClassesRequest) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{18}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{22}
 }
 
 type ListBucketClassesResponse struct {
@@ -993,7 +1345,7 @@ type ListBucketClassesResponse struct {
 
 func (x *ListBucketClassesResponse) Reset() {
 	*x = ListBucketClassesResponse{}
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[19]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +1357,7 @@ func (x *ListBucketClassesResponse) String() string {
 func (*ListBucketClassesResponse) ProtoMessage() {}
 
 func (x *ListBucketClassesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_bucket_v1alpha1_api_proto_msgTypes[19]
+	mi := &file_bucket_v1alpha1_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1370,7 @@ func (x *ListBucketClassesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBucketClassesResponse.ProtoReflect.Descriptor instead.
 func (*ListBucketClassesResponse) Descriptor() ([]byte, []int) {
-	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{19}
+	return file_bucket_v1alpha1_api_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListBucketClassesResponse) GetBucketClasses() []*BucketClass {
@@ -1040,10 +1392,12 @@ const file_bucket_v1alpha1_api_proto_rawDesc = "" +
 	"\x0eevents_to_time\x18\x04 \x01(\x03R\feventsToTime\x1a@\n" +
 	"\x12LabelSelectorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb9\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x02\n" +
 	"\fBucketFilter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12W\n" +
-	"\x0elabel_selector\x18\x02 \x03(\v20.bucket.v1alpha1.BucketFilter.LabelSelectorEntryR\rlabelSelector\x1a@\n" +
+	"\x0elabel_selector\x18\x02 \x03(\v20.bucket.v1alpha1.BucketFilter.LabelSelectorEntryR\rlabelSelector\x124\n" +
+	"\x06states\x18\x03 \x03(\x0e2\x1c.bucket.v1alpha1.BucketStateR\x06states\x12\x18\n" +
+	"\aclasses\x18\x04 \x03(\tR\aclasses\x1a@\n" +
 	"\x12LabelSelectorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\"\n" +
@@ -1069,20 +1423,40 @@ const file_bucket_v1alpha1_api_proto_rawDesc = "" +
 	"secretData\x1a=\n" +
 	"\x0fSecretDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"I\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x86\x01\n" +
 	"\x11ListEventsRequest\x124\n" +
-	"\x06filter\x18\x01 \x01(\v2\x1c.bucket.v1alpha1.EventFilterR\x06filter\"C\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.bucket.v1alpha1.EventFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12%\n" +
+	"\x0econtinue_token\x18\x03 \x01(\tR\rcontinueToken\"j\n" +
 	"\x12ListEventsResponse\x12-\n" +
-	"\x06events\x18\x01 \x03(\v2\x15.event.v1alpha1.EventR\x06events\"*\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.event.v1alpha1.EventR\x06events\x12%\n" +
+	"\x0econtinue_token\x18\x02 \x01(\tR\rcontinueToken\"u\n" +
+	"\x12WatchEventsRequest\x124\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.bucket.v1alpha1.EventFilterR\x06filter\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"m\n" +
+	"\x13WatchEventsResponse\x12+\n" +
+	"\x05event\x18\x01 \x01(\v2\x15.event.v1alpha1.EventR\x05event\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"*\n" +
 	"\x0eVersionRequest\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"]\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\xa5\x01\n" +
 	"\x0fVersionResponse\x12!\n" +
 	"\fruntime_name\x18\x01 \x01(\tR\vruntimeName\x12'\n" +
-	"\x0fruntime_version\x18\x02 \x01(\tR\x0eruntimeVersion\"K\n" +
+	"\x0fruntime_version\x18\x02 \x01(\tR\x0eruntimeVersion\x12F\n" +
+	"\fcapabilities\x18\x03 \x03(\x0e2\".bucket.v1alpha1.RuntimeCapabilityR\fcapabilities\"\x88\x01\n" +
 	"\x12ListBucketsRequest\x125\n" +
-	"\x06filter\x18\x01 \x01(\v2\x1d.bucket.v1alpha1.BucketFilterR\x06filter\"H\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.bucket.v1alpha1.BucketFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12%\n" +
+	"\x0econtinue_token\x18\x03 \x01(\tR\rcontinueToken\"o\n" +
 	"\x13ListBucketsResponse\x121\n" +
-	"\abuckets\x18\x01 \x03(\v2\x17.bucket.v1alpha1.BucketR\abuckets\"F\n" +
+	"\abuckets\x18\x01 \x03(\v2\x17.bucket.v1alpha1.BucketR\abuckets\x12%\n" +
+	"\x0econtinue_token\x18\x02 \x01(\tR\rcontinueToken\"w\n" +
+	"\x13WatchBucketsRequest\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.bucket.v1alpha1.BucketFilterR\x06filter\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"\xa5\x01\n" +
+	"\x14WatchBucketsResponse\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.meta.v1alpha1.WatchEventTypeR\x04type\x12/\n" +
+	"\x06bucket\x18\x02 \x01(\v2\x17.bucket.v1alpha1.BucketR\x06bucket\x12)\n" +
+	"\x10resource_version\x18\x03 \x01(\tR\x0fresourceVersion\"F\n" +
 	"\x13CreateBucketRequest\x12/\n" +
 	"\x06bucket\x18\x01 \x01(\v2\x17.bucket.v1alpha1.BucketR\x06bucket\"G\n" +
 	"\x14CreateBucketResponse\x12/\n" +
@@ -1096,12 +1470,17 @@ const file_bucket_v1alpha1_api_proto_rawDesc = "" +
 	"\vBucketState\x12\x12\n" +
 	"\x0eBUCKET_PENDING\x10\x00\x12\x14\n" +
 	"\x10BUCKET_AVAILABLE\x10\x01\x12\x10\n" +
-	"\fBUCKET_ERROR\x10\x022\xc0\x04\n" +
+	"\fBUCKET_ERROR\x10\x02*U\n" +
+	"\x11RuntimeCapability\x12\"\n" +
+	"\x1eRUNTIME_CAPABILITY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RUNTIME_CAPABILITY_WATCH\x10\x012\xff\x05\n" +
 	"\rBucketRuntime\x12N\n" +
 	"\aVersion\x12\x1f.bucket.v1alpha1.VersionRequest\x1a .bucket.v1alpha1.VersionResponse\"\x00\x12W\n" +
 	"\n" +
-	"ListEvents\x12\".bucket.v1alpha1.ListEventsRequest\x1a#.bucket.v1alpha1.ListEventsResponse\"\x00\x12Z\n" +
-	"\vListBuckets\x12#.bucket.v1alpha1.ListBucketsRequest\x1a$.bucket.v1alpha1.ListBucketsResponse\"\x00\x12]\n" +
+	"ListEvents\x12\".bucket.v1alpha1.ListEventsRequest\x1a#.bucket.v1alpha1.ListEventsResponse\"\x00\x12\\\n" +
+	"\vWatchEvents\x12#.bucket.v1alpha1.WatchEventsRequest\x1a$.bucket.v1alpha1.WatchEventsResponse\"\x000\x01\x12Z\n" +
+	"\vListBuckets\x12#.bucket.v1alpha1.ListBucketsRequest\x1a$.bucket.v1alpha1.ListBucketsResponse\"\x00\x12_\n" +
+	"\fWatchBuckets\x12$.bucket.v1alpha1.WatchBucketsRequest\x1a%.bucket.v1alpha1.WatchBucketsResponse\"\x000\x01\x12]\n" +
 	"\fCreateBucket\x12$.bucket.v1alpha1.CreateBucketRequest\x1a%.bucket.v1alpha1.CreateBucketResponse\"\x00\x12]\n" +
 	"\fDeleteBucket\x12$.bucket.v1alpha1.DeleteBucketRequest\x1a%.bucket.v1alpha1.DeleteBucketResponse\"\x00\x12l\n" +
 	"\x11ListBucketClasses\x12).bucket.v1alpha1.ListBucketClassesRequest\x1a*.bucket.v1alpha1.ListBucketClassesResponse\"\x00B;Z9github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1b\x06proto3"
@@ -1118,70 +1497,87 @@ func file_bucket_v1alpha1_api_proto_rawDescGZIP() []byte {
 	return file_bucket_v1alpha1_api_proto_rawDescData
 }
 
-var file_bucket_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_bucket_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_bucket_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_bucket_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_bucket_v1alpha1_api_proto_goTypes = []any{
 	(BucketState)(0),                  // 0: bucket.v1alpha1.BucketState
-	(*EventFilter)(nil),               // 1: bucket.v1alpha1.EventFilter
-	(*BucketFilter)(nil),              // 2: bucket.v1alpha1.BucketFilter
-	(*BucketSpec)(nil),                // 3: bucket.v1alpha1.BucketSpec
-	(*BucketStatus)(nil),              // 4: bucket.v1alpha1.BucketStatus
-	(*Bucket)(nil),                    // 5: bucket.v1alpha1.Bucket
-	(*BucketClassCapabilities)(nil),   // 6: bucket.v1alpha1.BucketClassCapabilities
-	(*BucketClass)(nil),               // 7: bucket.v1alpha1.BucketClass
-	(*BucketAccess)(nil),              // 8: bucket.v1alpha1.BucketAccess
-	(*ListEventsRequest)(nil),         // 9: bucket.v1alpha1.ListEventsRequest
-	(*ListEventsResponse)(nil),        // 10: bucket.v1alpha1.ListEventsResponse
-	(*VersionRequest)(nil),            // 11: bucket.v1alpha1.VersionRequest
-	(*VersionResponse)(nil),           // 12: bucket.v1alpha1.VersionResponse
-	(*ListBucketsRequest)(nil),        // 13: bucket.v1alpha1.ListBucketsRequest
-	(*ListBucketsResponse)(nil),       // 14: bucket.v1alpha1.ListBucketsResponse
-	(*CreateBucketRequest)(nil),       // 15: bucket.v1alpha1.CreateBucketRequest
-	(*CreateBucketResponse)(nil),      // 16: bucket.v1alpha1.CreateBucketResponse
-	(*DeleteBucketRequest)(nil),       // 17: bucket.v1alpha1.DeleteBucketRequest
-	(*DeleteBucketResponse)(nil),      // 18: bucket.v1alpha1.DeleteBucketResponse
-	(*ListBucketClassesRequest)(nil),  // 19: bucket.v1alpha1.ListBucketClassesRequest
-	(*ListBucketClassesResponse)(nil), // 20: bucket.v1alpha1.ListBucketClassesResponse
-	nil,                               // 21: bucket.v1alpha1.EventFilter.LabelSelectorEntry
-	nil,                               // 22: bucket.v1alpha1.BucketFilter.LabelSelectorEntry
-	nil,                               // 23: bucket.v1alpha1.BucketAccess.SecretDataEntry
-	(*v1alpha1.ObjectMetadata)(nil),   // 24: meta.v1alpha1.ObjectMetadata
-	(*v1alpha11.Event)(nil),           // 25: event.v1alpha1.Event
+	(RuntimeCapability)(0),            // 1: bucket.v1alpha1.RuntimeCapability
+	(*EventFilter)(nil),               // 2: bucket.v1alpha1.EventFilter
+	(*BucketFilter)(nil),              // 3: bucket.v1alpha1.BucketFilter
+	(*BucketSpec)(nil),                // 4: bucket.v1alpha1.BucketSpec
+	(*BucketStatus)(nil),              // 5: bucket.v1alpha1.BucketStatus
+	(*Bucket)(nil),                    // 6: bucket.v1alpha1.Bucket
+	(*BucketClassCapabilities)(nil),   // 7: bucket.v1alpha1.BucketClassCapabilities
+	(*BucketClass)(nil),               // 8: bucket.v1alpha1.BucketClass
+	(*BucketAccess)(nil),              // 9: bucket.v1alpha1.BucketAccess
+	(*ListEventsRequest)(nil),         // 10: bucket.v1alpha1.ListEventsRequest
+	(*ListEventsResponse)(nil),        // 11: bucket.v1alpha1.ListEventsResponse
+	(*WatchEventsRequest)(nil),        // 12: bucket.v1alpha1.WatchEventsRequest
+	(*WatchEventsResponse)(nil),       // 13: bucket.v1alpha1.WatchEventsResponse
+	(*VersionRequest)(nil),            // 14: bucket.v1alpha1.VersionRequest
+	(*VersionResponse)(nil),           // 15: bucket.v1alpha1.VersionResponse
+	(*ListBucketsRequest)(nil),        // 16: bucket.v1alpha1.ListBucketsRequest
+	(*ListBucketsResponse)(nil),       // 17: bucket.v1alpha1.ListBucketsResponse
+	(*WatchBucketsRequest)(nil),       // 18: bucket.v1alpha1.WatchBucketsRequest
+	(*WatchBucketsResponse)(nil),      // 19: bucket.v1alpha1.WatchBucketsResponse
+	(*CreateBucketRequest)(nil),       // 20: bucket.v1alpha1.CreateBucketRequest
+	(*CreateBucketResponse)(nil),      // 21: bucket.v1alpha1.CreateBucketResponse
+	(*DeleteBucketRequest)(nil),       // 22: bucket.v1alpha1.DeleteBucketRequest
+	(*DeleteBucketResponse)(nil),      // 23: bucket.v1alpha1.DeleteBucketResponse
+	(*ListBucketClassesRequest)(nil),  // 24: bucket.v1alpha1.ListBucketClassesRequest
+	(*ListBucketClassesResponse)(nil), // 25: bucket.v1alpha1.ListBucketClassesResponse
+	nil,                               // 26: bucket.v1alpha1.EventFilter.LabelSelectorEntry
+	nil,                               // 27: bucket.v1alpha1.BucketFilter.LabelSelectorEntry
+	nil,                               // 28: bucket.v1alpha1.BucketAccess.SecretDataEntry
+	(*v1alpha1.ObjectMetadata)(nil),   // 29: meta.v1alpha1.ObjectMetadata
+	(*v1alpha11.Event)(nil),           // 30: event.v1alpha1.Event
+	(v1alpha1.WatchEventType)(0),      // 31: meta.v1alpha1.WatchEventType
 }
 var file_bucket_v1alpha1_api_proto_depIdxs = []int32{
-	21, // 0: bucket.v1alpha1.EventFilter.label_selector:type_name -> bucket.v1alpha1.EventFilter.LabelSelectorEntry
-	22, // 1: bucket.v1alpha1.BucketFilter.label_selector:type_name -> bucket.v1alpha1.BucketFilter.LabelSelectorEntry
-	0,  // 2: bucket.v1alpha1.BucketStatus.state:type_name -> bucket.v1alpha1.BucketState
-	8,  // 3: bucket.v1alpha1.BucketStatus.access:type_name -> bucket.v1alpha1.BucketAccess
-	24, // 4: bucket.v1alpha1.Bucket.metadata:type_name -> meta.v1alpha1.ObjectMetadata
-	3,  // 5: bucket.v1alpha1.Bucket.spec:type_name -> bucket.v1alpha1.BucketSpec
-	4,  // 6: bucket.v1alpha1.Bucket.status:type_name -> bucket.v1alpha1.BucketStatus
-	6,  // 7: bucket.v1alpha1.BucketClass.capabilities:type_name -> bucket.v1alpha1.BucketClassCapabilities
-	23, // 8: bucket.v1alpha1.BucketAccess.secret_data:type_name -> bucket.v1alpha1.BucketAccess.SecretDataEntry
-	1,  // 9: bucket.v1alpha1.ListEventsRequest.filter:type_name -> bucket.v1alpha1.EventFilter
-	25, // 10: bucket.v1alpha1.ListEventsResponse.events:type_name -> event.v1alpha1.Event
-	2,  // 11: bucket.v1alpha1.ListBucketsRequest.filter:type_name -> bucket.v1alpha1.BucketFilter
-	5,  // 12: bucket.v1alpha1.ListBucketsResponse.buckets:type_name -> bucket.v1alpha1.Bucket
-	5,  // 13: bucket.v1alpha1.CreateBucketRequest.bucket:type_name -> bucket.v1alpha1.Bucket
-	5,  // 14: bucket.v1alpha1.CreateBucketResponse.bucket:type_name -> bucket.v1alpha1.Bucket
-	7,  // 15: bucket.v1alpha1.ListBucketClassesResponse.bucket_classes:type_name -> bucket.v1alpha1.BucketClass
-	11, // 16: bucket.v1alpha1.BucketRuntime.Version:input_type -> bucket.v1alpha1.VersionRequest
-	9,  // 17: bucket.v1alpha1.BucketRuntime.ListEvents:input_type -> bucket.v1alpha1.ListEventsRequest
-	13, // 18: bucket.v1alpha1.BucketRuntime.ListBuckets:input_type -> bucket.v1alpha1.ListBucketsRequest
-	15, // 19: bucket.v1alpha1.BucketRuntime.CreateBucket:input_type -> bucket.v1alpha1.CreateBucketRequest
-	17, // 20: bucket.v1alpha1.BucketRuntime.DeleteBucket:input_type -> bucket.v1alpha1.DeleteBucketRequest
-	19, // 21: bucket.v1alpha1.BucketRuntime.ListBucketClasses:input_type -> bucket.v1alpha1.ListBucketClassesRequest
-	12, // 22: bucket.v1alpha1.BucketRuntime.Version:output_type -> bucket.v1alpha1.VersionResponse
-	10, // 23: bucket.v1alpha1.BucketRuntime.ListEvents:output_type -> bucket.v1alpha1.ListEventsResponse
-	14, // 24: bucket.v1alpha1.BucketRuntime.ListBuckets:output_type -> bucket.v1alpha1.ListBucketsResponse
-	16, // 25: bucket.v1alpha1.BucketRuntime.CreateBucket:output_type -> bucket.v1alpha1.CreateBucketResponse
-	18, // 26: bucket.v1alpha1.BucketRuntime.DeleteBucket:output_type -> bucket.v1alpha1.DeleteBucketResponse
-	20, // 27: bucket.v1alpha1.BucketRuntime.ListBucketClasses:output_type -> bucket.v1alpha1.ListBucketClassesResponse
-	22, // [22:28] is the sub-list for method output_type
-	16, // [16:22] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	26, // 0: bucket.v1alpha1.EventFilter.label_selector:type_name -> bucket.v1alpha1.EventFilter.LabelSelectorEntry
+	27, // 1: bucket.v1alpha1.BucketFilter.label_selector:type_name -> bucket.v1alpha1.BucketFilter.LabelSelectorEntry
+	0,  // 2: bucket.v1alpha1.BucketFilter.states:type_name -> bucket.v1alpha1.BucketState
+	0,  // 3: bucket.v1alpha1.BucketStatus.state:type_name -> bucket.v1alpha1.BucketState
+	9,  // 4: bucket.v1alpha1.BucketStatus.access:type_name -> bucket.v1alpha1.BucketAccess
+	29, // 5: bucket.v1alpha1.Bucket.metadata:type_name -> meta.v1alpha1.ObjectMetadata
+	4,  // 6: bucket.v1alpha1.Bucket.spec:type_name -> bucket.v1alpha1.BucketSpec
+	5,  // 7: bucket.v1alpha1.Bucket.status:type_name -> bucket.v1alpha1.BucketStatus
+	7,  // 8: bucket.v1alpha1.BucketClass.capabilities:type_name -> bucket.v1alpha1.BucketClassCapabilities
+	28, // 9: bucket.v1alpha1.BucketAccess.secret_data:type_name -> bucket.v1alpha1.BucketAccess.SecretDataEntry
+	2,  // 10: bucket.v1alpha1.ListEventsRequest.filter:type_name -> bucket.v1alpha1.EventFilter
+	30, // 11: bucket.v1alpha1.ListEventsResponse.events:type_name -> event.v1alpha1.Event
+	2,  // 12: bucket.v1alpha1.WatchEventsRequest.filter:type_name -> bucket.v1alpha1.EventFilter
+	30, // 13: bucket.v1alpha1.WatchEventsResponse.event:type_name -> event.v1alpha1.Event
+	1,  // 14: bucket.v1alpha1.VersionResponse.capabilities:type_name -> bucket.v1alpha1.RuntimeCapability
+	3,  // 15: bucket.v1alpha1.ListBucketsRequest.filter:type_name -> bucket.v1alpha1.BucketFilter
+	6,  // 16: bucket.v1alpha1.ListBucketsResponse.buckets:type_name -> bucket.v1alpha1.Bucket
+	3,  // 17: bucket.v1alpha1.WatchBucketsRequest.filter:type_name -> bucket.v1alpha1.BucketFilter
+	31, // 18: bucket.v1alpha1.WatchBucketsResponse.type:type_name -> meta.v1alpha1.WatchEventType
+	6,  // 19: bucket.v1alpha1.WatchBucketsResponse.bucket:type_name -> bucket.v1alpha1.Bucket
+	6,  // 20: bucket.v1alpha1.CreateBucketRequest.bucket:type_name -> bucket.v1alpha1.Bucket
+	6,  // 21: bucket.v1alpha1.CreateBucketResponse.bucket:type_name -> bucket.v1alpha1.Bucket
+	8,  // 22: bucket.v1alpha1.ListBucketClassesResponse.bucket_classes:type_name -> bucket.v1alpha1.BucketClass
+	14, // 23: bucket.v1alpha1.BucketRuntime.Version:input_type -> bucket.v1alpha1.VersionRequest
+	10, // 24: bucket.v1alpha1.BucketRuntime.ListEvents:input_type -> bucket.v1alpha1.ListEventsRequest
+	12, // 25: bucket.v1alpha1.BucketRuntime.WatchEvents:input_type -> bucket.v1alpha1.WatchEventsRequest
+	16, // 26: bucket.v1alpha1.BucketRuntime.ListBuckets:input_type -> bucket.v1alpha1.ListBucketsRequest
+	18, // 27: bucket.v1alpha1.BucketRuntime.WatchBuckets:input_type -> bucket.v1alpha1.WatchBucketsRequest
+	20, // 28: bucket.v1alpha1.BucketRuntime.CreateBucket:input_type -> bucket.v1alpha1.CreateBucketRequest
+	22, // 29: bucket.v1alpha1.BucketRuntime.DeleteBucket:input_type -> bucket.v1alpha1.DeleteBucketRequest
+	24, // 30: bucket.v1alpha1.BucketRuntime.ListBucketClasses:input_type -> bucket.v1alpha1.ListBucketClassesRequest
+	15, // 31: bucket.v1alpha1.BucketRuntime.Version:output_type -> bucket.v1alpha1.VersionResponse
+	11, // 32: bucket.v1alpha1.BucketRuntime.ListEvents:output_type -> bucket.v1alpha1.ListEventsResponse
+	13, // 33: bucket.v1alpha1.BucketRuntime.WatchEvents:output_type -> bucket.v1alpha1.WatchEventsResponse
+	17, // 34: bucket.v1alpha1.BucketRuntime.ListBuckets:output_type -> bucket.v1alpha1.ListBucketsResponse
+	19, // 35: bucket.v1alpha1.BucketRuntime.WatchBuckets:output_type -> bucket.v1alpha1.WatchBucketsResponse
+	21, // 36: bucket.v1alpha1.BucketRuntime.CreateBucket:output_type -> bucket.v1alpha1.CreateBucketResponse
+	23, // 37: bucket.v1alpha1.BucketRuntime.DeleteBucket:output_type -> bucket.v1alpha1.DeleteBucketResponse
+	25, // 38: bucket.v1alpha1.BucketRuntime.ListBucketClasses:output_type -> bucket.v1alpha1.ListBucketClassesResponse
+	31, // [31:39] is the sub-list for method output_type
+	23, // [23:31] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_bucket_v1alpha1_api_proto_init() }
@@ -1194,8 +1590,8 @@ func file_bucket_v1alpha1_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_bucket_v1alpha1_api_proto_rawDesc), len(file_bucket_v1alpha1_api_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   23,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
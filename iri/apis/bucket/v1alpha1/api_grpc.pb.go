@@ -22,7 +22,9 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	BucketRuntime_Version_FullMethodName           = "/bucket.v1alpha1.BucketRuntime/Version"
 	BucketRuntime_ListEvents_FullMethodName        = "/bucket.v1alpha1.BucketRuntime/ListEvents"
+	BucketRuntime_WatchEvents_FullMethodName       = "/bucket.v1alpha1.BucketRuntime/WatchEvents"
 	BucketRuntime_ListBuckets_FullMethodName       = "/bucket.v1alpha1.BucketRuntime/ListBuckets"
+	BucketRuntime_WatchBuckets_FullMethodName      = "/bucket.v1alpha1.BucketRuntime/WatchBuckets"
 	BucketRuntime_CreateBucket_FullMethodName      = "/bucket.v1alpha1.BucketRuntime/CreateBucket"
 	BucketRuntime_DeleteBucket_FullMethodName      = "/bucket.v1alpha1.BucketRuntime/DeleteBucket"
 	BucketRuntime_ListBucketClasses_FullMethodName = "/bucket.v1alpha1.BucketRuntime/ListBucketClasses"
@@ -34,7 +36,9 @@ const (
 type BucketRuntimeClient interface {
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error)
 	ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error)
+	WatchBuckets(ctx context.Context, in *WatchBucketsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchBucketsResponse], error)
 	CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error)
 	DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error)
 	ListBucketClasses(ctx context.Context, in *ListBucketClassesRequest, opts ...grpc.CallOption) (*ListBucketClassesResponse, error)
@@ -68,6 +72,25 @@ func (c *bucketRuntimeClient) ListEvents(ctx context.Context, in *ListEventsRequ
 	return out, nil
 }
 
+func (c *bucketRuntimeClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BucketRuntime_ServiceDesc.Streams[0], BucketRuntime_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, WatchEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BucketRuntime_WatchEventsClient = grpc.ServerStreamingClient[WatchEventsResponse]
+
 func (c *bucketRuntimeClient) ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBucketsResponse)
@@ -78,6 +101,25 @@ func (c *bucketRuntimeClient) ListBuckets(ctx context.Context, in *ListBucketsRe
 	return out, nil
 }
 
+func (c *bucketRuntimeClient) WatchBuckets(ctx context.Context, in *WatchBucketsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchBucketsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BucketRuntime_ServiceDesc.Streams[1], BucketRuntime_WatchBuckets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchBucketsRequest, WatchBucketsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BucketRuntime_WatchBucketsClient = grpc.ServerStreamingClient[WatchBucketsResponse]
+
 func (c *bucketRuntimeClient) CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateBucketResponse)
@@ -114,7 +156,9 @@ func (c *bucketRuntimeClient) ListBucketClasses(ctx context.Context, in *ListBuc
 type BucketRuntimeServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error
 	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error)
+	WatchBuckets(*WatchBucketsRequest, grpc.ServerStreamingServer[WatchBucketsResponse]) error
 	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
 	DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error)
 	ListBucketClasses(context.Context, *ListBucketClassesRequest) (*ListBucketClassesResponse, error)
@@ -134,9 +178,15 @@ func (UnimplementedBucketRuntimeServer) Version(context.Context, *VersionRequest
 func (UnimplementedBucketRuntimeServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedBucketRuntimeServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedBucketRuntimeServer) ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListBuckets not implemented")
 }
+func (UnimplementedBucketRuntimeServer) WatchBuckets(*WatchBucketsRequest, grpc.ServerStreamingServer[WatchBucketsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchBuckets not implemented")
+}
 func (UnimplementedBucketRuntimeServer) CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateBucket not implemented")
 }
@@ -203,6 +253,17 @@ func _BucketRuntime_ListEvents_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketRuntime_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BucketRuntimeServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, WatchEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BucketRuntime_WatchEventsServer = grpc.ServerStreamingServer[WatchEventsResponse]
+
 func _BucketRuntime_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBucketsRequest)
 	if err := dec(in); err != nil {
@@ -221,6 +282,17 @@ func _BucketRuntime_ListBuckets_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BucketRuntime_WatchBuckets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBucketsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BucketRuntimeServer).WatchBuckets(m, &grpc.GenericServerStream[WatchBucketsRequest, WatchBucketsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BucketRuntime_WatchBucketsServer = grpc.ServerStreamingServer[WatchBucketsResponse]
+
 func _BucketRuntime_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBucketRequest)
 	if err := dec(in); err != nil {
@@ -307,6 +379,17 @@ var BucketRuntime_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _BucketRuntime_ListBucketClasses_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _BucketRuntime_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchBuckets",
+			Handler:       _BucketRuntime_WatchBuckets_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "bucket/v1alpha1/api.proto",
 }
@@ -12,11 +12,17 @@ import (
 type RuntimeService interface {
 	Version(context.Context, *api.VersionRequest) (*api.VersionResponse, error)
 	ListEvents(context.Context, *api.ListEventsRequest) (*api.ListEventsResponse, error)
+	WatchEvents(context.Context, *api.WatchEventsRequest) (api.MachineRuntime_WatchEventsClient, error)
 	ListMachines(context.Context, *api.ListMachinesRequest) (*api.ListMachinesResponse, error)
+	WatchMachines(context.Context, *api.WatchMachinesRequest) (api.MachineRuntime_WatchMachinesClient, error)
 	CreateMachine(context.Context, *api.CreateMachineRequest) (*api.CreateMachineResponse, error)
 	DeleteMachine(context.Context, *api.DeleteMachineRequest) (*api.DeleteMachineResponse, error)
 	UpdateMachineAnnotations(context.Context, *api.UpdateMachineAnnotationsRequest) (*api.UpdateMachineAnnotationsResponse, error)
 	UpdateMachinePower(context.Context, *api.UpdateMachinePowerRequest) (*api.UpdateMachinePowerResponse, error)
+	RebootMachine(context.Context, *api.RebootMachineRequest) (*api.RebootMachineResponse, error)
+	UpdateMachineClass(context.Context, *api.UpdateMachineClassRequest) (*api.UpdateMachineClassResponse, error)
+	FreezeMachine(context.Context, *api.FreezeMachineRequest) (*api.FreezeMachineResponse, error)
+	ThawMachine(context.Context, *api.ThawMachineRequest) (*api.ThawMachineResponse, error)
 	AttachVolume(context.Context, *api.AttachVolumeRequest) (*api.AttachVolumeResponse, error)
 	DetachVolume(context.Context, *api.DetachVolumeRequest) (*api.DetachVolumeResponse, error)
 	UpdateVolume(context.Context, *api.UpdateVolumeRequest) (*api.UpdateVolumeResponse, error)
@@ -24,4 +30,7 @@ type RuntimeService interface {
 	DetachNetworkInterface(context.Context, *api.DetachNetworkInterfaceRequest) (*api.DetachNetworkInterfaceResponse, error)
 	Status(context.Context, *api.StatusRequest) (*api.StatusResponse, error)
 	Exec(context.Context, *api.ExecRequest) (*api.ExecResponse, error)
+	GetConsoleLog(context.Context, *api.GetConsoleLogRequest) (api.MachineRuntime_GetConsoleLogClient, error)
+	GetMachineStats(context.Context, *api.GetMachineStatsRequest) (*api.GetMachineStatsResponse, error)
+	ListMachineStats(context.Context, *api.ListMachineStatsRequest) (*api.ListMachineStatsResponse, error)
 }
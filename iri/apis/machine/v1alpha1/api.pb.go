@@ -27,8 +27,9 @@ const (
 type Power int32
 
 const (
-	Power_POWER_ON  Power = 0
-	Power_POWER_OFF Power = 1
+	Power_POWER_ON        Power = 0
+	Power_POWER_OFF       Power = 1
+	Power_POWER_SUSPENDED Power = 2
 )
 
 // Enum value maps for Power.
@@ -36,10 +37,12 @@ var (
 	Power_name = map[int32]string{
 		0: "POWER_ON",
 		1: "POWER_OFF",
+		2: "POWER_SUSPENDED",
 	}
 	Power_value = map[string]int32{
-		"POWER_ON":  0,
-		"POWER_OFF": 1,
+		"POWER_ON":        0,
+		"POWER_OFF":       1,
+		"POWER_SUSPENDED": 2,
 	}
 )
 
@@ -70,6 +73,54 @@ func (Power) EnumDescriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{0}
 }
 
+type RebootMode int32
+
+const (
+	// Gracefully reboot the machine via the guest OS.
+	RebootMode_REBOOT_MODE_SOFT RebootMode = 0
+	// Immediately reset the machine without involving the guest OS.
+	RebootMode_REBOOT_MODE_HARD RebootMode = 1
+)
+
+// Enum value maps for RebootMode.
+var (
+	RebootMode_name = map[int32]string{
+		0: "REBOOT_MODE_SOFT",
+		1: "REBOOT_MODE_HARD",
+	}
+	RebootMode_value = map[string]int32{
+		"REBOOT_MODE_SOFT": 0,
+		"REBOOT_MODE_HARD": 1,
+	}
+)
+
+func (x RebootMode) Enum() *RebootMode {
+	p := new(RebootMode)
+	*p = x
+	return p
+}
+
+func (x RebootMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RebootMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_v1alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (RebootMode) Type() protoreflect.EnumType {
+	return &file_machine_v1alpha1_api_proto_enumTypes[1]
+}
+
+func (x RebootMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RebootMode.Descriptor instead.
+func (RebootMode) EnumDescriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
 type VolumeState int32
 
 const (
@@ -100,11 +151,11 @@ func (x VolumeState) String() string {
 }
 
 func (VolumeState) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_v1alpha1_api_proto_enumTypes[1].Descriptor()
+	return file_machine_v1alpha1_api_proto_enumTypes[2].Descriptor()
 }
 
 func (VolumeState) Type() protoreflect.EnumType {
-	return &file_machine_v1alpha1_api_proto_enumTypes[1]
+	return &file_machine_v1alpha1_api_proto_enumTypes[2]
 }
 
 func (x VolumeState) Number() protoreflect.EnumNumber {
@@ -113,7 +164,7 @@ func (x VolumeState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VolumeState.Descriptor instead.
 func (VolumeState) EnumDescriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{2}
 }
 
 type NetworkInterfaceState int32
@@ -146,11 +197,11 @@ func (x NetworkInterfaceState) String() string {
 }
 
 func (NetworkInterfaceState) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_v1alpha1_api_proto_enumTypes[2].Descriptor()
+	return file_machine_v1alpha1_api_proto_enumTypes[3].Descriptor()
 }
 
 func (NetworkInterfaceState) Type() protoreflect.EnumType {
-	return &file_machine_v1alpha1_api_proto_enumTypes[2]
+	return &file_machine_v1alpha1_api_proto_enumTypes[3]
 }
 
 func (x NetworkInterfaceState) Number() protoreflect.EnumNumber {
@@ -159,7 +210,7 @@ func (x NetworkInterfaceState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetworkInterfaceState.Descriptor instead.
 func (NetworkInterfaceState) EnumDescriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{3}
 }
 
 type MachineState int32
@@ -204,11 +255,11 @@ func (x MachineState) String() string {
 }
 
 func (MachineState) Descriptor() protoreflect.EnumDescriptor {
-	return file_machine_v1alpha1_api_proto_enumTypes[3].Descriptor()
+	return file_machine_v1alpha1_api_proto_enumTypes[4].Descriptor()
 }
 
 func (MachineState) Type() protoreflect.EnumType {
-	return &file_machine_v1alpha1_api_proto_enumTypes[3]
+	return &file_machine_v1alpha1_api_proto_enumTypes[4]
 }
 
 func (x MachineState) Number() protoreflect.EnumNumber {
@@ -217,7 +268,79 @@ func (x MachineState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MachineState.Descriptor instead.
 func (MachineState) EnumDescriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+// RuntimeCapability is an optional feature of a machine runtime.
+type RuntimeCapability int32
+
+const (
+	RuntimeCapability_RUNTIME_CAPABILITY_UNSPECIFIED RuntimeCapability = 0
+	// The runtime implements WatchMachines and WatchEvents.
+	RuntimeCapability_RUNTIME_CAPABILITY_WATCH RuntimeCapability = 1
+	// The runtime implements Exec.
+	RuntimeCapability_RUNTIME_CAPABILITY_EXEC RuntimeCapability = 2
+	// Network interfaces can be attached to and detached from running machines.
+	RuntimeCapability_RUNTIME_CAPABILITY_NETWORK_INTERFACE_HOTPLUG RuntimeCapability = 3
+	// Volumes can be attached to and detached from running machines.
+	RuntimeCapability_RUNTIME_CAPABILITY_VOLUME_HOTPLUG RuntimeCapability = 4
+	// The runtime implements UpdateMachineClass.
+	RuntimeCapability_RUNTIME_CAPABILITY_MACHINE_CLASS_RESIZE RuntimeCapability = 5
+	// The runtime implements GetMachineStats and ListMachineStats.
+	RuntimeCapability_RUNTIME_CAPABILITY_STATS RuntimeCapability = 6
+	// The runtime implements FreezeMachine and ThawMachine.
+	RuntimeCapability_RUNTIME_CAPABILITY_FREEZE RuntimeCapability = 7
+)
+
+// Enum value maps for RuntimeCapability.
+var (
+	RuntimeCapability_name = map[int32]string{
+		0: "RUNTIME_CAPABILITY_UNSPECIFIED",
+		1: "RUNTIME_CAPABILITY_WATCH",
+		2: "RUNTIME_CAPABILITY_EXEC",
+		3: "RUNTIME_CAPABILITY_NETWORK_INTERFACE_HOTPLUG",
+		4: "RUNTIME_CAPABILITY_VOLUME_HOTPLUG",
+		5: "RUNTIME_CAPABILITY_MACHINE_CLASS_RESIZE",
+		6: "RUNTIME_CAPABILITY_STATS",
+		7: "RUNTIME_CAPABILITY_FREEZE",
+	}
+	RuntimeCapability_value = map[string]int32{
+		"RUNTIME_CAPABILITY_UNSPECIFIED":               0,
+		"RUNTIME_CAPABILITY_WATCH":                     1,
+		"RUNTIME_CAPABILITY_EXEC":                      2,
+		"RUNTIME_CAPABILITY_NETWORK_INTERFACE_HOTPLUG": 3,
+		"RUNTIME_CAPABILITY_VOLUME_HOTPLUG":            4,
+		"RUNTIME_CAPABILITY_MACHINE_CLASS_RESIZE":      5,
+		"RUNTIME_CAPABILITY_STATS":                     6,
+		"RUNTIME_CAPABILITY_FREEZE":                    7,
+	}
+)
+
+func (x RuntimeCapability) Enum() *RuntimeCapability {
+	p := new(RuntimeCapability)
+	*p = x
+	return p
+}
+
+func (x RuntimeCapability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuntimeCapability) Descriptor() protoreflect.EnumDescriptor {
+	return file_machine_v1alpha1_api_proto_enumTypes[5].Descriptor()
+}
+
+func (RuntimeCapability) Type() protoreflect.EnumType {
+	return &file_machine_v1alpha1_api_proto_enumTypes[5]
+}
+
+func (x RuntimeCapability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuntimeCapability.Descriptor instead.
+func (RuntimeCapability) EnumDescriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{5}
 }
 
 type VolumeSpec struct {
@@ -292,6 +415,10 @@ type MachineFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	LabelSelector map[string]string      `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// states restricts the listed machines to the given states. If empty, machines in any state are listed.
+	States []MachineState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=machine.v1alpha1.MachineState" json:"states,omitempty"`
+	// classes restricts the listed machines to the given classes. If empty, machines of any class are listed.
+	Classes       []string `protobuf:"bytes,4,rep,name=classes,proto3" json:"classes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -340,6 +467,20 @@ func (x *MachineFilter) GetLabelSelector() map[string]string {
 	return nil
 }
 
+func (x *MachineFilter) GetStates() []MachineState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *MachineFilter) GetClasses() []string {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
 type EventFilter struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1347,8 +1488,10 @@ type VersionResponse struct {
 	// Version of the machine runtime. The string must be
 	// semver-compatible.
 	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Optional features supported by the machine runtime.
+	Capabilities  []RuntimeCapability `protobuf:"varint,3,rep,packed,name=capabilities,proto3,enum=machine.v1alpha1.RuntimeCapability" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VersionResponse) Reset() {
@@ -1395,9 +1538,20 @@ func (x *VersionResponse) GetRuntimeVersion() string {
 	return ""
 }
 
+func (x *VersionResponse) GetCapabilities() []RuntimeCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 type ListMachinesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *MachineFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *MachineFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// limit is the maximum number of machines to return. If zero, all machines are returned.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue_token is the continue_token of a previous response to list the next page of machines.
+	ContinueToken string `protobuf:"bytes,3,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1439,9 +1593,25 @@ func (x *ListMachinesRequest) GetFilter() *MachineFilter {
 	return nil
 }
 
+func (x *ListMachinesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMachinesRequest) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
 type ListMachinesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Machines      []*Machine             `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Machines []*Machine             `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines,omitempty"`
+	// continue_token is set if there are more machines to list.
+	ContinueToken string `protobuf:"bytes,2,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1483,27 +1653,38 @@ func (x *ListMachinesResponse) GetMachines() []*Machine {
 	return nil
 }
 
-type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListMachinesResponse) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
 }
 
-func (x *ListEventsRequest) Reset() {
-	*x = ListEventsRequest{}
+type WatchMachinesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *MachineFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version of the last event received by the client.
+	// If empty, the server first sends the current state as WATCH_EVENT_ADDED events.
+	// Servers that cannot resume from the given resource version do the same.
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchMachinesRequest) Reset() {
+	*x = WatchMachinesRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsRequest) String() string {
+func (x *WatchMachinesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsRequest) ProtoMessage() {}
+func (*WatchMachinesRequest) ProtoMessage() {}
 
-func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchMachinesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1515,39 +1696,50 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
-func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchMachinesRequest.ProtoReflect.Descriptor instead.
+func (*WatchMachinesRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListEventsRequest) GetFilter() *EventFilter {
+func (x *WatchMachinesRequest) GetFilter() *MachineFilter {
 	if x != nil {
 		return x.Filter
 	}
 	return nil
 }
 
-type ListEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*v1alpha11.Event     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WatchMachinesRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
 }
 
-func (x *ListEventsResponse) Reset() {
-	*x = ListEventsResponse{}
+type WatchMachinesResponse struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Type  v1alpha1.WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=meta.v1alpha1.WatchEventType" json:"type,omitempty"`
+	// machine is the machine the event is about. For WATCH_EVENT_DELETED, servers may only
+	// populate the metadata.
+	Machine         *Machine `protobuf:"bytes,2,opt,name=machine,proto3" json:"machine,omitempty"`
+	ResourceVersion string   `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchMachinesResponse) Reset() {
+	*x = WatchMachinesResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListEventsResponse) String() string {
+func (x *WatchMachinesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEventsResponse) ProtoMessage() {}
+func (*WatchMachinesResponse) ProtoMessage() {}
 
-func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchMachinesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1559,39 +1751,57 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
-func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchMachinesResponse.ProtoReflect.Descriptor instead.
+func (*WatchMachinesResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListEventsResponse) GetEvents() []*v1alpha11.Event {
+func (x *WatchMachinesResponse) GetType() v1alpha1.WatchEventType {
 	if x != nil {
-		return x.Events
+		return x.Type
+	}
+	return v1alpha1.WatchEventType(0)
+}
+
+func (x *WatchMachinesResponse) GetMachine() *Machine {
+	if x != nil {
+		return x.Machine
 	}
 	return nil
 }
 
-type CreateMachineRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Machine       *Machine               `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
+func (x *WatchMachinesResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// limit is the maximum number of events to return. If zero, all events are returned.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue_token is the continue_token of a previous response to list the next page of events.
+	ContinueToken string `protobuf:"bytes,3,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMachineRequest) Reset() {
-	*x = CreateMachineRequest{}
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMachineRequest) String() string {
+func (x *ListEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMachineRequest) ProtoMessage() {}
+func (*ListEventsRequest) ProtoMessage() {}
 
-func (x *CreateMachineRequest) ProtoReflect() protoreflect.Message {
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1603,39 +1813,55 @@ func (x *CreateMachineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMachineRequest.ProtoReflect.Descriptor instead.
-func (*CreateMachineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CreateMachineRequest) GetMachine() *Machine {
+func (x *ListEventsRequest) GetFilter() *EventFilter {
 	if x != nil {
-		return x.Machine
+		return x.Filter
 	}
 	return nil
 }
 
-type CreateMachineResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Machine       *Machine               `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
+func (x *ListEventsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+type ListEventsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*v1alpha11.Event     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// continue_token is set if there are more events to list.
+	ContinueToken string `protobuf:"bytes,2,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMachineResponse) Reset() {
-	*x = CreateMachineResponse{}
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMachineResponse) String() string {
+func (x *ListEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMachineResponse) ProtoMessage() {}
+func (*ListEventsResponse) ProtoMessage() {}
 
-func (x *CreateMachineResponse) ProtoReflect() protoreflect.Message {
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1647,39 +1873,49 @@ func (x *CreateMachineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMachineResponse.ProtoReflect.Descriptor instead.
-func (*CreateMachineResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *CreateMachineResponse) GetMachine() *Machine {
+func (x *ListEventsResponse) GetEvents() []*v1alpha11.Event {
 	if x != nil {
-		return x.Machine
+		return x.Events
 	}
 	return nil
 }
 
-type DeleteMachineRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ListEventsResponse) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
 }
 
-func (x *DeleteMachineRequest) Reset() {
-	*x = DeleteMachineRequest{}
+type WatchEventsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version of the last event received by the client.
+	// Events that occurred before the watch was started are not sent; clients catch up via ListEvents.
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMachineRequest) String() string {
+func (x *WatchEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMachineRequest) ProtoMessage() {}
+func (*WatchEventsRequest) ProtoMessage() {}
 
-func (x *DeleteMachineRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1691,38 +1927,47 @@ func (x *DeleteMachineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMachineRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMachineRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteMachineRequest) GetMachineId() string {
+func (x *WatchEventsRequest) GetFilter() *EventFilter {
 	if x != nil {
-		return x.MachineId
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
 	}
 	return ""
 }
 
-type DeleteMachineResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WatchEventsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Event           *v1alpha11.Event       `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ResourceVersion string                 `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteMachineResponse) Reset() {
-	*x = DeleteMachineResponse{}
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMachineResponse) String() string {
+func (x *WatchEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMachineResponse) ProtoMessage() {}
+func (*WatchEventsResponse) ProtoMessage() {}
 
-func (x *DeleteMachineResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1734,33 +1979,46 @@ func (x *DeleteMachineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMachineResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMachineResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{26}
 }
 
-type UpdateMachineAnnotationsRequest struct {
+func (x *WatchEventsResponse) GetEvent() *v1alpha11.Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type CreateMachineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	Annotations   map[string]string      `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Machine       *Machine               `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMachineAnnotationsRequest) Reset() {
-	*x = UpdateMachineAnnotationsRequest{}
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[27]
+func (x *CreateMachineRequest) Reset() {
+	*x = CreateMachineRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMachineAnnotationsRequest) String() string {
+func (x *CreateMachineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMachineAnnotationsRequest) ProtoMessage() {}
+func (*CreateMachineRequest) ProtoMessage() {}
 
-func (x *UpdateMachineAnnotationsRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateMachineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1772,45 +2030,39 @@ func (x *UpdateMachineAnnotationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMachineAnnotationsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMachineAnnotationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateMachineRequest.ProtoReflect.Descriptor instead.
+func (*CreateMachineRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateMachineAnnotationsRequest) GetMachineId() string {
-	if x != nil {
-		return x.MachineId
-	}
-	return ""
-}
-
-func (x *UpdateMachineAnnotationsRequest) GetAnnotations() map[string]string {
+func (x *CreateMachineRequest) GetMachine() *Machine {
 	if x != nil {
-		return x.Annotations
+		return x.Machine
 	}
 	return nil
 }
 
-type UpdateMachineAnnotationsResponse struct {
+type CreateMachineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Machine       *Machine               `protobuf:"bytes,1,opt,name=machine,proto3" json:"machine,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMachineAnnotationsResponse) Reset() {
-	*x = UpdateMachineAnnotationsResponse{}
+func (x *CreateMachineResponse) Reset() {
+	*x = CreateMachineResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMachineAnnotationsResponse) String() string {
+func (x *CreateMachineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMachineAnnotationsResponse) ProtoMessage() {}
+func (*CreateMachineResponse) ProtoMessage() {}
 
-func (x *UpdateMachineAnnotationsResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateMachineResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1822,33 +2074,39 @@ func (x *UpdateMachineAnnotationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMachineAnnotationsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateMachineAnnotationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateMachineResponse.ProtoReflect.Descriptor instead.
+func (*CreateMachineResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{28}
 }
 
-type UpdateMachinePowerRequest struct {
+func (x *CreateMachineResponse) GetMachine() *Machine {
+	if x != nil {
+		return x.Machine
+	}
+	return nil
+}
+
+type DeleteMachineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	Power         Power                  `protobuf:"varint,2,opt,name=power,proto3,enum=machine.v1alpha1.Power" json:"power,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMachinePowerRequest) Reset() {
-	*x = UpdateMachinePowerRequest{}
+func (x *DeleteMachineRequest) Reset() {
+	*x = DeleteMachineRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMachinePowerRequest) String() string {
+func (x *DeleteMachineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMachinePowerRequest) ProtoMessage() {}
+func (*DeleteMachineRequest) ProtoMessage() {}
 
-func (x *UpdateMachinePowerRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteMachineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,45 +2118,38 @@ func (x *UpdateMachinePowerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMachinePowerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMachinePowerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMachineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMachineRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateMachinePowerRequest) GetMachineId() string {
+func (x *DeleteMachineRequest) GetMachineId() string {
 	if x != nil {
 		return x.MachineId
 	}
 	return ""
 }
 
-func (x *UpdateMachinePowerRequest) GetPower() Power {
-	if x != nil {
-		return x.Power
-	}
-	return Power_POWER_ON
-}
-
-type UpdateMachinePowerResponse struct {
+type DeleteMachineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMachinePowerResponse) Reset() {
-	*x = UpdateMachinePowerResponse{}
+func (x *DeleteMachineResponse) Reset() {
+	*x = DeleteMachineResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMachinePowerResponse) String() string {
+func (x *DeleteMachineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMachinePowerResponse) ProtoMessage() {}
+func (*DeleteMachineResponse) ProtoMessage() {}
 
-func (x *UpdateMachinePowerResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteMachineResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1910,33 +2161,33 @@ func (x *UpdateMachinePowerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMachinePowerResponse.ProtoReflect.Descriptor instead.
-func (*UpdateMachinePowerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMachineResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMachineResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{30}
 }
 
-type AttachVolumeRequest struct {
+type UpdateMachineAnnotationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	Volume        *Volume                `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Annotations   map[string]string      `protobuf:"bytes,2,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachVolumeRequest) Reset() {
-	*x = AttachVolumeRequest{}
+func (x *UpdateMachineAnnotationsRequest) Reset() {
+	*x = UpdateMachineAnnotationsRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachVolumeRequest) String() string {
+func (x *UpdateMachineAnnotationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachVolumeRequest) ProtoMessage() {}
+func (*UpdateMachineAnnotationsRequest) ProtoMessage() {}
 
-func (x *AttachVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateMachineAnnotationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1948,45 +2199,45 @@ func (x *AttachVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachVolumeRequest.ProtoReflect.Descriptor instead.
-func (*AttachVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMachineAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMachineAnnotationsRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AttachVolumeRequest) GetMachineId() string {
+func (x *UpdateMachineAnnotationsRequest) GetMachineId() string {
 	if x != nil {
 		return x.MachineId
 	}
 	return ""
 }
 
-func (x *AttachVolumeRequest) GetVolume() *Volume {
+func (x *UpdateMachineAnnotationsRequest) GetAnnotations() map[string]string {
 	if x != nil {
-		return x.Volume
+		return x.Annotations
 	}
 	return nil
 }
 
-type AttachVolumeResponse struct {
+type UpdateMachineAnnotationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachVolumeResponse) Reset() {
-	*x = AttachVolumeResponse{}
+func (x *UpdateMachineAnnotationsResponse) Reset() {
+	*x = UpdateMachineAnnotationsResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachVolumeResponse) String() string {
+func (x *UpdateMachineAnnotationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachVolumeResponse) ProtoMessage() {}
+func (*UpdateMachineAnnotationsResponse) ProtoMessage() {}
 
-func (x *AttachVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateMachineAnnotationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1998,33 +2249,33 @@ func (x *AttachVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachVolumeResponse.ProtoReflect.Descriptor instead.
-func (*AttachVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMachineAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMachineAnnotationsResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{32}
 }
 
-type DetachVolumeRequest struct {
+type UpdateMachinePowerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Power         Power                  `protobuf:"varint,2,opt,name=power,proto3,enum=machine.v1alpha1.Power" json:"power,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetachVolumeRequest) Reset() {
-	*x = DetachVolumeRequest{}
+func (x *UpdateMachinePowerRequest) Reset() {
+	*x = UpdateMachinePowerRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetachVolumeRequest) String() string {
+func (x *UpdateMachinePowerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetachVolumeRequest) ProtoMessage() {}
+func (*UpdateMachinePowerRequest) ProtoMessage() {}
 
-func (x *DetachVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateMachinePowerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2036,45 +2287,45 @@ func (x *DetachVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetachVolumeRequest.ProtoReflect.Descriptor instead.
-func (*DetachVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMachinePowerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMachinePowerRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DetachVolumeRequest) GetMachineId() string {
+func (x *UpdateMachinePowerRequest) GetMachineId() string {
 	if x != nil {
 		return x.MachineId
 	}
 	return ""
 }
 
-func (x *DetachVolumeRequest) GetName() string {
+func (x *UpdateMachinePowerRequest) GetPower() Power {
 	if x != nil {
-		return x.Name
+		return x.Power
 	}
-	return ""
+	return Power_POWER_ON
 }
 
-type DetachVolumeResponse struct {
+type UpdateMachinePowerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetachVolumeResponse) Reset() {
-	*x = DetachVolumeResponse{}
+func (x *UpdateMachinePowerResponse) Reset() {
+	*x = UpdateMachinePowerResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetachVolumeResponse) String() string {
+func (x *UpdateMachinePowerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetachVolumeResponse) ProtoMessage() {}
+func (*UpdateMachinePowerResponse) ProtoMessage() {}
 
-func (x *DetachVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateMachinePowerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2086,33 +2337,33 @@ func (x *DetachVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetachVolumeResponse.ProtoReflect.Descriptor instead.
-func (*DetachVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMachinePowerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMachinePowerResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{34}
 }
 
-type UpdateVolumeRequest struct {
+type RebootMachineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	Volume        *Volume                `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Mode          RebootMode             `protobuf:"varint,2,opt,name=mode,proto3,enum=machine.v1alpha1.RebootMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVolumeRequest) Reset() {
-	*x = UpdateVolumeRequest{}
+func (x *RebootMachineRequest) Reset() {
+	*x = RebootMachineRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVolumeRequest) String() string {
+func (x *RebootMachineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVolumeRequest) ProtoMessage() {}
+func (*RebootMachineRequest) ProtoMessage() {}
 
-func (x *UpdateVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *RebootMachineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2124,45 +2375,45 @@ func (x *UpdateVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVolumeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RebootMachineRequest.ProtoReflect.Descriptor instead.
+func (*RebootMachineRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdateVolumeRequest) GetMachineId() string {
+func (x *RebootMachineRequest) GetMachineId() string {
 	if x != nil {
 		return x.MachineId
 	}
 	return ""
 }
 
-func (x *UpdateVolumeRequest) GetVolume() *Volume {
+func (x *RebootMachineRequest) GetMode() RebootMode {
 	if x != nil {
-		return x.Volume
+		return x.Mode
 	}
-	return nil
+	return RebootMode_REBOOT_MODE_SOFT
 }
 
-type UpdateVolumeResponse struct {
+type RebootMachineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVolumeResponse) Reset() {
-	*x = UpdateVolumeResponse{}
+func (x *RebootMachineResponse) Reset() {
+	*x = RebootMachineResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVolumeResponse) String() string {
+func (x *RebootMachineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVolumeResponse) ProtoMessage() {}
+func (*RebootMachineResponse) ProtoMessage() {}
 
-func (x *UpdateVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *RebootMachineResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2174,33 +2425,33 @@ func (x *UpdateVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVolumeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RebootMachineResponse.ProtoReflect.Descriptor instead.
+func (*RebootMachineResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{36}
 }
 
-type AttachNetworkInterfaceRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	MachineId        string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	NetworkInterface *NetworkInterface      `protobuf:"bytes,2,opt,name=network_interface,json=networkInterface,proto3" json:"network_interface,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type UpdateMachineClassRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	Class         string                 `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachNetworkInterfaceRequest) Reset() {
-	*x = AttachNetworkInterfaceRequest{}
+func (x *UpdateMachineClassRequest) Reset() {
+	*x = UpdateMachineClassRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachNetworkInterfaceRequest) String() string {
+func (x *UpdateMachineClassRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachNetworkInterfaceRequest) ProtoMessage() {}
+func (*UpdateMachineClassRequest) ProtoMessage() {}
 
-func (x *AttachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateMachineClassRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2212,45 +2463,45 @@ func (x *AttachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachNetworkInterfaceRequest.ProtoReflect.Descriptor instead.
-func (*AttachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMachineClassRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMachineClassRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *AttachNetworkInterfaceRequest) GetMachineId() string {
+func (x *UpdateMachineClassRequest) GetMachineId() string {
 	if x != nil {
 		return x.MachineId
 	}
 	return ""
 }
 
-func (x *AttachNetworkInterfaceRequest) GetNetworkInterface() *NetworkInterface {
+func (x *UpdateMachineClassRequest) GetClass() string {
 	if x != nil {
-		return x.NetworkInterface
+		return x.Class
 	}
-	return nil
+	return ""
 }
 
-type AttachNetworkInterfaceResponse struct {
+type UpdateMachineClassResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttachNetworkInterfaceResponse) Reset() {
-	*x = AttachNetworkInterfaceResponse{}
+func (x *UpdateMachineClassResponse) Reset() {
+	*x = UpdateMachineClassResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttachNetworkInterfaceResponse) String() string {
+func (x *UpdateMachineClassResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttachNetworkInterfaceResponse) ProtoMessage() {}
+func (*UpdateMachineClassResponse) ProtoMessage() {}
 
-func (x *AttachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateMachineClassResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2262,33 +2513,37 @@ func (x *AttachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttachNetworkInterfaceResponse.ProtoReflect.Descriptor instead.
-func (*AttachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMachineClassResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMachineClassResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{38}
 }
 
-type DetachNetworkInterfaceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// FreezeMachineRequest freezes the I/O of the machine.
+// FreezeMachine returns once the I/O is frozen. Runtimes applying the freeze asynchronously return a
+// retryable error (e.g. DeadlineExceeded) while it is pending.
+type FreezeMachineRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MachineId string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	// The runtime thaws the machine on its own once the timeout expired.
+	TimeoutSeconds int64 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DetachNetworkInterfaceRequest) Reset() {
-	*x = DetachNetworkInterfaceRequest{}
+func (x *FreezeMachineRequest) Reset() {
+	*x = FreezeMachineRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetachNetworkInterfaceRequest) String() string {
+func (x *FreezeMachineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetachNetworkInterfaceRequest) ProtoMessage() {}
+func (*FreezeMachineRequest) ProtoMessage() {}
 
-func (x *DetachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
+func (x *FreezeMachineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2300,45 +2555,45 @@ func (x *DetachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetachNetworkInterfaceRequest.ProtoReflect.Descriptor instead.
-func (*DetachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeMachineRequest.ProtoReflect.Descriptor instead.
+func (*FreezeMachineRequest) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DetachNetworkInterfaceRequest) GetMachineId() string {
+func (x *FreezeMachineRequest) GetMachineId() string {
 	if x != nil {
 		return x.MachineId
 	}
 	return ""
 }
 
-func (x *DetachNetworkInterfaceRequest) GetName() string {
+func (x *FreezeMachineRequest) GetTimeoutSeconds() int64 {
 	if x != nil {
-		return x.Name
+		return x.TimeoutSeconds
 	}
-	return ""
+	return 0
 }
 
-type DetachNetworkInterfaceResponse struct {
+type FreezeMachineResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DetachNetworkInterfaceResponse) Reset() {
-	*x = DetachNetworkInterfaceResponse{}
+func (x *FreezeMachineResponse) Reset() {
+	*x = FreezeMachineResponse{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DetachNetworkInterfaceResponse) String() string {
+func (x *FreezeMachineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetachNetworkInterfaceResponse) ProtoMessage() {}
+func (*FreezeMachineResponse) ProtoMessage() {}
 
-func (x *DetachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
+func (x *FreezeMachineResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2350,32 +2605,1017 @@ func (x *DetachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetachNetworkInterfaceResponse.ProtoReflect.Descriptor instead.
-func (*DetachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FreezeMachineResponse.ProtoReflect.Descriptor instead.
+func (*FreezeMachineResponse) Descriptor() ([]byte, []int) {
 	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{40}
 }
 
-type StatusRequest struct {
+type ThawMachineRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatusRequest) Reset() {
-	*x = StatusRequest{}
+func (x *ThawMachineRequest) Reset() {
+	*x = ThawMachineRequest{}
 	mi := &file_machine_v1alpha1_api_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
+func (x *ThawMachineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThawMachineRequest) ProtoMessage() {}
+
+func (x *ThawMachineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThawMachineRequest.ProtoReflect.Descriptor instead.
+func (*ThawMachineRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ThawMachineRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type ThawMachineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThawMachineResponse) Reset() {
+	*x = ThawMachineResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThawMachineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThawMachineResponse) ProtoMessage() {}
+
+func (x *ThawMachineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThawMachineResponse.ProtoReflect.Descriptor instead.
+func (*ThawMachineResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{42}
+}
+
+type AttachVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	Volume        *Volume                `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachVolumeRequest) Reset() {
+	*x = AttachVolumeRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachVolumeRequest) ProtoMessage() {}
+
+func (x *AttachVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachVolumeRequest.ProtoReflect.Descriptor instead.
+func (*AttachVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AttachVolumeRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *AttachVolumeRequest) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type AttachVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachVolumeResponse) Reset() {
+	*x = AttachVolumeResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachVolumeResponse) ProtoMessage() {}
+
+func (x *AttachVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachVolumeResponse.ProtoReflect.Descriptor instead.
+func (*AttachVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{44}
+}
+
+type DetachVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachVolumeRequest) Reset() {
+	*x = DetachVolumeRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachVolumeRequest) ProtoMessage() {}
+
+func (x *DetachVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DetachVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DetachVolumeRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *DetachVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DetachVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachVolumeResponse) Reset() {
+	*x = DetachVolumeResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachVolumeResponse) ProtoMessage() {}
+
+func (x *DetachVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DetachVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{46}
+}
+
+type UpdateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	Volume        *Volume                `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVolumeRequest) Reset() {
+	*x = UpdateVolumeRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVolumeRequest) ProtoMessage() {}
+
+func (x *UpdateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateVolumeRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *UpdateVolumeRequest) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type UpdateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVolumeResponse) Reset() {
+	*x = UpdateVolumeResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVolumeResponse) ProtoMessage() {}
+
+func (x *UpdateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{48}
+}
+
+type AttachNetworkInterfaceRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MachineId        string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	NetworkInterface *NetworkInterface      `protobuf:"bytes,2,opt,name=network_interface,json=networkInterface,proto3" json:"network_interface,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AttachNetworkInterfaceRequest) Reset() {
+	*x = AttachNetworkInterfaceRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachNetworkInterfaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachNetworkInterfaceRequest) ProtoMessage() {}
+
+func (x *AttachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachNetworkInterfaceRequest.ProtoReflect.Descriptor instead.
+func (*AttachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AttachNetworkInterfaceRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *AttachNetworkInterfaceRequest) GetNetworkInterface() *NetworkInterface {
+	if x != nil {
+		return x.NetworkInterface
+	}
+	return nil
+}
+
+type AttachNetworkInterfaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachNetworkInterfaceResponse) Reset() {
+	*x = AttachNetworkInterfaceResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachNetworkInterfaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachNetworkInterfaceResponse) ProtoMessage() {}
+
+func (x *AttachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachNetworkInterfaceResponse.ProtoReflect.Descriptor instead.
+func (*AttachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{50}
+}
+
+type DetachNetworkInterfaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachNetworkInterfaceRequest) Reset() {
+	*x = DetachNetworkInterfaceRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachNetworkInterfaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachNetworkInterfaceRequest) ProtoMessage() {}
+
+func (x *DetachNetworkInterfaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachNetworkInterfaceRequest.ProtoReflect.Descriptor instead.
+func (*DetachNetworkInterfaceRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DetachNetworkInterfaceRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *DetachNetworkInterfaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DetachNetworkInterfaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DetachNetworkInterfaceResponse) Reset() {
+	*x = DetachNetworkInterfaceResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DetachNetworkInterfaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachNetworkInterfaceResponse) ProtoMessage() {}
+
+func (x *DetachNetworkInterfaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachNetworkInterfaceResponse.ProtoReflect.Descriptor instead.
+func (*DetachNetworkInterfaceResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{52}
+}
+
+type StatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
 func (x *StatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusRequest) ProtoMessage() {}
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{53}
+}
+
+type StatusResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MachineClassStatus []*MachineClassStatus  `protobuf:"bytes,1,rep,name=machine_class_status,json=machineClassStatus,proto3" json:"machine_class_status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StatusResponse) GetMachineClassStatus() []*MachineClassStatus {
+	if x != nil {
+		return x.MachineClassStatus
+	}
+	return nil
+}
+
+type ExecRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecRequest) Reset() {
+	*x = ExecRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecRequest) ProtoMessage() {}
+
+func (x *ExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
+func (*ExecRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExecRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+type ExecResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecResponse) Reset() {
+	*x = ExecResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecResponse) ProtoMessage() {}
+
+func (x *ExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
+func (*ExecResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExecResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetConsoleLogRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MachineId string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	// follow keeps the stream open and sends new console output as it is produced.
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// tail_lines limits the output to the given number of lines from the end of the log.
+	// If unset, the full log is returned. Zero returns no lines.
+	TailLines *int64 `protobuf:"varint,3,opt,name=tail_lines,json=tailLines,proto3,oneof" json:"tail_lines,omitempty"`
+	// since_time only returns console output produced after the given unix timestamp in nanoseconds.
+	// Zero returns the full log.
+	SinceTime     int64 `protobuf:"varint,4,opt,name=since_time,json=sinceTime,proto3" json:"since_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsoleLogRequest) Reset() {
+	*x = GetConsoleLogRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsoleLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogRequest) ProtoMessage() {}
+
+func (x *GetConsoleLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogRequest.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetConsoleLogRequest) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *GetConsoleLogRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *GetConsoleLogRequest) GetTailLines() int64 {
+	if x != nil && x.TailLines != nil {
+		return *x.TailLines
+	}
+	return 0
+}
+
+func (x *GetConsoleLogRequest) GetSinceTime() int64 {
+	if x != nil {
+		return x.SinceTime
+	}
+	return 0
+}
+
+type GetConsoleLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsoleLogResponse) Reset() {
+	*x = GetConsoleLogResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsoleLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsoleLogResponse) ProtoMessage() {}
+
+func (x *GetConsoleLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsoleLogResponse.ProtoReflect.Descriptor instead.
+func (*GetConsoleLogResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetConsoleLogResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CpuStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// usage_core_nano_seconds is the cumulative CPU time consumed by the machine in core-nanoseconds.
+	UsageCoreNanoSeconds uint64 `protobuf:"varint,1,opt,name=usage_core_nano_seconds,json=usageCoreNanoSeconds,proto3" json:"usage_core_nano_seconds,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CpuStats) Reset() {
+	*x = CpuStats{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CpuStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuStats) ProtoMessage() {}
+
+func (x *CpuStats) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuStats.ProtoReflect.Descriptor instead.
+func (*CpuStats) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CpuStats) GetUsageCoreNanoSeconds() uint64 {
+	if x != nil {
+		return x.UsageCoreNanoSeconds
+	}
+	return 0
+}
+
+type MemoryStats struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UsageBytes     uint64                 `protobuf:"varint,1,opt,name=usage_bytes,json=usageBytes,proto3" json:"usage_bytes,omitempty"`
+	AvailableBytes uint64                 `protobuf:"varint,2,opt,name=available_bytes,json=availableBytes,proto3" json:"available_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MemoryStats) Reset() {
+	*x = MemoryStats{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryStats) ProtoMessage() {}
+
+func (x *MemoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryStats.ProtoReflect.Descriptor instead.
+func (*MemoryStats) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MemoryStats) GetUsageBytes() uint64 {
+	if x != nil {
+		return x.UsageBytes
+	}
+	return 0
+}
+
+func (x *MemoryStats) GetAvailableBytes() uint64 {
+	if x != nil {
+		return x.AvailableBytes
+	}
+	return 0
+}
+
+type VolumeStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the volume in the machine spec.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReadBytes     uint64 `protobuf:"varint,2,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	WriteBytes    uint64 `protobuf:"varint,3,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	ReadOps       uint64 `protobuf:"varint,4,opt,name=read_ops,json=readOps,proto3" json:"read_ops,omitempty"`
+	WriteOps      uint64 `protobuf:"varint,5,opt,name=write_ops,json=writeOps,proto3" json:"write_ops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeStats) Reset() {
+	*x = VolumeStats{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeStats) ProtoMessage() {}
+
+func (x *VolumeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeStats.ProtoReflect.Descriptor instead.
+func (*VolumeStats) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *VolumeStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeStats) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *VolumeStats) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *VolumeStats) GetReadOps() uint64 {
+	if x != nil {
+		return x.ReadOps
+	}
+	return 0
+}
+
+func (x *VolumeStats) GetWriteOps() uint64 {
+	if x != nil {
+		return x.WriteOps
+	}
+	return 0
+}
+
+type NetworkInterfaceStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the name of the network interface in the machine spec.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RxBytes       uint64 `protobuf:"varint,2,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	TxBytes       uint64 `protobuf:"varint,3,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	RxPackets     uint64 `protobuf:"varint,4,opt,name=rx_packets,json=rxPackets,proto3" json:"rx_packets,omitempty"`
+	TxPackets     uint64 `protobuf:"varint,5,opt,name=tx_packets,json=txPackets,proto3" json:"tx_packets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkInterfaceStats) Reset() {
+	*x = NetworkInterfaceStats{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInterfaceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInterfaceStats) ProtoMessage() {}
 
-func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[41]
+func (x *NetworkInterfaceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2386,33 +3626,74 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
-func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use NetworkInterfaceStats.ProtoReflect.Descriptor instead.
+func (*NetworkInterfaceStats) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{62}
 }
 
-type StatusResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	MachineClassStatus []*MachineClassStatus  `protobuf:"bytes,1,rep,name=machine_class_status,json=machineClassStatus,proto3" json:"machine_class_status,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *NetworkInterfaceStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[42]
+func (x *NetworkInterfaceStats) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterfaceStats) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *NetworkInterfaceStats) GetRxPackets() uint64 {
+	if x != nil {
+		return x.RxPackets
+	}
+	return 0
+}
+
+func (x *NetworkInterfaceStats) GetTxPackets() uint64 {
+	if x != nil {
+		return x.TxPackets
+	}
+	return 0
+}
+
+type MachineStats struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	MachineId string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	// timestamp is the unix timestamp in nanoseconds the stats were collected at.
+	Timestamp         int64                    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Cpu               *CpuStats                `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory            *MemoryStats             `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Volumes           []*VolumeStats           `protobuf:"bytes,5,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	NetworkInterfaces []*NetworkInterfaceStats `protobuf:"bytes,6,rep,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MachineStats) Reset() {
+	*x = MachineStats{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatusResponse) String() string {
+func (x *MachineStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*MachineStats) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[42]
+func (x *MachineStats) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2423,40 +3704,75 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use MachineStats.ProtoReflect.Descriptor instead.
+func (*MachineStats) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *StatusResponse) GetMachineClassStatus() []*MachineClassStatus {
+func (x *MachineStats) GetMachineId() string {
 	if x != nil {
-		return x.MachineClassStatus
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *MachineStats) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *MachineStats) GetCpu() *CpuStats {
+	if x != nil {
+		return x.Cpu
 	}
 	return nil
 }
 
-type ExecRequest struct {
+func (x *MachineStats) GetMemory() *MemoryStats {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *MachineStats) GetVolumes() []*VolumeStats {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *MachineStats) GetNetworkInterfaces() []*NetworkInterfaceStats {
+	if x != nil {
+		return x.NetworkInterfaces
+	}
+	return nil
+}
+
+type GetMachineStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MachineId     string                 `protobuf:"bytes,1,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecRequest) Reset() {
-	*x = ExecRequest{}
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[43]
+func (x *GetMachineStatsRequest) Reset() {
+	*x = GetMachineStatsRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecRequest) String() string {
+func (x *GetMachineStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecRequest) ProtoMessage() {}
+func (*GetMachineStatsRequest) ProtoMessage() {}
 
-func (x *ExecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[43]
+func (x *GetMachineStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2467,40 +3783,40 @@ func (x *ExecRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecRequest.ProtoReflect.Descriptor instead.
-func (*ExecRequest) Descriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetMachineStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMachineStatsRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ExecRequest) GetMachineId() string {
+func (x *GetMachineStatsRequest) GetMachineId() string {
 	if x != nil {
 		return x.MachineId
 	}
 	return ""
 }
 
-type ExecResponse struct {
+type GetMachineStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Stats         *MachineStats          `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecResponse) Reset() {
-	*x = ExecResponse{}
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[44]
+func (x *GetMachineStatsResponse) Reset() {
+	*x = GetMachineStatsResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecResponse) String() string {
+func (x *GetMachineStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecResponse) ProtoMessage() {}
+func (*GetMachineStatsResponse) ProtoMessage() {}
 
-func (x *ExecResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[44]
+func (x *GetMachineStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2511,28 +3827,120 @@ func (x *ExecResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecResponse.ProtoReflect.Descriptor instead.
-func (*ExecResponse) Descriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use GetMachineStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetMachineStatsResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ExecResponse) GetUrl() string {
+func (x *GetMachineStatsResponse) GetStats() *MachineStats {
 	if x != nil {
-		return x.Url
+		return x.Stats
 	}
-	return ""
+	return nil
+}
+
+type ListMachineStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *MachineFilter         `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachineStatsRequest) Reset() {
+	*x = ListMachineStatsRequest{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachineStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineStatsRequest) ProtoMessage() {}
+
+func (x *ListMachineStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListMachineStatsRequest) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListMachineStatsRequest) GetFilter() *MachineFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListMachineStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*MachineStats        `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMachineStatsResponse) Reset() {
+	*x = ListMachineStatsResponse{}
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMachineStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMachineStatsResponse) ProtoMessage() {}
+
+func (x *ListMachineStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMachineStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListMachineStatsResponse) Descriptor() ([]byte, []int) {
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListMachineStatsResponse) GetStats() []*MachineStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
 }
 
 type GuestConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	SshPublicKeys []string               `protobuf:"bytes,2,rep,name=ssh_public_keys,json=sshPublicKeys,proto3" json:"ssh_public_keys,omitempty"`
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	NtpServers    []string               `protobuf:"bytes,4,rep,name=ntp_servers,json=ntpServers,proto3" json:"ntp_servers,omitempty"`
+	UserData      []byte                 `protobuf:"bytes,5,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GuestConfig) Reset() {
 	*x = GuestConfig{}
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[45]
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2544,7 +3952,7 @@ func (x *GuestConfig) String() string {
 func (*GuestConfig) ProtoMessage() {}
 
 func (x *GuestConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_machine_v1alpha1_api_proto_msgTypes[45]
+	mi := &file_machine_v1alpha1_api_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2557,7 +3965,7 @@ func (x *GuestConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuestConfig.ProtoReflect.Descriptor instead.
 func (*GuestConfig) Descriptor() ([]byte, []int) {
-	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{45}
+	return file_machine_v1alpha1_api_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *GuestConfig) GetHostname() string {
@@ -2567,6 +3975,34 @@ func (x *GuestConfig) GetHostname() string {
 	return ""
 }
 
+func (x *GuestConfig) GetSshPublicKeys() []string {
+	if x != nil {
+		return x.SshPublicKeys
+	}
+	return nil
+}
+
+func (x *GuestConfig) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GuestConfig) GetNtpServers() []string {
+	if x != nil {
+		return x.NtpServers
+	}
+	return nil
+}
+
+func (x *GuestConfig) GetUserData() []byte {
+	if x != nil {
+		return x.UserData
+	}
+	return nil
+}
+
 var File_machine_v1alpha1_api_proto protoreflect.FileDescriptor
 
 const file_machine_v1alpha1_api_proto_rawDesc = "" +
@@ -2586,10 +4022,12 @@ const file_machine_v1alpha1_api_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
 	"\x0fSecretDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xbc\x01\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x8e\x02\n" +
 	"\rMachineFilter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12Y\n" +
-	"\x0elabel_selector\x18\x02 \x03(\v22.machine.v1alpha1.MachineFilter.LabelSelectorEntryR\rlabelSelector\x1a@\n" +
+	"\x0elabel_selector\x18\x02 \x03(\v22.machine.v1alpha1.MachineFilter.LabelSelectorEntryR\rlabelSelector\x126\n" +
+	"\x06states\x18\x03 \x03(\x0e2\x1e.machine.v1alpha1.MachineStateR\x06states\x12\x18\n" +
+	"\aclasses\x18\x04 \x03(\tR\aclasses\x1a@\n" +
 	"\x12LabelSelectorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x88\x02\n" +
@@ -2690,18 +4128,38 @@ const file_machine_v1alpha1_api_proto_rawDesc = "" +
 	"\rmachine_class\x18\x01 \x01(\v2\x1e.machine.v1alpha1.MachineClassR\fmachineClass\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x03R\bquantity\"*\n" +
 	"\x0eVersionRequest\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"]\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\xa6\x01\n" +
 	"\x0fVersionResponse\x12!\n" +
 	"\fruntime_name\x18\x01 \x01(\tR\vruntimeName\x12'\n" +
-	"\x0fruntime_version\x18\x02 \x01(\tR\x0eruntimeVersion\"N\n" +
+	"\x0fruntime_version\x18\x02 \x01(\tR\x0eruntimeVersion\x12G\n" +
+	"\fcapabilities\x18\x03 \x03(\x0e2#.machine.v1alpha1.RuntimeCapabilityR\fcapabilities\"\x8b\x01\n" +
 	"\x13ListMachinesRequest\x127\n" +
-	"\x06filter\x18\x01 \x01(\v2\x1f.machine.v1alpha1.MachineFilterR\x06filter\"M\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1f.machine.v1alpha1.MachineFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12%\n" +
+	"\x0econtinue_token\x18\x03 \x01(\tR\rcontinueToken\"t\n" +
 	"\x14ListMachinesResponse\x125\n" +
-	"\bmachines\x18\x01 \x03(\v2\x19.machine.v1alpha1.MachineR\bmachines\"J\n" +
+	"\bmachines\x18\x01 \x03(\v2\x19.machine.v1alpha1.MachineR\bmachines\x12%\n" +
+	"\x0econtinue_token\x18\x02 \x01(\tR\rcontinueToken\"z\n" +
+	"\x14WatchMachinesRequest\x127\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1f.machine.v1alpha1.MachineFilterR\x06filter\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"\xaa\x01\n" +
+	"\x15WatchMachinesResponse\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.meta.v1alpha1.WatchEventTypeR\x04type\x123\n" +
+	"\amachine\x18\x02 \x01(\v2\x19.machine.v1alpha1.MachineR\amachine\x12)\n" +
+	"\x10resource_version\x18\x03 \x01(\tR\x0fresourceVersion\"\x87\x01\n" +
 	"\x11ListEventsRequest\x125\n" +
-	"\x06filter\x18\x01 \x01(\v2\x1d.machine.v1alpha1.EventFilterR\x06filter\"C\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.machine.v1alpha1.EventFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12%\n" +
+	"\x0econtinue_token\x18\x03 \x01(\tR\rcontinueToken\"j\n" +
 	"\x12ListEventsResponse\x12-\n" +
-	"\x06events\x18\x01 \x03(\v2\x15.event.v1alpha1.EventR\x06events\"K\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.event.v1alpha1.EventR\x06events\x12%\n" +
+	"\x0econtinue_token\x18\x02 \x01(\tR\rcontinueToken\"v\n" +
+	"\x12WatchEventsRequest\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.machine.v1alpha1.EventFilterR\x06filter\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"m\n" +
+	"\x13WatchEventsResponse\x12+\n" +
+	"\x05event\x18\x01 \x01(\v2\x15.event.v1alpha1.EventR\x05event\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"K\n" +
 	"\x14CreateMachineRequest\x123\n" +
 	"\amachine\x18\x01 \x01(\v2\x19.machine.v1alpha1.MachineR\amachine\"L\n" +
 	"\x15CreateMachineResponse\x123\n" +
@@ -2722,7 +4180,26 @@ const file_machine_v1alpha1_api_proto_rawDesc = "" +
 	"\n" +
 	"machine_id\x18\x01 \x01(\tR\tmachineId\x12-\n" +
 	"\x05power\x18\x02 \x01(\x0e2\x17.machine.v1alpha1.PowerR\x05power\"\x1c\n" +
-	"\x1aUpdateMachinePowerResponse\"f\n" +
+	"\x1aUpdateMachinePowerResponse\"g\n" +
+	"\x14RebootMachineRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x120\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x1c.machine.v1alpha1.RebootModeR\x04mode\"\x17\n" +
+	"\x15RebootMachineResponse\"P\n" +
+	"\x19UpdateMachineClassRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x14\n" +
+	"\x05class\x18\x02 \x01(\tR\x05class\"\x1c\n" +
+	"\x1aUpdateMachineClassResponse\"^\n" +
+	"\x14FreezeMachineRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12'\n" +
+	"\x0ftimeout_seconds\x18\x02 \x01(\x03R\x0etimeoutSeconds\"\x17\n" +
+	"\x15FreezeMachineResponse\"3\n" +
+	"\x12ThawMachineRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\"\x15\n" +
+	"\x13ThawMachineResponse\"f\n" +
 	"\x13AttachVolumeRequest\x12\x1d\n" +
 	"\n" +
 	"machine_id\x18\x01 \x01(\tR\tmachineId\x120\n" +
@@ -2755,12 +4232,72 @@ const file_machine_v1alpha1_api_proto_rawDesc = "" +
 	"\n" +
 	"machine_id\x18\x01 \x01(\tR\tmachineId\" \n" +
 	"\fExecResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\")\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\x9f\x01\n" +
+	"\x14GetConsoleLogRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x16\n" +
+	"\x06follow\x18\x02 \x01(\bR\x06follow\x12\"\n" +
+	"\n" +
+	"tail_lines\x18\x03 \x01(\x03H\x00R\ttailLines\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"since_time\x18\x04 \x01(\x03R\tsinceTimeB\r\n" +
+	"\v_tail_lines\"+\n" +
+	"\x15GetConsoleLogResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"A\n" +
+	"\bCpuStats\x125\n" +
+	"\x17usage_core_nano_seconds\x18\x01 \x01(\x04R\x14usageCoreNanoSeconds\"W\n" +
+	"\vMemoryStats\x12\x1f\n" +
+	"\vusage_bytes\x18\x01 \x01(\x04R\n" +
+	"usageBytes\x12'\n" +
+	"\x0favailable_bytes\x18\x02 \x01(\x04R\x0eavailableBytes\"\x99\x01\n" +
+	"\vVolumeStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"read_bytes\x18\x02 \x01(\x04R\treadBytes\x12\x1f\n" +
+	"\vwrite_bytes\x18\x03 \x01(\x04R\n" +
+	"writeBytes\x12\x19\n" +
+	"\bread_ops\x18\x04 \x01(\x04R\areadOps\x12\x1b\n" +
+	"\twrite_ops\x18\x05 \x01(\x04R\bwriteOps\"\x9f\x01\n" +
+	"\x15NetworkInterfaceStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x19\n" +
+	"\brx_bytes\x18\x02 \x01(\x04R\arxBytes\x12\x19\n" +
+	"\btx_bytes\x18\x03 \x01(\x04R\atxBytes\x12\x1d\n" +
+	"\n" +
+	"rx_packets\x18\x04 \x01(\x04R\trxPackets\x12\x1d\n" +
+	"\n" +
+	"tx_packets\x18\x05 \x01(\x04R\ttxPackets\"\xc1\x02\n" +
+	"\fMachineStats\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12,\n" +
+	"\x03cpu\x18\x03 \x01(\v2\x1a.machine.v1alpha1.CpuStatsR\x03cpu\x125\n" +
+	"\x06memory\x18\x04 \x01(\v2\x1d.machine.v1alpha1.MemoryStatsR\x06memory\x127\n" +
+	"\avolumes\x18\x05 \x03(\v2\x1d.machine.v1alpha1.VolumeStatsR\avolumes\x12V\n" +
+	"\x12network_interfaces\x18\x06 \x03(\v2'.machine.v1alpha1.NetworkInterfaceStatsR\x11networkInterfaces\"7\n" +
+	"\x16GetMachineStatsRequest\x12\x1d\n" +
+	"\n" +
+	"machine_id\x18\x01 \x01(\tR\tmachineId\"O\n" +
+	"\x17GetMachineStatsResponse\x124\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1e.machine.v1alpha1.MachineStatsR\x05stats\"R\n" +
+	"\x17ListMachineStatsRequest\x127\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1f.machine.v1alpha1.MachineFilterR\x06filter\"P\n" +
+	"\x18ListMachineStatsResponse\x124\n" +
+	"\x05stats\x18\x01 \x03(\v2\x1e.machine.v1alpha1.MachineStatsR\x05stats\"\xab\x01\n" +
 	"\vGuestConfig\x12\x1a\n" +
-	"\bhostname\x18\x01 \x01(\tR\bhostname*$\n" +
+	"\bhostname\x18\x01 \x01(\tR\bhostname\x12&\n" +
+	"\x0fssh_public_keys\x18\x02 \x03(\tR\rsshPublicKeys\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x1f\n" +
+	"\vntp_servers\x18\x04 \x03(\tR\n" +
+	"ntpServers\x12\x1b\n" +
+	"\tuser_data\x18\x05 \x01(\fR\buserData*9\n" +
 	"\x05Power\x12\f\n" +
 	"\bPOWER_ON\x10\x00\x12\r\n" +
-	"\tPOWER_OFF\x10\x01*6\n" +
+	"\tPOWER_OFF\x10\x01\x12\x13\n" +
+	"\x0fPOWER_SUSPENDED\x10\x02*8\n" +
+	"\n" +
+	"RebootMode\x12\x14\n" +
+	"\x10REBOOT_MODE_SOFT\x10\x00\x12\x14\n" +
+	"\x10REBOOT_MODE_HARD\x10\x01*6\n" +
 	"\vVolumeState\x12\x12\n" +
 	"\x0eVOLUME_PENDING\x10\x00\x12\x13\n" +
 	"\x0fVOLUME_ATTACHED\x10\x01*V\n" +
@@ -2773,23 +4310,41 @@ const file_machine_v1alpha1_api_proto_rawDesc = "" +
 	"\x11MACHINE_SUSPENDED\x10\x02\x12\x16\n" +
 	"\x12MACHINE_TERMINATED\x10\x03\x12\x17\n" +
 	"\x13MACHINE_TERMINATING\x10\x04\x12\x13\n" +
-	"\x0fMACHINE_STOPPED\x10\x052\x8c\v\n" +
+	"\x0fMACHINE_STOPPED\x10\x05*\xb5\x02\n" +
+	"\x11RuntimeCapability\x12\"\n" +
+	"\x1eRUNTIME_CAPABILITY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RUNTIME_CAPABILITY_WATCH\x10\x01\x12\x1b\n" +
+	"\x17RUNTIME_CAPABILITY_EXEC\x10\x02\x120\n" +
+	",RUNTIME_CAPABILITY_NETWORK_INTERFACE_HOTPLUG\x10\x03\x12%\n" +
+	"!RUNTIME_CAPABILITY_VOLUME_HOTPLUG\x10\x04\x12+\n" +
+	"'RUNTIME_CAPABILITY_MACHINE_CLASS_RESIZE\x10\x05\x12\x1c\n" +
+	"\x18RUNTIME_CAPABILITY_STATS\x10\x06\x12\x1d\n" +
+	"\x19RUNTIME_CAPABILITY_FREEZE\x10\a2\x9a\x12\n" +
 	"\x0eMachineRuntime\x12P\n" +
 	"\aVersion\x12 .machine.v1alpha1.VersionRequest\x1a!.machine.v1alpha1.VersionResponse\"\x00\x12Y\n" +
 	"\n" +
-	"ListEvents\x12#.machine.v1alpha1.ListEventsRequest\x1a$.machine.v1alpha1.ListEventsResponse\"\x00\x12_\n" +
-	"\fListMachines\x12%.machine.v1alpha1.ListMachinesRequest\x1a&.machine.v1alpha1.ListMachinesResponse\"\x00\x12b\n" +
+	"ListEvents\x12#.machine.v1alpha1.ListEventsRequest\x1a$.machine.v1alpha1.ListEventsResponse\"\x00\x12^\n" +
+	"\vWatchEvents\x12$.machine.v1alpha1.WatchEventsRequest\x1a%.machine.v1alpha1.WatchEventsResponse\"\x000\x01\x12_\n" +
+	"\fListMachines\x12%.machine.v1alpha1.ListMachinesRequest\x1a&.machine.v1alpha1.ListMachinesResponse\"\x00\x12d\n" +
+	"\rWatchMachines\x12&.machine.v1alpha1.WatchMachinesRequest\x1a'.machine.v1alpha1.WatchMachinesResponse\"\x000\x01\x12b\n" +
 	"\rCreateMachine\x12&.machine.v1alpha1.CreateMachineRequest\x1a'.machine.v1alpha1.CreateMachineResponse\"\x00\x12b\n" +
 	"\rDeleteMachine\x12&.machine.v1alpha1.DeleteMachineRequest\x1a'.machine.v1alpha1.DeleteMachineResponse\"\x00\x12\x81\x01\n" +
 	"\x18UpdateMachineAnnotations\x121.machine.v1alpha1.UpdateMachineAnnotationsRequest\x1a2.machine.v1alpha1.UpdateMachineAnnotationsResponse\x12o\n" +
-	"\x12UpdateMachinePower\x12+.machine.v1alpha1.UpdateMachinePowerRequest\x1a,.machine.v1alpha1.UpdateMachinePowerResponse\x12_\n" +
+	"\x12UpdateMachinePower\x12+.machine.v1alpha1.UpdateMachinePowerRequest\x1a,.machine.v1alpha1.UpdateMachinePowerResponse\x12`\n" +
+	"\rRebootMachine\x12&.machine.v1alpha1.RebootMachineRequest\x1a'.machine.v1alpha1.RebootMachineResponse\x12o\n" +
+	"\x12UpdateMachineClass\x12+.machine.v1alpha1.UpdateMachineClassRequest\x1a,.machine.v1alpha1.UpdateMachineClassResponse\x12`\n" +
+	"\rFreezeMachine\x12&.machine.v1alpha1.FreezeMachineRequest\x1a'.machine.v1alpha1.FreezeMachineResponse\x12Z\n" +
+	"\vThawMachine\x12$.machine.v1alpha1.ThawMachineRequest\x1a%.machine.v1alpha1.ThawMachineResponse\x12_\n" +
 	"\fAttachVolume\x12%.machine.v1alpha1.AttachVolumeRequest\x1a&.machine.v1alpha1.AttachVolumeResponse\"\x00\x12_\n" +
 	"\fDetachVolume\x12%.machine.v1alpha1.DetachVolumeRequest\x1a&.machine.v1alpha1.DetachVolumeResponse\"\x00\x12_\n" +
 	"\fUpdateVolume\x12%.machine.v1alpha1.UpdateVolumeRequest\x1a&.machine.v1alpha1.UpdateVolumeResponse\"\x00\x12{\n" +
 	"\x16AttachNetworkInterface\x12/.machine.v1alpha1.AttachNetworkInterfaceRequest\x1a0.machine.v1alpha1.AttachNetworkInterfaceResponse\x12{\n" +
 	"\x16DetachNetworkInterface\x12/.machine.v1alpha1.DetachNetworkInterfaceRequest\x1a0.machine.v1alpha1.DetachNetworkInterfaceResponse\x12K\n" +
 	"\x06Status\x12\x1f.machine.v1alpha1.StatusRequest\x1a .machine.v1alpha1.StatusResponse\x12E\n" +
-	"\x04Exec\x12\x1d.machine.v1alpha1.ExecRequest\x1a\x1e.machine.v1alpha1.ExecResponseB<Z:github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1b\x06proto3"
+	"\x04Exec\x12\x1d.machine.v1alpha1.ExecRequest\x1a\x1e.machine.v1alpha1.ExecResponse\x12b\n" +
+	"\rGetConsoleLog\x12&.machine.v1alpha1.GetConsoleLogRequest\x1a'.machine.v1alpha1.GetConsoleLogResponse0\x01\x12f\n" +
+	"\x0fGetMachineStats\x12(.machine.v1alpha1.GetMachineStatsRequest\x1a).machine.v1alpha1.GetMachineStatsResponse\x12i\n" +
+	"\x10ListMachineStats\x12).machine.v1alpha1.ListMachineStatsRequest\x1a*.machine.v1alpha1.ListMachineStatsResponseB<Z:github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1b\x06proto3"
 
 var (
 	file_machine_v1alpha1_api_proto_rawDescOnce sync.Once
@@ -2803,145 +4358,204 @@ func file_machine_v1alpha1_api_proto_rawDescGZIP() []byte {
 	return file_machine_v1alpha1_api_proto_rawDescData
 }
 
-var file_machine_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_machine_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_machine_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_machine_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 79)
 var file_machine_v1alpha1_api_proto_goTypes = []any{
 	(Power)(0),                               // 0: machine.v1alpha1.Power
-	(VolumeState)(0),                         // 1: machine.v1alpha1.VolumeState
-	(NetworkInterfaceState)(0),               // 2: machine.v1alpha1.NetworkInterfaceState
-	(MachineState)(0),                        // 3: machine.v1alpha1.MachineState
-	(*VolumeSpec)(nil),                       // 4: machine.v1alpha1.VolumeSpec
-	(*MachineFilter)(nil),                    // 5: machine.v1alpha1.MachineFilter
-	(*EventFilter)(nil),                      // 6: machine.v1alpha1.EventFilter
-	(*MachineClassCapabilities)(nil),         // 7: machine.v1alpha1.MachineClassCapabilities
-	(*Machine)(nil),                          // 8: machine.v1alpha1.Machine
-	(*ImageSpec)(nil),                        // 9: machine.v1alpha1.ImageSpec
-	(*LocalDisk)(nil),                        // 10: machine.v1alpha1.LocalDisk
-	(*VolumeConnection)(nil),                 // 11: machine.v1alpha1.VolumeConnection
-	(*Volume)(nil),                           // 12: machine.v1alpha1.Volume
-	(*NetworkInterface)(nil),                 // 13: machine.v1alpha1.NetworkInterface
-	(*MachineSpec)(nil),                      // 14: machine.v1alpha1.MachineSpec
-	(*MachineStatus)(nil),                    // 15: machine.v1alpha1.MachineStatus
-	(*Conditions)(nil),                       // 16: machine.v1alpha1.Conditions
-	(*VolumeStatus)(nil),                     // 17: machine.v1alpha1.VolumeStatus
-	(*NetworkInterfaceStatus)(nil),           // 18: machine.v1alpha1.NetworkInterfaceStatus
-	(*MachineClass)(nil),                     // 19: machine.v1alpha1.MachineClass
-	(*MachineClassStatus)(nil),               // 20: machine.v1alpha1.MachineClassStatus
-	(*VersionRequest)(nil),                   // 21: machine.v1alpha1.VersionRequest
-	(*VersionResponse)(nil),                  // 22: machine.v1alpha1.VersionResponse
-	(*ListMachinesRequest)(nil),              // 23: machine.v1alpha1.ListMachinesRequest
-	(*ListMachinesResponse)(nil),             // 24: machine.v1alpha1.ListMachinesResponse
-	(*ListEventsRequest)(nil),                // 25: machine.v1alpha1.ListEventsRequest
-	(*ListEventsResponse)(nil),               // 26: machine.v1alpha1.ListEventsResponse
-	(*CreateMachineRequest)(nil),             // 27: machine.v1alpha1.CreateMachineRequest
-	(*CreateMachineResponse)(nil),            // 28: machine.v1alpha1.CreateMachineResponse
-	(*DeleteMachineRequest)(nil),             // 29: machine.v1alpha1.DeleteMachineRequest
-	(*DeleteMachineResponse)(nil),            // 30: machine.v1alpha1.DeleteMachineResponse
-	(*UpdateMachineAnnotationsRequest)(nil),  // 31: machine.v1alpha1.UpdateMachineAnnotationsRequest
-	(*UpdateMachineAnnotationsResponse)(nil), // 32: machine.v1alpha1.UpdateMachineAnnotationsResponse
-	(*UpdateMachinePowerRequest)(nil),        // 33: machine.v1alpha1.UpdateMachinePowerRequest
-	(*UpdateMachinePowerResponse)(nil),       // 34: machine.v1alpha1.UpdateMachinePowerResponse
-	(*AttachVolumeRequest)(nil),              // 35: machine.v1alpha1.AttachVolumeRequest
-	(*AttachVolumeResponse)(nil),             // 36: machine.v1alpha1.AttachVolumeResponse
-	(*DetachVolumeRequest)(nil),              // 37: machine.v1alpha1.DetachVolumeRequest
-	(*DetachVolumeResponse)(nil),             // 38: machine.v1alpha1.DetachVolumeResponse
-	(*UpdateVolumeRequest)(nil),              // 39: machine.v1alpha1.UpdateVolumeRequest
-	(*UpdateVolumeResponse)(nil),             // 40: machine.v1alpha1.UpdateVolumeResponse
-	(*AttachNetworkInterfaceRequest)(nil),    // 41: machine.v1alpha1.AttachNetworkInterfaceRequest
-	(*AttachNetworkInterfaceResponse)(nil),   // 42: machine.v1alpha1.AttachNetworkInterfaceResponse
-	(*DetachNetworkInterfaceRequest)(nil),    // 43: machine.v1alpha1.DetachNetworkInterfaceRequest
-	(*DetachNetworkInterfaceResponse)(nil),   // 44: machine.v1alpha1.DetachNetworkInterfaceResponse
-	(*StatusRequest)(nil),                    // 45: machine.v1alpha1.StatusRequest
-	(*StatusResponse)(nil),                   // 46: machine.v1alpha1.StatusResponse
-	(*ExecRequest)(nil),                      // 47: machine.v1alpha1.ExecRequest
-	(*ExecResponse)(nil),                     // 48: machine.v1alpha1.ExecResponse
-	(*GuestConfig)(nil),                      // 49: machine.v1alpha1.GuestConfig
-	nil,                                      // 50: machine.v1alpha1.VolumeSpec.AttributesEntry
-	nil,                                      // 51: machine.v1alpha1.VolumeSpec.SecretDataEntry
-	nil,                                      // 52: machine.v1alpha1.MachineFilter.LabelSelectorEntry
-	nil,                                      // 53: machine.v1alpha1.EventFilter.LabelSelectorEntry
-	nil,                                      // 54: machine.v1alpha1.MachineClassCapabilities.ResourcesEntry
-	nil,                                      // 55: machine.v1alpha1.VolumeConnection.AttributesEntry
-	nil,                                      // 56: machine.v1alpha1.VolumeConnection.SecretDataEntry
-	nil,                                      // 57: machine.v1alpha1.VolumeConnection.EncryptionDataEntry
-	nil,                                      // 58: machine.v1alpha1.NetworkInterface.AttributesEntry
-	nil,                                      // 59: machine.v1alpha1.UpdateMachineAnnotationsRequest.AnnotationsEntry
-	(*v1alpha1.ObjectMetadata)(nil),          // 60: meta.v1alpha1.ObjectMetadata
-	(*v1alpha11.Event)(nil),                  // 61: event.v1alpha1.Event
+	(RebootMode)(0),                          // 1: machine.v1alpha1.RebootMode
+	(VolumeState)(0),                         // 2: machine.v1alpha1.VolumeState
+	(NetworkInterfaceState)(0),               // 3: machine.v1alpha1.NetworkInterfaceState
+	(MachineState)(0),                        // 4: machine.v1alpha1.MachineState
+	(RuntimeCapability)(0),                   // 5: machine.v1alpha1.RuntimeCapability
+	(*VolumeSpec)(nil),                       // 6: machine.v1alpha1.VolumeSpec
+	(*MachineFilter)(nil),                    // 7: machine.v1alpha1.MachineFilter
+	(*EventFilter)(nil),                      // 8: machine.v1alpha1.EventFilter
+	(*MachineClassCapabilities)(nil),         // 9: machine.v1alpha1.MachineClassCapabilities
+	(*Machine)(nil),                          // 10: machine.v1alpha1.Machine
+	(*ImageSpec)(nil),                        // 11: machine.v1alpha1.ImageSpec
+	(*LocalDisk)(nil),                        // 12: machine.v1alpha1.LocalDisk
+	(*VolumeConnection)(nil),                 // 13: machine.v1alpha1.VolumeConnection
+	(*Volume)(nil),                           // 14: machine.v1alpha1.Volume
+	(*NetworkInterface)(nil),                 // 15: machine.v1alpha1.NetworkInterface
+	(*MachineSpec)(nil),                      // 16: machine.v1alpha1.MachineSpec
+	(*MachineStatus)(nil),                    // 17: machine.v1alpha1.MachineStatus
+	(*Conditions)(nil),                       // 18: machine.v1alpha1.Conditions
+	(*VolumeStatus)(nil),                     // 19: machine.v1alpha1.VolumeStatus
+	(*NetworkInterfaceStatus)(nil),           // 20: machine.v1alpha1.NetworkInterfaceStatus
+	(*MachineClass)(nil),                     // 21: machine.v1alpha1.MachineClass
+	(*MachineClassStatus)(nil),               // 22: machine.v1alpha1.MachineClassStatus
+	(*VersionRequest)(nil),                   // 23: machine.v1alpha1.VersionRequest
+	(*VersionResponse)(nil),                  // 24: machine.v1alpha1.VersionResponse
+	(*ListMachinesRequest)(nil),              // 25: machine.v1alpha1.ListMachinesRequest
+	(*ListMachinesResponse)(nil),             // 26: machine.v1alpha1.ListMachinesResponse
+	(*WatchMachinesRequest)(nil),             // 27: machine.v1alpha1.WatchMachinesRequest
+	(*WatchMachinesResponse)(nil),            // 28: machine.v1alpha1.WatchMachinesResponse
+	(*ListEventsRequest)(nil),                // 29: machine.v1alpha1.ListEventsRequest
+	(*ListEventsResponse)(nil),               // 30: machine.v1alpha1.ListEventsResponse
+	(*WatchEventsRequest)(nil),               // 31: machine.v1alpha1.WatchEventsRequest
+	(*WatchEventsResponse)(nil),              // 32: machine.v1alpha1.WatchEventsResponse
+	(*CreateMachineRequest)(nil),             // 33: machine.v1alpha1.CreateMachineRequest
+	(*CreateMachineResponse)(nil),            // 34: machine.v1alpha1.CreateMachineResponse
+	(*DeleteMachineRequest)(nil),             // 35: machine.v1alpha1.DeleteMachineRequest
+	(*DeleteMachineResponse)(nil),            // 36: machine.v1alpha1.DeleteMachineResponse
+	(*UpdateMachineAnnotationsRequest)(nil),  // 37: machine.v1alpha1.UpdateMachineAnnotationsRequest
+	(*UpdateMachineAnnotationsResponse)(nil), // 38: machine.v1alpha1.UpdateMachineAnnotationsResponse
+	(*UpdateMachinePowerRequest)(nil),        // 39: machine.v1alpha1.UpdateMachinePowerRequest
+	(*UpdateMachinePowerResponse)(nil),       // 40: machine.v1alpha1.UpdateMachinePowerResponse
+	(*RebootMachineRequest)(nil),             // 41: machine.v1alpha1.RebootMachineRequest
+	(*RebootMachineResponse)(nil),            // 42: machine.v1alpha1.RebootMachineResponse
+	(*UpdateMachineClassRequest)(nil),        // 43: machine.v1alpha1.UpdateMachineClassRequest
+	(*UpdateMachineClassResponse)(nil),       // 44: machine.v1alpha1.UpdateMachineClassResponse
+	(*FreezeMachineRequest)(nil),             // 45: machine.v1alpha1.FreezeMachineRequest
+	(*FreezeMachineResponse)(nil),            // 46: machine.v1alpha1.FreezeMachineResponse
+	(*ThawMachineRequest)(nil),               // 47: machine.v1alpha1.ThawMachineRequest
+	(*ThawMachineResponse)(nil),              // 48: machine.v1alpha1.ThawMachineResponse
+	(*AttachVolumeRequest)(nil),              // 49: machine.v1alpha1.AttachVolumeRequest
+	(*AttachVolumeResponse)(nil),             // 50: machine.v1alpha1.AttachVolumeResponse
+	(*DetachVolumeRequest)(nil),              // 51: machine.v1alpha1.DetachVolumeRequest
+	(*DetachVolumeResponse)(nil),             // 52: machine.v1alpha1.DetachVolumeResponse
+	(*UpdateVolumeRequest)(nil),              // 53: machine.v1alpha1.UpdateVolumeRequest
+	(*UpdateVolumeResponse)(nil),             // 54: machine.v1alpha1.UpdateVolumeResponse
+	(*AttachNetworkInterfaceRequest)(nil),    // 55: machine.v1alpha1.AttachNetworkInterfaceRequest
+	(*AttachNetworkInterfaceResponse)(nil),   // 56: machine.v1alpha1.AttachNetworkInterfaceResponse
+	(*DetachNetworkInterfaceRequest)(nil),    // 57: machine.v1alpha1.DetachNetworkInterfaceRequest
+	(*DetachNetworkInterfaceResponse)(nil),   // 58: machine.v1alpha1.DetachNetworkInterfaceResponse
+	(*StatusRequest)(nil),                    // 59: machine.v1alpha1.StatusRequest
+	(*StatusResponse)(nil),                   // 60: machine.v1alpha1.StatusResponse
+	(*ExecRequest)(nil),                      // 61: machine.v1alpha1.ExecRequest
+	(*ExecResponse)(nil),                     // 62: machine.v1alpha1.ExecResponse
+	(*GetConsoleLogRequest)(nil),             // 63: machine.v1alpha1.GetConsoleLogRequest
+	(*GetConsoleLogResponse)(nil),            // 64: machine.v1alpha1.GetConsoleLogResponse
+	(*CpuStats)(nil),                         // 65: machine.v1alpha1.CpuStats
+	(*MemoryStats)(nil),                      // 66: machine.v1alpha1.MemoryStats
+	(*VolumeStats)(nil),                      // 67: machine.v1alpha1.VolumeStats
+	(*NetworkInterfaceStats)(nil),            // 68: machine.v1alpha1.NetworkInterfaceStats
+	(*MachineStats)(nil),                     // 69: machine.v1alpha1.MachineStats
+	(*GetMachineStatsRequest)(nil),           // 70: machine.v1alpha1.GetMachineStatsRequest
+	(*GetMachineStatsResponse)(nil),          // 71: machine.v1alpha1.GetMachineStatsResponse
+	(*ListMachineStatsRequest)(nil),          // 72: machine.v1alpha1.ListMachineStatsRequest
+	(*ListMachineStatsResponse)(nil),         // 73: machine.v1alpha1.ListMachineStatsResponse
+	(*GuestConfig)(nil),                      // 74: machine.v1alpha1.GuestConfig
+	nil,                                      // 75: machine.v1alpha1.VolumeSpec.AttributesEntry
+	nil,                                      // 76: machine.v1alpha1.VolumeSpec.SecretDataEntry
+	nil,                                      // 77: machine.v1alpha1.MachineFilter.LabelSelectorEntry
+	nil,                                      // 78: machine.v1alpha1.EventFilter.LabelSelectorEntry
+	nil,                                      // 79: machine.v1alpha1.MachineClassCapabilities.ResourcesEntry
+	nil,                                      // 80: machine.v1alpha1.VolumeConnection.AttributesEntry
+	nil,                                      // 81: machine.v1alpha1.VolumeConnection.SecretDataEntry
+	nil,                                      // 82: machine.v1alpha1.VolumeConnection.EncryptionDataEntry
+	nil,                                      // 83: machine.v1alpha1.NetworkInterface.AttributesEntry
+	nil,                                      // 84: machine.v1alpha1.UpdateMachineAnnotationsRequest.AnnotationsEntry
+	(*v1alpha1.ObjectMetadata)(nil),          // 85: meta.v1alpha1.ObjectMetadata
+	(v1alpha1.WatchEventType)(0),             // 86: meta.v1alpha1.WatchEventType
+	(*v1alpha11.Event)(nil),                  // 87: event.v1alpha1.Event
 }
 var file_machine_v1alpha1_api_proto_depIdxs = []int32{
-	50, // 0: machine.v1alpha1.VolumeSpec.attributes:type_name -> machine.v1alpha1.VolumeSpec.AttributesEntry
-	51, // 1: machine.v1alpha1.VolumeSpec.secret_data:type_name -> machine.v1alpha1.VolumeSpec.SecretDataEntry
-	52, // 2: machine.v1alpha1.MachineFilter.label_selector:type_name -> machine.v1alpha1.MachineFilter.LabelSelectorEntry
-	53, // 3: machine.v1alpha1.EventFilter.label_selector:type_name -> machine.v1alpha1.EventFilter.LabelSelectorEntry
-	54, // 4: machine.v1alpha1.MachineClassCapabilities.resources:type_name -> machine.v1alpha1.MachineClassCapabilities.ResourcesEntry
-	60, // 5: machine.v1alpha1.Machine.metadata:type_name -> meta.v1alpha1.ObjectMetadata
-	14, // 6: machine.v1alpha1.Machine.spec:type_name -> machine.v1alpha1.MachineSpec
-	15, // 7: machine.v1alpha1.Machine.status:type_name -> machine.v1alpha1.MachineStatus
-	9,  // 8: machine.v1alpha1.LocalDisk.image:type_name -> machine.v1alpha1.ImageSpec
-	55, // 9: machine.v1alpha1.VolumeConnection.attributes:type_name -> machine.v1alpha1.VolumeConnection.AttributesEntry
-	56, // 10: machine.v1alpha1.VolumeConnection.secret_data:type_name -> machine.v1alpha1.VolumeConnection.SecretDataEntry
-	57, // 11: machine.v1alpha1.VolumeConnection.encryption_data:type_name -> machine.v1alpha1.VolumeConnection.EncryptionDataEntry
-	10, // 12: machine.v1alpha1.Volume.local_disk:type_name -> machine.v1alpha1.LocalDisk
-	11, // 13: machine.v1alpha1.Volume.connection:type_name -> machine.v1alpha1.VolumeConnection
-	58, // 14: machine.v1alpha1.NetworkInterface.attributes:type_name -> machine.v1alpha1.NetworkInterface.AttributesEntry
-	0,  // 15: machine.v1alpha1.MachineSpec.power:type_name -> machine.v1alpha1.Power
-	12, // 16: machine.v1alpha1.MachineSpec.volumes:type_name -> machine.v1alpha1.Volume
-	13, // 17: machine.v1alpha1.MachineSpec.network_interfaces:type_name -> machine.v1alpha1.NetworkInterface
-	49, // 18: machine.v1alpha1.MachineSpec.guest_config:type_name -> machine.v1alpha1.GuestConfig
-	3,  // 19: machine.v1alpha1.MachineStatus.state:type_name -> machine.v1alpha1.MachineState
-	17, // 20: machine.v1alpha1.MachineStatus.volumes:type_name -> machine.v1alpha1.VolumeStatus
-	18, // 21: machine.v1alpha1.MachineStatus.network_interfaces:type_name -> machine.v1alpha1.NetworkInterfaceStatus
-	16, // 22: machine.v1alpha1.MachineStatus.machine_conditions:type_name -> machine.v1alpha1.Conditions
-	1,  // 23: machine.v1alpha1.VolumeStatus.state:type_name -> machine.v1alpha1.VolumeState
-	2,  // 24: machine.v1alpha1.NetworkInterfaceStatus.state:type_name -> machine.v1alpha1.NetworkInterfaceState
-	7,  // 25: machine.v1alpha1.MachineClass.capabilities:type_name -> machine.v1alpha1.MachineClassCapabilities
-	19, // 26: machine.v1alpha1.MachineClassStatus.machine_class:type_name -> machine.v1alpha1.MachineClass
-	5,  // 27: machine.v1alpha1.ListMachinesRequest.filter:type_name -> machine.v1alpha1.MachineFilter
-	8,  // 28: machine.v1alpha1.ListMachinesResponse.machines:type_name -> machine.v1alpha1.Machine
-	6,  // 29: machine.v1alpha1.ListEventsRequest.filter:type_name -> machine.v1alpha1.EventFilter
-	61, // 30: machine.v1alpha1.ListEventsResponse.events:type_name -> event.v1alpha1.Event
-	8,  // 31: machine.v1alpha1.CreateMachineRequest.machine:type_name -> machine.v1alpha1.Machine
-	8,  // 32: machine.v1alpha1.CreateMachineResponse.machine:type_name -> machine.v1alpha1.Machine
-	59, // 33: machine.v1alpha1.UpdateMachineAnnotationsRequest.annotations:type_name -> machine.v1alpha1.UpdateMachineAnnotationsRequest.AnnotationsEntry
-	0,  // 34: machine.v1alpha1.UpdateMachinePowerRequest.power:type_name -> machine.v1alpha1.Power
-	12, // 35: machine.v1alpha1.AttachVolumeRequest.volume:type_name -> machine.v1alpha1.Volume
-	12, // 36: machine.v1alpha1.UpdateVolumeRequest.volume:type_name -> machine.v1alpha1.Volume
-	13, // 37: machine.v1alpha1.AttachNetworkInterfaceRequest.network_interface:type_name -> machine.v1alpha1.NetworkInterface
-	20, // 38: machine.v1alpha1.StatusResponse.machine_class_status:type_name -> machine.v1alpha1.MachineClassStatus
-	21, // 39: machine.v1alpha1.MachineRuntime.Version:input_type -> machine.v1alpha1.VersionRequest
-	25, // 40: machine.v1alpha1.MachineRuntime.ListEvents:input_type -> machine.v1alpha1.ListEventsRequest
-	23, // 41: machine.v1alpha1.MachineRuntime.ListMachines:input_type -> machine.v1alpha1.ListMachinesRequest
-	27, // 42: machine.v1alpha1.MachineRuntime.CreateMachine:input_type -> machine.v1alpha1.CreateMachineRequest
-	29, // 43: machine.v1alpha1.MachineRuntime.DeleteMachine:input_type -> machine.v1alpha1.DeleteMachineRequest
-	31, // 44: machine.v1alpha1.MachineRuntime.UpdateMachineAnnotations:input_type -> machine.v1alpha1.UpdateMachineAnnotationsRequest
-	33, // 45: machine.v1alpha1.MachineRuntime.UpdateMachinePower:input_type -> machine.v1alpha1.UpdateMachinePowerRequest
-	35, // 46: machine.v1alpha1.MachineRuntime.AttachVolume:input_type -> machine.v1alpha1.AttachVolumeRequest
-	37, // 47: machine.v1alpha1.MachineRuntime.DetachVolume:input_type -> machine.v1alpha1.DetachVolumeRequest
-	39, // 48: machine.v1alpha1.MachineRuntime.UpdateVolume:input_type -> machine.v1alpha1.UpdateVolumeRequest
-	41, // 49: machine.v1alpha1.MachineRuntime.AttachNetworkInterface:input_type -> machine.v1alpha1.AttachNetworkInterfaceRequest
-	43, // 50: machine.v1alpha1.MachineRuntime.DetachNetworkInterface:input_type -> machine.v1alpha1.DetachNetworkInterfaceRequest
-	45, // 51: machine.v1alpha1.MachineRuntime.Status:input_type -> machine.v1alpha1.StatusRequest
-	47, // 52: machine.v1alpha1.MachineRuntime.Exec:input_type -> machine.v1alpha1.ExecRequest
-	22, // 53: machine.v1alpha1.MachineRuntime.Version:output_type -> machine.v1alpha1.VersionResponse
-	26, // 54: machine.v1alpha1.MachineRuntime.ListEvents:output_type -> machine.v1alpha1.ListEventsResponse
-	24, // 55: machine.v1alpha1.MachineRuntime.ListMachines:output_type -> machine.v1alpha1.ListMachinesResponse
-	28, // 56: machine.v1alpha1.MachineRuntime.CreateMachine:output_type -> machine.v1alpha1.CreateMachineResponse
-	30, // 57: machine.v1alpha1.MachineRuntime.DeleteMachine:output_type -> machine.v1alpha1.DeleteMachineResponse
-	32, // 58: machine.v1alpha1.MachineRuntime.UpdateMachineAnnotations:output_type -> machine.v1alpha1.UpdateMachineAnnotationsResponse
-	34, // 59: machine.v1alpha1.MachineRuntime.UpdateMachinePower:output_type -> machine.v1alpha1.UpdateMachinePowerResponse
-	36, // 60: machine.v1alpha1.MachineRuntime.AttachVolume:output_type -> machine.v1alpha1.AttachVolumeResponse
-	38, // 61: machine.v1alpha1.MachineRuntime.DetachVolume:output_type -> machine.v1alpha1.DetachVolumeResponse
-	40, // 62: machine.v1alpha1.MachineRuntime.UpdateVolume:output_type -> machine.v1alpha1.UpdateVolumeResponse
-	42, // 63: machine.v1alpha1.MachineRuntime.AttachNetworkInterface:output_type -> machine.v1alpha1.AttachNetworkInterfaceResponse
-	44, // 64: machine.v1alpha1.MachineRuntime.DetachNetworkInterface:output_type -> machine.v1alpha1.DetachNetworkInterfaceResponse
-	46, // 65: machine.v1alpha1.MachineRuntime.Status:output_type -> machine.v1alpha1.StatusResponse
-	48, // 66: machine.v1alpha1.MachineRuntime.Exec:output_type -> machine.v1alpha1.ExecResponse
-	53, // [53:67] is the sub-list for method output_type
-	39, // [39:53] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	75, // 0: machine.v1alpha1.VolumeSpec.attributes:type_name -> machine.v1alpha1.VolumeSpec.AttributesEntry
+	76, // 1: machine.v1alpha1.VolumeSpec.secret_data:type_name -> machine.v1alpha1.VolumeSpec.SecretDataEntry
+	77, // 2: machine.v1alpha1.MachineFilter.label_selector:type_name -> machine.v1alpha1.MachineFilter.LabelSelectorEntry
+	4,  // 3: machine.v1alpha1.MachineFilter.states:type_name -> machine.v1alpha1.MachineState
+	78, // 4: machine.v1alpha1.EventFilter.label_selector:type_name -> machine.v1alpha1.EventFilter.LabelSelectorEntry
+	79, // 5: machine.v1alpha1.MachineClassCapabilities.resources:type_name -> machine.v1alpha1.MachineClassCapabilities.ResourcesEntry
+	85, // 6: machine.v1alpha1.Machine.metadata:type_name -> meta.v1alpha1.ObjectMetadata
+	16, // 7: machine.v1alpha1.Machine.spec:type_name -> machine.v1alpha1.MachineSpec
+	17, // 8: machine.v1alpha1.Machine.status:type_name -> machine.v1alpha1.MachineStatus
+	11, // 9: machine.v1alpha1.LocalDisk.image:type_name -> machine.v1alpha1.ImageSpec
+	80, // 10: machine.v1alpha1.VolumeConnection.attributes:type_name -> machine.v1alpha1.VolumeConnection.AttributesEntry
+	81, // 11: machine.v1alpha1.VolumeConnection.secret_data:type_name -> machine.v1alpha1.VolumeConnection.SecretDataEntry
+	82, // 12: machine.v1alpha1.VolumeConnection.encryption_data:type_name -> machine.v1alpha1.VolumeConnection.EncryptionDataEntry
+	12, // 13: machine.v1alpha1.Volume.local_disk:type_name -> machine.v1alpha1.LocalDisk
+	13, // 14: machine.v1alpha1.Volume.connection:type_name -> machine.v1alpha1.VolumeConnection
+	83, // 15: machine.v1alpha1.NetworkInterface.attributes:type_name -> machine.v1alpha1.NetworkInterface.AttributesEntry
+	0,  // 16: machine.v1alpha1.MachineSpec.power:type_name -> machine.v1alpha1.Power
+	14, // 17: machine.v1alpha1.MachineSpec.volumes:type_name -> machine.v1alpha1.Volume
+	15, // 18: machine.v1alpha1.MachineSpec.network_interfaces:type_name -> machine.v1alpha1.NetworkInterface
+	74, // 19: machine.v1alpha1.MachineSpec.guest_config:type_name -> machine.v1alpha1.GuestConfig
+	4,  // 20: machine.v1alpha1.MachineStatus.state:type_name -> machine.v1alpha1.MachineState
+	19, // 21: machine.v1alpha1.MachineStatus.volumes:type_name -> machine.v1alpha1.VolumeStatus
+	20, // 22: machine.v1alpha1.MachineStatus.network_interfaces:type_name -> machine.v1alpha1.NetworkInterfaceStatus
+	18, // 23: machine.v1alpha1.MachineStatus.machine_conditions:type_name -> machine.v1alpha1.Conditions
+	2,  // 24: machine.v1alpha1.VolumeStatus.state:type_name -> machine.v1alpha1.VolumeState
+	3,  // 25: machine.v1alpha1.NetworkInterfaceStatus.state:type_name -> machine.v1alpha1.NetworkInterfaceState
+	9,  // 26: machine.v1alpha1.MachineClass.capabilities:type_name -> machine.v1alpha1.MachineClassCapabilities
+	21, // 27: machine.v1alpha1.MachineClassStatus.machine_class:type_name -> machine.v1alpha1.MachineClass
+	5,  // 28: machine.v1alpha1.VersionResponse.capabilities:type_name -> machine.v1alpha1.RuntimeCapability
+	7,  // 29: machine.v1alpha1.ListMachinesRequest.filter:type_name -> machine.v1alpha1.MachineFilter
+	10, // 30: machine.v1alpha1.ListMachinesResponse.machines:type_name -> machine.v1alpha1.Machine
+	7,  // 31: machine.v1alpha1.WatchMachinesRequest.filter:type_name -> machine.v1alpha1.MachineFilter
+	86, // 32: machine.v1alpha1.WatchMachinesResponse.type:type_name -> meta.v1alpha1.WatchEventType
+	10, // 33: machine.v1alpha1.WatchMachinesResponse.machine:type_name -> machine.v1alpha1.Machine
+	8,  // 34: machine.v1alpha1.ListEventsRequest.filter:type_name -> machine.v1alpha1.EventFilter
+	87, // 35: machine.v1alpha1.ListEventsResponse.events:type_name -> event.v1alpha1.Event
+	8,  // 36: machine.v1alpha1.WatchEventsRequest.filter:type_name -> machine.v1alpha1.EventFilter
+	87, // 37: machine.v1alpha1.WatchEventsResponse.event:type_name -> event.v1alpha1.Event
+	10, // 38: machine.v1alpha1.CreateMachineRequest.machine:type_name -> machine.v1alpha1.Machine
+	10, // 39: machine.v1alpha1.CreateMachineResponse.machine:type_name -> machine.v1alpha1.Machine
+	84, // 40: machine.v1alpha1.UpdateMachineAnnotationsRequest.annotations:type_name -> machine.v1alpha1.UpdateMachineAnnotationsRequest.AnnotationsEntry
+	0,  // 41: machine.v1alpha1.UpdateMachinePowerRequest.power:type_name -> machine.v1alpha1.Power
+	1,  // 42: machine.v1alpha1.RebootMachineRequest.mode:type_name -> machine.v1alpha1.RebootMode
+	14, // 43: machine.v1alpha1.AttachVolumeRequest.volume:type_name -> machine.v1alpha1.Volume
+	14, // 44: machine.v1alpha1.UpdateVolumeRequest.volume:type_name -> machine.v1alpha1.Volume
+	15, // 45: machine.v1alpha1.AttachNetworkInterfaceRequest.network_interface:type_name -> machine.v1alpha1.NetworkInterface
+	22, // 46: machine.v1alpha1.StatusResponse.machine_class_status:type_name -> machine.v1alpha1.MachineClassStatus
+	65, // 47: machine.v1alpha1.MachineStats.cpu:type_name -> machine.v1alpha1.CpuStats
+	66, // 48: machine.v1alpha1.MachineStats.memory:type_name -> machine.v1alpha1.MemoryStats
+	67, // 49: machine.v1alpha1.MachineStats.volumes:type_name -> machine.v1alpha1.VolumeStats
+	68, // 50: machine.v1alpha1.MachineStats.network_interfaces:type_name -> machine.v1alpha1.NetworkInterfaceStats
+	69, // 51: machine.v1alpha1.GetMachineStatsResponse.stats:type_name -> machine.v1alpha1.MachineStats
+	7,  // 52: machine.v1alpha1.ListMachineStatsRequest.filter:type_name -> machine.v1alpha1.MachineFilter
+	69, // 53: machine.v1alpha1.ListMachineStatsResponse.stats:type_name -> machine.v1alpha1.MachineStats
+	23, // 54: machine.v1alpha1.MachineRuntime.Version:input_type -> machine.v1alpha1.VersionRequest
+	29, // 55: machine.v1alpha1.MachineRuntime.ListEvents:input_type -> machine.v1alpha1.ListEventsRequest
+	31, // 56: machine.v1alpha1.MachineRuntime.WatchEvents:input_type -> machine.v1alpha1.WatchEventsRequest
+	25, // 57: machine.v1alpha1.MachineRuntime.ListMachines:input_type -> machine.v1alpha1.ListMachinesRequest
+	27, // 58: machine.v1alpha1.MachineRuntime.WatchMachines:input_type -> machine.v1alpha1.WatchMachinesRequest
+	33, // 59: machine.v1alpha1.MachineRuntime.CreateMachine:input_type -> machine.v1alpha1.CreateMachineRequest
+	35, // 60: machine.v1alpha1.MachineRuntime.DeleteMachine:input_type -> machine.v1alpha1.DeleteMachineRequest
+	37, // 61: machine.v1alpha1.MachineRuntime.UpdateMachineAnnotations:input_type -> machine.v1alpha1.UpdateMachineAnnotationsRequest
+	39, // 62: machine.v1alpha1.MachineRuntime.UpdateMachinePower:input_type -> machine.v1alpha1.UpdateMachinePowerRequest
+	41, // 63: machine.v1alpha1.MachineRuntime.RebootMachine:input_type -> machine.v1alpha1.RebootMachineRequest
+	43, // 64: machine.v1alpha1.MachineRuntime.UpdateMachineClass:input_type -> machine.v1alpha1.UpdateMachineClassRequest
+	45, // 65: machine.v1alpha1.MachineRuntime.FreezeMachine:input_type -> machine.v1alpha1.FreezeMachineRequest
+	47, // 66: machine.v1alpha1.MachineRuntime.ThawMachine:input_type -> machine.v1alpha1.ThawMachineRequest
+	49, // 67: machine.v1alpha1.MachineRuntime.AttachVolume:input_type -> machine.v1alpha1.AttachVolumeRequest
+	51, // 68: machine.v1alpha1.MachineRuntime.DetachVolume:input_type -> machine.v1alpha1.DetachVolumeRequest
+	53, // 69: machine.v1alpha1.MachineRuntime.UpdateVolume:input_type -> machine.v1alpha1.UpdateVolumeRequest
+	55, // 70: machine.v1alpha1.MachineRuntime.AttachNetworkInterface:input_type -> machine.v1alpha1.AttachNetworkInterfaceRequest
+	57, // 71: machine.v1alpha1.MachineRuntime.DetachNetworkInterface:input_type -> machine.v1alpha1.DetachNetworkInterfaceRequest
+	59, // 72: machine.v1alpha1.MachineRuntime.Status:input_type -> machine.v1alpha1.StatusRequest
+	61, // 73: machine.v1alpha1.MachineRuntime.Exec:input_type -> machine.v1alpha1.ExecRequest
+	63, // 74: machine.v1alpha1.MachineRuntime.GetConsoleLog:input_type -> machine.v1alpha1.GetConsoleLogRequest
+	70, // 75: machine.v1alpha1.MachineRuntime.GetMachineStats:input_type -> machine.v1alpha1.GetMachineStatsRequest
+	72, // 76: machine.v1alpha1.MachineRuntime.ListMachineStats:input_type -> machine.v1alpha1.ListMachineStatsRequest
+	24, // 77: machine.v1alpha1.MachineRuntime.Version:output_type -> machine.v1alpha1.VersionResponse
+	30, // 78: machine.v1alpha1.MachineRuntime.ListEvents:output_type -> machine.v1alpha1.ListEventsResponse
+	32, // 79: machine.v1alpha1.MachineRuntime.WatchEvents:output_type -> machine.v1alpha1.WatchEventsResponse
+	26, // 80: machine.v1alpha1.MachineRuntime.ListMachines:output_type -> machine.v1alpha1.ListMachinesResponse
+	28, // 81: machine.v1alpha1.MachineRuntime.WatchMachines:output_type -> machine.v1alpha1.WatchMachinesResponse
+	34, // 82: machine.v1alpha1.MachineRuntime.CreateMachine:output_type -> machine.v1alpha1.CreateMachineResponse
+	36, // 83: machine.v1alpha1.MachineRuntime.DeleteMachine:output_type -> machine.v1alpha1.DeleteMachineResponse
+	38, // 84: machine.v1alpha1.MachineRuntime.UpdateMachineAnnotations:output_type -> machine.v1alpha1.UpdateMachineAnnotationsResponse
+	40, // 85: machine.v1alpha1.MachineRuntime.UpdateMachinePower:output_type -> machine.v1alpha1.UpdateMachinePowerResponse
+	42, // 86: machine.v1alpha1.MachineRuntime.RebootMachine:output_type -> machine.v1alpha1.RebootMachineResponse
+	44, // 87: machine.v1alpha1.MachineRuntime.UpdateMachineClass:output_type -> machine.v1alpha1.UpdateMachineClassResponse
+	46, // 88: machine.v1alpha1.MachineRuntime.FreezeMachine:output_type -> machine.v1alpha1.FreezeMachineResponse
+	48, // 89: machine.v1alpha1.MachineRuntime.ThawMachine:output_type -> machine.v1alpha1.ThawMachineResponse
+	50, // 90: machine.v1alpha1.MachineRuntime.AttachVolume:output_type -> machine.v1alpha1.AttachVolumeResponse
+	52, // 91: machine.v1alpha1.MachineRuntime.DetachVolume:output_type -> machine.v1alpha1.DetachVolumeResponse
+	54, // 92: machine.v1alpha1.MachineRuntime.UpdateVolume:output_type -> machine.v1alpha1.UpdateVolumeResponse
+	56, // 93: machine.v1alpha1.MachineRuntime.AttachNetworkInterface:output_type -> machine.v1alpha1.AttachNetworkInterfaceResponse
+	58, // 94: machine.v1alpha1.MachineRuntime.DetachNetworkInterface:output_type -> machine.v1alpha1.DetachNetworkInterfaceResponse
+	60, // 95: machine.v1alpha1.MachineRuntime.Status:output_type -> machine.v1alpha1.StatusResponse
+	62, // 96: machine.v1alpha1.MachineRuntime.Exec:output_type -> machine.v1alpha1.ExecResponse
+	64, // 97: machine.v1alpha1.MachineRuntime.GetConsoleLog:output_type -> machine.v1alpha1.GetConsoleLogResponse
+	71, // 98: machine.v1alpha1.MachineRuntime.GetMachineStats:output_type -> machine.v1alpha1.GetMachineStatsResponse
+	73, // 99: machine.v1alpha1.MachineRuntime.ListMachineStats:output_type -> machine.v1alpha1.ListMachineStatsResponse
+	77, // [77:100] is the sub-list for method output_type
+	54, // [54:77] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_machine_v1alpha1_api_proto_init() }
@@ -2949,13 +4563,14 @@ func file_machine_v1alpha1_api_proto_init() {
 	if File_machine_v1alpha1_api_proto != nil {
 		return
 	}
+	file_machine_v1alpha1_api_proto_msgTypes[57].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_machine_v1alpha1_api_proto_rawDesc), len(file_machine_v1alpha1_api_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   56,
+			NumEnums:      6,
+			NumMessages:   79,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,11 +22,17 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	MachineRuntime_Version_FullMethodName                  = "/machine.v1alpha1.MachineRuntime/Version"
 	MachineRuntime_ListEvents_FullMethodName               = "/machine.v1alpha1.MachineRuntime/ListEvents"
+	MachineRuntime_WatchEvents_FullMethodName              = "/machine.v1alpha1.MachineRuntime/WatchEvents"
 	MachineRuntime_ListMachines_FullMethodName             = "/machine.v1alpha1.MachineRuntime/ListMachines"
+	MachineRuntime_WatchMachines_FullMethodName            = "/machine.v1alpha1.MachineRuntime/WatchMachines"
 	MachineRuntime_CreateMachine_FullMethodName            = "/machine.v1alpha1.MachineRuntime/CreateMachine"
 	MachineRuntime_DeleteMachine_FullMethodName            = "/machine.v1alpha1.MachineRuntime/DeleteMachine"
 	MachineRuntime_UpdateMachineAnnotations_FullMethodName = "/machine.v1alpha1.MachineRuntime/UpdateMachineAnnotations"
 	MachineRuntime_UpdateMachinePower_FullMethodName       = "/machine.v1alpha1.MachineRuntime/UpdateMachinePower"
+	MachineRuntime_RebootMachine_FullMethodName            = "/machine.v1alpha1.MachineRuntime/RebootMachine"
+	MachineRuntime_UpdateMachineClass_FullMethodName       = "/machine.v1alpha1.MachineRuntime/UpdateMachineClass"
+	MachineRuntime_FreezeMachine_FullMethodName            = "/machine.v1alpha1.MachineRuntime/FreezeMachine"
+	MachineRuntime_ThawMachine_FullMethodName              = "/machine.v1alpha1.MachineRuntime/ThawMachine"
 	MachineRuntime_AttachVolume_FullMethodName             = "/machine.v1alpha1.MachineRuntime/AttachVolume"
 	MachineRuntime_DetachVolume_FullMethodName             = "/machine.v1alpha1.MachineRuntime/DetachVolume"
 	MachineRuntime_UpdateVolume_FullMethodName             = "/machine.v1alpha1.MachineRuntime/UpdateVolume"
@@ -34,6 +40,9 @@ const (
 	MachineRuntime_DetachNetworkInterface_FullMethodName   = "/machine.v1alpha1.MachineRuntime/DetachNetworkInterface"
 	MachineRuntime_Status_FullMethodName                   = "/machine.v1alpha1.MachineRuntime/Status"
 	MachineRuntime_Exec_FullMethodName                     = "/machine.v1alpha1.MachineRuntime/Exec"
+	MachineRuntime_GetConsoleLog_FullMethodName            = "/machine.v1alpha1.MachineRuntime/GetConsoleLog"
+	MachineRuntime_GetMachineStats_FullMethodName          = "/machine.v1alpha1.MachineRuntime/GetMachineStats"
+	MachineRuntime_ListMachineStats_FullMethodName         = "/machine.v1alpha1.MachineRuntime/ListMachineStats"
 )
 
 // MachineRuntimeClient is the client API for MachineRuntime service.
@@ -42,11 +51,17 @@ const (
 type MachineRuntimeClient interface {
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error)
 	ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error)
+	WatchMachines(ctx context.Context, in *WatchMachinesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMachinesResponse], error)
 	CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*CreateMachineResponse, error)
 	DeleteMachine(ctx context.Context, in *DeleteMachineRequest, opts ...grpc.CallOption) (*DeleteMachineResponse, error)
 	UpdateMachineAnnotations(ctx context.Context, in *UpdateMachineAnnotationsRequest, opts ...grpc.CallOption) (*UpdateMachineAnnotationsResponse, error)
 	UpdateMachinePower(ctx context.Context, in *UpdateMachinePowerRequest, opts ...grpc.CallOption) (*UpdateMachinePowerResponse, error)
+	RebootMachine(ctx context.Context, in *RebootMachineRequest, opts ...grpc.CallOption) (*RebootMachineResponse, error)
+	UpdateMachineClass(ctx context.Context, in *UpdateMachineClassRequest, opts ...grpc.CallOption) (*UpdateMachineClassResponse, error)
+	FreezeMachine(ctx context.Context, in *FreezeMachineRequest, opts ...grpc.CallOption) (*FreezeMachineResponse, error)
+	ThawMachine(ctx context.Context, in *ThawMachineRequest, opts ...grpc.CallOption) (*ThawMachineResponse, error)
 	AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error)
 	DetachVolume(ctx context.Context, in *DetachVolumeRequest, opts ...grpc.CallOption) (*DetachVolumeResponse, error)
 	UpdateVolume(ctx context.Context, in *UpdateVolumeRequest, opts ...grpc.CallOption) (*UpdateVolumeResponse, error)
@@ -54,6 +69,9 @@ type MachineRuntimeClient interface {
 	DetachNetworkInterface(ctx context.Context, in *DetachNetworkInterfaceRequest, opts ...grpc.CallOption) (*DetachNetworkInterfaceResponse, error)
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
 	Exec(ctx context.Context, in *ExecRequest, opts ...grpc.CallOption) (*ExecResponse, error)
+	GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetConsoleLogResponse], error)
+	GetMachineStats(ctx context.Context, in *GetMachineStatsRequest, opts ...grpc.CallOption) (*GetMachineStatsResponse, error)
+	ListMachineStats(ctx context.Context, in *ListMachineStatsRequest, opts ...grpc.CallOption) (*ListMachineStatsResponse, error)
 }
 
 type machineRuntimeClient struct {
@@ -84,6 +102,25 @@ func (c *machineRuntimeClient) ListEvents(ctx context.Context, in *ListEventsReq
 	return out, nil
 }
 
+func (c *machineRuntimeClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineRuntime_ServiceDesc.Streams[0], MachineRuntime_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, WatchEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineRuntime_WatchEventsClient = grpc.ServerStreamingClient[WatchEventsResponse]
+
 func (c *machineRuntimeClient) ListMachines(ctx context.Context, in *ListMachinesRequest, opts ...grpc.CallOption) (*ListMachinesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMachinesResponse)
@@ -94,6 +131,25 @@ func (c *machineRuntimeClient) ListMachines(ctx context.Context, in *ListMachine
 	return out, nil
 }
 
+func (c *machineRuntimeClient) WatchMachines(ctx context.Context, in *WatchMachinesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMachinesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineRuntime_ServiceDesc.Streams[1], MachineRuntime_WatchMachines_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMachinesRequest, WatchMachinesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineRuntime_WatchMachinesClient = grpc.ServerStreamingClient[WatchMachinesResponse]
+
 func (c *machineRuntimeClient) CreateMachine(ctx context.Context, in *CreateMachineRequest, opts ...grpc.CallOption) (*CreateMachineResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateMachineResponse)
@@ -134,6 +190,46 @@ func (c *machineRuntimeClient) UpdateMachinePower(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *machineRuntimeClient) RebootMachine(ctx context.Context, in *RebootMachineRequest, opts ...grpc.CallOption) (*RebootMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebootMachineResponse)
+	err := c.cc.Invoke(ctx, MachineRuntime_RebootMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineRuntimeClient) UpdateMachineClass(ctx context.Context, in *UpdateMachineClassRequest, opts ...grpc.CallOption) (*UpdateMachineClassResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMachineClassResponse)
+	err := c.cc.Invoke(ctx, MachineRuntime_UpdateMachineClass_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineRuntimeClient) FreezeMachine(ctx context.Context, in *FreezeMachineRequest, opts ...grpc.CallOption) (*FreezeMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeMachineResponse)
+	err := c.cc.Invoke(ctx, MachineRuntime_FreezeMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineRuntimeClient) ThawMachine(ctx context.Context, in *ThawMachineRequest, opts ...grpc.CallOption) (*ThawMachineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ThawMachineResponse)
+	err := c.cc.Invoke(ctx, MachineRuntime_ThawMachine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineRuntimeClient) AttachVolume(ctx context.Context, in *AttachVolumeRequest, opts ...grpc.CallOption) (*AttachVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AttachVolumeResponse)
@@ -204,17 +300,62 @@ func (c *machineRuntimeClient) Exec(ctx context.Context, in *ExecRequest, opts .
 	return out, nil
 }
 
+func (c *machineRuntimeClient) GetConsoleLog(ctx context.Context, in *GetConsoleLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetConsoleLogResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineRuntime_ServiceDesc.Streams[2], MachineRuntime_GetConsoleLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetConsoleLogRequest, GetConsoleLogResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineRuntime_GetConsoleLogClient = grpc.ServerStreamingClient[GetConsoleLogResponse]
+
+func (c *machineRuntimeClient) GetMachineStats(ctx context.Context, in *GetMachineStatsRequest, opts ...grpc.CallOption) (*GetMachineStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMachineStatsResponse)
+	err := c.cc.Invoke(ctx, MachineRuntime_GetMachineStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineRuntimeClient) ListMachineStats(ctx context.Context, in *ListMachineStatsRequest, opts ...grpc.CallOption) (*ListMachineStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMachineStatsResponse)
+	err := c.cc.Invoke(ctx, MachineRuntime_ListMachineStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MachineRuntimeServer is the server API for MachineRuntime service.
 // All implementations must embed UnimplementedMachineRuntimeServer
 // for forward compatibility.
 type MachineRuntimeServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error
 	ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error)
+	WatchMachines(*WatchMachinesRequest, grpc.ServerStreamingServer[WatchMachinesResponse]) error
 	CreateMachine(context.Context, *CreateMachineRequest) (*CreateMachineResponse, error)
 	DeleteMachine(context.Context, *DeleteMachineRequest) (*DeleteMachineResponse, error)
 	UpdateMachineAnnotations(context.Context, *UpdateMachineAnnotationsRequest) (*UpdateMachineAnnotationsResponse, error)
 	UpdateMachinePower(context.Context, *UpdateMachinePowerRequest) (*UpdateMachinePowerResponse, error)
+	RebootMachine(context.Context, *RebootMachineRequest) (*RebootMachineResponse, error)
+	UpdateMachineClass(context.Context, *UpdateMachineClassRequest) (*UpdateMachineClassResponse, error)
+	FreezeMachine(context.Context, *FreezeMachineRequest) (*FreezeMachineResponse, error)
+	ThawMachine(context.Context, *ThawMachineRequest) (*ThawMachineResponse, error)
 	AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error)
 	DetachVolume(context.Context, *DetachVolumeRequest) (*DetachVolumeResponse, error)
 	UpdateVolume(context.Context, *UpdateVolumeRequest) (*UpdateVolumeResponse, error)
@@ -222,6 +363,9 @@ type MachineRuntimeServer interface {
 	DetachNetworkInterface(context.Context, *DetachNetworkInterfaceRequest) (*DetachNetworkInterfaceResponse, error)
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
 	Exec(context.Context, *ExecRequest) (*ExecResponse, error)
+	GetConsoleLog(*GetConsoleLogRequest, grpc.ServerStreamingServer[GetConsoleLogResponse]) error
+	GetMachineStats(context.Context, *GetMachineStatsRequest) (*GetMachineStatsResponse, error)
+	ListMachineStats(context.Context, *ListMachineStatsRequest) (*ListMachineStatsResponse, error)
 	mustEmbedUnimplementedMachineRuntimeServer()
 }
 
@@ -238,9 +382,15 @@ func (UnimplementedMachineRuntimeServer) Version(context.Context, *VersionReques
 func (UnimplementedMachineRuntimeServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedMachineRuntimeServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedMachineRuntimeServer) ListMachines(context.Context, *ListMachinesRequest) (*ListMachinesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListMachines not implemented")
 }
+func (UnimplementedMachineRuntimeServer) WatchMachines(*WatchMachinesRequest, grpc.ServerStreamingServer[WatchMachinesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchMachines not implemented")
+}
 func (UnimplementedMachineRuntimeServer) CreateMachine(context.Context, *CreateMachineRequest) (*CreateMachineResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateMachine not implemented")
 }
@@ -253,6 +403,18 @@ func (UnimplementedMachineRuntimeServer) UpdateMachineAnnotations(context.Contex
 func (UnimplementedMachineRuntimeServer) UpdateMachinePower(context.Context, *UpdateMachinePowerRequest) (*UpdateMachinePowerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateMachinePower not implemented")
 }
+func (UnimplementedMachineRuntimeServer) RebootMachine(context.Context, *RebootMachineRequest) (*RebootMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RebootMachine not implemented")
+}
+func (UnimplementedMachineRuntimeServer) UpdateMachineClass(context.Context, *UpdateMachineClassRequest) (*UpdateMachineClassResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMachineClass not implemented")
+}
+func (UnimplementedMachineRuntimeServer) FreezeMachine(context.Context, *FreezeMachineRequest) (*FreezeMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FreezeMachine not implemented")
+}
+func (UnimplementedMachineRuntimeServer) ThawMachine(context.Context, *ThawMachineRequest) (*ThawMachineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ThawMachine not implemented")
+}
 func (UnimplementedMachineRuntimeServer) AttachVolume(context.Context, *AttachVolumeRequest) (*AttachVolumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AttachVolume not implemented")
 }
@@ -274,6 +436,15 @@ func (UnimplementedMachineRuntimeServer) Status(context.Context, *StatusRequest)
 func (UnimplementedMachineRuntimeServer) Exec(context.Context, *ExecRequest) (*ExecResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Exec not implemented")
 }
+func (UnimplementedMachineRuntimeServer) GetConsoleLog(*GetConsoleLogRequest, grpc.ServerStreamingServer[GetConsoleLogResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetConsoleLog not implemented")
+}
+func (UnimplementedMachineRuntimeServer) GetMachineStats(context.Context, *GetMachineStatsRequest) (*GetMachineStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMachineStats not implemented")
+}
+func (UnimplementedMachineRuntimeServer) ListMachineStats(context.Context, *ListMachineStatsRequest) (*ListMachineStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMachineStats not implemented")
+}
 func (UnimplementedMachineRuntimeServer) mustEmbedUnimplementedMachineRuntimeServer() {}
 func (UnimplementedMachineRuntimeServer) testEmbeddedByValue()                        {}
 
@@ -331,6 +502,17 @@ func _MachineRuntime_ListEvents_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineRuntime_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineRuntimeServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, WatchEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineRuntime_WatchEventsServer = grpc.ServerStreamingServer[WatchEventsResponse]
+
 func _MachineRuntime_ListMachines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMachinesRequest)
 	if err := dec(in); err != nil {
@@ -349,6 +531,17 @@ func _MachineRuntime_ListMachines_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineRuntime_WatchMachines_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMachinesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineRuntimeServer).WatchMachines(m, &grpc.GenericServerStream[WatchMachinesRequest, WatchMachinesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineRuntime_WatchMachinesServer = grpc.ServerStreamingServer[WatchMachinesResponse]
+
 func _MachineRuntime_CreateMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMachineRequest)
 	if err := dec(in); err != nil {
@@ -421,6 +614,78 @@ func _MachineRuntime_UpdateMachinePower_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineRuntime_RebootMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineRuntimeServer).RebootMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineRuntime_RebootMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineRuntimeServer).RebootMachine(ctx, req.(*RebootMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineRuntime_UpdateMachineClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMachineClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineRuntimeServer).UpdateMachineClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineRuntime_UpdateMachineClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineRuntimeServer).UpdateMachineClass(ctx, req.(*UpdateMachineClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineRuntime_FreezeMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineRuntimeServer).FreezeMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineRuntime_FreezeMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineRuntimeServer).FreezeMachine(ctx, req.(*FreezeMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineRuntime_ThawMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThawMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineRuntimeServer).ThawMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineRuntime_ThawMachine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineRuntimeServer).ThawMachine(ctx, req.(*ThawMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MachineRuntime_AttachVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AttachVolumeRequest)
 	if err := dec(in); err != nil {
@@ -547,6 +812,53 @@ func _MachineRuntime_Exec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MachineRuntime_GetConsoleLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsoleLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineRuntimeServer).GetConsoleLog(m, &grpc.GenericServerStream[GetConsoleLogRequest, GetConsoleLogResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MachineRuntime_GetConsoleLogServer = grpc.ServerStreamingServer[GetConsoleLogResponse]
+
+func _MachineRuntime_GetMachineStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMachineStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineRuntimeServer).GetMachineStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineRuntime_GetMachineStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineRuntimeServer).GetMachineStats(ctx, req.(*GetMachineStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineRuntime_ListMachineStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMachineStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineRuntimeServer).ListMachineStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineRuntime_ListMachineStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineRuntimeServer).ListMachineStats(ctx, req.(*ListMachineStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MachineRuntime_ServiceDesc is the grpc.ServiceDesc for MachineRuntime service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -582,6 +894,22 @@ var MachineRuntime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMachinePower",
 			Handler:    _MachineRuntime_UpdateMachinePower_Handler,
 		},
+		{
+			MethodName: "RebootMachine",
+			Handler:    _MachineRuntime_RebootMachine_Handler,
+		},
+		{
+			MethodName: "UpdateMachineClass",
+			Handler:    _MachineRuntime_UpdateMachineClass_Handler,
+		},
+		{
+			MethodName: "FreezeMachine",
+			Handler:    _MachineRuntime_FreezeMachine_Handler,
+		},
+		{
+			MethodName: "ThawMachine",
+			Handler:    _MachineRuntime_ThawMachine_Handler,
+		},
 		{
 			MethodName: "AttachVolume",
 			Handler:    _MachineRuntime_AttachVolume_Handler,
@@ -610,7 +938,31 @@ var MachineRuntime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _MachineRuntime_Exec_Handler,
 		},
+		{
+			MethodName: "GetMachineStats",
+			Handler:    _MachineRuntime_GetMachineStats_Handler,
+		},
+		{
+			MethodName: "ListMachineStats",
+			Handler:    _MachineRuntime_ListMachineStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _MachineRuntime_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMachines",
+			Handler:       _MachineRuntime_WatchMachines_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetConsoleLog",
+			Handler:       _MachineRuntime_GetConsoleLog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "machine/v1alpha1/api.proto",
 }
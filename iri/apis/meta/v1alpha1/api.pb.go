@@ -22,6 +22,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// WatchEventType is the type of change a watch event reports.
+type WatchEventType int32
+
+const (
+	WatchEventType_WATCH_EVENT_ADDED    WatchEventType = 0
+	WatchEventType_WATCH_EVENT_MODIFIED WatchEventType = 1
+	WatchEventType_WATCH_EVENT_DELETED  WatchEventType = 2
+)
+
+// Enum value maps for WatchEventType.
+var (
+	WatchEventType_name = map[int32]string{
+		0: "WATCH_EVENT_ADDED",
+		1: "WATCH_EVENT_MODIFIED",
+		2: "WATCH_EVENT_DELETED",
+	}
+	WatchEventType_value = map[string]int32{
+		"WATCH_EVENT_ADDED":    0,
+		"WATCH_EVENT_MODIFIED": 1,
+		"WATCH_EVENT_DELETED":  2,
+	}
+)
+
+func (x WatchEventType) Enum() *WatchEventType {
+	p := new(WatchEventType)
+	*p = x
+	return p
+}
+
+func (x WatchEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_meta_v1alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (WatchEventType) Type() protoreflect.EnumType {
+	return &file_meta_v1alpha1_api_proto_enumTypes[0]
+}
+
+func (x WatchEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEventType.Descriptor instead.
+func (WatchEventType) EnumDescriptor() ([]byte, []int) {
+	return file_meta_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
 type ObjectMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -127,7 +177,11 @@ const file_meta_v1alpha1_api_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B9Z7github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1b\x06proto3"
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*Z\n" +
+	"\x0eWatchEventType\x12\x15\n" +
+	"\x11WATCH_EVENT_ADDED\x10\x00\x12\x18\n" +
+	"\x14WATCH_EVENT_MODIFIED\x10\x01\x12\x17\n" +
+	"\x13WATCH_EVENT_DELETED\x10\x02B9Z7github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1b\x06proto3"
 
 var (
 	file_meta_v1alpha1_api_proto_rawDescOnce sync.Once
@@ -141,15 +195,17 @@ func file_meta_v1alpha1_api_proto_rawDescGZIP() []byte {
 	return file_meta_v1alpha1_api_proto_rawDescData
 }
 
+var file_meta_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_meta_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_meta_v1alpha1_api_proto_goTypes = []any{
-	(*ObjectMetadata)(nil), // 0: meta.v1alpha1.ObjectMetadata
-	nil,                    // 1: meta.v1alpha1.ObjectMetadata.AnnotationsEntry
-	nil,                    // 2: meta.v1alpha1.ObjectMetadata.LabelsEntry
+	(WatchEventType)(0),    // 0: meta.v1alpha1.WatchEventType
+	(*ObjectMetadata)(nil), // 1: meta.v1alpha1.ObjectMetadata
+	nil,                    // 2: meta.v1alpha1.ObjectMetadata.AnnotationsEntry
+	nil,                    // 3: meta.v1alpha1.ObjectMetadata.LabelsEntry
 }
 var file_meta_v1alpha1_api_proto_depIdxs = []int32{
-	1, // 0: meta.v1alpha1.ObjectMetadata.annotations:type_name -> meta.v1alpha1.ObjectMetadata.AnnotationsEntry
-	2, // 1: meta.v1alpha1.ObjectMetadata.labels:type_name -> meta.v1alpha1.ObjectMetadata.LabelsEntry
+	2, // 0: meta.v1alpha1.ObjectMetadata.annotations:type_name -> meta.v1alpha1.ObjectMetadata.AnnotationsEntry
+	3, // 1: meta.v1alpha1.ObjectMetadata.labels:type_name -> meta.v1alpha1.ObjectMetadata.LabelsEntry
 	2, // [2:2] is the sub-list for method output_type
 	2, // [2:2] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
@@ -167,13 +223,14 @@ func file_meta_v1alpha1_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_meta_v1alpha1_api_proto_rawDesc), len(file_meta_v1alpha1_api_proto_rawDesc)),
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_meta_v1alpha1_api_proto_goTypes,
 		DependencyIndexes: file_meta_v1alpha1_api_proto_depIdxs,
+		EnumInfos:         file_meta_v1alpha1_api_proto_enumTypes,
 		MessageInfos:      file_meta_v1alpha1_api_proto_msgTypes,
 	}.Build()
 	File_meta_v1alpha1_api_proto = out.File
@@ -73,6 +73,127 @@ func (VolumeState) EnumDescriptor() ([]byte, []int) {
 	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{0}
 }
 
+// RuntimeCapability is an optional feature of a volume runtime.
+type RuntimeCapability int32
+
+const (
+	RuntimeCapability_RUNTIME_CAPABILITY_UNSPECIFIED RuntimeCapability = 0
+	// The runtime implements WatchVolumes and WatchEvents.
+	RuntimeCapability_RUNTIME_CAPABILITY_WATCH RuntimeCapability = 1
+	// The runtime implements ExpandVolume.
+	RuntimeCapability_RUNTIME_CAPABILITY_EXPAND RuntimeCapability = 2
+	// The runtime implements CreateVolumeSnapshot, DeleteVolumeSnapshot and ListVolumeSnapshots.
+	RuntimeCapability_RUNTIME_CAPABILITY_SNAPSHOTS RuntimeCapability = 3
+	// The runtime encrypts volumes that specify an encryption.
+	RuntimeCapability_RUNTIME_CAPABILITY_ENCRYPTION RuntimeCapability = 4
+	// The runtime creates volumes from a clone data source.
+	RuntimeCapability_RUNTIME_CAPABILITY_CLONE RuntimeCapability = 5
+	// The runtime implements RevertVolume.
+	RuntimeCapability_RUNTIME_CAPABILITY_REVERT RuntimeCapability = 6
+	// The runtime implements ExportVolumeSnapshot and GetVolumeSnapshotExport and creates volumes from a backup data source.
+	RuntimeCapability_RUNTIME_CAPABILITY_BACKUP RuntimeCapability = 7
+)
+
+// Enum value maps for RuntimeCapability.
+var (
+	RuntimeCapability_name = map[int32]string{
+		0: "RUNTIME_CAPABILITY_UNSPECIFIED",
+		1: "RUNTIME_CAPABILITY_WATCH",
+		2: "RUNTIME_CAPABILITY_EXPAND",
+		3: "RUNTIME_CAPABILITY_SNAPSHOTS",
+		4: "RUNTIME_CAPABILITY_ENCRYPTION",
+		5: "RUNTIME_CAPABILITY_CLONE",
+		6: "RUNTIME_CAPABILITY_REVERT",
+		7: "RUNTIME_CAPABILITY_BACKUP",
+	}
+	RuntimeCapability_value = map[string]int32{
+		"RUNTIME_CAPABILITY_UNSPECIFIED": 0,
+		"RUNTIME_CAPABILITY_WATCH":       1,
+		"RUNTIME_CAPABILITY_EXPAND":      2,
+		"RUNTIME_CAPABILITY_SNAPSHOTS":   3,
+		"RUNTIME_CAPABILITY_ENCRYPTION":  4,
+		"RUNTIME_CAPABILITY_CLONE":       5,
+		"RUNTIME_CAPABILITY_REVERT":      6,
+		"RUNTIME_CAPABILITY_BACKUP":      7,
+	}
+)
+
+func (x RuntimeCapability) Enum() *RuntimeCapability {
+	p := new(RuntimeCapability)
+	*p = x
+	return p
+}
+
+func (x RuntimeCapability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuntimeCapability) Descriptor() protoreflect.EnumDescriptor {
+	return file_volume_v1alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (RuntimeCapability) Type() protoreflect.EnumType {
+	return &file_volume_v1alpha1_api_proto_enumTypes[1]
+}
+
+func (x RuntimeCapability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuntimeCapability.Descriptor instead.
+func (RuntimeCapability) EnumDescriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+type VolumeSnapshotExportState int32
+
+const (
+	VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_RUNNING   VolumeSnapshotExportState = 0
+	VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_SUCCEEDED VolumeSnapshotExportState = 1
+	VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_FAILED    VolumeSnapshotExportState = 2
+)
+
+// Enum value maps for VolumeSnapshotExportState.
+var (
+	VolumeSnapshotExportState_name = map[int32]string{
+		0: "VOLUME_SNAPSHOT_EXPORT_RUNNING",
+		1: "VOLUME_SNAPSHOT_EXPORT_SUCCEEDED",
+		2: "VOLUME_SNAPSHOT_EXPORT_FAILED",
+	}
+	VolumeSnapshotExportState_value = map[string]int32{
+		"VOLUME_SNAPSHOT_EXPORT_RUNNING":   0,
+		"VOLUME_SNAPSHOT_EXPORT_SUCCEEDED": 1,
+		"VOLUME_SNAPSHOT_EXPORT_FAILED":    2,
+	}
+)
+
+func (x VolumeSnapshotExportState) Enum() *VolumeSnapshotExportState {
+	p := new(VolumeSnapshotExportState)
+	*p = x
+	return p
+}
+
+func (x VolumeSnapshotExportState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VolumeSnapshotExportState) Descriptor() protoreflect.EnumDescriptor {
+	return file_volume_v1alpha1_api_proto_enumTypes[2].Descriptor()
+}
+
+func (VolumeSnapshotExportState) Type() protoreflect.EnumType {
+	return &file_volume_v1alpha1_api_proto_enumTypes[2]
+}
+
+func (x VolumeSnapshotExportState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VolumeSnapshotExportState.Descriptor instead.
+func (VolumeSnapshotExportState) EnumDescriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
 type VolumeSnapshotState int32
 
 const (
@@ -106,11 +227,11 @@ func (x VolumeSnapshotState) String() string {
 }
 
 func (VolumeSnapshotState) Descriptor() protoreflect.EnumDescriptor {
-	return file_volume_v1alpha1_api_proto_enumTypes[1].Descriptor()
+	return file_volume_v1alpha1_api_proto_enumTypes[3].Descriptor()
 }
 
 func (VolumeSnapshotState) Type() protoreflect.EnumType {
-	return &file_volume_v1alpha1_api_proto_enumTypes[1]
+	return &file_volume_v1alpha1_api_proto_enumTypes[3]
 }
 
 func (x VolumeSnapshotState) Number() protoreflect.EnumNumber {
@@ -119,13 +240,17 @@ func (x VolumeSnapshotState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VolumeSnapshotState.Descriptor instead.
 func (VolumeSnapshotState) EnumDescriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{3}
 }
 
 type VolumeFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	LabelSelector map[string]string      `protobuf:"bytes,2,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// states restricts the listed volumes to the given states. If empty, volumes in any state are listed.
+	States []VolumeState `protobuf:"varint,3,rep,packed,name=states,proto3,enum=volume.v1alpha1.VolumeState" json:"states,omitempty"`
+	// classes restricts the listed volumes to the given classes. If empty, volumes of any class are listed.
+	Classes       []string `protobuf:"bytes,4,rep,name=classes,proto3" json:"classes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -174,6 +299,20 @@ func (x *VolumeFilter) GetLabelSelector() map[string]string {
 	return nil
 }
 
+func (x *VolumeFilter) GetStates() []VolumeState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *VolumeFilter) GetClasses() []string {
+	if x != nil {
+		return x.Classes
+	}
+	return nil
+}
+
 type EventFilter struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -426,17 +565,172 @@ func (x *SnapshotDataSource) GetSnapshotId() string {
 	return ""
 }
 
+type CloneDataSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneDataSource) Reset() {
+	*x = CloneDataSource{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneDataSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneDataSource) ProtoMessage() {}
+
+func (x *CloneDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneDataSource.ProtoReflect.Descriptor instead.
+func (*CloneDataSource) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CloneDataSource) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+// ObjectStorageLocation is the location of an object in an S3-compatible object storage.
+type ObjectStorageLocation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Endpoint is the address of the REST API of the bucket.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Key is the key of the object in the bucket.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// SecretData are the credentials to access the bucket, e.g. 'AccessKeyID' and 'SecretAccessKey'.
+	SecretData    map[string][]byte `protobuf:"bytes,3,rep,name=secret_data,json=secretData,proto3" json:"secret_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectStorageLocation) Reset() {
+	*x = ObjectStorageLocation{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectStorageLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectStorageLocation) ProtoMessage() {}
+
+func (x *ObjectStorageLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectStorageLocation.ProtoReflect.Descriptor instead.
+func (*ObjectStorageLocation) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ObjectStorageLocation) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ObjectStorageLocation) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ObjectStorageLocation) GetSecretData() map[string][]byte {
+	if x != nil {
+		return x.SecretData
+	}
+	return nil
+}
+
+// BackupDataSource imports the volume from a backup previously exported via ExportVolumeSnapshot.
+type BackupDataSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *ObjectStorageLocation `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDataSource) Reset() {
+	*x = BackupDataSource{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDataSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDataSource) ProtoMessage() {}
+
+func (x *BackupDataSource) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDataSource.ProtoReflect.Descriptor instead.
+func (*BackupDataSource) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BackupDataSource) GetLocation() *ObjectStorageLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
 type VolumeDataSource struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	ImageDataSource    *ImageDataSource       `protobuf:"bytes,1,opt,name=image_data_source,json=imageDataSource,proto3" json:"image_data_source,omitempty"`
 	SnapshotDataSource *SnapshotDataSource    `protobuf:"bytes,2,opt,name=snapshot_data_source,json=snapshotDataSource,proto3" json:"snapshot_data_source,omitempty"`
+	CloneDataSource    *CloneDataSource       `protobuf:"bytes,3,opt,name=clone_data_source,json=cloneDataSource,proto3" json:"clone_data_source,omitempty"`
+	BackupDataSource   *BackupDataSource      `protobuf:"bytes,4,opt,name=backup_data_source,json=backupDataSource,proto3" json:"backup_data_source,omitempty"`
 	unknownFields      protoimpl.UnknownFields
 	sizeCache          protoimpl.SizeCache
 }
 
 func (x *VolumeDataSource) Reset() {
 	*x = VolumeDataSource{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[6]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +742,7 @@ func (x *VolumeDataSource) String() string {
 func (*VolumeDataSource) ProtoMessage() {}
 
 func (x *VolumeDataSource) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[6]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +755,7 @@ func (x *VolumeDataSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeDataSource.ProtoReflect.Descriptor instead.
 func (*VolumeDataSource) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *VolumeDataSource) GetImageDataSource() *ImageDataSource {
@@ -478,6 +772,20 @@ func (x *VolumeDataSource) GetSnapshotDataSource() *SnapshotDataSource {
 	return nil
 }
 
+func (x *VolumeDataSource) GetCloneDataSource() *CloneDataSource {
+	if x != nil {
+		return x.CloneDataSource
+	}
+	return nil
+}
+
+func (x *VolumeDataSource) GetBackupDataSource() *BackupDataSource {
+	if x != nil {
+		return x.BackupDataSource
+	}
+	return nil
+}
+
 type VolumeSpec struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Class            string                 `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
@@ -490,7 +798,7 @@ type VolumeSpec struct {
 
 func (x *VolumeSpec) Reset() {
 	*x = VolumeSpec{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[7]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +810,7 @@ func (x *VolumeSpec) String() string {
 func (*VolumeSpec) ProtoMessage() {}
 
 func (x *VolumeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[7]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +823,7 @@ func (x *VolumeSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeSpec.ProtoReflect.Descriptor instead.
 func (*VolumeSpec) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *VolumeSpec) GetClass() string {
@@ -557,7 +865,7 @@ type VolumeStatus struct {
 
 func (x *VolumeStatus) Reset() {
 	*x = VolumeStatus{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[8]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -569,7 +877,7 @@ func (x *VolumeStatus) String() string {
 func (*VolumeStatus) ProtoMessage() {}
 
 func (x *VolumeStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[8]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,7 +890,7 @@ func (x *VolumeStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeStatus.ProtoReflect.Descriptor instead.
 func (*VolumeStatus) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *VolumeStatus) GetState() VolumeState {
@@ -617,7 +925,7 @@ type Volume struct {
 
 func (x *Volume) Reset() {
 	*x = Volume{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[9]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -629,7 +937,7 @@ func (x *Volume) String() string {
 func (*Volume) ProtoMessage() {}
 
 func (x *Volume) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[9]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,7 +950,7 @@ func (x *Volume) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Volume.ProtoReflect.Descriptor instead.
 func (*Volume) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{9}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Volume) GetMetadata() *v1alpha1.ObjectMetadata {
@@ -676,7 +984,7 @@ type VolumeClassCapabilities struct {
 
 func (x *VolumeClassCapabilities) Reset() {
 	*x = VolumeClassCapabilities{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[10]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -688,7 +996,7 @@ func (x *VolumeClassCapabilities) String() string {
 func (*VolumeClassCapabilities) ProtoMessage() {}
 
 func (x *VolumeClassCapabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[10]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +1009,7 @@ func (x *VolumeClassCapabilities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeClassCapabilities.ProtoReflect.Descriptor instead.
 func (*VolumeClassCapabilities) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{10}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *VolumeClassCapabilities) GetTps() int64 {
@@ -728,7 +1036,7 @@ type VolumeClass struct {
 
 func (x *VolumeClass) Reset() {
 	*x = VolumeClass{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[11]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +1048,7 @@ func (x *VolumeClass) String() string {
 func (*VolumeClass) ProtoMessage() {}
 
 func (x *VolumeClass) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[11]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1061,7 @@ func (x *VolumeClass) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeClass.ProtoReflect.Descriptor instead.
 func (*VolumeClass) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{11}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *VolumeClass) GetName() string {
@@ -780,7 +1088,7 @@ type VolumeClassStatus struct {
 
 func (x *VolumeClassStatus) Reset() {
 	*x = VolumeClassStatus{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[12]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +1100,7 @@ func (x *VolumeClassStatus) String() string {
 func (*VolumeClassStatus) ProtoMessage() {}
 
 func (x *VolumeClassStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[12]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +1113,7 @@ func (x *VolumeClassStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeClassStatus.ProtoReflect.Descriptor instead.
 func (*VolumeClassStatus) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{12}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VolumeClassStatus) GetVolumeClass() *VolumeClass {
@@ -834,7 +1142,7 @@ type VolumeAccess struct {
 
 func (x *VolumeAccess) Reset() {
 	*x = VolumeAccess{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[13]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -846,7 +1154,7 @@ func (x *VolumeAccess) String() string {
 func (*VolumeAccess) ProtoMessage() {}
 
 func (x *VolumeAccess) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[13]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1167,7 @@ func (x *VolumeAccess) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeAccess.ProtoReflect.Descriptor instead.
 func (*VolumeAccess) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{13}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *VolumeAccess) GetDriver() string {
@@ -891,15 +1199,19 @@ func (x *VolumeAccess) GetSecretData() map[string][]byte {
 }
 
 type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// limit is the maximum number of events to return. If zero, all events are returned.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue_token is the continue_token of a previous response to list the next page of events.
+	ContinueToken string `protobuf:"bytes,3,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEventsRequest) Reset() {
 	*x = ListEventsRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[14]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -911,7 +1223,7 @@ func (x *ListEventsRequest) String() string {
 func (*ListEventsRequest) ProtoMessage() {}
 
 func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[14]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +1236,7 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{14}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListEventsRequest) GetFilter() *EventFilter {
@@ -934,16 +1246,32 @@ func (x *ListEventsRequest) GetFilter() *EventFilter {
 	return nil
 }
 
+func (x *ListEventsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
 type ListEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*v1alpha11.Event     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*v1alpha11.Event     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// continue_token is set if there are more events to list.
+	ContinueToken string `protobuf:"bytes,2,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEventsResponse) Reset() {
 	*x = ListEventsResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[15]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -955,7 +1283,7 @@ func (x *ListEventsResponse) String() string {
 func (*ListEventsResponse) ProtoMessage() {}
 
 func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[15]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1296,7 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{15}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListEventsResponse) GetEvents() []*v1alpha11.Event {
@@ -978,28 +1306,620 @@ func (x *ListEventsResponse) GetEvents() []*v1alpha11.Event {
 	return nil
 }
 
-type VersionRequest struct {
+func (x *ListEventsResponse) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+type WatchEventsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *EventFilter           `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version of the last event received by the client.
+	// Events that occurred before the watch was started are not sent; clients catch up via ListEvents.
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchEventsRequest) GetFilter() *EventFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type WatchEventsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Event           *v1alpha11.Event       `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	ResourceVersion string                 `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchEventsResponse) Reset() {
+	*x = WatchEventsResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsResponse) ProtoMessage() {}
+
+func (x *WatchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsResponse.ProtoReflect.Descriptor instead.
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WatchEventsResponse) GetEvent() *v1alpha11.Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchEventsResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type VersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type VersionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the volume runtime.
+	RuntimeName string `protobuf:"bytes,1,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
+	// Version of the volume runtime. The string must be
+	// semver-compatible.
+	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
+	// Optional features supported by the volume runtime.
+	Capabilities  []RuntimeCapability `protobuf:"varint,3,rep,packed,name=capabilities,proto3,enum=volume.v1alpha1.RuntimeCapability" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionResponse) Reset() {
+	*x = VersionResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionResponse) ProtoMessage() {}
+
+func (x *VersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
+func (*VersionResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VersionResponse) GetRuntimeName() string {
+	if x != nil {
+		return x.RuntimeName
+	}
+	return ""
+}
+
+func (x *VersionResponse) GetRuntimeVersion() string {
+	if x != nil {
+		return x.RuntimeVersion
+	}
+	return ""
+}
+
+func (x *VersionResponse) GetCapabilities() []RuntimeCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type ListVolumesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *VolumeFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// limit is the maximum number of volumes to return. If zero, all volumes are returned.
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// continue_token is the continue_token of a previous response to list the next page of volumes.
+	ContinueToken string `protobuf:"bytes,3,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListVolumesRequest) GetFilter() *VolumeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListVolumesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListVolumesRequest) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+type ListVolumesResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Volumes []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	// continue_token is set if there are more volumes to list.
+	ContinueToken string `protobuf:"bytes,2,opt,name=continue_token,json=continueToken,proto3" json:"continue_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *ListVolumesResponse) GetContinueToken() string {
+	if x != nil {
+		return x.ContinueToken
+	}
+	return ""
+}
+
+type WatchVolumesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *VolumeFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// resource_version is the resource version of the last event received by the client.
+	// If empty, the server first sends the current state as WATCH_EVENT_ADDED events.
+	// Servers that cannot resume from the given resource version do the same.
+	ResourceVersion string `protobuf:"bytes,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchVolumesRequest) Reset() {
+	*x = WatchVolumesRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVolumesRequest) ProtoMessage() {}
+
+func (x *WatchVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVolumesRequest.ProtoReflect.Descriptor instead.
+func (*WatchVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *WatchVolumesRequest) GetFilter() *VolumeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchVolumesRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type WatchVolumesResponse struct {
+	state protoimpl.MessageState  `protogen:"open.v1"`
+	Type  v1alpha1.WatchEventType `protobuf:"varint,1,opt,name=type,proto3,enum=meta.v1alpha1.WatchEventType" json:"type,omitempty"`
+	// volume is the volume the event is about. For WATCH_EVENT_DELETED, servers may only
+	// populate the metadata.
+	Volume          *Volume `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	ResourceVersion string  `protobuf:"bytes,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchVolumesResponse) Reset() {
+	*x = WatchVolumesResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVolumesResponse) ProtoMessage() {}
+
+func (x *WatchVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVolumesResponse.ProtoReflect.Descriptor instead.
+func (*WatchVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WatchVolumesResponse) GetType() v1alpha1.WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return v1alpha1.WatchEventType(0)
+}
+
+func (x *WatchVolumesResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+func (x *WatchVolumesResponse) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type CreateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeRequest) ProtoMessage() {}
+
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateVolumeRequest) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ExpandVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Resources     *VolumeResources       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandVolumeRequest) Reset() {
+	*x = ExpandVolumeRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandVolumeRequest) ProtoMessage() {}
+
+func (x *ExpandVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ExpandVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExpandVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *ExpandVolumeRequest) GetResources() *VolumeResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type CreateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeResponse) Reset() {
+	*x = CreateVolumeResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeResponse) ProtoMessage() {}
+
+func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateVolumeResponse) GetVolume() *Volume {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ExpandVolumeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VersionRequest) Reset() {
-	*x = VersionRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[16]
+func (x *ExpandVolumeResponse) Reset() {
+	*x = ExpandVolumeResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionRequest) String() string {
+func (x *ExpandVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionRequest) ProtoMessage() {}
+func (*ExpandVolumeResponse) ProtoMessage() {}
 
-func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[16]
+func (x *ExpandVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,44 +1930,39 @@ func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
-func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *VersionRequest) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+// Deprecated: Use ExpandVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ExpandVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{30}
 }
 
-type VersionResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Name of the volume runtime.
-	RuntimeName string `protobuf:"bytes,1,opt,name=runtime_name,json=runtimeName,proto3" json:"runtime_name,omitempty"`
-	// Version of the volume runtime. The string must be
-	// semver-compatible.
-	RuntimeVersion string `protobuf:"bytes,2,opt,name=runtime_version,json=runtimeVersion,proto3" json:"runtime_version,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// RevertVolumeRequest reverts the volume to a snapshot of itself.
+// The volume must not be attached to a machine while it is reverted.
+// RevertVolume returns once the revert has been applied. Runtimes applying the revert asynchronously
+// return a retryable error (e.g. DeadlineExceeded) while it is pending and keep waiting for the pending
+// revert when called again with the same snapshot.
+type RevertVolumeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId         string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	VolumeSnapshotId string                 `protobuf:"bytes,2,opt,name=volume_snapshot_id,json=volumeSnapshotId,proto3" json:"volume_snapshot_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *VersionResponse) Reset() {
-	*x = VersionResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[17]
+func (x *RevertVolumeRequest) Reset() {
+	*x = RevertVolumeRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionResponse) String() string {
+func (x *RevertVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionResponse) ProtoMessage() {}
+func (*RevertVolumeRequest) ProtoMessage() {}
 
-func (x *VersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[17]
+func (x *RevertVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,47 +1973,46 @@ func (x *VersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionResponse.ProtoReflect.Descriptor instead.
-func (*VersionResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RevertVolumeRequest.ProtoReflect.Descriptor instead.
+func (*RevertVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *VersionResponse) GetRuntimeName() string {
+func (x *RevertVolumeRequest) GetVolumeId() string {
 	if x != nil {
-		return x.RuntimeName
+		return x.VolumeId
 	}
 	return ""
 }
 
-func (x *VersionResponse) GetRuntimeVersion() string {
+func (x *RevertVolumeRequest) GetVolumeSnapshotId() string {
 	if x != nil {
-		return x.RuntimeVersion
+		return x.VolumeSnapshotId
 	}
 	return ""
 }
 
-type ListVolumesRequest struct {
+type RevertVolumeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filter        *VolumeFilter          `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVolumesRequest) Reset() {
-	*x = ListVolumesRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[18]
+func (x *RevertVolumeResponse) Reset() {
+	*x = RevertVolumeResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVolumesRequest) String() string {
+func (x *RevertVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVolumesRequest) ProtoMessage() {}
+func (*RevertVolumeResponse) ProtoMessage() {}
 
-func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[18]
+func (x *RevertVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,40 +2023,37 @@ func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
-func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *ListVolumesRequest) GetFilter() *VolumeFilter {
-	if x != nil {
-		return x.Filter
-	}
-	return nil
+// Deprecated: Use RevertVolumeResponse.ProtoReflect.Descriptor instead.
+func (*RevertVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{32}
 }
 
-type ListVolumesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volumes       []*Volume              `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ExportVolumeSnapshotRequest starts streaming the data of a volume snapshot into an object of an
+// S3-compatible object storage. The call returns once the export has been started. Its progress is
+// reported by GetVolumeSnapshotExport.
+type ExportVolumeSnapshotRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	VolumeSnapshotId string                 `protobuf:"bytes,1,opt,name=volume_snapshot_id,json=volumeSnapshotId,proto3" json:"volume_snapshot_id,omitempty"`
+	Location         *ObjectStorageLocation `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *ListVolumesResponse) Reset() {
-	*x = ListVolumesResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[19]
+func (x *ExportVolumeSnapshotRequest) Reset() {
+	*x = ExportVolumeSnapshotRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVolumesResponse) String() string {
+func (x *ExportVolumeSnapshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVolumesResponse) ProtoMessage() {}
+func (*ExportVolumeSnapshotRequest) ProtoMessage() {}
 
-func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[19]
+func (x *ExportVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,40 +2064,48 @@ func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
-func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ExportVolumeSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ExportVolumeSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListVolumesResponse) GetVolumes() []*Volume {
+func (x *ExportVolumeSnapshotRequest) GetVolumeSnapshotId() string {
 	if x != nil {
-		return x.Volumes
+		return x.VolumeSnapshotId
+	}
+	return ""
+}
+
+func (x *ExportVolumeSnapshotRequest) GetLocation() *ObjectStorageLocation {
+	if x != nil {
+		return x.Location
 	}
 	return nil
 }
 
-type CreateVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+type ExportVolumeSnapshotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// export_id identifies the started export in GetVolumeSnapshotExport.
+	ExportId      string `protobuf:"bytes,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeRequest) Reset() {
-	*x = CreateVolumeRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[20]
+func (x *ExportVolumeSnapshotResponse) Reset() {
+	*x = ExportVolumeSnapshotResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeRequest) String() string {
+func (x *ExportVolumeSnapshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeRequest) ProtoMessage() {}
+func (*ExportVolumeSnapshotResponse) ProtoMessage() {}
 
-func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[20]
+func (x *ExportVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1197,41 +2116,47 @@ func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
-func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ExportVolumeSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*ExportVolumeSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateVolumeRequest) GetVolume() *Volume {
+func (x *ExportVolumeSnapshotResponse) GetExportId() string {
 	if x != nil {
-		return x.Volume
+		return x.ExportId
 	}
-	return nil
+	return ""
 }
 
-type ExpandVolumeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	Resources     *VolumeResources       `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+type VolumeSnapshotExport struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Id               string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VolumeSnapshotId string                    `protobuf:"bytes,2,opt,name=volume_snapshot_id,json=volumeSnapshotId,proto3" json:"volume_snapshot_id,omitempty"`
+	State            VolumeSnapshotExportState `protobuf:"varint,3,opt,name=state,proto3,enum=volume.v1alpha1.VolumeSnapshotExportState" json:"state,omitempty"`
+	// bytes_written is the number of bytes written to the object so far.
+	// Once the export succeeded, it is the size of the written object.
+	BytesWritten int64 `protobuf:"varint,4,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"`
+	// message is a human-readable explanation of a failed export.
+	Message       string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpandVolumeRequest) Reset() {
-	*x = ExpandVolumeRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[21]
+func (x *VolumeSnapshotExport) Reset() {
+	*x = VolumeSnapshotExport{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpandVolumeRequest) String() string {
+func (x *VolumeSnapshotExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpandVolumeRequest) ProtoMessage() {}
+func (*VolumeSnapshotExport) ProtoMessage() {}
 
-func (x *ExpandVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[21]
+func (x *VolumeSnapshotExport) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,47 +2167,71 @@ func (x *ExpandVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpandVolumeRequest.ProtoReflect.Descriptor instead.
-func (*ExpandVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use VolumeSnapshotExport.ProtoReflect.Descriptor instead.
+func (*VolumeSnapshotExport) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ExpandVolumeRequest) GetVolumeId() string {
+func (x *VolumeSnapshotExport) GetId() string {
 	if x != nil {
-		return x.VolumeId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ExpandVolumeRequest) GetResources() *VolumeResources {
+func (x *VolumeSnapshotExport) GetVolumeSnapshotId() string {
 	if x != nil {
-		return x.Resources
+		return x.VolumeSnapshotId
 	}
-	return nil
+	return ""
 }
 
-type CreateVolumeResponse struct {
+func (x *VolumeSnapshotExport) GetState() VolumeSnapshotExportState {
+	if x != nil {
+		return x.State
+	}
+	return VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_RUNNING
+}
+
+func (x *VolumeSnapshotExport) GetBytesWritten() int64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *VolumeSnapshotExport) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetVolumeSnapshotExportRequest gets the progress of an export started via ExportVolumeSnapshot.
+// If the runtime does not know the export (anymore), e.g. after a restart, NotFound is returned
+// and the export has to be started again.
+type GetVolumeSnapshotExportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Volume        *Volume                `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	ExportId      string                 `protobuf:"bytes,1,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVolumeResponse) Reset() {
-	*x = CreateVolumeResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[22]
+func (x *GetVolumeSnapshotExportRequest) Reset() {
+	*x = GetVolumeSnapshotExportRequest{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVolumeResponse) String() string {
+func (x *GetVolumeSnapshotExportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVolumeResponse) ProtoMessage() {}
+func (*GetVolumeSnapshotExportRequest) ProtoMessage() {}
 
-func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[22]
+func (x *GetVolumeSnapshotExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,39 +2242,40 @@ func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
-func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetVolumeSnapshotExportRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeSnapshotExportRequest) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateVolumeResponse) GetVolume() *Volume {
+func (x *GetVolumeSnapshotExportRequest) GetExportId() string {
 	if x != nil {
-		return x.Volume
+		return x.ExportId
 	}
-	return nil
+	return ""
 }
 
-type ExpandVolumeResponse struct {
+type GetVolumeSnapshotExportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *VolumeSnapshotExport  `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpandVolumeResponse) Reset() {
-	*x = ExpandVolumeResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[23]
+func (x *GetVolumeSnapshotExportResponse) Reset() {
+	*x = GetVolumeSnapshotExportResponse{}
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpandVolumeResponse) String() string {
+func (x *GetVolumeSnapshotExportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpandVolumeResponse) ProtoMessage() {}
+func (*GetVolumeSnapshotExportResponse) ProtoMessage() {}
 
-func (x *ExpandVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[23]
+func (x *GetVolumeSnapshotExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,9 +2286,16 @@ func (x *ExpandVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpandVolumeResponse.ProtoReflect.Descriptor instead.
-func (*ExpandVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetVolumeSnapshotExportResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeSnapshotExportResponse) Descriptor() ([]byte, []int) {
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetVolumeSnapshotExportResponse) GetExport() *VolumeSnapshotExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
 }
 
 type DeleteVolumeRequest struct {
@@ -1350,7 +2307,7 @@ type DeleteVolumeRequest struct {
 
 func (x *DeleteVolumeRequest) Reset() {
 	*x = DeleteVolumeRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[24]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1362,7 +2319,7 @@ func (x *DeleteVolumeRequest) String() string {
 func (*DeleteVolumeRequest) ProtoMessage() {}
 
 func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[24]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,7 +2332,7 @@ func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{24}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *DeleteVolumeRequest) GetVolumeId() string {
@@ -1393,7 +2350,7 @@ type DeleteVolumeResponse struct {
 
 func (x *DeleteVolumeResponse) Reset() {
 	*x = DeleteVolumeResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[25]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1405,7 +2362,7 @@ func (x *DeleteVolumeResponse) String() string {
 func (*DeleteVolumeResponse) ProtoMessage() {}
 
 func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[25]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,7 +2375,7 @@ func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{25}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{39}
 }
 
 type StatusRequest struct {
@@ -1429,7 +2386,7 @@ type StatusRequest struct {
 
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[26]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1441,7 +2398,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[26]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1454,7 +2411,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{26}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{40}
 }
 
 type StatusResponse struct {
@@ -1466,7 +2423,7 @@ type StatusResponse struct {
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[27]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1478,7 +2435,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[27]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,7 +2448,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{27}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *StatusResponse) GetVolumeClassStatus() []*VolumeClassStatus {
@@ -1510,7 +2467,7 @@ type VolumeSnapshotSpec struct {
 
 func (x *VolumeSnapshotSpec) Reset() {
 	*x = VolumeSnapshotSpec{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[28]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1522,7 +2479,7 @@ func (x *VolumeSnapshotSpec) String() string {
 func (*VolumeSnapshotSpec) ProtoMessage() {}
 
 func (x *VolumeSnapshotSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[28]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1535,7 +2492,7 @@ func (x *VolumeSnapshotSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeSnapshotSpec.ProtoReflect.Descriptor instead.
 func (*VolumeSnapshotSpec) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{28}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *VolumeSnapshotSpec) GetVolumeId() string {
@@ -1555,7 +2512,7 @@ type VolumeSnapshotStatus struct {
 
 func (x *VolumeSnapshotStatus) Reset() {
 	*x = VolumeSnapshotStatus{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[29]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1567,7 +2524,7 @@ func (x *VolumeSnapshotStatus) String() string {
 func (*VolumeSnapshotStatus) ProtoMessage() {}
 
 func (x *VolumeSnapshotStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[29]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1580,7 +2537,7 @@ func (x *VolumeSnapshotStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeSnapshotStatus.ProtoReflect.Descriptor instead.
 func (*VolumeSnapshotStatus) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{29}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *VolumeSnapshotStatus) GetState() VolumeSnapshotState {
@@ -1608,7 +2565,7 @@ type VolumeSnapshot struct {
 
 func (x *VolumeSnapshot) Reset() {
 	*x = VolumeSnapshot{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[30]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1620,7 +2577,7 @@ func (x *VolumeSnapshot) String() string {
 func (*VolumeSnapshot) ProtoMessage() {}
 
 func (x *VolumeSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[30]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +2590,7 @@ func (x *VolumeSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeSnapshot.ProtoReflect.Descriptor instead.
 func (*VolumeSnapshot) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{30}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *VolumeSnapshot) GetMetadata() *v1alpha1.ObjectMetadata {
@@ -1667,7 +2624,7 @@ type VolumeSnapshotFilter struct {
 
 func (x *VolumeSnapshotFilter) Reset() {
 	*x = VolumeSnapshotFilter{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[31]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1679,7 +2636,7 @@ func (x *VolumeSnapshotFilter) String() string {
 func (*VolumeSnapshotFilter) ProtoMessage() {}
 
 func (x *VolumeSnapshotFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[31]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1692,7 +2649,7 @@ func (x *VolumeSnapshotFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VolumeSnapshotFilter.ProtoReflect.Descriptor instead.
 func (*VolumeSnapshotFilter) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{31}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *VolumeSnapshotFilter) GetId() string {
@@ -1718,7 +2675,7 @@ type ListVolumeSnapshotsRequest struct {
 
 func (x *ListVolumeSnapshotsRequest) Reset() {
 	*x = ListVolumeSnapshotsRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[32]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1730,7 +2687,7 @@ func (x *ListVolumeSnapshotsRequest) String() string {
 func (*ListVolumeSnapshotsRequest) ProtoMessage() {}
 
 func (x *ListVolumeSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[32]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,7 +2700,7 @@ func (x *ListVolumeSnapshotsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVolumeSnapshotsRequest.ProtoReflect.Descriptor instead.
 func (*ListVolumeSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{32}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ListVolumeSnapshotsRequest) GetFilter() *VolumeSnapshotFilter {
@@ -1762,7 +2719,7 @@ type ListVolumeSnapshotsResponse struct {
 
 func (x *ListVolumeSnapshotsResponse) Reset() {
 	*x = ListVolumeSnapshotsResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[33]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1774,7 +2731,7 @@ func (x *ListVolumeSnapshotsResponse) String() string {
 func (*ListVolumeSnapshotsResponse) ProtoMessage() {}
 
 func (x *ListVolumeSnapshotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[33]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,7 +2744,7 @@ func (x *ListVolumeSnapshotsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListVolumeSnapshotsResponse.ProtoReflect.Descriptor instead.
 func (*ListVolumeSnapshotsResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{33}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ListVolumeSnapshotsResponse) GetVolumeSnapshots() []*VolumeSnapshot {
@@ -1806,7 +2763,7 @@ type CreateVolumeSnapshotRequest struct {
 
 func (x *CreateVolumeSnapshotRequest) Reset() {
 	*x = CreateVolumeSnapshotRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[34]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1818,7 +2775,7 @@ func (x *CreateVolumeSnapshotRequest) String() string {
 func (*CreateVolumeSnapshotRequest) ProtoMessage() {}
 
 func (x *CreateVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[34]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1831,7 +2788,7 @@ func (x *CreateVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*CreateVolumeSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{34}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CreateVolumeSnapshotRequest) GetVolumeSnapshot() *VolumeSnapshot {
@@ -1850,7 +2807,7 @@ type CreateVolumeSnapshotResponse struct {
 
 func (x *CreateVolumeSnapshotResponse) Reset() {
 	*x = CreateVolumeSnapshotResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[35]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1862,7 +2819,7 @@ func (x *CreateVolumeSnapshotResponse) String() string {
 func (*CreateVolumeSnapshotResponse) ProtoMessage() {}
 
 func (x *CreateVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[35]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1875,7 +2832,7 @@ func (x *CreateVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateVolumeSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*CreateVolumeSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{35}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CreateVolumeSnapshotResponse) GetVolumeSnapshot() *VolumeSnapshot {
@@ -1894,7 +2851,7 @@ type DeleteVolumeSnapshotRequest struct {
 
 func (x *DeleteVolumeSnapshotRequest) Reset() {
 	*x = DeleteVolumeSnapshotRequest{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[36]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1906,7 +2863,7 @@ func (x *DeleteVolumeSnapshotRequest) String() string {
 func (*DeleteVolumeSnapshotRequest) ProtoMessage() {}
 
 func (x *DeleteVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[36]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1919,7 +2876,7 @@ func (x *DeleteVolumeSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{36}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *DeleteVolumeSnapshotRequest) GetVolumeSnapshotId() string {
@@ -1937,7 +2894,7 @@ type DeleteVolumeSnapshotResponse struct {
 
 func (x *DeleteVolumeSnapshotResponse) Reset() {
 	*x = DeleteVolumeSnapshotResponse{}
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[37]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1949,7 +2906,7 @@ func (x *DeleteVolumeSnapshotResponse) String() string {
 func (*DeleteVolumeSnapshotResponse) ProtoMessage() {}
 
 func (x *DeleteVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_volume_v1alpha1_api_proto_msgTypes[37]
+	mi := &file_volume_v1alpha1_api_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1962,17 +2919,19 @@ func (x *DeleteVolumeSnapshotResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVolumeSnapshotResponse.ProtoReflect.Descriptor instead.
 func (*DeleteVolumeSnapshotResponse) Descriptor() ([]byte, []int) {
-	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{37}
+	return file_volume_v1alpha1_api_proto_rawDescGZIP(), []int{51}
 }
 
 var File_volume_v1alpha1_api_proto protoreflect.FileDescriptor
 
 const file_volume_v1alpha1_api_proto_rawDesc = "" +
 	"\n" +
-	"\x19volume/v1alpha1/api.proto\x12\x0fvolume.v1alpha1\x1a\x17meta/v1alpha1/api.proto\x1a\x18event/v1alpha1/api.proto\"\xb9\x01\n" +
+	"\x19volume/v1alpha1/api.proto\x12\x0fvolume.v1alpha1\x1a\x17meta/v1alpha1/api.proto\x1a\x18event/v1alpha1/api.proto\"\x89\x02\n" +
 	"\fVolumeFilter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12W\n" +
-	"\x0elabel_selector\x18\x02 \x03(\v20.volume.v1alpha1.VolumeFilter.LabelSelectorEntryR\rlabelSelector\x1a@\n" +
+	"\x0elabel_selector\x18\x02 \x03(\v20.volume.v1alpha1.VolumeFilter.LabelSelectorEntryR\rlabelSelector\x124\n" +
+	"\x06states\x18\x03 \x03(\x0e2\x1c.volume.v1alpha1.VolumeStateR\x06states\x12\x18\n" +
+	"\aclasses\x18\x04 \x03(\tR\aclasses\x1a@\n" +
 	"\x12LabelSelectorEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x02\n" +
@@ -1997,10 +2956,24 @@ const file_volume_v1alpha1_api_proto_rawDesc = "" +
 	"\farchitecture\x18\x02 \x01(\tR\farchitecture\"5\n" +
 	"\x12SnapshotDataSource\x12\x1f\n" +
 	"\vsnapshot_id\x18\x01 \x01(\tR\n" +
-	"snapshotId\"\xb7\x01\n" +
+	"snapshotId\".\n" +
+	"\x0fCloneDataSource\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\"\xdd\x01\n" +
+	"\x15ObjectStorageLocation\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12W\n" +
+	"\vsecret_data\x18\x03 \x03(\v26.volume.v1alpha1.ObjectStorageLocation.SecretDataEntryR\n" +
+	"secretData\x1a=\n" +
+	"\x0fSecretDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"V\n" +
+	"\x10BackupDataSource\x12B\n" +
+	"\blocation\x18\x01 \x01(\v2&.volume.v1alpha1.ObjectStorageLocationR\blocation\"\xd6\x02\n" +
 	"\x10VolumeDataSource\x12L\n" +
 	"\x11image_data_source\x18\x01 \x01(\v2 .volume.v1alpha1.ImageDataSourceR\x0fimageDataSource\x12U\n" +
-	"\x14snapshot_data_source\x18\x02 \x01(\v2#.volume.v1alpha1.SnapshotDataSourceR\x12snapshotDataSource\"\x81\x02\n" +
+	"\x14snapshot_data_source\x18\x02 \x01(\v2#.volume.v1alpha1.SnapshotDataSourceR\x12snapshotDataSource\x12L\n" +
+	"\x11clone_data_source\x18\x03 \x01(\v2 .volume.v1alpha1.CloneDataSourceR\x0fcloneDataSource\x12O\n" +
+	"\x12backup_data_source\x18\x04 \x01(\v2!.volume.v1alpha1.BackupDataSourceR\x10backupDataSource\"\x81\x02\n" +
 	"\n" +
 	"VolumeSpec\x12\x14\n" +
 	"\x05class\x18\x02 \x01(\tR\x05class\x12>\n" +
@@ -2039,20 +3012,40 @@ const file_volume_v1alpha1_api_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
 	"\x0fSecretDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"I\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x86\x01\n" +
 	"\x11ListEventsRequest\x124\n" +
-	"\x06filter\x18\x01 \x01(\v2\x1c.volume.v1alpha1.EventFilterR\x06filter\"C\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.volume.v1alpha1.EventFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12%\n" +
+	"\x0econtinue_token\x18\x03 \x01(\tR\rcontinueToken\"j\n" +
 	"\x12ListEventsResponse\x12-\n" +
-	"\x06events\x18\x01 \x03(\v2\x15.event.v1alpha1.EventR\x06events\"*\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.event.v1alpha1.EventR\x06events\x12%\n" +
+	"\x0econtinue_token\x18\x02 \x01(\tR\rcontinueToken\"u\n" +
+	"\x12WatchEventsRequest\x124\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.volume.v1alpha1.EventFilterR\x06filter\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"m\n" +
+	"\x13WatchEventsResponse\x12+\n" +
+	"\x05event\x18\x01 \x01(\v2\x15.event.v1alpha1.EventR\x05event\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"*\n" +
 	"\x0eVersionRequest\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"]\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\xa5\x01\n" +
 	"\x0fVersionResponse\x12!\n" +
 	"\fruntime_name\x18\x01 \x01(\tR\vruntimeName\x12'\n" +
-	"\x0fruntime_version\x18\x02 \x01(\tR\x0eruntimeVersion\"K\n" +
+	"\x0fruntime_version\x18\x02 \x01(\tR\x0eruntimeVersion\x12F\n" +
+	"\fcapabilities\x18\x03 \x03(\x0e2\".volume.v1alpha1.RuntimeCapabilityR\fcapabilities\"\x88\x01\n" +
 	"\x12ListVolumesRequest\x125\n" +
-	"\x06filter\x18\x01 \x01(\v2\x1d.volume.v1alpha1.VolumeFilterR\x06filter\"H\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.volume.v1alpha1.VolumeFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12%\n" +
+	"\x0econtinue_token\x18\x03 \x01(\tR\rcontinueToken\"o\n" +
 	"\x13ListVolumesResponse\x121\n" +
-	"\avolumes\x18\x01 \x03(\v2\x17.volume.v1alpha1.VolumeR\avolumes\"F\n" +
+	"\avolumes\x18\x01 \x03(\v2\x17.volume.v1alpha1.VolumeR\avolumes\x12%\n" +
+	"\x0econtinue_token\x18\x02 \x01(\tR\rcontinueToken\"w\n" +
+	"\x13WatchVolumesRequest\x125\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1d.volume.v1alpha1.VolumeFilterR\x06filter\x12)\n" +
+	"\x10resource_version\x18\x02 \x01(\tR\x0fresourceVersion\"\xa5\x01\n" +
+	"\x14WatchVolumesResponse\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.meta.v1alpha1.WatchEventTypeR\x04type\x12/\n" +
+	"\x06volume\x18\x02 \x01(\v2\x17.volume.v1alpha1.VolumeR\x06volume\x12)\n" +
+	"\x10resource_version\x18\x03 \x01(\tR\x0fresourceVersion\"F\n" +
 	"\x13CreateVolumeRequest\x12/\n" +
 	"\x06volume\x18\x01 \x01(\v2\x17.volume.v1alpha1.VolumeR\x06volume\"r\n" +
 	"\x13ExpandVolumeRequest\x12\x1b\n" +
@@ -2060,7 +3053,26 @@ const file_volume_v1alpha1_api_proto_rawDesc = "" +
 	"\tresources\x18\x02 \x01(\v2 .volume.v1alpha1.VolumeResourcesR\tresources\"G\n" +
 	"\x14CreateVolumeResponse\x12/\n" +
 	"\x06volume\x18\x01 \x01(\v2\x17.volume.v1alpha1.VolumeR\x06volume\"\x16\n" +
-	"\x14ExpandVolumeResponse\"2\n" +
+	"\x14ExpandVolumeResponse\"`\n" +
+	"\x13RevertVolumeRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12,\n" +
+	"\x12volume_snapshot_id\x18\x02 \x01(\tR\x10volumeSnapshotId\"\x16\n" +
+	"\x14RevertVolumeResponse\"\x8f\x01\n" +
+	"\x1bExportVolumeSnapshotRequest\x12,\n" +
+	"\x12volume_snapshot_id\x18\x01 \x01(\tR\x10volumeSnapshotId\x12B\n" +
+	"\blocation\x18\x02 \x01(\v2&.volume.v1alpha1.ObjectStorageLocationR\blocation\"G\n" +
+	"\x1cExportVolumeSnapshotResponse\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\tR\bexportIdJ\x04\b\x01\x10\x02R\x04size\"\xd5\x01\n" +
+	"\x14VolumeSnapshotExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12volume_snapshot_id\x18\x02 \x01(\tR\x10volumeSnapshotId\x12@\n" +
+	"\x05state\x18\x03 \x01(\x0e2*.volume.v1alpha1.VolumeSnapshotExportStateR\x05state\x12#\n" +
+	"\rbytes_written\x18\x04 \x01(\x03R\fbytesWritten\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"=\n" +
+	"\x1eGetVolumeSnapshotExportRequest\x12\x1b\n" +
+	"\texport_id\x18\x01 \x01(\tR\bexportId\"`\n" +
+	"\x1fGetVolumeSnapshotExportResponse\x12=\n" +
+	"\x06export\x18\x01 \x01(\v2%.volume.v1alpha1.VolumeSnapshotExportR\x06export\"2\n" +
 	"\x13DeleteVolumeRequest\x12\x1b\n" +
 	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\"\x16\n" +
 	"\x14DeleteVolumeResponse\"\x0f\n" +
@@ -2096,18 +3108,36 @@ const file_volume_v1alpha1_api_proto_rawDesc = "" +
 	"\vVolumeState\x12\x12\n" +
 	"\x0eVOLUME_PENDING\x10\x00\x12\x14\n" +
 	"\x10VOLUME_AVAILABLE\x10\x01\x12\x10\n" +
-	"\fVOLUME_ERROR\x10\x02*i\n" +
+	"\fVOLUME_ERROR\x10\x02*\x95\x02\n" +
+	"\x11RuntimeCapability\x12\"\n" +
+	"\x1eRUNTIME_CAPABILITY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RUNTIME_CAPABILITY_WATCH\x10\x01\x12\x1d\n" +
+	"\x19RUNTIME_CAPABILITY_EXPAND\x10\x02\x12 \n" +
+	"\x1cRUNTIME_CAPABILITY_SNAPSHOTS\x10\x03\x12!\n" +
+	"\x1dRUNTIME_CAPABILITY_ENCRYPTION\x10\x04\x12\x1c\n" +
+	"\x18RUNTIME_CAPABILITY_CLONE\x10\x05\x12\x1d\n" +
+	"\x19RUNTIME_CAPABILITY_REVERT\x10\x06\x12\x1d\n" +
+	"\x19RUNTIME_CAPABILITY_BACKUP\x10\a*\x88\x01\n" +
+	"\x19VolumeSnapshotExportState\x12\"\n" +
+	"\x1eVOLUME_SNAPSHOT_EXPORT_RUNNING\x10\x00\x12$\n" +
+	" VOLUME_SNAPSHOT_EXPORT_SUCCEEDED\x10\x01\x12!\n" +
+	"\x1dVOLUME_SNAPSHOT_EXPORT_FAILED\x10\x02*i\n" +
 	"\x13VolumeSnapshotState\x12\x1b\n" +
 	"\x17VOLUME_SNAPSHOT_PENDING\x10\x00\x12\x19\n" +
 	"\x15VOLUME_SNAPSHOT_READY\x10\x01\x12\x1a\n" +
-	"\x16VOLUME_SNAPSHOT_FAILED\x10\x022\xe0\a\n" +
+	"\x16VOLUME_SNAPSHOT_FAILED\x10\x022\xf5\v\n" +
 	"\rVolumeRuntime\x12N\n" +
 	"\aVersion\x12\x1f.volume.v1alpha1.VersionRequest\x1a .volume.v1alpha1.VersionResponse\"\x00\x12W\n" +
 	"\n" +
-	"ListEvents\x12\".volume.v1alpha1.ListEventsRequest\x1a#.volume.v1alpha1.ListEventsResponse\"\x00\x12Z\n" +
-	"\vListVolumes\x12#.volume.v1alpha1.ListVolumesRequest\x1a$.volume.v1alpha1.ListVolumesResponse\"\x00\x12]\n" +
+	"ListEvents\x12\".volume.v1alpha1.ListEventsRequest\x1a#.volume.v1alpha1.ListEventsResponse\"\x00\x12\\\n" +
+	"\vWatchEvents\x12#.volume.v1alpha1.WatchEventsRequest\x1a$.volume.v1alpha1.WatchEventsResponse\"\x000\x01\x12Z\n" +
+	"\vListVolumes\x12#.volume.v1alpha1.ListVolumesRequest\x1a$.volume.v1alpha1.ListVolumesResponse\"\x00\x12_\n" +
+	"\fWatchVolumes\x12$.volume.v1alpha1.WatchVolumesRequest\x1a%.volume.v1alpha1.WatchVolumesResponse\"\x000\x01\x12]\n" +
 	"\fCreateVolume\x12$.volume.v1alpha1.CreateVolumeRequest\x1a%.volume.v1alpha1.CreateVolumeResponse\"\x00\x12]\n" +
 	"\fExpandVolume\x12$.volume.v1alpha1.ExpandVolumeRequest\x1a%.volume.v1alpha1.ExpandVolumeResponse\"\x00\x12]\n" +
+	"\fRevertVolume\x12$.volume.v1alpha1.RevertVolumeRequest\x1a%.volume.v1alpha1.RevertVolumeResponse\"\x00\x12u\n" +
+	"\x14ExportVolumeSnapshot\x12,.volume.v1alpha1.ExportVolumeSnapshotRequest\x1a-.volume.v1alpha1.ExportVolumeSnapshotResponse\"\x00\x12~\n" +
+	"\x17GetVolumeSnapshotExport\x12/.volume.v1alpha1.GetVolumeSnapshotExportRequest\x1a0.volume.v1alpha1.GetVolumeSnapshotExportResponse\"\x00\x12]\n" +
 	"\fDeleteVolume\x12$.volume.v1alpha1.DeleteVolumeRequest\x1a%.volume.v1alpha1.DeleteVolumeResponse\"\x00\x12u\n" +
 	"\x14CreateVolumeSnapshot\x12,.volume.v1alpha1.CreateVolumeSnapshotRequest\x1a-.volume.v1alpha1.CreateVolumeSnapshotResponse\"\x00\x12u\n" +
 	"\x14DeleteVolumeSnapshot\x12,.volume.v1alpha1.DeleteVolumeSnapshotRequest\x1a-.volume.v1alpha1.DeleteVolumeSnapshotResponse\"\x00\x12r\n" +
@@ -2126,119 +3156,161 @@ func file_volume_v1alpha1_api_proto_rawDescGZIP() []byte {
 	return file_volume_v1alpha1_api_proto_rawDescData
 }
 
-var file_volume_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_volume_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_volume_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_volume_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_volume_v1alpha1_api_proto_goTypes = []any{
-	(VolumeState)(0),                     // 0: volume.v1alpha1.VolumeState
-	(VolumeSnapshotState)(0),             // 1: volume.v1alpha1.VolumeSnapshotState
-	(*VolumeFilter)(nil),                 // 2: volume.v1alpha1.VolumeFilter
-	(*EventFilter)(nil),                  // 3: volume.v1alpha1.EventFilter
-	(*VolumeResources)(nil),              // 4: volume.v1alpha1.VolumeResources
-	(*EncryptionSpec)(nil),               // 5: volume.v1alpha1.EncryptionSpec
-	(*ImageDataSource)(nil),              // 6: volume.v1alpha1.ImageDataSource
-	(*SnapshotDataSource)(nil),           // 7: volume.v1alpha1.SnapshotDataSource
-	(*VolumeDataSource)(nil),             // 8: volume.v1alpha1.VolumeDataSource
-	(*VolumeSpec)(nil),                   // 9: volume.v1alpha1.VolumeSpec
-	(*VolumeStatus)(nil),                 // 10: volume.v1alpha1.VolumeStatus
-	(*Volume)(nil),                       // 11: volume.v1alpha1.Volume
-	(*VolumeClassCapabilities)(nil),      // 12: volume.v1alpha1.VolumeClassCapabilities
-	(*VolumeClass)(nil),                  // 13: volume.v1alpha1.VolumeClass
-	(*VolumeClassStatus)(nil),            // 14: volume.v1alpha1.VolumeClassStatus
-	(*VolumeAccess)(nil),                 // 15: volume.v1alpha1.VolumeAccess
-	(*ListEventsRequest)(nil),            // 16: volume.v1alpha1.ListEventsRequest
-	(*ListEventsResponse)(nil),           // 17: volume.v1alpha1.ListEventsResponse
-	(*VersionRequest)(nil),               // 18: volume.v1alpha1.VersionRequest
-	(*VersionResponse)(nil),              // 19: volume.v1alpha1.VersionResponse
-	(*ListVolumesRequest)(nil),           // 20: volume.v1alpha1.ListVolumesRequest
-	(*ListVolumesResponse)(nil),          // 21: volume.v1alpha1.ListVolumesResponse
-	(*CreateVolumeRequest)(nil),          // 22: volume.v1alpha1.CreateVolumeRequest
-	(*ExpandVolumeRequest)(nil),          // 23: volume.v1alpha1.ExpandVolumeRequest
-	(*CreateVolumeResponse)(nil),         // 24: volume.v1alpha1.CreateVolumeResponse
-	(*ExpandVolumeResponse)(nil),         // 25: volume.v1alpha1.ExpandVolumeResponse
-	(*DeleteVolumeRequest)(nil),          // 26: volume.v1alpha1.DeleteVolumeRequest
-	(*DeleteVolumeResponse)(nil),         // 27: volume.v1alpha1.DeleteVolumeResponse
-	(*StatusRequest)(nil),                // 28: volume.v1alpha1.StatusRequest
-	(*StatusResponse)(nil),               // 29: volume.v1alpha1.StatusResponse
-	(*VolumeSnapshotSpec)(nil),           // 30: volume.v1alpha1.VolumeSnapshotSpec
-	(*VolumeSnapshotStatus)(nil),         // 31: volume.v1alpha1.VolumeSnapshotStatus
-	(*VolumeSnapshot)(nil),               // 32: volume.v1alpha1.VolumeSnapshot
-	(*VolumeSnapshotFilter)(nil),         // 33: volume.v1alpha1.VolumeSnapshotFilter
-	(*ListVolumeSnapshotsRequest)(nil),   // 34: volume.v1alpha1.ListVolumeSnapshotsRequest
-	(*ListVolumeSnapshotsResponse)(nil),  // 35: volume.v1alpha1.ListVolumeSnapshotsResponse
-	(*CreateVolumeSnapshotRequest)(nil),  // 36: volume.v1alpha1.CreateVolumeSnapshotRequest
-	(*CreateVolumeSnapshotResponse)(nil), // 37: volume.v1alpha1.CreateVolumeSnapshotResponse
-	(*DeleteVolumeSnapshotRequest)(nil),  // 38: volume.v1alpha1.DeleteVolumeSnapshotRequest
-	(*DeleteVolumeSnapshotResponse)(nil), // 39: volume.v1alpha1.DeleteVolumeSnapshotResponse
-	nil,                                  // 40: volume.v1alpha1.VolumeFilter.LabelSelectorEntry
-	nil,                                  // 41: volume.v1alpha1.EventFilter.LabelSelectorEntry
-	nil,                                  // 42: volume.v1alpha1.EncryptionSpec.SecretDataEntry
-	nil,                                  // 43: volume.v1alpha1.VolumeAccess.AttributesEntry
-	nil,                                  // 44: volume.v1alpha1.VolumeAccess.SecretDataEntry
-	nil,                                  // 45: volume.v1alpha1.VolumeSnapshotFilter.LabelSelectorEntry
-	(*v1alpha1.ObjectMetadata)(nil),      // 46: meta.v1alpha1.ObjectMetadata
-	(*v1alpha11.Event)(nil),              // 47: event.v1alpha1.Event
+	(VolumeState)(0),                        // 0: volume.v1alpha1.VolumeState
+	(RuntimeCapability)(0),                  // 1: volume.v1alpha1.RuntimeCapability
+	(VolumeSnapshotExportState)(0),          // 2: volume.v1alpha1.VolumeSnapshotExportState
+	(VolumeSnapshotState)(0),                // 3: volume.v1alpha1.VolumeSnapshotState
+	(*VolumeFilter)(nil),                    // 4: volume.v1alpha1.VolumeFilter
+	(*EventFilter)(nil),                     // 5: volume.v1alpha1.EventFilter
+	(*VolumeResources)(nil),                 // 6: volume.v1alpha1.VolumeResources
+	(*EncryptionSpec)(nil),                  // 7: volume.v1alpha1.EncryptionSpec
+	(*ImageDataSource)(nil),                 // 8: volume.v1alpha1.ImageDataSource
+	(*SnapshotDataSource)(nil),              // 9: volume.v1alpha1.SnapshotDataSource
+	(*CloneDataSource)(nil),                 // 10: volume.v1alpha1.CloneDataSource
+	(*ObjectStorageLocation)(nil),           // 11: volume.v1alpha1.ObjectStorageLocation
+	(*BackupDataSource)(nil),                // 12: volume.v1alpha1.BackupDataSource
+	(*VolumeDataSource)(nil),                // 13: volume.v1alpha1.VolumeDataSource
+	(*VolumeSpec)(nil),                      // 14: volume.v1alpha1.VolumeSpec
+	(*VolumeStatus)(nil),                    // 15: volume.v1alpha1.VolumeStatus
+	(*Volume)(nil),                          // 16: volume.v1alpha1.Volume
+	(*VolumeClassCapabilities)(nil),         // 17: volume.v1alpha1.VolumeClassCapabilities
+	(*VolumeClass)(nil),                     // 18: volume.v1alpha1.VolumeClass
+	(*VolumeClassStatus)(nil),               // 19: volume.v1alpha1.VolumeClassStatus
+	(*VolumeAccess)(nil),                    // 20: volume.v1alpha1.VolumeAccess
+	(*ListEventsRequest)(nil),               // 21: volume.v1alpha1.ListEventsRequest
+	(*ListEventsResponse)(nil),              // 22: volume.v1alpha1.ListEventsResponse
+	(*WatchEventsRequest)(nil),              // 23: volume.v1alpha1.WatchEventsRequest
+	(*WatchEventsResponse)(nil),             // 24: volume.v1alpha1.WatchEventsResponse
+	(*VersionRequest)(nil),                  // 25: volume.v1alpha1.VersionRequest
+	(*VersionResponse)(nil),                 // 26: volume.v1alpha1.VersionResponse
+	(*ListVolumesRequest)(nil),              // 27: volume.v1alpha1.ListVolumesRequest
+	(*ListVolumesResponse)(nil),             // 28: volume.v1alpha1.ListVolumesResponse
+	(*WatchVolumesRequest)(nil),             // 29: volume.v1alpha1.WatchVolumesRequest
+	(*WatchVolumesResponse)(nil),            // 30: volume.v1alpha1.WatchVolumesResponse
+	(*CreateVolumeRequest)(nil),             // 31: volume.v1alpha1.CreateVolumeRequest
+	(*ExpandVolumeRequest)(nil),             // 32: volume.v1alpha1.ExpandVolumeRequest
+	(*CreateVolumeResponse)(nil),            // 33: volume.v1alpha1.CreateVolumeResponse
+	(*ExpandVolumeResponse)(nil),            // 34: volume.v1alpha1.ExpandVolumeResponse
+	(*RevertVolumeRequest)(nil),             // 35: volume.v1alpha1.RevertVolumeRequest
+	(*RevertVolumeResponse)(nil),            // 36: volume.v1alpha1.RevertVolumeResponse
+	(*ExportVolumeSnapshotRequest)(nil),     // 37: volume.v1alpha1.ExportVolumeSnapshotRequest
+	(*ExportVolumeSnapshotResponse)(nil),    // 38: volume.v1alpha1.ExportVolumeSnapshotResponse
+	(*VolumeSnapshotExport)(nil),            // 39: volume.v1alpha1.VolumeSnapshotExport
+	(*GetVolumeSnapshotExportRequest)(nil),  // 40: volume.v1alpha1.GetVolumeSnapshotExportRequest
+	(*GetVolumeSnapshotExportResponse)(nil), // 41: volume.v1alpha1.GetVolumeSnapshotExportResponse
+	(*DeleteVolumeRequest)(nil),             // 42: volume.v1alpha1.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),            // 43: volume.v1alpha1.DeleteVolumeResponse
+	(*StatusRequest)(nil),                   // 44: volume.v1alpha1.StatusRequest
+	(*StatusResponse)(nil),                  // 45: volume.v1alpha1.StatusResponse
+	(*VolumeSnapshotSpec)(nil),              // 46: volume.v1alpha1.VolumeSnapshotSpec
+	(*VolumeSnapshotStatus)(nil),            // 47: volume.v1alpha1.VolumeSnapshotStatus
+	(*VolumeSnapshot)(nil),                  // 48: volume.v1alpha1.VolumeSnapshot
+	(*VolumeSnapshotFilter)(nil),            // 49: volume.v1alpha1.VolumeSnapshotFilter
+	(*ListVolumeSnapshotsRequest)(nil),      // 50: volume.v1alpha1.ListVolumeSnapshotsRequest
+	(*ListVolumeSnapshotsResponse)(nil),     // 51: volume.v1alpha1.ListVolumeSnapshotsResponse
+	(*CreateVolumeSnapshotRequest)(nil),     // 52: volume.v1alpha1.CreateVolumeSnapshotRequest
+	(*CreateVolumeSnapshotResponse)(nil),    // 53: volume.v1alpha1.CreateVolumeSnapshotResponse
+	(*DeleteVolumeSnapshotRequest)(nil),     // 54: volume.v1alpha1.DeleteVolumeSnapshotRequest
+	(*DeleteVolumeSnapshotResponse)(nil),    // 55: volume.v1alpha1.DeleteVolumeSnapshotResponse
+	nil,                                     // 56: volume.v1alpha1.VolumeFilter.LabelSelectorEntry
+	nil,                                     // 57: volume.v1alpha1.EventFilter.LabelSelectorEntry
+	nil,                                     // 58: volume.v1alpha1.EncryptionSpec.SecretDataEntry
+	nil,                                     // 59: volume.v1alpha1.ObjectStorageLocation.SecretDataEntry
+	nil,                                     // 60: volume.v1alpha1.VolumeAccess.AttributesEntry
+	nil,                                     // 61: volume.v1alpha1.VolumeAccess.SecretDataEntry
+	nil,                                     // 62: volume.v1alpha1.VolumeSnapshotFilter.LabelSelectorEntry
+	(*v1alpha1.ObjectMetadata)(nil),         // 63: meta.v1alpha1.ObjectMetadata
+	(*v1alpha11.Event)(nil),                 // 64: event.v1alpha1.Event
+	(v1alpha1.WatchEventType)(0),            // 65: meta.v1alpha1.WatchEventType
 }
 var file_volume_v1alpha1_api_proto_depIdxs = []int32{
-	40, // 0: volume.v1alpha1.VolumeFilter.label_selector:type_name -> volume.v1alpha1.VolumeFilter.LabelSelectorEntry
-	41, // 1: volume.v1alpha1.EventFilter.label_selector:type_name -> volume.v1alpha1.EventFilter.LabelSelectorEntry
-	42, // 2: volume.v1alpha1.EncryptionSpec.secret_data:type_name -> volume.v1alpha1.EncryptionSpec.SecretDataEntry
-	6,  // 3: volume.v1alpha1.VolumeDataSource.image_data_source:type_name -> volume.v1alpha1.ImageDataSource
-	7,  // 4: volume.v1alpha1.VolumeDataSource.snapshot_data_source:type_name -> volume.v1alpha1.SnapshotDataSource
-	4,  // 5: volume.v1alpha1.VolumeSpec.resources:type_name -> volume.v1alpha1.VolumeResources
-	5,  // 6: volume.v1alpha1.VolumeSpec.encryption:type_name -> volume.v1alpha1.EncryptionSpec
-	8,  // 7: volume.v1alpha1.VolumeSpec.volume_data_source:type_name -> volume.v1alpha1.VolumeDataSource
-	0,  // 8: volume.v1alpha1.VolumeStatus.state:type_name -> volume.v1alpha1.VolumeState
-	15, // 9: volume.v1alpha1.VolumeStatus.access:type_name -> volume.v1alpha1.VolumeAccess
-	4,  // 10: volume.v1alpha1.VolumeStatus.resources:type_name -> volume.v1alpha1.VolumeResources
-	46, // 11: volume.v1alpha1.Volume.metadata:type_name -> meta.v1alpha1.ObjectMetadata
-	9,  // 12: volume.v1alpha1.Volume.spec:type_name -> volume.v1alpha1.VolumeSpec
-	10, // 13: volume.v1alpha1.Volume.status:type_name -> volume.v1alpha1.VolumeStatus
-	12, // 14: volume.v1alpha1.VolumeClass.capabilities:type_name -> volume.v1alpha1.VolumeClassCapabilities
-	13, // 15: volume.v1alpha1.VolumeClassStatus.volume_class:type_name -> volume.v1alpha1.VolumeClass
-	43, // 16: volume.v1alpha1.VolumeAccess.attributes:type_name -> volume.v1alpha1.VolumeAccess.AttributesEntry
-	44, // 17: volume.v1alpha1.VolumeAccess.secret_data:type_name -> volume.v1alpha1.VolumeAccess.SecretDataEntry
-	3,  // 18: volume.v1alpha1.ListEventsRequest.filter:type_name -> volume.v1alpha1.EventFilter
-	47, // 19: volume.v1alpha1.ListEventsResponse.events:type_name -> event.v1alpha1.Event
-	2,  // 20: volume.v1alpha1.ListVolumesRequest.filter:type_name -> volume.v1alpha1.VolumeFilter
-	11, // 21: volume.v1alpha1.ListVolumesResponse.volumes:type_name -> volume.v1alpha1.Volume
-	11, // 22: volume.v1alpha1.CreateVolumeRequest.volume:type_name -> volume.v1alpha1.Volume
-	4,  // 23: volume.v1alpha1.ExpandVolumeRequest.resources:type_name -> volume.v1alpha1.VolumeResources
-	11, // 24: volume.v1alpha1.CreateVolumeResponse.volume:type_name -> volume.v1alpha1.Volume
-	14, // 25: volume.v1alpha1.StatusResponse.volume_class_status:type_name -> volume.v1alpha1.VolumeClassStatus
-	1,  // 26: volume.v1alpha1.VolumeSnapshotStatus.state:type_name -> volume.v1alpha1.VolumeSnapshotState
-	46, // 27: volume.v1alpha1.VolumeSnapshot.metadata:type_name -> meta.v1alpha1.ObjectMetadata
-	30, // 28: volume.v1alpha1.VolumeSnapshot.spec:type_name -> volume.v1alpha1.VolumeSnapshotSpec
-	31, // 29: volume.v1alpha1.VolumeSnapshot.status:type_name -> volume.v1alpha1.VolumeSnapshotStatus
-	45, // 30: volume.v1alpha1.VolumeSnapshotFilter.label_selector:type_name -> volume.v1alpha1.VolumeSnapshotFilter.LabelSelectorEntry
-	33, // 31: volume.v1alpha1.ListVolumeSnapshotsRequest.filter:type_name -> volume.v1alpha1.VolumeSnapshotFilter
-	32, // 32: volume.v1alpha1.ListVolumeSnapshotsResponse.volume_snapshots:type_name -> volume.v1alpha1.VolumeSnapshot
-	32, // 33: volume.v1alpha1.CreateVolumeSnapshotRequest.volume_snapshot:type_name -> volume.v1alpha1.VolumeSnapshot
-	32, // 34: volume.v1alpha1.CreateVolumeSnapshotResponse.volume_snapshot:type_name -> volume.v1alpha1.VolumeSnapshot
-	18, // 35: volume.v1alpha1.VolumeRuntime.Version:input_type -> volume.v1alpha1.VersionRequest
-	16, // 36: volume.v1alpha1.VolumeRuntime.ListEvents:input_type -> volume.v1alpha1.ListEventsRequest
-	20, // 37: volume.v1alpha1.VolumeRuntime.ListVolumes:input_type -> volume.v1alpha1.ListVolumesRequest
-	22, // 38: volume.v1alpha1.VolumeRuntime.CreateVolume:input_type -> volume.v1alpha1.CreateVolumeRequest
-	23, // 39: volume.v1alpha1.VolumeRuntime.ExpandVolume:input_type -> volume.v1alpha1.ExpandVolumeRequest
-	26, // 40: volume.v1alpha1.VolumeRuntime.DeleteVolume:input_type -> volume.v1alpha1.DeleteVolumeRequest
-	36, // 41: volume.v1alpha1.VolumeRuntime.CreateVolumeSnapshot:input_type -> volume.v1alpha1.CreateVolumeSnapshotRequest
-	38, // 42: volume.v1alpha1.VolumeRuntime.DeleteVolumeSnapshot:input_type -> volume.v1alpha1.DeleteVolumeSnapshotRequest
-	34, // 43: volume.v1alpha1.VolumeRuntime.ListVolumeSnapshots:input_type -> volume.v1alpha1.ListVolumeSnapshotsRequest
-	28, // 44: volume.v1alpha1.VolumeRuntime.Status:input_type -> volume.v1alpha1.StatusRequest
-	19, // 45: volume.v1alpha1.VolumeRuntime.Version:output_type -> volume.v1alpha1.VersionResponse
-	17, // 46: volume.v1alpha1.VolumeRuntime.ListEvents:output_type -> volume.v1alpha1.ListEventsResponse
-	21, // 47: volume.v1alpha1.VolumeRuntime.ListVolumes:output_type -> volume.v1alpha1.ListVolumesResponse
-	24, // 48: volume.v1alpha1.VolumeRuntime.CreateVolume:output_type -> volume.v1alpha1.CreateVolumeResponse
-	25, // 49: volume.v1alpha1.VolumeRuntime.ExpandVolume:output_type -> volume.v1alpha1.ExpandVolumeResponse
-	27, // 50: volume.v1alpha1.VolumeRuntime.DeleteVolume:output_type -> volume.v1alpha1.DeleteVolumeResponse
-	37, // 51: volume.v1alpha1.VolumeRuntime.CreateVolumeSnapshot:output_type -> volume.v1alpha1.CreateVolumeSnapshotResponse
-	39, // 52: volume.v1alpha1.VolumeRuntime.DeleteVolumeSnapshot:output_type -> volume.v1alpha1.DeleteVolumeSnapshotResponse
-	35, // 53: volume.v1alpha1.VolumeRuntime.ListVolumeSnapshots:output_type -> volume.v1alpha1.ListVolumeSnapshotsResponse
-	29, // 54: volume.v1alpha1.VolumeRuntime.Status:output_type -> volume.v1alpha1.StatusResponse
-	45, // [45:55] is the sub-list for method output_type
-	35, // [35:45] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	56, // 0: volume.v1alpha1.VolumeFilter.label_selector:type_name -> volume.v1alpha1.VolumeFilter.LabelSelectorEntry
+	0,  // 1: volume.v1alpha1.VolumeFilter.states:type_name -> volume.v1alpha1.VolumeState
+	57, // 2: volume.v1alpha1.EventFilter.label_selector:type_name -> volume.v1alpha1.EventFilter.LabelSelectorEntry
+	58, // 3: volume.v1alpha1.EncryptionSpec.secret_data:type_name -> volume.v1alpha1.EncryptionSpec.SecretDataEntry
+	59, // 4: volume.v1alpha1.ObjectStorageLocation.secret_data:type_name -> volume.v1alpha1.ObjectStorageLocation.SecretDataEntry
+	11, // 5: volume.v1alpha1.BackupDataSource.location:type_name -> volume.v1alpha1.ObjectStorageLocation
+	8,  // 6: volume.v1alpha1.VolumeDataSource.image_data_source:type_name -> volume.v1alpha1.ImageDataSource
+	9,  // 7: volume.v1alpha1.VolumeDataSource.snapshot_data_source:type_name -> volume.v1alpha1.SnapshotDataSource
+	10, // 8: volume.v1alpha1.VolumeDataSource.clone_data_source:type_name -> volume.v1alpha1.CloneDataSource
+	12, // 9: volume.v1alpha1.VolumeDataSource.backup_data_source:type_name -> volume.v1alpha1.BackupDataSource
+	6,  // 10: volume.v1alpha1.VolumeSpec.resources:type_name -> volume.v1alpha1.VolumeResources
+	7,  // 11: volume.v1alpha1.VolumeSpec.encryption:type_name -> volume.v1alpha1.EncryptionSpec
+	13, // 12: volume.v1alpha1.VolumeSpec.volume_data_source:type_name -> volume.v1alpha1.VolumeDataSource
+	0,  // 13: volume.v1alpha1.VolumeStatus.state:type_name -> volume.v1alpha1.VolumeState
+	20, // 14: volume.v1alpha1.VolumeStatus.access:type_name -> volume.v1alpha1.VolumeAccess
+	6,  // 15: volume.v1alpha1.VolumeStatus.resources:type_name -> volume.v1alpha1.VolumeResources
+	63, // 16: volume.v1alpha1.Volume.metadata:type_name -> meta.v1alpha1.ObjectMetadata
+	14, // 17: volume.v1alpha1.Volume.spec:type_name -> volume.v1alpha1.VolumeSpec
+	15, // 18: volume.v1alpha1.Volume.status:type_name -> volume.v1alpha1.VolumeStatus
+	17, // 19: volume.v1alpha1.VolumeClass.capabilities:type_name -> volume.v1alpha1.VolumeClassCapabilities
+	18, // 20: volume.v1alpha1.VolumeClassStatus.volume_class:type_name -> volume.v1alpha1.VolumeClass
+	60, // 21: volume.v1alpha1.VolumeAccess.attributes:type_name -> volume.v1alpha1.VolumeAccess.AttributesEntry
+	61, // 22: volume.v1alpha1.VolumeAccess.secret_data:type_name -> volume.v1alpha1.VolumeAccess.SecretDataEntry
+	5,  // 23: volume.v1alpha1.ListEventsRequest.filter:type_name -> volume.v1alpha1.EventFilter
+	64, // 24: volume.v1alpha1.ListEventsResponse.events:type_name -> event.v1alpha1.Event
+	5,  // 25: volume.v1alpha1.WatchEventsRequest.filter:type_name -> volume.v1alpha1.EventFilter
+	64, // 26: volume.v1alpha1.WatchEventsResponse.event:type_name -> event.v1alpha1.Event
+	1,  // 27: volume.v1alpha1.VersionResponse.capabilities:type_name -> volume.v1alpha1.RuntimeCapability
+	4,  // 28: volume.v1alpha1.ListVolumesRequest.filter:type_name -> volume.v1alpha1.VolumeFilter
+	16, // 29: volume.v1alpha1.ListVolumesResponse.volumes:type_name -> volume.v1alpha1.Volume
+	4,  // 30: volume.v1alpha1.WatchVolumesRequest.filter:type_name -> volume.v1alpha1.VolumeFilter
+	65, // 31: volume.v1alpha1.WatchVolumesResponse.type:type_name -> meta.v1alpha1.WatchEventType
+	16, // 32: volume.v1alpha1.WatchVolumesResponse.volume:type_name -> volume.v1alpha1.Volume
+	16, // 33: volume.v1alpha1.CreateVolumeRequest.volume:type_name -> volume.v1alpha1.Volume
+	6,  // 34: volume.v1alpha1.ExpandVolumeRequest.resources:type_name -> volume.v1alpha1.VolumeResources
+	16, // 35: volume.v1alpha1.CreateVolumeResponse.volume:type_name -> volume.v1alpha1.Volume
+	11, // 36: volume.v1alpha1.ExportVolumeSnapshotRequest.location:type_name -> volume.v1alpha1.ObjectStorageLocation
+	2,  // 37: volume.v1alpha1.VolumeSnapshotExport.state:type_name -> volume.v1alpha1.VolumeSnapshotExportState
+	39, // 38: volume.v1alpha1.GetVolumeSnapshotExportResponse.export:type_name -> volume.v1alpha1.VolumeSnapshotExport
+	19, // 39: volume.v1alpha1.StatusResponse.volume_class_status:type_name -> volume.v1alpha1.VolumeClassStatus
+	3,  // 40: volume.v1alpha1.VolumeSnapshotStatus.state:type_name -> volume.v1alpha1.VolumeSnapshotState
+	63, // 41: volume.v1alpha1.VolumeSnapshot.metadata:type_name -> meta.v1alpha1.ObjectMetadata
+	46, // 42: volume.v1alpha1.VolumeSnapshot.spec:type_name -> volume.v1alpha1.VolumeSnapshotSpec
+	47, // 43: volume.v1alpha1.VolumeSnapshot.status:type_name -> volume.v1alpha1.VolumeSnapshotStatus
+	62, // 44: volume.v1alpha1.VolumeSnapshotFilter.label_selector:type_name -> volume.v1alpha1.VolumeSnapshotFilter.LabelSelectorEntry
+	49, // 45: volume.v1alpha1.ListVolumeSnapshotsRequest.filter:type_name -> volume.v1alpha1.VolumeSnapshotFilter
+	48, // 46: volume.v1alpha1.ListVolumeSnapshotsResponse.volume_snapshots:type_name -> volume.v1alpha1.VolumeSnapshot
+	48, // 47: volume.v1alpha1.CreateVolumeSnapshotRequest.volume_snapshot:type_name -> volume.v1alpha1.VolumeSnapshot
+	48, // 48: volume.v1alpha1.CreateVolumeSnapshotResponse.volume_snapshot:type_name -> volume.v1alpha1.VolumeSnapshot
+	25, // 49: volume.v1alpha1.VolumeRuntime.Version:input_type -> volume.v1alpha1.VersionRequest
+	21, // 50: volume.v1alpha1.VolumeRuntime.ListEvents:input_type -> volume.v1alpha1.ListEventsRequest
+	23, // 51: volume.v1alpha1.VolumeRuntime.WatchEvents:input_type -> volume.v1alpha1.WatchEventsRequest
+	27, // 52: volume.v1alpha1.VolumeRuntime.ListVolumes:input_type -> volume.v1alpha1.ListVolumesRequest
+	29, // 53: volume.v1alpha1.VolumeRuntime.WatchVolumes:input_type -> volume.v1alpha1.WatchVolumesRequest
+	31, // 54: volume.v1alpha1.VolumeRuntime.CreateVolume:input_type -> volume.v1alpha1.CreateVolumeRequest
+	32, // 55: volume.v1alpha1.VolumeRuntime.ExpandVolume:input_type -> volume.v1alpha1.ExpandVolumeRequest
+	35, // 56: volume.v1alpha1.VolumeRuntime.RevertVolume:input_type -> volume.v1alpha1.RevertVolumeRequest
+	37, // 57: volume.v1alpha1.VolumeRuntime.ExportVolumeSnapshot:input_type -> volume.v1alpha1.ExportVolumeSnapshotRequest
+	40, // 58: volume.v1alpha1.VolumeRuntime.GetVolumeSnapshotExport:input_type -> volume.v1alpha1.GetVolumeSnapshotExportRequest
+	42, // 59: volume.v1alpha1.VolumeRuntime.DeleteVolume:input_type -> volume.v1alpha1.DeleteVolumeRequest
+	52, // 60: volume.v1alpha1.VolumeRuntime.CreateVolumeSnapshot:input_type -> volume.v1alpha1.CreateVolumeSnapshotRequest
+	54, // 61: volume.v1alpha1.VolumeRuntime.DeleteVolumeSnapshot:input_type -> volume.v1alpha1.DeleteVolumeSnapshotRequest
+	50, // 62: volume.v1alpha1.VolumeRuntime.ListVolumeSnapshots:input_type -> volume.v1alpha1.ListVolumeSnapshotsRequest
+	44, // 63: volume.v1alpha1.VolumeRuntime.Status:input_type -> volume.v1alpha1.StatusRequest
+	26, // 64: volume.v1alpha1.VolumeRuntime.Version:output_type -> volume.v1alpha1.VersionResponse
+	22, // 65: volume.v1alpha1.VolumeRuntime.ListEvents:output_type -> volume.v1alpha1.ListEventsResponse
+	24, // 66: volume.v1alpha1.VolumeRuntime.WatchEvents:output_type -> volume.v1alpha1.WatchEventsResponse
+	28, // 67: volume.v1alpha1.VolumeRuntime.ListVolumes:output_type -> volume.v1alpha1.ListVolumesResponse
+	30, // 68: volume.v1alpha1.VolumeRuntime.WatchVolumes:output_type -> volume.v1alpha1.WatchVolumesResponse
+	33, // 69: volume.v1alpha1.VolumeRuntime.CreateVolume:output_type -> volume.v1alpha1.CreateVolumeResponse
+	34, // 70: volume.v1alpha1.VolumeRuntime.ExpandVolume:output_type -> volume.v1alpha1.ExpandVolumeResponse
+	36, // 71: volume.v1alpha1.VolumeRuntime.RevertVolume:output_type -> volume.v1alpha1.RevertVolumeResponse
+	38, // 72: volume.v1alpha1.VolumeRuntime.ExportVolumeSnapshot:output_type -> volume.v1alpha1.ExportVolumeSnapshotResponse
+	41, // 73: volume.v1alpha1.VolumeRuntime.GetVolumeSnapshotExport:output_type -> volume.v1alpha1.GetVolumeSnapshotExportResponse
+	43, // 74: volume.v1alpha1.VolumeRuntime.DeleteVolume:output_type -> volume.v1alpha1.DeleteVolumeResponse
+	53, // 75: volume.v1alpha1.VolumeRuntime.CreateVolumeSnapshot:output_type -> volume.v1alpha1.CreateVolumeSnapshotResponse
+	55, // 76: volume.v1alpha1.VolumeRuntime.DeleteVolumeSnapshot:output_type -> volume.v1alpha1.DeleteVolumeSnapshotResponse
+	51, // 77: volume.v1alpha1.VolumeRuntime.ListVolumeSnapshots:output_type -> volume.v1alpha1.ListVolumeSnapshotsResponse
+	45, // 78: volume.v1alpha1.VolumeRuntime.Status:output_type -> volume.v1alpha1.StatusResponse
+	64, // [64:79] is the sub-list for method output_type
+	49, // [49:64] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_volume_v1alpha1_api_proto_init() }
@@ -2251,8 +3323,8 @@ func file_volume_v1alpha1_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_volume_v1alpha1_api_proto_rawDesc), len(file_volume_v1alpha1_api_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   44,
+			NumEnums:      4,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
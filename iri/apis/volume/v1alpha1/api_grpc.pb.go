@@ -20,16 +20,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VolumeRuntime_Version_FullMethodName              = "/volume.v1alpha1.VolumeRuntime/Version"
-	VolumeRuntime_ListEvents_FullMethodName           = "/volume.v1alpha1.VolumeRuntime/ListEvents"
-	VolumeRuntime_ListVolumes_FullMethodName          = "/volume.v1alpha1.VolumeRuntime/ListVolumes"
-	VolumeRuntime_CreateVolume_FullMethodName         = "/volume.v1alpha1.VolumeRuntime/CreateVolume"
-	VolumeRuntime_ExpandVolume_FullMethodName         = "/volume.v1alpha1.VolumeRuntime/ExpandVolume"
-	VolumeRuntime_DeleteVolume_FullMethodName         = "/volume.v1alpha1.VolumeRuntime/DeleteVolume"
-	VolumeRuntime_CreateVolumeSnapshot_FullMethodName = "/volume.v1alpha1.VolumeRuntime/CreateVolumeSnapshot"
-	VolumeRuntime_DeleteVolumeSnapshot_FullMethodName = "/volume.v1alpha1.VolumeRuntime/DeleteVolumeSnapshot"
-	VolumeRuntime_ListVolumeSnapshots_FullMethodName  = "/volume.v1alpha1.VolumeRuntime/ListVolumeSnapshots"
-	VolumeRuntime_Status_FullMethodName               = "/volume.v1alpha1.VolumeRuntime/Status"
+	VolumeRuntime_Version_FullMethodName                 = "/volume.v1alpha1.VolumeRuntime/Version"
+	VolumeRuntime_ListEvents_FullMethodName              = "/volume.v1alpha1.VolumeRuntime/ListEvents"
+	VolumeRuntime_WatchEvents_FullMethodName             = "/volume.v1alpha1.VolumeRuntime/WatchEvents"
+	VolumeRuntime_ListVolumes_FullMethodName             = "/volume.v1alpha1.VolumeRuntime/ListVolumes"
+	VolumeRuntime_WatchVolumes_FullMethodName            = "/volume.v1alpha1.VolumeRuntime/WatchVolumes"
+	VolumeRuntime_CreateVolume_FullMethodName            = "/volume.v1alpha1.VolumeRuntime/CreateVolume"
+	VolumeRuntime_ExpandVolume_FullMethodName            = "/volume.v1alpha1.VolumeRuntime/ExpandVolume"
+	VolumeRuntime_RevertVolume_FullMethodName            = "/volume.v1alpha1.VolumeRuntime/RevertVolume"
+	VolumeRuntime_ExportVolumeSnapshot_FullMethodName    = "/volume.v1alpha1.VolumeRuntime/ExportVolumeSnapshot"
+	VolumeRuntime_GetVolumeSnapshotExport_FullMethodName = "/volume.v1alpha1.VolumeRuntime/GetVolumeSnapshotExport"
+	VolumeRuntime_DeleteVolume_FullMethodName            = "/volume.v1alpha1.VolumeRuntime/DeleteVolume"
+	VolumeRuntime_CreateVolumeSnapshot_FullMethodName    = "/volume.v1alpha1.VolumeRuntime/CreateVolumeSnapshot"
+	VolumeRuntime_DeleteVolumeSnapshot_FullMethodName    = "/volume.v1alpha1.VolumeRuntime/DeleteVolumeSnapshot"
+	VolumeRuntime_ListVolumeSnapshots_FullMethodName     = "/volume.v1alpha1.VolumeRuntime/ListVolumeSnapshots"
+	VolumeRuntime_Status_FullMethodName                  = "/volume.v1alpha1.VolumeRuntime/Status"
 )
 
 // VolumeRuntimeClient is the client API for VolumeRuntime service.
@@ -38,9 +43,14 @@ const (
 type VolumeRuntimeClient interface {
 	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error)
 	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	WatchVolumes(ctx context.Context, in *WatchVolumesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchVolumesResponse], error)
 	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
 	ExpandVolume(ctx context.Context, in *ExpandVolumeRequest, opts ...grpc.CallOption) (*ExpandVolumeResponse, error)
+	RevertVolume(ctx context.Context, in *RevertVolumeRequest, opts ...grpc.CallOption) (*RevertVolumeResponse, error)
+	ExportVolumeSnapshot(ctx context.Context, in *ExportVolumeSnapshotRequest, opts ...grpc.CallOption) (*ExportVolumeSnapshotResponse, error)
+	GetVolumeSnapshotExport(ctx context.Context, in *GetVolumeSnapshotExportRequest, opts ...grpc.CallOption) (*GetVolumeSnapshotExportResponse, error)
 	DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error)
 	CreateVolumeSnapshot(ctx context.Context, in *CreateVolumeSnapshotRequest, opts ...grpc.CallOption) (*CreateVolumeSnapshotResponse, error)
 	DeleteVolumeSnapshot(ctx context.Context, in *DeleteVolumeSnapshotRequest, opts ...grpc.CallOption) (*DeleteVolumeSnapshotResponse, error)
@@ -76,6 +86,25 @@ func (c *volumeRuntimeClient) ListEvents(ctx context.Context, in *ListEventsRequ
 	return out, nil
 }
 
+func (c *volumeRuntimeClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VolumeRuntime_ServiceDesc.Streams[0], VolumeRuntime_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, WatchEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VolumeRuntime_WatchEventsClient = grpc.ServerStreamingClient[WatchEventsResponse]
+
 func (c *volumeRuntimeClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListVolumesResponse)
@@ -86,6 +115,25 @@ func (c *volumeRuntimeClient) ListVolumes(ctx context.Context, in *ListVolumesRe
 	return out, nil
 }
 
+func (c *volumeRuntimeClient) WatchVolumes(ctx context.Context, in *WatchVolumesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchVolumesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VolumeRuntime_ServiceDesc.Streams[1], VolumeRuntime_WatchVolumes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchVolumesRequest, WatchVolumesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VolumeRuntime_WatchVolumesClient = grpc.ServerStreamingClient[WatchVolumesResponse]
+
 func (c *volumeRuntimeClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateVolumeResponse)
@@ -106,6 +154,36 @@ func (c *volumeRuntimeClient) ExpandVolume(ctx context.Context, in *ExpandVolume
 	return out, nil
 }
 
+func (c *volumeRuntimeClient) RevertVolume(ctx context.Context, in *RevertVolumeRequest, opts ...grpc.CallOption) (*RevertVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertVolumeResponse)
+	err := c.cc.Invoke(ctx, VolumeRuntime_RevertVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeRuntimeClient) ExportVolumeSnapshot(ctx context.Context, in *ExportVolumeSnapshotRequest, opts ...grpc.CallOption) (*ExportVolumeSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportVolumeSnapshotResponse)
+	err := c.cc.Invoke(ctx, VolumeRuntime_ExportVolumeSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeRuntimeClient) GetVolumeSnapshotExport(ctx context.Context, in *GetVolumeSnapshotExportRequest, opts ...grpc.CallOption) (*GetVolumeSnapshotExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVolumeSnapshotExportResponse)
+	err := c.cc.Invoke(ctx, VolumeRuntime_GetVolumeSnapshotExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeRuntimeClient) DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteVolumeResponse)
@@ -162,9 +240,14 @@ func (c *volumeRuntimeClient) Status(ctx context.Context, in *StatusRequest, opt
 type VolumeRuntimeServer interface {
 	Version(context.Context, *VersionRequest) (*VersionResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error
 	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	WatchVolumes(*WatchVolumesRequest, grpc.ServerStreamingServer[WatchVolumesResponse]) error
 	CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
 	ExpandVolume(context.Context, *ExpandVolumeRequest) (*ExpandVolumeResponse, error)
+	RevertVolume(context.Context, *RevertVolumeRequest) (*RevertVolumeResponse, error)
+	ExportVolumeSnapshot(context.Context, *ExportVolumeSnapshotRequest) (*ExportVolumeSnapshotResponse, error)
+	GetVolumeSnapshotExport(context.Context, *GetVolumeSnapshotExportRequest) (*GetVolumeSnapshotExportResponse, error)
 	DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error)
 	CreateVolumeSnapshot(context.Context, *CreateVolumeSnapshotRequest) (*CreateVolumeSnapshotResponse, error)
 	DeleteVolumeSnapshot(context.Context, *DeleteVolumeSnapshotRequest) (*DeleteVolumeSnapshotResponse, error)
@@ -186,15 +269,30 @@ func (UnimplementedVolumeRuntimeServer) Version(context.Context, *VersionRequest
 func (UnimplementedVolumeRuntimeServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedVolumeRuntimeServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[WatchEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvents not implemented")
+}
 func (UnimplementedVolumeRuntimeServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListVolumes not implemented")
 }
+func (UnimplementedVolumeRuntimeServer) WatchVolumes(*WatchVolumesRequest, grpc.ServerStreamingServer[WatchVolumesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchVolumes not implemented")
+}
 func (UnimplementedVolumeRuntimeServer) CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateVolume not implemented")
 }
 func (UnimplementedVolumeRuntimeServer) ExpandVolume(context.Context, *ExpandVolumeRequest) (*ExpandVolumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ExpandVolume not implemented")
 }
+func (UnimplementedVolumeRuntimeServer) RevertVolume(context.Context, *RevertVolumeRequest) (*RevertVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevertVolume not implemented")
+}
+func (UnimplementedVolumeRuntimeServer) ExportVolumeSnapshot(context.Context, *ExportVolumeSnapshotRequest) (*ExportVolumeSnapshotResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportVolumeSnapshot not implemented")
+}
+func (UnimplementedVolumeRuntimeServer) GetVolumeSnapshotExport(context.Context, *GetVolumeSnapshotExportRequest) (*GetVolumeSnapshotExportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVolumeSnapshotExport not implemented")
+}
 func (UnimplementedVolumeRuntimeServer) DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteVolume not implemented")
 }
@@ -267,6 +365,17 @@ func _VolumeRuntime_ListEvents_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeRuntime_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolumeRuntimeServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, WatchEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VolumeRuntime_WatchEventsServer = grpc.ServerStreamingServer[WatchEventsResponse]
+
 func _VolumeRuntime_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListVolumesRequest)
 	if err := dec(in); err != nil {
@@ -285,6 +394,17 @@ func _VolumeRuntime_ListVolumes_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeRuntime_WatchVolumes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVolumesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolumeRuntimeServer).WatchVolumes(m, &grpc.GenericServerStream[WatchVolumesRequest, WatchVolumesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VolumeRuntime_WatchVolumesServer = grpc.ServerStreamingServer[WatchVolumesResponse]
+
 func _VolumeRuntime_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateVolumeRequest)
 	if err := dec(in); err != nil {
@@ -321,6 +441,60 @@ func _VolumeRuntime_ExpandVolume_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VolumeRuntime_RevertVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeRuntimeServer).RevertVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeRuntime_RevertVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeRuntimeServer).RevertVolume(ctx, req.(*RevertVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeRuntime_ExportVolumeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportVolumeSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeRuntimeServer).ExportVolumeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeRuntime_ExportVolumeSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeRuntimeServer).ExportVolumeSnapshot(ctx, req.(*ExportVolumeSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VolumeRuntime_GetVolumeSnapshotExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeSnapshotExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeRuntimeServer).GetVolumeSnapshotExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VolumeRuntime_GetVolumeSnapshotExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeRuntimeServer).GetVolumeSnapshotExport(ctx, req.(*GetVolumeSnapshotExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VolumeRuntime_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteVolumeRequest)
 	if err := dec(in); err != nil {
@@ -438,6 +612,18 @@ var VolumeRuntime_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExpandVolume",
 			Handler:    _VolumeRuntime_ExpandVolume_Handler,
 		},
+		{
+			MethodName: "RevertVolume",
+			Handler:    _VolumeRuntime_RevertVolume_Handler,
+		},
+		{
+			MethodName: "ExportVolumeSnapshot",
+			Handler:    _VolumeRuntime_ExportVolumeSnapshot_Handler,
+		},
+		{
+			MethodName: "GetVolumeSnapshotExport",
+			Handler:    _VolumeRuntime_GetVolumeSnapshotExport_Handler,
+		},
 		{
 			MethodName: "DeleteVolume",
 			Handler:    _VolumeRuntime_DeleteVolume_Handler,
@@ -459,6 +645,17 @@ var VolumeRuntime_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _VolumeRuntime_Status_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _VolumeRuntime_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchVolumes",
+			Handler:       _VolumeRuntime_WatchVolumes_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "volume/v1alpha1/api.proto",
 }
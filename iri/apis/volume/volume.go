@@ -12,13 +12,18 @@ import (
 type RuntimeService interface {
 	Version(context.Context, *api.VersionRequest) (*api.VersionResponse, error)
 	ListEvents(context.Context, *api.ListEventsRequest) (*api.ListEventsResponse, error)
+	WatchEvents(context.Context, *api.WatchEventsRequest) (api.VolumeRuntime_WatchEventsClient, error)
 	ListVolumes(context.Context, *api.ListVolumesRequest) (*api.ListVolumesResponse, error)
+	WatchVolumes(context.Context, *api.WatchVolumesRequest) (api.VolumeRuntime_WatchVolumesClient, error)
 	CreateVolume(context.Context, *api.CreateVolumeRequest) (*api.CreateVolumeResponse, error)
 	ExpandVolume(context.Context, *api.ExpandVolumeRequest) (*api.ExpandVolumeResponse, error)
+	RevertVolume(context.Context, *api.RevertVolumeRequest) (*api.RevertVolumeResponse, error)
 	DeleteVolume(context.Context, *api.DeleteVolumeRequest) (*api.DeleteVolumeResponse, error)
 	CreateVolumeSnapshot(context.Context, *api.CreateVolumeSnapshotRequest) (*api.CreateVolumeSnapshotResponse, error)
 	DeleteVolumeSnapshot(context.Context, *api.DeleteVolumeSnapshotRequest) (*api.DeleteVolumeSnapshotResponse, error)
 	ListVolumeSnapshots(context.Context, *api.ListVolumeSnapshotsRequest) (*api.ListVolumeSnapshotsResponse, error)
+	ExportVolumeSnapshot(context.Context, *api.ExportVolumeSnapshotRequest) (*api.ExportVolumeSnapshotResponse, error)
+	GetVolumeSnapshotExport(context.Context, *api.GetVolumeSnapshotExportRequest) (*api.GetVolumeSnapshotExportResponse, error)
 
 	Status(context.Context, *api.StatusRequest) (*api.StatusResponse, error)
 }
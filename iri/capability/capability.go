@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package capability converts IRI runtime capabilities to names usable in labels and conditions.
+package capability
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	enumPrefix = "RUNTIME_CAPABILITY_"
+	// unspecified is the name of the zero value of the runtime capability enums.
+	unspecified = "unspecified"
+)
+
+// Name returns the name of a runtime capability,
+// e.g. network-interface-hotplug for RUNTIME_CAPABILITY_NETWORK_INTERFACE_HOTPLUG.
+func Name[C fmt.Stringer](c C) string {
+	name := strings.TrimPrefix(c.String(), enumPrefix)
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
+
+// Names returns the sorted, unique names of the given runtime capabilities.
+// The unspecified capability is omitted.
+func Names[C fmt.Stringer](cs []C) []string {
+	var names []string
+	for _, c := range cs {
+		if name := Name(c); name != unspecified {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
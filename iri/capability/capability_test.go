@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package capability_test
+
+import (
+	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irivolume "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/iri/capability"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Capability", func() {
+	Describe("Name", func() {
+		It("should convert the enum name to a label-safe name", func() {
+			Expect(Name(irimachine.RuntimeCapability_RUNTIME_CAPABILITY_NETWORK_INTERFACE_HOTPLUG)).To(Equal("network-interface-hotplug"))
+			Expect(Name(irivolume.RuntimeCapability_RUNTIME_CAPABILITY_SNAPSHOTS)).To(Equal("snapshots"))
+		})
+	})
+
+	Describe("Names", func() {
+		It("should return sorted unique names without the unspecified capability", func() {
+			Expect(Names([]irivolume.RuntimeCapability{
+				irivolume.RuntimeCapability_RUNTIME_CAPABILITY_SNAPSHOTS,
+				irivolume.RuntimeCapability_RUNTIME_CAPABILITY_UNSPECIFIED,
+				irivolume.RuntimeCapability_RUNTIME_CAPABILITY_EXPAND,
+				irivolume.RuntimeCapability_RUNTIME_CAPABILITY_SNAPSHOTS,
+			})).To(Equal([]string{"expand", "snapshots"}))
+		})
+
+		It("should return nil for no capabilities", func() {
+			Expect(Names[irivolume.RuntimeCapability](nil)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package paging implements the limit / continue_token paging of IRI list requests.
+//
+// Continue tokens are opaque to clients. Objects with an id are paged by their id, so that
+// concurrent creations and deletions neither skip nor repeat objects. Items without an id,
+// such as events, are paged by their position.
+package paging
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strconv"
+	"strings"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultLimit is the page size clients use when listing all items page by page.
+const DefaultLimit int64 = 500
+
+const (
+	keyPrefix    = "key:"
+	offsetPrefix = "offset:"
+)
+
+func encodeToken(s string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
+
+func decodeToken(continueToken, prefix string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(continueToken)
+	if err != nil || !strings.HasPrefix(string(data), prefix) {
+		return "", status.Errorf(codes.InvalidArgument, "invalid continue token %q", continueToken)
+	}
+	return strings.TrimPrefix(string(data), prefix), nil
+}
+
+// ValidateLimit returns an InvalidArgument error if the limit is negative.
+func ValidateLimit(limit int64) error {
+	if limit < 0 {
+		return status.Errorf(codes.InvalidArgument, "limit must not be negative, got %d", limit)
+	}
+	return nil
+}
+
+// ObjectID returns the id of the object. It is the key objects are paged by.
+func ObjectID[O irimeta.Object](obj O) string {
+	return obj.GetMetadata().GetId()
+}
+
+// PageByKey sorts the items by their unique key and returns at most limit items following the
+// item the continue token points to, together with the continue token of the next page.
+// A limit of zero returns all remaining items.
+func PageByKey[T any](items []T, key func(T) string, limit int64, continueToken string) ([]T, string, error) {
+	if err := ValidateLimit(limit); err != nil {
+		return nil, "", err
+	}
+
+	items = slices.SortedFunc(slices.Values(items), func(a, b T) int {
+		return strings.Compare(key(a), key(b))
+	})
+
+	if continueToken != "" {
+		lastKey, err := decodeToken(continueToken, keyPrefix)
+		if err != nil {
+			return nil, "", err
+		}
+
+		start, _ := slices.BinarySearchFunc(items, lastKey, func(item T, lastKey string) int {
+			if key(item) <= lastKey {
+				return -1
+			}
+			return 1
+		})
+		items = items[start:]
+	}
+
+	if limit == 0 || int64(len(items)) <= limit {
+		return items, "", nil
+	}
+	items = items[:limit]
+	return items, encodeToken(keyPrefix + key(items[len(items)-1])), nil
+}
+
+// PageByOffset returns at most limit items starting at the position the continue token points to,
+// together with the continue token of the next page. A limit of zero returns all remaining items.
+func PageByOffset[T any](items []T, limit int64, continueToken string) ([]T, string, error) {
+	if err := ValidateLimit(limit); err != nil {
+		return nil, "", err
+	}
+
+	var offset int
+	if continueToken != "" {
+		data, err := decodeToken(continueToken, offsetPrefix)
+		if err != nil {
+			return nil, "", err
+		}
+
+		offset, err = strconv.Atoi(data)
+		if err != nil || offset < 0 {
+			return nil, "", status.Errorf(codes.InvalidArgument, "invalid continue token %q", continueToken)
+		}
+	}
+	if offset >= len(items) {
+		return nil, "", nil
+	}
+	items = items[offset:]
+
+	if limit == 0 || int64(len(items)) <= limit {
+		return items, "", nil
+	}
+	return items[:limit], encodeToken(offsetPrefix + strconv.Itoa(offset+int(limit))), nil
+}
+
+// ListFunc lists a single page of items.
+type ListFunc[T any] func(ctx context.Context, limit int64, continueToken string) ([]T, string, error)
+
+// ListAll lists all items by requesting pages of the given size until there is no continue token.
+// Servers that do not support paging return all items in the first page.
+func ListAll[T any](ctx context.Context, limit int64, list ListFunc[T]) ([]T, error) {
+	var (
+		res           []T
+		continueToken string
+	)
+	for {
+		items, nextContinueToken, err := list(ctx, limit, continueToken)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, items...)
+		if nextContinueToken == "" {
+			return res, nil
+		}
+		continueToken = nextContinueToken
+	}
+}
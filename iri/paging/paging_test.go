@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package paging_test
+
+import (
+	"context"
+
+	. "github.com/ironcore-dev/ironcore/iri/paging"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func identity(s string) string {
+	return s
+}
+
+var _ = Describe("Paging", func() {
+	Describe("PageByKey", func() {
+		It("should page the items sorted by their key", func() {
+			items := []string{"d", "b", "e", "a", "c"}
+
+			page, continueToken, err := PageByKey(items, identity, 2, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"a", "b"}))
+			Expect(continueToken).NotTo(BeEmpty())
+
+			page, continueToken, err = PageByKey(items, identity, 2, continueToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"c", "d"}))
+			Expect(continueToken).NotTo(BeEmpty())
+
+			page, continueToken, err = PageByKey(items, identity, 2, continueToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"e"}))
+			Expect(continueToken).To(BeEmpty())
+		})
+
+		It("should continue after the last key if the items changed in between", func() {
+			page, continueToken, err := PageByKey([]string{"a", "b", "c", "d"}, identity, 2, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"a", "b"}))
+
+			page, continueToken, err = PageByKey([]string{"a", "c", "d", "bb"}, identity, 2, continueToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"bb", "c"}))
+			Expect(continueToken).NotTo(BeEmpty())
+		})
+
+		It("should return all items without limit", func() {
+			page, continueToken, err := PageByKey([]string{"b", "a"}, identity, 0, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"a", "b"}))
+			Expect(continueToken).To(BeEmpty())
+		})
+
+		It("should reject invalid continue tokens and limits", func() {
+			_, _, err := PageByKey([]string{"a"}, identity, 1, "invalid!")
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			_, offsetToken, err := PageByOffset([]string{"a", "b"}, 1, "")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = PageByKey([]string{"a"}, identity, 1, offsetToken)
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			_, _, err = PageByKey([]string{"a"}, identity, -1, "")
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("PageByOffset", func() {
+		It("should page the items by their position", func() {
+			items := []string{"c", "a", "b"}
+
+			page, continueToken, err := PageByOffset(items, 2, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"c", "a"}))
+			Expect(continueToken).NotTo(BeEmpty())
+
+			page, continueToken, err = PageByOffset(items, 2, continueToken)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page).To(Equal([]string{"b"}))
+			Expect(continueToken).To(BeEmpty())
+		})
+	})
+
+	Describe("ListAll", func() {
+		It("should list all pages", func(ctx SpecContext) {
+			items := []string{"a", "b", "c", "d", "e"}
+
+			var calls int
+			res, err := ListAll(ctx, 2, func(ctx context.Context, limit int64, continueToken string) ([]string, string, error) {
+				calls++
+				return PageByKey(items, identity, limit, continueToken)
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(items))
+			Expect(calls).To(Equal(3))
+		})
+	})
+})
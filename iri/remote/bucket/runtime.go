@@ -9,6 +9,8 @@ import (
 
 	"github.com/ironcore-dev/ironcore/iri/apis/bucket"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -33,12 +35,61 @@ func NewRemoteRuntime(endpoint string) (bucket.RuntimeService, error) {
 func (r *remoteRuntime) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
 	return r.client.Version(ctx, req)
 }
+
+// ListEvents lists the events page by page unless the request specifies a limit or continue token.
 func (r *remoteRuntime) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*iri.ListEventsResponse, error) {
-	return r.client.ListEvents(ctx, req)
+	if req.Limit > 0 || req.ContinueToken != "" {
+		return r.client.ListEvents(ctx, req)
+	}
+
+	events, err := paging.ListAll(ctx, paging.DefaultLimit, func(ctx context.Context, limit int64, continueToken string) ([]*irievent.Event, string, error) {
+		res, err := r.client.ListEvents(ctx, &iri.ListEventsRequest{
+			Filter:        req.Filter,
+			Limit:         limit,
+			ContinueToken: continueToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Events, res.ContinueToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListEventsResponse{Events: events}, nil
+}
+
+func (r *remoteRuntime) WatchEvents(ctx context.Context, req *iri.WatchEventsRequest) (iri.BucketRuntime_WatchEventsClient, error) {
+	return r.client.WatchEvents(ctx, req)
 }
 
+// ListBuckets lists the buckets page by page unless the request specifies a limit or continue token.
 func (r *remoteRuntime) ListBuckets(ctx context.Context, request *iri.ListBucketsRequest) (*iri.ListBucketsResponse, error) {
-	return r.client.ListBuckets(ctx, request)
+	if request.Limit > 0 || request.ContinueToken != "" {
+		return r.client.ListBuckets(ctx, request)
+	}
+
+	buckets, err := paging.ListAll(ctx, paging.DefaultLimit, func(ctx context.Context, limit int64, continueToken string) ([]*iri.Bucket, string, error) {
+		res, err := r.client.ListBuckets(ctx, &iri.ListBucketsRequest{
+			Filter:        request.Filter,
+			Limit:         limit,
+			ContinueToken: continueToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Buckets, res.ContinueToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListBucketsResponse{Buckets: buckets}, nil
+}
+
+func (r *remoteRuntime) WatchBuckets(ctx context.Context, request *iri.WatchBucketsRequest) (iri.BucketRuntime_WatchBucketsClient, error) {
+	return r.client.WatchBuckets(ctx, request)
 }
 
 func (r *remoteRuntime) CreateBucket(ctx context.Context, request *iri.CreateBucketRequest) (*iri.CreateBucketResponse, error) {
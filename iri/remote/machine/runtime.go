@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 
+	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	"github.com/ironcore-dev/ironcore/iri/apis/machine"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -34,12 +36,60 @@ func (r *remoteRuntime) Version(ctx context.Context, req *iri.VersionRequest) (*
 	return r.client.Version(ctx, req)
 }
 
+// ListEvents lists the events page by page unless the request specifies a limit or continue token.
 func (r *remoteRuntime) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*iri.ListEventsResponse, error) {
-	return r.client.ListEvents(ctx, req)
+	if req.Limit > 0 || req.ContinueToken != "" {
+		return r.client.ListEvents(ctx, req)
+	}
+
+	events, err := paging.ListAll(ctx, paging.DefaultLimit, func(ctx context.Context, limit int64, continueToken string) ([]*irievent.Event, string, error) {
+		res, err := r.client.ListEvents(ctx, &iri.ListEventsRequest{
+			Filter:        req.Filter,
+			Limit:         limit,
+			ContinueToken: continueToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Events, res.ContinueToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListEventsResponse{Events: events}, nil
+}
+
+func (r *remoteRuntime) WatchEvents(ctx context.Context, req *iri.WatchEventsRequest) (iri.MachineRuntime_WatchEventsClient, error) {
+	return r.client.WatchEvents(ctx, req)
 }
 
+// ListMachines lists the machines page by page unless the request specifies a limit or continue token.
 func (r *remoteRuntime) ListMachines(ctx context.Context, req *iri.ListMachinesRequest) (*iri.ListMachinesResponse, error) {
-	return r.client.ListMachines(ctx, req)
+	if req.Limit > 0 || req.ContinueToken != "" {
+		return r.client.ListMachines(ctx, req)
+	}
+
+	machines, err := paging.ListAll(ctx, paging.DefaultLimit, func(ctx context.Context, limit int64, continueToken string) ([]*iri.Machine, string, error) {
+		res, err := r.client.ListMachines(ctx, &iri.ListMachinesRequest{
+			Filter:        req.Filter,
+			Limit:         limit,
+			ContinueToken: continueToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Machines, res.ContinueToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListMachinesResponse{Machines: machines}, nil
+}
+
+func (r *remoteRuntime) WatchMachines(ctx context.Context, req *iri.WatchMachinesRequest) (iri.MachineRuntime_WatchMachinesClient, error) {
+	return r.client.WatchMachines(ctx, req)
 }
 
 func (r *remoteRuntime) CreateMachine(ctx context.Context, req *iri.CreateMachineRequest) (*iri.CreateMachineResponse, error) {
@@ -58,6 +108,22 @@ func (r *remoteRuntime) UpdateMachinePower(ctx context.Context, req *iri.UpdateM
 	return r.client.UpdateMachinePower(ctx, req)
 }
 
+func (r *remoteRuntime) RebootMachine(ctx context.Context, req *iri.RebootMachineRequest) (*iri.RebootMachineResponse, error) {
+	return r.client.RebootMachine(ctx, req)
+}
+
+func (r *remoteRuntime) UpdateMachineClass(ctx context.Context, req *iri.UpdateMachineClassRequest) (*iri.UpdateMachineClassResponse, error) {
+	return r.client.UpdateMachineClass(ctx, req)
+}
+
+func (r *remoteRuntime) FreezeMachine(ctx context.Context, req *iri.FreezeMachineRequest) (*iri.FreezeMachineResponse, error) {
+	return r.client.FreezeMachine(ctx, req)
+}
+
+func (r *remoteRuntime) ThawMachine(ctx context.Context, req *iri.ThawMachineRequest) (*iri.ThawMachineResponse, error) {
+	return r.client.ThawMachine(ctx, req)
+}
+
 func (r *remoteRuntime) AttachVolume(ctx context.Context, req *iri.AttachVolumeRequest) (*iri.AttachVolumeResponse, error) {
 	return r.client.AttachVolume(ctx, req)
 }
@@ -85,3 +151,15 @@ func (r *remoteRuntime) Status(ctx context.Context, req *iri.StatusRequest) (*ir
 func (r *remoteRuntime) Exec(ctx context.Context, req *iri.ExecRequest) (*iri.ExecResponse, error) {
 	return r.client.Exec(ctx, req)
 }
+
+func (r *remoteRuntime) GetConsoleLog(ctx context.Context, req *iri.GetConsoleLogRequest) (iri.MachineRuntime_GetConsoleLogClient, error) {
+	return r.client.GetConsoleLog(ctx, req)
+}
+
+func (r *remoteRuntime) GetMachineStats(ctx context.Context, req *iri.GetMachineStatsRequest) (*iri.GetMachineStatsResponse, error) {
+	return r.client.GetMachineStats(ctx, req)
+}
+
+func (r *remoteRuntime) ListMachineStats(ctx context.Context, req *iri.ListMachineStatsRequest) (*iri.ListMachineStatsResponse, error) {
+	return r.client.ListMachineStats(ctx, req)
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"fmt"
 
+	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	"github.com/ironcore-dev/ironcore/iri/apis/volume"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -33,11 +35,61 @@ func NewRemoteRuntime(endpoint string) (volume.RuntimeService, error) {
 func (r *remoteRuntime) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
 	return r.client.Version(ctx, req)
 }
+
+// ListEvents lists the events page by page unless the request specifies a limit or continue token.
 func (r *remoteRuntime) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*iri.ListEventsResponse, error) {
-	return r.client.ListEvents(ctx, req)
+	if req.Limit > 0 || req.ContinueToken != "" {
+		return r.client.ListEvents(ctx, req)
+	}
+
+	events, err := paging.ListAll(ctx, paging.DefaultLimit, func(ctx context.Context, limit int64, continueToken string) ([]*irievent.Event, string, error) {
+		res, err := r.client.ListEvents(ctx, &iri.ListEventsRequest{
+			Filter:        req.Filter,
+			Limit:         limit,
+			ContinueToken: continueToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Events, res.ContinueToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListEventsResponse{Events: events}, nil
 }
+
+func (r *remoteRuntime) WatchEvents(ctx context.Context, req *iri.WatchEventsRequest) (iri.VolumeRuntime_WatchEventsClient, error) {
+	return r.client.WatchEvents(ctx, req)
+}
+
+// ListVolumes lists the volumes page by page unless the request specifies a limit or continue token.
 func (r *remoteRuntime) ListVolumes(ctx context.Context, request *iri.ListVolumesRequest) (*iri.ListVolumesResponse, error) {
-	return r.client.ListVolumes(ctx, request)
+	if request.Limit > 0 || request.ContinueToken != "" {
+		return r.client.ListVolumes(ctx, request)
+	}
+
+	volumes, err := paging.ListAll(ctx, paging.DefaultLimit, func(ctx context.Context, limit int64, continueToken string) ([]*iri.Volume, string, error) {
+		res, err := r.client.ListVolumes(ctx, &iri.ListVolumesRequest{
+			Filter:        request.Filter,
+			Limit:         limit,
+			ContinueToken: continueToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return res.Volumes, res.ContinueToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListVolumesResponse{Volumes: volumes}, nil
+}
+
+func (r *remoteRuntime) WatchVolumes(ctx context.Context, request *iri.WatchVolumesRequest) (iri.VolumeRuntime_WatchVolumesClient, error) {
+	return r.client.WatchVolumes(ctx, request)
 }
 
 func (r *remoteRuntime) CreateVolume(ctx context.Context, request *iri.CreateVolumeRequest) (*iri.CreateVolumeResponse, error) {
@@ -48,6 +100,10 @@ func (r *remoteRuntime) ExpandVolume(ctx context.Context, request *iri.ExpandVol
 	return r.client.ExpandVolume(ctx, request)
 }
 
+func (r *remoteRuntime) RevertVolume(ctx context.Context, request *iri.RevertVolumeRequest) (*iri.RevertVolumeResponse, error) {
+	return r.client.RevertVolume(ctx, request)
+}
+
 func (r *remoteRuntime) DeleteVolume(ctx context.Context, request *iri.DeleteVolumeRequest) (*iri.DeleteVolumeResponse, error) {
 	return r.client.DeleteVolume(ctx, request)
 }
@@ -64,6 +120,14 @@ func (r *remoteRuntime) ListVolumeSnapshots(ctx context.Context, request *iri.Li
 	return r.client.ListVolumeSnapshots(ctx, request)
 }
 
+func (r *remoteRuntime) ExportVolumeSnapshot(ctx context.Context, request *iri.ExportVolumeSnapshotRequest) (*iri.ExportVolumeSnapshotResponse, error) {
+	return r.client.ExportVolumeSnapshot(ctx, request)
+}
+
+func (r *remoteRuntime) GetVolumeSnapshotExport(ctx context.Context, request *iri.GetVolumeSnapshotExportRequest) (*iri.GetVolumeSnapshotExportResponse, error) {
+	return r.client.GetVolumeSnapshotExport(ctx, request)
+}
+
 func (r *remoteRuntime) Status(ctx context.Context, request *iri.StatusRequest) (*iri.StatusResponse, error) {
 	return r.client.Status(ctx, request)
 }
@@ -5,6 +5,7 @@ package bucket
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -13,8 +14,13 @@ import (
 
 	"github.com/ironcore-dev/ironcore/broker/common/idgen"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
+	"github.com/ironcore-dev/ironcore/iri/testing/fault"
+	"github.com/ironcore-dev/ironcore/iri/testing/internal/fakewatch"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -45,6 +51,22 @@ type FakeRuntimeService struct {
 	Buckets       map[string]*FakeBucket
 	BucketClasses map[string]*FakeBucketClass
 	Events        []*FakeEvent
+	Capabilities  []iri.RuntimeCapability
+
+	// Faults injects failures and latency into the calls of the runtime.
+	Faults *fault.Injector
+}
+
+// AllRuntimeCapabilities returns all capabilities a runtime can have. It is the default of the fake runtime.
+func AllRuntimeCapabilities() []iri.RuntimeCapability {
+	var capabilities []iri.RuntimeCapability
+	for value := range iri.RuntimeCapability_name {
+		if capability := iri.RuntimeCapability(value); capability != iri.RuntimeCapability_RUNTIME_CAPABILITY_UNSPECIFIED {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	slices.Sort(capabilities)
+	return capabilities
 }
 
 func NewFakeRuntimeService() *FakeRuntimeService {
@@ -54,6 +76,8 @@ func NewFakeRuntimeService() *FakeRuntimeService {
 		Buckets:       make(map[string]*FakeBucket),
 		BucketClasses: make(map[string]*FakeBucketClass),
 		Events:        []*FakeEvent{},
+		Capabilities:  AllRuntimeCapabilities(),
+		Faults:        fault.NewInjector(),
 	}
 }
 
@@ -85,6 +109,10 @@ func (r *FakeRuntimeService) SetEvents(events []*FakeEvent) {
 }
 
 func (r *FakeRuntimeService) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*iri.ListEventsResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_ListEvents_FullMethodName); err != nil {
+		return nil, err
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
@@ -93,22 +121,86 @@ func (r *FakeRuntimeService) ListEvents(ctx context.Context, req *iri.ListEvents
 		res = append(res, &e.Event)
 	}
 
-	return &iri.ListEventsResponse{Events: res}, nil
+	res, continueToken, err := paging.PageByOffset(res, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListEventsResponse{Events: res, ContinueToken: continueToken}, nil
+}
+
+// WatchEvents implements bucket.RuntimeService.
+func (r *FakeRuntimeService) WatchEvents(ctx context.Context, req *iri.WatchEventsRequest) (iri.BucketRuntime_WatchEventsClient, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_WatchEvents_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	return &fakeWatchEventsClient{
+		ctx: ctx,
+		watcher: fakewatch.NewEventWatcher(ctx, func() []*FakeEvent {
+			r.Lock()
+			defer r.Unlock()
+			return r.Events
+		}),
+	}, nil
+}
+
+func (r *FakeRuntimeService) SetCapabilities(capabilities []iri.RuntimeCapability) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Capabilities = capabilities
 }
 
 func (r *FakeRuntimeService) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_Version_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
 	return &iri.VersionResponse{
 		RuntimeName:    FakeRuntimeName,
 		RuntimeVersion: FakeVersion,
+		Capabilities:   slices.Clone(r.Capabilities),
 	}, nil
 }
 
 func (r *FakeRuntimeService) ListBuckets(ctx context.Context, req *iri.ListBucketsRequest) (*iri.ListBucketsResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_ListBuckets_FullMethodName); err != nil {
+		return nil, err
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
-	filter := req.Filter
+	var buckets []*iri.Bucket
+	for _, bucket := range r.filterBuckets(req.Filter) {
+		if matchesStatesAndClasses(req.Filter, bucket) {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	buckets, continueToken, err := paging.PageByKey(buckets, paging.ObjectID, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListBucketsResponse{Buckets: buckets, ContinueToken: continueToken}, nil
+}
+
+func matchesStatesAndClasses(filter *iri.BucketFilter, bucket *iri.Bucket) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.States) > 0 && !slices.Contains(filter.States, bucket.GetStatus().GetState()) {
+		return false
+	}
+	return len(filter.Classes) == 0 || slices.Contains(filter.Classes, bucket.GetSpec().GetClass())
+}
 
+func (r *FakeRuntimeService) filterBuckets(filter *iri.BucketFilter) []*iri.Bucket {
 	var res []*iri.Bucket
 	for _, v := range r.Buckets {
 		if filter != nil {
@@ -122,10 +214,36 @@ func (r *FakeRuntimeService) ListBuckets(ctx context.Context, req *iri.ListBucke
 
 		res = append(res, v.Bucket)
 	}
-	return &iri.ListBucketsResponse{Buckets: res}, nil
+	return res
+}
+
+// WatchBuckets implements bucket.RuntimeService.
+// The resource version of the request is ignored, the current buckets are always sent first.
+func (r *FakeRuntimeService) WatchBuckets(ctx context.Context, req *iri.WatchBucketsRequest) (iri.BucketRuntime_WatchBucketsClient, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_WatchBuckets_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	return &fakeWatchBucketsClient{
+		ctx: ctx,
+		watcher: fakewatch.NewObjectWatcher(ctx, func() []*iri.Bucket {
+			r.Lock()
+			defer r.Unlock()
+
+			var res []*iri.Bucket
+			for _, bucket := range r.filterBuckets(req.Filter) {
+				res = append(res, proto.Clone(bucket).(*iri.Bucket))
+			}
+			return res
+		}),
+	}, nil
 }
 
 func (r *FakeRuntimeService) CreateBucket(ctx context.Context, req *iri.CreateBucketRequest) (*iri.CreateBucketResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_CreateBucket_FullMethodName); err != nil {
+		return nil, err
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
@@ -144,6 +262,10 @@ func (r *FakeRuntimeService) CreateBucket(ctx context.Context, req *iri.CreateBu
 }
 
 func (r *FakeRuntimeService) DeleteBucket(ctx context.Context, req *iri.DeleteBucketRequest) (*iri.DeleteBucketResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_DeleteBucket_FullMethodName); err != nil {
+		return nil, err
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
@@ -157,6 +279,10 @@ func (r *FakeRuntimeService) DeleteBucket(ctx context.Context, req *iri.DeleteBu
 }
 
 func (r *FakeRuntimeService) ListBucketClasses(ctx context.Context, req *iri.ListBucketClassesRequest) (*iri.ListBucketClassesResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.BucketRuntime_ListBucketClasses_FullMethodName); err != nil {
+		return nil, err
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
@@ -170,3 +296,58 @@ func (r *FakeRuntimeService) ListBucketClasses(ctx context.Context, req *iri.Lis
 func filterInLabels(labelSelector, lbls map[string]string) bool {
 	return labels.SelectorFromSet(labelSelector).Matches(labels.Set(lbls))
 }
+
+// fakeWatchBucketsClient is a grpc.ServerStreamingClient sending the changes of the fake buckets.
+type fakeWatchBucketsClient struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	watcher *fakewatch.ObjectWatcher[*iri.Bucket]
+}
+
+func (c *fakeWatchBucketsClient) Recv() (*iri.WatchBucketsResponse, error) {
+	evt, err := c.watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return &iri.WatchBucketsResponse{
+		Type:            evt.Type,
+		Bucket:          evt.Object,
+		ResourceVersion: evt.ResourceVersion,
+	}, nil
+}
+
+func (c *fakeWatchBucketsClient) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeWatchBucketsClient) CloseSend() error {
+	return nil
+}
+
+// fakeWatchEventsClient is a grpc.ServerStreamingClient sending the events added to the fake runtime.
+type fakeWatchEventsClient struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	watcher *fakewatch.EventWatcher[*FakeEvent]
+}
+
+func (c *fakeWatchEventsClient) Recv() (*iri.WatchEventsResponse, error) {
+	evt, resourceVersion, err := c.watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return &iri.WatchEventsResponse{
+		Event:           proto.Clone(&evt.Event).(*irievent.Event),
+		ResourceVersion: resourceVersion,
+	}, nil
+}
+
+func (c *fakeWatchEventsClient) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeWatchEventsClient) CloseSend() error {
+	return nil
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package bucket
+
+import (
+	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/internal/fakeserver"
+	"google.golang.org/grpc"
+)
+
+type unimplementedServer struct {
+	iri.UnimplementedBucketRuntimeServer
+}
+
+// Server serves a FakeRuntimeService via gRPC.
+type Server struct {
+	*FakeRuntimeService
+	unimplementedServer
+}
+
+var _ iri.BucketRuntimeServer = (*Server)(nil)
+
+// NewServer creates a new Server serving the given runtime.
+func NewServer(runtime *FakeRuntimeService) *Server {
+	return &Server{FakeRuntimeService: runtime}
+}
+
+func (s *Server) WatchEvents(req *iri.WatchEventsRequest, server grpc.ServerStreamingServer[iri.WatchEventsResponse]) error {
+	client, err := s.FakeRuntimeService.WatchEvents(server.Context(), req)
+	return fakeserver.Forward(client, err, server)
+}
+
+func (s *Server) WatchBuckets(req *iri.WatchBucketsRequest, server grpc.ServerStreamingServer[iri.WatchBucketsResponse]) error {
+	client, err := s.FakeRuntimeService.WatchBuckets(server.Context(), req)
+	return fakeserver.Forward(client, err, server)
+}
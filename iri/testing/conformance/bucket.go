@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+
+	"github.com/ironcore-dev/ironcore/iri/apis/bucket"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+)
+
+// BucketRuntimeConfig configures the bucket runtime conformance specs.
+type BucketRuntimeConfig struct {
+	Config
+
+	// Runtime is the bucket runtime to verify.
+	Runtime bucket.RuntimeService
+	// BucketClass is the class of the buckets created by the specs. It has to be supported by the runtime.
+	BucketClass string
+}
+
+// DescribeBucketRuntime describes the conformance specs of a bucket runtime.
+// getConfig is called before every spec, after the setup nodes of the enclosing containers ran.
+func DescribeBucketRuntime(getConfig func() BucketRuntimeConfig) bool {
+	return Describe("Bucket runtime conformance", Label("conformance", "bucket"), func() {
+		var (
+			cfg         BucketRuntimeConfig
+			scopeLabels map[string]string
+		)
+
+		BeforeEach(func() {
+			cfg = getConfig()
+			scopeLabels = newScopeLabels()
+		})
+
+		createBucket := func(ctx context.Context, labels map[string]string) *iri.Bucket {
+			GinkgoHelper()
+			res, err := cfg.Runtime.CreateBucket(ctx, &iri.CreateBucketRequest{
+				Bucket: &iri.Bucket{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels:      labels,
+						Annotations: map[string]string{"conformance": "true"},
+					},
+					Spec: &iri.BucketSpec{
+						Class: cfg.BucketClass,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Bucket.GetMetadata().GetId()).NotTo(BeEmpty(), "created bucket has no id")
+
+			bucketID := res.Bucket.Metadata.Id
+			DeferCleanup(func(ctx SpecContext) {
+				_, err := cfg.Runtime.DeleteBucket(ctx, &iri.DeleteBucketRequest{BucketId: bucketID})
+				Expect(ignoreNotFound(err)).To(Succeed())
+			})
+			return res.Bucket
+		}
+
+		listBuckets := func(ctx context.Context, filter *iri.BucketFilter) []*iri.Bucket {
+			GinkgoHelper()
+			res, err := cfg.Runtime.ListBuckets(ctx, &iri.ListBucketsRequest{Filter: filter})
+			Expect(err).NotTo(HaveOccurred())
+			return res.Buckets
+		}
+
+		bucketIDs := func(ctx context.Context, filter *iri.BucketFilter) func() []string {
+			return func() []string {
+				var ids []string
+				for _, bucket := range listBuckets(ctx, filter) {
+					ids = append(ids, bucket.Metadata.Id)
+				}
+				return ids
+			}
+		}
+
+		getBucket := func(ctx context.Context, id string) func() *iri.Bucket {
+			return func() *iri.Bucket {
+				buckets := listBuckets(ctx, &iri.BucketFilter{Id: id})
+				if len(buckets) != 1 {
+					return nil
+				}
+				return buckets[0]
+			}
+		}
+
+		It("should report its name and version", func(ctx SpecContext) {
+			res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.RuntimeName).NotTo(BeEmpty())
+			Expect(res.RuntimeVersion).NotTo(BeEmpty())
+		})
+
+		It("should list the bucket class used by the specs", func(ctx SpecContext) {
+			res, err := cfg.Runtime.ListBucketClasses(ctx, &iri.ListBucketClassesRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.BucketClasses).To(ContainElement(HaveField("Name", cfg.BucketClass)))
+		})
+
+		Describe("CreateBucket", func() {
+			It("should create a bucket with the requested metadata and spec", func(ctx SpecContext) {
+				bucket := createBucket(ctx, scopeLabels)
+				Expect(bucket.Metadata.CreatedAt).To(BeNumerically(">", 0))
+				Expect(bucket.Metadata.Labels).To(Equal(scopeLabels))
+				Expect(bucket.Metadata.Annotations).To(HaveKeyWithValue("conformance", "true"))
+				Expect(bucket.Spec.Class).To(Equal(cfg.BucketClass))
+				Expect(iri.BucketState_name).To(HaveKey(int32(bucket.GetStatus().GetState())))
+			})
+
+			It("should create a new bucket for every request", func(ctx SpecContext) {
+				first := createBucket(ctx, scopeLabels)
+				second := createBucket(ctx, scopeLabels)
+				Expect(first.Metadata.Id).NotTo(Equal(second.Metadata.Id))
+
+				Eventually(ctx, bucketIDs(ctx, &iri.BucketFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(ConsistOf(first.Metadata.Id, second.Metadata.Id))
+			})
+		})
+
+		Describe("ListBuckets", func() {
+			It("should list buckets by id", func(ctx SpecContext) {
+				bucket := createBucket(ctx, scopeLabels)
+
+				Eventually(ctx, getBucket(ctx, bucket.Metadata.Id)).WithTimeout(cfg.timeout()).Should(SatisfyAll(
+					HaveField("Metadata.Id", bucket.Metadata.Id),
+					HaveField("Metadata.Labels", Equal(scopeLabels)),
+					HaveField("Spec.Class", cfg.BucketClass),
+				))
+			})
+
+			It("should return an empty list for unknown ids", func(ctx SpecContext) {
+				Expect(listBuckets(ctx, &iri.BucketFilter{Id: unknownID})).To(BeEmpty())
+			})
+
+			It("should only list buckets matching the label selector", func(ctx SpecContext) {
+				foo := createBucket(ctx, withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "foo"))
+				createBucket(ctx, withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "bar"))
+
+				Eventually(ctx, bucketIDs(ctx, &iri.BucketFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(HaveLen(2))
+				Expect(bucketIDs(ctx, &iri.BucketFilter{
+					LabelSelector: withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "foo"),
+				})()).To(ConsistOf(foo.Metadata.Id))
+			})
+
+			It("should filter buckets by class and state", func(ctx SpecContext) {
+				bucket := createBucket(ctx, scopeLabels)
+				Eventually(ctx, getBucket(ctx, bucket.Metadata.Id)).WithTimeout(cfg.timeout()).ShouldNot(BeNil())
+
+				Expect(bucketIDs(ctx, &iri.BucketFilter{
+					LabelSelector: scopeLabels,
+					Classes:       []string{cfg.BucketClass},
+				})()).To(ConsistOf(bucket.Metadata.Id))
+				Expect(bucketIDs(ctx, &iri.BucketFilter{
+					LabelSelector: scopeLabels,
+					Classes:       []string{"conformance-unknown-class"},
+				})()).To(BeEmpty())
+
+				Expect(bucketIDs(ctx, &iri.BucketFilter{
+					LabelSelector: scopeLabels,
+					States:        []iri.BucketState{iri.BucketState_BUCKET_ERROR},
+				})()).To(BeEmpty())
+			})
+
+			It("should page through the buckets", func(ctx SpecContext) {
+				var ids []any
+				for range 3 {
+					ids = append(ids, createBucket(ctx, scopeLabels).Metadata.Id)
+				}
+				Eventually(ctx, bucketIDs(ctx, &iri.BucketFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(HaveLen(len(ids)))
+
+				var (
+					listed        []string
+					continueToken string
+				)
+				for {
+					res, err := cfg.Runtime.ListBuckets(ctx, &iri.ListBucketsRequest{
+						Filter:        &iri.BucketFilter{LabelSelector: scopeLabels},
+						Limit:         2,
+						ContinueToken: continueToken,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(len(res.Buckets)).To(BeNumerically("<=", 2))
+					for _, bucket := range res.Buckets {
+						listed = append(listed, bucket.Metadata.Id)
+					}
+
+					if res.ContinueToken == "" {
+						break
+					}
+					continueToken = res.ContinueToken
+				}
+				Expect(listed).To(ConsistOf(ids...))
+			})
+
+			It("should reject an invalid continue token", func(ctx SpecContext) {
+				_, err := cfg.Runtime.ListBuckets(ctx, &iri.ListBucketsRequest{
+					Limit:         1,
+					ContinueToken: "conformance-invalid-token",
+				})
+				Expect(err).To(HaveCode(codes.InvalidArgument))
+			})
+		})
+
+		Describe("DeleteBucket", func() {
+			It("should delete a bucket", func(ctx SpecContext) {
+				bucket := createBucket(ctx, scopeLabels)
+
+				Expect(cfg.Runtime.DeleteBucket(ctx, &iri.DeleteBucketRequest{BucketId: bucket.Metadata.Id})).Error().NotTo(HaveOccurred())
+				Eventually(ctx, getBucket(ctx, bucket.Metadata.Id)).WithTimeout(cfg.timeout()).Should(BeNil())
+			})
+
+			It("should return NotFound for a bucket that was already deleted", func(ctx SpecContext) {
+				bucket := createBucket(ctx, scopeLabels)
+
+				Expect(cfg.Runtime.DeleteBucket(ctx, &iri.DeleteBucketRequest{BucketId: bucket.Metadata.Id})).Error().NotTo(HaveOccurred())
+				Eventually(ctx, getBucket(ctx, bucket.Metadata.Id)).WithTimeout(cfg.timeout()).Should(BeNil())
+
+				_, err := cfg.Runtime.DeleteBucket(ctx, &iri.DeleteBucketRequest{BucketId: bucket.Metadata.Id})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+		})
+
+		Describe("State transitions", func() {
+			It("should eventually make a created bucket available", func(ctx SpecContext) {
+				if cfg.ReadyTimeout <= 0 {
+					Skip("no ready timeout configured")
+				}
+
+				bucket := createBucket(ctx, scopeLabels)
+				Eventually(ctx, getBucket(ctx, bucket.Metadata.Id)).WithTimeout(cfg.ReadyTimeout).
+					Should(HaveField("Status.State", iri.BucketState_BUCKET_AVAILABLE))
+			})
+		})
+
+		Describe("Error codes", func() {
+			It("should return NotFound for operations on unknown buckets", func(ctx SpecContext) {
+				_, err := cfg.Runtime.DeleteBucket(ctx, &iri.DeleteBucketRequest{BucketId: unknownID})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+		})
+	})
+}
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformance contains Ginkgo specs that verify the behavior of IRI runtime implementations.
+//
+// The specs only depend on the machine.RuntimeService, volume.RuntimeService and bucket.RuntimeService
+// interfaces, so they can be run against in-process runtimes as well as against remote runtimes
+// (see iri/remote). Every spec labels the objects it creates with a value unique to the spec and
+// deletes them afterward, so that the specs can run against runtimes that hold other objects.
+package conformance
+
+import (
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	// ScopeLabel is set on all objects created by the specs. Its value is unique to each spec.
+	ScopeLabel = "conformance.iri.ironcore.dev/scope"
+
+	// DefaultTimeout is the default time a runtime may take until changes show up in list responses.
+	DefaultTimeout = 10 * time.Second
+
+	// unknownID is the id of an object that does not exist in any runtime.
+	unknownID = "conformance-does-not-exist"
+)
+
+// Config is the configuration common to all runtime kinds.
+type Config struct {
+	// Timeout is the time the runtime may take until changes show up in list responses.
+	// Defaults to DefaultTimeout.
+	Timeout time.Duration
+	// ReadyTimeout is the time the runtime may take until created objects are ready, i.e. running
+	// or available. If zero, readiness is not verified. Runtimes that only manage objects without
+	// backing them, like the in-tree fakes, leave it unset.
+	ReadyTimeout time.Duration
+}
+
+func (c Config) timeout() time.Duration {
+	if c.Timeout <= 0 {
+		return DefaultTimeout
+	}
+	return c.Timeout
+}
+
+func newScopeLabels() map[string]string {
+	return map[string]string{ScopeLabel: utilrand.String(10)}
+}
+
+func withLabel(labels map[string]string, key, value string) map[string]string {
+	res := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		res[k] = v
+	}
+	res[key] = value
+	return res
+}
+
+// ignoreNotFound ignores NotFound errors, as objects may already have been deleted by the spec.
+func ignoreNotFound(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return err
+}
+
+// HaveCode succeeds if the actual error has the given gRPC status code.
+func HaveCode(code codes.Code) types.GomegaMatcher {
+	return WithTransform(status.Code, Equal(code))
+}
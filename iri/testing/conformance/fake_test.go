@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance_test
+
+import (
+	iribucket "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	fakebucket "github.com/ironcore-dev/ironcore/iri/testing/bucket"
+	. "github.com/ironcore-dev/ironcore/iri/testing/conformance"
+	fakemachine "github.com/ironcore-dev/ironcore/iri/testing/machine"
+	fakevolume "github.com/ironcore-dev/ironcore/iri/testing/volume"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = Describe("Fake runtimes", func() {
+	DescribeMachineRuntime(func() MachineRuntimeConfig {
+		return MachineRuntimeConfig{
+			Runtime:      fakemachine.NewFakeRuntimeService(),
+			MachineClass: "x3-xlarge",
+		}
+	})
+
+	DescribeVolumeRuntime(func() VolumeRuntimeConfig {
+		return VolumeRuntimeConfig{
+			Runtime:     fakevolume.NewFakeRuntimeService(),
+			VolumeClass: "fast",
+		}
+	})
+
+	DescribeBucketRuntime(func() BucketRuntimeConfig {
+		runtime := fakebucket.NewFakeRuntimeService()
+		runtime.SetBucketClasses([]*fakebucket.FakeBucketClass{
+			{BucketClass: iribucket.BucketClass{Name: "standard"}},
+		})
+		return BucketRuntimeConfig{
+			Runtime:     runtime,
+			BucketClass: "standard",
+		}
+	})
+})
@@ -0,0 +1,316 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"slices"
+
+	"github.com/ironcore-dev/ironcore/iri/apis/machine"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+)
+
+// MachineRuntimeConfig configures the machine runtime conformance specs.
+type MachineRuntimeConfig struct {
+	Config
+
+	// Runtime is the machine runtime to verify.
+	Runtime machine.RuntimeService
+	// MachineClass is the class of the machines created by the specs. It has to be supported by the runtime.
+	MachineClass string
+}
+
+// DescribeMachineRuntime describes the conformance specs of a machine runtime.
+// getConfig is called before every spec, after the setup nodes of the enclosing containers ran.
+func DescribeMachineRuntime(getConfig func() MachineRuntimeConfig) bool {
+	return Describe("Machine runtime conformance", Label("conformance", "machine"), func() {
+		var (
+			cfg         MachineRuntimeConfig
+			scopeLabels map[string]string
+		)
+
+		BeforeEach(func() {
+			cfg = getConfig()
+			scopeLabels = newScopeLabels()
+		})
+
+		createMachine := func(ctx context.Context, labels map[string]string) *iri.Machine {
+			GinkgoHelper()
+			res, err := cfg.Runtime.CreateMachine(ctx, &iri.CreateMachineRequest{
+				Machine: &iri.Machine{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels:      labels,
+						Annotations: map[string]string{"conformance": "true"},
+					},
+					Spec: &iri.MachineSpec{
+						Power: iri.Power_POWER_ON,
+						Class: cfg.MachineClass,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Machine.GetMetadata().GetId()).NotTo(BeEmpty(), "created machine has no id")
+
+			machineID := res.Machine.Metadata.Id
+			DeferCleanup(func(ctx SpecContext) {
+				_, err := cfg.Runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: machineID})
+				Expect(ignoreNotFound(err)).To(Succeed())
+			})
+			return res.Machine
+		}
+
+		listMachines := func(ctx context.Context, filter *iri.MachineFilter) []*iri.Machine {
+			GinkgoHelper()
+			res, err := cfg.Runtime.ListMachines(ctx, &iri.ListMachinesRequest{Filter: filter})
+			Expect(err).NotTo(HaveOccurred())
+			return res.Machines
+		}
+
+		machineIDs := func(ctx context.Context, filter *iri.MachineFilter) func() []string {
+			return func() []string {
+				var ids []string
+				for _, machine := range listMachines(ctx, filter) {
+					ids = append(ids, machine.Metadata.Id)
+				}
+				return ids
+			}
+		}
+
+		getMachine := func(ctx context.Context, id string) func() *iri.Machine {
+			return func() *iri.Machine {
+				machines := listMachines(ctx, &iri.MachineFilter{Id: id})
+				if len(machines) != 1 {
+					return nil
+				}
+				return machines[0]
+			}
+		}
+
+		It("should report its name and version", func(ctx SpecContext) {
+			res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.RuntimeName).NotTo(BeEmpty())
+			Expect(res.RuntimeVersion).NotTo(BeEmpty())
+		})
+
+		Describe("CreateMachine", func() {
+			It("should create a machine with the requested metadata and spec", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+				Expect(machine.Metadata.CreatedAt).To(BeNumerically(">", 0))
+				Expect(machine.Metadata.Labels).To(Equal(scopeLabels))
+				Expect(machine.Metadata.Annotations).To(HaveKeyWithValue("conformance", "true"))
+				Expect(machine.Spec.Class).To(Equal(cfg.MachineClass))
+				Expect(iri.MachineState_name).To(HaveKey(int32(machine.GetStatus().GetState())))
+			})
+
+			It("should create a new machine for every request", func(ctx SpecContext) {
+				first := createMachine(ctx, scopeLabels)
+				second := createMachine(ctx, scopeLabels)
+				Expect(first.Metadata.Id).NotTo(Equal(second.Metadata.Id))
+
+				Eventually(ctx, machineIDs(ctx, &iri.MachineFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(ConsistOf(first.Metadata.Id, second.Metadata.Id))
+			})
+		})
+
+		Describe("ListMachines", func() {
+			It("should list machines by id", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+
+				Eventually(ctx, getMachine(ctx, machine.Metadata.Id)).WithTimeout(cfg.timeout()).Should(SatisfyAll(
+					HaveField("Metadata.Id", machine.Metadata.Id),
+					HaveField("Metadata.Labels", Equal(scopeLabels)),
+					HaveField("Spec.Class", cfg.MachineClass),
+				))
+			})
+
+			It("should return an empty list for unknown ids", func(ctx SpecContext) {
+				Expect(listMachines(ctx, &iri.MachineFilter{Id: unknownID})).To(BeEmpty())
+			})
+
+			It("should only list machines matching the label selector", func(ctx SpecContext) {
+				foo := createMachine(ctx, withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "foo"))
+				createMachine(ctx, withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "bar"))
+
+				Eventually(ctx, machineIDs(ctx, &iri.MachineFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(HaveLen(2))
+				Expect(machineIDs(ctx, &iri.MachineFilter{
+					LabelSelector: withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "foo"),
+				})()).To(ConsistOf(foo.Metadata.Id))
+			})
+
+			It("should filter machines by class and state", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+				Eventually(ctx, getMachine(ctx, machine.Metadata.Id)).WithTimeout(cfg.timeout()).ShouldNot(BeNil())
+
+				Expect(machineIDs(ctx, &iri.MachineFilter{
+					LabelSelector: scopeLabels,
+					Classes:       []string{cfg.MachineClass},
+				})()).To(ConsistOf(machine.Metadata.Id))
+				Expect(machineIDs(ctx, &iri.MachineFilter{
+					LabelSelector: scopeLabels,
+					Classes:       []string{"conformance-unknown-class"},
+				})()).To(BeEmpty())
+
+				Expect(machineIDs(ctx, &iri.MachineFilter{
+					LabelSelector: scopeLabels,
+					States:        []iri.MachineState{iri.MachineState_MACHINE_TERMINATED},
+				})()).To(BeEmpty())
+			})
+
+			It("should page through the machines", func(ctx SpecContext) {
+				var ids []any
+				for range 3 {
+					ids = append(ids, createMachine(ctx, scopeLabels).Metadata.Id)
+				}
+				Eventually(ctx, machineIDs(ctx, &iri.MachineFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(HaveLen(len(ids)))
+
+				var (
+					listed        []string
+					continueToken string
+				)
+				for {
+					res, err := cfg.Runtime.ListMachines(ctx, &iri.ListMachinesRequest{
+						Filter:        &iri.MachineFilter{LabelSelector: scopeLabels},
+						Limit:         2,
+						ContinueToken: continueToken,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(len(res.Machines)).To(BeNumerically("<=", 2))
+					for _, machine := range res.Machines {
+						listed = append(listed, machine.Metadata.Id)
+					}
+
+					if res.ContinueToken == "" {
+						break
+					}
+					continueToken = res.ContinueToken
+				}
+				Expect(listed).To(ConsistOf(ids...))
+			})
+
+			It("should reject an invalid continue token", func(ctx SpecContext) {
+				_, err := cfg.Runtime.ListMachines(ctx, &iri.ListMachinesRequest{
+					Limit:         1,
+					ContinueToken: "conformance-invalid-token",
+				})
+				Expect(err).To(HaveCode(codes.InvalidArgument))
+			})
+		})
+
+		Describe("DeleteMachine", func() {
+			It("should delete a machine", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+
+				Expect(cfg.Runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: machine.Metadata.Id})).Error().NotTo(HaveOccurred())
+				Eventually(ctx, getMachine(ctx, machine.Metadata.Id)).WithTimeout(cfg.timeout()).Should(BeNil())
+			})
+
+			It("should return NotFound for a machine that was already deleted", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+
+				Expect(cfg.Runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: machine.Metadata.Id})).Error().NotTo(HaveOccurred())
+				Eventually(ctx, getMachine(ctx, machine.Metadata.Id)).WithTimeout(cfg.timeout()).Should(BeNil())
+
+				_, err := cfg.Runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: machine.Metadata.Id})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+		})
+
+		Describe("Updates", func() {
+			It("should update the annotations idempotently", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+
+				annotations := map[string]string{"conformance": "updated"}
+				for range 2 {
+					Expect(cfg.Runtime.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{
+						MachineId:   machine.Metadata.Id,
+						Annotations: annotations,
+					})).Error().NotTo(HaveOccurred())
+				}
+				Eventually(ctx, getMachine(ctx, machine.Metadata.Id)).WithTimeout(cfg.timeout()).
+					Should(HaveField("Metadata.Annotations", Equal(annotations)))
+			})
+
+			It("should update the power idempotently", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+
+				for range 2 {
+					Expect(cfg.Runtime.UpdateMachinePower(ctx, &iri.UpdateMachinePowerRequest{
+						MachineId: machine.Metadata.Id,
+						Power:     iri.Power_POWER_OFF,
+					})).Error().NotTo(HaveOccurred())
+				}
+				Eventually(ctx, getMachine(ctx, machine.Metadata.Id)).WithTimeout(cfg.timeout()).
+					Should(HaveField("Spec.Power", iri.Power_POWER_OFF))
+			})
+		})
+
+		Describe("State transitions", func() {
+			It("should eventually run a created machine", func(ctx SpecContext) {
+				if cfg.ReadyTimeout <= 0 {
+					Skip("no ready timeout configured")
+				}
+
+				machine := createMachine(ctx, scopeLabels)
+				Eventually(ctx, getMachine(ctx, machine.Metadata.Id)).WithTimeout(cfg.ReadyTimeout).
+					Should(HaveField("Status.State", iri.MachineState_MACHINE_RUNNING))
+			})
+		})
+
+		Describe("FreezeMachine", func() {
+			BeforeEach(func(ctx SpecContext) {
+				res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				if !slices.Contains(res.Capabilities, iri.RuntimeCapability_RUNTIME_CAPABILITY_FREEZE) {
+					Skip("runtime does not support freezing")
+				}
+			})
+
+			It("should return NotFound for unknown machines", func(ctx SpecContext) {
+				_, err := cfg.Runtime.FreezeMachine(ctx, &iri.FreezeMachineRequest{MachineId: unknownID, TimeoutSeconds: 30})
+				Expect(err).To(HaveCode(codes.NotFound))
+
+				_, err = cfg.Runtime.ThawMachine(ctx, &iri.ThawMachineRequest{MachineId: unknownID})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+
+			It("should freeze and thaw a machine", func(ctx SpecContext) {
+				machine := createMachine(ctx, scopeLabels)
+
+				Expect(cfg.Runtime.FreezeMachine(ctx, &iri.FreezeMachineRequest{
+					MachineId:      machine.Metadata.Id,
+					TimeoutSeconds: 30,
+				})).Error().NotTo(HaveOccurred())
+				Expect(cfg.Runtime.ThawMachine(ctx, &iri.ThawMachineRequest{
+					MachineId: machine.Metadata.Id,
+				})).Error().NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("Error codes", func() {
+			It("should return NotFound for operations on unknown machines", func(ctx SpecContext) {
+				_, err := cfg.Runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: unknownID})
+				Expect(err).To(HaveCode(codes.NotFound))
+
+				_, err = cfg.Runtime.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{MachineId: unknownID})
+				Expect(err).To(HaveCode(codes.NotFound))
+
+				_, err = cfg.Runtime.UpdateMachinePower(ctx, &iri.UpdateMachinePowerRequest{MachineId: unknownID})
+				Expect(err).To(HaveCode(codes.NotFound))
+
+				_, err = cfg.Runtime.RebootMachine(ctx, &iri.RebootMachineRequest{MachineId: unknownID})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+		})
+	})
+}
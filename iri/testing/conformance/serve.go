@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"net"
+	"path/filepath"
+
+	"github.com/ironcore-dev/ironcore/iri/apis/bucket"
+	iribucket "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/apis/machine"
+	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/apis/volume"
+	irivolume "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	remotebucket "github.com/ironcore-dev/ironcore/iri/remote/bucket"
+	remotemachine "github.com/ironcore-dev/ironcore/iri/remote/machine"
+	remotevolume "github.com/ironcore-dev/ironcore/iri/remote/volume"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+// serve serves a gRPC server on a unix socket until the current spec is done and returns its address.
+func serve(register func(grpcSrv *grpc.Server)) string {
+	GinkgoHelper()
+	socketPath := filepath.Join(GinkgoT().TempDir(), "iri.sock")
+	lis, err := net.Listen("unix", socketPath)
+	Expect(err).NotTo(HaveOccurred())
+
+	grpcSrv := grpc.NewServer()
+	register(grpcSrv)
+	go func() {
+		defer GinkgoRecover()
+		Expect(grpcSrv.Serve(lis)).To(Succeed())
+	}()
+	DeferCleanup(grpcSrv.Stop)
+
+	return "unix://" + socketPath
+}
+
+// ServeMachineRuntime serves the machine runtime server via gRPC until the current spec is done
+// and returns a remote client for it. It has to be called from a setup node.
+func ServeMachineRuntime(srv irimachine.MachineRuntimeServer) machine.RuntimeService {
+	GinkgoHelper()
+	runtime, err := remotemachine.NewRemoteRuntime(serve(func(grpcSrv *grpc.Server) {
+		irimachine.RegisterMachineRuntimeServer(grpcSrv, srv)
+	}))
+	Expect(err).NotTo(HaveOccurred())
+	return runtime
+}
+
+// ServeVolumeRuntime serves the volume runtime server via gRPC until the current spec is done
+// and returns a remote client for it. It has to be called from a setup node.
+func ServeVolumeRuntime(srv irivolume.VolumeRuntimeServer) volume.RuntimeService {
+	GinkgoHelper()
+	runtime, err := remotevolume.NewRemoteRuntime(serve(func(grpcSrv *grpc.Server) {
+		irivolume.RegisterVolumeRuntimeServer(grpcSrv, srv)
+	}))
+	Expect(err).NotTo(HaveOccurred())
+	return runtime
+}
+
+// ServeBucketRuntime serves the bucket runtime server via gRPC until the current spec is done
+// and returns a remote client for it. It has to be called from a setup node.
+func ServeBucketRuntime(srv iribucket.BucketRuntimeServer) bucket.RuntimeService {
+	GinkgoHelper()
+	runtime, err := remotebucket.NewRemoteRuntime(serve(func(grpcSrv *grpc.Server) {
+		iribucket.RegisterBucketRuntimeServer(grpcSrv, srv)
+	}))
+	Expect(err).NotTo(HaveOccurred())
+	return runtime
+}
@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package conformance
+
+import (
+	"context"
+	"slices"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/apis/volume"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+)
+
+// DefaultVolumeStorageBytes is the default size of the volumes created by the specs.
+const DefaultVolumeStorageBytes int64 = 1024 * 1024 * 1024
+
+// VolumeRuntimeConfig configures the volume runtime conformance specs.
+type VolumeRuntimeConfig struct {
+	Config
+
+	// Runtime is the volume runtime to verify.
+	Runtime volume.RuntimeService
+	// VolumeClass is the class of the volumes created by the specs. It has to be supported by the runtime.
+	VolumeClass string
+	// StorageBytes is the size of the volumes created by the specs. Defaults to DefaultVolumeStorageBytes.
+	StorageBytes int64
+}
+
+func (c VolumeRuntimeConfig) storageBytes() int64 {
+	if c.StorageBytes <= 0 {
+		return DefaultVolumeStorageBytes
+	}
+	return c.StorageBytes
+}
+
+// DescribeVolumeRuntime describes the conformance specs of a volume runtime.
+// getConfig is called before every spec, after the setup nodes of the enclosing containers ran.
+func DescribeVolumeRuntime(getConfig func() VolumeRuntimeConfig) bool {
+	return Describe("Volume runtime conformance", Label("conformance", "volume"), func() {
+		var (
+			cfg         VolumeRuntimeConfig
+			scopeLabels map[string]string
+		)
+
+		BeforeEach(func() {
+			cfg = getConfig()
+			scopeLabels = newScopeLabels()
+		})
+
+		createVolumeFrom := func(ctx context.Context, labels map[string]string, dataSource *iri.VolumeDataSource) *iri.Volume {
+			GinkgoHelper()
+			res, err := cfg.Runtime.CreateVolume(ctx, &iri.CreateVolumeRequest{
+				Volume: &iri.Volume{
+					Metadata: &irimeta.ObjectMetadata{
+						Labels:      labels,
+						Annotations: map[string]string{"conformance": "true"},
+					},
+					Spec: &iri.VolumeSpec{
+						Class: cfg.VolumeClass,
+						Resources: &iri.VolumeResources{
+							StorageBytes: cfg.storageBytes(),
+						},
+						VolumeDataSource: dataSource,
+					},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Volume.GetMetadata().GetId()).NotTo(BeEmpty(), "created volume has no id")
+
+			volumeID := res.Volume.Metadata.Id
+			DeferCleanup(func(ctx SpecContext) {
+				_, err := cfg.Runtime.DeleteVolume(ctx, &iri.DeleteVolumeRequest{VolumeId: volumeID})
+				Expect(ignoreNotFound(err)).To(Succeed())
+			})
+			return res.Volume
+		}
+
+		createVolume := func(ctx context.Context, labels map[string]string) *iri.Volume {
+			GinkgoHelper()
+			return createVolumeFrom(ctx, labels, nil)
+		}
+
+		listVolumes := func(ctx context.Context, filter *iri.VolumeFilter) []*iri.Volume {
+			GinkgoHelper()
+			res, err := cfg.Runtime.ListVolumes(ctx, &iri.ListVolumesRequest{Filter: filter})
+			Expect(err).NotTo(HaveOccurred())
+			return res.Volumes
+		}
+
+		volumeIDs := func(ctx context.Context, filter *iri.VolumeFilter) func() []string {
+			return func() []string {
+				var ids []string
+				for _, volume := range listVolumes(ctx, filter) {
+					ids = append(ids, volume.Metadata.Id)
+				}
+				return ids
+			}
+		}
+
+		getVolume := func(ctx context.Context, id string) func() *iri.Volume {
+			return func() *iri.Volume {
+				volumes := listVolumes(ctx, &iri.VolumeFilter{Id: id})
+				if len(volumes) != 1 {
+					return nil
+				}
+				return volumes[0]
+			}
+		}
+
+		It("should report its name and version", func(ctx SpecContext) {
+			res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.RuntimeName).NotTo(BeEmpty())
+			Expect(res.RuntimeVersion).NotTo(BeEmpty())
+		})
+
+		Describe("CreateVolume", func() {
+			It("should create a volume with the requested metadata and spec", func(ctx SpecContext) {
+				volume := createVolume(ctx, scopeLabels)
+				Expect(volume.Metadata.CreatedAt).To(BeNumerically(">", 0))
+				Expect(volume.Metadata.Labels).To(Equal(scopeLabels))
+				Expect(volume.Metadata.Annotations).To(HaveKeyWithValue("conformance", "true"))
+				Expect(volume.Spec.Class).To(Equal(cfg.VolumeClass))
+				Expect(volume.Spec.Resources.GetStorageBytes()).To(Equal(cfg.storageBytes()))
+				Expect(iri.VolumeState_name).To(HaveKey(int32(volume.GetStatus().GetState())))
+			})
+
+			It("should create a new volume for every request", func(ctx SpecContext) {
+				first := createVolume(ctx, scopeLabels)
+				second := createVolume(ctx, scopeLabels)
+				Expect(first.Metadata.Id).NotTo(Equal(second.Metadata.Id))
+
+				Eventually(ctx, volumeIDs(ctx, &iri.VolumeFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(ConsistOf(first.Metadata.Id, second.Metadata.Id))
+			})
+
+			It("should clone a volume if the runtime supports cloning", func(ctx SpecContext) {
+				res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				if !slices.Contains(res.Capabilities, iri.RuntimeCapability_RUNTIME_CAPABILITY_CLONE) {
+					Skip("runtime does not support cloning")
+				}
+
+				source := createVolume(ctx, scopeLabels)
+				clone := createVolumeFrom(ctx, scopeLabels, &iri.VolumeDataSource{
+					CloneDataSource: &iri.CloneDataSource{VolumeId: source.Metadata.Id},
+				})
+				Expect(clone.Metadata.Id).NotTo(Equal(source.Metadata.Id))
+
+				Eventually(ctx, getVolume(ctx, clone.Metadata.Id)).WithTimeout(cfg.timeout()).
+					Should(HaveField("Spec.VolumeDataSource.CloneDataSource.VolumeId", source.Metadata.Id))
+			})
+		})
+
+		Describe("ListVolumes", func() {
+			It("should list volumes by id", func(ctx SpecContext) {
+				volume := createVolume(ctx, scopeLabels)
+
+				Eventually(ctx, getVolume(ctx, volume.Metadata.Id)).WithTimeout(cfg.timeout()).Should(SatisfyAll(
+					HaveField("Metadata.Id", volume.Metadata.Id),
+					HaveField("Metadata.Labels", Equal(scopeLabels)),
+					HaveField("Spec.Class", cfg.VolumeClass),
+				))
+			})
+
+			It("should return an empty list for unknown ids", func(ctx SpecContext) {
+				Expect(listVolumes(ctx, &iri.VolumeFilter{Id: unknownID})).To(BeEmpty())
+			})
+
+			It("should only list volumes matching the label selector", func(ctx SpecContext) {
+				foo := createVolume(ctx, withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "foo"))
+				createVolume(ctx, withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "bar"))
+
+				Eventually(ctx, volumeIDs(ctx, &iri.VolumeFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(HaveLen(2))
+				Expect(volumeIDs(ctx, &iri.VolumeFilter{
+					LabelSelector: withLabel(scopeLabels, "conformance.iri.ironcore.dev/name", "foo"),
+				})()).To(ConsistOf(foo.Metadata.Id))
+			})
+
+			It("should filter volumes by class and state", func(ctx SpecContext) {
+				volume := createVolume(ctx, scopeLabels)
+				Eventually(ctx, getVolume(ctx, volume.Metadata.Id)).WithTimeout(cfg.timeout()).ShouldNot(BeNil())
+
+				Expect(volumeIDs(ctx, &iri.VolumeFilter{
+					LabelSelector: scopeLabels,
+					Classes:       []string{cfg.VolumeClass},
+				})()).To(ConsistOf(volume.Metadata.Id))
+				Expect(volumeIDs(ctx, &iri.VolumeFilter{
+					LabelSelector: scopeLabels,
+					Classes:       []string{"conformance-unknown-class"},
+				})()).To(BeEmpty())
+
+				Expect(volumeIDs(ctx, &iri.VolumeFilter{
+					LabelSelector: scopeLabels,
+					States:        []iri.VolumeState{iri.VolumeState_VOLUME_ERROR},
+				})()).To(BeEmpty())
+			})
+
+			It("should page through the volumes", func(ctx SpecContext) {
+				var ids []any
+				for range 3 {
+					ids = append(ids, createVolume(ctx, scopeLabels).Metadata.Id)
+				}
+				Eventually(ctx, volumeIDs(ctx, &iri.VolumeFilter{LabelSelector: scopeLabels})).
+					WithTimeout(cfg.timeout()).
+					Should(HaveLen(len(ids)))
+
+				var (
+					listed        []string
+					continueToken string
+				)
+				for {
+					res, err := cfg.Runtime.ListVolumes(ctx, &iri.ListVolumesRequest{
+						Filter:        &iri.VolumeFilter{LabelSelector: scopeLabels},
+						Limit:         2,
+						ContinueToken: continueToken,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(len(res.Volumes)).To(BeNumerically("<=", 2))
+					for _, volume := range res.Volumes {
+						listed = append(listed, volume.Metadata.Id)
+					}
+
+					if res.ContinueToken == "" {
+						break
+					}
+					continueToken = res.ContinueToken
+				}
+				Expect(listed).To(ConsistOf(ids...))
+			})
+
+			It("should reject an invalid continue token", func(ctx SpecContext) {
+				_, err := cfg.Runtime.ListVolumes(ctx, &iri.ListVolumesRequest{
+					Limit:         1,
+					ContinueToken: "conformance-invalid-token",
+				})
+				Expect(err).To(HaveCode(codes.InvalidArgument))
+			})
+		})
+
+		Describe("DeleteVolume", func() {
+			It("should delete a volume", func(ctx SpecContext) {
+				volume := createVolume(ctx, scopeLabels)
+
+				Expect(cfg.Runtime.DeleteVolume(ctx, &iri.DeleteVolumeRequest{VolumeId: volume.Metadata.Id})).Error().NotTo(HaveOccurred())
+				Eventually(ctx, getVolume(ctx, volume.Metadata.Id)).WithTimeout(cfg.timeout()).Should(BeNil())
+			})
+
+			It("should return NotFound for a volume that was already deleted", func(ctx SpecContext) {
+				volume := createVolume(ctx, scopeLabels)
+
+				Expect(cfg.Runtime.DeleteVolume(ctx, &iri.DeleteVolumeRequest{VolumeId: volume.Metadata.Id})).Error().NotTo(HaveOccurred())
+				Eventually(ctx, getVolume(ctx, volume.Metadata.Id)).WithTimeout(cfg.timeout()).Should(BeNil())
+
+				_, err := cfg.Runtime.DeleteVolume(ctx, &iri.DeleteVolumeRequest{VolumeId: volume.Metadata.Id})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+		})
+
+		Describe("ExpandVolume", func() {
+			It("should expand a volume idempotently", func(ctx SpecContext) {
+				volume := createVolume(ctx, scopeLabels)
+
+				storageBytes := 2 * cfg.storageBytes()
+				for range 2 {
+					Expect(cfg.Runtime.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
+						VolumeId:  volume.Metadata.Id,
+						Resources: &iri.VolumeResources{StorageBytes: storageBytes},
+					})).Error().NotTo(HaveOccurred())
+				}
+				Eventually(ctx, getVolume(ctx, volume.Metadata.Id)).WithTimeout(cfg.timeout()).
+					Should(HaveField("Spec.Resources.StorageBytes", storageBytes))
+			})
+		})
+
+		Describe("RevertVolume", func() {
+			BeforeEach(func(ctx SpecContext) {
+				res, err := cfg.Runtime.Version(ctx, &iri.VersionRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				if !slices.Contains(res.Capabilities, iri.RuntimeCapability_RUNTIME_CAPABILITY_REVERT) {
+					Skip("runtime does not support reverting")
+				}
+			})
+
+			It("should return NotFound for unknown volumes and snapshots", func(ctx SpecContext) {
+				_, err := cfg.Runtime.RevertVolume(ctx, &iri.RevertVolumeRequest{
+					VolumeId:         unknownID,
+					VolumeSnapshotId: unknownID,
+				})
+				Expect(err).To(HaveCode(codes.NotFound))
+
+				volume := createVolume(ctx, scopeLabels)
+				_, err = cfg.Runtime.RevertVolume(ctx, &iri.RevertVolumeRequest{
+					VolumeId:         volume.Metadata.Id,
+					VolumeSnapshotId: unknownID,
+				})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+
+			It("should revert a volume to a ready snapshot of itself", func(ctx SpecContext) {
+				if cfg.ReadyTimeout <= 0 {
+					Skip("no ready timeout configured")
+				}
+
+				volume := createVolume(ctx, scopeLabels)
+				Eventually(ctx, getVolume(ctx, volume.Metadata.Id)).WithTimeout(cfg.ReadyTimeout).
+					Should(HaveField("Status.State", iri.VolumeState_VOLUME_AVAILABLE))
+
+				res, err := cfg.Runtime.CreateVolumeSnapshot(ctx, &iri.CreateVolumeSnapshotRequest{
+					VolumeSnapshot: &iri.VolumeSnapshot{
+						Metadata: &irimeta.ObjectMetadata{Labels: scopeLabels},
+						Spec:     &iri.VolumeSnapshotSpec{VolumeId: volume.Metadata.Id},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				volumeSnapshotID := res.VolumeSnapshot.Metadata.Id
+				DeferCleanup(func(ctx SpecContext) {
+					_, err := cfg.Runtime.DeleteVolumeSnapshot(ctx, &iri.DeleteVolumeSnapshotRequest{VolumeSnapshotId: volumeSnapshotID})
+					Expect(ignoreNotFound(err)).To(Succeed())
+				})
+
+				Eventually(ctx, func() iri.VolumeSnapshotState {
+					res, err := cfg.Runtime.ListVolumeSnapshots(ctx, &iri.ListVolumeSnapshotsRequest{
+						Filter: &iri.VolumeSnapshotFilter{Id: volumeSnapshotID},
+					})
+					Expect(err).NotTo(HaveOccurred())
+					if len(res.VolumeSnapshots) != 1 {
+						return iri.VolumeSnapshotState_VOLUME_SNAPSHOT_PENDING
+					}
+					return res.VolumeSnapshots[0].GetStatus().GetState()
+				}).WithTimeout(cfg.ReadyTimeout).Should(Equal(iri.VolumeSnapshotState_VOLUME_SNAPSHOT_READY))
+
+				Expect(cfg.Runtime.RevertVolume(ctx, &iri.RevertVolumeRequest{
+					VolumeId:         volume.Metadata.Id,
+					VolumeSnapshotId: volumeSnapshotID,
+				})).Error().NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("State transitions", func() {
+			It("should eventually make a created volume available", func(ctx SpecContext) {
+				if cfg.ReadyTimeout <= 0 {
+					Skip("no ready timeout configured")
+				}
+
+				volume := createVolume(ctx, scopeLabels)
+				Eventually(ctx, getVolume(ctx, volume.Metadata.Id)).WithTimeout(cfg.ReadyTimeout).
+					Should(HaveField("Status.State", iri.VolumeState_VOLUME_AVAILABLE))
+			})
+		})
+
+		Describe("Error codes", func() {
+			It("should return NotFound for operations on unknown volumes", func(ctx SpecContext) {
+				_, err := cfg.Runtime.DeleteVolume(ctx, &iri.DeleteVolumeRequest{VolumeId: unknownID})
+				Expect(err).To(HaveCode(codes.NotFound))
+
+				_, err = cfg.Runtime.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
+					VolumeId:  unknownID,
+					Resources: &iri.VolumeResources{StorageBytes: cfg.storageBytes()},
+				})
+				Expect(err).To(HaveCode(codes.NotFound))
+			})
+		})
+	})
+}
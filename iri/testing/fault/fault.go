@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fault injects failures and latency into the calls of the fake runtimes.
+//
+// Calls are identified by the full method names of the IRI services, e.g.
+// machine.MachineRuntime_CreateMachine_FullMethodName.
+package fault
+
+import (
+	"context"
+	"math/rand/v2"
+	"path"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error returns the error of an injected failure with the given code.
+func Error(code codes.Code) error {
+	return status.Error(code, "injected fault")
+}
+
+// Chaos configures random failures and latency.
+type Chaos struct {
+	// FailureRate is the probability in [0, 1] of a call to fail.
+	FailureRate float64
+	// Codes are the codes the failing calls return. One is picked at random for every failure.
+	// Defaults to codes.Unavailable.
+	Codes []codes.Code
+	// MaxLatency is the maximum random latency added to every call.
+	MaxLatency time.Duration
+	// Methods are the methods affected by chaos, either as full method names or as method names
+	// without service, e.g. CreateMachine. If empty, all methods are affected.
+	Methods []string
+	// Seed is the seed of the random numbers. If zero, a random seed is used.
+	Seed uint64
+}
+
+func (c *Chaos) affects(method string) bool {
+	return len(c.Methods) == 0 || slices.Contains(c.Methods, method) || slices.Contains(c.Methods, path.Base(method))
+}
+
+// Injector decides whether calls fail or are delayed. A nil Injector never fails or delays calls.
+type Injector struct {
+	mu sync.Mutex
+
+	scripts map[string][]error
+	calls   map[string]int
+
+	chaos *Chaos
+	rand  *rand.Rand
+}
+
+// NewInjector creates a new Injector that neither fails nor delays calls until configured.
+func NewInjector() *Injector {
+	return &Injector{
+		scripts: make(map[string][]error),
+		calls:   make(map[string]int),
+	}
+}
+
+// FailNext makes the next calls of the method return the given errors, in order. A nil error
+// lets the corresponding call pass without chaos being applied.
+func (i *Injector) FailNext(method string, errs ...error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.scripts[method] = append(i.scripts[method], errs...)
+}
+
+// SetChaos configures random failures and latency for all subsequent calls. A nil Chaos disables them.
+func (i *Injector) SetChaos(chaos *Chaos) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.chaos = chaos
+	if chaos == nil {
+		i.rand = nil
+		return
+	}
+
+	seed := chaos.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	i.rand = rand.New(rand.NewPCG(seed, seed))
+}
+
+// Reset removes all scripted failures and chaos and resets the call counts.
+func (i *Injector) Reset() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.scripts = make(map[string][]error)
+	i.calls = make(map[string]int)
+	i.chaos = nil
+	i.rand = nil
+}
+
+// Calls returns how often the method was called.
+func (i *Injector) Calls(method string) int {
+	if i == nil {
+		return 0
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.calls[method]
+}
+
+// Inject is called by the fake runtimes at the beginning of every call of the method.
+// It waits for the latency of the call and returns the error the call has to fail with, if any.
+func (i *Injector) Inject(ctx context.Context, method string) error {
+	if i == nil {
+		return nil
+	}
+
+	latency, err := i.next(method)
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+	return err
+}
+
+func (i *Injector) next(method string) (time.Duration, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.calls[method]++
+
+	if script := i.scripts[method]; len(script) > 0 {
+		i.scripts[method] = script[1:]
+		return 0, script[0]
+	}
+
+	chaos := i.chaos
+	if chaos == nil || !chaos.affects(method) {
+		return 0, nil
+	}
+
+	var latency time.Duration
+	if chaos.MaxLatency > 0 {
+		latency = time.Duration(i.rand.Int64N(int64(chaos.MaxLatency) + 1))
+	}
+	if i.rand.Float64() >= chaos.FailureRate {
+		return latency, nil
+	}
+
+	code := codes.Unavailable
+	if len(chaos.Codes) > 0 {
+		code = chaos.Codes[i.rand.IntN(len(chaos.Codes))]
+	}
+	return latency, Error(code)
+}
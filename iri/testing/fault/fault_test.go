@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package fault_test
+
+import (
+	"context"
+	"time"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/iri/testing/fault"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	createMachine = iri.MachineRuntime_CreateMachine_FullMethodName
+	listMachines  = iri.MachineRuntime_ListMachines_FullMethodName
+)
+
+var _ = Describe("Injector", func() {
+	var injector *Injector
+
+	BeforeEach(func() {
+		injector = NewInjector()
+	})
+
+	It("should not fail calls until configured", func(ctx SpecContext) {
+		Expect(injector.Inject(ctx, createMachine)).To(Succeed())
+		Expect(injector.Calls(createMachine)).To(Equal(1))
+	})
+
+	It("should fail the next calls with the scripted errors in order", func(ctx SpecContext) {
+		injector.FailNext(createMachine, Error(codes.Unavailable), nil, Error(codes.ResourceExhausted))
+
+		Expect(status.Code(injector.Inject(ctx, createMachine))).To(Equal(codes.Unavailable))
+		Expect(injector.Inject(ctx, listMachines)).To(Succeed())
+		Expect(injector.Inject(ctx, createMachine)).To(Succeed())
+		Expect(status.Code(injector.Inject(ctx, createMachine))).To(Equal(codes.ResourceExhausted))
+		Expect(injector.Inject(ctx, createMachine)).To(Succeed())
+
+		Expect(injector.Calls(createMachine)).To(Equal(4))
+		Expect(injector.Calls(listMachines)).To(Equal(1))
+	})
+
+	It("should fail only the methods affected by chaos", func(ctx SpecContext) {
+		injector.SetChaos(&Chaos{
+			FailureRate: 1,
+			Codes:       []codes.Code{codes.Internal},
+			Methods:     []string{"CreateMachine"},
+		})
+
+		Expect(status.Code(injector.Inject(ctx, createMachine))).To(Equal(codes.Internal))
+		Expect(injector.Inject(ctx, listMachines)).To(Succeed())
+	})
+
+	It("should prefer scripted errors over chaos", func(ctx SpecContext) {
+		injector.SetChaos(&Chaos{FailureRate: 1})
+		injector.FailNext(createMachine, nil)
+
+		Expect(injector.Inject(ctx, createMachine)).To(Succeed())
+		Expect(status.Code(injector.Inject(ctx, createMachine))).To(Equal(codes.Unavailable))
+	})
+
+	It("should fail the same calls for the same seed", func(ctx SpecContext) {
+		results := func() []codes.Code {
+			injector.SetChaos(&Chaos{FailureRate: 0.5, Seed: 42})
+
+			var res []codes.Code
+			for range 20 {
+				res = append(res, status.Code(injector.Inject(ctx, createMachine)))
+			}
+			return res
+		}
+
+		first := results()
+		Expect(first).To(ContainElements(codes.OK, codes.Unavailable))
+		Expect(results()).To(Equal(first))
+	})
+
+	It("should abort the latency when the context is done", func() {
+		injector.SetChaos(&Chaos{MaxLatency: time.Hour, Seed: 1})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		Expect(status.Code(injector.Inject(ctx, createMachine))).To(Equal(codes.DeadlineExceeded))
+	})
+
+	It("should remove all faults on reset", func(ctx SpecContext) {
+		injector.SetChaos(&Chaos{FailureRate: 1})
+		injector.FailNext(createMachine, Error(codes.Internal))
+		Expect(injector.Inject(ctx, listMachines)).NotTo(Succeed())
+
+		injector.Reset()
+
+		Expect(injector.Inject(ctx, createMachine)).To(Succeed())
+		Expect(injector.Calls(listMachines)).To(BeZero())
+	})
+
+	It("should never fail calls if nil", func(ctx SpecContext) {
+		var injector *Injector
+		Expect(injector.Inject(ctx, createMachine)).To(Succeed())
+		Expect(injector.Calls(createMachine)).To(BeZero())
+	})
+})
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fakeserver helps serving the fake runtimes via gRPC.
+package fakeserver
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
+)
+
+// Forward sends everything received from the client, as returned by a fake runtime, to the server stream.
+// It returns once the client is exhausted or fails.
+func Forward[Res any](client grpc.ServerStreamingClient[Res], err error, server grpc.ServerStreamingServer[Res]) error {
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if err := server.Send(res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fakewatch implements the watches of the fake runtimes.
+//
+// As tests mutate the state of the fake runtimes directly, the watches cannot be fed by the
+// mutating methods. Instead, they periodically take snapshots of the fake runtime state and
+// report the differences.
+package fakewatch
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// PollInterval is the interval in which the watches poll the state of the fake runtime.
+var PollInterval = 50 * time.Millisecond
+
+// ObjectEvent is a change of an object reported by an ObjectWatcher.
+type ObjectEvent[O irimeta.Object] struct {
+	Type            irimeta.WatchEventType
+	Object          O
+	ResourceVersion string
+}
+
+// ObjectWatcher reports the changes of the objects of a fake runtime.
+// Initially, all existing objects are reported as added.
+type ObjectWatcher[O irimeta.Object] struct {
+	ctx  context.Context
+	list func() []O
+
+	resourceVersion uint64
+	objects         map[string]O
+	queue           []ObjectEvent[O]
+}
+
+// NewObjectWatcher creates a new ObjectWatcher. list has to return copies of the current objects
+// that are not mutated afterward.
+func NewObjectWatcher[O irimeta.Object](ctx context.Context, list func() []O) *ObjectWatcher[O] {
+	return &ObjectWatcher[O]{
+		ctx:     ctx,
+		list:    list,
+		objects: make(map[string]O),
+	}
+}
+
+func (w *ObjectWatcher[O]) enqueue(typ irimeta.WatchEventType, obj O) {
+	w.resourceVersion++
+	w.queue = append(w.queue, ObjectEvent[O]{
+		Type:            typ,
+		Object:          obj,
+		ResourceVersion: strconv.FormatUint(w.resourceVersion, 10),
+	})
+}
+
+func (w *ObjectWatcher[O]) poll() {
+	current := make(map[string]O)
+	for _, obj := range w.list() {
+		id := obj.GetMetadata().GetId()
+		current[id] = obj
+
+		old, ok := w.objects[id]
+		switch {
+		case !ok:
+			w.enqueue(irimeta.WatchEventType_WATCH_EVENT_ADDED, obj)
+		case !proto.Equal(old, obj):
+			w.enqueue(irimeta.WatchEventType_WATCH_EVENT_MODIFIED, obj)
+		}
+	}
+	for id, old := range w.objects {
+		if _, ok := current[id]; !ok {
+			w.enqueue(irimeta.WatchEventType_WATCH_EVENT_DELETED, old)
+		}
+	}
+	w.objects = current
+}
+
+// Next blocks until there is a change and returns it.
+func (w *ObjectWatcher[O]) Next() (ObjectEvent[O], error) {
+	for {
+		w.poll()
+		if len(w.queue) > 0 {
+			evt := w.queue[0]
+			w.queue = w.queue[1:]
+			return evt, nil
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return ObjectEvent[O]{}, w.ctx.Err()
+		case <-time.After(PollInterval):
+		}
+	}
+}
+
+// EventWatcher reports the events added to a fake runtime.
+// Events that exist when the watcher is created are not reported.
+type EventWatcher[E comparable] struct {
+	ctx  context.Context
+	list func() []E
+
+	resourceVersion uint64
+	seen            sets.Set[E]
+}
+
+// NewEventWatcher creates a new EventWatcher. list has to return the current events.
+func NewEventWatcher[E comparable](ctx context.Context, list func() []E) *EventWatcher[E] {
+	return &EventWatcher[E]{
+		ctx:  ctx,
+		list: list,
+		seen: sets.New(list()...),
+	}
+}
+
+// Next blocks until an event is added and returns it together with its resource version.
+func (w *EventWatcher[E]) Next() (E, string, error) {
+	for {
+		for _, evt := range w.list() {
+			if w.seen.Has(evt) {
+				continue
+			}
+
+			w.seen.Insert(evt)
+			w.resourceVersion++
+			return evt, strconv.FormatUint(w.resourceVersion, 10), nil
+		}
+
+		select {
+		case <-w.ctx.Done():
+			var zero E
+			return zero, "", w.ctx.Err()
+		case <-time.After(PollInterval):
+		}
+	}
+}
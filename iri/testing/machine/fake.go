@@ -4,18 +4,27 @@
 package machine
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"io"
+	"slices"
 	"strconv"
 	"sync"
 	"time"
 
 	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
+	"github.com/ironcore-dev/ironcore/iri/testing/fault"
+	"github.com/ironcore-dev/ironcore/iri/testing/internal/fakewatch"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/clock"
 )
 
 var (
@@ -26,6 +35,23 @@ var (
 	FakeRuntimeName = "fakeRuntime"
 )
 
+// Synthetic values reported by the fake runtime as machine stats.
+const (
+	FakeCPUUsageCoreNanoSeconds = 1_000_000_000
+	FakeMemoryUsageBytes        = 512 * 1024 * 1024
+	FakeMemoryAvailableBytes    = 512 * 1024 * 1024
+
+	FakeVolumeReadBytes  = 4096
+	FakeVolumeWriteBytes = 2048
+	FakeVolumeReadOps    = 4
+	FakeVolumeWriteOps   = 2
+
+	FakeNetworkInterfaceRxBytes   = 1500
+	FakeNetworkInterfaceTxBytes   = 1000
+	FakeNetworkInterfaceRxPackets = 3
+	FakeNetworkInterfaceTxPackets = 2
+)
+
 func filterInLabels(labelSelector, lbls map[string]string) bool {
 	return labels.SelectorFromSet(labelSelector).Matches(labels.Set(lbls))
 }
@@ -49,6 +75,24 @@ func generateID(length int) string {
 
 type FakeMachine struct {
 	*iri.Machine
+
+	// Reboots are the reboot modes the machine was rebooted with, in order.
+	Reboots []iri.RebootMode
+
+	// FrozenUntil is the time the I/O of the machine is frozen until, if it is frozen.
+	FrozenUntil time.Time
+
+	// ConsoleLog is the serial console output of the machine.
+	ConsoleLog []byte
+
+	// runningAt is the time the pending machine runs, if it boots.
+	runningAt time.Time
+	// terminatedAt is the time the terminating machine is gone, if it terminates.
+	terminatedAt time.Time
+	// volumesAttachedAt are the times the pending volumes are attached, by name.
+	volumesAttachedAt map[string]time.Time
+	// networkInterfacesAttachedAt are the times the pending network interfaces are attached, by name.
+	networkInterfacesAttachedAt map[string]time.Time
 }
 
 type FakeVolume struct {
@@ -67,6 +111,19 @@ type FakeEvent struct {
 	irievent.Event
 }
 
+// Transitions configures how long the fake runtime takes for state transitions.
+// Without transitions, the fake runtime does not change the state of machines on its own.
+type Transitions struct {
+	// Boot is the time a pending, powered-on machine takes until it runs.
+	Boot time.Duration
+	// VolumeAttach is the time an attached volume stays pending.
+	VolumeAttach time.Duration
+	// NetworkInterfaceAttach is the time an attached network interface stays pending.
+	NetworkInterfaceAttach time.Duration
+	// Termination is the time a deleted machine stays terminating until it is gone.
+	Termination time.Duration
+}
+
 type FakeRuntimeService struct {
 	sync.Mutex
 
@@ -74,11 +131,25 @@ type FakeRuntimeService struct {
 	MachineClassStatus map[string]*FakeMachineClassStatus
 	GetExecURL         func(req *iri.ExecRequest) string
 	Events             []*FakeEvent
+	Capabilities       []iri.RuntimeCapability
+
+	// Faults injects failures and latency into the calls of the runtime.
+	Faults *fault.Injector
+	// Transitions configures the state transitions of the machines. If nil, there are none.
+	Transitions *Transitions
+	// EnforceCapacity makes the runtime reject machines exceeding the quantity of their machine class.
+	EnforceCapacity bool
+	// Clock is the clock of the state transitions.
+	Clock clock.PassiveClock
 }
 
 // ListEvents implements machine.RuntimeService.
 func (r *FakeRuntimeService) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*iri.ListEventsResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_ListEvents_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	var res []*irievent.Event
@@ -86,7 +157,40 @@ func (r *FakeRuntimeService) ListEvents(ctx context.Context, req *iri.ListEvents
 		res = append(res, &e.Event)
 	}
 
-	return &iri.ListEventsResponse{Events: res}, nil
+	res, continueToken, err := paging.PageByOffset(res, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListEventsResponse{Events: res, ContinueToken: continueToken}, nil
+}
+
+// WatchEvents implements machine.RuntimeService.
+func (r *FakeRuntimeService) WatchEvents(ctx context.Context, req *iri.WatchEventsRequest) (iri.MachineRuntime_WatchEventsClient, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_WatchEvents_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	return &fakeWatchEventsClient{
+		ctx: ctx,
+		watcher: fakewatch.NewEventWatcher(ctx, func() []*FakeEvent {
+			r.Lock()
+			defer r.Unlock()
+			return r.Events
+		}),
+	}, nil
+}
+
+// AllRuntimeCapabilities returns all capabilities a runtime can have. It is the default of the fake runtime.
+func AllRuntimeCapabilities() []iri.RuntimeCapability {
+	var capabilities []iri.RuntimeCapability
+	for value := range iri.RuntimeCapability_name {
+		if capability := iri.RuntimeCapability(value); capability != iri.RuntimeCapability_RUNTIME_CAPABILITY_UNSPECIFIED {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	slices.Sort(capabilities)
+	return capabilities
 }
 
 func NewFakeRuntimeService() *FakeRuntimeService {
@@ -94,7 +198,125 @@ func NewFakeRuntimeService() *FakeRuntimeService {
 		Machines:           make(map[string]*FakeMachine),
 		MachineClassStatus: make(map[string]*FakeMachineClassStatus),
 		Events:             []*FakeEvent{},
+		Capabilities:       AllRuntimeCapabilities(),
+		Faults:             fault.NewInjector(),
+		Clock:              clock.RealClock{},
+	}
+}
+
+func (r *FakeRuntimeService) SetTransitions(transitions *Transitions) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Transitions = transitions
+}
+
+func (r *FakeRuntimeService) SetEnforceCapacity(enforceCapacity bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.EnforceCapacity = enforceCapacity
+}
+
+func (r *FakeRuntimeService) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+	return r.Clock.Now()
+}
+
+// lock locks the runtime and applies all state transitions that are due.
+func (r *FakeRuntimeService) lock() {
+	r.Lock()
+	r.advance()
+}
+
+func (r *FakeRuntimeService) advance() {
+	if r.Transitions == nil {
+		return
 	}
+
+	now := r.now()
+	for id, machine := range r.Machines {
+		if !machine.terminatedAt.IsZero() {
+			if !now.Before(machine.terminatedAt) {
+				delete(r.Machines, id)
+			}
+			continue
+		}
+		if machine.Status == nil {
+			continue
+		}
+
+		if !machine.runningAt.IsZero() && !now.Before(machine.runningAt) {
+			machine.runningAt = time.Time{}
+			machine.Status.State = iri.MachineState_MACHINE_RUNNING
+		}
+		for _, volume := range machine.Status.Volumes {
+			if attachedAt, ok := machine.volumesAttachedAt[volume.Name]; ok && !now.Before(attachedAt) {
+				delete(machine.volumesAttachedAt, volume.Name)
+				volume.State = iri.VolumeState_VOLUME_ATTACHED
+			}
+		}
+		for _, nic := range machine.Status.NetworkInterfaces {
+			if attachedAt, ok := machine.networkInterfacesAttachedAt[nic.Name]; ok && !now.Before(attachedAt) {
+				delete(machine.networkInterfacesAttachedAt, nic.Name)
+				nic.State = iri.NetworkInterfaceState_NETWORK_INTERFACE_ATTACHED
+			}
+		}
+	}
+}
+
+// boot makes the machine pending and schedules it to run after the boot delay.
+func (r *FakeRuntimeService) boot(machine *FakeMachine) {
+	machine.Status.State = iri.MachineState_MACHINE_PENDING
+	machine.runningAt = r.now().Add(r.Transitions.Boot)
+}
+
+func (r *FakeRuntimeService) attachVolumeStatus(machine *FakeMachine, volume *iri.Volume) {
+	if machine.volumesAttachedAt == nil {
+		machine.volumesAttachedAt = make(map[string]time.Time)
+	}
+	machine.volumesAttachedAt[volume.Name] = r.now().Add(r.Transitions.VolumeAttach)
+	machine.Status.Volumes = append(machine.Status.Volumes, &iri.VolumeStatus{
+		Name:   volume.Name,
+		Handle: volume.GetConnection().GetHandle(),
+		State:  iri.VolumeState_VOLUME_PENDING,
+	})
+}
+
+func (r *FakeRuntimeService) attachNetworkInterfaceStatus(machine *FakeMachine, nic *iri.NetworkInterface) {
+	if machine.networkInterfacesAttachedAt == nil {
+		machine.networkInterfacesAttachedAt = make(map[string]time.Time)
+	}
+	machine.networkInterfacesAttachedAt[nic.Name] = r.now().Add(r.Transitions.NetworkInterfaceAttach)
+	machine.Status.NetworkInterfaces = append(machine.Status.NetworkInterfaces, &iri.NetworkInterfaceStatus{
+		Name:  nic.Name,
+		State: iri.NetworkInterfaceState_NETWORK_INTERFACE_PENDING,
+	})
+}
+
+// checkCapacity returns a ResourceExhausted error if another machine of the class exceeds its quantity.
+func (r *FakeRuntimeService) checkCapacity(class string) error {
+	if !r.EnforceCapacity {
+		return nil
+	}
+
+	classStatus, ok := r.MachineClassStatus[class]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "machine class %q not supported", class)
+	}
+
+	var used int64
+	for _, machine := range r.Machines {
+		if machine.GetSpec().GetClass() == class && machine.terminatedAt.IsZero() {
+			used++
+		}
+	}
+	if used >= classStatus.Quantity {
+		return status.Errorf(codes.ResourceExhausted, "machine class %q exhausted: %d of %d machines in use", class, used, classStatus.Quantity)
+	}
+	return nil
 }
 
 func (r *FakeRuntimeService) SetMachines(machines []*FakeMachine) {
@@ -131,19 +353,62 @@ func (r *FakeRuntimeService) SetEvents(events []*FakeEvent) {
 	r.Events = events
 }
 
+func (r *FakeRuntimeService) SetCapabilities(capabilities []iri.RuntimeCapability) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Capabilities = capabilities
+}
+
 func (r *FakeRuntimeService) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_Version_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
 	return &iri.VersionResponse{
 		RuntimeName:    FakeRuntimeName,
 		RuntimeVersion: FakeVersion,
+		Capabilities:   slices.Clone(r.Capabilities),
 	}, nil
 }
 
 func (r *FakeRuntimeService) ListMachines(ctx context.Context, req *iri.ListMachinesRequest) (*iri.ListMachinesResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_ListMachines_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
-	filter := req.Filter
+	var machines []*iri.Machine
+	for _, machine := range r.filterMachines(req.Filter) {
+		if matchesStatesAndClasses(req.Filter, machine) {
+			machines = append(machines, machine)
+		}
+	}
+
+	machines, continueToken, err := paging.PageByKey(machines, paging.ObjectID, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
 
+	return &iri.ListMachinesResponse{Machines: machines, ContinueToken: continueToken}, nil
+}
+
+func matchesStatesAndClasses(filter *iri.MachineFilter, machine *iri.Machine) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.States) > 0 && !slices.Contains(filter.States, machine.GetStatus().GetState()) {
+		return false
+	}
+	return len(filter.Classes) == 0 || slices.Contains(filter.Classes, machine.GetSpec().GetClass())
+}
+
+func (r *FakeRuntimeService) filterMachines(filter *iri.MachineFilter) []*iri.Machine {
 	var res []*iri.Machine
 	for _, m := range r.Machines {
 		if filter != nil {
@@ -157,23 +422,65 @@ func (r *FakeRuntimeService) ListMachines(ctx context.Context, req *iri.ListMach
 
 		res = append(res, m.Machine)
 	}
-	return &iri.ListMachinesResponse{Machines: res}, nil
+	return res
+}
+
+// WatchMachines implements machine.RuntimeService.
+// The resource version of the request is ignored, the current machines are always sent first.
+func (r *FakeRuntimeService) WatchMachines(ctx context.Context, req *iri.WatchMachinesRequest) (iri.MachineRuntime_WatchMachinesClient, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_WatchMachines_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	return &fakeWatchMachinesClient{
+		ctx: ctx,
+		watcher: fakewatch.NewObjectWatcher(ctx, func() []*iri.Machine {
+			r.lock()
+			defer r.Unlock()
+
+			var res []*iri.Machine
+			for _, machine := range r.filterMachines(req.Filter) {
+				res = append(res, proto.Clone(machine).(*iri.Machine))
+			}
+			return res
+		}),
+	}, nil
 }
 
 func (r *FakeRuntimeService) CreateMachine(ctx context.Context, req *iri.CreateMachineRequest) (*iri.CreateMachineResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_CreateMachine_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machine := req.Machine
+	if err := r.checkCapacity(machine.GetSpec().GetClass()); err != nil {
+		return nil, err
+	}
+
 	machine.Metadata.Id = generateID(defaultIDLength)
-	machine.Metadata.CreatedAt = time.Now().UnixNano()
+	machine.Metadata.CreatedAt = r.now().UnixNano()
 	machine.Status = &iri.MachineStatus{
 		State: iri.MachineState_MACHINE_PENDING,
 	}
 
-	r.Machines[machine.Metadata.Id] = &FakeMachine{
+	fakeMachine := &FakeMachine{
 		Machine: machine,
 	}
+	if r.Transitions != nil {
+		if machine.Spec.GetPower() == iri.Power_POWER_ON {
+			r.boot(fakeMachine)
+		}
+		for _, volume := range machine.Spec.GetVolumes() {
+			r.attachVolumeStatus(fakeMachine, volume)
+		}
+		for _, nic := range machine.Spec.GetNetworkInterfaces() {
+			r.attachNetworkInterfaceStatus(fakeMachine, nic)
+		}
+	}
+	r.Machines[machine.Metadata.Id] = fakeMachine
 
 	return &iri.CreateMachineResponse{
 		Machine: machine,
@@ -181,20 +488,38 @@ func (r *FakeRuntimeService) CreateMachine(ctx context.Context, req *iri.CreateM
 }
 
 func (r *FakeRuntimeService) DeleteMachine(ctx context.Context, req *iri.DeleteMachineRequest) (*iri.DeleteMachineResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_DeleteMachine_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
-	if _, ok := r.Machines[machineID]; !ok {
+	machine, ok := r.Machines[machineID]
+	if !ok {
 		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
 	}
 
-	delete(r.Machines, machineID)
+	if r.Transitions == nil || machine.Status == nil {
+		delete(r.Machines, machineID)
+		return &iri.DeleteMachineResponse{}, nil
+	}
+
+	if machine.terminatedAt.IsZero() {
+		machine.runningAt = time.Time{}
+		machine.terminatedAt = r.now().Add(r.Transitions.Termination)
+		machine.Status.State = iri.MachineState_MACHINE_TERMINATING
+	}
 	return &iri.DeleteMachineResponse{}, nil
 }
 
 func (r *FakeRuntimeService) UpdateMachineAnnotations(ctx context.Context, req *iri.UpdateMachineAnnotationsRequest) (*iri.UpdateMachineAnnotationsResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_UpdateMachineAnnotations_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
@@ -208,7 +533,11 @@ func (r *FakeRuntimeService) UpdateMachineAnnotations(ctx context.Context, req *
 }
 
 func (r *FakeRuntimeService) UpdateMachinePower(ctx context.Context, req *iri.UpdateMachinePowerRequest) (*iri.UpdateMachinePowerResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_UpdateMachinePower_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
@@ -217,12 +546,114 @@ func (r *FakeRuntimeService) UpdateMachinePower(ctx context.Context, req *iri.Up
 		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
 	}
 
+	if r.Transitions != nil && machine.Status != nil && machine.terminatedAt.IsZero() && machine.Spec.Power != req.Power {
+		switch req.Power {
+		case iri.Power_POWER_ON:
+			r.boot(machine)
+		case iri.Power_POWER_OFF:
+			machine.runningAt = time.Time{}
+			machine.Status.State = iri.MachineState_MACHINE_STOPPED
+		case iri.Power_POWER_SUSPENDED:
+			machine.runningAt = time.Time{}
+			machine.Status.State = iri.MachineState_MACHINE_SUSPENDED
+		}
+	}
+
 	machine.Spec.Power = req.Power
 	return &iri.UpdateMachinePowerResponse{}, nil
 }
 
+func (r *FakeRuntimeService) RebootMachine(ctx context.Context, req *iri.RebootMachineRequest) (*iri.RebootMachineResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_RebootMachine_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	machineID := req.MachineId
+	machine, ok := r.Machines[machineID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+	}
+
+	machine.Reboots = append(machine.Reboots, req.Mode)
+	return &iri.RebootMachineResponse{}, nil
+}
+
+func (r *FakeRuntimeService) UpdateMachineClass(ctx context.Context, req *iri.UpdateMachineClassRequest) (*iri.UpdateMachineClassResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_UpdateMachineClass_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	machineID := req.MachineId
+	machine, ok := r.Machines[machineID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+	}
+
+	if _, ok := r.MachineClassStatus[req.Class]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "machine class %q not supported", req.Class)
+	}
+	if machine.Spec.Class != req.Class {
+		if err := r.checkCapacity(req.Class); err != nil {
+			return nil, err
+		}
+	}
+
+	machine.Spec.Class = req.Class
+	return &iri.UpdateMachineClassResponse{}, nil
+}
+
+func (r *FakeRuntimeService) FreezeMachine(ctx context.Context, req *iri.FreezeMachineRequest) (*iri.FreezeMachineResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_FreezeMachine_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	machineID := req.MachineId
+	machine, ok := r.Machines[machineID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+	}
+
+	if req.TimeoutSeconds <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "timeout has to be greater than zero")
+	}
+
+	machine.FrozenUntil = r.now().Add(time.Duration(req.TimeoutSeconds) * time.Second)
+	return &iri.FreezeMachineResponse{}, nil
+}
+
+func (r *FakeRuntimeService) ThawMachine(ctx context.Context, req *iri.ThawMachineRequest) (*iri.ThawMachineResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_ThawMachine_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	machineID := req.MachineId
+	machine, ok := r.Machines[machineID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "machine %q not found", machineID)
+	}
+
+	machine.FrozenUntil = time.Time{}
+	return &iri.ThawMachineResponse{}, nil
+}
+
 func (r *FakeRuntimeService) AttachVolume(ctx context.Context, req *iri.AttachVolumeRequest) (*iri.AttachVolumeResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_AttachVolume_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
@@ -232,11 +663,18 @@ func (r *FakeRuntimeService) AttachVolume(ctx context.Context, req *iri.AttachVo
 	}
 
 	machine.Spec.Volumes = append(machine.Spec.Volumes, req.Volume)
+	if r.Transitions != nil && machine.Status != nil {
+		r.attachVolumeStatus(machine, req.Volume)
+	}
 	return &iri.AttachVolumeResponse{}, nil
 }
 
 func (r *FakeRuntimeService) DetachVolume(ctx context.Context, req *iri.DetachVolumeRequest) (*iri.DetachVolumeResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_DetachVolume_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
@@ -262,11 +700,21 @@ func (r *FakeRuntimeService) DetachVolume(ctx context.Context, req *iri.DetachVo
 	}
 
 	machine.Spec.Volumes = filtered
+	if machine.Status != nil {
+		machine.Status.Volumes = slices.DeleteFunc(machine.Status.Volumes, func(volume *iri.VolumeStatus) bool {
+			return volume.Name == req.Name
+		})
+	}
+	delete(machine.volumesAttachedAt, req.Name)
 	return &iri.DetachVolumeResponse{}, nil
 }
 
 func (r *FakeRuntimeService) UpdateVolume(ctx context.Context, req *iri.UpdateVolumeRequest) (*iri.UpdateVolumeResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_UpdateVolume_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
@@ -287,7 +735,11 @@ func (r *FakeRuntimeService) UpdateVolume(ctx context.Context, req *iri.UpdateVo
 }
 
 func (r *FakeRuntimeService) AttachNetworkInterface(ctx context.Context, req *iri.AttachNetworkInterfaceRequest) (*iri.AttachNetworkInterfaceResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_AttachNetworkInterface_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
@@ -297,11 +749,18 @@ func (r *FakeRuntimeService) AttachNetworkInterface(ctx context.Context, req *ir
 	}
 
 	machine.Spec.NetworkInterfaces = append(machine.Spec.NetworkInterfaces, req.NetworkInterface)
+	if r.Transitions != nil && machine.Status != nil {
+		r.attachNetworkInterfaceStatus(machine, req.NetworkInterface)
+	}
 	return &iri.AttachNetworkInterfaceResponse{}, nil
 }
 
 func (r *FakeRuntimeService) DetachNetworkInterface(ctx context.Context, req *iri.DetachNetworkInterfaceRequest) (*iri.DetachNetworkInterfaceResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_DetachNetworkInterface_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	machineID := req.MachineId
@@ -327,11 +786,21 @@ func (r *FakeRuntimeService) DetachNetworkInterface(ctx context.Context, req *ir
 	}
 
 	machine.Spec.NetworkInterfaces = filtered
+	if machine.Status != nil {
+		machine.Status.NetworkInterfaces = slices.DeleteFunc(machine.Status.NetworkInterfaces, func(nic *iri.NetworkInterfaceStatus) bool {
+			return nic.Name == req.Name
+		})
+	}
+	delete(machine.networkInterfacesAttachedAt, req.Name)
 	return &iri.DetachNetworkInterfaceResponse{}, nil
 }
 
 func (r *FakeRuntimeService) Status(ctx context.Context, req *iri.StatusRequest) (*iri.StatusResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_Status_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	var res []*iri.MachineClassStatus
@@ -342,7 +811,11 @@ func (r *FakeRuntimeService) Status(ctx context.Context, req *iri.StatusRequest)
 }
 
 func (r *FakeRuntimeService) Exec(ctx context.Context, req *iri.ExecRequest) (*iri.ExecResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_Exec_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	var url string
@@ -351,3 +824,208 @@ func (r *FakeRuntimeService) Exec(ctx context.Context, req *iri.ExecRequest) (*i
 	}
 	return &iri.ExecResponse{Url: url}, nil
 }
+
+func (r *FakeRuntimeService) GetConsoleLog(ctx context.Context, req *iri.GetConsoleLogRequest) (iri.MachineRuntime_GetConsoleLogClient, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_GetConsoleLog_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	machine, ok := r.Machines[req.MachineId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "machine %q not found", req.MachineId)
+	}
+
+	data := tailLines(machine.ConsoleLog, req.TailLines)
+	return &fakeConsoleLogClient{
+		ctx:  ctx,
+		data: bytes.Clone(data),
+	}, nil
+}
+
+func tailLines(data []byte, tail *int64) []byte {
+	if tail == nil {
+		return data
+	}
+	n := *tail
+	if n <= 0 {
+		return nil
+	}
+
+	end := len(data)
+	if end > 0 && data[end-1] == '\n' {
+		end--
+	}
+
+	var count int64
+	for i := end - 1; i >= 0; i-- {
+		if data[i] != '\n' {
+			continue
+		}
+		if count++; count == n {
+			return data[i+1:]
+		}
+	}
+	return data
+}
+
+// fakeConsoleLogClient is a grpc.ServerStreamingClient sending the console log as a single chunk.
+type fakeConsoleLogClient struct {
+	grpc.ClientStream
+
+	ctx  context.Context
+	data []byte
+	sent bool
+}
+
+func (c *fakeConsoleLogClient) Recv() (*iri.GetConsoleLogResponse, error) {
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if c.sent || len(c.data) == 0 {
+		return nil, io.EOF
+	}
+
+	c.sent = true
+	return &iri.GetConsoleLogResponse{Data: c.data}, nil
+}
+
+func (c *fakeConsoleLogClient) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeConsoleLogClient) CloseSend() error {
+	return nil
+}
+
+// fakeWatchMachinesClient is a grpc.ServerStreamingClient sending the changes of the fake machines.
+type fakeWatchMachinesClient struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	watcher *fakewatch.ObjectWatcher[*iri.Machine]
+}
+
+func (c *fakeWatchMachinesClient) Recv() (*iri.WatchMachinesResponse, error) {
+	evt, err := c.watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return &iri.WatchMachinesResponse{
+		Type:            evt.Type,
+		Machine:         evt.Object,
+		ResourceVersion: evt.ResourceVersion,
+	}, nil
+}
+
+func (c *fakeWatchMachinesClient) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeWatchMachinesClient) CloseSend() error {
+	return nil
+}
+
+// fakeWatchEventsClient is a grpc.ServerStreamingClient sending the events added to the fake runtime.
+type fakeWatchEventsClient struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	watcher *fakewatch.EventWatcher[*FakeEvent]
+}
+
+func (c *fakeWatchEventsClient) Recv() (*iri.WatchEventsResponse, error) {
+	evt, resourceVersion, err := c.watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return &iri.WatchEventsResponse{
+		Event:           proto.Clone(&evt.Event).(*irievent.Event),
+		ResourceVersion: resourceVersion,
+	}, nil
+}
+
+func (c *fakeWatchEventsClient) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeWatchEventsClient) CloseSend() error {
+	return nil
+}
+
+func fakeMachineStats(machine *FakeMachine) *iri.MachineStats {
+	stats := &iri.MachineStats{
+		MachineId: machine.Metadata.Id,
+		Timestamp: time.Now().UnixNano(),
+		Cpu: &iri.CpuStats{
+			UsageCoreNanoSeconds: FakeCPUUsageCoreNanoSeconds,
+		},
+		Memory: &iri.MemoryStats{
+			UsageBytes:     FakeMemoryUsageBytes,
+			AvailableBytes: FakeMemoryAvailableBytes,
+		},
+	}
+	for _, volume := range machine.Spec.GetVolumes() {
+		stats.Volumes = append(stats.Volumes, &iri.VolumeStats{
+			Name:       volume.Name,
+			ReadBytes:  FakeVolumeReadBytes,
+			WriteBytes: FakeVolumeWriteBytes,
+			ReadOps:    FakeVolumeReadOps,
+			WriteOps:   FakeVolumeWriteOps,
+		})
+	}
+	for _, nic := range machine.Spec.GetNetworkInterfaces() {
+		stats.NetworkInterfaces = append(stats.NetworkInterfaces, &iri.NetworkInterfaceStats{
+			Name:      nic.Name,
+			RxBytes:   FakeNetworkInterfaceRxBytes,
+			TxBytes:   FakeNetworkInterfaceTxBytes,
+			RxPackets: FakeNetworkInterfaceRxPackets,
+			TxPackets: FakeNetworkInterfaceTxPackets,
+		})
+	}
+	return stats
+}
+
+func (r *FakeRuntimeService) GetMachineStats(ctx context.Context, req *iri.GetMachineStatsRequest) (*iri.GetMachineStatsResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_GetMachineStats_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	machine, ok := r.Machines[req.MachineId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "machine %q not found", req.MachineId)
+	}
+
+	return &iri.GetMachineStatsResponse{Stats: fakeMachineStats(machine)}, nil
+}
+
+func (r *FakeRuntimeService) ListMachineStats(ctx context.Context, req *iri.ListMachineStatsRequest) (*iri.ListMachineStatsResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.MachineRuntime_ListMachineStats_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	filter := req.Filter
+
+	var res []*iri.MachineStats
+	for _, m := range r.Machines {
+		if filter != nil {
+			if filter.Id != "" && filter.Id != m.Metadata.Id {
+				continue
+			}
+			if filter.LabelSelector != nil && !filterInLabels(filter.LabelSelector, m.Metadata.Labels) {
+				continue
+			}
+		}
+
+		res = append(res, fakeMachineStats(m))
+	}
+	return &iri.ListMachineStatsResponse{Stats: res}, nil
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machine_test
+
+import (
+	"time"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/fault"
+	. "github.com/ironcore-dev/ironcore/iri/testing/machine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("FakeRuntimeService", func() {
+	var (
+		runtime *FakeRuntimeService
+		clock   *testingclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		clock = testingclock.NewFakeClock(time.Unix(0, 0))
+		runtime = NewFakeRuntimeService()
+		runtime.Clock = clock
+		runtime.SetMachineClasses([]*FakeMachineClassStatus{
+			{
+				MachineClassStatus: iri.MachineClassStatus{
+					MachineClass: &iri.MachineClass{Name: "small"},
+					Quantity:     1,
+				},
+			},
+		})
+	})
+
+	createMachine := func(ctx SpecContext, class string) (*iri.Machine, error) {
+		res, err := runtime.CreateMachine(ctx, &iri.CreateMachineRequest{
+			Machine: &iri.Machine{
+				Metadata: &irimeta.ObjectMetadata{},
+				Spec: &iri.MachineSpec{
+					Power: iri.Power_POWER_ON,
+					Class: class,
+					Volumes: []*iri.Volume{
+						{Name: "root", Connection: &iri.VolumeConnection{Driver: "fake", Handle: "root-handle"}},
+					},
+				},
+			},
+		})
+		return res.GetMachine(), err
+	}
+
+	getMachine := func(ctx SpecContext, id string) *iri.Machine {
+		res, err := runtime.ListMachines(ctx, &iri.ListMachinesRequest{Filter: &iri.MachineFilter{Id: id}})
+		Expect(err).NotTo(HaveOccurred())
+		if len(res.Machines) == 0 {
+			return nil
+		}
+		return res.Machines[0]
+	}
+
+	It("should not change the machine state without transitions", func(ctx SpecContext) {
+		machine, err := createMachine(ctx, "small")
+		Expect(err).NotTo(HaveOccurred())
+
+		clock.Step(time.Hour)
+		Expect(getMachine(ctx, machine.Metadata.Id).Status.State).To(Equal(iri.MachineState_MACHINE_PENDING))
+
+		_, err = runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: machine.Metadata.Id})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getMachine(ctx, machine.Metadata.Id)).To(BeNil())
+	})
+
+	It("should boot, attach, stop and terminate the machine after the delays", func(ctx SpecContext) {
+		runtime.SetTransitions(&Transitions{
+			Boot:         3 * time.Second,
+			VolumeAttach: time.Second,
+			Termination:  2 * time.Second,
+		})
+
+		machine, err := createMachine(ctx, "small")
+		Expect(err).NotTo(HaveOccurred())
+		id := machine.Metadata.Id
+
+		machine = getMachine(ctx, id)
+		Expect(machine.Status.State).To(Equal(iri.MachineState_MACHINE_PENDING))
+		Expect(machine.Status.Volumes).To(ConsistOf(HaveField("State", iri.VolumeState_VOLUME_PENDING)))
+
+		clock.Step(time.Second)
+		machine = getMachine(ctx, id)
+		Expect(machine.Status.State).To(Equal(iri.MachineState_MACHINE_PENDING))
+		Expect(machine.Status.Volumes).To(ConsistOf(SatisfyAll(
+			HaveField("Handle", "root-handle"),
+			HaveField("State", iri.VolumeState_VOLUME_ATTACHED),
+		)))
+
+		clock.Step(2 * time.Second)
+		Expect(getMachine(ctx, id).Status.State).To(Equal(iri.MachineState_MACHINE_RUNNING))
+
+		_, err = runtime.UpdateMachinePower(ctx, &iri.UpdateMachinePowerRequest{MachineId: id, Power: iri.Power_POWER_OFF})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getMachine(ctx, id).Status.State).To(Equal(iri.MachineState_MACHINE_STOPPED))
+
+		_, err = runtime.DeleteMachine(ctx, &iri.DeleteMachineRequest{MachineId: id})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getMachine(ctx, id).Status.State).To(Equal(iri.MachineState_MACHINE_TERMINATING))
+
+		clock.Step(2 * time.Second)
+		Expect(getMachine(ctx, id)).To(BeNil())
+	})
+
+	It("should reject machines exceeding the quantity of their class", func(ctx SpecContext) {
+		runtime.SetEnforceCapacity(true)
+
+		_, err := createMachine(ctx, "small")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = createMachine(ctx, "small")
+		Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+	})
+
+	It("should freeze and thaw the machine", func(ctx SpecContext) {
+		machine, err := createMachine(ctx, "small")
+		Expect(err).NotTo(HaveOccurred())
+		id := machine.Metadata.Id
+
+		_, err = runtime.FreezeMachine(ctx, &iri.FreezeMachineRequest{MachineId: id, TimeoutSeconds: 30})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runtime.Machines[id].FrozenUntil).To(Equal(clock.Now().Add(30 * time.Second)))
+
+		_, err = runtime.ThawMachine(ctx, &iri.ThawMachineRequest{MachineId: id})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runtime.Machines[id].FrozenUntil).To(BeZero())
+
+		_, err = runtime.FreezeMachine(ctx, &iri.FreezeMachineRequest{MachineId: id})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		_, err = runtime.ThawMachine(ctx, &iri.ThawMachineRequest{MachineId: "unknown"})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("should fail calls with injected faults", func(ctx SpecContext) {
+		runtime.Faults.FailNext(iri.MachineRuntime_CreateMachine_FullMethodName, fault.Error(codes.Unavailable))
+
+		_, err := createMachine(ctx, "small")
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+
+		_, err = createMachine(ctx, "small")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package machine
+
+import (
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/internal/fakeserver"
+	"google.golang.org/grpc"
+)
+
+type unimplementedServer struct {
+	iri.UnimplementedMachineRuntimeServer
+}
+
+// Server serves a FakeRuntimeService via gRPC.
+type Server struct {
+	*FakeRuntimeService
+	unimplementedServer
+}
+
+var _ iri.MachineRuntimeServer = (*Server)(nil)
+
+// NewServer creates a new Server serving the given runtime.
+func NewServer(runtime *FakeRuntimeService) *Server {
+	return &Server{FakeRuntimeService: runtime}
+}
+
+func (s *Server) WatchEvents(req *iri.WatchEventsRequest, server grpc.ServerStreamingServer[iri.WatchEventsResponse]) error {
+	client, err := s.FakeRuntimeService.WatchEvents(server.Context(), req)
+	return fakeserver.Forward(client, err, server)
+}
+
+func (s *Server) WatchMachines(req *iri.WatchMachinesRequest, server grpc.ServerStreamingServer[iri.WatchMachinesResponse]) error {
+	client, err := s.FakeRuntimeService.WatchMachines(server.Context(), req)
+	return fakeserver.Forward(client, err, server)
+}
+
+func (s *Server) GetConsoleLog(req *iri.GetConsoleLogRequest, server grpc.ServerStreamingServer[iri.GetConsoleLogResponse]) error {
+	client, err := s.FakeRuntimeService.GetConsoleLog(server.Context(), req)
+	return fakeserver.Forward(client, err, server)
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package objectstorage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// AccessKeyIDKey is the key of the access key id in the secret data of a bucket.
+	AccessKeyIDKey = "AccessKeyID"
+	// SecretAccessKeyKey is the key of the secret access key in the secret data of a bucket.
+	SecretAccessKeyKey = "SecretAccessKey"
+
+	// DefaultRegion is the region requests are signed for.
+	DefaultRegion = "us-east-1"
+)
+
+// Error is an error response of the object storage.
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("object storage error %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound reports whether the error is an error response of the object storage for a missing object.
+func IsNotFound(err error) bool {
+	var objectStorageErr *Error
+	return errors.As(err, &objectStorageErr) && objectStorageErr.StatusCode == http.StatusNotFound
+}
+
+// IsForbidden reports whether the error is an error response of the object storage for invalid credentials.
+func IsForbidden(err error) bool {
+	var objectStorageErr *Error
+	return errors.As(err, &objectStorageErr) && objectStorageErr.StatusCode == http.StatusForbidden
+}
+
+// Client is a minimal client of the object API of a bucket of an S3-compatible object storage.
+type Client struct {
+	endpoint        *url.URL
+	accessKeyID     string
+	secretAccessKey string
+
+	// HTTPClient is the client to do requests with. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewClient creates a new Client for the bucket with the given endpoint and the credentials in the given secret data.
+// Endpoints without scheme are accessed via https.
+func NewClient(endpoint string, secretData map[string][]byte) (*Client, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("must specify endpoint")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing endpoint: %w", err)
+	}
+
+	accessKeyID, secretAccessKey := string(secretData[AccessKeyIDKey]), string(secretData[SecretAccessKeyKey])
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, fmt.Errorf("secret data has to contain %s and %s", AccessKeyIDKey, SecretAccessKeyKey)
+	}
+
+	return &Client{
+		endpoint:        u,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+	}, nil
+}
+
+func (c *Client) do(ctx context.Context, method, key string, body []byte) ([]byte, error) {
+	u := c.endpoint.JoinPath(key)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	sign(req, c.accessKeyID, c.secretAccessKey, DefaultRegion, time.Now())
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if res.StatusCode >= http.StatusMultipleChoices {
+		errRes := &errorResponse{}
+		_ = xml.Unmarshal(data, errRes)
+		return nil, &Error{StatusCode: res.StatusCode, Code: errRes.Code, Message: errRes.Message}
+	}
+	return data, nil
+}
+
+// PutObject writes the object with the given key.
+func (c *Client) PutObject(ctx context.Context, key string, data []byte) error {
+	_, err := c.do(ctx, http.MethodPut, key, data)
+	return err
+}
+
+// GetObject reads the object with the given key.
+func (c *Client) GetObject(ctx context.Context, key string) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, key, nil)
+}
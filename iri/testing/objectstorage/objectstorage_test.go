@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package objectstorage_test
+
+import (
+	"net/http/httptest"
+
+	. "github.com/ironcore-dev/ironcore/iri/testing/objectstorage"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ObjectStorage", func() {
+	var (
+		srv        *Server
+		endpoint   string
+		secretData map[string][]byte
+	)
+
+	BeforeEach(func() {
+		srv = NewServer()
+		srv.AddCredentials("foo", "bar")
+		httpSrv := httptest.NewServer(srv)
+		DeferCleanup(httpSrv.Close)
+
+		endpoint = httpSrv.URL
+		secretData = map[string][]byte{
+			AccessKeyIDKey:     []byte("foo"),
+			SecretAccessKeyKey: []byte("bar"),
+		}
+	})
+
+	It("should put and get objects", func(ctx SpecContext) {
+		c, err := NewClient(endpoint, secretData)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("putting an object")
+		Expect(c.PutObject(ctx, "backups/my backup", []byte("data"))).To(Succeed())
+		Expect(srv.Keys()).To(Equal([]string{"backups/my backup"}))
+
+		By("getting the object")
+		Expect(c.GetObject(ctx, "backups/my backup")).To(Equal([]byte("data")))
+
+		By("getting a missing object")
+		_, err = c.GetObject(ctx, "backups/missing")
+		Expect(IsNotFound(err)).To(BeTrue(), "expected not found error but got %v", err)
+	})
+
+	It("should reject requests with invalid credentials", func(ctx SpecContext) {
+		By("using an unknown access key id")
+		c, err := NewClient(endpoint, map[string][]byte{
+			AccessKeyIDKey:     []byte("unknown"),
+			SecretAccessKeyKey: []byte("bar"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsForbidden(c.PutObject(ctx, "foo", []byte("data")))).To(BeTrue())
+
+		By("using a wrong secret access key")
+		c, err = NewClient(endpoint, map[string][]byte{
+			AccessKeyIDKey:     []byte("foo"),
+			SecretAccessKeyKey: []byte("wrong"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsForbidden(c.PutObject(ctx, "foo", []byte("data")))).To(BeTrue())
+
+		Expect(srv.Keys()).To(BeEmpty())
+	})
+
+	It("should require credentials", func() {
+		_, err := NewClient(endpoint, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package objectstorage provides an in-memory stand-in for buckets of S3-compatible object storages and a minimal
+// client for them, so that volume backups can be tested without an object storage service.
+package objectstorage
+
+import (
+	"crypto/hmac"
+	"encoding/xml"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Server is an in-memory stand-in for the object API of a bucket of an S3-compatible object storage.
+// The endpoint of the server is the endpoint of the bucket, i.e. objects are addressed by the request path.
+// Requests have to be signed with AWS signature version 4 using credentials added via AddCredentials.
+type Server struct {
+	mu sync.RWMutex
+
+	credentials map[string]string
+	objects     map[string][]byte
+}
+
+// NewServer creates a new Server without objects and credentials.
+func NewServer() *Server {
+	return &Server{
+		credentials: make(map[string]string),
+		objects:     make(map[string][]byte),
+	}
+}
+
+// AddCredentials allows requests signed with the given credentials.
+func (s *Server) AddCredentials(accessKeyID, secretAccessKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.credentials[accessKeyID] = secretAccessKey
+}
+
+// Object returns the data of the object with the given key, if it exists.
+func (s *Server) Object(key string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data, ok := s.objects[key]
+	return slices.Clone(data), ok
+}
+
+// Keys returns the sorted keys of all objects.
+func (s *Server) Keys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return slices.Sorted(maps.Keys(s.objects))
+}
+
+type errorResponse struct {
+	XMLName xml.Name `xml:"Error"`
+	Code    string   `xml:"Code"`
+	Message string   `xml:"Message"`
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(statusCode)
+	_ = xml.NewEncoder(w).Encode(errorResponse{Code: code, Message: message})
+}
+
+func (s *Server) authenticate(req *http.Request) (int, string, string) {
+	auth, err := parseAuthorization(req.Header.Get("Authorization"))
+	if err != nil {
+		return http.StatusForbidden, "AccessDenied", err.Error()
+	}
+
+	s.mu.RLock()
+	secretAccessKey, ok := s.credentials[auth.accessKeyID]
+	s.mu.RUnlock()
+	if !ok {
+		return http.StatusForbidden, "InvalidAccessKeyId", "The access key id does not exist."
+	}
+
+	expected := signature(req, auth.signedHeaders, secretAccessKey, auth.date, auth.region)
+	if !hmac.Equal([]byte(expected), []byte(auth.signature)) {
+		return http.StatusForbidden, "SignatureDoesNotMatch", "The request signature does not match."
+	}
+	return http.StatusOK, "", ""
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if statusCode, code, message := s.authenticate(req); statusCode != http.StatusOK {
+		writeError(w, statusCode, code, message)
+		return
+	}
+
+	key := strings.TrimPrefix(req.URL.Path, "/")
+	if key == "" {
+		writeError(w, http.StatusBadRequest, "InvalidRequest", "Bucket operations are not supported.")
+		return
+	}
+
+	switch req.Method {
+	case http.MethodPut:
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+
+		s.mu.Lock()
+		s.objects[key] = data
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.Object(key)
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		if req.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case http.MethodDelete:
+		s.mu.Lock()
+		delete(s.objects, key)
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", "The method is not allowed.")
+	}
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package objectstorage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	signatureAlgorithm = "AWS4-HMAC-SHA256"
+	signatureService   = "s3"
+	amzDateFormat      = "20060102T150405Z"
+	unsignedPayload    = "UNSIGNED-PAYLOAD"
+
+	amzDateHeader          = "X-Amz-Date"
+	amzContentSHA256Header = "X-Amz-Content-Sha256"
+)
+
+// signedHeaders are the headers the client signs.
+var signedHeaders = []string{"host", "x-amz-content-sha256", "x-amz-date"}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func requestHost(req *http.Request) string {
+	if req.Host != "" {
+		return req.Host
+	}
+	return req.URL.Host
+}
+
+func canonicalRequest(req *http.Request, headers []string) string {
+	var canonicalHeaders strings.Builder
+	for _, header := range headers {
+		value := req.Header.Get(header)
+		if header == "host" {
+			value = requestHost(req)
+		}
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", header, strings.TrimSpace(value))
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		strings.Join(headers, ";"),
+		req.Header.Get(amzContentSHA256Header),
+	}, "\n")
+}
+
+// signature computes the AWS signature version 4 of the request.
+func signature(req *http.Request, headers []string, secretAccessKey, date, region string) string {
+	scope := strings.Join([]string{date, region, signatureService, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		signatureAlgorithm,
+		req.Header.Get(amzDateHeader),
+		scope,
+		sha256Hex(canonicalRequest(req, headers)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, signatureService)
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// sign signs the request with AWS signature version 4, leaving its payload unsigned.
+func sign(req *http.Request, accessKeyID, secretAccessKey, region string, now time.Time) {
+	now = now.UTC()
+	date := now.Format("20060102")
+	req.Header.Set(amzDateHeader, now.Format(amzDateFormat))
+	req.Header.Set(amzContentSHA256Header, unsignedPayload)
+
+	sig := signature(req, signedHeaders, secretAccessKey, date, region)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s/%s/%s/aws4_request, SignedHeaders=%s, Signature=%s",
+		signatureAlgorithm, accessKeyID, date, region, signatureService, strings.Join(signedHeaders, ";"), sig))
+}
+
+// authorization is a parsed AWS signature version 4 authorization header.
+type authorization struct {
+	accessKeyID   string
+	date          string
+	region        string
+	signedHeaders []string
+	signature     string
+}
+
+func parseAuthorization(header string) (*authorization, error) {
+	params, ok := strings.CutPrefix(header, signatureAlgorithm+" ")
+	if !ok {
+		return nil, fmt.Errorf("unsupported authorization algorithm")
+	}
+
+	auth := &authorization{}
+	for _, param := range strings.Split(params, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			return nil, fmt.Errorf("malformed authorization parameter %q", param)
+		}
+
+		switch key {
+		case "Credential":
+			parts := strings.Split(value, "/")
+			if len(parts) != 5 || parts[3] != signatureService || parts[4] != "aws4_request" {
+				return nil, fmt.Errorf("malformed credential %q", value)
+			}
+			auth.accessKeyID, auth.date, auth.region = parts[0], parts[1], parts[2]
+		case "SignedHeaders":
+			auth.signedHeaders = strings.Split(value, ";")
+		case "Signature":
+			auth.signature = value
+		}
+	}
+	if auth.accessKeyID == "" || auth.signature == "" || !slices.Contains(auth.signedHeaders, "host") {
+		return nil, fmt.Errorf("incomplete authorization")
+	}
+	return auth, nil
+}
@@ -5,15 +5,24 @@ package volume
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/ironcore-dev/ironcore/broker/common/idgen"
 	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/paging"
+	"github.com/ironcore-dev/ironcore/iri/testing/fault"
+	"github.com/ironcore-dev/ironcore/iri/testing/internal/fakewatch"
+	"github.com/ironcore-dev/ironcore/iri/testing/objectstorage"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/clock"
 )
 
 var (
@@ -30,10 +39,26 @@ func filterInLabels(labelSelector, lbls map[string]string) bool {
 
 type FakeVolume struct {
 	*iri.Volume
+
+	// Reverts are the IDs of the volume snapshots the volume was reverted to, in order.
+	Reverts []string
+
+	// RestoredVolumeSnapshotID is the ID of the volume snapshot whose backup the volume was created from, if any.
+	RestoredVolumeSnapshotID string
+
+	// availableAt is the time the pending volume becomes available, if it is provisioned.
+	availableAt time.Time
 }
 
 type FakeVolumeSnapshot struct {
 	*iri.VolumeSnapshot
+
+	// readyAt is the time the pending volume snapshot becomes ready, if it is taken.
+	readyAt time.Time
+}
+
+type FakeVolumeSnapshotExport struct {
+	*iri.VolumeSnapshotExport
 }
 
 type FakeVolumeClassStatus struct {
@@ -48,21 +73,139 @@ type FakeRuntimeService struct {
 
 	idGen idgen.IDGen
 
-	Volumes             map[string]*FakeVolume
-	VolumeSnapshots     map[string]*FakeVolumeSnapshot
-	VolumeClassesStatus map[string]*FakeVolumeClassStatus
-	Events              []*FakeEvent
+	Volumes         map[string]*FakeVolume
+	VolumeSnapshots map[string]*FakeVolumeSnapshot
+	// VolumeSnapshotExports are the exports started via ExportVolumeSnapshot.
+	VolumeSnapshotExports map[string]*FakeVolumeSnapshotExport
+	VolumeClassesStatus   map[string]*FakeVolumeClassStatus
+	Events                []*FakeEvent
+	Capabilities          []iri.RuntimeCapability
+
+	// Faults injects failures and latency into the calls of the runtime.
+	Faults *fault.Injector
+	// Transitions configures the state transitions of the volumes and volume snapshots. If nil, there are none.
+	Transitions *Transitions
+	// EnforceCapacity makes the runtime reject volumes exceeding the quantity of their volume class.
+	EnforceCapacity bool
+	// Clock is the clock of the state transitions.
+	Clock clock.PassiveClock
+}
+
+// Transitions configures how long the fake runtime takes for state transitions.
+// Without transitions, the fake runtime does not change the state of volumes on its own.
+type Transitions struct {
+	// Provisioning is the time a created volume stays pending until it is available.
+	Provisioning time.Duration
+	// Snapshot is the time a created volume snapshot stays pending until it is ready.
+	Snapshot time.Duration
+}
+
+// AllRuntimeCapabilities returns all capabilities a runtime can have. It is the default of the fake runtime.
+func AllRuntimeCapabilities() []iri.RuntimeCapability {
+	var capabilities []iri.RuntimeCapability
+	for value := range iri.RuntimeCapability_name {
+		if capability := iri.RuntimeCapability(value); capability != iri.RuntimeCapability_RUNTIME_CAPABILITY_UNSPECIFIED {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	slices.Sort(capabilities)
+	return capabilities
 }
 
 func NewFakeRuntimeService() *FakeRuntimeService {
 	return &FakeRuntimeService{
 		idGen: idgen.Default,
 
-		Volumes:             make(map[string]*FakeVolume),
-		VolumeSnapshots:     make(map[string]*FakeVolumeSnapshot),
-		VolumeClassesStatus: make(map[string]*FakeVolumeClassStatus),
-		Events:              []*FakeEvent{},
+		Volumes:               make(map[string]*FakeVolume),
+		VolumeSnapshots:       make(map[string]*FakeVolumeSnapshot),
+		VolumeSnapshotExports: make(map[string]*FakeVolumeSnapshotExport),
+		VolumeClassesStatus:   make(map[string]*FakeVolumeClassStatus),
+		Events:                []*FakeEvent{},
+		Capabilities:          AllRuntimeCapabilities(),
+		Faults:                fault.NewInjector(),
+		Clock:                 clock.RealClock{},
+	}
+}
+
+func (r *FakeRuntimeService) SetTransitions(transitions *Transitions) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Transitions = transitions
+}
+
+func (r *FakeRuntimeService) SetEnforceCapacity(enforceCapacity bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.EnforceCapacity = enforceCapacity
+}
+
+func (r *FakeRuntimeService) now() time.Time {
+	if r.Clock == nil {
+		return time.Now()
+	}
+	return r.Clock.Now()
+}
+
+// lock locks the runtime and applies all state transitions that are due.
+func (r *FakeRuntimeService) lock() {
+	r.Lock()
+	r.advance()
+}
+
+func (r *FakeRuntimeService) advance() {
+	if r.Transitions == nil {
+		return
+	}
+
+	now := r.now()
+	for _, volume := range r.Volumes {
+		if volume.availableAt.IsZero() || now.Before(volume.availableAt) || volume.Status == nil {
+			continue
+		}
+
+		volume.availableAt = time.Time{}
+		volume.Status.State = iri.VolumeState_VOLUME_AVAILABLE
+		volume.Status.Resources = &iri.VolumeResources{
+			StorageBytes: volume.GetSpec().GetResources().GetStorageBytes(),
+		}
+	}
+	for _, volumeSnapshot := range r.VolumeSnapshots {
+		if volumeSnapshot.readyAt.IsZero() || now.Before(volumeSnapshot.readyAt) || volumeSnapshot.Status == nil {
+			continue
+		}
+
+		volumeSnapshot.readyAt = time.Time{}
+		volumeSnapshot.Status.State = iri.VolumeSnapshotState_VOLUME_SNAPSHOT_READY
+		if volume, ok := r.Volumes[volumeSnapshot.GetSpec().GetVolumeId()]; ok {
+			volumeSnapshot.Status.Size = volume.GetSpec().GetResources().GetStorageBytes()
+		}
+	}
+}
+
+// checkCapacity returns a ResourceExhausted error if the additional storage bytes exceed the quantity of the class.
+// The volume with the given id, if any, is not counted.
+func (r *FakeRuntimeService) checkCapacity(class, volumeID string, storageBytes int64) error {
+	if !r.EnforceCapacity {
+		return nil
+	}
+
+	classStatus, ok := r.VolumeClassesStatus[class]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "volume class %q not supported", class)
+	}
+
+	var used int64
+	for id, volume := range r.Volumes {
+		if id != volumeID && volume.GetSpec().GetClass() == class {
+			used += volume.GetSpec().GetResources().GetStorageBytes()
+		}
 	}
+	if used+storageBytes > classStatus.Quantity {
+		return status.Errorf(codes.ResourceExhausted, "volume class %q exhausted: %d of %d bytes in use, %d requested", class, used, classStatus.Quantity, storageBytes)
+	}
+	return nil
 }
 
 func (r *FakeRuntimeService) SetVolumes(volumes []*FakeVolume) {
@@ -85,6 +228,16 @@ func (r *FakeRuntimeService) SetVolumeSnapshots(volumeSnapshots []*FakeVolumeSna
 	}
 }
 
+func (r *FakeRuntimeService) SetVolumeSnapshotExports(volumeSnapshotExports []*FakeVolumeSnapshotExport) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.VolumeSnapshotExports = make(map[string]*FakeVolumeSnapshotExport)
+	for _, volumeSnapshotExport := range volumeSnapshotExports {
+		r.VolumeSnapshotExports[volumeSnapshotExport.Id] = volumeSnapshotExport
+	}
+}
+
 func (r *FakeRuntimeService) SetVolumeClasses(volumeClassStatus []*FakeVolumeClassStatus) {
 	r.Lock()
 	defer r.Unlock()
@@ -104,7 +257,11 @@ func (r *FakeRuntimeService) SetEvents(events []*FakeEvent) {
 
 // ListEvents implements volume.RuntimeService.
 func (r *FakeRuntimeService) ListEvents(ctx context.Context, req *iri.ListEventsRequest) (*iri.ListEventsResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_ListEvents_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	var res []*irievent.Event
@@ -112,21 +269,86 @@ func (r *FakeRuntimeService) ListEvents(ctx context.Context, req *iri.ListEvents
 		res = append(res, &e.Event)
 	}
 
-	return &iri.ListEventsResponse{Events: res}, nil
+	res, continueToken, err := paging.PageByOffset(res, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &iri.ListEventsResponse{Events: res, ContinueToken: continueToken}, nil
+}
+
+// WatchEvents implements volume.RuntimeService.
+func (r *FakeRuntimeService) WatchEvents(ctx context.Context, req *iri.WatchEventsRequest) (iri.VolumeRuntime_WatchEventsClient, error) {
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_WatchEvents_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	return &fakeWatchEventsClient{
+		ctx: ctx,
+		watcher: fakewatch.NewEventWatcher(ctx, func() []*FakeEvent {
+			r.Lock()
+			defer r.Unlock()
+			return r.Events
+		}),
+	}, nil
+}
+
+func (r *FakeRuntimeService) SetCapabilities(capabilities []iri.RuntimeCapability) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.Capabilities = capabilities
 }
+
 func (r *FakeRuntimeService) Version(ctx context.Context, req *iri.VersionRequest) (*iri.VersionResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_Version_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
 	return &iri.VersionResponse{
 		RuntimeName:    FakeRuntimeName,
 		RuntimeVersion: FakeVersion,
+		Capabilities:   slices.Clone(r.Capabilities),
 	}, nil
 }
 
 func (r *FakeRuntimeService) ListVolumes(ctx context.Context, req *iri.ListVolumesRequest) (*iri.ListVolumesResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_ListVolumes_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
-	filter := req.Filter
+	var volumes []*iri.Volume
+	for _, volume := range r.filterVolumes(req.Filter) {
+		if matchesStatesAndClasses(req.Filter, volume) {
+			volumes = append(volumes, volume)
+		}
+	}
+
+	volumes, continueToken, err := paging.PageByKey(volumes, paging.ObjectID, req.Limit, req.ContinueToken)
+	if err != nil {
+		return nil, err
+	}
 
+	return &iri.ListVolumesResponse{Volumes: volumes, ContinueToken: continueToken}, nil
+}
+
+func matchesStatesAndClasses(filter *iri.VolumeFilter, volume *iri.Volume) bool {
+	if filter == nil {
+		return true
+	}
+	if len(filter.States) > 0 && !slices.Contains(filter.States, volume.GetStatus().GetState()) {
+		return false
+	}
+	return len(filter.Classes) == 0 || slices.Contains(filter.Classes, volume.GetSpec().GetClass())
+}
+
+func (r *FakeRuntimeService) filterVolumes(filter *iri.VolumeFilter) []*iri.Volume {
 	var res []*iri.Volume
 	for _, v := range r.Volumes {
 		if filter != nil {
@@ -138,24 +360,83 @@ func (r *FakeRuntimeService) ListVolumes(ctx context.Context, req *iri.ListVolum
 			}
 		}
 
-		volume := v.Volume
-		res = append(res, volume)
+		res = append(res, v.Volume)
+	}
+	return res
+}
+
+// WatchVolumes implements volume.RuntimeService.
+// The resource version of the request is ignored, the current volumes are always sent first.
+func (r *FakeRuntimeService) WatchVolumes(ctx context.Context, req *iri.WatchVolumesRequest) (iri.VolumeRuntime_WatchVolumesClient, error) {
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_WatchVolumes_FullMethodName); err != nil {
+		return nil, err
 	}
-	return &iri.ListVolumesResponse{Volumes: res}, nil
+
+	return &fakeWatchVolumesClient{
+		ctx: ctx,
+		watcher: fakewatch.NewObjectWatcher(ctx, func() []*iri.Volume {
+			r.lock()
+			defer r.Unlock()
+
+			var res []*iri.Volume
+			for _, volume := range r.filterVolumes(req.Filter) {
+				res = append(res, proto.Clone(volume).(*iri.Volume))
+			}
+			return res
+		}),
+	}, nil
 }
 
 func (r *FakeRuntimeService) CreateVolume(ctx context.Context, req *iri.CreateVolumeRequest) (*iri.CreateVolumeResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_CreateVolume_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	var backup *fakeBackup
+	if backupDataSource := req.Volume.GetSpec().GetVolumeDataSource().GetBackupDataSource(); backupDataSource != nil {
+		var err error
+		if backup, err = getFakeBackup(ctx, backupDataSource.GetLocation()); err != nil {
+			return nil, err
+		}
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	volume := req.Volume
+	if err := r.checkCapacity(volume.GetSpec().GetClass(), "", volume.GetSpec().GetResources().GetStorageBytes()); err != nil {
+		return nil, err
+	}
+	if clone := volume.GetSpec().GetVolumeDataSource().GetCloneDataSource(); clone != nil {
+		source, ok := r.Volumes[clone.VolumeId]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "source volume %q not found", clone.VolumeId)
+		}
+		if volume.Spec.Resources.GetStorageBytes() < source.Spec.GetResources().GetStorageBytes() {
+			return nil, status.Errorf(codes.InvalidArgument, "volume storage %d is less than storage %d of source volume %q",
+				volume.Spec.Resources.GetStorageBytes(), source.Spec.GetResources().GetStorageBytes(), clone.VolumeId)
+		}
+	}
+
+	if backup != nil && volume.Spec.Resources.GetStorageBytes() < backup.StorageBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "volume storage %d is less than storage %d of backup",
+			volume.Spec.Resources.GetStorageBytes(), backup.StorageBytes)
+	}
+
 	volume.Metadata.Id = r.idGen.Generate()
-	volume.Metadata.CreatedAt = time.Now().UnixNano()
+	volume.Metadata.CreatedAt = r.now().UnixNano()
 	volume.Status = &iri.VolumeStatus{}
 
-	r.Volumes[volume.Metadata.Id] = &FakeVolume{
+	fakeVolume := &FakeVolume{
 		Volume: volume,
 	}
+	if backup != nil {
+		fakeVolume.RestoredVolumeSnapshotID = backup.VolumeSnapshotID
+	}
+	if r.Transitions != nil {
+		fakeVolume.availableAt = r.now().Add(r.Transitions.Provisioning)
+	}
+	r.Volumes[volume.Metadata.Id] = fakeVolume
 
 	return &iri.CreateVolumeResponse{
 		Volume: volume,
@@ -163,13 +444,20 @@ func (r *FakeRuntimeService) CreateVolume(ctx context.Context, req *iri.CreateVo
 }
 
 func (r *FakeRuntimeService) ExpandVolume(ctx context.Context, req *iri.ExpandVolumeRequest) (*iri.ExpandVolumeResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_ExpandVolume_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	volume, ok := r.Volumes[req.VolumeId]
 	if !ok {
 		return nil, status.Errorf(codes.NotFound, "volume %q not found", req.VolumeId)
 	}
+	if err := r.checkCapacity(volume.Spec.Class, req.VolumeId, req.Resources.StorageBytes); err != nil {
+		return nil, err
+	}
 
 	volume.Spec.Resources.StorageBytes = req.Resources.StorageBytes
 
@@ -180,8 +468,39 @@ func (r *FakeRuntimeService) ExpandVolume(ctx context.Context, req *iri.ExpandVo
 	return &iri.ExpandVolumeResponse{}, nil
 }
 
+func (r *FakeRuntimeService) RevertVolume(ctx context.Context, req *iri.RevertVolumeRequest) (*iri.RevertVolumeResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_RevertVolume_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	volume, ok := r.Volumes[req.VolumeId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "volume %q not found", req.VolumeId)
+	}
+	volumeSnapshot, ok := r.VolumeSnapshots[req.VolumeSnapshotId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "volume snapshot %q not found", req.VolumeSnapshotId)
+	}
+	if volumeSnapshot.GetSpec().GetVolumeId() != req.VolumeId {
+		return nil, status.Errorf(codes.InvalidArgument, "volume snapshot %q is not a snapshot of volume %q", req.VolumeSnapshotId, req.VolumeId)
+	}
+	if volumeSnapshot.GetStatus().GetState() != iri.VolumeSnapshotState_VOLUME_SNAPSHOT_READY {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume snapshot %q is not ready", req.VolumeSnapshotId)
+	}
+
+	volume.Reverts = append(volume.Reverts, req.VolumeSnapshotId)
+	return &iri.RevertVolumeResponse{}, nil
+}
+
 func (r *FakeRuntimeService) DeleteVolume(ctx context.Context, req *iri.DeleteVolumeRequest) (*iri.DeleteVolumeResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_DeleteVolume_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	volumeID := req.VolumeId
@@ -194,7 +513,11 @@ func (r *FakeRuntimeService) DeleteVolume(ctx context.Context, req *iri.DeleteVo
 }
 
 func (r *FakeRuntimeService) ListVolumeSnapshots(ctx context.Context, req *iri.ListVolumeSnapshotsRequest) (*iri.ListVolumeSnapshotsResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_ListVolumeSnapshots_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	filter := req.Filter
@@ -217,17 +540,25 @@ func (r *FakeRuntimeService) ListVolumeSnapshots(ctx context.Context, req *iri.L
 }
 
 func (r *FakeRuntimeService) CreateVolumeSnapshot(ctx context.Context, req *iri.CreateVolumeSnapshotRequest) (*iri.CreateVolumeSnapshotResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_CreateVolumeSnapshot_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	volumeSnapshot := req.VolumeSnapshot
 	volumeSnapshot.Metadata.Id = r.idGen.Generate()
-	volumeSnapshot.Metadata.CreatedAt = time.Now().UnixNano()
+	volumeSnapshot.Metadata.CreatedAt = r.now().UnixNano()
 	volumeSnapshot.Status = &iri.VolumeSnapshotStatus{}
 
-	r.VolumeSnapshots[volumeSnapshot.Metadata.Id] = &FakeVolumeSnapshot{
+	fakeVolumeSnapshot := &FakeVolumeSnapshot{
 		VolumeSnapshot: volumeSnapshot,
 	}
+	if r.Transitions != nil {
+		fakeVolumeSnapshot.readyAt = r.now().Add(r.Transitions.Snapshot)
+	}
+	r.VolumeSnapshots[volumeSnapshot.Metadata.Id] = fakeVolumeSnapshot
 
 	return &iri.CreateVolumeSnapshotResponse{
 		VolumeSnapshot: volumeSnapshot,
@@ -235,7 +566,11 @@ func (r *FakeRuntimeService) CreateVolumeSnapshot(ctx context.Context, req *iri.
 }
 
 func (r *FakeRuntimeService) DeleteVolumeSnapshot(ctx context.Context, req *iri.DeleteVolumeSnapshotRequest) (*iri.DeleteVolumeSnapshotResponse, error) {
-	r.Lock()
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_DeleteVolumeSnapshot_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
 	defer r.Unlock()
 
 	volumeSnapshotID := req.VolumeSnapshotId
@@ -247,13 +582,199 @@ func (r *FakeRuntimeService) DeleteVolumeSnapshot(ctx context.Context, req *iri.
 	return &iri.DeleteVolumeSnapshotResponse{}, nil
 }
 
-func (r *FakeRuntimeService) Status(ctx context.Context, req *iri.StatusRequest) (*iri.StatusResponse, error) {
+// fakeBackup is the content of the objects the fake runtime exports volume snapshots to.
+type fakeBackup struct {
+	VolumeSnapshotID string `json:"volumeSnapshotID"`
+	StorageBytes     int64  `json:"storageBytes"`
+}
+
+func newObjectStorageClient(location *iri.ObjectStorageLocation) (*objectstorage.Client, error) {
+	if location.GetKey() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "must specify object key")
+	}
+	c, err := objectstorage.NewClient(location.GetEndpoint(), location.GetSecretData())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid object storage location: %v", err)
+	}
+	return c, nil
+}
+
+func convertObjectStorageError(err error) error {
+	switch {
+	case objectstorage.IsNotFound(err):
+		return status.Errorf(codes.NotFound, "object not found: %v", err)
+	case objectstorage.IsForbidden(err):
+		return status.Errorf(codes.PermissionDenied, "access to object storage denied: %v", err)
+	default:
+		return status.Errorf(codes.Unavailable, "error accessing object storage: %v", err)
+	}
+}
+
+func getFakeBackup(ctx context.Context, location *iri.ObjectStorageLocation) (*fakeBackup, error) {
+	c, err := newObjectStorageClient(location)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.GetObject(ctx, location.Key)
+	if err != nil {
+		return nil, convertObjectStorageError(err)
+	}
+
+	backup := &fakeBackup{}
+	if err := json.Unmarshal(data, backup); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "object %q is no backup: %v", location.Key, err)
+	}
+	return backup, nil
+}
+
+func (r *FakeRuntimeService) ExportVolumeSnapshot(ctx context.Context, req *iri.ExportVolumeSnapshotRequest) (*iri.ExportVolumeSnapshotResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_ExportVolumeSnapshot_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	c, err := newObjectStorageClient(req.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	volumeSnapshot, ok := r.VolumeSnapshots[req.VolumeSnapshotId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "volume snapshot %q not found", req.VolumeSnapshotId)
+	}
+	if volumeSnapshot.GetStatus().GetState() != iri.VolumeSnapshotState_VOLUME_SNAPSHOT_READY {
+		return nil, status.Errorf(codes.FailedPrecondition, "volume snapshot %q is not ready", req.VolumeSnapshotId)
+	}
+
+	backup := &fakeBackup{VolumeSnapshotID: req.VolumeSnapshotId}
+	if volume, ok := r.Volumes[volumeSnapshot.GetSpec().GetVolumeId()]; ok {
+		backup.StorageBytes = volume.GetSpec().GetResources().GetStorageBytes()
+	}
+
+	export := &iri.VolumeSnapshotExport{
+		Id:               r.idGen.Generate(),
+		VolumeSnapshotId: req.VolumeSnapshotId,
+		State:            iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_RUNNING,
+	}
+	r.VolumeSnapshotExports[export.Id] = &FakeVolumeSnapshotExport{VolumeSnapshotExport: export}
+
+	// The export outlives the call, so it must not be canceled with it.
+	go r.runVolumeSnapshotExport(context.WithoutCancel(ctx), export.Id, c, req.Location.Key, backup)
+
+	return &iri.ExportVolumeSnapshotResponse{
+		ExportId: export.Id,
+	}, nil
+}
+
+func (r *FakeRuntimeService) runVolumeSnapshotExport(ctx context.Context, exportID string, c *objectstorage.Client, key string, backup *fakeBackup) {
+	data, err := json.Marshal(backup)
+	if err == nil {
+		err = c.PutObject(ctx, key, data)
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
+	export, ok := r.VolumeSnapshotExports[exportID]
+	if !ok {
+		return
+	}
+	if err != nil {
+		export.State = iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_FAILED
+		export.Message = status.Convert(convertObjectStorageError(err)).Message()
+		return
+	}
+	export.State = iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_SUCCEEDED
+	export.BytesWritten = int64(len(data))
+}
+
+func (r *FakeRuntimeService) GetVolumeSnapshotExport(ctx context.Context, req *iri.GetVolumeSnapshotExportRequest) (*iri.GetVolumeSnapshotExportResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_GetVolumeSnapshotExport_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
+	export, ok := r.VolumeSnapshotExports[req.ExportId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "volume snapshot export %q not found", req.ExportId)
+	}
+
+	return &iri.GetVolumeSnapshotExportResponse{
+		Export: proto.Clone(export.VolumeSnapshotExport).(*iri.VolumeSnapshotExport),
+	}, nil
+}
+
+func (r *FakeRuntimeService) Status(ctx context.Context, req *iri.StatusRequest) (*iri.StatusResponse, error) {
+	if err := r.Faults.Inject(ctx, iri.VolumeRuntime_Status_FullMethodName); err != nil {
+		return nil, err
+	}
+
+	r.lock()
+	defer r.Unlock()
+
 	var res []*iri.VolumeClassStatus
 	for _, m := range r.VolumeClassesStatus {
 		res = append(res, &m.VolumeClassStatus)
 	}
 	return &iri.StatusResponse{VolumeClassStatus: res}, nil
 }
+
+// fakeWatchVolumesClient is a grpc.ServerStreamingClient sending the changes of the fake volumes.
+type fakeWatchVolumesClient struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	watcher *fakewatch.ObjectWatcher[*iri.Volume]
+}
+
+func (c *fakeWatchVolumesClient) Recv() (*iri.WatchVolumesResponse, error) {
+	evt, err := c.watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return &iri.WatchVolumesResponse{
+		Type:            evt.Type,
+		Volume:          evt.Object,
+		ResourceVersion: evt.ResourceVersion,
+	}, nil
+}
+
+func (c *fakeWatchVolumesClient) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeWatchVolumesClient) CloseSend() error {
+	return nil
+}
+
+// fakeWatchEventsClient is a grpc.ServerStreamingClient sending the events added to the fake runtime.
+type fakeWatchEventsClient struct {
+	grpc.ClientStream
+
+	ctx     context.Context
+	watcher *fakewatch.EventWatcher[*FakeEvent]
+}
+
+func (c *fakeWatchEventsClient) Recv() (*iri.WatchEventsResponse, error) {
+	evt, resourceVersion, err := c.watcher.Next()
+	if err != nil {
+		return nil, err
+	}
+	return &iri.WatchEventsResponse{
+		Event:           proto.Clone(&evt.Event).(*irievent.Event),
+		ResourceVersion: resourceVersion,
+	}, nil
+}
+
+func (c *fakeWatchEventsClient) Context() context.Context {
+	return c.ctx
+}
+
+func (c *fakeWatchEventsClient) CloseSend() error {
+	return nil
+}
@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volume_test
+
+import (
+	"net/http/httptest"
+	"time"
+
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/objectstorage"
+	. "github.com/ironcore-dev/ironcore/iri/testing/volume"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("FakeRuntimeService", func() {
+	var (
+		runtime *FakeRuntimeService
+		clock   *testingclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		clock = testingclock.NewFakeClock(time.Unix(0, 0))
+		runtime = NewFakeRuntimeService()
+		runtime.Clock = clock
+		runtime.SetVolumeClasses([]*FakeVolumeClassStatus{
+			{
+				VolumeClassStatus: iri.VolumeClassStatus{
+					VolumeClass: &iri.VolumeClass{Name: "fast"},
+					Quantity:    100,
+				},
+			},
+		})
+	})
+
+	createVolume := func(ctx SpecContext, storageBytes int64) (*iri.Volume, error) {
+		res, err := runtime.CreateVolume(ctx, &iri.CreateVolumeRequest{
+			Volume: &iri.Volume{
+				Metadata: &irimeta.ObjectMetadata{},
+				Spec: &iri.VolumeSpec{
+					Class:     "fast",
+					Resources: &iri.VolumeResources{StorageBytes: storageBytes},
+				},
+			},
+		})
+		return res.GetVolume(), err
+	}
+
+	getVolume := func(ctx SpecContext, id string) *iri.Volume {
+		res, err := runtime.ListVolumes(ctx, &iri.ListVolumesRequest{Filter: &iri.VolumeFilter{Id: id}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Volumes).To(HaveLen(1))
+		return res.Volumes[0]
+	}
+
+	It("should make volumes available and snapshots ready after the delays", func(ctx SpecContext) {
+		runtime.SetTransitions(&Transitions{
+			Provisioning: 2 * time.Second,
+			Snapshot:     time.Second,
+		})
+
+		volume, err := createVolume(ctx, 50)
+		Expect(err).NotTo(HaveOccurred())
+		id := volume.Metadata.Id
+
+		clock.Step(time.Second)
+		Expect(getVolume(ctx, id).Status.State).To(Equal(iri.VolumeState_VOLUME_PENDING))
+
+		clock.Step(time.Second)
+		volume = getVolume(ctx, id)
+		Expect(volume.Status.State).To(Equal(iri.VolumeState_VOLUME_AVAILABLE))
+		Expect(volume.Status.Resources.StorageBytes).To(Equal(int64(50)))
+
+		_, err = runtime.CreateVolumeSnapshot(ctx, &iri.CreateVolumeSnapshotRequest{
+			VolumeSnapshot: &iri.VolumeSnapshot{
+				Metadata: &irimeta.ObjectMetadata{},
+				Spec:     &iri.VolumeSnapshotSpec{VolumeId: id},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		clock.Step(time.Second)
+		res, err := runtime.ListVolumeSnapshots(ctx, &iri.ListVolumeSnapshotsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.VolumeSnapshots).To(ConsistOf(HaveField("Status", SatisfyAll(
+			HaveField("State", iri.VolumeSnapshotState_VOLUME_SNAPSHOT_READY),
+			HaveField("Size", int64(50)),
+		))))
+	})
+
+	It("should reject volumes and expansions exceeding the quantity of their class", func(ctx SpecContext) {
+		runtime.SetEnforceCapacity(true)
+
+		volume, err := createVolume(ctx, 60)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = createVolume(ctx, 50)
+		Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+
+		_, err = runtime.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
+			VolumeId:  volume.Metadata.Id,
+			Resources: &iri.VolumeResources{StorageBytes: 100},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = runtime.ExpandVolume(ctx, &iri.ExpandVolumeRequest{
+			VolumeId:  volume.Metadata.Id,
+			Resources: &iri.VolumeResources{StorageBytes: 101},
+		})
+		Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+	})
+
+	It("should only clone existing volumes into volumes at least as large", func(ctx SpecContext) {
+		source, err := createVolume(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		cloneVolume := func(sourceID string, storageBytes int64) error {
+			_, err := runtime.CreateVolume(ctx, &iri.CreateVolumeRequest{
+				Volume: &iri.Volume{
+					Metadata: &irimeta.ObjectMetadata{},
+					Spec: &iri.VolumeSpec{
+						Class:     "fast",
+						Resources: &iri.VolumeResources{StorageBytes: storageBytes},
+						VolumeDataSource: &iri.VolumeDataSource{
+							CloneDataSource: &iri.CloneDataSource{VolumeId: sourceID},
+						},
+					},
+				},
+			})
+			return err
+		}
+
+		Expect(status.Code(cloneVolume("unknown", 10))).To(Equal(codes.NotFound))
+		Expect(status.Code(cloneVolume(source.Metadata.Id, 9))).To(Equal(codes.InvalidArgument))
+		Expect(cloneVolume(source.Metadata.Id, 10)).To(Succeed())
+	})
+
+	It("should only revert volumes to ready snapshots of themselves", func(ctx SpecContext) {
+		runtime.SetTransitions(&Transitions{Snapshot: time.Second})
+
+		volume, err := createVolume(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+		other, err := createVolume(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := runtime.CreateVolumeSnapshot(ctx, &iri.CreateVolumeSnapshotRequest{
+			VolumeSnapshot: &iri.VolumeSnapshot{
+				Metadata: &irimeta.ObjectMetadata{},
+				Spec:     &iri.VolumeSnapshotSpec{VolumeId: volume.Metadata.Id},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		snapshotID := res.VolumeSnapshot.Metadata.Id
+
+		revertVolume := func(volumeID, snapshotID string) error {
+			_, err := runtime.RevertVolume(ctx, &iri.RevertVolumeRequest{
+				VolumeId:         volumeID,
+				VolumeSnapshotId: snapshotID,
+			})
+			return err
+		}
+
+		Expect(status.Code(revertVolume("unknown", snapshotID))).To(Equal(codes.NotFound))
+		Expect(status.Code(revertVolume(volume.Metadata.Id, "unknown"))).To(Equal(codes.NotFound))
+		Expect(status.Code(revertVolume(other.Metadata.Id, snapshotID))).To(Equal(codes.InvalidArgument))
+		Expect(status.Code(revertVolume(volume.Metadata.Id, snapshotID))).To(Equal(codes.FailedPrecondition))
+
+		clock.Step(time.Second)
+		Expect(revertVolume(volume.Metadata.Id, snapshotID)).To(Succeed())
+		Expect(runtime.Volumes[volume.Metadata.Id].Reverts).To(Equal([]string{snapshotID}))
+	})
+
+	It("should export ready snapshots to and restore volumes from an object storage", func(ctx SpecContext) {
+		runtime.SetTransitions(&Transitions{Snapshot: time.Second})
+
+		objectStorage := objectstorage.NewServer()
+		objectStorage.AddCredentials("foo", "bar")
+		objectStorageSrv := httptest.NewServer(objectStorage)
+		DeferCleanup(objectStorageSrv.Close)
+
+		location := func(key string) *iri.ObjectStorageLocation {
+			return &iri.ObjectStorageLocation{
+				Endpoint: objectStorageSrv.URL,
+				Key:      key,
+				SecretData: map[string][]byte{
+					objectstorage.AccessKeyIDKey:     []byte("foo"),
+					objectstorage.SecretAccessKeyKey: []byte("bar"),
+				},
+			}
+		}
+
+		volume, err := createVolume(ctx, 10)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := runtime.CreateVolumeSnapshot(ctx, &iri.CreateVolumeSnapshotRequest{
+			VolumeSnapshot: &iri.VolumeSnapshot{
+				Metadata: &irimeta.ObjectMetadata{},
+				Spec:     &iri.VolumeSnapshotSpec{VolumeId: volume.Metadata.Id},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		snapshotID := res.VolumeSnapshot.Metadata.Id
+
+		exportVolumeSnapshot := func(snapshotID string, location *iri.ObjectStorageLocation) (string, error) {
+			res, err := runtime.ExportVolumeSnapshot(ctx, &iri.ExportVolumeSnapshotRequest{
+				VolumeSnapshotId: snapshotID,
+				Location:         location,
+			})
+			return res.GetExportId(), err
+		}
+		getVolumeSnapshotExport := func(exportID string) (*iri.VolumeSnapshotExport, error) {
+			res, err := runtime.GetVolumeSnapshotExport(ctx, &iri.GetVolumeSnapshotExportRequest{ExportId: exportID})
+			return res.GetExport(), err
+		}
+
+		By("exporting the snapshot")
+		_, err = exportVolumeSnapshot("unknown", location("backup"))
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+		_, err = exportVolumeSnapshot(snapshotID, location("backup"))
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+		clock.Step(time.Second)
+		invalidLocation := location("backup")
+		invalidLocation.SecretData = nil
+		_, err = exportVolumeSnapshot(snapshotID, invalidLocation)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		exportID, err := exportVolumeSnapshot(snapshotID, location("backup"))
+		Expect(err).NotTo(HaveOccurred())
+
+		By("waiting for the export to succeed")
+		Eventually(func() (*iri.VolumeSnapshotExport, error) {
+			return getVolumeSnapshotExport(exportID)
+		}).Should(SatisfyAll(
+			HaveField("VolumeSnapshotId", snapshotID),
+			HaveField("State", iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_SUCCEEDED),
+			HaveField("BytesWritten", BeNumerically(">", 0)),
+		))
+		Expect(objectStorage.Keys()).To(Equal([]string{"backup"}))
+
+		_, err = getVolumeSnapshotExport("unknown")
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		By("failing an export with invalid credentials")
+		forbiddenLocation := location("forbidden")
+		forbiddenLocation.SecretData[objectstorage.SecretAccessKeyKey] = []byte("wrong")
+		exportID, err = exportVolumeSnapshot(snapshotID, forbiddenLocation)
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() (*iri.VolumeSnapshotExport, error) {
+			return getVolumeSnapshotExport(exportID)
+		}).Should(SatisfyAll(
+			HaveField("State", iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_FAILED),
+			HaveField("Message", Not(BeEmpty())),
+		))
+		Expect(objectStorage.Keys()).To(Equal([]string{"backup"}))
+
+		By("restoring volumes from the backup")
+		restoreVolume := func(location *iri.ObjectStorageLocation, storageBytes int64) (*iri.Volume, error) {
+			res, err := runtime.CreateVolume(ctx, &iri.CreateVolumeRequest{
+				Volume: &iri.Volume{
+					Metadata: &irimeta.ObjectMetadata{},
+					Spec: &iri.VolumeSpec{
+						Class:     "fast",
+						Resources: &iri.VolumeResources{StorageBytes: storageBytes},
+						VolumeDataSource: &iri.VolumeDataSource{
+							BackupDataSource: &iri.BackupDataSource{Location: location},
+						},
+					},
+				},
+			})
+			return res.GetVolume(), err
+		}
+
+		_, err = restoreVolume(location("unknown"), 10)
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+		_, err = restoreVolume(location("backup"), 9)
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		restored, err := restoreVolume(location("backup"), 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runtime.Volumes[restored.Metadata.Id].RestoredVolumeSnapshotID).To(Equal(snapshotID))
+	})
+})
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package volume
+
+import (
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/internal/fakeserver"
+	"google.golang.org/grpc"
+)
+
+type unimplementedServer struct {
+	iri.UnimplementedVolumeRuntimeServer
+}
+
+// Server serves a FakeRuntimeService via gRPC.
+type Server struct {
+	*FakeRuntimeService
+	unimplementedServer
+}
+
+var _ iri.VolumeRuntimeServer = (*Server)(nil)
+
+// NewServer creates a new Server serving the given runtime.
+func NewServer(runtime *FakeRuntimeService) *Server {
+	return &Server{FakeRuntimeService: runtime}
+}
+
+func (s *Server) WatchEvents(req *iri.WatchEventsRequest, server grpc.ServerStreamingServer[iri.WatchEventsResponse]) error {
+	client, err := s.FakeRuntimeService.WatchEvents(server.Context(), req)
+	return fakeserver.Forward(client, err, server)
+}
+
+func (s *Server) WatchVolumes(req *iri.WatchVolumesRequest, server grpc.ServerStreamingServer[iri.WatchVolumesResponse]) error {
+	client, err := s.FakeRuntimeService.WatchVolumes(server.Context(), req)
+	return fakeserver.Forward(client, err, server)
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package consolelog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type Options struct {
+	Follow    bool
+	TailLines int64
+}
+
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.BoolVarP(&o.Follow, "follow", "f", false, "Follow the console log of the machine.")
+	fs.Int64Var(&o.TailLines, "tail", -1, "Number of lines from the end of the console log to show. -1 shows the full log.")
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var opts Options
+
+	cmd := &cobra.Command{
+		Use:  "consolelog machine-id",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			machineID := args[0]
+
+			return Run(ctx, streams, client, machineID, opts)
+		},
+	}
+
+	opts.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.MachineRuntimeClient, machineID string, opts Options) error {
+	req := &iri.GetConsoleLogRequest{
+		MachineId: machineID,
+		Follow:    opts.Follow,
+	}
+	if opts.TailLines >= 0 {
+		req.TailLines = &opts.TailLines
+	}
+
+	stream, err := client.GetConsoleLog(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error getting console log: %w", err)
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("error receiving console log: %w", err)
+		}
+
+		if _, err := streams.Out.Write(res.Data); err != nil {
+			return fmt.Errorf("error writing console log: %w", err)
+		}
+	}
+}
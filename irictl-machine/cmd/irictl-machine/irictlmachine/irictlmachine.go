@@ -8,6 +8,7 @@ import (
 
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/attach"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/consolelog"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/create"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/delete"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/detach"
@@ -47,6 +48,7 @@ func Command(streams clicommon.Streams) *cobra.Command {
 		delete.Command(streams, &clientOpts),
 		update.Command(streams, &clientOpts),
 		exec.Command(streams, &clientOpts),
+		consolelog.Command(streams, &clientOpts),
 		attach.Command(streams, &clientOpts),
 		detach.Command(streams, &clientOpts),
 	)
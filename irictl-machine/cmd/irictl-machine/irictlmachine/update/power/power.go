@@ -21,7 +21,7 @@ type Options struct {
 }
 
 func (o *Options) AddFlags(cmd *cobra.Command) {
-	cmd.Flags().Int32Var(&o.Power, "power", o.Power, "The power state to set (0: POWER_ON, 1: POWER_OFF).")
+	cmd.Flags().Int32Var(&o.Power, "power", o.Power, "The power state to set (0: POWER_ON, 1: POWER_OFF, 2: POWER_SUSPENDED).")
 	cmd.Flags().StringVar(&o.MachineID, "machine-id", "", "The machine ID to modify.")
 	utilruntime.Must(cmd.MarkFlagRequired("machine-id"))
 }
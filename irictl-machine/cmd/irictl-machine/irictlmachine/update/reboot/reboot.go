@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package reboot
+
+import (
+	"context"
+	"fmt"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/spf13/cobra"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type Options struct {
+	Mode      int32
+	MachineID string
+}
+
+func (o *Options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().Int32Var(&o.Mode, "mode", o.Mode, "The reboot mode to use (0: REBOOT_MODE_SOFT, 1: REBOOT_MODE_HARD).")
+	cmd.Flags().StringVar(&o.MachineID, "machine-id", "", "The machine ID to reboot.")
+	utilruntime.Must(cmd.MarkFlagRequired("machine-id"))
+}
+
+func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Command {
+	var (
+		opts Options
+	)
+
+	cmd := &cobra.Command{
+		Use: "reboot",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.Client()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			return Run(ctx, streams, client, opts)
+		},
+	}
+
+	opts.AddFlags(cmd)
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.MachineRuntimeClient, opts Options) error {
+	if _, err := client.RebootMachine(ctx, &iri.RebootMachineRequest{
+		MachineId: opts.MachineID,
+		Mode:      iri.RebootMode(opts.Mode),
+	}); err != nil {
+		return fmt.Errorf("error rebooting machine %s: %w", opts.MachineID, err)
+	}
+
+	_, _ = fmt.Fprintf(streams.Out, "Rebooted machine %s\n", opts.MachineID)
+	return nil
+}
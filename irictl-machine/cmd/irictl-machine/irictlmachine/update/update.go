@@ -5,7 +5,9 @@ package update
 
 import (
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/common"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update/class"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update/power"
+	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update/reboot"
 	"github.com/ironcore-dev/ironcore/irictl-machine/cmd/irictl-machine/irictlmachine/update/volume"
 	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/spf13/cobra"
@@ -19,6 +21,8 @@ func Command(streams clicommon.Streams, clientFactory common.Factory) *cobra.Com
 	cmd.AddCommand(
 		volume.Command(streams, clientFactory),
 		power.Command(streams, clientFactory),
+		reboot.Command(streams, clientFactory),
+		class.Command(streams, clientFactory),
 	)
 
 	return cmd
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package tableconverters
+
+import (
+	"time"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl/api"
+	"github.com/ironcore-dev/ironcore/irictl/tableconverter"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var (
+	machineStatsHeaders = []api.Header{
+		{Name: "ID"},
+		{Name: "CPU"},
+		{Name: "Memory"},
+		{Name: "Volumes"},
+		{Name: "NetworkInterfaces"},
+	}
+
+	MachineStats = tableconverter.Funcs[*iri.MachineStats]{
+		Headers: tableconverter.Headers(machineStatsHeaders),
+		Rows: tableconverter.SingleRowFrom(func(stats *iri.MachineStats) (api.Row, error) {
+			return api.Row{
+				stats.MachineId,
+				(time.Duration(stats.GetCpu().GetUsageCoreNanoSeconds()) * time.Nanosecond).String(),
+				resource.NewQuantity(int64(stats.GetMemory().GetUsageBytes()), resource.BinarySI).String(),
+				len(stats.Volumes),
+				len(stats.NetworkInterfaces),
+			}, nil
+		}),
+	}
+
+	MachineStatsSlice = tableconverter.SliceFuncs[*iri.MachineStats](MachineStats)
+)
+
+func init() {
+	RegistryBuilder.Register(
+		tableconverter.ToTagAndTypedAny[*iri.MachineStats](MachineStats),
+		tableconverter.ToTagAndTypedAny[[]*iri.MachineStats](MachineStatsSlice),
+	)
+}
@@ -4,7 +4,10 @@
 package tableconverters
 
 import (
+	"strings"
+
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capability"
 	"github.com/ironcore-dev/ironcore/irictl/api"
 	"github.com/ironcore-dev/ironcore/irictl/tableconverter"
 )
@@ -13,6 +16,7 @@ var (
 	versionsHeaders = []api.Header{
 		{Name: "Name"},
 		{Name: "Version"},
+		{Name: "Capabilities"},
 	}
 
 	VersionResponse = tableconverter.Funcs[*iri.VersionResponse]{
@@ -21,6 +25,7 @@ var (
 			return api.Row{
 				versionInfo.RuntimeName,
 				versionInfo.RuntimeVersion,
+				strings.Join(capability.Names(versionInfo.Capabilities), ","),
 			}, nil
 		}),
 	}
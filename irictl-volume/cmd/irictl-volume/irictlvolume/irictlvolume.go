@@ -10,6 +10,7 @@ import (
 	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/create"
 	delete2 "github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/delete"
 	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/get"
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/update"
 	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
 	"github.com/spf13/cobra"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,6 +42,7 @@ func Command(streams clicommon.Streams) *cobra.Command {
 		get.Command(streams, &clientOpts),
 		delete2.Command(streams, &clientOpts),
 		create.Command(streams, &clientOpts),
+		update.Command(streams, &clientOpts),
 	)
 
 	return cmd
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package revert
+
+import (
+	"context"
+	"fmt"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/common"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/spf13/cobra"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+type Options struct {
+	VolumeID         string
+	VolumeSnapshotID string
+}
+
+func (o *Options) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&o.VolumeID, "volume-id", "", "The volume ID to revert.")
+	cmd.Flags().StringVar(&o.VolumeSnapshotID, "volume-snapshot-id", "", "The ID of the volume snapshot to revert the volume to.")
+	utilruntime.Must(cmd.MarkFlagRequired("volume-id"))
+	utilruntime.Must(cmd.MarkFlagRequired("volume-snapshot-id"))
+}
+
+func Command(streams clicommon.Streams, clientFactory common.ClientFactory) *cobra.Command {
+	var (
+		opts Options
+	)
+
+	cmd := &cobra.Command{
+		Use: "revert",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			log := ctrl.LoggerFrom(ctx)
+
+			client, cleanup, err := clientFactory.New()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := cleanup(); err != nil {
+					log.Error(err, "Error cleaning up")
+				}
+			}()
+
+			return Run(ctx, streams, client, opts)
+		},
+	}
+
+	opts.AddFlags(cmd)
+
+	return cmd
+}
+
+func Run(ctx context.Context, streams clicommon.Streams, client iri.VolumeRuntimeClient, opts Options) error {
+	if _, err := client.RevertVolume(ctx, &iri.RevertVolumeRequest{
+		VolumeId:         opts.VolumeID,
+		VolumeSnapshotId: opts.VolumeSnapshotID,
+	}); err != nil {
+		return fmt.Errorf("error reverting volume %s: %w", opts.VolumeID, err)
+	}
+
+	_, _ = fmt.Fprintf(streams.Out, "Reverted volume %s to volume snapshot %s\n", opts.VolumeID, opts.VolumeSnapshotID)
+	return nil
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package update
+
+import (
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/common"
+	"github.com/ironcore-dev/ironcore/irictl-volume/cmd/irictl-volume/irictlvolume/update/revert"
+	clicommon "github.com/ironcore-dev/ironcore/irictl/cmd"
+	"github.com/spf13/cobra"
+)
+
+func Command(streams clicommon.Streams, clientFactory common.ClientFactory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update",
+	}
+
+	cmd.AddCommand(
+		revert.Command(streams, clientFactory),
+	)
+
+	return cmd
+}
@@ -12,7 +12,10 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	"github.com/ironcore-dev/ironcore/iri/apis/bucket"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	"github.com/ironcore-dev/ironcore/poollet/bucketpoollet/api/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -29,8 +32,9 @@ type BucketEventMapper struct {
 
 	bucketRuntime bucket.RuntimeService
 
-	relistPeriod time.Duration
-	lastFetched  time.Time
+	relistPeriod    time.Duration
+	lastFetched     time.Time
+	resourceVersion string
 }
 
 func (m *BucketEventMapper) relist(ctx context.Context, log logr.Logger) error {
@@ -45,31 +49,79 @@ func (m *BucketEventMapper) relist(ctx context.Context, log logr.Logger) error {
 
 	m.lastFetched = toEventFilterTime
 	for _, bucketEvent := range res.Events {
-		if bucketEvent.Spec.InvolvedObjectMeta.Labels != nil {
-			involvedBucket := &storagev1alpha1.Bucket{
-				ObjectMeta: metav1.ObjectMeta{
-					UID:       types.UID(bucketEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.BucketUIDLabel]),
-					Name:      bucketEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.BucketNameLabel],
-					Namespace: bucketEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.BucketNamespaceLabel],
-				},
-			}
-			// events.k8s.io/v1 requires a non-empty action field. Providers should populate
-			// Spec.Action on IRI events; fall back to "Unknown" so a forgotten value is
-			// obvious in the emitted event rather than silently dropped by the API server.
-			action := bucketEvent.Spec.Action
-			if action == "" {
-				action = "Unknown"
-			}
-			m.Eventf(involvedBucket, nil, bucketEvent.Spec.Type, bucketEvent.Spec.Reason, action, bucketEvent.Spec.Message)
-		}
+		m.emit(bucketEvent)
 	}
 
 	return nil
 }
 
+func (m *BucketEventMapper) emit(bucketEvent *irievent.Event) {
+	if bucketEvent.Spec.InvolvedObjectMeta.Labels != nil {
+		involvedBucket := &storagev1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:       types.UID(bucketEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.BucketUIDLabel]),
+				Name:      bucketEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.BucketNameLabel],
+				Namespace: bucketEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.BucketNamespaceLabel],
+			},
+		}
+		// events.k8s.io/v1 requires a non-empty action field. Providers should populate
+		// Spec.Action on IRI events; fall back to "Unknown" so a forgotten value is
+		// obvious in the emitted event rather than silently dropped by the API server.
+		action := bucketEvent.Spec.Action
+		if action == "" {
+			action = "Unknown"
+		}
+		m.Eventf(involvedBucket, nil, bucketEvent.Spec.Type, bucketEvent.Spec.Reason, action, bucketEvent.Spec.Message)
+	}
+}
+
+// watch emits the bucket cluster events received via watch until the watch breaks.
+func (m *BucketEventMapper) watch(ctx context.Context, log logr.Logger) error {
+	log.V(1).Info("Watching bucket cluster events")
+	stream, err := m.bucketRuntime.WatchEvents(ctx, &iri.WatchEventsRequest{
+		ResourceVersion: m.resourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		m.lastFetched = time.Now()
+		m.resourceVersion = res.ResourceVersion
+		m.emit(res.Event)
+	}
+}
+
 func (m *BucketEventMapper) Start(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx).WithName("bem")
 	m.lastFetched = time.Now()
+	for {
+		err := m.watch(ctx, log)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Info("Watching bucket cluster events is not implemented, falling back to relisting")
+			break
+		}
+
+		// Relist to catch up on the events missed while not watching.
+		log.Error(err, "Watch broke, relisting")
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(m.relistPeriod):
+		}
+		if err := m.relist(ctx, log); err != nil {
+			log.Error(err, "Error relisting")
+		}
+	}
+
 	wait.UntilWithContext(ctx, func(ctx context.Context) {
 		if err := m.relist(ctx, log); err != nil {
 			log.Error(err, "Error relisting")
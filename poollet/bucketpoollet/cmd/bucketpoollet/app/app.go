@@ -303,12 +303,24 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error adding bucket event mapper: %w", err)
 	}
 
-	bucketEvents := irievent.NewGenerator(func(ctx context.Context) ([]*iri.Bucket, error) {
+	bucketEvents := irievent.NewWatchGenerator(func(ctx context.Context) ([]*iri.Bucket, error) {
 		res, err := bucketRuntime.ListBuckets(ctx, &iri.ListBucketsRequest{})
 		if err != nil {
 			return nil, err
 		}
 		return res.Buckets, nil
+	}, func(ctx context.Context, resourceVersion string, handle func(irievent.WatchEvent[*iri.Bucket])) error {
+		stream, err := bucketRuntime.WatchBuckets(ctx, &iri.WatchBucketsRequest{ResourceVersion: resourceVersion})
+		if err != nil {
+			return err
+		}
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			handle(irievent.WatchEvent[*iri.Bucket]{Type: res.Type, Object: res.Bucket, ResourceVersion: res.ResourceVersion})
+		}
 	}, irievent.GeneratorOptions{
 		ChannelCapacity: opts.ChannelCapacity,
 		RelistPeriod:    opts.RelistPeriod,
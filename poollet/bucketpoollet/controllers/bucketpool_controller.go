@@ -12,6 +12,8 @@ import (
 	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iriBucket "github.com/ironcore-dev/ironcore/iri/apis/bucket"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/bucket/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capability"
 	"github.com/ironcore-dev/ironcore/poollet/bucketpoollet/bcm"
 	poolletutils "github.com/ironcore-dev/ironcore/poollet/common/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -71,12 +73,46 @@ func (r *BucketPoolReconciler) supportsBucketClass(ctx context.Context, bucketCl
 	return true, nil
 }
 
+func (r *BucketPoolReconciler) getRuntimeCapabilities(ctx context.Context) ([]string, error) {
+	res, err := r.BucketRuntime.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting bucket runtime version: %w", err)
+	}
+	return capability.Names(res.Capabilities), nil
+}
+
+// runtimeCapabilitiesCondition returns the RuntimeCapabilities condition for the capabilities reported by the bucket runtime.
+func runtimeCapabilitiesCondition(bucketPool *storagev1alpha1.BucketPool, capabilities []string, capabilitiesErr error) storagev1alpha1.BucketPoolCondition {
+	if capabilitiesErr != nil {
+		return storagev1alpha1.BucketPoolCondition{
+			Type:               storagev1alpha1.BucketPoolRuntimeCapabilities,
+			Status:             corev1.ConditionFalse,
+			Reason:             poolletutils.RuntimeCapabilitiesVersionFailedReason,
+			Message:            capabilitiesErr.Error(),
+			ObservedGeneration: bucketPool.Generation,
+		}
+	}
+	return storagev1alpha1.BucketPoolCondition{
+		Type:               storagev1alpha1.BucketPoolRuntimeCapabilities,
+		Status:             corev1.ConditionTrue,
+		Reason:             poolletutils.RuntimeCapabilitiesDiscoveredReason,
+		Message:            poolletutils.RuntimeCapabilitiesMessage(capabilities),
+		ObservedGeneration: bucketPool.Generation,
+	}
+}
+
 func (r *BucketPoolReconciler) reconcile(ctx context.Context, log logr.Logger, bucketPool *storagev1alpha1.BucketPool) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
-	log.V(1).Info("Enforcing configured topology labels")
-	if err := r.enforceOriginalTopologyLabels(ctx, log, bucketPool); err != nil {
-		return ctrl.Result{}, fmt.Errorf("error enforcing original topology labels: %w", err)
+	log.V(1).Info("Getting runtime capabilities")
+	capabilities, capabilitiesErr := r.getRuntimeCapabilities(ctx)
+	if capabilitiesErr != nil {
+		log.Error(capabilitiesErr, "Error getting runtime capabilities")
+	}
+
+	log.V(1).Info("Enforcing configured topology and runtime capability labels")
+	if err := r.enforceLabels(ctx, log, bucketPool, capabilities, capabilitiesErr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error enforcing labels: %w", err)
 	}
 
 	log.V(1).Info("Listing bucket classes")
@@ -102,6 +138,7 @@ func (r *BucketPoolReconciler) reconcile(ctx context.Context, log logr.Logger, b
 	log.V(1).Info("Updating bucket pool status")
 	base := bucketPool.DeepCopy()
 	bucketPool.Status.AvailableBucketClasses = supported
+	bucketPool.Status.Conditions = storagev1alpha1.SetBucketPoolCondition(bucketPool.Status.Conditions, runtimeCapabilitiesCondition(bucketPool, capabilities, capabilitiesErr))
 	if err := r.Status().Patch(ctx, bucketPool, client.MergeFrom(base)); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error patching bucket pool status: %w", err)
 	}
@@ -110,10 +147,21 @@ func (r *BucketPoolReconciler) reconcile(ctx context.Context, log logr.Logger, b
 	return ctrl.Result{}, nil
 }
 
-func (r *BucketPoolReconciler) enforceOriginalTopologyLabels(ctx context.Context, log logr.Logger, bucketPool *storagev1alpha1.BucketPool) error {
+// enforceLabels sets the configured topology labels and the labels of the runtime capabilities.
+// If the capabilities could not be determined, the capability labels are left as they are.
+func (r *BucketPoolReconciler) enforceLabels(
+	ctx context.Context,
+	log logr.Logger,
+	bucketPool *storagev1alpha1.BucketPool,
+	capabilities []string,
+	capabilitiesErr error,
+) error {
 	base := bucketPool.DeepCopy()
 
 	poolletutils.SetTopologyLabels(log, &bucketPool.ObjectMeta, r.TopologyLabels)
+	if capabilitiesErr == nil {
+		poolletutils.SetRuntimeCapabilityLabels(log, &bucketPool.ObjectMeta, capabilities)
+	}
 
 	return r.Patch(ctx, bucketPool, client.MergeFrom(base))
 }
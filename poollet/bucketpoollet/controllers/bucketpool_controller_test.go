@@ -90,4 +90,32 @@ var _ = Describe("BucketPoolController", func() {
 			HaveField("ObjectMeta.Labels", HaveKeyWithValue("topology.ironcore.dev/zone", "test-zone-1")),
 		))
 	})
+
+	It("should surface the runtime capabilities", func(ctx SpecContext) {
+		By("checking the capabilities are reflected in the labels and conditions")
+		Eventually(Object(bucketPool)).Should(SatisfyAll(
+			HaveField("ObjectMeta.Labels", HaveKeyWithValue("capability.ironcore.dev/watch", "true")),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", storagev1alpha1.BucketPoolRuntimeCapabilities),
+				HaveField("Status", corev1.ConditionTrue),
+			))),
+		))
+
+		By("reporting no capabilities and adding a stale capability label to trigger a reconciliation")
+		srv.SetCapabilities(nil)
+		Eventually(Update(bucketPool, func() {
+			bucketPool.Labels["capability.ironcore.dev/stale"] = "true"
+		})).Should(Succeed())
+
+		By("checking the labels and conditions are updated")
+		Eventually(Object(bucketPool)).Should(SatisfyAll(
+			HaveField("ObjectMeta.Labels", Not(HaveKey("capability.ironcore.dev/watch"))),
+			HaveField("ObjectMeta.Labels", Not(HaveKey("capability.ironcore.dev/stale"))),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", storagev1alpha1.BucketPoolRuntimeCapabilities),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Message", "Runtime reports no capabilities"),
+			))),
+		))
+	})
 })
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"strings"
+
+	"github.com/go-logr/logr"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RuntimeCapabilitiesDiscoveredReason is the reason of a RuntimeCapabilities pool condition
+	// if the runtime reported its capabilities.
+	RuntimeCapabilitiesDiscoveredReason = "Discovered"
+	// RuntimeCapabilitiesVersionFailedReason is the reason of a RuntimeCapabilities pool condition
+	// if the runtime could not be asked for its capabilities.
+	RuntimeCapabilitiesVersionFailedReason = "VersionFailed"
+)
+
+// SetRuntimeCapabilityLabels sets a commonv1alpha1.RuntimeCapabilityLabelPrefix label for every
+// given capability and removes the labels of all other capabilities.
+func SetRuntimeCapabilityLabels(log logr.Logger, om *v1.ObjectMeta, capabilities []string) {
+	desired := make(map[string]struct{}, len(capabilities))
+	for _, capability := range capabilities {
+		desired[commonv1alpha1.RuntimeCapabilityLabelPrefix+capability] = struct{}{}
+	}
+
+	for key := range om.Labels {
+		if _, ok := desired[key]; !ok && strings.HasPrefix(key, commonv1alpha1.RuntimeCapabilityLabelPrefix) {
+			log.V(1).Info("Removing runtime capability label", "Label", key)
+			delete(om.Labels, key)
+		}
+	}
+
+	if len(desired) == 0 {
+		return
+	}
+	if om.Labels == nil {
+		om.Labels = make(map[string]string)
+	}
+	for key := range desired {
+		log.V(1).Info("Setting runtime capability label", "Label", key)
+		om.Labels[key] = "true"
+	}
+}
+
+// RuntimeCapabilitiesMessage returns the message of a RuntimeCapabilities pool condition listing the given capabilities.
+func RuntimeCapabilitiesMessage(capabilities []string) string {
+	if len(capabilities) == 0 {
+		return "Runtime reports no capabilities"
+	}
+	return "Runtime capabilities: " + strings.Join(capabilities, ", ")
+}
@@ -15,6 +15,8 @@ import (
 
 	"github.com/go-logr/logr"
 	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/server/healthz"
@@ -117,11 +119,27 @@ type generator[O irimeta.Object] struct {
 	// firstListTime is the first time a relist happened.
 	firstListTime time.Time
 
+	// watching reports whether a watch is currently open.
+	watching atomic.Bool
+
 	items oldNewMap[O]
 
-	list func(ctx context.Context) ([]O, error)
+	list  func(ctx context.Context) ([]O, error)
+	watch WatchFunc[O]
+}
+
+// WatchEvent is a change of an object reported by a watch.
+type WatchEvent[O irimeta.Object] struct {
+	Type            irimeta.WatchEventType
+	Object          O
+	ResourceVersion string
 }
 
+// WatchFunc watches the objects, starting after the given resource version, and calls handle
+// sequentially for every change. If the resource version is empty, the current objects are
+// reported as added first. WatchFunc blocks until the watch breaks or the context is done.
+type WatchFunc[O irimeta.Object] func(ctx context.Context, resourceVersion string, handle func(WatchEvent[O])) error
+
 type GeneratorOptions struct {
 	ChannelCapacity int
 	RelistPeriod    time.Duration
@@ -141,6 +159,17 @@ func setGeneratorOptionsDefaults(o *GeneratorOptions) {
 }
 
 func NewGenerator[O irimeta.Object](list func(ctx context.Context) ([]O, error), opts GeneratorOptions) Generator[O] {
+	return newGenerator(list, nil, opts)
+}
+
+// NewWatchGenerator creates a Generator that keeps track of the objects via watch. Only if the
+// watch breaks, the objects are relisted before watching again. If watching is not implemented
+// by the runtime, the Generator falls back to relisting every RelistPeriod.
+func NewWatchGenerator[O irimeta.Object](list func(ctx context.Context) ([]O, error), watch WatchFunc[O], opts GeneratorOptions) Generator[O] {
+	return newGenerator(list, watch, opts)
+}
+
+func newGenerator[O irimeta.Object](list func(ctx context.Context) ([]O, error), watch WatchFunc[O], opts GeneratorOptions) *generator[O] {
 	setGeneratorOptionsDefaults(&opts)
 
 	return &generator[O]{
@@ -151,6 +180,7 @@ func NewGenerator[O irimeta.Object](list func(ctx context.Context) ([]O, error),
 		firstListTime:   time.Time{},
 		items:           make(oldNewMap[O]),
 		list:            list,
+		watch:           watch,
 		handlers:        sets.New[*handler[O]](),
 	}
 }
@@ -165,6 +195,10 @@ func (g *generator[O]) Name() string {
 }
 
 func (g *generator[O]) Check(_ *http.Request) error {
+	if g.watching.Load() {
+		return nil
+	}
+
 	relistTime := g.relistTime.Load()
 	if relistTime == nil {
 		return fmt.Errorf("mleg did not relist yet")
@@ -208,21 +242,131 @@ func (g *generator[O]) Start(ctx context.Context) error {
 	go func() {
 		defer close(g.eventChannel)
 
-		t := time.NewTicker(g.relistPeriod)
-		defer t.Stop()
+		if g.watch != nil && !g.runWatch(ctx, log) {
+			return
+		}
+		g.runRelist(ctx, log)
+	}()
+
+	return nil
+}
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-t.C:
-				if err := g.relist(ctx, log); err != nil {
-					log.Error(err, "Error relisting")
+func (g *generator[O]) runRelist(ctx context.Context, log logr.Logger) {
+	t := time.NewTicker(g.relistPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := g.relist(ctx, log); err != nil {
+				log.Error(err, "Error relisting")
+			}
+		}
+	}
+}
+
+// runWatch keeps track of the objects via watch. When the watch breaks, the objects are relisted
+// to pick up the changes missed in between, e.g. deletions, before watching again.
+// runWatch returns false if the context is done and true if the runtime does not implement
+// watching and the generator has to fall back to relisting.
+func (g *generator[O]) runWatch(ctx context.Context, log logr.Logger) bool {
+	var (
+		resourceVersion string
+		needsRelist     bool
+	)
+	for {
+		if needsRelist {
+			if err := g.relist(ctx, log); err != nil {
+				log.Error(err, "Error relisting")
+				if !g.waitRelistPeriod(ctx) {
+					return false
 				}
+				continue
 			}
+			// The relist captured the current state, so the watch starts from scratch instead of resuming
+			// from a resource version the runtime may not support resuming from.
+			resourceVersion = ""
+			needsRelist = false
 		}
-	}()
 
+		g.firstListTime = time.Now()
+		g.watching.Store(true)
+		err := g.watch(ctx, resourceVersion, func(evt WatchEvent[O]) {
+			resourceVersion = evt.ResourceVersion
+			g.handleWatchEvent(ctx, log, evt)
+		})
+		g.watching.Store(false)
+		if ctx.Err() != nil {
+			return false
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Info("Watching is not implemented, falling back to relisting")
+			return true
+		}
+
+		log.Error(err, "Watch broke, relisting")
+		needsRelist = true
+		if !g.waitRelistPeriod(ctx) {
+			return false
+		}
+	}
+}
+
+func (g *generator[O]) waitRelistPeriod(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(g.relistPeriod):
+		return true
+	}
+}
+
+func (g *generator[O]) handleWatchEvent(ctx context.Context, log logr.Logger, evt WatchEvent[O]) {
+	id := g.items.id(evt.Object)
+	itemOld, oldOK := g.items.getOld(id)
+
+	var e *event[O]
+	switch evt.Type {
+	case irimeta.WatchEventType_WATCH_EVENT_DELETED:
+		if !oldOK {
+			return
+		}
+		delete(g.items, id)
+		e = &event[O]{Delete: &DeleteEvent[O]{Object: itemOld}}
+	default:
+		itemNew := evt.Object
+		g.items[id] = &oldNewMapEntry[O]{Old: &itemNew}
+		switch {
+		case !oldOK:
+			e = g.addedEvent(itemNew)
+		case !proto.Equal(itemOld, itemNew):
+			e = &event[O]{Update: &UpdateEvent[O]{ObjectOld: itemOld, ObjectNew: itemNew}}
+		default:
+			return
+		}
+	}
+
+	_ = g.sendEvent(ctx, log, id, e)
+}
+
+func (g *generator[O]) addedEvent(obj O) *event[O] {
+	createdAt := time.Unix(0, obj.GetMetadata().CreatedAt)
+	if createdAt.Before(g.firstListTime) {
+		return &event[O]{Create: &CreateEvent[O]{Object: obj}}
+	}
+	return &event[O]{Generic: &GenericEvent[O]{Object: obj}}
+}
+
+func (g *generator[O]) sendEvent(ctx context.Context, log logr.Logger, id string, evt *event[O]) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case g.eventChannel <- evt:
+	default:
+		log.Info("Event channel is full, discarding event", "ID", id)
+	}
 	return nil
 }
 
@@ -243,12 +387,7 @@ func (g *generator[O]) relist(ctx context.Context, log logr.Logger) error {
 		itemNew, newOK := g.items.getCurrent(key)
 		switch {
 		case !oldOK && newOK:
-			createdAt := time.Unix(0, itemNew.GetMetadata().CreatedAt)
-			if createdAt.Before(g.firstListTime) {
-				eventsByKey[key] = []*event[O]{{Create: &CreateEvent[O]{Object: itemNew}}}
-			} else {
-				eventsByKey[key] = []*event[O]{{Generic: &GenericEvent[O]{Object: itemNew}}}
-			}
+			eventsByKey[key] = []*event[O]{g.addedEvent(itemNew)}
 		case oldOK && !newOK:
 			eventsByKey[key] = []*event[O]{{Delete: &DeleteEvent[O]{Object: itemOld}}}
 		case oldOK && newOK:
@@ -261,12 +400,8 @@ func (g *generator[O]) relist(ctx context.Context, log logr.Logger) error {
 	for machineID, events := range eventsByKey {
 		g.items.update(machineID)
 		for i := range events {
-			select {
-			case <-ctx.Done():
-				return ctx.Err()
-			case g.eventChannel <- events[i]:
-			default:
-				log.Info("Event channel is full, discarding event", "MachineID", machineID)
+			if err := g.sendEvent(ctx, log, machineID, events[i]); err != nil {
+				return err
 			}
 		}
 	}
@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package irievent_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	fakemachine "github.com/ironcore-dev/ironcore/iri/testing/machine"
+	. "github.com/ironcore-dev/ironcore/poollet/irievent"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func listMachines(machineRuntime *fakemachine.FakeRuntimeService) func(ctx context.Context) ([]*iri.Machine, error) {
+	return func(ctx context.Context) ([]*iri.Machine, error) {
+		res, err := machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{})
+		if err != nil {
+			return nil, err
+		}
+		return res.Machines, nil
+	}
+}
+
+func watchMachines(machineRuntime *fakemachine.FakeRuntimeService) WatchFunc[*iri.Machine] {
+	return func(ctx context.Context, resourceVersion string, handle func(WatchEvent[*iri.Machine])) error {
+		stream, err := machineRuntime.WatchMachines(ctx, &iri.WatchMachinesRequest{ResourceVersion: resourceVersion})
+		if err != nil {
+			return err
+		}
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			handle(WatchEvent[*iri.Machine]{Type: res.Type, Object: res.Machine, ResourceVersion: res.ResourceVersion})
+		}
+	}
+}
+
+func newMachine(id string) *fakemachine.FakeMachine {
+	return &fakemachine.FakeMachine{
+		Machine: &iri.Machine{
+			Metadata: &irimeta.ObjectMetadata{Id: id},
+			Spec:     &iri.MachineSpec{Power: iri.Power_POWER_ON},
+			Status:   &iri.MachineStatus{State: iri.MachineState_MACHINE_PENDING},
+		},
+	}
+}
+
+// recordEvents starts the generator and records the events it emits in the form "<type>/<id>".
+func recordEvents(ctx context.Context, generator Generator[*iri.Machine]) <-chan string {
+	events := make(chan string, 64)
+	_, err := generator.AddHandler(HandlerFuncs[*iri.Machine]{
+		CreateFunc: func(event CreateEvent[*iri.Machine]) {
+			events <- "create/" + event.Object.Metadata.Id
+		},
+		UpdateFunc: func(event UpdateEvent[*iri.Machine]) {
+			events <- "update/" + event.ObjectNew.Metadata.Id
+		},
+		DeleteFunc: func(event DeleteEvent[*iri.Machine]) {
+			events <- "delete/" + event.Object.Metadata.Id
+		},
+		GenericFunc: func(event GenericEvent[*iri.Machine]) {
+			events <- "generic/" + event.Object.Metadata.Id
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(generator.Start(ctx)).To(Succeed())
+	return events
+}
+
+var _ = Describe("Generator", func() {
+	var machineRuntime *fakemachine.FakeRuntimeService
+
+	BeforeEach(func() {
+		machineRuntime = fakemachine.NewFakeRuntimeService()
+		machineRuntime.SetMachines([]*fakemachine.FakeMachine{newMachine("foo")})
+	})
+
+	It("should emit the changes reported by the watch", func(ctx SpecContext) {
+		generator := NewWatchGenerator(listMachines(machineRuntime), watchMachines(machineRuntime), GeneratorOptions{
+			RelistPeriod: time.Hour,
+		})
+		events := recordEvents(ctx, generator)
+
+		By("waiting for the existing machine to be reported")
+		Eventually(events).Should(Receive(Equal("create/foo")))
+		Expect(generator.Check(nil)).To(Succeed())
+
+		By("updating the machine state")
+		machineRuntime.Lock()
+		machineRuntime.Machines["foo"].Status.State = iri.MachineState_MACHINE_RUNNING
+		machineRuntime.Unlock()
+		Eventually(events).Should(Receive(Equal("update/foo")))
+
+		By("deleting the machine")
+		machineRuntime.SetMachines(nil)
+		Eventually(events).Should(Receive(Equal("delete/foo")))
+	})
+
+	It("should relist when the watch breaks", func(ctx SpecContext) {
+		var calls int
+		generator := NewWatchGenerator(listMachines(machineRuntime), func(ctx context.Context, resourceVersion string, handle func(WatchEvent[*iri.Machine])) error {
+			calls++
+			if calls == 1 {
+				handle(WatchEvent[*iri.Machine]{
+					Type:            irimeta.WatchEventType_WATCH_EVENT_ADDED,
+					Object:          machineRuntime.Machines["foo"].Machine,
+					ResourceVersion: "1",
+				})
+				machineRuntime.SetMachines(nil)
+				return errors.New("watch broke")
+			}
+
+			// The relist captured the current state, so the watch starts from scratch.
+			Expect(resourceVersion).To(BeEmpty())
+			<-ctx.Done()
+			return ctx.Err()
+		}, GeneratorOptions{
+			RelistPeriod: 10 * time.Millisecond,
+		})
+		events := recordEvents(ctx, generator)
+
+		Eventually(events).Should(Receive(Equal("create/foo")))
+		By("waiting for the relist to pick up the deletion missed by the watch")
+		Eventually(events).Should(Receive(Equal("delete/foo")))
+	})
+
+	It("should fall back to relisting if watching is not implemented", func(ctx SpecContext) {
+		generator := NewWatchGenerator(listMachines(machineRuntime), func(context.Context, string, func(WatchEvent[*iri.Machine])) error {
+			return status.Error(codes.Unimplemented, "not implemented")
+		}, GeneratorOptions{
+			RelistPeriod: 10 * time.Millisecond,
+		})
+		events := recordEvents(ctx, generator)
+
+		Eventually(events).Should(Receive(Equal("create/foo")))
+
+		By("deleting the machine")
+		machineRuntime.SetMachines(nil)
+		Eventually(events).Should(Receive(Equal("delete/foo")))
+	})
+})
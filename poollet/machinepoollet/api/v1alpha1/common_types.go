@@ -36,6 +36,16 @@ const (
 
 	NetworkInterfaceMappingAnnotation = "machinepoollet.ironcore.dev/networkinterfacemapping"
 
+	// RestartRequestedAtAnnotation records the last machine restart request that was applied to the IRI machine.
+	RestartRequestedAtAnnotation = "machinepoollet.ironcore.dev/restart-requested-at"
+
+	// IOFreezeRequestedAtAnnotation records the last machine I/O freeze request that was applied to the IRI machine.
+	IOFreezeRequestedAtAnnotation = "machinepoollet.ironcore.dev/io-freeze-requested-at"
+
+	// MetadataURLAnnotation is the URL of the machinepoollet metadata endpoint serving the IRI machine.
+	// Runtimes route metadata requests of the guest (e.g. to 169.254.169.254) to this URL.
+	MetadataURLAnnotation = "machinepoollet.ironcore.dev/metadata-url"
+
 	FieldOwner       = "machinepoollet.ironcore.dev/field-owner"
 	MachineFinalizer = "machinepoollet.ironcore.dev/machine"
 
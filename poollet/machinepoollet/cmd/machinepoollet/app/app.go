@@ -32,6 +32,7 @@ import (
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/controllers"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/mcm"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/mem"
+	machinepoolletmetrics "github.com/ironcore-dev/ironcore/poollet/machinepoollet/metrics"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/server"
 	"github.com/ironcore-dev/ironcore/utils/client/config"
 
@@ -48,6 +49,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 )
@@ -89,6 +91,8 @@ type Options struct {
 	NetworkDownwardAPILabels      map[string]string
 	NetworkDownwardAPIAnnotations map[string]string
 
+	MetadataURL string
+
 	TopologyRegionLabel string
 	TopologyZoneLabel   string
 
@@ -145,6 +149,9 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringToStringVar(&o.NetworkDownwardAPILabels, "network-downward-api-label", o.NetworkDownwardAPILabels, "Downward-API labels to set on the iri network.")
 	fs.StringToStringVar(&o.NetworkDownwardAPIAnnotations, "network-downward-api-annotation", o.NetworkDownwardAPIAnnotations, "Downward-API annotations to set on the iri network.")
 
+	fs.StringVar(&o.MetadataURL, "metadata-url", o.MetadataURL, "Base URL under which the machine runtime can reach the machinepoollet metadata server (see --metadata-bind-address). "+
+		"If set, the machine metadata URL is announced to the runtime.")
+
 	fs.StringVar(&o.TopologyRegionLabel, "topology-region-label", "", "Label to use for the region topology information.")
 	fs.StringVar(&o.TopologyZoneLabel, "topology-zone-label", "", "Label to use for the zone topology information.")
 
@@ -237,6 +244,10 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error getting port from address: %w", err)
 	}
 
+	if opts.MetadataURL != "" && opts.ServerFlags.Serving.MetadataAddress == "" {
+		return fmt.Errorf("--metadata-url requires --metadata-bind-address to be set")
+	}
+
 	if opts.HeartbeatInterval <= 0 {
 		return fmt.Errorf("--heartbeat-interval must be > 0, got %s", opts.HeartbeatInterval)
 	}
@@ -407,6 +418,7 @@ func Run(ctx context.Context, opts Options) error {
 		machineRuntime,
 		logger.WithName("server"),
 	)
+	srvOpts.Client = mgr.GetClient()
 	srv, err := server.New(cfg, srvOpts)
 	if err != nil {
 		return fmt.Errorf("error creating machinepoollet server: %w", err)
@@ -416,6 +428,10 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error adding machinepoollet server to manager: %w", err)
 	}
 
+	ctrlmetrics.Registry.MustRegister(machinepoolletmetrics.NewMachineStatsCollector(machineRuntime, machinepoolletmetrics.MachineStatsCollectorOptions{
+		Log: logger.WithName("metrics"),
+	}))
+
 	machineClassMapper := mcm.NewGeneric(machineRuntime, mcm.GenericOptions{})
 	if err := mgr.Add(machineClassMapper); err != nil {
 		return fmt.Errorf("error adding machine class mapper: %w", err)
@@ -426,12 +442,24 @@ func Run(ctx context.Context, opts Options) error {
 		return fmt.Errorf("error adding machine event mapper: %w", err)
 	}
 
-	machineEvents := irievent.NewGenerator(func(ctx context.Context) ([]*iri.Machine, error) {
+	machineEvents := irievent.NewWatchGenerator(func(ctx context.Context) ([]*iri.Machine, error) {
 		res, err := machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{})
 		if err != nil {
 			return nil, err
 		}
 		return res.Machines, nil
+	}, func(ctx context.Context, resourceVersion string, handle func(irievent.WatchEvent[*iri.Machine])) error {
+		stream, err := machineRuntime.WatchMachines(ctx, &iri.WatchMachinesRequest{ResourceVersion: resourceVersion})
+		if err != nil {
+			return err
+		}
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			handle(irievent.WatchEvent[*iri.Machine]{Type: res.Type, Object: res.Machine, ResourceVersion: res.ResourceVersion})
+		}
 	}, irievent.GeneratorOptions{
 		ChannelCapacity: opts.ChannelCapacity,
 		RelistPeriod:    opts.RelistPeriod,
@@ -483,6 +511,7 @@ func Run(ctx context.Context, opts Options) error {
 			NicDownwardAPIAnnotations:     opts.NicDownwardAPIAnnotations,
 			NetworkDownwardAPILabels:      opts.NetworkDownwardAPILabels,
 			NetworkDownwardAPIAnnotations: opts.NetworkDownwardAPIAnnotations,
+			MetadataURL:                   opts.MetadataURL,
 			WatchFilterValue:              opts.WatchFilterValue,
 			MaxConcurrentReconciles:       opts.MaxConcurrentReconciles,
 		}).SetupWithManager(mgr); err != nil {
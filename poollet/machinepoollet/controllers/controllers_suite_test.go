@@ -67,6 +67,8 @@ const (
 
 	fooDownwardAPILabel = "custom-downward-api-label"
 	fooAnnotation       = "foo"
+
+	metadataURL = "https://machinepoollet.example.org:20250"
 )
 
 func TestControllers(t *testing.T) {
@@ -244,6 +246,7 @@ func SetupTest() (*corev1.Namespace, *computev1alpha1.MachinePool, *computev1alp
 			MachineRuntimeVersion: machine.FakeVersion,
 			MachineClassMapper:    machineClassMapper,
 			MachinePoolName:       mp.Name,
+			MetadataURL:           metadataURL,
 			MachineDownwardAPILabels: map[string]string{
 				fooDownwardAPILabel: fmt.Sprintf("metadata.annotations['%s']", fooAnnotation),
 			},
@@ -8,4 +8,11 @@ const (
 	NetworkInterfaceNotReady = "NetworkInterfaceNotReady"
 	VolumeNotReady           = "VolumeNotReady"
 	IgnitionNotReady         = "IgnitionNotReady"
+	GuestConfigNotReady      = "GuestConfigNotReady"
+	MachineRestarted         = "MachineRestarted"
+	MachineResizing          = "MachineResizing"
+	MachineResizeFailed      = "MachineResizeFailed"
+	MachineIOFrozen          = "MachineIOFrozen"
+	MachineIOThawed          = "MachineIOThawed"
+	MachineIOFreezeFailed    = "MachineIOFreezeFailed"
 )
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/exp/maps"
@@ -18,9 +20,11 @@ import (
 	"github.com/ironcore-dev/controller-utils/conditionutils"
 	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	ipamv1alpha1 "github.com/ironcore-dev/ironcore/api/ipam/v1alpha1"
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
 	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
@@ -72,6 +76,10 @@ type MachineReconciler struct {
 	NetworkDownwardAPILabels      map[string]string
 	NetworkDownwardAPIAnnotations map[string]string
 
+	// MetadataURL is the base URL under which runtimes can reach the machinepoollet server.
+	// If set, the machine metadata URL is announced to the runtime via an IRI machine annotation.
+	MetadataURL string
+
 	WatchFilterValue string
 
 	MaxConcurrentReconciles int
@@ -83,6 +91,7 @@ type MachineReconciler struct {
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machines/finalizers,verbs=update
+//+kubebuilder:rbac:groups=compute.ironcore.dev,resources=machinepools,verbs=get;list;watch
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networkinterfaces,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=networking.ironcore.dev,resources=networkinterfaces/status,verbs=get;update;patch
@@ -308,6 +317,18 @@ func (r *MachineReconciler) iriMachineAnnotations(
 		v1alpha1.NetworkInterfaceMappingAnnotation: nicMappingString,
 	}
 
+	if restart := machine.Spec.Restart; restart != nil {
+		annotations[v1alpha1.RestartRequestedAtAnnotation] = restart.RequestedAt.UTC().Format(time.RFC3339)
+	}
+
+	if ioFreeze := machine.Spec.IOFreeze; ioFreeze != nil {
+		annotations[v1alpha1.IOFreezeRequestedAtAnnotation] = ioFreeze.RequestedAt.UTC().Format(time.RFC3339)
+	}
+
+	if r.MetadataURL != "" {
+		annotations[v1alpha1.MetadataURLAnnotation] = strings.TrimSuffix(r.MetadataURL, "/") + "/metadata/" + string(machine.UID)
+	}
+
 	for name, fieldPath := range r.MachineDownwardAPIAnnotations {
 		value, err := fieldpath.ExtractFieldPathAsString(machine, fieldPath)
 		if err != nil {
@@ -384,6 +405,7 @@ func (r *MachineReconciler) updateStatus(
 	machine *computev1alpha1.Machine,
 	iriMachine *iri.Machine,
 	nics []networkingv1alpha1.NetworkInterface,
+	conditions ...computev1alpha1.MachineCondition,
 ) error {
 	requiredIRIGeneration, err := r.getIRIMachineGeneration(iriMachine)
 	if err != nil {
@@ -404,7 +426,7 @@ func (r *MachineReconciler) updateStatus(
 
 	var errs []error
 
-	if err := r.updateMachineStatus(ctx, machine, iriMachine); err != nil {
+	if err := r.updateMachineStatus(ctx, machine, iriMachine, conditions...); err != nil {
 		errs = append(errs, err)
 	}
 	if err := r.updateNetworkInterfaceStatus(ctx, iriMachine, nics); err != nil {
@@ -471,7 +493,7 @@ func (r *MachineReconciler) updateNetworkInterfaceStatus(
 	return errors.Join(errs...)
 }
 
-func (r *MachineReconciler) updateMachineStatus(ctx context.Context, machine *computev1alpha1.Machine, iriMachine *iri.Machine) error {
+func (r *MachineReconciler) updateMachineStatus(ctx context.Context, machine *computev1alpha1.Machine, iriMachine *iri.Machine, conditions ...computev1alpha1.MachineCondition) error {
 	now := metav1.Now()
 
 	generation, err := r.getMachineGeneration(iriMachine)
@@ -498,6 +520,9 @@ func (r *MachineReconciler) updateMachineStatus(ctx context.Context, machine *co
 
 	base := machine.DeepCopy()
 
+	if state != machine.Status.State {
+		machine.Status.LastStateTransitionTime = &now
+	}
 	machine.Status.State = state
 	machine.Status.MachineID = machineID.String()
 	machine.Status.ObservedGeneration = generation
@@ -506,6 +531,13 @@ func (r *MachineReconciler) updateMachineStatus(ctx context.Context, machine *co
 	if err := ComputeMachineConditions(&machine.Status.Conditions, state, volumeStatuses, nicStatuses); err != nil {
 		return fmt.Errorf("error computing machine conditions: %w", err)
 	}
+	for _, cond := range conditions {
+		if err := conditionutils.UpdateSlice(&machine.Status.Conditions, string(cond.Type),
+			conditionutils.UpdateFromCondition{Condition: cond},
+		); err != nil {
+			return fmt.Errorf("error updating %s condition: %w", cond.Type, err)
+		}
+	}
 
 	if err := r.Status().Patch(ctx, machine, client.MergeFrom(base)); err != nil {
 		return fmt.Errorf("error patching status: %w", err)
@@ -580,6 +612,8 @@ func (r *MachineReconciler) prepareIRIPower(power computev1alpha1.Power) (iri.Po
 		return iri.Power_POWER_ON, nil
 	case computev1alpha1.PowerOff:
 		return iri.Power_POWER_OFF, nil
+	case computev1alpha1.PowerSuspended:
+		return iri.Power_POWER_SUSPENDED, nil
 	default:
 		return 0, fmt.Errorf("unknown power %q", power)
 	}
@@ -606,6 +640,155 @@ func (r *MachineReconciler) updateIRIPower(ctx context.Context, log logr.Logger,
 	return nil
 }
 
+func (r *MachineReconciler) prepareIRIRebootMode(mode computev1alpha1.RestartMode) (iri.RebootMode, error) {
+	switch mode {
+	case computev1alpha1.RestartModeSoft, "":
+		return iri.RebootMode_REBOOT_MODE_SOFT, nil
+	case computev1alpha1.RestartModeHard:
+		return iri.RebootMode_REBOOT_MODE_HARD, nil
+	default:
+		return 0, fmt.Errorf("unknown restart mode %q", mode)
+	}
+}
+
+func (r *MachineReconciler) updateIRIRestart(ctx context.Context, log logr.Logger, machine *computev1alpha1.Machine, iriMachine *iri.Machine) error {
+	restart := machine.Spec.Restart
+	if restart == nil {
+		log.V(1).Info("No restart requested")
+		return nil
+	}
+
+	requestedAt := restart.RequestedAt.UTC().Format(time.RFC3339)
+	if iriMachine.GetMetadata().GetAnnotations()[v1alpha1.RestartRequestedAtAnnotation] == requestedAt {
+		log.V(1).Info("Restart already applied", "RequestedAt", requestedAt)
+		return nil
+	}
+
+	if machine.Spec.Power != computev1alpha1.PowerOn {
+		log.V(1).Info("Machine is not powered on, skipping restart", "RequestedAt", requestedAt)
+		return nil
+	}
+
+	mode, err := r.prepareIRIRebootMode(restart.Mode)
+	if err != nil {
+		return fmt.Errorf("error preparing iri reboot mode: %w", err)
+	}
+
+	log.V(1).Info("Rebooting machine", "RequestedAt", requestedAt, "Mode", mode)
+	if _, err := r.MachineRuntime.RebootMachine(ctx, &iri.RebootMachineRequest{
+		MachineId: iriMachine.Metadata.Id,
+		Mode:      mode,
+	}); err != nil {
+		return fmt.Errorf("error rebooting machine: %w", err)
+	}
+
+	r.Eventf(machine, nil, corev1.EventTypeNormal, machinepoolletEvents.MachineRestarted, "Restart", "Restarted machine (mode: %s)", restart.Mode)
+
+	// Persist the restart marker right away, so a failure in a later update step does not reboot the machine again.
+	log.V(1).Info("Marking restart as applied", "RequestedAt", requestedAt)
+	annotations := maps.Clone(iriMachine.GetMetadata().GetAnnotations())
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[v1alpha1.RestartRequestedAtAnnotation] = requestedAt
+	if _, err := r.MachineRuntime.UpdateMachineAnnotations(ctx, &iri.UpdateMachineAnnotationsRequest{
+		MachineId:   iriMachine.Metadata.Id,
+		Annotations: annotations,
+	}); err != nil {
+		return fmt.Errorf("error marking restart as applied: %w", err)
+	}
+	iriMachine.Metadata.Annotations = annotations
+	return nil
+}
+
+// machineClassFitsPool reports whether the machine pool has room left for the machine with the given machine class.
+// As the machine pool status already accounts the machine with its new machine class, the allocatable
+// quantity is derived from the machine pool capacity and the other machines of that class in the pool.
+func (r *MachineReconciler) machineClassFitsPool(ctx context.Context, machine *computev1alpha1.Machine, machineClassName string) (bool, error) {
+	machinePool := &computev1alpha1.MachinePool{}
+	if err := r.Get(ctx, client.ObjectKey{Name: r.MachinePoolName}, machinePool); err != nil {
+		return false, fmt.Errorf("error getting machine pool %s: %w", r.MachinePoolName, err)
+	}
+
+	capacity, ok := machinePool.Status.Capacity[corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, machineClassName)]
+	if !ok {
+		return false, nil
+	}
+
+	machineList := &computev1alpha1.MachineList{}
+	if err := r.List(ctx, machineList,
+		client.MatchingFields{computeclient.MachineSpecMachinePoolRefNameField: r.MachinePoolName},
+	); err != nil {
+		return false, fmt.Errorf("error listing machines in machine pool %s: %w", r.MachinePoolName, err)
+	}
+
+	allocatable := capacity.Value()
+	for _, other := range machineList.Items {
+		if other.UID != machine.UID && other.Spec.MachineClassRef.Name == machineClassName {
+			allocatable--
+		}
+	}
+	return allocatable > 0, nil
+}
+
+// updateIRIMachineClass resizes the iri machine to the machine class of the machine if they differ.
+// It returns the Resizing condition of the machine, or nil if the machine has never been resized.
+func (r *MachineReconciler) updateIRIMachineClass(ctx context.Context, log logr.Logger, machine *computev1alpha1.Machine, iriMachine *iri.Machine) (*computev1alpha1.MachineCondition, error) {
+	machineClassName := machine.Spec.MachineClassRef.Name
+	class, ok, err := r.prepareIRIMachineClass(ctx, machine, machineClassName)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		log.V(1).Info("Machine class is not ready", "MachineClass", machineClassName)
+		return nil, nil
+	}
+
+	if class == iriMachine.Spec.Class {
+		if computev1alpha1.FindMachineCondition(machine.Status.Conditions, computev1alpha1.MachineResizing) == nil {
+			return nil, nil
+		}
+		return &computev1alpha1.MachineCondition{
+			Type:    computev1alpha1.MachineResizing,
+			Status:  corev1.ConditionFalse,
+			Reason:  "Resized",
+			Message: fmt.Sprintf("Machine has been resized to machine class %s", machineClassName),
+		}, nil
+	}
+
+	fits, err := r.machineClassFitsPool(ctx, machine, machineClassName)
+	if err != nil {
+		return nil, err
+	}
+	if !fits {
+		log.V(1).Info("Machine class does not fit machine pool", "MachineClass", machineClassName)
+		r.Eventf(machine, nil, corev1.EventTypeWarning, machinepoolletEvents.MachineResizeFailed, "Resize",
+			"Machine pool %s has no room left for machine class %s", r.MachinePoolName, machineClassName)
+		return &computev1alpha1.MachineCondition{
+			Type:    computev1alpha1.MachineResizing,
+			Status:  corev1.ConditionFalse,
+			Reason:  "InsufficientCapacity",
+			Message: fmt.Sprintf("Machine pool %s has no room left for machine class %s", r.MachinePoolName, machineClassName),
+		}, nil
+	}
+
+	log.V(1).Info("Resizing machine", "MachineClass", machineClassName, "Class", class)
+	if _, err := r.MachineRuntime.UpdateMachineClass(ctx, &iri.UpdateMachineClassRequest{
+		MachineId: iriMachine.Metadata.Id,
+		Class:     class,
+	}); err != nil {
+		return nil, fmt.Errorf("error resizing machine: %w", err)
+	}
+
+	r.Eventf(machine, nil, corev1.EventTypeNormal, machinepoolletEvents.MachineResizing, "Resize", "Resizing machine to machine class %s", machineClassName)
+	return &computev1alpha1.MachineCondition{
+		Type:    computev1alpha1.MachineResizing,
+		Status:  corev1.ConditionTrue,
+		Reason:  "Resizing",
+		Message: fmt.Sprintf("Machine is being resized to machine class %s", machineClassName),
+	}, nil
+}
+
 func (r *MachineReconciler) update(
 	ctx context.Context,
 	log logr.Logger,
@@ -634,6 +817,28 @@ func (r *MachineReconciler) update(
 		errs = append(errs, fmt.Errorf("error updating power state: %w", err))
 	}
 
+	log.V(1).Info("Updating restart")
+	if err := r.updateIRIRestart(ctx, log, machine, iriMachine); err != nil {
+		errs = append(errs, fmt.Errorf("error updating restart: %w", err))
+	}
+
+	log.V(1).Info("Updating machine class")
+	var conditions []computev1alpha1.MachineCondition
+	resizingCondition, err := r.updateIRIMachineClass(ctx, log, machine, iriMachine)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error updating machine class: %w", err))
+	} else if resizingCondition != nil {
+		conditions = append(conditions, *resizingCondition)
+	}
+
+	log.V(1).Info("Updating io freeze")
+	ioFrozenCondition, ioFreezeExpiresIn, err := r.updateIRIIOFreeze(ctx, log, machine, iriMachine)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error updating io freeze: %w", err))
+	} else if ioFrozenCondition != nil {
+		conditions = append(conditions, *ioFrozenCondition)
+	}
+
 	if len(errs) > 0 {
 		return ctrl.Result{}, fmt.Errorf("error(s) updating machine: %v", errs)
 	}
@@ -651,12 +856,12 @@ func (r *MachineReconciler) update(
 	}
 
 	log.V(1).Info("Updating machine status")
-	if err := r.updateStatus(ctx, log, machine, iriMachine, nics); err != nil {
+	if err := r.updateStatus(ctx, log, machine, iriMachine, nics, conditions...); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
 	}
 
 	log.V(1).Info("Updated existing machine")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: ioFreezeExpiresIn}, nil
 }
 
 func (r *MachineReconciler) updateIRIAnnotations(
@@ -690,7 +895,8 @@ func (r *MachineReconciler) updateIRIAnnotations(
 var iriMachineStateToMachineState = map[iri.MachineState]computev1alpha1.MachineState{
 	iri.MachineState_MACHINE_PENDING:     computev1alpha1.MachineStatePending,
 	iri.MachineState_MACHINE_RUNNING:     computev1alpha1.MachineStateRunning,
-	iri.MachineState_MACHINE_SUSPENDED:   computev1alpha1.MachineStateShutdown,
+	iri.MachineState_MACHINE_SUSPENDED:   computev1alpha1.MachineStateSuspended,
+	iri.MachineState_MACHINE_STOPPED:     computev1alpha1.MachineStateShutdown,
 	iri.MachineState_MACHINE_TERMINATED:  computev1alpha1.MachineStateTerminated,
 	iri.MachineState_MACHINE_TERMINATING: computev1alpha1.MachineStateTerminating,
 }
@@ -762,6 +968,60 @@ func (r *MachineReconciler) prepareIRIIgnitionData(ctx context.Context, machine
 	return data, true, nil
 }
 
+func (r *MachineReconciler) prepareIRIGuestConfig(ctx context.Context, machine *computev1alpha1.Machine, guestConfig *computev1alpha1.MachineGuestConfig) (*iri.GuestConfig, bool, error) {
+	var sshPublicKeys []string
+	for _, sshPublicKeyRef := range guestConfig.SSHPublicKeyRefs {
+		data, ok, err := r.getGuestConfigSecretData(ctx, machine, sshPublicKeyRef, computev1alpha1.DefaultSSHPublicKeyKey)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		sshPublicKeys = append(sshPublicKeys, strings.TrimSpace(string(data)))
+	}
+
+	var userData []byte
+	if userDataRef := guestConfig.UserDataRef; userDataRef != nil {
+		data, ok, err := r.getGuestConfigSecretData(ctx, machine, *userDataRef, computev1alpha1.DefaultUserDataKey)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		userData = data
+	}
+
+	return &iri.GuestConfig{
+		Hostname:      guestConfig.Hostname,
+		SshPublicKeys: sshPublicKeys,
+		Timezone:      guestConfig.Timezone,
+		NtpServers:    guestConfig.NTPServers,
+		UserData:      userData,
+	}, true, nil
+}
+
+func (r *MachineReconciler) getGuestConfigSecretData(ctx context.Context, machine *computev1alpha1.Machine, ref commonv1alpha1.SecretKeySelector, defaultKey string) ([]byte, bool, error) {
+	secret := &corev1.Secret{}
+	secretKey := client.ObjectKey{Namespace: machine.Namespace, Name: ref.Name}
+	if err := r.Get(ctx, secretKey, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, false, err
+		}
+
+		r.Eventf(machine, nil, corev1.EventTypeNormal, machinepoolletEvents.GuestConfigNotReady, "GuestConfig", "Guest config secret %s not found", ref.Name)
+		return nil, false, nil
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = defaultKey
+	}
+
+	data, ok := secret.Data[key]
+	if !ok {
+		r.Eventf(machine, nil, corev1.EventTypeNormal, machinepoolletEvents.GuestConfigNotReady, "GuestConfig", "Guest config secret %s has no data at key %s", ref.Name, key)
+		return nil, false, nil
+	}
+
+	return data, true, nil
+}
+
 func (r *MachineReconciler) prepareIRIMachine(
 	ctx context.Context,
 	log logr.Logger,
@@ -823,8 +1083,14 @@ func (r *MachineReconciler) prepareIRIMachine(
 
 	var iriGuestConfig *iri.GuestConfig
 	if guestConfig := machine.Spec.GuestConfig; guestConfig != nil {
-		iriGuestConfig = &iri.GuestConfig{
-			Hostname: guestConfig.Hostname,
+		config, guestConfigOK, err := r.prepareIRIGuestConfig(ctx, machine, guestConfig)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("error preparing iri guest config: %w", err))
+		case !guestConfigOK:
+			ok = false
+		default:
+			iriGuestConfig = config
 		}
 	}
 
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-logr/logr"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	machinepoolletEvents "github.com/ironcore-dev/ironcore/poollet/machinepoollet/controllers/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func isPermanentIOFreezeError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound, codes.Unimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+// updateIRIIOFreeze freezes or thaws the I/O of the iri machine as requested by the machine.
+// It returns the IOFrozen condition of the machine, or nil if it does not change, and the duration
+// after which the requested freeze expires.
+func (r *MachineReconciler) updateIRIIOFreeze(ctx context.Context, log logr.Logger, machine *computev1alpha1.Machine, iriMachine *iri.Machine) (*computev1alpha1.MachineCondition, time.Duration, error) {
+	now := time.Now()
+	cond := computev1alpha1.FindMachineCondition(machine.Status.Conditions, computev1alpha1.MachineIOFrozen)
+	frozen := cond != nil && cond.Status == corev1.ConditionTrue
+
+	ioFreeze := machine.Spec.IOFreeze
+	if ioFreeze == nil || !now.Before(computev1alpha1.MachineIOFreezeDeadline(ioFreeze)) {
+		if !frozen {
+			log.V(1).Info("No io freeze requested")
+			return nil, 0, nil
+		}
+
+		log.V(1).Info("Thawing machine")
+		if _, err := r.MachineRuntime.ThawMachine(ctx, &iri.ThawMachineRequest{
+			MachineId: iriMachine.Metadata.Id,
+		}); err != nil {
+			return nil, 0, fmt.Errorf("error thawing machine: %w", err)
+		}
+
+		r.Eventf(machine, nil, corev1.EventTypeNormal, machinepoolletEvents.MachineIOThawed, "Thaw", "Thawed machine I/O")
+		return &computev1alpha1.MachineCondition{
+			Type:    computev1alpha1.MachineIOFrozen,
+			Status:  corev1.ConditionFalse,
+			Reason:  "Thawed",
+			Message: "Machine I/O has been thawed",
+		}, 0, nil
+	}
+
+	deadline := computev1alpha1.MachineIOFreezeDeadline(ioFreeze)
+	requestedAt := ioFreeze.RequestedAt.UTC().Format(time.RFC3339)
+	if iriMachine.GetMetadata().GetAnnotations()[v1alpha1.IOFreezeRequestedAtAnnotation] == requestedAt {
+		log.V(1).Info("IO freeze already applied", "RequestedAt", requestedAt)
+		return nil, deadline.Sub(now), nil
+	}
+
+	// The runtime thaws the machine on its own once the freeze expired, even if we cannot reach it anymore.
+	// FreezeMachine only returns once the I/O is frozen, so the machine can be reported as frozen right after.
+	timeoutSeconds := int64(math.Ceil(deadline.Sub(now).Seconds()))
+	log.V(1).Info("Freezing machine", "RequestedAt", requestedAt, "TimeoutSeconds", timeoutSeconds)
+	if _, err := r.MachineRuntime.FreezeMachine(ctx, &iri.FreezeMachineRequest{
+		MachineId:      iriMachine.Metadata.Id,
+		TimeoutSeconds: timeoutSeconds,
+	}); err != nil {
+		if !isPermanentIOFreezeError(err) {
+			return nil, 0, fmt.Errorf("error freezing machine: %w", err)
+		}
+
+		r.Eventf(machine, nil, corev1.EventTypeWarning, machinepoolletEvents.MachineIOFreezeFailed, "Freeze", "Failed to freeze machine I/O: %s", status.Convert(err).Message())
+		return &computev1alpha1.MachineCondition{
+			Type:    computev1alpha1.MachineIOFrozen,
+			Status:  corev1.ConditionFalse,
+			Reason:  "FreezeFailed",
+			Message: fmt.Sprintf("Failed to freeze machine I/O: %s", status.Convert(err).Message()),
+		}, 0, nil
+	}
+
+	r.Eventf(machine, nil, corev1.EventTypeNormal, machinepoolletEvents.MachineIOFrozen, "Freeze", "Froze machine I/O until %s", deadline.UTC().Format(time.RFC3339))
+	return &computev1alpha1.MachineCondition{
+		Type:    computev1alpha1.MachineIOFrozen,
+		Status:  corev1.ConditionTrue,
+		Reason:  "Frozen",
+		Message: fmt.Sprintf("Machine I/O is frozen until %s", deadline.UTC().Format(time.RFC3339)),
+	}, deadline.Sub(now), nil
+}
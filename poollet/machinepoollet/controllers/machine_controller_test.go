@@ -16,6 +16,7 @@ import (
 	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/fault"
 	testingmachine "github.com/ironcore-dev/ironcore/iri/testing/machine"
 	poolletutils "github.com/ironcore-dev/ironcore/poollet/common/utils"
 	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
@@ -23,6 +24,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -149,6 +151,7 @@ var _ = Describe("MachineController", func() {
 		By("inspecting the iri machine")
 		Expect(iriMachine.Metadata.Labels).To(HaveKeyWithValue(poolletutils.DownwardAPILabel(machinepoolletv1alpha1.MachineDownwardAPIPrefix, fooDownwardAPILabel), fooAnnotationValue))
 		Expect(iriMachine.Metadata.Labels).To(HaveKeyWithValue(machinepoolletv1alpha1.MachineUIDLabel, string(machine.UID)))
+		Expect(iriMachine.Metadata.Annotations).To(HaveKeyWithValue(machinepoolletv1alpha1.MetadataURLAnnotation, metadataURL+"/metadata/"+string(machine.UID)))
 		Expect(iriMachine.Spec.Class).To(Equal(mc.Name))
 		Expect(iriMachine.Spec.Power).To(Equal(iri.Power_POWER_ON))
 		Expect(iriMachine.Spec.Volumes).To(ConsistOf(ProtoEqual(&iri.Volume{
@@ -319,6 +322,19 @@ var _ = Describe("MachineController", func() {
 			}
 		})).Should(Succeed())
 
+		By("creating a guest config secret")
+		guestConfigSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "guest-config-",
+			},
+			Data: map[string][]byte{
+				computev1alpha1.DefaultSSHPublicKeyKey: []byte("ssh-ed25519 AAAA user@host\n"),
+				computev1alpha1.DefaultUserDataKey:     []byte("#cloud-config\n"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, guestConfigSecret)).To(Succeed())
+
 		By("creating a machine")
 		machine := &computev1alpha1.Machine{
 			ObjectMeta: metav1.ObjectMeta{
@@ -349,6 +365,12 @@ var _ = Describe("MachineController", func() {
 				},
 				GuestConfig: &computev1alpha1.MachineGuestConfig{
 					Hostname: "machine-hostname",
+					SSHPublicKeyRefs: []commonv1alpha1.SecretKeySelector{
+						{Name: guestConfigSecret.Name},
+					},
+					Timezone:    "Europe/Berlin",
+					NTPServers:  []string{"ntp.example.org"},
+					UserDataRef: &commonv1alpha1.SecretKeySelector{Name: guestConfigSecret.Name},
 				},
 			},
 		}
@@ -366,7 +388,13 @@ var _ = Describe("MachineController", func() {
 		Expect(iriMachine.Metadata.Labels).To(HaveKeyWithValue(machinepoolletv1alpha1.MachineUIDLabel, string(machine.UID)))
 		Expect(iriMachine.Spec.Class).To(Equal(mc.Name))
 		Expect(iriMachine.Spec.Power).To(Equal(iri.Power_POWER_ON))
-		Expect(iriMachine.Spec.GuestConfig.Hostname).To(Equal("machine-hostname"))
+		Expect(iriMachine.Spec.GuestConfig).To(ProtoEqual(&iri.GuestConfig{
+			Hostname:      "machine-hostname",
+			SshPublicKeys: []string{"ssh-ed25519 AAAA user@host"},
+			Timezone:      "Europe/Berlin",
+			NtpServers:    []string{"ntp.example.org"},
+			UserData:      []byte("#cloud-config\n"),
+		}))
 		Expect(iriMachine.Spec.Volumes).To(ConsistOf(ProtoEqual(&iri.Volume{
 			Name:   "primary",
 			Device: "oda",
@@ -482,6 +510,307 @@ var _ = Describe("MachineController", func() {
 
 		By("waiting for the iri machine to be updated")
 		Eventually(iriMachine).Should(HaveField("Spec.Power", Equal(iri.Power_POWER_OFF)))
+
+		By("suspending the machine")
+		base = machine.DeepCopy()
+		machine.Spec.Power = computev1alpha1.PowerSuspended
+		Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri machine to be suspended")
+		Eventually(iriMachine).Should(HaveField("Spec.Power", Equal(iri.Power_POWER_SUSPENDED)))
+
+		By("reporting the iri machine as suspended")
+		iriMachine = &testingmachine.FakeMachine{Machine: proto.Clone(iriMachine.Machine).(*iri.Machine)}
+		iriMachine.Status.ObservedGeneration = iriMachine.Metadata.Generation
+		iriMachine.Status.State = iri.MachineState_MACHINE_SUSPENDED
+		srv.SetMachines([]*testingmachine.FakeMachine{iriMachine})
+
+		By("waiting for the machine to report the suspended state")
+		Eventually(Object(machine)).Should(HaveField("Status.State", Equal(computev1alpha1.MachineStateSuspended)))
+	})
+
+	It("should create a machine once its guest config secret is created", func(ctx SpecContext) {
+		const guestConfigSecretName = "guest-config"
+
+		By("creating a machine referencing a missing guest config secret")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: mc.Name},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: mp.Name},
+				GuestConfig: &computev1alpha1.MachineGuestConfig{
+					SSHPublicKeyRefs: []commonv1alpha1.SecretKeySelector{
+						{Name: guestConfigSecretName},
+					},
+					UserDataRef: &commonv1alpha1.SecretKeySelector{Name: guestConfigSecretName},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, machine)
+
+		By("asserting the machine is not created while the secret is missing")
+		Consistently(srv).Should(HaveField("Machines", BeEmpty()))
+
+		By("creating the guest config secret")
+		guestConfigSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns.Name,
+				Name:      guestConfigSecretName,
+			},
+			Data: map[string][]byte{
+				computev1alpha1.DefaultSSHPublicKeyKey: []byte("ssh-ed25519 AAAA user@host\n"),
+				computev1alpha1.DefaultUserDataKey:     []byte("#cloud-config\n"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, guestConfigSecret)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, guestConfigSecret)
+
+		By("waiting for the machine to be created with the guest config")
+		Eventually(srv).Should(HaveField("Machines", HaveLen(1)))
+		_, iriMachine := GetSingleMapEntry(srv.Machines)
+		Expect(iriMachine.Spec.GuestConfig).To(ProtoEqual(&iri.GuestConfig{
+			SshPublicKeys: []string{"ssh-ed25519 AAAA user@host"},
+			UserData:      []byte("#cloud-config\n"),
+		}))
+	})
+
+	It("should restart a machine when requested", func(ctx SpecContext) {
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: mc.Name},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: mp.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, machine)
+
+		By("waiting for the machine to be created")
+		Eventually(srv).Should(HaveField("Machines", HaveLen(1)))
+
+		By("inspecting the machine")
+		_, iriMachine := GetSingleMapEntry(srv.Machines)
+		Expect(iriMachine.Reboots).To(BeEmpty())
+
+		By("requesting a hard restart of the machine")
+		requestedAt := metav1.NewTime(time.Now().Truncate(time.Second))
+		base := machine.DeepCopy()
+		machine.Spec.Restart = &computev1alpha1.MachineRestart{
+			RequestedAt: requestedAt,
+			Mode:        computev1alpha1.RestartModeHard,
+		}
+		Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri machine to be rebooted once")
+		Eventually(iriMachine).Should(SatisfyAll(
+			HaveField("Reboots", Equal([]iri.RebootMode{iri.RebootMode_REBOOT_MODE_HARD})),
+			HaveField("Metadata.Annotations", HaveKeyWithValue(
+				machinepoolletv1alpha1.RestartRequestedAtAnnotation,
+				requestedAt.UTC().Format(time.RFC3339),
+			)),
+		))
+		Consistently(iriMachine).Should(HaveField("Reboots", HaveLen(1)))
+	})
+
+	It("should restart a machine only once when another update step fails", func(ctx SpecContext) {
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: mc.Name},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: mp.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, machine)
+
+		By("waiting for the machine to be created")
+		Eventually(srv).Should(HaveField("Machines", HaveLen(1)))
+		_, iriMachine := GetSingleMapEntry(srv.Machines)
+
+		By("failing the next volume attachments")
+		srv.Faults.FailNext(iri.MachineRuntime_AttachVolume_FullMethodName,
+			fault.Error(codes.Unavailable),
+			fault.Error(codes.Unavailable),
+			fault.Error(codes.Unavailable),
+		)
+
+		By("adding a volume and requesting a restart of the machine")
+		requestedAt := metav1.NewTime(time.Now().Truncate(time.Second))
+		base := machine.DeepCopy()
+		machine.Spec.Volumes = []computev1alpha1.Volume{
+			{
+				Name: "disk",
+				VolumeSource: computev1alpha1.VolumeSource{
+					LocalDisk: &computev1alpha1.LocalDiskVolumeSource{},
+				},
+			},
+		}
+		machine.Spec.Restart = &computev1alpha1.MachineRestart{
+			RequestedAt: requestedAt,
+			Mode:        computev1alpha1.RestartModeHard,
+		}
+		Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the volume to be attached after the failures")
+		Eventually(iriMachine).Should(HaveField("Spec.Volumes", ConsistOf(HaveField("Name", "disk"))))
+
+		By("asserting the iri machine was rebooted only once")
+		Expect(srv.Faults.Calls(iri.MachineRuntime_AttachVolume_FullMethodName)).To(BeNumerically(">=", 4))
+		Consistently(iriMachine).Should(HaveField("Reboots", Equal([]iri.RebootMode{iri.RebootMode_REBOOT_MODE_HARD})))
+	})
+
+	It("should freeze and thaw the I/O of a machine when requested", func(ctx SpecContext) {
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: mc.Name},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: mp.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, machine)
+
+		By("waiting for the machine to be created")
+		Eventually(srv).Should(HaveField("Machines", HaveLen(1)))
+		_, iriMachine := GetSingleMapEntry(srv.Machines)
+		Expect(iriMachine.FrozenUntil).To(BeZero())
+
+		By("requesting an I/O freeze of the machine")
+		requestedAt := metav1.NewTime(time.Now().Truncate(time.Second))
+		base := machine.DeepCopy()
+		machine.Spec.IOFreeze = &computev1alpha1.MachineIOFreeze{
+			RequestedAt: requestedAt,
+			Timeout:     metav1.Duration{Duration: 5 * time.Minute},
+		}
+		Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri machine and the machine to be frozen")
+		Eventually(iriMachine).Should(SatisfyAll(
+			HaveField("FrozenUntil", Not(BeZero())),
+			HaveField("Metadata.Annotations", HaveKeyWithValue(
+				machinepoolletv1alpha1.IOFreezeRequestedAtAnnotation,
+				requestedAt.UTC().Format(time.RFC3339),
+			)),
+		))
+		Eventually(Object(machine)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", computev1alpha1.MachineIOFrozen),
+			HaveField("Status", corev1.ConditionTrue),
+		))))
+
+		By("releasing the I/O freeze")
+		base = machine.DeepCopy()
+		machine.Spec.IOFreeze = nil
+		Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri machine and the machine to be thawed")
+		Eventually(iriMachine).Should(HaveField("FrozenUntil", BeZero()))
+		Eventually(Object(machine)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", computev1alpha1.MachineIOFrozen),
+			HaveField("Status", corev1.ConditionFalse),
+		))))
+	})
+
+	It("should resize a machine to another machine class", func(ctx SpecContext) {
+		By("creating a larger machine class")
+		largeMachineClass := &computev1alpha1.MachineClass{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "test-mc-large-",
+			},
+			Capabilities: corev1alpha1.ResourceList{
+				corev1alpha1.ResourceCPU:    resource.MustParse("2"),
+				corev1alpha1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, largeMachineClass)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, largeMachineClass)
+
+		By("announcing the larger machine class in the runtime")
+		srv.SetMachineClasses([]*testingmachine.FakeMachineClassStatus{
+			{
+				MachineClassStatus: iri.MachineClassStatus{
+					MachineClass: &iri.MachineClass{
+						Name: mc.Name,
+						Capabilities: &iri.MachineClassCapabilities{
+							Resources: map[string]int64{
+								string(corev1alpha1.ResourceCPU):    mc.Capabilities.CPU().Value(),
+								string(corev1alpha1.ResourceMemory): mc.Capabilities.Memory().Value(),
+							},
+						},
+					},
+					Quantity: 1,
+				},
+			},
+			{
+				MachineClassStatus: iri.MachineClassStatus{
+					MachineClass: &iri.MachineClass{
+						Name: largeMachineClass.Name,
+						Capabilities: &iri.MachineClassCapabilities{
+							Resources: map[string]int64{
+								string(corev1alpha1.ResourceCPU):    largeMachineClass.Capabilities.CPU().Value(),
+								string(corev1alpha1.ResourceMemory): largeMachineClass.Capabilities.Memory().Value(),
+							},
+						},
+					},
+					Quantity: 1,
+				},
+			},
+		})
+
+		By("waiting for the machine pool to report capacity for the larger machine class")
+		Eventually(Object(mp)).Should(HaveField("Status.Capacity", HaveKeyWithValue(
+			corev1alpha1.ClassCountFor(corev1alpha1.ClassTypeMachineClass, largeMachineClass.Name),
+			resource.MustParse("1"),
+		)))
+
+		By("creating a machine")
+		machine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "machine-",
+			},
+			Spec: computev1alpha1.MachineSpec{
+				MachineClassRef: corev1.LocalObjectReference{Name: mc.Name},
+				MachinePoolRef:  &corev1.LocalObjectReference{Name: mp.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, machine)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, machine)
+
+		By("waiting for the machine to be created")
+		Eventually(srv).Should(HaveField("Machines", HaveLen(1)))
+		_, iriMachine := GetSingleMapEntry(srv.Machines)
+		Expect(iriMachine.Spec.Class).To(Equal(mc.Name))
+
+		By("resizing the machine to the larger machine class")
+		base := machine.DeepCopy()
+		machine.Spec.MachineClassRef = corev1.LocalObjectReference{Name: largeMachineClass.Name}
+		Expect(k8sClient.Patch(ctx, machine, client.MergeFrom(base))).To(Succeed())
+
+		By("waiting for the iri machine to be resized")
+		Eventually(iriMachine).Should(HaveField("Spec.Class", Equal(largeMachineClass.Name)))
+
+		By("waiting for the machine to report the resize as completed")
+		Eventually(Object(machine)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", computev1alpha1.MachineResizing),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", "Resized"),
+		))))
 	})
 
 	It("should correctly manage state of a machine", func(ctx SpecContext) {
@@ -509,7 +838,10 @@ var _ = Describe("MachineController", func() {
 		iriMachine.Status.ObservedGeneration = 1
 		iriMachine.Status.State = iri.MachineState_MACHINE_RUNNING
 		srv.SetMachines([]*testingmachine.FakeMachine{iriMachine})
-		Eventually(Object(machine)).Should(HaveField("Status.State", Equal(computev1alpha1.MachineStateRunning)))
+		Eventually(Object(machine)).Should(SatisfyAll(
+			HaveField("Status.State", Equal(computev1alpha1.MachineStateRunning)),
+			HaveField("Status.LastStateTransitionTime", Not(BeNil())),
+		))
 
 		By("waiting for the machine conditions to be updated")
 		Eventually(Object(machine)).Should(SatisfyAll(
@@ -112,6 +112,12 @@ func (r *MachineReconciler) getVolumesForMachine(ctx context.Context, machine *c
 			continue
 		}
 
+		if cond := storagev1alpha1.FindVolumeCondition(volume.Status.Conditions, storagev1alpha1.VolumeReverting); cond != nil &&
+			cond.Status == corev1.ConditionTrue && cond.Reason == storagev1alpha1.VolumeRevertingReasonReverting {
+			r.Eventf(machine, nil, corev1.EventTypeNormal, events.VolumeNotReady, "Volume %s is being reverted", volume.Name)
+			continue
+		}
+
 		volumes = append(volumes, volume)
 	}
 	return volumes, errors.Join(errs...)
@@ -15,6 +15,7 @@ import (
 	computeclient "github.com/ironcore-dev/ironcore/internal/client/compute"
 	"github.com/ironcore-dev/ironcore/iri/apis/machine"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capability"
 	poolletutils "github.com/ironcore-dev/ironcore/poollet/common/utils"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/mcm"
 	ironcoreclient "github.com/ironcore-dev/ironcore/utils/client"
@@ -137,7 +138,23 @@ func (r *MachinePoolReconciler) calculateCapacity(
 	return capacity, quota.SubtractWithNonNegativeResult(capacity, usedResources), supported, nil
 }
 
-func (r *MachinePoolReconciler) updateStatus(ctx context.Context, log logr.Logger, machinePool *computev1alpha1.MachinePool, machines []computev1alpha1.Machine, machineClassList []computev1alpha1.MachineClass) error {
+func (r *MachinePoolReconciler) getRuntimeCapabilities(ctx context.Context) ([]string, error) {
+	res, err := r.MachineRuntime.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting machine runtime version: %w", err)
+	}
+	return capability.Names(res.Capabilities), nil
+}
+
+func (r *MachinePoolReconciler) updateStatus(
+	ctx context.Context,
+	log logr.Logger,
+	machinePool *computev1alpha1.MachinePool,
+	machines []computev1alpha1.Machine,
+	machineClassList []computev1alpha1.MachineClass,
+	capabilities []string,
+	capabilitiesErr error,
+) error {
 	capacity, allocatable, supported, err := r.calculateCapacity(ctx, log, machines, machineClassList)
 	if err != nil {
 		return fmt.Errorf("error calculating pool resources:%w", err)
@@ -152,8 +169,10 @@ func (r *MachinePoolReconciler) updateStatus(ctx context.Context, log logr.Logge
 	machinePool.Status.DaemonEndpoints.MachinepoolletEndpoint.Port = r.Port
 
 	r.applyReadyCondition(machinePool)
+	applyRuntimeCapabilitiesCondition(machinePool, capabilities, capabilitiesErr)
 
-	if err := r.Status().Patch(ctx, machinePool, client.MergeFrom(base)); err != nil {
+	// The condition list is shared with other controllers (e.g. the Drained condition), guard against overwriting it.
+	if err := r.Status().Patch(ctx, machinePool, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
 		return fmt.Errorf("error patching machine pool status: %w", err)
 	}
 
@@ -184,6 +203,35 @@ func (r *MachinePoolReconciler) applyReadyCondition(machinePool *computev1alpha1
 	machinePool.Status.Conditions = computev1alpha1.SetMachinePoolCondition(machinePool.Status.Conditions, desired)
 }
 
+// applyRuntimeCapabilitiesCondition updates machinePool.Status.Conditions[RuntimeCapabilities] from the
+// capabilities reported by the machine runtime. It only touches the condition if it changed, as every
+// update advances its LastUpdateTime and would trigger another reconciliation.
+func applyRuntimeCapabilitiesCondition(machinePool *computev1alpha1.MachinePool, capabilities []string, capabilitiesErr error) {
+	desired := computev1alpha1.MachinePoolCondition{
+		Type:               computev1alpha1.MachinePoolRuntimeCapabilities,
+		Status:             corev1.ConditionTrue,
+		Reason:             poolletutils.RuntimeCapabilitiesDiscoveredReason,
+		Message:            poolletutils.RuntimeCapabilitiesMessage(capabilities),
+		ObservedGeneration: machinePool.Generation,
+	}
+	if capabilitiesErr != nil {
+		desired.Status = corev1.ConditionFalse
+		desired.Reason = poolletutils.RuntimeCapabilitiesVersionFailedReason
+		desired.Message = capabilitiesErr.Error()
+	}
+
+	existing := computev1alpha1.FindMachinePoolCondition(machinePool.Status.Conditions, computev1alpha1.MachinePoolRuntimeCapabilities)
+	if existing != nil &&
+		existing.Status == desired.Status &&
+		existing.Reason == desired.Reason &&
+		existing.Message == desired.Message &&
+		existing.ObservedGeneration == desired.ObservedGeneration {
+		return
+	}
+
+	machinePool.Status.Conditions = computev1alpha1.SetMachinePoolCondition(machinePool.Status.Conditions, desired)
+}
+
 func (r *MachinePoolReconciler) reconcile(ctx context.Context, log logr.Logger, machinePool *computev1alpha1.MachinePool) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
@@ -197,9 +245,15 @@ func (r *MachinePoolReconciler) reconcile(ctx context.Context, log logr.Logger,
 		return ctrl.Result{RequeueAfter: 1}, nil
 	}
 
-	log.V(1).Info("Enforcing configured topology labels")
-	if err := r.enforceOriginalTopologyLabels(ctx, log, machinePool); err != nil {
-		return ctrl.Result{}, fmt.Errorf("error enforcing original topology labels: %w", err)
+	log.V(1).Info("Getting runtime capabilities")
+	capabilities, capabilitiesErr := r.getRuntimeCapabilities(ctx)
+	if capabilitiesErr != nil {
+		log.Error(capabilitiesErr, "Error getting runtime capabilities")
+	}
+
+	log.V(1).Info("Enforcing configured topology and runtime capability labels")
+	if err := r.enforceLabels(ctx, log, machinePool, capabilities, capabilitiesErr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error enforcing labels: %w", err)
 	}
 
 	log.V(1).Info("Listing machine classes")
@@ -217,7 +271,7 @@ func (r *MachinePoolReconciler) reconcile(ctx context.Context, log logr.Logger,
 	}
 
 	log.V(1).Info("Updating machine pool status")
-	if err := r.updateStatus(ctx, log, machinePool, machineList.Items, machineClassList.Items); err != nil {
+	if err := r.updateStatus(ctx, log, machinePool, machineList.Items, machineClassList.Items, capabilities, capabilitiesErr); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
 	}
 
@@ -225,10 +279,21 @@ func (r *MachinePoolReconciler) reconcile(ctx context.Context, log logr.Logger,
 	return ctrl.Result{}, nil
 }
 
-func (r *MachinePoolReconciler) enforceOriginalTopologyLabels(ctx context.Context, log logr.Logger, machinePool *computev1alpha1.MachinePool) error {
+// enforceLabels sets the configured topology labels and the labels of the runtime capabilities.
+// If the capabilities could not be determined, the capability labels are left as they are.
+func (r *MachinePoolReconciler) enforceLabels(
+	ctx context.Context,
+	log logr.Logger,
+	machinePool *computev1alpha1.MachinePool,
+	capabilities []string,
+	capabilitiesErr error,
+) error {
 	base := machinePool.DeepCopy()
 
 	poolletutils.SetTopologyLabels(log, &machinePool.ObjectMeta, r.TopologyLabels)
+	if capabilitiesErr == nil {
+		poolletutils.SetRuntimeCapabilityLabels(log, &machinePool.ObjectMeta, capabilities)
+	}
 
 	return r.Patch(ctx, machinePool, client.MergeFrom(base))
 }
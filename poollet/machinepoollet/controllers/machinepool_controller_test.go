@@ -282,4 +282,33 @@ var _ = Describe("MachinePoolController", func() {
 			HaveField("ObjectMeta.Labels", HaveKeyWithValue("topology.ironcore.dev/zone", "foo-zone-1")),
 		))
 	})
+
+	It("should surface the runtime capabilities", func(ctx SpecContext) {
+		By("checking the capabilities are reflected in the labels and conditions")
+		Eventually(Object(machinePool)).Should(SatisfyAll(
+			HaveField("ObjectMeta.Labels", HaveKeyWithValue("capability.ironcore.dev/network-interface-hotplug", "true")),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", computev1alpha1.MachinePoolRuntimeCapabilities),
+				HaveField("Status", corev1.ConditionTrue),
+			))),
+		))
+
+		By("only reporting the exec capability and adding a stale capability label to trigger a reconciliation")
+		srv.SetCapabilities([]iri.RuntimeCapability{iri.RuntimeCapability_RUNTIME_CAPABILITY_EXEC})
+		Eventually(Update(machinePool, func() {
+			machinePool.Labels["capability.ironcore.dev/stale"] = "true"
+		})).Should(Succeed())
+
+		By("checking the labels and conditions are updated")
+		Eventually(Object(machinePool)).Should(SatisfyAll(
+			HaveField("ObjectMeta.Labels", HaveKeyWithValue("capability.ironcore.dev/exec", "true")),
+			HaveField("ObjectMeta.Labels", Not(HaveKey("capability.ironcore.dev/network-interface-hotplug"))),
+			HaveField("ObjectMeta.Labels", Not(HaveKey("capability.ironcore.dev/stale"))),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", computev1alpha1.MachinePoolRuntimeCapabilities),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Message", "Runtime capabilities: exec"),
+			))),
+		))
+	})
 })
@@ -10,9 +10,12 @@ import (
 
 	"github.com/go-logr/logr"
 	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	"github.com/ironcore-dev/ironcore/iri/apis/machine"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
 	"github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -29,8 +32,9 @@ type MachineEventMapper struct {
 
 	machineRuntime machine.RuntimeService
 
-	relistPeriod time.Duration
-	lastFetched  time.Time
+	relistPeriod    time.Duration
+	lastFetched     time.Time
+	resourceVersion string
 }
 
 func (m *MachineEventMapper) relist(ctx context.Context, log logr.Logger) error {
@@ -45,31 +49,79 @@ func (m *MachineEventMapper) relist(ctx context.Context, log logr.Logger) error
 
 	m.lastFetched = toEventFilterTime
 	for _, machineEvent := range res.Events {
-		if machineEvent.Spec.InvolvedObjectMeta.Labels != nil {
-			involvedMachine := &computev1alpha1.Machine{
-				ObjectMeta: metav1.ObjectMeta{
-					UID:       types.UID(machineEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.MachineUIDLabel]),
-					Name:      machineEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.MachineNameLabel],
-					Namespace: machineEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.MachineNamespaceLabel],
-				},
-			}
-			// events.k8s.io/v1 requires a non-empty action field. Providers should populate
-			// Spec.Action on IRI events; fall back to "Unknown" so a forgotten value is
-			// obvious in the emitted event rather than silently dropped by the API server.
-			action := machineEvent.Spec.Action
-			if action == "" {
-				action = "Unknown"
-			}
-			m.Eventf(involvedMachine, nil, machineEvent.Spec.Type, machineEvent.Spec.Reason, action, machineEvent.Spec.Message)
-		}
+		m.emit(machineEvent)
 	}
 
 	return nil
 }
 
+func (m *MachineEventMapper) emit(machineEvent *irievent.Event) {
+	if machineEvent.Spec.InvolvedObjectMeta.Labels != nil {
+		involvedMachine := &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:       types.UID(machineEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.MachineUIDLabel]),
+				Name:      machineEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.MachineNameLabel],
+				Namespace: machineEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.MachineNamespaceLabel],
+			},
+		}
+		// events.k8s.io/v1 requires a non-empty action field. Providers should populate
+		// Spec.Action on IRI events; fall back to "Unknown" so a forgotten value is
+		// obvious in the emitted event rather than silently dropped by the API server.
+		action := machineEvent.Spec.Action
+		if action == "" {
+			action = "Unknown"
+		}
+		m.Eventf(involvedMachine, nil, machineEvent.Spec.Type, machineEvent.Spec.Reason, action, machineEvent.Spec.Message)
+	}
+}
+
+// watch emits the machine cluster events received via watch until the watch breaks.
+func (m *MachineEventMapper) watch(ctx context.Context, log logr.Logger) error {
+	log.V(1).Info("Watching machine cluster events")
+	stream, err := m.machineRuntime.WatchEvents(ctx, &iri.WatchEventsRequest{
+		ResourceVersion: m.resourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		m.lastFetched = time.Now()
+		m.resourceVersion = res.ResourceVersion
+		m.emit(res.Event)
+	}
+}
+
 func (m *MachineEventMapper) Start(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx).WithName("mem")
 	m.lastFetched = time.Now()
+	for {
+		err := m.watch(ctx, log)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Info("Watching machine cluster events is not implemented, falling back to relisting")
+			break
+		}
+
+		// Relist to catch up on the events missed while not watching.
+		log.Error(err, "Watch broke, relisting")
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(m.relistPeriod):
+		}
+		if err := m.relist(ctx, log); err != nil {
+			log.Error(err, "Error relisting")
+		}
+	}
+
 	wait.UntilWithContext(ctx, func(ctx context.Context) {
 		if err := m.relist(ctx, log); err != nil {
 			log.Error(err, "Error relisting")
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	irimachine "github.com/ironcore-dev/ironcore/iri/apis/machine"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const namespace = "machinepoollet"
+
+var (
+	machineLabels          = []string{"namespace", "name"}
+	volumeLabels           = []string{"namespace", "name", "volume"}
+	networkInterfaceLabels = []string{"namespace", "name", "network_interface"}
+
+	machineCPUUsageSecondsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "cpu_usage_seconds_total"),
+		"Cumulative CPU time consumed by the machine in core-seconds.",
+		machineLabels, nil,
+	)
+	machineMemoryUsageBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "memory_usage_bytes"),
+		"Memory in use by the machine in bytes.",
+		machineLabels, nil,
+	)
+	machineMemoryAvailableBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "memory_available_bytes"),
+		"Memory available to the machine in bytes.",
+		machineLabels, nil,
+	)
+
+	machineVolumeReadBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "volume_read_bytes_total"),
+		"Cumulative number of bytes read from the volume.",
+		volumeLabels, nil,
+	)
+	machineVolumeWriteBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "volume_write_bytes_total"),
+		"Cumulative number of bytes written to the volume.",
+		volumeLabels, nil,
+	)
+	machineVolumeReadOpsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "volume_read_ops_total"),
+		"Cumulative number of read operations on the volume.",
+		volumeLabels, nil,
+	)
+	machineVolumeWriteOpsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "volume_write_ops_total"),
+		"Cumulative number of write operations on the volume.",
+		volumeLabels, nil,
+	)
+
+	machineNetworkInterfaceRxBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "network_interface_receive_bytes_total"),
+		"Cumulative number of bytes received by the network interface.",
+		networkInterfaceLabels, nil,
+	)
+	machineNetworkInterfaceTxBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "network_interface_transmit_bytes_total"),
+		"Cumulative number of bytes transmitted by the network interface.",
+		networkInterfaceLabels, nil,
+	)
+	machineNetworkInterfaceRxPacketsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "network_interface_receive_packets_total"),
+		"Cumulative number of packets received by the network interface.",
+		networkInterfaceLabels, nil,
+	)
+	machineNetworkInterfaceTxPacketsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "machine", "network_interface_transmit_packets_total"),
+		"Cumulative number of packets transmitted by the network interface.",
+		networkInterfaceLabels, nil,
+	)
+)
+
+type MachineStatsCollectorOptions struct {
+	// Timeout is the timeout for collecting the stats from the machine runtime.
+	Timeout time.Duration
+	// Log is the logger to use. If unset, a package-global logger will be used.
+	Log logr.Logger
+}
+
+func setMachineStatsCollectorOptionsDefaults(o *MachineStatsCollectorOptions) {
+	if o.Timeout == 0 {
+		o.Timeout = 10 * time.Second
+	}
+	if o.Log.GetSink() == nil {
+		o.Log = ctrl.Log.WithName("machinepoollet").WithName("metrics")
+	}
+}
+
+// MachineStatsCollector is a prometheus.Collector exporting the stats of all machines of a machine runtime,
+// labeled with the namespace and name of the corresponding ironcore machine.
+type MachineStatsCollector struct {
+	machineRuntime irimachine.RuntimeService
+	timeout        time.Duration
+	log            logr.Logger
+}
+
+var _ prometheus.Collector = (*MachineStatsCollector)(nil)
+
+func NewMachineStatsCollector(machineRuntime irimachine.RuntimeService, opts MachineStatsCollectorOptions) *MachineStatsCollector {
+	setMachineStatsCollectorOptionsDefaults(&opts)
+	return &MachineStatsCollector{
+		machineRuntime: machineRuntime,
+		timeout:        opts.Timeout,
+		log:            opts.Log,
+	}
+}
+
+func (c *MachineStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- machineCPUUsageSecondsDesc
+	ch <- machineMemoryUsageBytesDesc
+	ch <- machineMemoryAvailableBytesDesc
+	ch <- machineVolumeReadBytesDesc
+	ch <- machineVolumeWriteBytesDesc
+	ch <- machineVolumeReadOpsDesc
+	ch <- machineVolumeWriteOpsDesc
+	ch <- machineNetworkInterfaceRxBytesDesc
+	ch <- machineNetworkInterfaceTxBytesDesc
+	ch <- machineNetworkInterfaceRxPacketsDesc
+	ch <- machineNetworkInterfaceTxPacketsDesc
+}
+
+func (c *MachineStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	listMachinesRes, err := c.machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{})
+	if err != nil {
+		c.log.Error(err, "Error listing machines")
+		return
+	}
+
+	machineLabelsByID := make(map[string][]string, len(listMachinesRes.Machines))
+	for _, machine := range listMachinesRes.Machines {
+		labels := machine.GetMetadata().GetLabels()
+		machineNamespace := labels[machinepoolletv1alpha1.MachineNamespaceLabel]
+		machineName := labels[machinepoolletv1alpha1.MachineNameLabel]
+		if machineNamespace == "" || machineName == "" {
+			continue
+		}
+		machineLabelsByID[machine.GetMetadata().GetId()] = []string{machineNamespace, machineName}
+	}
+
+	listMachineStatsRes, err := c.machineRuntime.ListMachineStats(ctx, &iri.ListMachineStatsRequest{})
+	if err != nil {
+		c.log.Error(err, "Error listing machine stats")
+		return
+	}
+
+	for _, stats := range listMachineStatsRes.Stats {
+		labels, ok := machineLabelsByID[stats.MachineId]
+		if !ok {
+			continue
+		}
+		collectMachineStats(ch, labels, stats)
+	}
+}
+
+func collectMachineStats(ch chan<- prometheus.Metric, labels []string, stats *iri.MachineStats) {
+	if cpu := stats.Cpu; cpu != nil {
+		ch <- prometheus.MustNewConstMetric(machineCPUUsageSecondsDesc, prometheus.CounterValue, float64(cpu.UsageCoreNanoSeconds)/float64(time.Second), labels...)
+	}
+	if memory := stats.Memory; memory != nil {
+		ch <- prometheus.MustNewConstMetric(machineMemoryUsageBytesDesc, prometheus.GaugeValue, float64(memory.UsageBytes), labels...)
+		ch <- prometheus.MustNewConstMetric(machineMemoryAvailableBytesDesc, prometheus.GaugeValue, float64(memory.AvailableBytes), labels...)
+	}
+	for _, volume := range stats.Volumes {
+		volumeLabels := append(labels[:len(labels):len(labels)], volume.Name)
+		ch <- prometheus.MustNewConstMetric(machineVolumeReadBytesDesc, prometheus.CounterValue, float64(volume.ReadBytes), volumeLabels...)
+		ch <- prometheus.MustNewConstMetric(machineVolumeWriteBytesDesc, prometheus.CounterValue, float64(volume.WriteBytes), volumeLabels...)
+		ch <- prometheus.MustNewConstMetric(machineVolumeReadOpsDesc, prometheus.CounterValue, float64(volume.ReadOps), volumeLabels...)
+		ch <- prometheus.MustNewConstMetric(machineVolumeWriteOpsDesc, prometheus.CounterValue, float64(volume.WriteOps), volumeLabels...)
+	}
+	for _, nic := range stats.NetworkInterfaces {
+		nicLabels := append(labels[:len(labels):len(labels)], nic.Name)
+		ch <- prometheus.MustNewConstMetric(machineNetworkInterfaceRxBytesDesc, prometheus.CounterValue, float64(nic.RxBytes), nicLabels...)
+		ch <- prometheus.MustNewConstMetric(machineNetworkInterfaceTxBytesDesc, prometheus.CounterValue, float64(nic.TxBytes), nicLabels...)
+		ch <- prometheus.MustNewConstMetric(machineNetworkInterfaceRxPacketsDesc, prometheus.CounterValue, float64(nic.RxPackets), nicLabels...)
+		ch <- prometheus.MustNewConstMetric(machineNetworkInterfaceTxPacketsDesc, prometheus.CounterValue, float64(nic.TxPackets), nicLabels...)
+	}
+}
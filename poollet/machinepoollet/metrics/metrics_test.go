@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"strings"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	fakemachine "github.com/ironcore-dev/ironcore/iri/testing/machine"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	. "github.com/ironcore-dev/ironcore/poollet/machinepoollet/metrics"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("MachineStatsCollector", func() {
+	It("should export the machine stats labeled with the machine namespace and name", func() {
+		machineRuntime := fakemachine.NewFakeRuntimeService()
+		machineRuntime.SetMachines([]*fakemachine.FakeMachine{
+			{
+				Machine: &iri.Machine{
+					Metadata: &irimeta.ObjectMetadata{
+						Id: "machine-1",
+						Labels: map[string]string{
+							machinepoolletv1alpha1.MachineNamespaceLabel: "foo",
+							machinepoolletv1alpha1.MachineNameLabel:      "my-machine",
+						},
+					},
+					Spec: &iri.MachineSpec{
+						Volumes:           []*iri.Volume{{Name: "root"}},
+						NetworkInterfaces: []*iri.NetworkInterface{{Name: "primary"}},
+					},
+				},
+			},
+			{
+				Machine: &iri.Machine{
+					Metadata: &irimeta.ObjectMetadata{Id: "unmanaged"},
+					Spec:     &iri.MachineSpec{},
+				},
+			},
+		})
+
+		collector := NewMachineStatsCollector(machineRuntime, MachineStatsCollectorOptions{})
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP machinepoollet_machine_cpu_usage_seconds_total Cumulative CPU time consumed by the machine in core-seconds.
+# TYPE machinepoollet_machine_cpu_usage_seconds_total counter
+machinepoollet_machine_cpu_usage_seconds_total{name="my-machine",namespace="foo"} 1
+# HELP machinepoollet_machine_memory_usage_bytes Memory in use by the machine in bytes.
+# TYPE machinepoollet_machine_memory_usage_bytes gauge
+machinepoollet_machine_memory_usage_bytes{name="my-machine",namespace="foo"} 5.36870912e+08
+# HELP machinepoollet_machine_volume_read_bytes_total Cumulative number of bytes read from the volume.
+# TYPE machinepoollet_machine_volume_read_bytes_total counter
+machinepoollet_machine_volume_read_bytes_total{name="my-machine",namespace="foo",volume="root"} 4096
+# HELP machinepoollet_machine_network_interface_transmit_bytes_total Cumulative number of bytes transmitted by the network interface.
+# TYPE machinepoollet_machine_network_interface_transmit_bytes_total counter
+machinepoollet_machine_network_interface_transmit_bytes_total{name="my-machine",namespace="foo",network_interface="primary"} 1000
+`),
+			"machinepoollet_machine_cpu_usage_seconds_total",
+			"machinepoollet_machine_memory_usage_bytes",
+			"machinepoollet_machine_volume_read_bytes_total",
+			"machinepoollet_machine_network_interface_transmit_bytes_total",
+		)).To(Succeed())
+
+		Expect(testutil.CollectAndCount(collector)).To(Equal(11))
+	})
+})
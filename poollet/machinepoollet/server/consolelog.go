@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2023 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func parseConsoleLogRequest(query url.Values) (*iri.GetConsoleLogRequest, error) {
+	req := &iri.GetConsoleLogRequest{}
+
+	if follow := query.Get("follow"); follow != "" {
+		v, err := strconv.ParseBool(follow)
+		if err != nil {
+			return nil, fmt.Errorf("invalid follow %q: %w", follow, err)
+		}
+		req.Follow = v
+	}
+
+	if tailLines := query.Get("tailLines"); tailLines != "" {
+		v, err := strconv.ParseInt(tailLines, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tailLines %q: %w", tailLines, err)
+		}
+		if v < 0 {
+			return nil, fmt.Errorf("tailLines must not be negative")
+		}
+		req.TailLines = &v
+	}
+
+	if sinceTime := query.Get("sinceTime"); sinceTime != "" {
+		v, err := time.Parse(time.RFC3339, sinceTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sinceTime %q: %w", sinceTime, err)
+		}
+		req.SinceTime = v.UnixNano()
+	}
+
+	return req, nil
+}
+
+func (s *Server) serveConsoleLog(w http.ResponseWriter, req *http.Request, namespace, name string) {
+	ctx := req.Context()
+	log := ctrl.LoggerFrom(ctx)
+
+	consoleLogReq, err := parseConsoleLogRequest(req.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	listMachinesRes, err := s.machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{
+		Filter: &iri.MachineFilter{
+			LabelSelector: map[string]string{
+				machinepoolletv1alpha1.MachineNamespaceLabel: namespace,
+				machinepoolletv1alpha1.MachineNameLabel:      name,
+			},
+		},
+	})
+	if err != nil {
+		log.Error(err, "Error listing machines")
+		s.writeError(w, err)
+		return
+	}
+	if len(listMachinesRes.Machines) == 0 {
+		http.Error(w, "machine not found", http.StatusNotFound)
+		return
+	}
+
+	machine := listMachinesRes.Machines[0]
+	consoleLogReq.MachineId = machine.Metadata.Id
+	stream, err := s.machineRuntime.GetConsoleLog(ctx, consoleLogReq)
+	if err != nil {
+		log.Error(err, "Error getting console log")
+		s.writeError(w, err)
+		return
+	}
+
+	res, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		log.Error(err, "Error receiving console log")
+		s.writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	for ; err == nil; res, err = stream.Recv() {
+		if _, err := w.Write(res.Data); err != nil {
+			log.V(1).Info("Error writing console log", "Error", err)
+			return
+		}
+		if consoleLogReq.Follow && flusher != nil {
+			flusher.Flush()
+		}
+	}
+	if !errors.Is(err, io.EOF) {
+		log.Error(err, "Error receiving console log")
+	}
+}
@@ -41,6 +41,7 @@ type ServingFlags struct {
 	DisableAuth      bool
 	HostnameOverride string
 	Address          string
+	MetadataAddress  string
 	CertDir          string
 
 	StreamCreationTimeout time.Duration
@@ -59,6 +60,8 @@ func (o *ServingFlags) BindFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&o.DisableAuth, "insecure-disable-auth", o.DisableAuth, "whether to completely disable authN/Z. Insecure and discouraged.")
 	fs.StringVar(&o.HostnameOverride, "hostname-override", o.HostnameOverride, "Override for the hostname.")
 	fs.StringVar(&o.Address, "address", o.Address, "Address to listen / serve on.")
+	fs.StringVar(&o.MetadataAddress, "metadata-bind-address", o.MetadataAddress, "Address to serve the unauthenticated machine metadata on via plain HTTP. "+
+		"Should only be reachable by the machine runtime. If unset, the machine metadata is not served.")
 	fs.StringVar(&o.CertDir, "cert-dir", o.CertDir, "The directory that contains the server key and certificate."+
 		"The files have to be named 'tls.crt' and 'tls.key'. If unset, "+
 		"{TempDir}/machinepoollet-server/serving-certs will be looked up for the certificates.")
@@ -75,6 +78,7 @@ func (o *ServingFlags) ServerOptions(machineRuntime irimachine.RuntimeService, l
 		Log:                   log,
 		HostnameOverride:      o.HostnameOverride,
 		Address:               o.Address,
+		MetadataAddress:       o.MetadataAddress,
 		CertDir:               o.CertDir,
 		Auth:                  authOpts,
 		DisableAuth:           o.DisableAuth,
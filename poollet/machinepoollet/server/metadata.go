@@ -0,0 +1,371 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var errMachineNotFound = errors.New("machine not found")
+
+// machineMetadata is the information about a machine that is exposed to its guest.
+type machineMetadata struct {
+	UID               types.UID
+	Namespace         string
+	Name              string
+	Hostname          string
+	Labels            map[string]string
+	NetworkInterfaces []networkInterfaceMetadata
+	Volumes           []volumeMetadata
+}
+
+type networkInterfaceMetadata struct {
+	Name      string
+	IPs       []commonv1alpha1.IP
+	VirtualIP *commonv1alpha1.IP
+}
+
+type volumeMetadata struct {
+	Name   string
+	Device string
+}
+
+func (s *Server) getMachineMetadata(ctx context.Context, uid types.UID) (*machineMetadata, error) {
+	res, err := s.machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{
+		Filter: &iri.MachineFilter{
+			LabelSelector: map[string]string{
+				machinepoolletv1alpha1.MachineUIDLabel: string(uid),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing machines: %w", err)
+	}
+	if len(res.Machines) == 0 {
+		return nil, errMachineNotFound
+	}
+
+	labels := res.Machines[0].GetMetadata().GetLabels()
+	machine := &computev1alpha1.Machine{}
+	machineKey := client.ObjectKey{
+		Namespace: labels[machinepoolletv1alpha1.MachineNamespaceLabel],
+		Name:      labels[machinepoolletv1alpha1.MachineNameLabel],
+	}
+	if err := s.client.Get(ctx, machineKey, machine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errMachineNotFound
+		}
+		return nil, fmt.Errorf("error getting machine %s: %w", machineKey, err)
+	}
+	if machine.UID != uid {
+		return nil, errMachineNotFound
+	}
+
+	hostname := machine.Name
+	if guestConfig := machine.Spec.GuestConfig; guestConfig != nil && guestConfig.Hostname != "" {
+		hostname = guestConfig.Hostname
+	}
+
+	nics, err := s.getNetworkInterfaceMetadata(ctx, machine)
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]volumeMetadata, 0, len(machine.Spec.Volumes))
+	for _, volume := range machine.Spec.Volumes {
+		volumes = append(volumes, volumeMetadata{
+			Name:   volume.Name,
+			Device: ptr.Deref(volume.Device, ""),
+		})
+	}
+
+	return &machineMetadata{
+		UID:               machine.UID,
+		Namespace:         machine.Namespace,
+		Name:              machine.Name,
+		Hostname:          hostname,
+		Labels:            machine.Labels,
+		NetworkInterfaces: nics,
+		Volumes:           volumes,
+	}, nil
+}
+
+func (s *Server) getNetworkInterfaceMetadata(ctx context.Context, machine *computev1alpha1.Machine) ([]networkInterfaceMetadata, error) {
+	nics := make([]networkInterfaceMetadata, 0, len(machine.Spec.NetworkInterfaces))
+	for _, machineNic := range machine.Spec.NetworkInterfaces {
+		nicMetadata := networkInterfaceMetadata{Name: machineNic.Name}
+
+		nicName := computev1alpha1.MachineNetworkInterfaceName(machine.Name, machineNic)
+		if nicName != "" {
+			nic := &networkingv1alpha1.NetworkInterface{}
+			nicKey := client.ObjectKey{Namespace: machine.Namespace, Name: nicName}
+			if err := s.client.Get(ctx, nicKey, nic); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return nil, fmt.Errorf("error getting network interface %s: %w", nicKey, err)
+				}
+			} else {
+				nicMetadata.IPs = nic.Status.IPs
+				nicMetadata.VirtualIP = nic.Status.VirtualIP
+			}
+		}
+
+		nics = append(nics, nicMetadata)
+	}
+	return nics, nil
+}
+
+// openStackMetaData is the subset of the OpenStack meta_data.json that is served.
+type openStackMetaData struct {
+	UUID      string            `json:"uuid"`
+	Name      string            `json:"name"`
+	Hostname  string            `json:"hostname"`
+	ProjectID string            `json:"project_id"`
+	Meta      map[string]string `json:"meta,omitempty"`
+	Devices   []openStackDevice `json:"devices,omitempty"`
+}
+
+type openStackDevice struct {
+	Type   string   `json:"type"`
+	Device string   `json:"device,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+// openStackNetworkData is the subset of the OpenStack network_data.json that is served.
+type openStackNetworkData struct {
+	Links    []openStackLink    `json:"links"`
+	Networks []openStackNetwork `json:"networks"`
+}
+
+type openStackLink struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type openStackNetwork struct {
+	ID        string `json:"id"`
+	Link      string `json:"link"`
+	Type      string `json:"type"`
+	IPAddress string `json:"ip_address"`
+	VirtualIP string `json:"virtual_ip,omitempty"`
+}
+
+func (m *machineMetadata) openStackMetaData() *openStackMetaData {
+	devices := make([]openStackDevice, 0, len(m.Volumes))
+	for _, volume := range m.Volumes {
+		devices = append(devices, openStackDevice{
+			Type:   "disk",
+			Device: volume.Device,
+			Tags:   []string{volume.Name},
+		})
+	}
+
+	return &openStackMetaData{
+		UUID:      string(m.UID),
+		Name:      m.Name,
+		Hostname:  m.Hostname,
+		ProjectID: m.Namespace,
+		Meta:      m.Labels,
+		Devices:   devices,
+	}
+}
+
+func (m *machineMetadata) openStackNetworkData() *openStackNetworkData {
+	data := &openStackNetworkData{
+		Links:    []openStackLink{},
+		Networks: []openStackNetwork{},
+	}
+	for _, nic := range m.NetworkInterfaces {
+		data.Links = append(data.Links, openStackLink{ID: nic.Name, Type: "phy"})
+
+		var virtualIP string
+		if nic.VirtualIP != nil {
+			virtualIP = nic.VirtualIP.String()
+		}
+
+		for i, ip := range nic.IPs {
+			networkType := "ipv4"
+			if ip.Family() == corev1.IPv6Protocol {
+				networkType = "ipv6"
+			}
+
+			data.Networks = append(data.Networks, openStackNetwork{
+				ID:        fmt.Sprintf("%s-%d", nic.Name, i),
+				Link:      nic.Name,
+				Type:      networkType,
+				IPAddress: ip.String(),
+				VirtualIP: virtualIP,
+			})
+		}
+	}
+	return data
+}
+
+// ec2MetaData builds the EC2 meta-data tree. Directories are maps, leaves are strings.
+func (m *machineMetadata) ec2MetaData() map[string]any {
+	var localIPv4, publicIPv4 string
+	interfaces := make(map[string]any, len(m.NetworkInterfaces))
+	for _, nic := range m.NetworkInterfaces {
+		var localIPv4s []string
+		for _, ip := range nic.IPs {
+			if ip.Family() != corev1.IPv4Protocol {
+				continue
+			}
+			localIPv4s = append(localIPv4s, ip.String())
+			if localIPv4 == "" {
+				localIPv4 = ip.String()
+			}
+		}
+
+		iface := map[string]any{
+			"local-ipv4s": strings.Join(localIPv4s, "\n"),
+		}
+		if nic.VirtualIP != nil {
+			iface["public-ipv4s"] = nic.VirtualIP.String()
+			if publicIPv4 == "" {
+				publicIPv4 = nic.VirtualIP.String()
+			}
+		}
+		interfaces[nic.Name] = iface
+	}
+
+	blockDeviceMapping := make(map[string]any, len(m.Volumes))
+	for _, volume := range m.Volumes {
+		blockDeviceMapping[volume.Name] = volume.Device
+	}
+
+	tags := make(map[string]any, len(m.Labels))
+	for key, value := range m.Labels {
+		// Like EC2, skip tags whose key cannot be represented as a path segment.
+		if strings.Contains(key, "/") {
+			continue
+		}
+		tags[key] = value
+	}
+
+	data := map[string]any{
+		"instance-id":          string(m.UID),
+		"hostname":             m.Hostname,
+		"local-hostname":       m.Hostname,
+		"block-device-mapping": blockDeviceMapping,
+		"network": map[string]any{
+			"interfaces": map[string]any{
+				"by-name": interfaces,
+			},
+		},
+		"tags": map[string]any{
+			"instance": tags,
+		},
+		"ironcore": map[string]any{
+			"namespace": m.Namespace,
+			"name":      m.Name,
+		},
+	}
+	if localIPv4 != "" {
+		data["local-ipv4"] = localIPv4
+	}
+	if publicIPv4 != "" {
+		data["public-ipv4"] = publicIPv4
+	}
+	return data
+}
+
+func (s *Server) registerMetadataRoutes(r chi.Router) {
+	r.Get("/openstack/latest/meta_data.json", s.serveMetadata(func(w http.ResponseWriter, _ *http.Request, metadata *machineMetadata) {
+		writeJSON(w, metadata.openStackMetaData())
+	}))
+	r.Get("/openstack/latest/network_data.json", s.serveMetadata(func(w http.ResponseWriter, _ *http.Request, metadata *machineMetadata) {
+		writeJSON(w, metadata.openStackNetworkData())
+	}))
+
+	serveEC2MetaData := s.serveMetadata(func(w http.ResponseWriter, req *http.Request, metadata *machineMetadata) {
+		writeEC2MetaData(w, metadata.ec2MetaData(), chi.URLParam(req, "*"))
+	})
+	r.Get("/latest/meta-data", serveEC2MetaData)
+	r.Get("/latest/meta-data/*", serveEC2MetaData)
+}
+
+func (s *Server) serveMetadata(serve func(w http.ResponseWriter, req *http.Request, metadata *machineMetadata)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		log := ctrl.LoggerFrom(ctx)
+
+		uid := types.UID(chi.URLParam(req, "uid"))
+		metadata, err := s.getMachineMetadata(ctx, uid)
+		if err != nil {
+			if errors.Is(err, errMachineNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			log.Error(err, "Error getting machine metadata")
+			s.writeError(w, err)
+			return
+		}
+
+		serve(w, req, metadata)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeEC2MetaData writes the node at the given path of the tree the way the EC2 instance metadata service does:
+// Directories are listed one entry per line, with sub-directories suffixed by a slash.
+func writeEC2MetaData(w http.ResponseWriter, tree map[string]any, path string) {
+	var node any = tree
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+
+		dir, ok := node.(map[string]any)
+		if !ok {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		if node, ok = dir[segment]; !ok {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	switch node := node.(type) {
+	case map[string]any:
+		entries := make([]string, 0, len(node))
+		for key, value := range node {
+			if _, isDir := value.(map[string]any); isDir {
+				key += "/"
+			}
+			entries = append(entries, key)
+		}
+		slices.Sort(entries)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(strings.Join(entries, "\n")))
+	case string:
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(node))
+	}
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	networkingv1alpha1 "github.com/ironcore-dev/ironcore/api/networking/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	fakemachine "github.com/ironcore-dev/ironcore/iri/testing/machine"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Metadata", func() {
+	var (
+		machine *computev1alpha1.Machine
+		srv     *Server
+		httpSrv *httptest.Server
+	)
+
+	BeforeEach(func() {
+		machine = &computev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "my-machine",
+				UID:       "machine-uid",
+				Labels: map[string]string{
+					"app":                    "web",
+					"app.kubernetes.io/name": "web",
+				},
+			},
+			Spec: computev1alpha1.MachineSpec{
+				GuestConfig: &computev1alpha1.MachineGuestConfig{Hostname: "web-0"},
+				NetworkInterfaces: []computev1alpha1.NetworkInterface{
+					{
+						Name: "primary",
+						NetworkInterfaceSource: computev1alpha1.NetworkInterfaceSource{
+							NetworkInterfaceRef: &corev1.LocalObjectReference{Name: "my-nic"},
+						},
+					},
+				},
+				Volumes: []computev1alpha1.Volume{
+					{
+						Name:   "root",
+						Device: ptr.To("oda"),
+						VolumeSource: computev1alpha1.VolumeSource{
+							VolumeRef: &corev1.LocalObjectReference{Name: "my-volume"},
+						},
+					},
+				},
+			},
+		}
+		nic := &networkingv1alpha1.NetworkInterface{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "foo",
+				Name:      "my-nic",
+			},
+			Status: networkingv1alpha1.NetworkInterfaceStatus{
+				IPs:       []commonv1alpha1.IP{commonv1alpha1.MustParseIP("10.0.0.1")},
+				VirtualIP: commonv1alpha1.MustParseNewIP("192.0.2.10"),
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(computev1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(networkingv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(machine, nic).Build()
+
+		machineRuntime := fakemachine.NewFakeRuntimeService()
+		machineRuntime.SetMachines([]*fakemachine.FakeMachine{
+			{
+				Machine: &iri.Machine{
+					Metadata: &irimeta.ObjectMetadata{
+						Id: "iri-machine",
+						Labels: map[string]string{
+							machinepoolletv1alpha1.MachineUIDLabel:       string(machine.UID),
+							machinepoolletv1alpha1.MachineNamespaceLabel: machine.Namespace,
+							machinepoolletv1alpha1.MachineNameLabel:      machine.Name,
+						},
+					},
+				},
+			},
+		})
+
+		var err error
+		srv, err = New(&rest.Config{}, Options{
+			MachineRuntime: machineRuntime,
+			Client:         c,
+			CertDir:        GinkgoT().TempDir(),
+			DisableAuth:    true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		httpSrv = httptest.NewServer(srv.metadataRouter())
+		DeferCleanup(httpSrv.Close)
+	})
+
+	getFrom := func(url string) (int, string) {
+		res, err := http.Get(url)
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = res.Body.Close() }()
+
+		data, err := io.ReadAll(res.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return res.StatusCode, string(data)
+	}
+	get := func(path string) (int, string) {
+		return getFrom(httpSrv.URL + path)
+	}
+
+	It("should serve the openstack meta data", func() {
+		code, body := get("/metadata/machine-uid/openstack/latest/meta_data.json")
+		Expect(code).To(Equal(http.StatusOK))
+
+		metaData := &openStackMetaData{}
+		Expect(json.Unmarshal([]byte(body), metaData)).To(Succeed())
+		Expect(metaData).To(Equal(&openStackMetaData{
+			UUID:      "machine-uid",
+			Name:      "my-machine",
+			Hostname:  "web-0",
+			ProjectID: "foo",
+			Meta:      machine.Labels,
+			Devices: []openStackDevice{
+				{Type: "disk", Device: "oda", Tags: []string{"root"}},
+			},
+		}))
+	})
+
+	It("should serve the openstack network data", func() {
+		code, body := get("/metadata/machine-uid/openstack/latest/network_data.json")
+		Expect(code).To(Equal(http.StatusOK))
+
+		networkData := &openStackNetworkData{}
+		Expect(json.Unmarshal([]byte(body), networkData)).To(Succeed())
+		Expect(networkData).To(Equal(&openStackNetworkData{
+			Links: []openStackLink{{ID: "primary", Type: "phy"}},
+			Networks: []openStackNetwork{
+				{ID: "primary-0", Link: "primary", Type: "ipv4", IPAddress: "10.0.0.1", VirtualIP: "192.0.2.10"},
+			},
+		}))
+	})
+
+	It("should serve the ec2 meta data tree", func() {
+		code, body := get("/metadata/machine-uid/latest/meta-data/")
+		Expect(code).To(Equal(http.StatusOK))
+		Expect(body).To(Equal("block-device-mapping/\nhostname\ninstance-id\nironcore/\nlocal-hostname\nlocal-ipv4\nnetwork/\npublic-ipv4\ntags/"))
+
+		for path, expected := range map[string]string{
+			"instance-id":                 "machine-uid",
+			"local-hostname":              "web-0",
+			"local-ipv4":                  "10.0.0.1",
+			"public-ipv4":                 "192.0.2.10",
+			"block-device-mapping/root":   "oda",
+			"ironcore/namespace":          "foo",
+			"tags/instance/":              "app",
+			"tags/instance/app":           "web",
+			"network/interfaces/by-name/": "primary/",
+			"network/interfaces/by-name/primary/public-ipv4s": "192.0.2.10",
+		} {
+			code, body := get("/metadata/machine-uid/latest/meta-data/" + path)
+			Expect(code).To(Equal(http.StatusOK), path)
+			Expect(body).To(Equal(expected), path)
+		}
+
+		code, _ = get("/metadata/machine-uid/latest/meta-data/does-not-exist")
+		Expect(code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should not serve metadata of unknown machines", func() {
+		code, _ := get("/metadata/unknown-uid/openstack/latest/meta_data.json")
+		Expect(code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should not serve metadata on the authenticated server", func() {
+		authenticatedSrv := httptest.NewServer(srv.router())
+		DeferCleanup(authenticatedSrv.Close)
+
+		code, _ := getFrom(authenticatedSrv.URL + "/metadata/machine-uid/openstack/latest/meta_data.json")
+		Expect(code).To(Equal(http.StatusNotFound))
+	})
+})
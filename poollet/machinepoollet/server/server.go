@@ -34,6 +34,7 @@ import (
 	certutil "k8s.io/client-go/util/cert"
 	"k8s.io/client-go/util/keyutil"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -44,6 +45,16 @@ type Options struct {
 	// MachineRuntime is the iri-machine runtime service.
 	MachineRuntime irimachine.RuntimeService
 
+	// Client is used to read the objects the machine metadata is built from.
+	// If unset, the metadata endpoint is not served.
+	Client client.Reader
+
+	// MetadataAddress is the address to serve the machine metadata on.
+	// The metadata endpoint is not authenticated and thus served via plain HTTP on its own listener
+	// that should only be reachable by the machine runtime.
+	// If empty, the metadata endpoint is not served.
+	MetadataAddress string
+
 	// Log is the logger to use in the server.
 	// If unset, a package-global router will be used.
 	Log logr.Logger
@@ -98,9 +109,12 @@ type Server struct {
 
 	machineRuntime irimachine.RuntimeService
 
+	client client.Reader
+
 	cacheTTL time.Duration
 
-	address string
+	address         string
+	metadataAddress string
 
 	certDir                 string
 	clientCACertificateFile string
@@ -249,7 +263,9 @@ func New(cfg *rest.Config, opts Options) (*Server, error) {
 		log:                     opts.Log,
 		auth:                    auth,
 		machineRuntime:          opts.MachineRuntime,
+		client:                  opts.Client,
 		address:                 opts.Address,
+		metadataAddress:         opts.MetadataAddress,
 		certDir:                 opts.CertDir,
 		clientCACertificateFile: caCertificateFile,
 		streamCreationTimeout:   opts.StreamCreationTimeout,
@@ -326,6 +342,17 @@ func (s *Server) router() http.Handler {
 	return r
 }
 
+func (s *Server) metadataRouter() http.Handler {
+	r := chi.NewRouter()
+
+	r.Use(utilshttp.InjectLogger(s.log))
+	r.Use(utilshttp.LogRequest)
+
+	r.Route("/metadata/{uid}", s.registerMetadataRoutes)
+
+	return r
+}
+
 func (s *Server) authMiddleware(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		ctx := req.Context()
@@ -398,6 +425,18 @@ func (s *Server) registerComputeRoutes(r chi.Router) {
 			s.serveExec(w, req, namespace, name)
 		})
 	}
+
+	r.Get("/namespaces/{namespace}/machines/{name}/consolelog", func(w http.ResponseWriter, req *http.Request) {
+		namespace := chi.URLParam(req, "namespace")
+		name := chi.URLParam(req, "name")
+		s.serveConsoleLog(w, req, namespace, name)
+	})
+
+	r.Get("/namespaces/{namespace}/machines/{name}/stats", func(w http.ResponseWriter, req *http.Request) {
+		namespace := chi.URLParam(req, "namespace")
+		name := chi.URLParam(req, "name")
+		s.serveStats(w, req, namespace, name)
+	})
 }
 
 func (s *Server) tlsConfig() (*tls.Config, error) {
@@ -445,45 +484,66 @@ func (s *Server) Start(ctx context.Context) error {
 		return fmt.Errorf("error listening: %w", err)
 	}
 
-	var (
-		srvErr  error
-		srvDone = make(chan struct{})
-	)
-
 	srv := &http.Server{
 		Handler:   s.router(),
 		TLSConfig: tlsConfig,
 	}
+	servers := []*http.Server{srv}
+	srvErrs := make(chan error, 2)
 
 	go func() {
-		defer close(srvDone)
-		defer func() { _ = ln.Close() }()
-
 		s.log.Info("Start serving", "Address", ln.Addr())
-		if err := srv.ServeTLS(
+		srvErrs <- ignoreServerClosed(srv.ServeTLS(
 			ln,
 			getCertPath(s.certDir),
 			getKeyPath(s.certDir),
-		); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			srvErr = err
-		}
+		))
 	}()
 
+	if s.metadataAddress != "" && s.client != nil {
+		metadataLn, err := net.Listen("tcp", s.metadataAddress)
+		if err != nil {
+			_ = srv.Close()
+			s.mu.Unlock()
+			return fmt.Errorf("error listening for metadata: %w", err)
+		}
+
+		metadataSrv := &http.Server{
+			Handler: s.metadataRouter(),
+		}
+		servers = append(servers, metadataSrv)
+
+		go func() {
+			s.log.Info("Start serving metadata", "Address", metadataLn.Addr())
+			srvErrs <- ignoreServerClosed(metadataSrv.Serve(metadataLn))
+		}()
+	}
+
 	s.mu.Unlock()
 
+	var srvErr error
 	select {
-	case <-srvDone:
-		return srvErr
+	case srvErr = <-srvErrs:
 	case <-ctx.Done():
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
-		defer cancel()
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout)
+	defer cancel()
+	for _, srv := range servers {
 		if shutdownErr := srv.Shutdown(shutdownCtx); shutdownErr != nil {
 			if srvErr == nil {
-				return shutdownErr
+				srvErr = shutdownErr
+				continue
 			}
 			s.log.Error(shutdownErr, "Error shutting down server")
 		}
+	}
+	return srvErr
+}
 
-		return srvErr
+func ignoreServerClosed(err error) error {
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
 	}
+	return err
 }
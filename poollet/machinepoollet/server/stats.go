@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/http"
+	"time"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func convertIRIMachineStats(namespace, name string, stats *iri.MachineStats) *computev1alpha1.MachineStats {
+	res := &computev1alpha1.MachineStats{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: computev1alpha1.SchemeGroupVersion.String(),
+			Kind:       "MachineStats",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Timestamp: metav1.NewTime(time.Unix(0, stats.Timestamp)),
+	}
+	if cpu := stats.Cpu; cpu != nil {
+		res.CPU = &computev1alpha1.MachineCPUStats{
+			UsageCoreNanoSeconds: int64(cpu.UsageCoreNanoSeconds),
+		}
+	}
+	if memory := stats.Memory; memory != nil {
+		res.Memory = &computev1alpha1.MachineMemoryStats{
+			UsageBytes:     int64(memory.UsageBytes),
+			AvailableBytes: int64(memory.AvailableBytes),
+		}
+	}
+	for _, volume := range stats.Volumes {
+		res.Volumes = append(res.Volumes, computev1alpha1.MachineVolumeStats{
+			Name:       volume.Name,
+			ReadBytes:  int64(volume.ReadBytes),
+			WriteBytes: int64(volume.WriteBytes),
+			ReadOps:    int64(volume.ReadOps),
+			WriteOps:   int64(volume.WriteOps),
+		})
+	}
+	for _, nic := range stats.NetworkInterfaces {
+		res.NetworkInterfaces = append(res.NetworkInterfaces, computev1alpha1.MachineNetworkInterfaceStats{
+			Name:      nic.Name,
+			RxBytes:   int64(nic.RxBytes),
+			TxBytes:   int64(nic.TxBytes),
+			RxPackets: int64(nic.RxPackets),
+			TxPackets: int64(nic.TxPackets),
+		})
+	}
+	return res
+}
+
+func (s *Server) serveStats(w http.ResponseWriter, req *http.Request, namespace, name string) {
+	ctx := req.Context()
+	log := ctrl.LoggerFrom(ctx)
+
+	listMachinesRes, err := s.machineRuntime.ListMachines(ctx, &iri.ListMachinesRequest{
+		Filter: &iri.MachineFilter{
+			LabelSelector: map[string]string{
+				machinepoolletv1alpha1.MachineNamespaceLabel: namespace,
+				machinepoolletv1alpha1.MachineNameLabel:      name,
+			},
+		},
+	})
+	if err != nil {
+		log.Error(err, "Error listing machines")
+		s.writeError(w, err)
+		return
+	}
+	if len(listMachinesRes.Machines) == 0 {
+		http.Error(w, "machine not found", http.StatusNotFound)
+		return
+	}
+
+	machine := listMachinesRes.Machines[0]
+	res, err := s.machineRuntime.GetMachineStats(ctx, &iri.GetMachineStatsRequest{
+		MachineId: machine.Metadata.Id,
+	})
+	if err != nil {
+		log.Error(err, "Error getting machine stats")
+		s.writeError(w, err)
+		return
+	}
+
+	writeJSON(w, convertIRIMachineStats(namespace, name, res.Stats))
+}
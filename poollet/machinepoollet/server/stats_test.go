@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	computev1alpha1 "github.com/ironcore-dev/ironcore/api/compute/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/machine/v1alpha1"
+	irimeta "github.com/ironcore-dev/ironcore/iri/apis/meta/v1alpha1"
+	fakemachine "github.com/ironcore-dev/ironcore/iri/testing/machine"
+	machinepoolletv1alpha1 "github.com/ironcore-dev/ironcore/poollet/machinepoollet/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("Stats", func() {
+	var httpSrv *httptest.Server
+
+	BeforeEach(func() {
+		machineRuntime := fakemachine.NewFakeRuntimeService()
+		machineRuntime.SetMachines([]*fakemachine.FakeMachine{
+			{
+				Machine: &iri.Machine{
+					Metadata: &irimeta.ObjectMetadata{
+						Id: "iri-machine",
+						Labels: map[string]string{
+							machinepoolletv1alpha1.MachineNamespaceLabel: "foo",
+							machinepoolletv1alpha1.MachineNameLabel:      "my-machine",
+						},
+					},
+					Spec: &iri.MachineSpec{
+						Volumes:           []*iri.Volume{{Name: "root"}},
+						NetworkInterfaces: []*iri.NetworkInterface{{Name: "primary"}},
+					},
+				},
+			},
+		})
+
+		srv, err := New(&rest.Config{}, Options{
+			MachineRuntime: machineRuntime,
+			CertDir:        GinkgoT().TempDir(),
+			DisableAuth:    true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		httpSrv = httptest.NewServer(srv.router())
+		DeferCleanup(httpSrv.Close)
+	})
+
+	It("should serve the machine stats", func() {
+		res, err := http.Get(httpSrv.URL + "/apis/compute.ironcore.dev/namespaces/foo/machines/my-machine/stats")
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = res.Body.Close() }()
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+		stats := &computev1alpha1.MachineStats{}
+		Expect(json.NewDecoder(res.Body).Decode(stats)).To(Succeed())
+		Expect(stats.Kind).To(Equal("MachineStats"))
+		Expect(stats.Namespace).To(Equal("foo"))
+		Expect(stats.Name).To(Equal("my-machine"))
+		Expect(stats.Timestamp.IsZero()).To(BeFalse())
+		Expect(stats.CPU).To(Equal(&computev1alpha1.MachineCPUStats{
+			UsageCoreNanoSeconds: fakemachine.FakeCPUUsageCoreNanoSeconds,
+		}))
+		Expect(stats.Memory).To(Equal(&computev1alpha1.MachineMemoryStats{
+			UsageBytes:     fakemachine.FakeMemoryUsageBytes,
+			AvailableBytes: fakemachine.FakeMemoryAvailableBytes,
+		}))
+		Expect(stats.Volumes).To(ConsistOf(computev1alpha1.MachineVolumeStats{
+			Name:       "root",
+			ReadBytes:  fakemachine.FakeVolumeReadBytes,
+			WriteBytes: fakemachine.FakeVolumeWriteBytes,
+			ReadOps:    fakemachine.FakeVolumeReadOps,
+			WriteOps:   fakemachine.FakeVolumeWriteOps,
+		}))
+		Expect(stats.NetworkInterfaces).To(ConsistOf(computev1alpha1.MachineNetworkInterfaceStats{
+			Name:      "primary",
+			RxBytes:   fakemachine.FakeNetworkInterfaceRxBytes,
+			TxBytes:   fakemachine.FakeNetworkInterfaceTxBytes,
+			RxPackets: fakemachine.FakeNetworkInterfaceRxPackets,
+			TxPackets: fakemachine.FakeNetworkInterfaceTxPackets,
+		}))
+	})
+
+	It("should return not found for an unknown machine", func() {
+		res, err := http.Get(httpSrv.URL + "/apis/compute.ironcore.dev/namespaces/foo/machines/unknown/stats")
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = res.Body.Close() }()
+		Expect(res.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
@@ -45,6 +45,7 @@ var scheme = runtime.NewScheme()
 const (
 	volumeController         = "volume"
 	volumeSnapshotController = "volumesnapshot"
+	volumeBackupController   = "volumebackup"
 )
 
 func init() {
@@ -142,6 +143,7 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Switches = switches.New(
 		volumeController,
 		volumeSnapshotController,
+		volumeBackupController,
 	)
 	fs.Var(o.Switches, "controllers",
 		fmt.Sprintf("Controllers to enable. All controllers: %v. Disabled-by-default controllers: %v",
@@ -337,12 +339,24 @@ func Run(ctx context.Context, opts Options) error {
 			return fmt.Errorf("error adding volume event mapper: %w", err)
 		}
 
-		volumeEvents = irievent.NewGenerator(func(ctx context.Context) ([]*iri.Volume, error) {
+		volumeEvents = irievent.NewWatchGenerator(func(ctx context.Context) ([]*iri.Volume, error) {
 			res, err := volumeRuntime.ListVolumes(ctx, &iri.ListVolumesRequest{})
 			if err != nil {
 				return nil, err
 			}
 			return res.Volumes, nil
+		}, func(ctx context.Context, resourceVersion string, handle func(irievent.WatchEvent[*iri.Volume])) error {
+			stream, err := volumeRuntime.WatchVolumes(ctx, &iri.WatchVolumesRequest{ResourceVersion: resourceVersion})
+			if err != nil {
+				return err
+			}
+			for {
+				res, err := stream.Recv()
+				if err != nil {
+					return err
+				}
+				handle(irievent.WatchEvent[*iri.Volume]{Type: res.Type, Object: res.Volume, ResourceVersion: res.ResourceVersion})
+			}
 		}, irievent.GeneratorOptions{
 			ChannelCapacity: opts.ChannelCapacity,
 			RelistPeriod:    opts.RelistPeriod,
@@ -358,6 +372,12 @@ func Run(ctx context.Context, opts Options) error {
 		if err := storageclient.SetupVolumeSpecVolumePoolRefNameFieldIndexer(ctx, indexer); err != nil {
 			return fmt.Errorf("error setting up %s indexer with manager: %w", storageclient.VolumeSpecVolumePoolRefNameField, err)
 		}
+		if err := storageclient.SetupVolumeSpecVolumeRefNameFieldIndexer(ctx, indexer); err != nil {
+			return fmt.Errorf("error setting up %s indexer with manager: %w", storageclient.VolumeSpecVolumeRefNameField, err)
+		}
+		if err := storageclient.SetupVolumeSpecVolumeBackupRefNameFieldIndexer(ctx, indexer); err != nil {
+			return fmt.Errorf("error setting up %s indexer with manager: %w", storageclient.VolumeSpecVolumeBackupRefNameField, err)
+		}
 	}
 
 	var volumeSnapshotEvents irievent.Generator[*iri.VolumeSnapshot]
@@ -458,6 +478,19 @@ func Run(ctx context.Context, opts Options) error {
 			}
 		}
 
+		if opts.Switches.Enabled(volumeBackupController) {
+			if err := (&controllers.VolumeBackupReconciler{
+				EventRecorder:           mgr.GetEventRecorder("volume-backups"),
+				Client:                  mgr.GetClient(),
+				VolumeRuntime:           volumeRuntime,
+				VolumePoolName:          opts.VolumePoolName,
+				WatchFilterValue:        opts.WatchFilterValue,
+				MaxConcurrentReconciles: opts.MaxConcurrentReconciles,
+			}).SetupWithManager(mgr); err != nil {
+				return fmt.Errorf("error setting up volume backup reconciler with manager: %w", err)
+			}
+		}
+
 		return nil
 	}
 
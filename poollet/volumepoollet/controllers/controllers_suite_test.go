@@ -226,6 +226,8 @@ func SetupTest() (*corev1.Namespace, *storagev1alpha1.VolumePool, *storagev1alph
 		indexer := k8sManager.GetFieldIndexer()
 		Expect(storageclient.SetupVolumeSpecVolumePoolRefNameFieldIndexer(ctx, indexer)).To(Succeed())
 		Expect(storageclient.SetupVolumeSpecVolumeSnapshotRefNameFieldIndexer(ctx, indexer)).To(Succeed())
+		Expect(storageclient.SetupVolumeSpecVolumeRefNameFieldIndexer(ctx, indexer)).To(Succeed())
+		Expect(storageclient.SetupVolumeSpecVolumeBackupRefNameFieldIndexer(ctx, indexer)).To(Succeed())
 
 		volumeClassMapper := vcm.NewGeneric(srv, vcm.GenericOptions{
 			RelistPeriod: 2 * time.Second,
@@ -297,6 +299,15 @@ func SetupTest() (*corev1.Namespace, *storagev1alpha1.VolumePool, *storagev1alph
 			VolumeSnapshotEvents: volumeSnapshotEvents,
 		}).SetupWithManager(k8sManager)).To(Succeed())
 
+		Expect((&controllers.VolumeBackupReconciler{
+			EventRecorder:           &events.FakeRecorder{},
+			Client:                  k8sManager.GetClient(),
+			VolumeRuntime:           srv,
+			VolumePoolName:          vp.Name,
+			ExportPollInterval:      pollingInterval,
+			MaxConcurrentReconciles: 1,
+		}).SetupWithManager(k8sManager)).To(Succeed())
+
 		go func() {
 			defer GinkgoRecover()
 			Expect(k8sManager.Start(mgrCtx)).To(Succeed(), "failed to start manager")
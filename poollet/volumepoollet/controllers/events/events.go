@@ -10,4 +10,14 @@ const (
 	VolumeSnapshotNotReady         = "VolumeSnapshotNotReady"
 	SourceVolumeNotFound           = "SourceVolumeNotFound"
 	SourceVolumeNotAvailable       = "SourceVolumeNotAvailable"
+	SourceVolumeInOtherPool        = "SourceVolumeInOtherPool"
+	SourceVolumeTooLarge           = "SourceVolumeTooLarge"
+	VolumeReverted                 = "VolumeReverted"
+	VolumeRevertFailed             = "VolumeRevertFailed"
+	VolumeBackupNotFound           = "VolumeBackupNotFound"
+	VolumeBackupNotReady           = "VolumeBackupNotReady"
+	VolumeBackupTooLarge           = "VolumeBackupTooLarge"
+	VolumeBackupExported           = "VolumeBackupExported"
+	VolumeBackupFailed             = "VolumeBackupFailed"
+	BucketNotReady                 = "BucketNotReady"
 )
@@ -122,6 +122,8 @@ func (r *VolumeReconciler) listIRIVolumesByUID(ctx context.Context, volumeUID ty
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes,verbs=get;list;watch;update;patch
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes/finalizers,verbs=update
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumebackups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=buckets,verbs=get;list;watch
 
 func (r *VolumeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -299,11 +301,86 @@ func (r *VolumeReconciler) prepareIRIVolumeSnapshotDataSource(volume *storagev1a
 	}, true, nil
 }
 
-func (r *VolumeReconciler) prepareIRIVolumeDataSource(volume *storagev1alpha1.Volume, volumeSnapshot *storagev1alpha1.VolumeSnapshot) (*iri.VolumeDataSource, bool, error) {
+func (r *VolumeReconciler) prepareIRIVolumeCloneDataSource(volume, sourceVolume *storagev1alpha1.Volume) (*iri.VolumeDataSource, bool, error) {
+	if !VolumeRunsInVolumePool(sourceVolume, r.VolumePoolName) {
+		r.Eventf(volume, nil, corev1.EventTypeWarning, volumepoolletevents.SourceVolumeInOtherPool, "Clone", "Source volume %s is not in volume pool %s", sourceVolume.Name, r.VolumePoolName)
+		return nil, false, nil
+	}
+
+	if sourceVolume.Status.State != storagev1alpha1.VolumeStateAvailable || sourceVolume.Status.VolumeID == "" {
+		r.Eventf(volume, nil, corev1.EventTypeNormal, volumepoolletevents.SourceVolumeNotAvailable, "Clone", "Source volume %s is not available (state: %s)", sourceVolume.Name, sourceVolume.Status.State)
+		return nil, false, nil
+	}
+
+	if volume.Spec.Resources.Storage().Cmp(*sourceVolume.Spec.Resources.Storage()) < 0 {
+		r.Eventf(volume, nil, corev1.EventTypeWarning, volumepoolletevents.SourceVolumeTooLarge, "Clone", "Source volume %s is larger than the volume (%s > %s)",
+			sourceVolume.Name, sourceVolume.Spec.Resources.Storage(), volume.Spec.Resources.Storage())
+		return nil, false, nil
+	}
+
+	sourceVolumeID, err := poolletutils.ParseID(sourceVolume.Status.VolumeID)
+	if err != nil {
+		return nil, false, fmt.Errorf("error parsing source volume ID: %w", err)
+	}
+
+	return &iri.VolumeDataSource{
+		CloneDataSource: &iri.CloneDataSource{
+			VolumeId: sourceVolumeID.ID,
+		},
+	}, true, nil
+}
+
+// prepareIRIVolumeBackupLocation returns the location of the object the referenced volume backup was exported to.
+// If the volume backup cannot be restored yet, it returns a nil location.
+func (r *VolumeReconciler) prepareIRIVolumeBackupLocation(ctx context.Context, volume *storagev1alpha1.Volume, volumeBackupName string) (*iri.ObjectStorageLocation, error) {
+	volumeBackup := &storagev1alpha1.VolumeBackup{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: volume.Namespace, Name: volumeBackupName}, volumeBackup); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting volume backup %s: %w", volumeBackupName, err)
+		}
+		r.Eventf(volume, nil, corev1.EventTypeNormal, volumepoolletevents.VolumeBackupNotFound, "Restore", "VolumeBackup %s not found", volumeBackupName)
+		return nil, nil
+	}
+
+	if volumeBackup.Status.State != storagev1alpha1.VolumeBackupStateReady || volumeBackup.Status.ObjectKey == "" {
+		r.Eventf(volume, volumeBackup, corev1.EventTypeNormal, volumepoolletevents.VolumeBackupNotReady, "Restore", "VolumeBackup %s is not ready (state: %s)", volumeBackupName, volumeBackup.Status.State)
+		return nil, nil
+	}
+
+	if volumeStorage := volumeBackup.Status.VolumeStorage; volumeStorage != nil && volume.Spec.Resources.Storage().Cmp(*volumeStorage) < 0 {
+		r.Eventf(volume, volumeBackup, corev1.EventTypeWarning, volumepoolletevents.VolumeBackupTooLarge, "Restore", "VolumeBackup %s is larger than the volume (%s > %s)",
+			volumeBackupName, volumeStorage, volume.Spec.Resources.Storage())
+		return nil, nil
+	}
+
+	location, notReadyMessage, err := prepareIRIObjectStorageLocation(ctx, r.Client, volume.Namespace, volumeBackup.Spec.BucketRef.Name, volumeBackup.Status.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		r.Eventf(volume, volumeBackup, corev1.EventTypeNormal, volumepoolletevents.BucketNotReady, "Restore", "%s", notReadyMessage)
+		return nil, nil
+	}
+	return location, nil
+}
+
+func (r *VolumeReconciler) prepareIRIVolumeDataSource(volume *storagev1alpha1.Volume, volumeSnapshot *storagev1alpha1.VolumeSnapshot, sourceVolume *storagev1alpha1.Volume, backupLocation *iri.ObjectStorageLocation) (*iri.VolumeDataSource, bool, error) {
 	if volume.Spec.DataSource.VolumeSnapshotRef != nil {
 		return r.prepareIRIVolumeSnapshotDataSource(volume, volumeSnapshot)
 	}
 
+	if volume.Spec.DataSource.VolumeRef != nil {
+		return r.prepareIRIVolumeCloneDataSource(volume, sourceVolume)
+	}
+
+	if volume.Spec.DataSource.VolumeBackupRef != nil {
+		return &iri.VolumeDataSource{
+			BackupDataSource: &iri.BackupDataSource{
+				Location: backupLocation,
+			},
+		}, true, nil
+	}
+
 	if osImage := volume.Spec.DataSource.OSImage; osImage != nil {
 		return &iri.VolumeDataSource{
 			ImageDataSource: &iri.ImageDataSource{
@@ -351,6 +428,10 @@ func (r *VolumeReconciler) prepareIRIVolumeInheritedEncryption(ctx context.Conte
 		return nil, fmt.Errorf("error getting source volume %s: %w", sourceVolumeKey, err)
 	}
 
+	return r.prepareIRISourceVolumeEncryption(ctx, sourceVolume)
+}
+
+func (r *VolumeReconciler) prepareIRISourceVolumeEncryption(ctx context.Context, sourceVolume *storagev1alpha1.Volume) (*iri.EncryptionSpec, error) {
 	if sourceVolume.Spec.Encryption == nil {
 		return nil, nil
 	}
@@ -369,7 +450,7 @@ func (r *VolumeReconciler) prepareIRIVolumeInheritedEncryption(ctx context.Conte
 	}, nil
 }
 
-func (r *VolumeReconciler) prepareIRIVolumeEncryption(ctx context.Context, volume *storagev1alpha1.Volume, volumeSnapshot *storagev1alpha1.VolumeSnapshot) (*iri.EncryptionSpec, bool, error) {
+func (r *VolumeReconciler) prepareIRIVolumeEncryption(ctx context.Context, volume *storagev1alpha1.Volume, volumeSnapshot *storagev1alpha1.VolumeSnapshot, sourceVolume *storagev1alpha1.Volume) (*iri.EncryptionSpec, bool, error) {
 	if volume.Spec.DataSource.VolumeSnapshotRef != nil {
 		inheritedEncryption, err := r.prepareIRIVolumeInheritedEncryption(ctx, volumeSnapshot)
 		if err != nil {
@@ -387,6 +468,23 @@ func (r *VolumeReconciler) prepareIRIVolumeEncryption(ctx context.Context, volum
 		}
 	}
 
+	if volume.Spec.DataSource.VolumeRef != nil {
+		inheritedEncryption, err := r.prepareIRISourceVolumeEncryption(ctx, sourceVolume)
+		if err != nil {
+			return nil, false, fmt.Errorf("error getting encryption from source volume: %w", err)
+		}
+
+		if inheritedEncryption != nil {
+			if volume.Spec.Encryption != nil {
+				return nil, false, fmt.Errorf("cannot specify encryption when cloning an encrypted volume: encryption will be inherited from source volume")
+			}
+
+			r.Eventf(volume, nil, corev1.EventTypeNormal, "VolumeEncryptionInherited", "Clone",
+				"Inheriting encryption from encrypted source volume %s", sourceVolume.Name)
+			return inheritedEncryption, true, nil
+		}
+	}
+
 	if volume.Spec.Encryption != nil {
 		return r.prepareIRIVolumeSpecEncryption(ctx, volume)
 	}
@@ -424,8 +522,34 @@ func (r *VolumeReconciler) prepareIRIVolume(ctx context.Context, log logr.Logger
 		}
 	}
 
+	var sourceVolume *storagev1alpha1.Volume
+	if volumeRef := volume.Spec.DataSource.VolumeRef; volumeRef != nil {
+		log.V(1).Info("Getting source volume")
+		sourceVolume = &storagev1alpha1.Volume{}
+		sourceVolumeKey := client.ObjectKey{Namespace: volume.Namespace, Name: volumeRef.Name}
+		if err := r.Get(ctx, sourceVolumeKey, sourceVolume); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, false, fmt.Errorf("error getting source volume %s: %w", volumeRef.Name, err)
+			}
+			r.Eventf(volume, nil, corev1.EventTypeNormal, volumepoolletevents.SourceVolumeNotFound, "Clone", "Source volume %s not found", volumeRef.Name)
+			return nil, false, nil
+		}
+	}
+
+	var backupLocation *iri.ObjectStorageLocation
+	if volumeBackupRef := volume.Spec.DataSource.VolumeBackupRef; volumeBackupRef != nil {
+		log.V(1).Info("Getting volume backup location")
+		backupLocation, err = r.prepareIRIVolumeBackupLocation(ctx, volume, volumeBackupRef.Name)
+		if err != nil {
+			return nil, false, fmt.Errorf("error preparing iri volume backup location: %w", err)
+		}
+		if backupLocation == nil {
+			return nil, false, nil
+		}
+	}
+
 	log.V(1).Info("Getting encryption secret")
-	encryption, encryptionOK, err := r.prepareIRIVolumeEncryption(ctx, volume, volumeSnapshot)
+	encryption, encryptionOK, err := r.prepareIRIVolumeEncryption(ctx, volume, volumeSnapshot, sourceVolume)
 	switch {
 	case err != nil:
 		errs = append(errs, fmt.Errorf("error preparing iri volume encryption: %w", err))
@@ -434,7 +558,7 @@ func (r *VolumeReconciler) prepareIRIVolume(ctx context.Context, log logr.Logger
 	}
 
 	log.V(1).Info("Getting volume data source")
-	dataSource, dataSourceOK, err := r.prepareIRIVolumeDataSource(volume, volumeSnapshot)
+	dataSource, dataSourceOK, err := r.prepareIRIVolumeDataSource(volume, volumeSnapshot, sourceVolume, backupLocation)
 	switch {
 	case err != nil:
 		errs = append(errs, fmt.Errorf("error preparing iri volume data source: %w", err))
@@ -562,6 +686,10 @@ func (r *VolumeReconciler) update(ctx context.Context, log logr.Logger, volume *
 		}
 	}
 
+	if err := r.updateIRIRevert(ctx, log, volume, iriVolume); err != nil {
+		return fmt.Errorf("error updating volume revert: %w", err)
+	}
+
 	return nil
 }
 
@@ -690,7 +818,71 @@ func (r *VolumeReconciler) enqueueVolumesReferencingSnapshot() handler.EventHand
 			return nil
 		}
 
-		return utilclient.ReconcileRequestsFromObjectStructSlice(volumeList.Items)
+		res := utilclient.ReconcileRequestsFromObjectStructSlice(volumeList.Items)
+
+		// The snapshotted volume may wait for the snapshot to be reverted to it.
+		if volumeRef := volumeSnapshot.Spec.VolumeRef; volumeRef != nil {
+			volume := &storagev1alpha1.Volume{}
+			volumeKey := client.ObjectKey{Namespace: volumeSnapshot.Namespace, Name: volumeRef.Name}
+			if err := r.Get(ctx, volumeKey, volume); client.IgnoreNotFound(err) != nil {
+				log.Error(err, "Error getting snapshotted volume", "VolumeKey", volumeKey)
+			} else if err == nil && volumeRevertPending(volume) && VolumeRunsInVolumePool(volume, r.VolumePoolName) {
+				res = append(res, ctrl.Request{NamespacedName: volumeKey})
+			}
+		}
+		return res
+	})
+}
+
+func (r *VolumeReconciler) enqueueVolumesCloningVolume() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		sourceVolume := obj.(*storagev1alpha1.Volume)
+		log := ctrl.LoggerFrom(ctx)
+
+		volumeList := &storagev1alpha1.VolumeList{}
+		if err := r.List(ctx, volumeList,
+			client.InNamespace(sourceVolume.Namespace),
+			client.MatchingFields{
+				storageclient.VolumeSpecVolumeRefNameField: sourceVolume.Name,
+			},
+		); err != nil {
+			log.Error(err, "Error listing volumes cloning volume", "SourceVolumeKey", client.ObjectKeyFromObject(sourceVolume))
+			return nil
+		}
+
+		var res []ctrl.Request
+		for _, volume := range volumeList.Items {
+			if VolumeRunsInVolumePool(&volume, r.VolumePoolName) {
+				res = append(res, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&volume)})
+			}
+		}
+		return res
+	})
+}
+
+func (r *VolumeReconciler) enqueueVolumesRestoringBackup() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		volumeBackup := obj.(*storagev1alpha1.VolumeBackup)
+		log := ctrl.LoggerFrom(ctx)
+
+		volumeList := &storagev1alpha1.VolumeList{}
+		if err := r.List(ctx, volumeList,
+			client.InNamespace(volumeBackup.Namespace),
+			client.MatchingFields{
+				storageclient.VolumeSpecVolumeBackupRefNameField: volumeBackup.Name,
+			},
+		); err != nil {
+			log.Error(err, "Error listing volumes restoring backup", "VolumeBackupKey", client.ObjectKeyFromObject(volumeBackup))
+			return nil
+		}
+
+		var res []ctrl.Request
+		for _, volume := range volumeList.Items {
+			if VolumeRunsInVolumePool(&volume, r.VolumePoolName) {
+				res = append(res, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&volume)})
+			}
+		}
+		return res
 	})
 }
 
@@ -714,6 +906,23 @@ func (r *VolumeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 				predicates.ResourceIsNotExternallyManaged(log),
 			),
 		).
+		Watches(
+			&storagev1alpha1.Volume{},
+			r.enqueueVolumesCloningVolume(),
+			builder.WithPredicates(
+				VolumeRunsInVolumePoolPredicate(r.VolumePoolName),
+				predicates.ResourceHasFilterLabel(log, r.WatchFilterValue),
+				predicates.ResourceIsNotExternallyManaged(log),
+			),
+		).
+		Watches(
+			&storagev1alpha1.VolumeBackup{},
+			r.enqueueVolumesRestoringBackup(),
+			builder.WithPredicates(
+				predicates.ResourceHasFilterLabel(log, r.WatchFilterValue),
+				predicates.ResourceIsNotExternallyManaged(log),
+			),
+		).
 		WithOptions(
 			controller.Options{
 				MaxConcurrentReconciles: r.MaxConcurrentReconciles,
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	poolletutils "github.com/ironcore-dev/ironcore/poollet/common/utils"
+	volumepoolletevents "github.com/ironcore-dev/ironcore/poollet/volumepoollet/controllers/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// volumeRevertPending reports whether the revert requested by the volume still has to be applied.
+// A failed revert is not retried until the volume changes again.
+func volumeRevertPending(volume *storagev1alpha1.Volume) bool {
+	revert := volume.Spec.Revert
+	if revert == nil {
+		return false
+	}
+
+	if lastApplied := volume.Status.LastAppliedRevert; lastApplied != nil && lastApplied.RequestedAt.Equal(&revert.RequestedAt) {
+		return false
+	}
+
+	cond := storagev1alpha1.FindVolumeCondition(volume.Status.Conditions, storagev1alpha1.VolumeReverting)
+	return cond == nil ||
+		cond.Status != corev1.ConditionFalse ||
+		cond.Reason != storagev1alpha1.VolumeRevertingReasonFailed ||
+		cond.ObservedGeneration != volume.Generation
+}
+
+func (r *VolumeReconciler) patchVolumeRevertStatus(
+	ctx context.Context,
+	volume *storagev1alpha1.Volume,
+	lastAppliedRevert *storagev1alpha1.VolumeRevert,
+	condStatus corev1.ConditionStatus,
+	reason, message string,
+) error {
+	base := volume.DeepCopy()
+	if lastAppliedRevert != nil {
+		volume.Status.LastAppliedRevert = lastAppliedRevert
+	}
+	volume.Status.Conditions = storagev1alpha1.SetVolumeCondition(volume.Status.Conditions, storagev1alpha1.VolumeCondition{
+		Type:               storagev1alpha1.VolumeReverting,
+		Status:             condStatus,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: volume.Generation,
+	})
+	if err := r.Status().Patch(ctx, volume, client.MergeFromWithOptions(base, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("error patching volume revert status: %w", err)
+	}
+	return nil
+}
+
+func (r *VolumeReconciler) getVolumeRevertSnapshotID(ctx context.Context, volume *storagev1alpha1.Volume) (string, string, error) {
+	volumeSnapshotName := volume.Spec.Revert.VolumeSnapshotRef.Name
+	volumeSnapshot := &storagev1alpha1.VolumeSnapshot{}
+	volumeSnapshotKey := client.ObjectKey{Namespace: volume.Namespace, Name: volumeSnapshotName}
+	if err := r.Get(ctx, volumeSnapshotKey, volumeSnapshot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", "", fmt.Errorf("error getting volume snapshot %s: %w", volumeSnapshotName, err)
+		}
+		return "", storagev1alpha1.VolumeRevertingReasonWaitingForSnapshot, nil
+	}
+
+	if volumeRef := volumeSnapshot.Spec.VolumeRef; volumeRef == nil || volumeRef.Name != volume.Name {
+		return "", storagev1alpha1.VolumeRevertingReasonFailed, nil
+	}
+
+	if volumeSnapshot.Status.State != storagev1alpha1.VolumeSnapshotStateReady || volumeSnapshot.Status.SnapshotID == "" {
+		return "", storagev1alpha1.VolumeRevertingReasonWaitingForSnapshot, nil
+	}
+
+	snapshotID, err := poolletutils.ParseID(volumeSnapshot.Status.SnapshotID)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing volume snapshot id %s: %w", volumeSnapshot.Status.SnapshotID, err)
+	}
+	return snapshotID.ID, "", nil
+}
+
+func isPermanentRevertError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound, codes.Unimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *VolumeReconciler) updateIRIRevert(ctx context.Context, log logr.Logger, volume *storagev1alpha1.Volume, iriVolume *iri.Volume) error {
+	if !volumeRevertPending(volume) {
+		log.V(1).Info("No revert pending")
+		return nil
+	}
+
+	revert := volume.Spec.Revert.DeepCopy()
+	volumeSnapshotName := revert.VolumeSnapshotRef.Name
+	log = log.WithValues("VolumeSnapshot", volumeSnapshotName, "RequestedAt", revert.RequestedAt)
+
+	if claimRef := volume.Spec.ClaimRef; claimRef != nil {
+		log.V(1).Info("Volume is claimed, waiting for detach", "Claimer", claimRef.Name)
+		return r.patchVolumeRevertStatus(ctx, volume, nil, corev1.ConditionTrue, storagev1alpha1.VolumeRevertingReasonWaitingForDetach,
+			fmt.Sprintf("Volume is claimed by %s", claimRef.Name))
+	}
+
+	log.V(1).Info("Getting volume snapshot")
+	snapshotID, reason, err := r.getVolumeRevertSnapshotID(ctx, volume)
+	if err != nil {
+		return err
+	}
+	switch reason {
+	case storagev1alpha1.VolumeRevertingReasonWaitingForSnapshot:
+		log.V(1).Info("Volume snapshot is not ready")
+		return r.patchVolumeRevertStatus(ctx, volume, nil, corev1.ConditionTrue, reason,
+			fmt.Sprintf("VolumeSnapshot %s is not ready", volumeSnapshotName))
+	case storagev1alpha1.VolumeRevertingReasonFailed:
+		r.Eventf(volume, nil, corev1.EventTypeWarning, volumepoolletevents.VolumeRevertFailed, "Revert", "VolumeSnapshot %s is not a snapshot of the volume", volumeSnapshotName)
+		return r.patchVolumeRevertStatus(ctx, volume, nil, corev1.ConditionFalse, reason,
+			fmt.Sprintf("VolumeSnapshot %s is not a snapshot of the volume", volumeSnapshotName))
+	}
+
+	// Report the revert before calling the runtime so that no machine attaches the volume meanwhile.
+	// The optimistic lock makes the report fail if the volume has been claimed since it was read.
+	if err := r.patchVolumeRevertStatus(ctx, volume, nil, corev1.ConditionTrue, storagev1alpha1.VolumeRevertingReasonReverting,
+		fmt.Sprintf("Reverting to VolumeSnapshot %s", volumeSnapshotName)); err != nil {
+		return err
+	}
+	if claimRef := volume.Spec.ClaimRef; claimRef != nil {
+		log.V(1).Info("Volume got claimed while reporting the revert, waiting for detach", "Claimer", claimRef.Name)
+		return r.patchVolumeRevertStatus(ctx, volume, nil, corev1.ConditionTrue, storagev1alpha1.VolumeRevertingReasonWaitingForDetach,
+			fmt.Sprintf("Volume is claimed by %s", claimRef.Name))
+	}
+
+	// RevertVolume returns once the runtime applied the revert.
+	log.V(1).Info("Reverting volume", "VolumeSnapshotID", snapshotID)
+	if _, err := r.VolumeRuntime.RevertVolume(ctx, &iri.RevertVolumeRequest{
+		VolumeId:         iriVolume.Metadata.Id,
+		VolumeSnapshotId: snapshotID,
+	}); err != nil {
+		if !isPermanentRevertError(err) {
+			return fmt.Errorf("error reverting volume: %w", err)
+		}
+
+		r.Eventf(volume, nil, corev1.EventTypeWarning, volumepoolletevents.VolumeRevertFailed, "Revert", "Failed to revert to VolumeSnapshot %s: %s", volumeSnapshotName, status.Convert(err).Message())
+		return r.patchVolumeRevertStatus(ctx, volume, nil, corev1.ConditionFalse, storagev1alpha1.VolumeRevertingReasonFailed,
+			fmt.Sprintf("Failed to revert to VolumeSnapshot %s: %s", volumeSnapshotName, status.Convert(err).Message()))
+	}
+
+	r.Eventf(volume, nil, corev1.EventTypeNormal, volumepoolletevents.VolumeReverted, "Revert", "Reverted volume to VolumeSnapshot %s", volumeSnapshotName)
+	return r.patchVolumeRevertStatus(ctx, volume, revert, corev1.ConditionFalse, storagev1alpha1.VolumeRevertingReasonReverted,
+		fmt.Sprintf("Reverted to VolumeSnapshot %s", volumeSnapshotName))
+}
@@ -6,6 +6,7 @@ package controllers_test
 import (
 	"fmt"
 
+	commonv1alpha1 "github.com/ironcore-dev/ironcore/api/common/v1alpha1"
 	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
@@ -1038,6 +1039,96 @@ var _ = Describe("VolumeController", func() {
 		))
 	})
 
+	It("should revert a volume to a snapshot once it is no longer claimed", func(ctx SpecContext) {
+		size := resource.MustParse("10Mi")
+
+		By("creating a claimed volume")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: vc.Name},
+				VolumePoolRef:  &corev1.LocalObjectReference{Name: vp.Name},
+				ClaimRef:       &commonv1alpha1.LocalUIDReference{Name: "machine", UID: "machine-uid"},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: size,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed())
+		DeferCleanup(expectVolumeDeleted, volume)
+
+		By("waiting for the runtime to report the volume")
+		Eventually(srv).Should(HaveField("Volumes", HaveLen(1)))
+
+		By("setting the volume status in the runtime")
+		_, iriVolume := GetSingleMapEntry(srv.Volumes)
+		iriVolume = &testingvolume.FakeVolume{Volume: proto.Clone(iriVolume.Volume).(*iri.Volume)}
+		iriVolume.Status.State = iri.VolumeState_VOLUME_AVAILABLE
+		srv.SetVolumes([]*testingvolume.FakeVolume{iriVolume})
+		Eventually(Object(volume)).Should(HaveField("Status.State", Equal(storagev1alpha1.VolumeStateAvailable)))
+
+		By("creating a volume snapshot")
+		volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "snapshot-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotSpec{
+				VolumeRef: &corev1.LocalObjectReference{Name: volume.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshot)).To(Succeed())
+		DeferCleanup(expectVolumeSnapshotDeleted, volumeSnapshot)
+
+		By("requesting a revert of the volume")
+		Eventually(Update(volume, func() {
+			volume.Spec.Revert = &storagev1alpha1.VolumeRevert{
+				VolumeSnapshotRef: corev1.LocalObjectReference{Name: volumeSnapshot.Name},
+				RequestedAt:       metav1.Now(),
+			}
+		})).Should(Succeed())
+
+		By("waiting for the volume to wait for its detach")
+		Eventually(Object(volume)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", storagev1alpha1.VolumeReverting),
+			HaveField("Status", corev1.ConditionTrue),
+			HaveField("Reason", storagev1alpha1.VolumeRevertingReasonWaitingForDetach),
+		))))
+
+		By("releasing the volume")
+		Eventually(Update(volume, func() {
+			volume.Spec.ClaimRef = nil
+		})).Should(Succeed())
+
+		By("waiting for the volume to wait for the snapshot")
+		Eventually(Object(volume)).Should(HaveField("Status.Conditions", ContainElement(SatisfyAll(
+			HaveField("Type", storagev1alpha1.VolumeReverting),
+			HaveField("Reason", storagev1alpha1.VolumeRevertingReasonWaitingForSnapshot),
+		))))
+
+		By("setting the volume snapshot status in the runtime")
+		Eventually(srv).Should(HaveField("VolumeSnapshots", HaveLen(1)))
+		_, iriVolumeSnapshot := GetSingleMapEntry(srv.VolumeSnapshots)
+		iriVolumeSnapshot = &testingvolume.FakeVolumeSnapshot{VolumeSnapshot: proto.Clone(iriVolumeSnapshot.VolumeSnapshot).(*iri.VolumeSnapshot)}
+		iriVolumeSnapshot.Status.State = iri.VolumeSnapshotState_VOLUME_SNAPSHOT_READY
+		iriVolumeSnapshot.Status.Size = size.Value()
+		srv.SetVolumeSnapshots([]*testingvolume.FakeVolumeSnapshot{iriVolumeSnapshot})
+
+		By("waiting for the volume to be reverted")
+		Eventually(Object(volume)).Should(SatisfyAll(
+			HaveField("Status.LastAppliedRevert.VolumeSnapshotRef.Name", volumeSnapshot.Name),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", storagev1alpha1.VolumeReverting),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", storagev1alpha1.VolumeRevertingReasonReverted),
+			))),
+		))
+		Expect(srv.Volumes[iriVolume.Metadata.Id].Reverts).To(Equal([]string{iriVolumeSnapshot.Metadata.Id}))
+	})
+
 })
 
 func GetSingleMapEntry[K comparable, V any](m map[K]V) (K, V) {
@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/go-logr/logr"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	iriVolume "github.com/ironcore-dev/ironcore/iri/apis/volume"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	poolletutils "github.com/ironcore-dev/ironcore/poollet/common/utils"
+	volumepoolletevents "github.com/ironcore-dev/ironcore/poollet/volumepoollet/controllers/events"
+	"github.com/ironcore-dev/ironcore/utils/predicates"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// defaultVolumeBackupExportPollInterval is the default interval to poll running exports with.
+const defaultVolumeBackupExportPollInterval = 5 * time.Second
+
+// VolumeBackupReconciler exports the VolumeSnapshots of Volumes running in the VolumePool into Buckets.
+type VolumeBackupReconciler struct {
+	events.EventRecorder
+	client.Client
+
+	VolumeRuntime iriVolume.RuntimeService
+
+	VolumePoolName string
+
+	// ExportPollInterval is the interval to poll the progress of running exports with.
+	// If zero, defaultVolumeBackupExportPollInterval is used.
+	ExportPollInterval time.Duration
+
+	WatchFilterValue string
+
+	MaxConcurrentReconciles int
+}
+
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumebackups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumebackups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumesnapshots,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=volumes,verbs=get;list;watch
+//+kubebuilder:rbac:groups=storage.ironcore.dev,resources=buckets,verbs=get;list;watch
+
+func (r *VolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	volumeBackup := &storagev1alpha1.VolumeBackup{}
+	if err := r.Get(ctx, req.NamespacedName, volumeBackup); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !volumeBackup.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return r.reconcileExists(ctx, log, volumeBackup)
+}
+
+func (r *VolumeBackupReconciler) reconcileExists(ctx context.Context, log logr.Logger, volumeBackup *storagev1alpha1.VolumeBackup) (ctrl.Result, error) {
+	switch volumeBackup.Status.State {
+	case storagev1alpha1.VolumeBackupStateReady, storagev1alpha1.VolumeBackupStateFailed:
+		log.V(1).Info("Volume backup is done", "State", volumeBackup.Status.State)
+		return ctrl.Result{}, nil
+	}
+
+	volumeSnapshotName := volumeBackup.Spec.VolumeSnapshotRef.Name
+	volumeSnapshot := &storagev1alpha1.VolumeSnapshot{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: volumeBackup.Namespace, Name: volumeSnapshotName}, volumeSnapshot); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("error getting volume snapshot %s: %w", volumeSnapshotName, err)
+		}
+		log.V(1).Info("Volume snapshot not found", "VolumeSnapshot", volumeSnapshotName)
+		return ctrl.Result{}, nil
+	}
+
+	// The volume backup is exported by the volume pool the snapshotted volume runs in.
+	volume, err := r.getVolumeSnapshotVolume(ctx, volumeSnapshot)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if volume == nil || !VolumeRunsInVolumePool(volume, r.VolumePoolName) {
+		log.V(1).Info("Volume snapshot is not of a volume in the volume pool", "VolumeSnapshot", volumeSnapshotName)
+		return ctrl.Result{}, nil
+	}
+
+	base := volumeBackup.DeepCopy()
+	requeueAfter, err := r.export(ctx, log, volumeBackup, volumeSnapshot, volume)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.V(1).Info("Updating volume backup status")
+	if err := r.Status().Patch(ctx, volumeBackup, client.MergeFrom(base)); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error updating volume backup status: %w", err)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *VolumeBackupReconciler) exportPollInterval() time.Duration {
+	if r.ExportPollInterval > 0 {
+		return r.ExportPollInterval
+	}
+	return defaultVolumeBackupExportPollInterval
+}
+
+// getVolumeSnapshotVolume returns the snapshotted volume of the volume snapshot, or nil if it does not exist.
+func (r *VolumeBackupReconciler) getVolumeSnapshotVolume(ctx context.Context, volumeSnapshot *storagev1alpha1.VolumeSnapshot) (*storagev1alpha1.Volume, error) {
+	volumeRef := volumeSnapshot.Spec.VolumeRef
+	if volumeRef == nil {
+		return nil, nil
+	}
+
+	volume := &storagev1alpha1.Volume{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: volumeSnapshot.Namespace, Name: volumeRef.Name}, volume); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting volume %s: %w", volumeRef.Name, err)
+		}
+		return nil, nil
+	}
+	return volume, nil
+}
+
+func setVolumeBackupState(volumeBackup *storagev1alpha1.VolumeBackup, state storagev1alpha1.VolumeBackupState, message string) {
+	if volumeBackup.Status.State != state {
+		now := metav1.Now()
+		volumeBackup.Status.LastStateTransitionTime = &now
+	}
+	volumeBackup.Status.State = state
+	volumeBackup.Status.Message = message
+}
+
+// volumeBackupObjectKey returns the key of the object a VolumeBackup is exported to.
+func volumeBackupObjectKey(volumeBackup *storagev1alpha1.VolumeBackup) string {
+	return path.Join("volumebackups", volumeBackup.Name, string(volumeBackup.UID))
+}
+
+// prepareIRIObjectStorageLocation returns the location of the object with the given key in the bucket.
+// If the bucket is not accessible yet, it returns a nil location and a message describing why.
+func prepareIRIObjectStorageLocation(ctx context.Context, c client.Client, namespace, bucketName, key string) (*iri.ObjectStorageLocation, string, error) {
+	bucket := &storagev1alpha1.Bucket{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: bucketName}, bucket); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, "", fmt.Errorf("error getting bucket %s: %w", bucketName, err)
+		}
+		return nil, fmt.Sprintf("Bucket %s not found", bucketName), nil
+	}
+
+	access := bucket.Status.Access
+	if bucket.Status.State != storagev1alpha1.BucketStateAvailable || access == nil || access.Endpoint == "" {
+		return nil, fmt.Sprintf("Bucket %s is not available (state: %s)", bucketName, bucket.Status.State), nil
+	}
+
+	var secretData map[string][]byte
+	if secretRef := access.SecretRef; secretRef != nil {
+		secret := &corev1.Secret{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretRef.Name}, secret); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, "", fmt.Errorf("error getting bucket access secret %s: %w", secretRef.Name, err)
+			}
+			return nil, fmt.Sprintf("Access secret %s of bucket %s not found", secretRef.Name, bucketName), nil
+		}
+		secretData = secret.Data
+	}
+
+	return &iri.ObjectStorageLocation{
+		Endpoint:   access.Endpoint,
+		Key:        key,
+		SecretData: secretData,
+	}, "", nil
+}
+
+func isPermanentExportError(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound, codes.Unimplemented, codes.PermissionDenied:
+		return true
+	default:
+		return false
+	}
+}
+
+// export starts or observes the export of the volume snapshot of the volume backup and updates its status in-memory.
+// It returns the duration after which a running export should be polled again.
+func (r *VolumeBackupReconciler) export(
+	ctx context.Context,
+	log logr.Logger,
+	volumeBackup *storagev1alpha1.VolumeBackup,
+	volumeSnapshot *storagev1alpha1.VolumeSnapshot,
+	volume *storagev1alpha1.Volume,
+) (time.Duration, error) {
+	if exportID := volumeBackup.Status.ExportID; exportID != "" {
+		requeueAfter, restart, err := r.observeExport(ctx, log, volumeBackup, volumeSnapshot, exportID)
+		if err != nil || !restart {
+			return requeueAfter, err
+		}
+
+		log.V(1).Info("Export is not known to the volume runtime, restarting it", "ExportID", exportID)
+		volumeBackup.Status.ExportID = ""
+	}
+
+	if volumeSnapshot.Status.State == storagev1alpha1.VolumeSnapshotStateFailed {
+		r.Eventf(volumeBackup, volumeSnapshot, corev1.EventTypeWarning, volumepoolletevents.VolumeBackupFailed, "Export",
+			"VolumeSnapshot %s failed", volumeSnapshot.Name)
+		setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStateFailed,
+			fmt.Sprintf("VolumeSnapshot %s failed", volumeSnapshot.Name))
+		return 0, nil
+	}
+	if volumeSnapshot.Status.State != storagev1alpha1.VolumeSnapshotStateReady || volumeSnapshot.Status.SnapshotID == "" {
+		log.V(1).Info("Volume snapshot is not ready yet")
+		setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStatePending,
+			fmt.Sprintf("Waiting for VolumeSnapshot %s to become ready", volumeSnapshot.Name))
+		return 0, nil
+	}
+
+	snapshotID, err := poolletutils.ParseID(volumeSnapshot.Status.SnapshotID)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing volume snapshot id %s: %w", volumeSnapshot.Status.SnapshotID, err)
+	}
+
+	objectKey := volumeBackupObjectKey(volumeBackup)
+	location, notReadyMessage, err := prepareIRIObjectStorageLocation(ctx, r.Client, volumeBackup.Namespace, volumeBackup.Spec.BucketRef.Name, objectKey)
+	if err != nil {
+		return 0, err
+	}
+	if location == nil {
+		log.V(1).Info("Bucket is not ready yet", "Message", notReadyMessage)
+		setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStatePending, notReadyMessage)
+		return 0, nil
+	}
+
+	log.V(1).Info("Starting volume snapshot export", "VolumeSnapshotID", snapshotID.ID, "ObjectKey", objectKey)
+	res, err := r.VolumeRuntime.ExportVolumeSnapshot(ctx, &iri.ExportVolumeSnapshotRequest{
+		VolumeSnapshotId: snapshotID.ID,
+		Location:         location,
+	})
+	if err != nil {
+		if !isPermanentExportError(err) {
+			return 0, fmt.Errorf("error exporting volume snapshot: %w", err)
+		}
+
+		r.Eventf(volumeBackup, volumeSnapshot, corev1.EventTypeWarning, volumepoolletevents.VolumeBackupFailed, "Export",
+			"Failed to export VolumeSnapshot %s: %s", volumeSnapshot.Name, status.Convert(err).Message())
+		setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStateFailed,
+			fmt.Sprintf("Failed to export VolumeSnapshot %s: %s", volumeSnapshot.Name, status.Convert(err).Message()))
+		return 0, nil
+	}
+
+	volumeBackup.Status.ExportID = res.ExportId
+	if storage := volume.Spec.Resources.Storage(); !storage.IsZero() {
+		volumeBackup.Status.VolumeStorage = storage
+	}
+	setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStatePending,
+		fmt.Sprintf("Exporting VolumeSnapshot %s", volumeSnapshot.Name))
+	return r.exportPollInterval(), nil
+}
+
+// observeExport updates the status of the volume backup in-memory from the export with the given id.
+// It reports whether the export has to be restarted as the volume runtime does not know it.
+func (r *VolumeBackupReconciler) observeExport(
+	ctx context.Context,
+	log logr.Logger,
+	volumeBackup *storagev1alpha1.VolumeBackup,
+	volumeSnapshot *storagev1alpha1.VolumeSnapshot,
+	exportID string,
+) (requeueAfter time.Duration, restart bool, err error) {
+	log.V(1).Info("Getting volume snapshot export", "ExportID", exportID)
+	res, err := r.VolumeRuntime.GetVolumeSnapshotExport(ctx, &iri.GetVolumeSnapshotExportRequest{ExportId: exportID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return 0, true, nil
+		}
+		return 0, false, fmt.Errorf("error getting volume snapshot export %s: %w", exportID, err)
+	}
+
+	export := res.Export
+	switch export.GetState() {
+	case iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_SUCCEEDED:
+		r.Eventf(volumeBackup, volumeSnapshot, corev1.EventTypeNormal, volumepoolletevents.VolumeBackupExported, "Export",
+			"Exported VolumeSnapshot %s to bucket %s", volumeSnapshot.Name, volumeBackup.Spec.BucketRef.Name)
+		volumeBackup.Status.ObjectKey = volumeBackupObjectKey(volumeBackup)
+		if export.BytesWritten > 0 {
+			volumeBackup.Status.Size = resource.NewQuantity(export.BytesWritten, resource.BinarySI)
+		}
+		setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStateReady,
+			fmt.Sprintf("Exported VolumeSnapshot %s", volumeSnapshot.Name))
+		return 0, false, nil
+	case iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_FAILED:
+		r.Eventf(volumeBackup, volumeSnapshot, corev1.EventTypeWarning, volumepoolletevents.VolumeBackupFailed, "Export",
+			"Failed to export VolumeSnapshot %s: %s", volumeSnapshot.Name, export.Message)
+		setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStateFailed,
+			fmt.Sprintf("Failed to export VolumeSnapshot %s: %s", volumeSnapshot.Name, export.Message))
+		return 0, false, nil
+	default:
+		log.V(1).Info("Volume snapshot export is running", "BytesWritten", export.GetBytesWritten())
+		setVolumeBackupState(volumeBackup, storagev1alpha1.VolumeBackupStatePending,
+			fmt.Sprintf("Exporting VolumeSnapshot %s (%s written)", volumeSnapshot.Name,
+				resource.NewQuantity(export.GetBytesWritten(), resource.BinarySI)))
+		return r.exportPollInterval(), false, nil
+	}
+}
+
+func (r *VolumeBackupReconciler) enqueueVolumeBackups(matches func(volumeBackup *storagev1alpha1.VolumeBackup, name string) bool) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []ctrl.Request {
+		log := ctrl.LoggerFrom(ctx)
+
+		volumeBackupList := &storagev1alpha1.VolumeBackupList{}
+		if err := r.List(ctx, volumeBackupList, client.InNamespace(obj.GetNamespace())); err != nil {
+			log.Error(err, "Error listing volume backups")
+			return nil
+		}
+
+		var res []ctrl.Request
+		for _, volumeBackup := range volumeBackupList.Items {
+			if matches(&volumeBackup, obj.GetName()) {
+				res = append(res, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&volumeBackup)})
+			}
+		}
+		return res
+	})
+}
+
+func (r *VolumeBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	log := ctrl.Log.WithName("volumepoollet").WithName("volumebackup")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(
+			&storagev1alpha1.VolumeBackup{},
+			builder.WithPredicates(
+				predicates.ResourceHasFilterLabel(log, r.WatchFilterValue),
+				predicates.ResourceIsNotExternallyManaged(log),
+			),
+		).
+		Watches(
+			&storagev1alpha1.VolumeSnapshot{},
+			r.enqueueVolumeBackups(func(volumeBackup *storagev1alpha1.VolumeBackup, name string) bool {
+				return volumeBackup.Spec.VolumeSnapshotRef.Name == name
+			}),
+		).
+		Watches(
+			&storagev1alpha1.Bucket{},
+			r.enqueueVolumeBackups(func(volumeBackup *storagev1alpha1.VolumeBackup, name string) bool {
+				return volumeBackup.Spec.BucketRef.Name == name
+			}),
+		).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: r.MaxConcurrentReconciles,
+		}).
+		Complete(r)
+}
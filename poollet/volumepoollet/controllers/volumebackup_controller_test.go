@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and IronCore contributors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers_test
+
+import (
+	"net/http/httptest"
+
+	corev1alpha1 "github.com/ironcore-dev/ironcore/api/core/v1alpha1"
+	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/testing/fault"
+	"github.com/ironcore-dev/ironcore/iri/testing/objectstorage"
+	testingvolume "github.com/ironcore-dev/ironcore/iri/testing/volume"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	. "sigs.k8s.io/controller-runtime/pkg/envtest/komega"
+)
+
+var _ = Describe("VolumeBackupController", func() {
+	ns, vp, vc, _, srv := SetupTest()
+
+	It("should export a volume snapshot to a bucket and restore a volume from it", func(ctx SpecContext) {
+		size := resource.MustParse("1Gi")
+
+		By("starting an object storage")
+		objectStorage := objectstorage.NewServer()
+		objectStorage.AddCredentials("access-key-id", "secret-access-key")
+		objectStorageServer := httptest.NewServer(objectStorage)
+		DeferCleanup(objectStorageServer.Close)
+
+		By("creating an available volume")
+		volume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: vc.Name},
+				VolumePoolRef:  &corev1.LocalObjectReference{Name: vp.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: size,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volume)).To(Succeed())
+		DeferCleanup(expectVolumeDeleted, volume)
+
+		Eventually(srv).Should(HaveField("Volumes", HaveLen(1)))
+		_, iriVolume := GetSingleMapEntry(srv.Volumes)
+		iriVolume = &testingvolume.FakeVolume{Volume: proto.Clone(iriVolume.Volume).(*iri.Volume)}
+		iriVolume.Status.State = iri.VolumeState_VOLUME_AVAILABLE
+		iriVolume.Status.Access = &iri.VolumeAccess{
+			Driver: "test-driver",
+			Handle: "test-volume-id",
+		}
+		srv.SetVolumes([]*testingvolume.FakeVolume{iriVolume})
+		Eventually(Object(volume)).Should(HaveField("Status.State", Equal(storagev1alpha1.VolumeStateAvailable)))
+
+		By("creating a ready volume snapshot")
+		volumeSnapshot := &storagev1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "volume-snapshot-",
+			},
+			Spec: storagev1alpha1.VolumeSnapshotSpec{
+				VolumeRef: &corev1.LocalObjectReference{Name: volume.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeSnapshot)).To(Succeed())
+		DeferCleanup(expectVolumeSnapshotDeleted, volumeSnapshot)
+
+		Eventually(srv).Should(HaveField("VolumeSnapshots", HaveLen(1)))
+		_, iriVolumeSnapshot := GetSingleMapEntry(srv.VolumeSnapshots)
+		iriVolumeSnapshot = &testingvolume.FakeVolumeSnapshot{VolumeSnapshot: proto.Clone(iriVolumeSnapshot.VolumeSnapshot).(*iri.VolumeSnapshot)}
+		iriVolumeSnapshot.Status.State = iri.VolumeSnapshotState_VOLUME_SNAPSHOT_READY
+		srv.SetVolumeSnapshots([]*testingvolume.FakeVolumeSnapshot{iriVolumeSnapshot})
+		Eventually(Object(volumeSnapshot)).Should(HaveField("Status.State", Equal(storagev1alpha1.VolumeSnapshotStateReady)))
+
+		By("creating a bucket")
+		bucket := &storagev1alpha1.Bucket{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "bucket-",
+			},
+			Spec: storagev1alpha1.BucketSpec{
+				BucketClassRef: &corev1.LocalObjectReference{Name: "bucket-class"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, bucket)).To(Succeed())
+
+		By("creating a volume backup")
+		volumeBackup := &storagev1alpha1.VolumeBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "volume-backup-",
+			},
+			Spec: storagev1alpha1.VolumeBackupSpec{
+				VolumeSnapshotRef: corev1.LocalObjectReference{Name: volumeSnapshot.Name},
+				BucketRef:         corev1.LocalObjectReference{Name: bucket.Name},
+			},
+		}
+		Expect(k8sClient.Create(ctx, volumeBackup)).To(Succeed())
+
+		By("waiting for the volume backup to wait for the bucket")
+		Eventually(Object(volumeBackup)).Should(SatisfyAll(
+			HaveField("Status.State", storagev1alpha1.VolumeBackupStatePending),
+			HaveField("Status.Message", ContainSubstring(bucket.Name)),
+		))
+
+		By("making the volume runtime lose the first export")
+		srv.Faults.FailNext(iri.VolumeRuntime_GetVolumeSnapshotExport_FullMethodName, fault.Error(codes.NotFound))
+
+		By("making the bucket available")
+		accessSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "bucket-access-",
+			},
+			Data: map[string][]byte{
+				objectstorage.AccessKeyIDKey:     []byte("access-key-id"),
+				objectstorage.SecretAccessKeyKey: []byte("secret-access-key"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, accessSecret)).To(Succeed())
+		Eventually(UpdateStatus(bucket, func() {
+			bucket.Status.State = storagev1alpha1.BucketStateAvailable
+			bucket.Status.Access = &storagev1alpha1.BucketAccess{
+				SecretRef: &corev1.LocalObjectReference{Name: accessSecret.Name},
+				Endpoint:  objectStorageServer.URL,
+			}
+		})).Should(Succeed())
+
+		By("waiting for the volume backup to be exported")
+		Eventually(Object(volumeBackup)).Should(SatisfyAll(
+			HaveField("Status.State", storagev1alpha1.VolumeBackupStateReady),
+			HaveField("Status.ExportID", Not(BeEmpty())),
+			HaveField("Status.ObjectKey", Not(BeEmpty())),
+			HaveField("Status.Size", Not(BeNil())),
+			HaveField("Status.VolumeStorage", Not(BeNil())),
+		))
+		Expect(objectStorage.Keys()).To(ConsistOf(volumeBackup.Status.ObjectKey))
+		Expect(volumeBackup.Status.VolumeStorage.Cmp(size)).To(Equal(0))
+
+		By("asserting the lost export was restarted")
+		Expect(srv.Faults.Calls(iri.VolumeRuntime_ExportVolumeSnapshot_FullMethodName)).To(BeNumerically(">=", 2))
+		Expect(srv.VolumeSnapshotExports).To(HaveKeyWithValue(volumeBackup.Status.ExportID,
+			HaveField("State", iri.VolumeSnapshotExportState_VOLUME_SNAPSHOT_EXPORT_SUCCEEDED)))
+
+		By("creating a volume smaller than the backed up volume from the volume backup")
+		smallVolume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "small-volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: vc.Name},
+				VolumePoolRef:  &corev1.LocalObjectReference{Name: vp.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: resource.MustParse("512Mi"),
+				},
+				DataSource: storagev1alpha1.VolumeDataSource{
+					VolumeBackupRef: &corev1.LocalObjectReference{Name: volumeBackup.Name},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, smallVolume)).To(Succeed())
+		DeferCleanup(expectVolumeDeleted, smallVolume)
+
+		By("asserting the volume is not restored")
+		Consistently(srv).Should(HaveField("Volumes", HaveLen(1)))
+
+		By("creating a volume from the volume backup")
+		restoredVolume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    ns.Name,
+				GenerateName: "restored-volume-",
+			},
+			Spec: storagev1alpha1.VolumeSpec{
+				VolumeClassRef: &corev1.LocalObjectReference{Name: vc.Name},
+				VolumePoolRef:  &corev1.LocalObjectReference{Name: vp.Name},
+				Resources: corev1alpha1.ResourceList{
+					corev1alpha1.ResourceStorage: size,
+				},
+				DataSource: storagev1alpha1.VolumeDataSource{
+					VolumeBackupRef: &corev1.LocalObjectReference{Name: volumeBackup.Name},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, restoredVolume)).To(Succeed())
+		DeferCleanup(expectVolumeDeleted, restoredVolume)
+
+		By("waiting for the runtime to restore the volume from the backup")
+		Eventually(srv).Should(HaveField("Volumes", ContainElement(SatisfyAll(
+			HaveField("Spec.VolumeDataSource.BackupDataSource.Location.Key", volumeBackup.Status.ObjectKey),
+			HaveField("RestoredVolumeSnapshotID", iriVolumeSnapshot.Metadata.Id),
+		))))
+	})
+})
@@ -18,6 +18,7 @@ import (
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
 	"github.com/ironcore-dev/ironcore/iri/apis/volume"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
+	"github.com/ironcore-dev/ironcore/iri/capability"
 	poolletutils "github.com/ironcore-dev/ironcore/poollet/common/utils"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/vcm"
 	ironcoreclient "github.com/ironcore-dev/ironcore/utils/client"
@@ -116,7 +117,23 @@ func (r *VolumePoolReconciler) calculateCapacity(
 	return capacity, quota.SubtractWithNonNegativeResult(capacity, usedResources), supported, nil
 }
 
-func (r *VolumePoolReconciler) updateStatus(ctx context.Context, log logr.Logger, volumePool *storagev1alpha1.VolumePool, volumes []storagev1alpha1.Volume, volumeClassList []storagev1alpha1.VolumeClass) error {
+func (r *VolumePoolReconciler) getRuntimeCapabilities(ctx context.Context) ([]string, error) {
+	res, err := r.VolumeRuntime.Version(ctx, &iri.VersionRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting volume runtime version: %w", err)
+	}
+	return capability.Names(res.Capabilities), nil
+}
+
+func (r *VolumePoolReconciler) updateStatus(
+	ctx context.Context,
+	log logr.Logger,
+	volumePool *storagev1alpha1.VolumePool,
+	volumes []storagev1alpha1.Volume,
+	volumeClassList []storagev1alpha1.VolumeClass,
+	capabilities []string,
+	capabilitiesErr error,
+) error {
 	capacity, allocatable, supported, err := r.calculateCapacity(ctx, log, volumes, volumeClassList)
 	if err != nil {
 		return fmt.Errorf("error calculating pool resources:%w", err)
@@ -127,6 +144,7 @@ func (r *VolumePoolReconciler) updateStatus(ctx context.Context, log logr.Logger
 	volumePool.Status.AvailableVolumeClasses = supported
 	volumePool.Status.Capacity = capacity
 	volumePool.Status.Allocatable = allocatable
+	volumePool.Status.Conditions = storagev1alpha1.SetVolumePoolCondition(volumePool.Status.Conditions, runtimeCapabilitiesCondition(volumePool, capabilities, capabilitiesErr))
 
 	if err := r.Status().Patch(ctx, volumePool, client.MergeFrom(base)); err != nil {
 		return fmt.Errorf("error patching volume pool status: %w", err)
@@ -135,6 +153,26 @@ func (r *VolumePoolReconciler) updateStatus(ctx context.Context, log logr.Logger
 	return nil
 }
 
+// runtimeCapabilitiesCondition returns the RuntimeCapabilities condition for the capabilities reported by the volume runtime.
+func runtimeCapabilitiesCondition(volumePool *storagev1alpha1.VolumePool, capabilities []string, capabilitiesErr error) storagev1alpha1.VolumePoolCondition {
+	if capabilitiesErr != nil {
+		return storagev1alpha1.VolumePoolCondition{
+			Type:               storagev1alpha1.VolumePoolRuntimeCapabilities,
+			Status:             corev1.ConditionFalse,
+			Reason:             poolletutils.RuntimeCapabilitiesVersionFailedReason,
+			Message:            capabilitiesErr.Error(),
+			ObservedGeneration: volumePool.Generation,
+		}
+	}
+	return storagev1alpha1.VolumePoolCondition{
+		Type:               storagev1alpha1.VolumePoolRuntimeCapabilities,
+		Status:             corev1.ConditionTrue,
+		Reason:             poolletutils.RuntimeCapabilitiesDiscoveredReason,
+		Message:            poolletutils.RuntimeCapabilitiesMessage(capabilities),
+		ObservedGeneration: volumePool.Generation,
+	}
+}
+
 func (r *VolumePoolReconciler) reconcile(ctx context.Context, log logr.Logger, volumePool *storagev1alpha1.VolumePool) (ctrl.Result, error) {
 	log.V(1).Info("Reconcile")
 
@@ -148,9 +186,15 @@ func (r *VolumePoolReconciler) reconcile(ctx context.Context, log logr.Logger, v
 		return ctrl.Result{RequeueAfter: 1}, nil
 	}
 
-	log.V(1).Info("Enforcing configured topology labels")
-	if err := r.enforceOriginalTopologyLabels(ctx, log, volumePool); err != nil {
-		return ctrl.Result{}, fmt.Errorf("error enforcing original topology labels: %w", err)
+	log.V(1).Info("Getting runtime capabilities")
+	capabilities, capabilitiesErr := r.getRuntimeCapabilities(ctx)
+	if capabilitiesErr != nil {
+		log.Error(capabilitiesErr, "Error getting runtime capabilities")
+	}
+
+	log.V(1).Info("Enforcing configured topology and runtime capability labels")
+	if err := r.enforceLabels(ctx, log, volumePool, capabilities, capabilitiesErr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("error enforcing labels: %w", err)
 	}
 
 	log.V(1).Info("Listing volume classes")
@@ -168,7 +212,7 @@ func (r *VolumePoolReconciler) reconcile(ctx context.Context, log logr.Logger, v
 	}
 
 	log.V(1).Info("Updating volume pool status")
-	if err := r.updateStatus(ctx, log, volumePool, volumeList.Items, volumeClassList.Items); err != nil {
+	if err := r.updateStatus(ctx, log, volumePool, volumeList.Items, volumeClassList.Items, capabilities, capabilitiesErr); err != nil {
 		return ctrl.Result{}, fmt.Errorf("error updating status: %w", err)
 	}
 
@@ -176,10 +220,21 @@ func (r *VolumePoolReconciler) reconcile(ctx context.Context, log logr.Logger, v
 	return ctrl.Result{}, nil
 }
 
-func (r *VolumePoolReconciler) enforceOriginalTopologyLabels(ctx context.Context, log logr.Logger, volumePool *storagev1alpha1.VolumePool) error {
+// enforceLabels sets the configured topology labels and the labels of the runtime capabilities.
+// If the capabilities could not be determined, the capability labels are left as they are.
+func (r *VolumePoolReconciler) enforceLabels(
+	ctx context.Context,
+	log logr.Logger,
+	volumePool *storagev1alpha1.VolumePool,
+	capabilities []string,
+	capabilitiesErr error,
+) error {
 	base := volumePool.DeepCopy()
 
 	poolletutils.SetTopologyLabels(log, &volumePool.ObjectMeta, r.TopologyLabels)
+	if capabilitiesErr == nil {
+		poolletutils.SetRuntimeCapabilityLabels(log, &volumePool.ObjectMeta, capabilities)
+	}
 
 	return r.Patch(ctx, volumePool, client.MergeFrom(base))
 }
@@ -363,4 +363,33 @@ var _ = Describe("VolumePoolController", func() {
 			HaveField("ObjectMeta.Labels", HaveKeyWithValue("topology.ironcore.dev/zone", "test-zone-1")),
 		))
 	})
+
+	It("should surface the runtime capabilities", func(ctx SpecContext) {
+		By("checking the capabilities are reflected in the labels and conditions")
+		Eventually(Object(volumePool)).Should(SatisfyAll(
+			HaveField("ObjectMeta.Labels", HaveKeyWithValue("capability.ironcore.dev/snapshots", "true")),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", storagev1alpha1.VolumePoolRuntimeCapabilities),
+				HaveField("Status", corev1.ConditionTrue),
+			))),
+		))
+
+		By("only reporting the expand capability and adding a stale capability label to trigger a reconciliation")
+		srv.SetCapabilities([]iri.RuntimeCapability{iri.RuntimeCapability_RUNTIME_CAPABILITY_EXPAND})
+		Eventually(Update(volumePool, func() {
+			volumePool.Labels["capability.ironcore.dev/stale"] = "true"
+		})).Should(Succeed())
+
+		By("checking the labels and conditions are updated")
+		Eventually(Object(volumePool)).Should(SatisfyAll(
+			HaveField("ObjectMeta.Labels", HaveKeyWithValue("capability.ironcore.dev/expand", "true")),
+			HaveField("ObjectMeta.Labels", Not(HaveKey("capability.ironcore.dev/snapshots"))),
+			HaveField("ObjectMeta.Labels", Not(HaveKey("capability.ironcore.dev/stale"))),
+			HaveField("Status.Conditions", ContainElement(SatisfyAll(
+				HaveField("Type", storagev1alpha1.VolumePoolRuntimeCapabilities),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Message", "Runtime capabilities: expand"),
+			))),
+		))
+	})
 })
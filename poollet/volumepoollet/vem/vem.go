@@ -10,12 +10,14 @@ import (
 
 	"github.com/go-logr/logr"
 	storagev1alpha1 "github.com/ironcore-dev/ironcore/api/storage/v1alpha1"
+	irievent "github.com/ironcore-dev/ironcore/iri/apis/event/v1alpha1"
 	"github.com/ironcore-dev/ironcore/iri/apis/volume"
 	iri "github.com/ironcore-dev/ironcore/iri/apis/volume/v1alpha1"
 	"github.com/ironcore-dev/ironcore/poollet/volumepoollet/api/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,8 +32,9 @@ type VolumeEventMapper struct {
 
 	volumeRuntime volume.RuntimeService
 
-	relistPeriod time.Duration
-	lastFetched  time.Time
+	relistPeriod    time.Duration
+	lastFetched     time.Time
+	resourceVersion string
 }
 
 func (m *VolumeEventMapper) relist(ctx context.Context, log logr.Logger) error {
@@ -46,31 +49,79 @@ func (m *VolumeEventMapper) relist(ctx context.Context, log logr.Logger) error {
 
 	m.lastFetched = toEventFilterTime
 	for _, volumeEvent := range res.Events {
-		if volumeEvent.Spec.InvolvedObjectMeta.Labels != nil {
-			involvedVolume := &storagev1alpha1.Volume{
-				ObjectMeta: metav1.ObjectMeta{
-					UID:       types.UID(volumeEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.VolumeUIDLabel]),
-					Name:      volumeEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.VolumeNameLabel],
-					Namespace: volumeEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.VolumeNamespaceLabel],
-				},
-			}
-			// events.k8s.io/v1 requires a non-empty action field. Providers should populate
-			// Spec.Action on IRI events; fall back to "Unknown" so a forgotten value is
-			// obvious in the emitted event rather than silently dropped by the API server.
-			action := volumeEvent.Spec.Action
-			if action == "" {
-				action = "Unknown"
-			}
-			m.Eventf(involvedVolume, nil, volumeEvent.Spec.Type, volumeEvent.Spec.Reason, action, volumeEvent.Spec.Message)
-		}
+		m.emit(volumeEvent)
 	}
 
 	return nil
 }
 
+func (m *VolumeEventMapper) emit(volumeEvent *irievent.Event) {
+	if volumeEvent.Spec.InvolvedObjectMeta.Labels != nil {
+		involvedVolume := &storagev1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:       types.UID(volumeEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.VolumeUIDLabel]),
+				Name:      volumeEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.VolumeNameLabel],
+				Namespace: volumeEvent.Spec.InvolvedObjectMeta.Labels[v1alpha1.VolumeNamespaceLabel],
+			},
+		}
+		// events.k8s.io/v1 requires a non-empty action field. Providers should populate
+		// Spec.Action on IRI events; fall back to "Unknown" so a forgotten value is
+		// obvious in the emitted event rather than silently dropped by the API server.
+		action := volumeEvent.Spec.Action
+		if action == "" {
+			action = "Unknown"
+		}
+		m.Eventf(involvedVolume, nil, volumeEvent.Spec.Type, volumeEvent.Spec.Reason, action, volumeEvent.Spec.Message)
+	}
+}
+
+// watch emits the volume cluster events received via watch until the watch breaks.
+func (m *VolumeEventMapper) watch(ctx context.Context, log logr.Logger) error {
+	log.V(1).Info("Watching volume cluster events")
+	stream, err := m.volumeRuntime.WatchEvents(ctx, &iri.WatchEventsRequest{
+		ResourceVersion: m.resourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		m.lastFetched = time.Now()
+		m.resourceVersion = res.ResourceVersion
+		m.emit(res.Event)
+	}
+}
+
 func (m *VolumeEventMapper) Start(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx).WithName("vem")
 	m.lastFetched = time.Now()
+	for {
+		err := m.watch(ctx, log)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if status.Code(err) == codes.Unimplemented {
+			log.Info("Watching volume cluster events is not implemented, falling back to relisting")
+			break
+		}
+
+		// Relist to catch up on the events missed while not watching.
+		log.Error(err, "Watch broke, relisting")
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(m.relistPeriod):
+		}
+		if err := m.relist(ctx, log); err != nil {
+			log.Error(err, "Error relisting")
+		}
+	}
+
 	wait.UntilWithContext(ctx, func(ctx context.Context) {
 		if err := m.relist(ctx, log); err != nil {
 			log.Error(err, "Error relisting")
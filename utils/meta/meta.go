@@ -4,9 +4,15 @@
 package meta
 
 import (
+	"hash/fnv"
+	"strconv"
+	"strings"
+
 	"github.com/ironcore-dev/ironcore/utils/generic"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 func MakeControllerRef(gvk schema.GroupVersionKind, obj metav1.Object) metav1.OwnerReference {
@@ -18,3 +24,18 @@ func MakeControllerRef(gvk schema.GroupVersionKind, obj metav1.Object) metav1.Ow
 		Controller: generic.Pointer(true),
 	}
 }
+
+// BoundedName joins the parts with dashes to an object name. Names exceeding the maximum name length
+// are truncated and suffixed with a hash of the full name.
+func BoundedName(parts ...string) string {
+	name := strings.Join(parts, "-")
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(name))
+	hash := rand.SafeEncodeString(strconv.FormatUint(uint64(hasher.Sum32()), 10))
+	prefix := strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength-len(hash)-1], "-.")
+	return prefix + "-" + hash
+}